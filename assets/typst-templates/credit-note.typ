@@ -0,0 +1,113 @@
+#import "default.typ": format-number
+
+#let data = json(sys.inputs.path)
+
+#let currency = if "currency" in data { data.currency } else { "$" }
+#let secondary-color = rgb("#666666")
+#let line-color = rgb("#eee")
+
+#let outcome-labels = (
+  REFUND: "Refunded to the original payment",
+  WALLET_CREDIT: "Credited to the customer wallet",
+  REDUCE_AMOUNT_REMAINING: "Deducted from the invoice amount due",
+)
+
+#set document(title: "Credit Note " + data.credit_note_number)
+#set page(margin: (top: 15mm, right: 15mm, bottom: 15mm, left: 15mm), numbering: none)
+#set text(font: "Inter", size: 10pt)
+#set table(stroke: none)
+
+#text(weight: "bold", size: 2em)[Credit Note]
+
+#grid(
+  columns: (1fr, 1fr, 1fr),
+  gutter: 0.5em,
+  [
+    #text(fill: secondary-color)[Credit Note Number]\
+    #data.credit_note_number
+  ],
+  [
+    #text(fill: secondary-color)[Invoice Number]\
+    #data.invoice_number
+  ],
+  [
+    #text(fill: secondary-color)[Date of Issue]\
+    #data.issuing_date
+  ],
+)
+
+#line(length: 100%, stroke: line-color)
+#v(2em)
+
+#grid(
+  columns: (1fr, 1fr),
+  gutter: 1em,
+  [
+    #text(weight: "semibold", size: 12pt)[From]
+    #v(0.25em)
+    #text(weight: "medium")[#data.biller.name] \
+    #text(fill: gray)[#data.biller.at("email", default: "--")] \
+    #text(fill: secondary-color)[#data.biller.address.street] \
+    #text(fill: secondary-color)[#data.biller.address.city] \
+    #text(fill: secondary-color)[#data.biller.address.postal_code]
+  ],
+  [
+    #text(weight: "semibold", size: 12pt)[Credit to]
+    #v(0.25em)
+    #text(weight: "medium")[#data.recipient.name] \
+    #text(fill: gray)[#data.recipient.at("email", default: "--")] \
+    #text(fill: secondary-color)[#data.recipient.address.street] \
+    #text(fill: secondary-color)[#data.recipient.address.city] \
+    #text(fill: secondary-color)[#data.recipient.address.postal_code]
+  ]
+)
+
+#v(2em)
+#line(length: 100%, stroke: line-color)
+#v(1em)
+
+== Credited Items
+#v(1em)
+
+#table(
+  columns: (3fr, 1fr),
+  inset: 8pt,
+  align: (left, right),
+  stroke: (x, y) => (bottom: 1pt + line-color),
+  table.header([*Description*], [*Amount*]),
+  ..data.line_items.map(item => (
+    item.display_name,
+    [#currency #format-number(item.amount)],
+  )).flatten(),
+)
+
+#v(1em)
+
+#align(right, table(
+  columns: 2,
+  align: (left, right),
+  inset: 6pt,
+  [*Total Credited*], [*#currency#format-number(data.total_amount)*],
+))
+
+#v(2em)
+
+#grid(
+  columns: (1fr, 1fr),
+  gutter: 1em,
+  [
+    #text(fill: secondary-color)[Reason]\
+    #data.reason.replace("_", " ")
+  ],
+  [
+    #text(fill: secondary-color)[Outcome]\
+    #outcome-labels.at(data.outcome, default: data.outcome)
+  ],
+)
+
+#if data.memo != "" {
+  v(1em)
+  [== Memo]
+  v(0.5em)
+  data.memo
+}
@@ -50,6 +50,22 @@
   result.rev() + decimal-part
 }
 
+// Describes a tier of a tiered price ex "Tier 2 (up to 1,000): $0.5 / unit + $10 flat"
+#let format-tier = (tier, currency) => {
+  let range = if tier.at("up_to", default: none) != none {
+    "up to " + format-number(tier.up_to)
+  } else {
+    "and above"
+  }
+
+  let rate = currency + format-number(tier.unit_amount) + " / unit"
+  if tier.at("flat_amount", default: none) != none {
+    rate += " + " + currency + format-number(tier.flat_amount) + " flat"
+  }
+
+  "Tier " + str(tier.tier) + " (" + range + "): " + rate
+}
+
 // Define the default-invoice function
 #let default-invoice(
   language: "en",
@@ -61,6 +77,9 @@
   issuing-date: none,
   due-date: none,
   amount-due: 0,  
+  subtotal: none,               // Sum of line items before discounts, defaults to amount-due
+  discounts: (),                // Discounts applied to the subtotal
+  taxes: (),                    // Tax lines, amount-due already includes exclusive taxes
   notes: "",
   biller: (:),                  // Company info
   recipient: (:),               // Customer info
@@ -194,7 +213,7 @@
       [*Amount*],
     ),
     ..items.map((item) => {
-      (
+      let rows = (
         item.at("plan_display_name", default: "Plan"),
         item.at("description", default: "Recurring"),
         if item.at("period_start", default: none) != none and item.at("period_end", default: none) != none {
@@ -203,8 +222,21 @@
           "-"
         },
         format-number(item.quantity),
-        [#currency #format-number(item.quantity * item.amount)],
+        [#currency #format-number(item.amount)],
       )
+
+      // Tier breakdown of tiered prices so the charge can be audited per tier
+      for tier in item.at("tiers", default: ()) {
+        rows += (
+          [],
+          text(size: 8pt, fill: styling.secondary-color)[#format-tier(tier, currency)],
+          [],
+          text(size: 8pt, fill: styling.secondary-color)[#format-number(tier.quantity)],
+          text(size: 8pt, fill: styling.secondary-color)[#currency #format-number(tier.amount)],
+        )
+      }
+
+      rows
     }).flatten(),
   )
 
@@ -217,10 +249,23 @@
       align: (left, right),
       inset: 6pt,
       stroke: none,
-      [Subtotal], [#currency#format-number(amount-due)],
-      [Tax], if vat == 0 { [-] } else { [#currency#format-number(calc.round(amount-due * vat, digits: 2))] },
+      [Subtotal], [#currency#format-number(if subtotal == none { amount-due } else { subtotal })],
+      ..discounts.map(discount => (
+        [Discount: #discount.description], [-#currency#format-number(discount.amount)],
+      )).flatten(),
+      ..if taxes.len() > 0 {
+        taxes.map(tax => (
+          [#tax.name (#format-number(tax.percentage)%#if tax.inclusive [ incl.])], [#currency#format-number(tax.amount)],
+        )).flatten()
+      } else {
+        ([Tax], if vat == 0 { [-] } else { [#currency#format-number(calc.round(amount-due * vat, digits: 2))] })
+      },
       table.hline(stroke: 1pt + styling.line-color),
-      [*Total Amount*], [*#currency#format-number(amount-due + calc.round(amount-due * vat, digits: 2))*],
+      [*Total Amount*], if taxes.len() > 0 {
+        [*#currency#format-number(amount-due)*]
+      } else {
+        [*#currency#format-number(amount-due + calc.round(amount-due * vat, digits: 2))*]
+      },
     )
   )
 
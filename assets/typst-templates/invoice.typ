@@ -14,6 +14,19 @@
   issuing-date: invoice-data.issuing_date,
   due-date: invoice-data.due_date,
   amount-due: invoice-data.amount_due,
+  subtotal: if "subtotal" in invoice-data {
+    invoice-data.subtotal
+  },
+  discounts: if "discounts" in invoice-data {
+    invoice-data.discounts
+  } else {
+    ()
+  },
+  taxes: if "taxes" in invoice-data {
+    invoice-data.taxes
+  } else {
+    ()
+  },
   notes: invoice-data.notes,
   vat: invoice-data.vat,
   biller: (
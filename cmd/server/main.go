@@ -19,10 +19,12 @@ import (
 	"github.com/flexprice/flexprice/internal/pdf"
 	"github.com/flexprice/flexprice/internal/postgres"
 	"github.com/flexprice/flexprice/internal/publisher"
+	"github.com/flexprice/flexprice/internal/pubsub/outbox"
 	pubsubRouter "github.com/flexprice/flexprice/internal/pubsub/router"
 	"github.com/flexprice/flexprice/internal/repository"
 	"github.com/flexprice/flexprice/internal/sentry"
 	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/storage"
 	"github.com/flexprice/flexprice/internal/temporal"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/typst"
@@ -64,6 +66,8 @@ func main() {
 			kafka.NewConsumer,
 			publisher.NewEventPublisher,
 			httpclient.NewDefaultClient,
+			httpclient.NewWebhookClient,
+			storage.NewStorage,
 			repository.NewEventRepository,
 			repository.NewMeterRepository,
 			repository.NewUserRepository,
@@ -73,6 +77,14 @@ func main() {
 			repository.NewInvoiceRepository,
 			repository.NewFeatureRepository,
 			repository.NewEntitlementRepository,
+			repository.NewCouponRepository,
+			repository.NewTaxRateRepository,
+			repository.NewCreditNoteRepository,
+			repository.NewDunningAttemptRepository,
+			repository.NewAlertRepository,
+			repository.NewWebhookEndpointRepository,
+			repository.NewWebhookDeliveryRepository,
+			repository.NewOutboxRepository,
 			pubsubRouter.NewRouter,
 			provideTemporalClient,
 			provideTemporalService,
@@ -105,6 +117,13 @@ func main() {
 			service.NewSecretService,
 			service.NewOnboardingService,
 			service.NewBillingService,
+			service.NewCouponService,
+			service.NewTaxRateService,
+			service.NewCreditNoteService,
+			service.NewDunningService,
+			service.NewAlertService,
+			service.NewWebhookEndpointService,
+			service.NewWebhookDeliveryService,
 		),
 	)
 
@@ -167,6 +186,7 @@ func startServer(
 	temporalClient *temporal.TemporalClient,
 	webhookService *webhook.WebhookService,
 	router *pubsubRouter.Router,
+	outboxRelay *outbox.Relay,
 	log *logger.Logger,
 ) {
 	mode := cfg.Deployment.Mode
@@ -183,10 +203,12 @@ func startServer(
 		startAPIServer(lc, r, cfg, log)
 		startConsumer(lc, consumer, eventRepo, cfg, log)
 		startMessageRouter(lc, router, webhookService, log)
+		startOutboxRelay(lc, outboxRelay, cfg, log)
 
 	case types.ModeAPI:
 		startAPIServer(lc, r, cfg, log)
 		startMessageRouter(lc, router, webhookService, log)
+		startOutboxRelay(lc, outboxRelay, cfg, log)
 
 	case types.ModeTemporalWorker:
 		startTemporalWorker(lc, temporalClient, &cfg.Temporal, log)
@@ -306,6 +328,33 @@ func startMessageRouter(
 	})
 }
 
+// Start the relay publishing the outbox events. The relay runs next to the message router as the
+// webhook pubsub is in-memory and only reaches the router of its own process, the other modes
+// write their events to the outbox which the relay of an api or local deployment publishes.
+func startOutboxRelay(
+	lc fx.Lifecycle,
+	relay *outbox.Relay,
+	cfg *config.Configuration,
+	log *logger.Logger,
+) {
+	if !cfg.Outbox.Enabled {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Info("Starting outbox relay")
+			relay.Start(context.Background())
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Info("Stopping outbox relay")
+			relay.Stop()
+			return nil
+		},
+	})
+}
+
 func startAWSLambdaAPI(r *gin.Engine) {
 	ginLambda := ginadapter.New(r)
 	lambda.Start(ginLambda.ProxyWithContext)
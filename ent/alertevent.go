@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/alertevent"
+	"github.com/shopspring/decimal"
+)
+
+// AlertEvent is the model entity for the AlertEvent schema.
+type AlertEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// AlertRuleID holds the value of the "alert_rule_id" field.
+	AlertRuleID string `json:"alert_rule_id,omitempty"`
+	// AlertType holds the value of the "alert_type" field.
+	AlertType string `json:"alert_type,omitempty"`
+	// CustomerID holds the value of the "customer_id" field.
+	CustomerID string `json:"customer_id,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID string `json:"subscription_id,omitempty"`
+	// FeatureID holds the value of the "feature_id" field.
+	FeatureID *string `json:"feature_id,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold decimal.Decimal `json:"threshold,omitempty"`
+	// Value holds the value of the "value" field.
+	Value decimal.Decimal `json:"value,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// PeriodEnd holds the value of the "period_end" field.
+	PeriodEnd    time.Time `json:"period_end,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AlertEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case alertevent.FieldThreshold, alertevent.FieldValue:
+			values[i] = new(decimal.Decimal)
+		case alertevent.FieldID, alertevent.FieldTenantID, alertevent.FieldStatus, alertevent.FieldCreatedBy, alertevent.FieldUpdatedBy, alertevent.FieldEnvironmentID, alertevent.FieldAlertRuleID, alertevent.FieldAlertType, alertevent.FieldCustomerID, alertevent.FieldSubscriptionID, alertevent.FieldFeatureID, alertevent.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case alertevent.FieldCreatedAt, alertevent.FieldUpdatedAt, alertevent.FieldPeriodStart, alertevent.FieldPeriodEnd:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AlertEvent fields.
+func (ae *AlertEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case alertevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ae.ID = value.String
+			}
+		case alertevent.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ae.TenantID = value.String
+			}
+		case alertevent.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ae.Status = value.String
+			}
+		case alertevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ae.CreatedAt = value.Time
+			}
+		case alertevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ae.UpdatedAt = value.Time
+			}
+		case alertevent.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ae.CreatedBy = value.String
+			}
+		case alertevent.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ae.UpdatedBy = value.String
+			}
+		case alertevent.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				ae.EnvironmentID = value.String
+			}
+		case alertevent.FieldAlertRuleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_rule_id", values[i])
+			} else if value.Valid {
+				ae.AlertRuleID = value.String
+			}
+		case alertevent.FieldAlertType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_type", values[i])
+			} else if value.Valid {
+				ae.AlertType = value.String
+			}
+		case alertevent.FieldCustomerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value.Valid {
+				ae.CustomerID = value.String
+			}
+		case alertevent.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				ae.SubscriptionID = value.String
+			}
+		case alertevent.FieldFeatureID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feature_id", values[i])
+			} else if value.Valid {
+				ae.FeatureID = new(string)
+				*ae.FeatureID = value.String
+			}
+		case alertevent.FieldThreshold:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value != nil {
+				ae.Threshold = *value
+			}
+		case alertevent.FieldValue:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value != nil {
+				ae.Value = *value
+			}
+		case alertevent.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				ae.Currency = value.String
+			}
+		case alertevent.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				ae.PeriodStart = value.Time
+			}
+		case alertevent.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				ae.PeriodEnd = value.Time
+			}
+		default:
+			ae.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the AlertEvent.
+// This includes values selected through modifiers, order, etc.
+func (ae *AlertEvent) GetValue(name string) (ent.Value, error) {
+	return ae.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AlertEvent.
+// Note that you need to call AlertEvent.Unwrap() before calling this method if this AlertEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AlertEvent) Update() *AlertEventUpdateOne {
+	return NewAlertEventClient(ae.config).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AlertEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AlertEvent) Unwrap() *AlertEvent {
+	_tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AlertEvent is not a transactional entity")
+	}
+	ae.config.driver = _tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AlertEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("AlertEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ae.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(ae.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(ae.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ae.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ae.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ae.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ae.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(ae.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("alert_rule_id=")
+	builder.WriteString(ae.AlertRuleID)
+	builder.WriteString(", ")
+	builder.WriteString("alert_type=")
+	builder.WriteString(ae.AlertType)
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(ae.CustomerID)
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(ae.SubscriptionID)
+	builder.WriteString(", ")
+	if v := ae.FeatureID; v != nil {
+		builder.WriteString("feature_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("threshold=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Threshold))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Value))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(ae.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(ae.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(ae.PeriodEnd.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AlertEvents is a parsable slice of AlertEvent.
+type AlertEvents []*AlertEvent
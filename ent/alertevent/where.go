@@ -0,0 +1,1171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package alertevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// AlertRuleID applies equality check predicate on the "alert_rule_id" field. It's identical to AlertRuleIDEQ.
+func AlertRuleID(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldAlertRuleID, v))
+}
+
+// AlertType applies equality check predicate on the "alert_type" field. It's identical to AlertTypeEQ.
+func AlertType(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldAlertType, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// FeatureID applies equality check predicate on the "feature_id" field. It's identical to FeatureIDEQ.
+func FeatureID(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldFeatureID, v))
+}
+
+// Threshold applies equality check predicate on the "threshold" field. It's identical to ThresholdEQ.
+func Threshold(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldThreshold, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldValue, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldCurrency, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// AlertRuleIDEQ applies the EQ predicate on the "alert_rule_id" field.
+func AlertRuleIDEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldAlertRuleID, v))
+}
+
+// AlertRuleIDNEQ applies the NEQ predicate on the "alert_rule_id" field.
+func AlertRuleIDNEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldAlertRuleID, v))
+}
+
+// AlertRuleIDIn applies the In predicate on the "alert_rule_id" field.
+func AlertRuleIDIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldAlertRuleID, vs...))
+}
+
+// AlertRuleIDNotIn applies the NotIn predicate on the "alert_rule_id" field.
+func AlertRuleIDNotIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldAlertRuleID, vs...))
+}
+
+// AlertRuleIDGT applies the GT predicate on the "alert_rule_id" field.
+func AlertRuleIDGT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldAlertRuleID, v))
+}
+
+// AlertRuleIDGTE applies the GTE predicate on the "alert_rule_id" field.
+func AlertRuleIDGTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldAlertRuleID, v))
+}
+
+// AlertRuleIDLT applies the LT predicate on the "alert_rule_id" field.
+func AlertRuleIDLT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldAlertRuleID, v))
+}
+
+// AlertRuleIDLTE applies the LTE predicate on the "alert_rule_id" field.
+func AlertRuleIDLTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldAlertRuleID, v))
+}
+
+// AlertRuleIDContains applies the Contains predicate on the "alert_rule_id" field.
+func AlertRuleIDContains(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContains(FieldAlertRuleID, v))
+}
+
+// AlertRuleIDHasPrefix applies the HasPrefix predicate on the "alert_rule_id" field.
+func AlertRuleIDHasPrefix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasPrefix(FieldAlertRuleID, v))
+}
+
+// AlertRuleIDHasSuffix applies the HasSuffix predicate on the "alert_rule_id" field.
+func AlertRuleIDHasSuffix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasSuffix(FieldAlertRuleID, v))
+}
+
+// AlertRuleIDEqualFold applies the EqualFold predicate on the "alert_rule_id" field.
+func AlertRuleIDEqualFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldAlertRuleID, v))
+}
+
+// AlertRuleIDContainsFold applies the ContainsFold predicate on the "alert_rule_id" field.
+func AlertRuleIDContainsFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldAlertRuleID, v))
+}
+
+// AlertTypeEQ applies the EQ predicate on the "alert_type" field.
+func AlertTypeEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldAlertType, v))
+}
+
+// AlertTypeNEQ applies the NEQ predicate on the "alert_type" field.
+func AlertTypeNEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldAlertType, v))
+}
+
+// AlertTypeIn applies the In predicate on the "alert_type" field.
+func AlertTypeIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldAlertType, vs...))
+}
+
+// AlertTypeNotIn applies the NotIn predicate on the "alert_type" field.
+func AlertTypeNotIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldAlertType, vs...))
+}
+
+// AlertTypeGT applies the GT predicate on the "alert_type" field.
+func AlertTypeGT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldAlertType, v))
+}
+
+// AlertTypeGTE applies the GTE predicate on the "alert_type" field.
+func AlertTypeGTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldAlertType, v))
+}
+
+// AlertTypeLT applies the LT predicate on the "alert_type" field.
+func AlertTypeLT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldAlertType, v))
+}
+
+// AlertTypeLTE applies the LTE predicate on the "alert_type" field.
+func AlertTypeLTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldAlertType, v))
+}
+
+// AlertTypeContains applies the Contains predicate on the "alert_type" field.
+func AlertTypeContains(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContains(FieldAlertType, v))
+}
+
+// AlertTypeHasPrefix applies the HasPrefix predicate on the "alert_type" field.
+func AlertTypeHasPrefix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasPrefix(FieldAlertType, v))
+}
+
+// AlertTypeHasSuffix applies the HasSuffix predicate on the "alert_type" field.
+func AlertTypeHasSuffix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasSuffix(FieldAlertType, v))
+}
+
+// AlertTypeEqualFold applies the EqualFold predicate on the "alert_type" field.
+func AlertTypeEqualFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldAlertType, v))
+}
+
+// AlertTypeContainsFold applies the ContainsFold predicate on the "alert_type" field.
+func AlertTypeContainsFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldAlertType, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// CustomerIDContains applies the Contains predicate on the "customer_id" field.
+func CustomerIDContains(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContains(FieldCustomerID, v))
+}
+
+// CustomerIDHasPrefix applies the HasPrefix predicate on the "customer_id" field.
+func CustomerIDHasPrefix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasPrefix(FieldCustomerID, v))
+}
+
+// CustomerIDHasSuffix applies the HasSuffix predicate on the "customer_id" field.
+func CustomerIDHasSuffix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasSuffix(FieldCustomerID, v))
+}
+
+// CustomerIDEqualFold applies the EqualFold predicate on the "customer_id" field.
+func CustomerIDEqualFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldCustomerID, v))
+}
+
+// CustomerIDContainsFold applies the ContainsFold predicate on the "customer_id" field.
+func CustomerIDContainsFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldCustomerID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContains applies the Contains predicate on the "subscription_id" field.
+func SubscriptionIDContains(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContains(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasPrefix applies the HasPrefix predicate on the "subscription_id" field.
+func SubscriptionIDHasPrefix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasPrefix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasSuffix applies the HasSuffix predicate on the "subscription_id" field.
+func SubscriptionIDHasSuffix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasSuffix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDEqualFold applies the EqualFold predicate on the "subscription_id" field.
+func SubscriptionIDEqualFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContainsFold applies the ContainsFold predicate on the "subscription_id" field.
+func SubscriptionIDContainsFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldSubscriptionID, v))
+}
+
+// FeatureIDEQ applies the EQ predicate on the "feature_id" field.
+func FeatureIDEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldFeatureID, v))
+}
+
+// FeatureIDNEQ applies the NEQ predicate on the "feature_id" field.
+func FeatureIDNEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldFeatureID, v))
+}
+
+// FeatureIDIn applies the In predicate on the "feature_id" field.
+func FeatureIDIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldFeatureID, vs...))
+}
+
+// FeatureIDNotIn applies the NotIn predicate on the "feature_id" field.
+func FeatureIDNotIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldFeatureID, vs...))
+}
+
+// FeatureIDGT applies the GT predicate on the "feature_id" field.
+func FeatureIDGT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldFeatureID, v))
+}
+
+// FeatureIDGTE applies the GTE predicate on the "feature_id" field.
+func FeatureIDGTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldFeatureID, v))
+}
+
+// FeatureIDLT applies the LT predicate on the "feature_id" field.
+func FeatureIDLT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldFeatureID, v))
+}
+
+// FeatureIDLTE applies the LTE predicate on the "feature_id" field.
+func FeatureIDLTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldFeatureID, v))
+}
+
+// FeatureIDContains applies the Contains predicate on the "feature_id" field.
+func FeatureIDContains(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContains(FieldFeatureID, v))
+}
+
+// FeatureIDHasPrefix applies the HasPrefix predicate on the "feature_id" field.
+func FeatureIDHasPrefix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasPrefix(FieldFeatureID, v))
+}
+
+// FeatureIDHasSuffix applies the HasSuffix predicate on the "feature_id" field.
+func FeatureIDHasSuffix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasSuffix(FieldFeatureID, v))
+}
+
+// FeatureIDIsNil applies the IsNil predicate on the "feature_id" field.
+func FeatureIDIsNil() predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIsNull(FieldFeatureID))
+}
+
+// FeatureIDNotNil applies the NotNil predicate on the "feature_id" field.
+func FeatureIDNotNil() predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotNull(FieldFeatureID))
+}
+
+// FeatureIDEqualFold applies the EqualFold predicate on the "feature_id" field.
+func FeatureIDEqualFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldFeatureID, v))
+}
+
+// FeatureIDContainsFold applies the ContainsFold predicate on the "feature_id" field.
+func FeatureIDContainsFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldFeatureID, v))
+}
+
+// ThresholdEQ applies the EQ predicate on the "threshold" field.
+func ThresholdEQ(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldThreshold, v))
+}
+
+// ThresholdNEQ applies the NEQ predicate on the "threshold" field.
+func ThresholdNEQ(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldThreshold, v))
+}
+
+// ThresholdIn applies the In predicate on the "threshold" field.
+func ThresholdIn(vs ...decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldThreshold, vs...))
+}
+
+// ThresholdNotIn applies the NotIn predicate on the "threshold" field.
+func ThresholdNotIn(vs ...decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldThreshold, vs...))
+}
+
+// ThresholdGT applies the GT predicate on the "threshold" field.
+func ThresholdGT(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldThreshold, v))
+}
+
+// ThresholdGTE applies the GTE predicate on the "threshold" field.
+func ThresholdGTE(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldThreshold, v))
+}
+
+// ThresholdLT applies the LT predicate on the "threshold" field.
+func ThresholdLT(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldThreshold, v))
+}
+
+// ThresholdLTE applies the LTE predicate on the "threshold" field.
+func ThresholdLTE(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldThreshold, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v decimal.Decimal) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldValue, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AlertEvent) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AlertEvent) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AlertEvent) predicate.AlertEvent {
+	return predicate.AlertEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/alertevent"
+	"github.com/shopspring/decimal"
+)
+
+// AlertEventCreate is the builder for creating a AlertEvent entity.
+type AlertEventCreate struct {
+	config
+	mutation *AlertEventMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (aec *AlertEventCreate) SetTenantID(s string) *AlertEventCreate {
+	aec.mutation.SetTenantID(s)
+	return aec
+}
+
+// SetStatus sets the "status" field.
+func (aec *AlertEventCreate) SetStatus(s string) *AlertEventCreate {
+	aec.mutation.SetStatus(s)
+	return aec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aec *AlertEventCreate) SetNillableStatus(s *string) *AlertEventCreate {
+	if s != nil {
+		aec.SetStatus(*s)
+	}
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AlertEventCreate) SetCreatedAt(t time.Time) *AlertEventCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AlertEventCreate) SetNillableCreatedAt(t *time.Time) *AlertEventCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aec *AlertEventCreate) SetUpdatedAt(t time.Time) *AlertEventCreate {
+	aec.mutation.SetUpdatedAt(t)
+	return aec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (aec *AlertEventCreate) SetNillableUpdatedAt(t *time.Time) *AlertEventCreate {
+	if t != nil {
+		aec.SetUpdatedAt(*t)
+	}
+	return aec
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (aec *AlertEventCreate) SetCreatedBy(s string) *AlertEventCreate {
+	aec.mutation.SetCreatedBy(s)
+	return aec
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (aec *AlertEventCreate) SetNillableCreatedBy(s *string) *AlertEventCreate {
+	if s != nil {
+		aec.SetCreatedBy(*s)
+	}
+	return aec
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (aec *AlertEventCreate) SetUpdatedBy(s string) *AlertEventCreate {
+	aec.mutation.SetUpdatedBy(s)
+	return aec
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (aec *AlertEventCreate) SetNillableUpdatedBy(s *string) *AlertEventCreate {
+	if s != nil {
+		aec.SetUpdatedBy(*s)
+	}
+	return aec
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (aec *AlertEventCreate) SetEnvironmentID(s string) *AlertEventCreate {
+	aec.mutation.SetEnvironmentID(s)
+	return aec
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (aec *AlertEventCreate) SetNillableEnvironmentID(s *string) *AlertEventCreate {
+	if s != nil {
+		aec.SetEnvironmentID(*s)
+	}
+	return aec
+}
+
+// SetAlertRuleID sets the "alert_rule_id" field.
+func (aec *AlertEventCreate) SetAlertRuleID(s string) *AlertEventCreate {
+	aec.mutation.SetAlertRuleID(s)
+	return aec
+}
+
+// SetAlertType sets the "alert_type" field.
+func (aec *AlertEventCreate) SetAlertType(s string) *AlertEventCreate {
+	aec.mutation.SetAlertType(s)
+	return aec
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (aec *AlertEventCreate) SetCustomerID(s string) *AlertEventCreate {
+	aec.mutation.SetCustomerID(s)
+	return aec
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (aec *AlertEventCreate) SetSubscriptionID(s string) *AlertEventCreate {
+	aec.mutation.SetSubscriptionID(s)
+	return aec
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (aec *AlertEventCreate) SetFeatureID(s string) *AlertEventCreate {
+	aec.mutation.SetFeatureID(s)
+	return aec
+}
+
+// SetNillableFeatureID sets the "feature_id" field if the given value is not nil.
+func (aec *AlertEventCreate) SetNillableFeatureID(s *string) *AlertEventCreate {
+	if s != nil {
+		aec.SetFeatureID(*s)
+	}
+	return aec
+}
+
+// SetThreshold sets the "threshold" field.
+func (aec *AlertEventCreate) SetThreshold(d decimal.Decimal) *AlertEventCreate {
+	aec.mutation.SetThreshold(d)
+	return aec
+}
+
+// SetValue sets the "value" field.
+func (aec *AlertEventCreate) SetValue(d decimal.Decimal) *AlertEventCreate {
+	aec.mutation.SetValue(d)
+	return aec
+}
+
+// SetCurrency sets the "currency" field.
+func (aec *AlertEventCreate) SetCurrency(s string) *AlertEventCreate {
+	aec.mutation.SetCurrency(s)
+	return aec
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (aec *AlertEventCreate) SetNillableCurrency(s *string) *AlertEventCreate {
+	if s != nil {
+		aec.SetCurrency(*s)
+	}
+	return aec
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (aec *AlertEventCreate) SetPeriodStart(t time.Time) *AlertEventCreate {
+	aec.mutation.SetPeriodStart(t)
+	return aec
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (aec *AlertEventCreate) SetPeriodEnd(t time.Time) *AlertEventCreate {
+	aec.mutation.SetPeriodEnd(t)
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AlertEventCreate) SetID(s string) *AlertEventCreate {
+	aec.mutation.SetID(s)
+	return aec
+}
+
+// Mutation returns the AlertEventMutation object of the builder.
+func (aec *AlertEventCreate) Mutation() *AlertEventMutation {
+	return aec.mutation
+}
+
+// Save creates the AlertEvent in the database.
+func (aec *AlertEventCreate) Save(ctx context.Context) (*AlertEvent, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AlertEventCreate) SaveX(ctx context.Context) *AlertEvent {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AlertEventCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AlertEventCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AlertEventCreate) defaults() {
+	if _, ok := aec.mutation.Status(); !ok {
+		v := alertevent.DefaultStatus
+		aec.mutation.SetStatus(v)
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := alertevent.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aec.mutation.UpdatedAt(); !ok {
+		v := alertevent.DefaultUpdatedAt()
+		aec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := aec.mutation.EnvironmentID(); !ok {
+		v := alertevent.DefaultEnvironmentID
+		aec.mutation.SetEnvironmentID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AlertEventCreate) check() error {
+	if _, ok := aec.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "AlertEvent.tenant_id"`)}
+	}
+	if v, ok := aec.mutation.TenantID(); ok {
+		if err := alertevent.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "AlertEvent.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AlertEvent.status"`)}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AlertEvent.created_at"`)}
+	}
+	if _, ok := aec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AlertEvent.updated_at"`)}
+	}
+	if _, ok := aec.mutation.AlertRuleID(); !ok {
+		return &ValidationError{Name: "alert_rule_id", err: errors.New(`ent: missing required field "AlertEvent.alert_rule_id"`)}
+	}
+	if v, ok := aec.mutation.AlertRuleID(); ok {
+		if err := alertevent.AlertRuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "alert_rule_id", err: fmt.Errorf(`ent: validator failed for field "AlertEvent.alert_rule_id": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.AlertType(); !ok {
+		return &ValidationError{Name: "alert_type", err: errors.New(`ent: missing required field "AlertEvent.alert_type"`)}
+	}
+	if v, ok := aec.mutation.AlertType(); ok {
+		if err := alertevent.AlertTypeValidator(v); err != nil {
+			return &ValidationError{Name: "alert_type", err: fmt.Errorf(`ent: validator failed for field "AlertEvent.alert_type": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "AlertEvent.customer_id"`)}
+	}
+	if v, ok := aec.mutation.CustomerID(); ok {
+		if err := alertevent.CustomerIDValidator(v); err != nil {
+			return &ValidationError{Name: "customer_id", err: fmt.Errorf(`ent: validator failed for field "AlertEvent.customer_id": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "AlertEvent.subscription_id"`)}
+	}
+	if v, ok := aec.mutation.SubscriptionID(); ok {
+		if err := alertevent.SubscriptionIDValidator(v); err != nil {
+			return &ValidationError{Name: "subscription_id", err: fmt.Errorf(`ent: validator failed for field "AlertEvent.subscription_id": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "AlertEvent.threshold"`)}
+	}
+	if _, ok := aec.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "AlertEvent.value"`)}
+	}
+	if _, ok := aec.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "AlertEvent.period_start"`)}
+	}
+	if _, ok := aec.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`ent: missing required field "AlertEvent.period_end"`)}
+	}
+	return nil
+}
+
+func (aec *AlertEventCreate) sqlSave(ctx context.Context) (*AlertEvent, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AlertEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AlertEventCreate) createSpec() (*AlertEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AlertEvent{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(alertevent.Table, sqlgraph.NewFieldSpec(alertevent.FieldID, field.TypeString))
+	)
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := aec.mutation.TenantID(); ok {
+		_spec.SetField(alertevent.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := aec.mutation.Status(); ok {
+		_spec.SetField(alertevent.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.SetField(alertevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := aec.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertevent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := aec.mutation.CreatedBy(); ok {
+		_spec.SetField(alertevent.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := aec.mutation.UpdatedBy(); ok {
+		_spec.SetField(alertevent.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := aec.mutation.EnvironmentID(); ok {
+		_spec.SetField(alertevent.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := aec.mutation.AlertRuleID(); ok {
+		_spec.SetField(alertevent.FieldAlertRuleID, field.TypeString, value)
+		_node.AlertRuleID = value
+	}
+	if value, ok := aec.mutation.AlertType(); ok {
+		_spec.SetField(alertevent.FieldAlertType, field.TypeString, value)
+		_node.AlertType = value
+	}
+	if value, ok := aec.mutation.CustomerID(); ok {
+		_spec.SetField(alertevent.FieldCustomerID, field.TypeString, value)
+		_node.CustomerID = value
+	}
+	if value, ok := aec.mutation.SubscriptionID(); ok {
+		_spec.SetField(alertevent.FieldSubscriptionID, field.TypeString, value)
+		_node.SubscriptionID = value
+	}
+	if value, ok := aec.mutation.FeatureID(); ok {
+		_spec.SetField(alertevent.FieldFeatureID, field.TypeString, value)
+		_node.FeatureID = &value
+	}
+	if value, ok := aec.mutation.Threshold(); ok {
+		_spec.SetField(alertevent.FieldThreshold, field.TypeOther, value)
+		_node.Threshold = value
+	}
+	if value, ok := aec.mutation.Value(); ok {
+		_spec.SetField(alertevent.FieldValue, field.TypeOther, value)
+		_node.Value = value
+	}
+	if value, ok := aec.mutation.Currency(); ok {
+		_spec.SetField(alertevent.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := aec.mutation.PeriodStart(); ok {
+		_spec.SetField(alertevent.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := aec.mutation.PeriodEnd(); ok {
+		_spec.SetField(alertevent.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	return _node, _spec
+}
+
+// AlertEventCreateBulk is the builder for creating many AlertEvent entities in bulk.
+type AlertEventCreateBulk struct {
+	config
+	err      error
+	builders []*AlertEventCreate
+}
+
+// Save creates the AlertEvent entities in the database.
+func (aecb *AlertEventCreateBulk) Save(ctx context.Context) ([]*AlertEvent, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AlertEvent, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AlertEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AlertEventCreateBulk) SaveX(ctx context.Context) []*AlertEvent {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AlertEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AlertEventCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/alertevent"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// AlertEventUpdate is the builder for updating AlertEvent entities.
+type AlertEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AlertEventMutation
+}
+
+// Where appends a list predicates to the AlertEventUpdate builder.
+func (aeu *AlertEventUpdate) Where(ps ...predicate.AlertEvent) *AlertEventUpdate {
+	aeu.mutation.Where(ps...)
+	return aeu
+}
+
+// SetStatus sets the "status" field.
+func (aeu *AlertEventUpdate) SetStatus(s string) *AlertEventUpdate {
+	aeu.mutation.SetStatus(s)
+	return aeu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aeu *AlertEventUpdate) SetNillableStatus(s *string) *AlertEventUpdate {
+	if s != nil {
+		aeu.SetStatus(*s)
+	}
+	return aeu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aeu *AlertEventUpdate) SetUpdatedAt(t time.Time) *AlertEventUpdate {
+	aeu.mutation.SetUpdatedAt(t)
+	return aeu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (aeu *AlertEventUpdate) SetUpdatedBy(s string) *AlertEventUpdate {
+	aeu.mutation.SetUpdatedBy(s)
+	return aeu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (aeu *AlertEventUpdate) SetNillableUpdatedBy(s *string) *AlertEventUpdate {
+	if s != nil {
+		aeu.SetUpdatedBy(*s)
+	}
+	return aeu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (aeu *AlertEventUpdate) ClearUpdatedBy() *AlertEventUpdate {
+	aeu.mutation.ClearUpdatedBy()
+	return aeu
+}
+
+// Mutation returns the AlertEventMutation object of the builder.
+func (aeu *AlertEventUpdate) Mutation() *AlertEventMutation {
+	return aeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *AlertEventUpdate) Save(ctx context.Context) (int, error) {
+	aeu.defaults()
+	return withHooks(ctx, aeu.sqlSave, aeu.mutation, aeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *AlertEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *AlertEventUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *AlertEventUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aeu *AlertEventUpdate) defaults() {
+	if _, ok := aeu.mutation.UpdatedAt(); !ok {
+		v := alertevent.UpdateDefaultUpdatedAt()
+		aeu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (aeu *AlertEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(alertevent.Table, alertevent.Columns, sqlgraph.NewFieldSpec(alertevent.FieldID, field.TypeString))
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeu.mutation.Status(); ok {
+		_spec.SetField(alertevent.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if aeu.mutation.CreatedByCleared() {
+		_spec.ClearField(alertevent.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := aeu.mutation.UpdatedBy(); ok {
+		_spec.SetField(alertevent.FieldUpdatedBy, field.TypeString, value)
+	}
+	if aeu.mutation.UpdatedByCleared() {
+		_spec.ClearField(alertevent.FieldUpdatedBy, field.TypeString)
+	}
+	if aeu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(alertevent.FieldEnvironmentID, field.TypeString)
+	}
+	if aeu.mutation.FeatureIDCleared() {
+		_spec.ClearField(alertevent.FieldFeatureID, field.TypeString)
+	}
+	if aeu.mutation.CurrencyCleared() {
+		_spec.ClearField(alertevent.FieldCurrency, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aeu.mutation.done = true
+	return n, nil
+}
+
+// AlertEventUpdateOne is the builder for updating a single AlertEvent entity.
+type AlertEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AlertEventMutation
+}
+
+// SetStatus sets the "status" field.
+func (aeuo *AlertEventUpdateOne) SetStatus(s string) *AlertEventUpdateOne {
+	aeuo.mutation.SetStatus(s)
+	return aeuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aeuo *AlertEventUpdateOne) SetNillableStatus(s *string) *AlertEventUpdateOne {
+	if s != nil {
+		aeuo.SetStatus(*s)
+	}
+	return aeuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aeuo *AlertEventUpdateOne) SetUpdatedAt(t time.Time) *AlertEventUpdateOne {
+	aeuo.mutation.SetUpdatedAt(t)
+	return aeuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (aeuo *AlertEventUpdateOne) SetUpdatedBy(s string) *AlertEventUpdateOne {
+	aeuo.mutation.SetUpdatedBy(s)
+	return aeuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (aeuo *AlertEventUpdateOne) SetNillableUpdatedBy(s *string) *AlertEventUpdateOne {
+	if s != nil {
+		aeuo.SetUpdatedBy(*s)
+	}
+	return aeuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (aeuo *AlertEventUpdateOne) ClearUpdatedBy() *AlertEventUpdateOne {
+	aeuo.mutation.ClearUpdatedBy()
+	return aeuo
+}
+
+// Mutation returns the AlertEventMutation object of the builder.
+func (aeuo *AlertEventUpdateOne) Mutation() *AlertEventMutation {
+	return aeuo.mutation
+}
+
+// Where appends a list predicates to the AlertEventUpdate builder.
+func (aeuo *AlertEventUpdateOne) Where(ps ...predicate.AlertEvent) *AlertEventUpdateOne {
+	aeuo.mutation.Where(ps...)
+	return aeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aeuo *AlertEventUpdateOne) Select(field string, fields ...string) *AlertEventUpdateOne {
+	aeuo.fields = append([]string{field}, fields...)
+	return aeuo
+}
+
+// Save executes the query and returns the updated AlertEvent entity.
+func (aeuo *AlertEventUpdateOne) Save(ctx context.Context) (*AlertEvent, error) {
+	aeuo.defaults()
+	return withHooks(ctx, aeuo.sqlSave, aeuo.mutation, aeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *AlertEventUpdateOne) SaveX(ctx context.Context) *AlertEvent {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *AlertEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *AlertEventUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aeuo *AlertEventUpdateOne) defaults() {
+	if _, ok := aeuo.mutation.UpdatedAt(); !ok {
+		v := alertevent.UpdateDefaultUpdatedAt()
+		aeuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (aeuo *AlertEventUpdateOne) sqlSave(ctx context.Context) (_node *AlertEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(alertevent.Table, alertevent.Columns, sqlgraph.NewFieldSpec(alertevent.FieldID, field.TypeString))
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AlertEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, alertevent.FieldID)
+		for _, f := range fields {
+			if !alertevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != alertevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeuo.mutation.Status(); ok {
+		_spec.SetField(alertevent.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if aeuo.mutation.CreatedByCleared() {
+		_spec.ClearField(alertevent.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := aeuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(alertevent.FieldUpdatedBy, field.TypeString, value)
+	}
+	if aeuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(alertevent.FieldUpdatedBy, field.TypeString)
+	}
+	if aeuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(alertevent.FieldEnvironmentID, field.TypeString)
+	}
+	if aeuo.mutation.FeatureIDCleared() {
+		_spec.ClearField(alertevent.FieldFeatureID, field.TypeString)
+	}
+	if aeuo.mutation.CurrencyCleared() {
+		_spec.ClearField(alertevent.FieldCurrency, field.TypeString)
+	}
+	_node = &AlertEvent{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aeuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/alertrule"
+	"github.com/shopspring/decimal"
+)
+
+// AlertRule is the model entity for the AlertRule schema.
+type AlertRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// AlertType holds the value of the "alert_type" field.
+	AlertType string `json:"alert_type,omitempty"`
+	// FeatureID holds the value of the "feature_id" field.
+	FeatureID *string `json:"feature_id,omitempty"`
+	// CustomerID holds the value of the "customer_id" field.
+	CustomerID *string `json:"customer_id,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Thresholds holds the value of the "thresholds" field.
+	Thresholds []decimal.Decimal `json:"thresholds,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AlertRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case alertrule.FieldThresholds, alertrule.FieldMetadata:
+			values[i] = new([]byte)
+		case alertrule.FieldID, alertrule.FieldTenantID, alertrule.FieldStatus, alertrule.FieldCreatedBy, alertrule.FieldUpdatedBy, alertrule.FieldEnvironmentID, alertrule.FieldName, alertrule.FieldAlertType, alertrule.FieldFeatureID, alertrule.FieldCustomerID, alertrule.FieldSubscriptionID, alertrule.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case alertrule.FieldCreatedAt, alertrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AlertRule fields.
+func (ar *AlertRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case alertrule.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ar.ID = value.String
+			}
+		case alertrule.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ar.TenantID = value.String
+			}
+		case alertrule.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ar.Status = value.String
+			}
+		case alertrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ar.CreatedAt = value.Time
+			}
+		case alertrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ar.UpdatedAt = value.Time
+			}
+		case alertrule.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ar.CreatedBy = value.String
+			}
+		case alertrule.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ar.UpdatedBy = value.String
+			}
+		case alertrule.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				ar.EnvironmentID = value.String
+			}
+		case alertrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ar.Name = value.String
+			}
+		case alertrule.FieldAlertType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_type", values[i])
+			} else if value.Valid {
+				ar.AlertType = value.String
+			}
+		case alertrule.FieldFeatureID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feature_id", values[i])
+			} else if value.Valid {
+				ar.FeatureID = new(string)
+				*ar.FeatureID = value.String
+			}
+		case alertrule.FieldCustomerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value.Valid {
+				ar.CustomerID = new(string)
+				*ar.CustomerID = value.String
+			}
+		case alertrule.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				ar.SubscriptionID = new(string)
+				*ar.SubscriptionID = value.String
+			}
+		case alertrule.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				ar.Currency = value.String
+			}
+		case alertrule.FieldThresholds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field thresholds", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ar.Thresholds); err != nil {
+					return fmt.Errorf("unmarshal field thresholds: %w", err)
+				}
+			}
+		case alertrule.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ar.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			ar.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AlertRule.
+// This includes values selected through modifiers, order, etc.
+func (ar *AlertRule) Value(name string) (ent.Value, error) {
+	return ar.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AlertRule.
+// Note that you need to call AlertRule.Unwrap() before calling this method if this AlertRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *AlertRule) Update() *AlertRuleUpdateOne {
+	return NewAlertRuleClient(ar.config).UpdateOne(ar)
+}
+
+// Unwrap unwraps the AlertRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *AlertRule) Unwrap() *AlertRule {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AlertRule is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *AlertRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("AlertRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(ar.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(ar.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ar.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ar.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ar.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ar.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(ar.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ar.Name)
+	builder.WriteString(", ")
+	builder.WriteString("alert_type=")
+	builder.WriteString(ar.AlertType)
+	builder.WriteString(", ")
+	if v := ar.FeatureID; v != nil {
+		builder.WriteString("feature_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ar.CustomerID; v != nil {
+		builder.WriteString("customer_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ar.SubscriptionID; v != nil {
+		builder.WriteString("subscription_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(ar.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("thresholds=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Thresholds))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AlertRules is a parsable slice of AlertRule.
+type AlertRules []*AlertRule
@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/alertrule"
+	"github.com/shopspring/decimal"
+)
+
+// AlertRuleCreate is the builder for creating a AlertRule entity.
+type AlertRuleCreate struct {
+	config
+	mutation *AlertRuleMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (arc *AlertRuleCreate) SetTenantID(s string) *AlertRuleCreate {
+	arc.mutation.SetTenantID(s)
+	return arc
+}
+
+// SetStatus sets the "status" field.
+func (arc *AlertRuleCreate) SetStatus(s string) *AlertRuleCreate {
+	arc.mutation.SetStatus(s)
+	return arc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableStatus(s *string) *AlertRuleCreate {
+	if s != nil {
+		arc.SetStatus(*s)
+	}
+	return arc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (arc *AlertRuleCreate) SetCreatedAt(t time.Time) *AlertRuleCreate {
+	arc.mutation.SetCreatedAt(t)
+	return arc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableCreatedAt(t *time.Time) *AlertRuleCreate {
+	if t != nil {
+		arc.SetCreatedAt(*t)
+	}
+	return arc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (arc *AlertRuleCreate) SetUpdatedAt(t time.Time) *AlertRuleCreate {
+	arc.mutation.SetUpdatedAt(t)
+	return arc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableUpdatedAt(t *time.Time) *AlertRuleCreate {
+	if t != nil {
+		arc.SetUpdatedAt(*t)
+	}
+	return arc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (arc *AlertRuleCreate) SetCreatedBy(s string) *AlertRuleCreate {
+	arc.mutation.SetCreatedBy(s)
+	return arc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableCreatedBy(s *string) *AlertRuleCreate {
+	if s != nil {
+		arc.SetCreatedBy(*s)
+	}
+	return arc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (arc *AlertRuleCreate) SetUpdatedBy(s string) *AlertRuleCreate {
+	arc.mutation.SetUpdatedBy(s)
+	return arc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableUpdatedBy(s *string) *AlertRuleCreate {
+	if s != nil {
+		arc.SetUpdatedBy(*s)
+	}
+	return arc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (arc *AlertRuleCreate) SetEnvironmentID(s string) *AlertRuleCreate {
+	arc.mutation.SetEnvironmentID(s)
+	return arc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableEnvironmentID(s *string) *AlertRuleCreate {
+	if s != nil {
+		arc.SetEnvironmentID(*s)
+	}
+	return arc
+}
+
+// SetName sets the "name" field.
+func (arc *AlertRuleCreate) SetName(s string) *AlertRuleCreate {
+	arc.mutation.SetName(s)
+	return arc
+}
+
+// SetAlertType sets the "alert_type" field.
+func (arc *AlertRuleCreate) SetAlertType(s string) *AlertRuleCreate {
+	arc.mutation.SetAlertType(s)
+	return arc
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (arc *AlertRuleCreate) SetFeatureID(s string) *AlertRuleCreate {
+	arc.mutation.SetFeatureID(s)
+	return arc
+}
+
+// SetNillableFeatureID sets the "feature_id" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableFeatureID(s *string) *AlertRuleCreate {
+	if s != nil {
+		arc.SetFeatureID(*s)
+	}
+	return arc
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (arc *AlertRuleCreate) SetCustomerID(s string) *AlertRuleCreate {
+	arc.mutation.SetCustomerID(s)
+	return arc
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableCustomerID(s *string) *AlertRuleCreate {
+	if s != nil {
+		arc.SetCustomerID(*s)
+	}
+	return arc
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (arc *AlertRuleCreate) SetSubscriptionID(s string) *AlertRuleCreate {
+	arc.mutation.SetSubscriptionID(s)
+	return arc
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableSubscriptionID(s *string) *AlertRuleCreate {
+	if s != nil {
+		arc.SetSubscriptionID(*s)
+	}
+	return arc
+}
+
+// SetCurrency sets the "currency" field.
+func (arc *AlertRuleCreate) SetCurrency(s string) *AlertRuleCreate {
+	arc.mutation.SetCurrency(s)
+	return arc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableCurrency(s *string) *AlertRuleCreate {
+	if s != nil {
+		arc.SetCurrency(*s)
+	}
+	return arc
+}
+
+// SetThresholds sets the "thresholds" field.
+func (arc *AlertRuleCreate) SetThresholds(d []decimal.Decimal) *AlertRuleCreate {
+	arc.mutation.SetThresholds(d)
+	return arc
+}
+
+// SetMetadata sets the "metadata" field.
+func (arc *AlertRuleCreate) SetMetadata(m map[string]string) *AlertRuleCreate {
+	arc.mutation.SetMetadata(m)
+	return arc
+}
+
+// SetID sets the "id" field.
+func (arc *AlertRuleCreate) SetID(s string) *AlertRuleCreate {
+	arc.mutation.SetID(s)
+	return arc
+}
+
+// Mutation returns the AlertRuleMutation object of the builder.
+func (arc *AlertRuleCreate) Mutation() *AlertRuleMutation {
+	return arc.mutation
+}
+
+// Save creates the AlertRule in the database.
+func (arc *AlertRuleCreate) Save(ctx context.Context) (*AlertRule, error) {
+	arc.defaults()
+	return withHooks(ctx, arc.sqlSave, arc.mutation, arc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *AlertRuleCreate) SaveX(ctx context.Context) *AlertRule {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *AlertRuleCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *AlertRuleCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (arc *AlertRuleCreate) defaults() {
+	if _, ok := arc.mutation.Status(); !ok {
+		v := alertrule.DefaultStatus
+		arc.mutation.SetStatus(v)
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		v := alertrule.DefaultCreatedAt()
+		arc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := arc.mutation.UpdatedAt(); !ok {
+		v := alertrule.DefaultUpdatedAt()
+		arc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := arc.mutation.EnvironmentID(); !ok {
+		v := alertrule.DefaultEnvironmentID
+		arc.mutation.SetEnvironmentID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *AlertRuleCreate) check() error {
+	if _, ok := arc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "AlertRule.tenant_id"`)}
+	}
+	if v, ok := arc.mutation.TenantID(); ok {
+		if err := alertrule.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "AlertRule.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AlertRule.status"`)}
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AlertRule.created_at"`)}
+	}
+	if _, ok := arc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AlertRule.updated_at"`)}
+	}
+	if _, ok := arc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AlertRule.name"`)}
+	}
+	if v, ok := arc.mutation.Name(); ok {
+		if err := alertrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AlertRule.name": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.AlertType(); !ok {
+		return &ValidationError{Name: "alert_type", err: errors.New(`ent: missing required field "AlertRule.alert_type"`)}
+	}
+	if v, ok := arc.mutation.AlertType(); ok {
+		if err := alertrule.AlertTypeValidator(v); err != nil {
+			return &ValidationError{Name: "alert_type", err: fmt.Errorf(`ent: validator failed for field "AlertRule.alert_type": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.Thresholds(); !ok {
+		return &ValidationError{Name: "thresholds", err: errors.New(`ent: missing required field "AlertRule.thresholds"`)}
+	}
+	return nil
+}
+
+func (arc *AlertRuleCreate) sqlSave(ctx context.Context) (*AlertRule, error) {
+	if err := arc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AlertRule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	arc.mutation.id = &_node.ID
+	arc.mutation.done = true
+	return _node, nil
+}
+
+func (arc *AlertRuleCreate) createSpec() (*AlertRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AlertRule{config: arc.config}
+		_spec = sqlgraph.NewCreateSpec(alertrule.Table, sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeString))
+	)
+	if id, ok := arc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := arc.mutation.TenantID(); ok {
+		_spec.SetField(alertrule.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := arc.mutation.Status(); ok {
+		_spec.SetField(alertrule.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := arc.mutation.CreatedAt(); ok {
+		_spec.SetField(alertrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := arc.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := arc.mutation.CreatedBy(); ok {
+		_spec.SetField(alertrule.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := arc.mutation.UpdatedBy(); ok {
+		_spec.SetField(alertrule.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := arc.mutation.EnvironmentID(); ok {
+		_spec.SetField(alertrule.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := arc.mutation.Name(); ok {
+		_spec.SetField(alertrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := arc.mutation.AlertType(); ok {
+		_spec.SetField(alertrule.FieldAlertType, field.TypeString, value)
+		_node.AlertType = value
+	}
+	if value, ok := arc.mutation.FeatureID(); ok {
+		_spec.SetField(alertrule.FieldFeatureID, field.TypeString, value)
+		_node.FeatureID = &value
+	}
+	if value, ok := arc.mutation.CustomerID(); ok {
+		_spec.SetField(alertrule.FieldCustomerID, field.TypeString, value)
+		_node.CustomerID = &value
+	}
+	if value, ok := arc.mutation.SubscriptionID(); ok {
+		_spec.SetField(alertrule.FieldSubscriptionID, field.TypeString, value)
+		_node.SubscriptionID = &value
+	}
+	if value, ok := arc.mutation.Currency(); ok {
+		_spec.SetField(alertrule.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := arc.mutation.Thresholds(); ok {
+		_spec.SetField(alertrule.FieldThresholds, field.TypeJSON, value)
+		_node.Thresholds = value
+	}
+	if value, ok := arc.mutation.Metadata(); ok {
+		_spec.SetField(alertrule.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	return _node, _spec
+}
+
+// AlertRuleCreateBulk is the builder for creating many AlertRule entities in bulk.
+type AlertRuleCreateBulk struct {
+	config
+	err      error
+	builders []*AlertRuleCreate
+}
+
+// Save creates the AlertRule entities in the database.
+func (arcb *AlertRuleCreateBulk) Save(ctx context.Context) ([]*AlertRule, error) {
+	if arcb.err != nil {
+		return nil, arcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*AlertRule, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AlertRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *AlertRuleCreateBulk) SaveX(ctx context.Context) []*AlertRule {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *AlertRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *AlertRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
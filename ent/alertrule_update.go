@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/alertrule"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// AlertRuleUpdate is the builder for updating AlertRule entities.
+type AlertRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AlertRuleMutation
+}
+
+// Where appends a list predicates to the AlertRuleUpdate builder.
+func (aru *AlertRuleUpdate) Where(ps ...predicate.AlertRule) *AlertRuleUpdate {
+	aru.mutation.Where(ps...)
+	return aru
+}
+
+// SetStatus sets the "status" field.
+func (aru *AlertRuleUpdate) SetStatus(s string) *AlertRuleUpdate {
+	aru.mutation.SetStatus(s)
+	return aru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableStatus(s *string) *AlertRuleUpdate {
+	if s != nil {
+		aru.SetStatus(*s)
+	}
+	return aru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aru *AlertRuleUpdate) SetUpdatedAt(t time.Time) *AlertRuleUpdate {
+	aru.mutation.SetUpdatedAt(t)
+	return aru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (aru *AlertRuleUpdate) SetUpdatedBy(s string) *AlertRuleUpdate {
+	aru.mutation.SetUpdatedBy(s)
+	return aru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableUpdatedBy(s *string) *AlertRuleUpdate {
+	if s != nil {
+		aru.SetUpdatedBy(*s)
+	}
+	return aru
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (aru *AlertRuleUpdate) ClearUpdatedBy() *AlertRuleUpdate {
+	aru.mutation.ClearUpdatedBy()
+	return aru
+}
+
+// SetName sets the "name" field.
+func (aru *AlertRuleUpdate) SetName(s string) *AlertRuleUpdate {
+	aru.mutation.SetName(s)
+	return aru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableName(s *string) *AlertRuleUpdate {
+	if s != nil {
+		aru.SetName(*s)
+	}
+	return aru
+}
+
+// SetThresholds sets the "thresholds" field.
+func (aru *AlertRuleUpdate) SetThresholds(d []decimal.Decimal) *AlertRuleUpdate {
+	aru.mutation.SetThresholds(d)
+	return aru
+}
+
+// AppendThresholds appends d to the "thresholds" field.
+func (aru *AlertRuleUpdate) AppendThresholds(d []decimal.Decimal) *AlertRuleUpdate {
+	aru.mutation.AppendThresholds(d)
+	return aru
+}
+
+// SetMetadata sets the "metadata" field.
+func (aru *AlertRuleUpdate) SetMetadata(m map[string]string) *AlertRuleUpdate {
+	aru.mutation.SetMetadata(m)
+	return aru
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (aru *AlertRuleUpdate) ClearMetadata() *AlertRuleUpdate {
+	aru.mutation.ClearMetadata()
+	return aru
+}
+
+// Mutation returns the AlertRuleMutation object of the builder.
+func (aru *AlertRuleUpdate) Mutation() *AlertRuleMutation {
+	return aru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aru *AlertRuleUpdate) Save(ctx context.Context) (int, error) {
+	aru.defaults()
+	return withHooks(ctx, aru.sqlSave, aru.mutation, aru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aru *AlertRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := aru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aru *AlertRuleUpdate) Exec(ctx context.Context) error {
+	_, err := aru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aru *AlertRuleUpdate) ExecX(ctx context.Context) {
+	if err := aru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aru *AlertRuleUpdate) defaults() {
+	if _, ok := aru.mutation.UpdatedAt(); !ok {
+		v := alertrule.UpdateDefaultUpdatedAt()
+		aru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aru *AlertRuleUpdate) check() error {
+	if v, ok := aru.mutation.Name(); ok {
+		if err := alertrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AlertRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aru *AlertRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(alertrule.Table, alertrule.Columns, sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeString))
+	if ps := aru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aru.mutation.Status(); ok {
+		_spec.SetField(alertrule.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := aru.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if aru.mutation.CreatedByCleared() {
+		_spec.ClearField(alertrule.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := aru.mutation.UpdatedBy(); ok {
+		_spec.SetField(alertrule.FieldUpdatedBy, field.TypeString, value)
+	}
+	if aru.mutation.UpdatedByCleared() {
+		_spec.ClearField(alertrule.FieldUpdatedBy, field.TypeString)
+	}
+	if aru.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(alertrule.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := aru.mutation.Name(); ok {
+		_spec.SetField(alertrule.FieldName, field.TypeString, value)
+	}
+	if aru.mutation.FeatureIDCleared() {
+		_spec.ClearField(alertrule.FieldFeatureID, field.TypeString)
+	}
+	if aru.mutation.CustomerIDCleared() {
+		_spec.ClearField(alertrule.FieldCustomerID, field.TypeString)
+	}
+	if aru.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(alertrule.FieldSubscriptionID, field.TypeString)
+	}
+	if aru.mutation.CurrencyCleared() {
+		_spec.ClearField(alertrule.FieldCurrency, field.TypeString)
+	}
+	if value, ok := aru.mutation.Thresholds(); ok {
+		_spec.SetField(alertrule.FieldThresholds, field.TypeJSON, value)
+	}
+	if value, ok := aru.mutation.AppendedThresholds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, alertrule.FieldThresholds, value)
+		})
+	}
+	if value, ok := aru.mutation.Metadata(); ok {
+		_spec.SetField(alertrule.FieldMetadata, field.TypeJSON, value)
+	}
+	if aru.mutation.MetadataCleared() {
+		_spec.ClearField(alertrule.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aru.mutation.done = true
+	return n, nil
+}
+
+// AlertRuleUpdateOne is the builder for updating a single AlertRule entity.
+type AlertRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AlertRuleMutation
+}
+
+// SetStatus sets the "status" field.
+func (aruo *AlertRuleUpdateOne) SetStatus(s string) *AlertRuleUpdateOne {
+	aruo.mutation.SetStatus(s)
+	return aruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableStatus(s *string) *AlertRuleUpdateOne {
+	if s != nil {
+		aruo.SetStatus(*s)
+	}
+	return aruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aruo *AlertRuleUpdateOne) SetUpdatedAt(t time.Time) *AlertRuleUpdateOne {
+	aruo.mutation.SetUpdatedAt(t)
+	return aruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (aruo *AlertRuleUpdateOne) SetUpdatedBy(s string) *AlertRuleUpdateOne {
+	aruo.mutation.SetUpdatedBy(s)
+	return aruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableUpdatedBy(s *string) *AlertRuleUpdateOne {
+	if s != nil {
+		aruo.SetUpdatedBy(*s)
+	}
+	return aruo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (aruo *AlertRuleUpdateOne) ClearUpdatedBy() *AlertRuleUpdateOne {
+	aruo.mutation.ClearUpdatedBy()
+	return aruo
+}
+
+// SetName sets the "name" field.
+func (aruo *AlertRuleUpdateOne) SetName(s string) *AlertRuleUpdateOne {
+	aruo.mutation.SetName(s)
+	return aruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableName(s *string) *AlertRuleUpdateOne {
+	if s != nil {
+		aruo.SetName(*s)
+	}
+	return aruo
+}
+
+// SetThresholds sets the "thresholds" field.
+func (aruo *AlertRuleUpdateOne) SetThresholds(d []decimal.Decimal) *AlertRuleUpdateOne {
+	aruo.mutation.SetThresholds(d)
+	return aruo
+}
+
+// AppendThresholds appends d to the "thresholds" field.
+func (aruo *AlertRuleUpdateOne) AppendThresholds(d []decimal.Decimal) *AlertRuleUpdateOne {
+	aruo.mutation.AppendThresholds(d)
+	return aruo
+}
+
+// SetMetadata sets the "metadata" field.
+func (aruo *AlertRuleUpdateOne) SetMetadata(m map[string]string) *AlertRuleUpdateOne {
+	aruo.mutation.SetMetadata(m)
+	return aruo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (aruo *AlertRuleUpdateOne) ClearMetadata() *AlertRuleUpdateOne {
+	aruo.mutation.ClearMetadata()
+	return aruo
+}
+
+// Mutation returns the AlertRuleMutation object of the builder.
+func (aruo *AlertRuleUpdateOne) Mutation() *AlertRuleMutation {
+	return aruo.mutation
+}
+
+// Where appends a list predicates to the AlertRuleUpdate builder.
+func (aruo *AlertRuleUpdateOne) Where(ps ...predicate.AlertRule) *AlertRuleUpdateOne {
+	aruo.mutation.Where(ps...)
+	return aruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aruo *AlertRuleUpdateOne) Select(field string, fields ...string) *AlertRuleUpdateOne {
+	aruo.fields = append([]string{field}, fields...)
+	return aruo
+}
+
+// Save executes the query and returns the updated AlertRule entity.
+func (aruo *AlertRuleUpdateOne) Save(ctx context.Context) (*AlertRule, error) {
+	aruo.defaults()
+	return withHooks(ctx, aruo.sqlSave, aruo.mutation, aruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aruo *AlertRuleUpdateOne) SaveX(ctx context.Context) *AlertRule {
+	node, err := aruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aruo *AlertRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := aruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aruo *AlertRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := aruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aruo *AlertRuleUpdateOne) defaults() {
+	if _, ok := aruo.mutation.UpdatedAt(); !ok {
+		v := alertrule.UpdateDefaultUpdatedAt()
+		aruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aruo *AlertRuleUpdateOne) check() error {
+	if v, ok := aruo.mutation.Name(); ok {
+		if err := alertrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AlertRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aruo *AlertRuleUpdateOne) sqlSave(ctx context.Context) (_node *AlertRule, err error) {
+	if err := aruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(alertrule.Table, alertrule.Columns, sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeString))
+	id, ok := aruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AlertRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, alertrule.FieldID)
+		for _, f := range fields {
+			if !alertrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != alertrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aruo.mutation.Status(); ok {
+		_spec.SetField(alertrule.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := aruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if aruo.mutation.CreatedByCleared() {
+		_spec.ClearField(alertrule.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := aruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(alertrule.FieldUpdatedBy, field.TypeString, value)
+	}
+	if aruo.mutation.UpdatedByCleared() {
+		_spec.ClearField(alertrule.FieldUpdatedBy, field.TypeString)
+	}
+	if aruo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(alertrule.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := aruo.mutation.Name(); ok {
+		_spec.SetField(alertrule.FieldName, field.TypeString, value)
+	}
+	if aruo.mutation.FeatureIDCleared() {
+		_spec.ClearField(alertrule.FieldFeatureID, field.TypeString)
+	}
+	if aruo.mutation.CustomerIDCleared() {
+		_spec.ClearField(alertrule.FieldCustomerID, field.TypeString)
+	}
+	if aruo.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(alertrule.FieldSubscriptionID, field.TypeString)
+	}
+	if aruo.mutation.CurrencyCleared() {
+		_spec.ClearField(alertrule.FieldCurrency, field.TypeString)
+	}
+	if value, ok := aruo.mutation.Thresholds(); ok {
+		_spec.SetField(alertrule.FieldThresholds, field.TypeJSON, value)
+	}
+	if value, ok := aruo.mutation.AppendedThresholds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, alertrule.FieldThresholds, value)
+		})
+	}
+	if value, ok := aruo.mutation.Metadata(); ok {
+		_spec.SetField(alertrule.FieldMetadata, field.TypeJSON, value)
+	}
+	if aruo.mutation.MetadataCleared() {
+		_spec.ClearField(alertrule.FieldMetadata, field.TypeJSON)
+	}
+	_node = &AlertRule{config: aruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aruo.mutation.done = true
+	return _node, nil
+}
@@ -15,9 +15,17 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/flexprice/flexprice/ent/alertevent"
+	"github.com/flexprice/flexprice/ent/alertrule"
 	"github.com/flexprice/flexprice/ent/auth"
 	"github.com/flexprice/flexprice/ent/billingsequence"
+	"github.com/flexprice/flexprice/ent/coupon"
+	"github.com/flexprice/flexprice/ent/couponapplication"
+	"github.com/flexprice/flexprice/ent/creditnote"
+	"github.com/flexprice/flexprice/ent/creditnotelineitem"
+	"github.com/flexprice/flexprice/ent/creditnotesequence"
 	"github.com/flexprice/flexprice/ent/customer"
+	"github.com/flexprice/flexprice/ent/dunningattempt"
 	"github.com/flexprice/flexprice/ent/entitlement"
 	"github.com/flexprice/flexprice/ent/environment"
 	"github.com/flexprice/flexprice/ent/feature"
@@ -25,6 +33,7 @@ import (
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
 	"github.com/flexprice/flexprice/ent/invoicesequence"
 	"github.com/flexprice/flexprice/ent/meter"
+	"github.com/flexprice/flexprice/ent/outboxevent"
 	"github.com/flexprice/flexprice/ent/payment"
 	"github.com/flexprice/flexprice/ent/paymentattempt"
 	"github.com/flexprice/flexprice/ent/plan"
@@ -33,11 +42,15 @@ import (
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
 	"github.com/flexprice/flexprice/ent/task"
+	"github.com/flexprice/flexprice/ent/taxrate"
 	"github.com/flexprice/flexprice/ent/tenant"
 	"github.com/flexprice/flexprice/ent/user"
 	"github.com/flexprice/flexprice/ent/wallet"
 	"github.com/flexprice/flexprice/ent/wallettransaction"
+	"github.com/flexprice/flexprice/ent/webhookdelivery"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
 
 	stdsql "database/sql"
 )
@@ -47,12 +60,28 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AlertEvent is the client for interacting with the AlertEvent builders.
+	AlertEvent *AlertEventClient
+	// AlertRule is the client for interacting with the AlertRule builders.
+	AlertRule *AlertRuleClient
 	// Auth is the client for interacting with the Auth builders.
 	Auth *AuthClient
 	// BillingSequence is the client for interacting with the BillingSequence builders.
 	BillingSequence *BillingSequenceClient
+	// Coupon is the client for interacting with the Coupon builders.
+	Coupon *CouponClient
+	// CouponApplication is the client for interacting with the CouponApplication builders.
+	CouponApplication *CouponApplicationClient
+	// CreditNote is the client for interacting with the CreditNote builders.
+	CreditNote *CreditNoteClient
+	// CreditNoteLineItem is the client for interacting with the CreditNoteLineItem builders.
+	CreditNoteLineItem *CreditNoteLineItemClient
+	// CreditNoteSequence is the client for interacting with the CreditNoteSequence builders.
+	CreditNoteSequence *CreditNoteSequenceClient
 	// Customer is the client for interacting with the Customer builders.
 	Customer *CustomerClient
+	// DunningAttempt is the client for interacting with the DunningAttempt builders.
+	DunningAttempt *DunningAttemptClient
 	// Entitlement is the client for interacting with the Entitlement builders.
 	Entitlement *EntitlementClient
 	// Environment is the client for interacting with the Environment builders.
@@ -67,6 +96,8 @@ type Client struct {
 	InvoiceSequence *InvoiceSequenceClient
 	// Meter is the client for interacting with the Meter builders.
 	Meter *MeterClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// Payment is the client for interacting with the Payment builders.
 	Payment *PaymentClient
 	// PaymentAttempt is the client for interacting with the PaymentAttempt builders.
@@ -83,8 +114,12 @@ type Client struct {
 	SubscriptionLineItem *SubscriptionLineItemClient
 	// SubscriptionPause is the client for interacting with the SubscriptionPause builders.
 	SubscriptionPause *SubscriptionPauseClient
+	// SubscriptionQuantityChange is the client for interacting with the SubscriptionQuantityChange builders.
+	SubscriptionQuantityChange *SubscriptionQuantityChangeClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaxRate is the client for interacting with the TaxRate builders.
+	TaxRate *TaxRateClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
 	// User is the client for interacting with the User builders.
@@ -93,6 +128,10 @@ type Client struct {
 	Wallet *WalletClient
 	// WalletTransaction is the client for interacting with the WalletTransaction builders.
 	WalletTransaction *WalletTransactionClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -104,9 +143,17 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AlertEvent = NewAlertEventClient(c.config)
+	c.AlertRule = NewAlertRuleClient(c.config)
 	c.Auth = NewAuthClient(c.config)
 	c.BillingSequence = NewBillingSequenceClient(c.config)
+	c.Coupon = NewCouponClient(c.config)
+	c.CouponApplication = NewCouponApplicationClient(c.config)
+	c.CreditNote = NewCreditNoteClient(c.config)
+	c.CreditNoteLineItem = NewCreditNoteLineItemClient(c.config)
+	c.CreditNoteSequence = NewCreditNoteSequenceClient(c.config)
 	c.Customer = NewCustomerClient(c.config)
+	c.DunningAttempt = NewDunningAttemptClient(c.config)
 	c.Entitlement = NewEntitlementClient(c.config)
 	c.Environment = NewEnvironmentClient(c.config)
 	c.Feature = NewFeatureClient(c.config)
@@ -114,6 +161,7 @@ func (c *Client) init() {
 	c.InvoiceLineItem = NewInvoiceLineItemClient(c.config)
 	c.InvoiceSequence = NewInvoiceSequenceClient(c.config)
 	c.Meter = NewMeterClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
 	c.Payment = NewPaymentClient(c.config)
 	c.PaymentAttempt = NewPaymentAttemptClient(c.config)
 	c.Plan = NewPlanClient(c.config)
@@ -122,11 +170,15 @@ func (c *Client) init() {
 	c.Subscription = NewSubscriptionClient(c.config)
 	c.SubscriptionLineItem = NewSubscriptionLineItemClient(c.config)
 	c.SubscriptionPause = NewSubscriptionPauseClient(c.config)
+	c.SubscriptionQuantityChange = NewSubscriptionQuantityChangeClient(c.config)
 	c.Task = NewTaskClient(c.config)
+	c.TaxRate = NewTaxRateClient(c.config)
 	c.Tenant = NewTenantClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.Wallet = NewWalletClient(c.config)
 	c.WalletTransaction = NewWalletTransactionClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookEndpoint = NewWebhookEndpointClient(c.config)
 }
 
 type (
@@ -217,31 +269,44 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                  ctx,
-		config:               cfg,
-		Auth:                 NewAuthClient(cfg),
-		BillingSequence:      NewBillingSequenceClient(cfg),
-		Customer:             NewCustomerClient(cfg),
-		Entitlement:          NewEntitlementClient(cfg),
-		Environment:          NewEnvironmentClient(cfg),
-		Feature:              NewFeatureClient(cfg),
-		Invoice:              NewInvoiceClient(cfg),
-		InvoiceLineItem:      NewInvoiceLineItemClient(cfg),
-		InvoiceSequence:      NewInvoiceSequenceClient(cfg),
-		Meter:                NewMeterClient(cfg),
-		Payment:              NewPaymentClient(cfg),
-		PaymentAttempt:       NewPaymentAttemptClient(cfg),
-		Plan:                 NewPlanClient(cfg),
-		Price:                NewPriceClient(cfg),
-		Secret:               NewSecretClient(cfg),
-		Subscription:         NewSubscriptionClient(cfg),
-		SubscriptionLineItem: NewSubscriptionLineItemClient(cfg),
-		SubscriptionPause:    NewSubscriptionPauseClient(cfg),
-		Task:                 NewTaskClient(cfg),
-		Tenant:               NewTenantClient(cfg),
-		User:                 NewUserClient(cfg),
-		Wallet:               NewWalletClient(cfg),
-		WalletTransaction:    NewWalletTransactionClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		AlertEvent:                 NewAlertEventClient(cfg),
+		AlertRule:                  NewAlertRuleClient(cfg),
+		Auth:                       NewAuthClient(cfg),
+		BillingSequence:            NewBillingSequenceClient(cfg),
+		Coupon:                     NewCouponClient(cfg),
+		CouponApplication:          NewCouponApplicationClient(cfg),
+		CreditNote:                 NewCreditNoteClient(cfg),
+		CreditNoteLineItem:         NewCreditNoteLineItemClient(cfg),
+		CreditNoteSequence:         NewCreditNoteSequenceClient(cfg),
+		Customer:                   NewCustomerClient(cfg),
+		DunningAttempt:             NewDunningAttemptClient(cfg),
+		Entitlement:                NewEntitlementClient(cfg),
+		Environment:                NewEnvironmentClient(cfg),
+		Feature:                    NewFeatureClient(cfg),
+		Invoice:                    NewInvoiceClient(cfg),
+		InvoiceLineItem:            NewInvoiceLineItemClient(cfg),
+		InvoiceSequence:            NewInvoiceSequenceClient(cfg),
+		Meter:                      NewMeterClient(cfg),
+		OutboxEvent:                NewOutboxEventClient(cfg),
+		Payment:                    NewPaymentClient(cfg),
+		PaymentAttempt:             NewPaymentAttemptClient(cfg),
+		Plan:                       NewPlanClient(cfg),
+		Price:                      NewPriceClient(cfg),
+		Secret:                     NewSecretClient(cfg),
+		Subscription:               NewSubscriptionClient(cfg),
+		SubscriptionLineItem:       NewSubscriptionLineItemClient(cfg),
+		SubscriptionPause:          NewSubscriptionPauseClient(cfg),
+		SubscriptionQuantityChange: NewSubscriptionQuantityChangeClient(cfg),
+		Task:                       NewTaskClient(cfg),
+		TaxRate:                    NewTaxRateClient(cfg),
+		Tenant:                     NewTenantClient(cfg),
+		User:                       NewUserClient(cfg),
+		Wallet:                     NewWalletClient(cfg),
+		WalletTransaction:          NewWalletTransactionClient(cfg),
+		WebhookDelivery:            NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:            NewWebhookEndpointClient(cfg),
 	}, nil
 }
 
@@ -259,38 +324,51 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                  ctx,
-		config:               cfg,
-		Auth:                 NewAuthClient(cfg),
-		BillingSequence:      NewBillingSequenceClient(cfg),
-		Customer:             NewCustomerClient(cfg),
-		Entitlement:          NewEntitlementClient(cfg),
-		Environment:          NewEnvironmentClient(cfg),
-		Feature:              NewFeatureClient(cfg),
-		Invoice:              NewInvoiceClient(cfg),
-		InvoiceLineItem:      NewInvoiceLineItemClient(cfg),
-		InvoiceSequence:      NewInvoiceSequenceClient(cfg),
-		Meter:                NewMeterClient(cfg),
-		Payment:              NewPaymentClient(cfg),
-		PaymentAttempt:       NewPaymentAttemptClient(cfg),
-		Plan:                 NewPlanClient(cfg),
-		Price:                NewPriceClient(cfg),
-		Secret:               NewSecretClient(cfg),
-		Subscription:         NewSubscriptionClient(cfg),
-		SubscriptionLineItem: NewSubscriptionLineItemClient(cfg),
-		SubscriptionPause:    NewSubscriptionPauseClient(cfg),
-		Task:                 NewTaskClient(cfg),
-		Tenant:               NewTenantClient(cfg),
-		User:                 NewUserClient(cfg),
-		Wallet:               NewWalletClient(cfg),
-		WalletTransaction:    NewWalletTransactionClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		AlertEvent:                 NewAlertEventClient(cfg),
+		AlertRule:                  NewAlertRuleClient(cfg),
+		Auth:                       NewAuthClient(cfg),
+		BillingSequence:            NewBillingSequenceClient(cfg),
+		Coupon:                     NewCouponClient(cfg),
+		CouponApplication:          NewCouponApplicationClient(cfg),
+		CreditNote:                 NewCreditNoteClient(cfg),
+		CreditNoteLineItem:         NewCreditNoteLineItemClient(cfg),
+		CreditNoteSequence:         NewCreditNoteSequenceClient(cfg),
+		Customer:                   NewCustomerClient(cfg),
+		DunningAttempt:             NewDunningAttemptClient(cfg),
+		Entitlement:                NewEntitlementClient(cfg),
+		Environment:                NewEnvironmentClient(cfg),
+		Feature:                    NewFeatureClient(cfg),
+		Invoice:                    NewInvoiceClient(cfg),
+		InvoiceLineItem:            NewInvoiceLineItemClient(cfg),
+		InvoiceSequence:            NewInvoiceSequenceClient(cfg),
+		Meter:                      NewMeterClient(cfg),
+		OutboxEvent:                NewOutboxEventClient(cfg),
+		Payment:                    NewPaymentClient(cfg),
+		PaymentAttempt:             NewPaymentAttemptClient(cfg),
+		Plan:                       NewPlanClient(cfg),
+		Price:                      NewPriceClient(cfg),
+		Secret:                     NewSecretClient(cfg),
+		Subscription:               NewSubscriptionClient(cfg),
+		SubscriptionLineItem:       NewSubscriptionLineItemClient(cfg),
+		SubscriptionPause:          NewSubscriptionPauseClient(cfg),
+		SubscriptionQuantityChange: NewSubscriptionQuantityChangeClient(cfg),
+		Task:                       NewTaskClient(cfg),
+		TaxRate:                    NewTaxRateClient(cfg),
+		Tenant:                     NewTenantClient(cfg),
+		User:                       NewUserClient(cfg),
+		Wallet:                     NewWalletClient(cfg),
+		WalletTransaction:          NewWalletTransactionClient(cfg),
+		WebhookDelivery:            NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:            NewWebhookEndpointClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Auth.
+//		AlertEvent.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -313,11 +391,14 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Auth, c.BillingSequence, c.Customer, c.Entitlement, c.Environment, c.Feature,
-		c.Invoice, c.InvoiceLineItem, c.InvoiceSequence, c.Meter, c.Payment,
-		c.PaymentAttempt, c.Plan, c.Price, c.Secret, c.Subscription,
-		c.SubscriptionLineItem, c.SubscriptionPause, c.Task, c.Tenant, c.User,
-		c.Wallet, c.WalletTransaction,
+		c.AlertEvent, c.AlertRule, c.Auth, c.BillingSequence, c.Coupon,
+		c.CouponApplication, c.CreditNote, c.CreditNoteLineItem, c.CreditNoteSequence,
+		c.Customer, c.DunningAttempt, c.Entitlement, c.Environment, c.Feature,
+		c.Invoice, c.InvoiceLineItem, c.InvoiceSequence, c.Meter, c.OutboxEvent,
+		c.Payment, c.PaymentAttempt, c.Plan, c.Price, c.Secret, c.Subscription,
+		c.SubscriptionLineItem, c.SubscriptionPause, c.SubscriptionQuantityChange,
+		c.Task, c.TaxRate, c.Tenant, c.User, c.Wallet, c.WalletTransaction,
+		c.WebhookDelivery, c.WebhookEndpoint,
 	} {
 		n.Use(hooks...)
 	}
@@ -327,11 +408,14 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Auth, c.BillingSequence, c.Customer, c.Entitlement, c.Environment, c.Feature,
-		c.Invoice, c.InvoiceLineItem, c.InvoiceSequence, c.Meter, c.Payment,
-		c.PaymentAttempt, c.Plan, c.Price, c.Secret, c.Subscription,
-		c.SubscriptionLineItem, c.SubscriptionPause, c.Task, c.Tenant, c.User,
-		c.Wallet, c.WalletTransaction,
+		c.AlertEvent, c.AlertRule, c.Auth, c.BillingSequence, c.Coupon,
+		c.CouponApplication, c.CreditNote, c.CreditNoteLineItem, c.CreditNoteSequence,
+		c.Customer, c.DunningAttempt, c.Entitlement, c.Environment, c.Feature,
+		c.Invoice, c.InvoiceLineItem, c.InvoiceSequence, c.Meter, c.OutboxEvent,
+		c.Payment, c.PaymentAttempt, c.Plan, c.Price, c.Secret, c.Subscription,
+		c.SubscriptionLineItem, c.SubscriptionPause, c.SubscriptionQuantityChange,
+		c.Task, c.TaxRate, c.Tenant, c.User, c.Wallet, c.WalletTransaction,
+		c.WebhookDelivery, c.WebhookEndpoint,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -340,12 +424,28 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AlertEventMutation:
+		return c.AlertEvent.mutate(ctx, m)
+	case *AlertRuleMutation:
+		return c.AlertRule.mutate(ctx, m)
 	case *AuthMutation:
 		return c.Auth.mutate(ctx, m)
 	case *BillingSequenceMutation:
 		return c.BillingSequence.mutate(ctx, m)
+	case *CouponMutation:
+		return c.Coupon.mutate(ctx, m)
+	case *CouponApplicationMutation:
+		return c.CouponApplication.mutate(ctx, m)
+	case *CreditNoteMutation:
+		return c.CreditNote.mutate(ctx, m)
+	case *CreditNoteLineItemMutation:
+		return c.CreditNoteLineItem.mutate(ctx, m)
+	case *CreditNoteSequenceMutation:
+		return c.CreditNoteSequence.mutate(ctx, m)
 	case *CustomerMutation:
 		return c.Customer.mutate(ctx, m)
+	case *DunningAttemptMutation:
+		return c.DunningAttempt.mutate(ctx, m)
 	case *EntitlementMutation:
 		return c.Entitlement.mutate(ctx, m)
 	case *EnvironmentMutation:
@@ -360,6 +460,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.InvoiceSequence.mutate(ctx, m)
 	case *MeterMutation:
 		return c.Meter.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
 	case *PaymentMutation:
 		return c.Payment.mutate(ctx, m)
 	case *PaymentAttemptMutation:
@@ -376,8 +478,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.SubscriptionLineItem.mutate(ctx, m)
 	case *SubscriptionPauseMutation:
 		return c.SubscriptionPause.mutate(ctx, m)
+	case *SubscriptionQuantityChangeMutation:
+		return c.SubscriptionQuantityChange.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
+	case *TaxRateMutation:
+		return c.TaxRate.mutate(ctx, m)
 	case *TenantMutation:
 		return c.Tenant.mutate(ctx, m)
 	case *UserMutation:
@@ -386,11 +492,281 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Wallet.mutate(ctx, m)
 	case *WalletTransactionMutation:
 		return c.WalletTransaction.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookEndpointMutation:
+		return c.WebhookEndpoint.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AlertEventClient is a client for the AlertEvent schema.
+type AlertEventClient struct {
+	config
+}
+
+// NewAlertEventClient returns a client for the AlertEvent from the given config.
+func NewAlertEventClient(c config) *AlertEventClient {
+	return &AlertEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `alertevent.Hooks(f(g(h())))`.
+func (c *AlertEventClient) Use(hooks ...Hook) {
+	c.hooks.AlertEvent = append(c.hooks.AlertEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `alertevent.Intercept(f(g(h())))`.
+func (c *AlertEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AlertEvent = append(c.inters.AlertEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AlertEvent entity.
+func (c *AlertEventClient) Create() *AlertEventCreate {
+	mutation := newAlertEventMutation(c.config, OpCreate)
+	return &AlertEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AlertEvent entities.
+func (c *AlertEventClient) CreateBulk(builders ...*AlertEventCreate) *AlertEventCreateBulk {
+	return &AlertEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AlertEventClient) MapCreateBulk(slice any, setFunc func(*AlertEventCreate, int)) *AlertEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AlertEventCreateBulk{err: fmt.Errorf("calling to AlertEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AlertEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AlertEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AlertEvent.
+func (c *AlertEventClient) Update() *AlertEventUpdate {
+	mutation := newAlertEventMutation(c.config, OpUpdate)
+	return &AlertEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AlertEventClient) UpdateOne(ae *AlertEvent) *AlertEventUpdateOne {
+	mutation := newAlertEventMutation(c.config, OpUpdateOne, withAlertEvent(ae))
+	return &AlertEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AlertEventClient) UpdateOneID(id string) *AlertEventUpdateOne {
+	mutation := newAlertEventMutation(c.config, OpUpdateOne, withAlertEventID(id))
+	return &AlertEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AlertEvent.
+func (c *AlertEventClient) Delete() *AlertEventDelete {
+	mutation := newAlertEventMutation(c.config, OpDelete)
+	return &AlertEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AlertEventClient) DeleteOne(ae *AlertEvent) *AlertEventDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AlertEventClient) DeleteOneID(id string) *AlertEventDeleteOne {
+	builder := c.Delete().Where(alertevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AlertEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AlertEvent.
+func (c *AlertEventClient) Query() *AlertEventQuery {
+	return &AlertEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAlertEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AlertEvent entity by its id.
+func (c *AlertEventClient) Get(ctx context.Context, id string) (*AlertEvent, error) {
+	return c.Query().Where(alertevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AlertEventClient) GetX(ctx context.Context, id string) *AlertEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AlertEventClient) Hooks() []Hook {
+	return c.hooks.AlertEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *AlertEventClient) Interceptors() []Interceptor {
+	return c.inters.AlertEvent
+}
+
+func (c *AlertEventClient) mutate(ctx context.Context, m *AlertEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AlertEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AlertEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AlertEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AlertEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AlertEvent mutation op: %q", m.Op())
+	}
+}
+
+// AlertRuleClient is a client for the AlertRule schema.
+type AlertRuleClient struct {
+	config
+}
+
+// NewAlertRuleClient returns a client for the AlertRule from the given config.
+func NewAlertRuleClient(c config) *AlertRuleClient {
+	return &AlertRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `alertrule.Hooks(f(g(h())))`.
+func (c *AlertRuleClient) Use(hooks ...Hook) {
+	c.hooks.AlertRule = append(c.hooks.AlertRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `alertrule.Intercept(f(g(h())))`.
+func (c *AlertRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AlertRule = append(c.inters.AlertRule, interceptors...)
+}
+
+// Create returns a builder for creating a AlertRule entity.
+func (c *AlertRuleClient) Create() *AlertRuleCreate {
+	mutation := newAlertRuleMutation(c.config, OpCreate)
+	return &AlertRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AlertRule entities.
+func (c *AlertRuleClient) CreateBulk(builders ...*AlertRuleCreate) *AlertRuleCreateBulk {
+	return &AlertRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AlertRuleClient) MapCreateBulk(slice any, setFunc func(*AlertRuleCreate, int)) *AlertRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AlertRuleCreateBulk{err: fmt.Errorf("calling to AlertRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AlertRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AlertRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AlertRule.
+func (c *AlertRuleClient) Update() *AlertRuleUpdate {
+	mutation := newAlertRuleMutation(c.config, OpUpdate)
+	return &AlertRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AlertRuleClient) UpdateOne(ar *AlertRule) *AlertRuleUpdateOne {
+	mutation := newAlertRuleMutation(c.config, OpUpdateOne, withAlertRule(ar))
+	return &AlertRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AlertRuleClient) UpdateOneID(id string) *AlertRuleUpdateOne {
+	mutation := newAlertRuleMutation(c.config, OpUpdateOne, withAlertRuleID(id))
+	return &AlertRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AlertRule.
+func (c *AlertRuleClient) Delete() *AlertRuleDelete {
+	mutation := newAlertRuleMutation(c.config, OpDelete)
+	return &AlertRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AlertRuleClient) DeleteOne(ar *AlertRule) *AlertRuleDeleteOne {
+	return c.DeleteOneID(ar.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AlertRuleClient) DeleteOneID(id string) *AlertRuleDeleteOne {
+	builder := c.Delete().Where(alertrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AlertRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for AlertRule.
+func (c *AlertRuleClient) Query() *AlertRuleQuery {
+	return &AlertRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAlertRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AlertRule entity by its id.
+func (c *AlertRuleClient) Get(ctx context.Context, id string) (*AlertRule, error) {
+	return c.Query().Where(alertrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AlertRuleClient) GetX(ctx context.Context, id string) *AlertRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AlertRuleClient) Hooks() []Hook {
+	return c.hooks.AlertRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *AlertRuleClient) Interceptors() []Interceptor {
+	return c.inters.AlertRule
+}
+
+func (c *AlertRuleClient) mutate(ctx context.Context, m *AlertRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AlertRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AlertRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AlertRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AlertRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AlertRule mutation op: %q", m.Op())
+	}
+}
+
 // AuthClient is a client for the Auth schema.
 type AuthClient struct {
 	config
@@ -657,107 +1033,937 @@ func (c *BillingSequenceClient) mutate(ctx context.Context, m *BillingSequenceMu
 	}
 }
 
-// CustomerClient is a client for the Customer schema.
-type CustomerClient struct {
+// CouponClient is a client for the Coupon schema.
+type CouponClient struct {
 	config
 }
 
-// NewCustomerClient returns a client for the Customer from the given config.
-func NewCustomerClient(c config) *CustomerClient {
-	return &CustomerClient{config: c}
+// NewCouponClient returns a client for the Coupon from the given config.
+func NewCouponClient(c config) *CouponClient {
+	return &CouponClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `coupon.Hooks(f(g(h())))`.
+func (c *CouponClient) Use(hooks ...Hook) {
+	c.hooks.Coupon = append(c.hooks.Coupon, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `coupon.Intercept(f(g(h())))`.
+func (c *CouponClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Coupon = append(c.inters.Coupon, interceptors...)
+}
+
+// Create returns a builder for creating a Coupon entity.
+func (c *CouponClient) Create() *CouponCreate {
+	mutation := newCouponMutation(c.config, OpCreate)
+	return &CouponCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Coupon entities.
+func (c *CouponClient) CreateBulk(builders ...*CouponCreate) *CouponCreateBulk {
+	return &CouponCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CouponClient) MapCreateBulk(slice any, setFunc func(*CouponCreate, int)) *CouponCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CouponCreateBulk{err: fmt.Errorf("calling to CouponClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CouponCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CouponCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Coupon.
+func (c *CouponClient) Update() *CouponUpdate {
+	mutation := newCouponMutation(c.config, OpUpdate)
+	return &CouponUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CouponClient) UpdateOne(co *Coupon) *CouponUpdateOne {
+	mutation := newCouponMutation(c.config, OpUpdateOne, withCoupon(co))
+	return &CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CouponClient) UpdateOneID(id string) *CouponUpdateOne {
+	mutation := newCouponMutation(c.config, OpUpdateOne, withCouponID(id))
+	return &CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Coupon.
+func (c *CouponClient) Delete() *CouponDelete {
+	mutation := newCouponMutation(c.config, OpDelete)
+	return &CouponDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CouponClient) DeleteOne(co *Coupon) *CouponDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CouponClient) DeleteOneID(id string) *CouponDeleteOne {
+	builder := c.Delete().Where(coupon.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CouponDeleteOne{builder}
+}
+
+// Query returns a query builder for Coupon.
+func (c *CouponClient) Query() *CouponQuery {
+	return &CouponQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCoupon},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Coupon entity by its id.
+func (c *CouponClient) Get(ctx context.Context, id string) (*Coupon, error) {
+	return c.Query().Where(coupon.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CouponClient) GetX(ctx context.Context, id string) *Coupon {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CouponClient) Hooks() []Hook {
+	return c.hooks.Coupon
+}
+
+// Interceptors returns the client interceptors.
+func (c *CouponClient) Interceptors() []Interceptor {
+	return c.inters.Coupon
+}
+
+func (c *CouponClient) mutate(ctx context.Context, m *CouponMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CouponCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CouponUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CouponDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Coupon mutation op: %q", m.Op())
+	}
+}
+
+// CouponApplicationClient is a client for the CouponApplication schema.
+type CouponApplicationClient struct {
+	config
+}
+
+// NewCouponApplicationClient returns a client for the CouponApplication from the given config.
+func NewCouponApplicationClient(c config) *CouponApplicationClient {
+	return &CouponApplicationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `couponapplication.Hooks(f(g(h())))`.
+func (c *CouponApplicationClient) Use(hooks ...Hook) {
+	c.hooks.CouponApplication = append(c.hooks.CouponApplication, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `couponapplication.Intercept(f(g(h())))`.
+func (c *CouponApplicationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CouponApplication = append(c.inters.CouponApplication, interceptors...)
+}
+
+// Create returns a builder for creating a CouponApplication entity.
+func (c *CouponApplicationClient) Create() *CouponApplicationCreate {
+	mutation := newCouponApplicationMutation(c.config, OpCreate)
+	return &CouponApplicationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CouponApplication entities.
+func (c *CouponApplicationClient) CreateBulk(builders ...*CouponApplicationCreate) *CouponApplicationCreateBulk {
+	return &CouponApplicationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CouponApplicationClient) MapCreateBulk(slice any, setFunc func(*CouponApplicationCreate, int)) *CouponApplicationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CouponApplicationCreateBulk{err: fmt.Errorf("calling to CouponApplicationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CouponApplicationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CouponApplicationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CouponApplication.
+func (c *CouponApplicationClient) Update() *CouponApplicationUpdate {
+	mutation := newCouponApplicationMutation(c.config, OpUpdate)
+	return &CouponApplicationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CouponApplicationClient) UpdateOne(ca *CouponApplication) *CouponApplicationUpdateOne {
+	mutation := newCouponApplicationMutation(c.config, OpUpdateOne, withCouponApplication(ca))
+	return &CouponApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CouponApplicationClient) UpdateOneID(id string) *CouponApplicationUpdateOne {
+	mutation := newCouponApplicationMutation(c.config, OpUpdateOne, withCouponApplicationID(id))
+	return &CouponApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CouponApplication.
+func (c *CouponApplicationClient) Delete() *CouponApplicationDelete {
+	mutation := newCouponApplicationMutation(c.config, OpDelete)
+	return &CouponApplicationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CouponApplicationClient) DeleteOne(ca *CouponApplication) *CouponApplicationDeleteOne {
+	return c.DeleteOneID(ca.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CouponApplicationClient) DeleteOneID(id string) *CouponApplicationDeleteOne {
+	builder := c.Delete().Where(couponapplication.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CouponApplicationDeleteOne{builder}
+}
+
+// Query returns a query builder for CouponApplication.
+func (c *CouponApplicationClient) Query() *CouponApplicationQuery {
+	return &CouponApplicationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCouponApplication},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CouponApplication entity by its id.
+func (c *CouponApplicationClient) Get(ctx context.Context, id string) (*CouponApplication, error) {
+	return c.Query().Where(couponapplication.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CouponApplicationClient) GetX(ctx context.Context, id string) *CouponApplication {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CouponApplicationClient) Hooks() []Hook {
+	return c.hooks.CouponApplication
+}
+
+// Interceptors returns the client interceptors.
+func (c *CouponApplicationClient) Interceptors() []Interceptor {
+	return c.inters.CouponApplication
+}
+
+func (c *CouponApplicationClient) mutate(ctx context.Context, m *CouponApplicationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CouponApplicationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CouponApplicationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CouponApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CouponApplicationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CouponApplication mutation op: %q", m.Op())
+	}
+}
+
+// CreditNoteClient is a client for the CreditNote schema.
+type CreditNoteClient struct {
+	config
+}
+
+// NewCreditNoteClient returns a client for the CreditNote from the given config.
+func NewCreditNoteClient(c config) *CreditNoteClient {
+	return &CreditNoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `creditnote.Hooks(f(g(h())))`.
+func (c *CreditNoteClient) Use(hooks ...Hook) {
+	c.hooks.CreditNote = append(c.hooks.CreditNote, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `creditnote.Intercept(f(g(h())))`.
+func (c *CreditNoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CreditNote = append(c.inters.CreditNote, interceptors...)
+}
+
+// Create returns a builder for creating a CreditNote entity.
+func (c *CreditNoteClient) Create() *CreditNoteCreate {
+	mutation := newCreditNoteMutation(c.config, OpCreate)
+	return &CreditNoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CreditNote entities.
+func (c *CreditNoteClient) CreateBulk(builders ...*CreditNoteCreate) *CreditNoteCreateBulk {
+	return &CreditNoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CreditNoteClient) MapCreateBulk(slice any, setFunc func(*CreditNoteCreate, int)) *CreditNoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CreditNoteCreateBulk{err: fmt.Errorf("calling to CreditNoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CreditNoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CreditNoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CreditNote.
+func (c *CreditNoteClient) Update() *CreditNoteUpdate {
+	mutation := newCreditNoteMutation(c.config, OpUpdate)
+	return &CreditNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CreditNoteClient) UpdateOne(cn *CreditNote) *CreditNoteUpdateOne {
+	mutation := newCreditNoteMutation(c.config, OpUpdateOne, withCreditNote(cn))
+	return &CreditNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CreditNoteClient) UpdateOneID(id string) *CreditNoteUpdateOne {
+	mutation := newCreditNoteMutation(c.config, OpUpdateOne, withCreditNoteID(id))
+	return &CreditNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CreditNote.
+func (c *CreditNoteClient) Delete() *CreditNoteDelete {
+	mutation := newCreditNoteMutation(c.config, OpDelete)
+	return &CreditNoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CreditNoteClient) DeleteOne(cn *CreditNote) *CreditNoteDeleteOne {
+	return c.DeleteOneID(cn.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CreditNoteClient) DeleteOneID(id string) *CreditNoteDeleteOne {
+	builder := c.Delete().Where(creditnote.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CreditNoteDeleteOne{builder}
+}
+
+// Query returns a query builder for CreditNote.
+func (c *CreditNoteClient) Query() *CreditNoteQuery {
+	return &CreditNoteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCreditNote},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CreditNote entity by its id.
+func (c *CreditNoteClient) Get(ctx context.Context, id string) (*CreditNote, error) {
+	return c.Query().Where(creditnote.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CreditNoteClient) GetX(ctx context.Context, id string) *CreditNote {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLineItems queries the line_items edge of a CreditNote.
+func (c *CreditNoteClient) QueryLineItems(cn *CreditNote) *CreditNoteLineItemQuery {
+	query := (&CreditNoteLineItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cn.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(creditnote.Table, creditnote.FieldID, id),
+			sqlgraph.To(creditnotelineitem.Table, creditnotelineitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, creditnote.LineItemsTable, creditnote.LineItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(cn.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CreditNoteClient) Hooks() []Hook {
+	return c.hooks.CreditNote
+}
+
+// Interceptors returns the client interceptors.
+func (c *CreditNoteClient) Interceptors() []Interceptor {
+	return c.inters.CreditNote
+}
+
+func (c *CreditNoteClient) mutate(ctx context.Context, m *CreditNoteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CreditNoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CreditNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CreditNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CreditNoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CreditNote mutation op: %q", m.Op())
+	}
+}
+
+// CreditNoteLineItemClient is a client for the CreditNoteLineItem schema.
+type CreditNoteLineItemClient struct {
+	config
+}
+
+// NewCreditNoteLineItemClient returns a client for the CreditNoteLineItem from the given config.
+func NewCreditNoteLineItemClient(c config) *CreditNoteLineItemClient {
+	return &CreditNoteLineItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `creditnotelineitem.Hooks(f(g(h())))`.
+func (c *CreditNoteLineItemClient) Use(hooks ...Hook) {
+	c.hooks.CreditNoteLineItem = append(c.hooks.CreditNoteLineItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `creditnotelineitem.Intercept(f(g(h())))`.
+func (c *CreditNoteLineItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CreditNoteLineItem = append(c.inters.CreditNoteLineItem, interceptors...)
+}
+
+// Create returns a builder for creating a CreditNoteLineItem entity.
+func (c *CreditNoteLineItemClient) Create() *CreditNoteLineItemCreate {
+	mutation := newCreditNoteLineItemMutation(c.config, OpCreate)
+	return &CreditNoteLineItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CreditNoteLineItem entities.
+func (c *CreditNoteLineItemClient) CreateBulk(builders ...*CreditNoteLineItemCreate) *CreditNoteLineItemCreateBulk {
+	return &CreditNoteLineItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CreditNoteLineItemClient) MapCreateBulk(slice any, setFunc func(*CreditNoteLineItemCreate, int)) *CreditNoteLineItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CreditNoteLineItemCreateBulk{err: fmt.Errorf("calling to CreditNoteLineItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CreditNoteLineItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CreditNoteLineItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CreditNoteLineItem.
+func (c *CreditNoteLineItemClient) Update() *CreditNoteLineItemUpdate {
+	mutation := newCreditNoteLineItemMutation(c.config, OpUpdate)
+	return &CreditNoteLineItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CreditNoteLineItemClient) UpdateOne(cnli *CreditNoteLineItem) *CreditNoteLineItemUpdateOne {
+	mutation := newCreditNoteLineItemMutation(c.config, OpUpdateOne, withCreditNoteLineItem(cnli))
+	return &CreditNoteLineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CreditNoteLineItemClient) UpdateOneID(id string) *CreditNoteLineItemUpdateOne {
+	mutation := newCreditNoteLineItemMutation(c.config, OpUpdateOne, withCreditNoteLineItemID(id))
+	return &CreditNoteLineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CreditNoteLineItem.
+func (c *CreditNoteLineItemClient) Delete() *CreditNoteLineItemDelete {
+	mutation := newCreditNoteLineItemMutation(c.config, OpDelete)
+	return &CreditNoteLineItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CreditNoteLineItemClient) DeleteOne(cnli *CreditNoteLineItem) *CreditNoteLineItemDeleteOne {
+	return c.DeleteOneID(cnli.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CreditNoteLineItemClient) DeleteOneID(id string) *CreditNoteLineItemDeleteOne {
+	builder := c.Delete().Where(creditnotelineitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CreditNoteLineItemDeleteOne{builder}
+}
+
+// Query returns a query builder for CreditNoteLineItem.
+func (c *CreditNoteLineItemClient) Query() *CreditNoteLineItemQuery {
+	return &CreditNoteLineItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCreditNoteLineItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CreditNoteLineItem entity by its id.
+func (c *CreditNoteLineItemClient) Get(ctx context.Context, id string) (*CreditNoteLineItem, error) {
+	return c.Query().Where(creditnotelineitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CreditNoteLineItemClient) GetX(ctx context.Context, id string) *CreditNoteLineItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCreditNote queries the credit_note edge of a CreditNoteLineItem.
+func (c *CreditNoteLineItemClient) QueryCreditNote(cnli *CreditNoteLineItem) *CreditNoteQuery {
+	query := (&CreditNoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cnli.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(creditnotelineitem.Table, creditnotelineitem.FieldID, id),
+			sqlgraph.To(creditnote.Table, creditnote.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, creditnotelineitem.CreditNoteTable, creditnotelineitem.CreditNoteColumn),
+		)
+		fromV = sqlgraph.Neighbors(cnli.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CreditNoteLineItemClient) Hooks() []Hook {
+	return c.hooks.CreditNoteLineItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *CreditNoteLineItemClient) Interceptors() []Interceptor {
+	return c.inters.CreditNoteLineItem
+}
+
+func (c *CreditNoteLineItemClient) mutate(ctx context.Context, m *CreditNoteLineItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CreditNoteLineItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CreditNoteLineItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CreditNoteLineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CreditNoteLineItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CreditNoteLineItem mutation op: %q", m.Op())
+	}
+}
+
+// CreditNoteSequenceClient is a client for the CreditNoteSequence schema.
+type CreditNoteSequenceClient struct {
+	config
+}
+
+// NewCreditNoteSequenceClient returns a client for the CreditNoteSequence from the given config.
+func NewCreditNoteSequenceClient(c config) *CreditNoteSequenceClient {
+	return &CreditNoteSequenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `creditnotesequence.Hooks(f(g(h())))`.
+func (c *CreditNoteSequenceClient) Use(hooks ...Hook) {
+	c.hooks.CreditNoteSequence = append(c.hooks.CreditNoteSequence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `creditnotesequence.Intercept(f(g(h())))`.
+func (c *CreditNoteSequenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CreditNoteSequence = append(c.inters.CreditNoteSequence, interceptors...)
+}
+
+// Create returns a builder for creating a CreditNoteSequence entity.
+func (c *CreditNoteSequenceClient) Create() *CreditNoteSequenceCreate {
+	mutation := newCreditNoteSequenceMutation(c.config, OpCreate)
+	return &CreditNoteSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CreditNoteSequence entities.
+func (c *CreditNoteSequenceClient) CreateBulk(builders ...*CreditNoteSequenceCreate) *CreditNoteSequenceCreateBulk {
+	return &CreditNoteSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CreditNoteSequenceClient) MapCreateBulk(slice any, setFunc func(*CreditNoteSequenceCreate, int)) *CreditNoteSequenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CreditNoteSequenceCreateBulk{err: fmt.Errorf("calling to CreditNoteSequenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CreditNoteSequenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CreditNoteSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CreditNoteSequence.
+func (c *CreditNoteSequenceClient) Update() *CreditNoteSequenceUpdate {
+	mutation := newCreditNoteSequenceMutation(c.config, OpUpdate)
+	return &CreditNoteSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CreditNoteSequenceClient) UpdateOne(cns *CreditNoteSequence) *CreditNoteSequenceUpdateOne {
+	mutation := newCreditNoteSequenceMutation(c.config, OpUpdateOne, withCreditNoteSequence(cns))
+	return &CreditNoteSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CreditNoteSequenceClient) UpdateOneID(id int) *CreditNoteSequenceUpdateOne {
+	mutation := newCreditNoteSequenceMutation(c.config, OpUpdateOne, withCreditNoteSequenceID(id))
+	return &CreditNoteSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CreditNoteSequence.
+func (c *CreditNoteSequenceClient) Delete() *CreditNoteSequenceDelete {
+	mutation := newCreditNoteSequenceMutation(c.config, OpDelete)
+	return &CreditNoteSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CreditNoteSequenceClient) DeleteOne(cns *CreditNoteSequence) *CreditNoteSequenceDeleteOne {
+	return c.DeleteOneID(cns.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CreditNoteSequenceClient) DeleteOneID(id int) *CreditNoteSequenceDeleteOne {
+	builder := c.Delete().Where(creditnotesequence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CreditNoteSequenceDeleteOne{builder}
+}
+
+// Query returns a query builder for CreditNoteSequence.
+func (c *CreditNoteSequenceClient) Query() *CreditNoteSequenceQuery {
+	return &CreditNoteSequenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCreditNoteSequence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CreditNoteSequence entity by its id.
+func (c *CreditNoteSequenceClient) Get(ctx context.Context, id int) (*CreditNoteSequence, error) {
+	return c.Query().Where(creditnotesequence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CreditNoteSequenceClient) GetX(ctx context.Context, id int) *CreditNoteSequence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CreditNoteSequenceClient) Hooks() []Hook {
+	return c.hooks.CreditNoteSequence
+}
+
+// Interceptors returns the client interceptors.
+func (c *CreditNoteSequenceClient) Interceptors() []Interceptor {
+	return c.inters.CreditNoteSequence
+}
+
+func (c *CreditNoteSequenceClient) mutate(ctx context.Context, m *CreditNoteSequenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CreditNoteSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CreditNoteSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CreditNoteSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CreditNoteSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CreditNoteSequence mutation op: %q", m.Op())
+	}
+}
+
+// CustomerClient is a client for the Customer schema.
+type CustomerClient struct {
+	config
+}
+
+// NewCustomerClient returns a client for the Customer from the given config.
+func NewCustomerClient(c config) *CustomerClient {
+	return &CustomerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `customer.Hooks(f(g(h())))`.
+func (c *CustomerClient) Use(hooks ...Hook) {
+	c.hooks.Customer = append(c.hooks.Customer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `customer.Intercept(f(g(h())))`.
+func (c *CustomerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Customer = append(c.inters.Customer, interceptors...)
+}
+
+// Create returns a builder for creating a Customer entity.
+func (c *CustomerClient) Create() *CustomerCreate {
+	mutation := newCustomerMutation(c.config, OpCreate)
+	return &CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Customer entities.
+func (c *CustomerClient) CreateBulk(builders ...*CustomerCreate) *CustomerCreateBulk {
+	return &CustomerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CustomerClient) MapCreateBulk(slice any, setFunc func(*CustomerCreate, int)) *CustomerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CustomerCreateBulk{err: fmt.Errorf("calling to CustomerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CustomerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CustomerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Customer.
+func (c *CustomerClient) Update() *CustomerUpdate {
+	mutation := newCustomerMutation(c.config, OpUpdate)
+	return &CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CustomerClient) UpdateOne(cu *Customer) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomer(cu))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CustomerClient) UpdateOneID(id string) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomerID(id))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Customer.
+func (c *CustomerClient) Delete() *CustomerDelete {
+	mutation := newCustomerMutation(c.config, OpDelete)
+	return &CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CustomerClient) DeleteOne(cu *Customer) *CustomerDeleteOne {
+	return c.DeleteOneID(cu.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CustomerClient) DeleteOneID(id string) *CustomerDeleteOne {
+	builder := c.Delete().Where(customer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CustomerDeleteOne{builder}
+}
+
+// Query returns a query builder for Customer.
+func (c *CustomerClient) Query() *CustomerQuery {
+	return &CustomerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCustomer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Customer entity by its id.
+func (c *CustomerClient) Get(ctx context.Context, id string) (*Customer, error) {
+	return c.Query().Where(customer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CustomerClient) GetX(ctx context.Context, id string) *Customer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CustomerClient) Hooks() []Hook {
+	return c.hooks.Customer
+}
+
+// Interceptors returns the client interceptors.
+func (c *CustomerClient) Interceptors() []Interceptor {
+	return c.inters.Customer
+}
+
+func (c *CustomerClient) mutate(ctx context.Context, m *CustomerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Customer mutation op: %q", m.Op())
+	}
+}
+
+// DunningAttemptClient is a client for the DunningAttempt schema.
+type DunningAttemptClient struct {
+	config
+}
+
+// NewDunningAttemptClient returns a client for the DunningAttempt from the given config.
+func NewDunningAttemptClient(c config) *DunningAttemptClient {
+	return &DunningAttemptClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `customer.Hooks(f(g(h())))`.
-func (c *CustomerClient) Use(hooks ...Hook) {
-	c.hooks.Customer = append(c.hooks.Customer, hooks...)
+// A call to `Use(f, g, h)` equals to `dunningattempt.Hooks(f(g(h())))`.
+func (c *DunningAttemptClient) Use(hooks ...Hook) {
+	c.hooks.DunningAttempt = append(c.hooks.DunningAttempt, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `customer.Intercept(f(g(h())))`.
-func (c *CustomerClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Customer = append(c.inters.Customer, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `dunningattempt.Intercept(f(g(h())))`.
+func (c *DunningAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DunningAttempt = append(c.inters.DunningAttempt, interceptors...)
 }
 
-// Create returns a builder for creating a Customer entity.
-func (c *CustomerClient) Create() *CustomerCreate {
-	mutation := newCustomerMutation(c.config, OpCreate)
-	return &CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DunningAttempt entity.
+func (c *DunningAttemptClient) Create() *DunningAttemptCreate {
+	mutation := newDunningAttemptMutation(c.config, OpCreate)
+	return &DunningAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Customer entities.
-func (c *CustomerClient) CreateBulk(builders ...*CustomerCreate) *CustomerCreateBulk {
-	return &CustomerCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DunningAttempt entities.
+func (c *DunningAttemptClient) CreateBulk(builders ...*DunningAttemptCreate) *DunningAttemptCreateBulk {
+	return &DunningAttemptCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CustomerClient) MapCreateBulk(slice any, setFunc func(*CustomerCreate, int)) *CustomerCreateBulk {
+func (c *DunningAttemptClient) MapCreateBulk(slice any, setFunc func(*DunningAttemptCreate, int)) *DunningAttemptCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CustomerCreateBulk{err: fmt.Errorf("calling to CustomerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DunningAttemptCreateBulk{err: fmt.Errorf("calling to DunningAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CustomerCreate, rv.Len())
+	builders := make([]*DunningAttemptCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CustomerCreateBulk{config: c.config, builders: builders}
+	return &DunningAttemptCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Customer.
-func (c *CustomerClient) Update() *CustomerUpdate {
-	mutation := newCustomerMutation(c.config, OpUpdate)
-	return &CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DunningAttempt.
+func (c *DunningAttemptClient) Update() *DunningAttemptUpdate {
+	mutation := newDunningAttemptMutation(c.config, OpUpdate)
+	return &DunningAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CustomerClient) UpdateOne(cu *Customer) *CustomerUpdateOne {
-	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomer(cu))
-	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DunningAttemptClient) UpdateOne(da *DunningAttempt) *DunningAttemptUpdateOne {
+	mutation := newDunningAttemptMutation(c.config, OpUpdateOne, withDunningAttempt(da))
+	return &DunningAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CustomerClient) UpdateOneID(id string) *CustomerUpdateOne {
-	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomerID(id))
-	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DunningAttemptClient) UpdateOneID(id string) *DunningAttemptUpdateOne {
+	mutation := newDunningAttemptMutation(c.config, OpUpdateOne, withDunningAttemptID(id))
+	return &DunningAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Customer.
-func (c *CustomerClient) Delete() *CustomerDelete {
-	mutation := newCustomerMutation(c.config, OpDelete)
-	return &CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DunningAttempt.
+func (c *DunningAttemptClient) Delete() *DunningAttemptDelete {
+	mutation := newDunningAttemptMutation(c.config, OpDelete)
+	return &DunningAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CustomerClient) DeleteOne(cu *Customer) *CustomerDeleteOne {
-	return c.DeleteOneID(cu.ID)
+func (c *DunningAttemptClient) DeleteOne(da *DunningAttempt) *DunningAttemptDeleteOne {
+	return c.DeleteOneID(da.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CustomerClient) DeleteOneID(id string) *CustomerDeleteOne {
-	builder := c.Delete().Where(customer.ID(id))
+func (c *DunningAttemptClient) DeleteOneID(id string) *DunningAttemptDeleteOne {
+	builder := c.Delete().Where(dunningattempt.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CustomerDeleteOne{builder}
+	return &DunningAttemptDeleteOne{builder}
 }
 
-// Query returns a query builder for Customer.
-func (c *CustomerClient) Query() *CustomerQuery {
-	return &CustomerQuery{
+// Query returns a query builder for DunningAttempt.
+func (c *DunningAttemptClient) Query() *DunningAttemptQuery {
+	return &DunningAttemptQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCustomer},
+		ctx:    &QueryContext{Type: TypeDunningAttempt},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Customer entity by its id.
-func (c *CustomerClient) Get(ctx context.Context, id string) (*Customer, error) {
-	return c.Query().Where(customer.ID(id)).Only(ctx)
+// Get returns a DunningAttempt entity by its id.
+func (c *DunningAttemptClient) Get(ctx context.Context, id string) (*DunningAttempt, error) {
+	return c.Query().Where(dunningattempt.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CustomerClient) GetX(ctx context.Context, id string) *Customer {
+func (c *DunningAttemptClient) GetX(ctx context.Context, id string) *DunningAttempt {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -766,27 +1972,27 @@ func (c *CustomerClient) GetX(ctx context.Context, id string) *Customer {
 }
 
 // Hooks returns the client hooks.
-func (c *CustomerClient) Hooks() []Hook {
-	return c.hooks.Customer
+func (c *DunningAttemptClient) Hooks() []Hook {
+	return c.hooks.DunningAttempt
 }
 
 // Interceptors returns the client interceptors.
-func (c *CustomerClient) Interceptors() []Interceptor {
-	return c.inters.Customer
+func (c *DunningAttemptClient) Interceptors() []Interceptor {
+	return c.inters.DunningAttempt
 }
 
-func (c *CustomerClient) mutate(ctx context.Context, m *CustomerMutation) (Value, error) {
+func (c *DunningAttemptClient) mutate(ctx context.Context, m *DunningAttemptMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DunningAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DunningAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DunningAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DunningAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Customer mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DunningAttempt mutation op: %q", m.Op())
 	}
 }
 
@@ -1769,6 +2975,139 @@ func (c *MeterClient) mutate(ctx context.Context, m *MeterMutation) (Value, erro
 	}
 }
 
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(oe *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(oe))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id string) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(oe *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(oe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id string) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id string) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id string) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	return c.hooks.OutboxEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
 // PaymentClient is a client for the Payment schema.
 type PaymentClient struct {
 	config
@@ -2622,6 +3961,22 @@ func (c *SubscriptionClient) QueryPauses(s *Subscription) *SubscriptionPauseQuer
 	return query
 }
 
+// QueryQuantityChanges queries the quantity_changes edge of a Subscription.
+func (c *SubscriptionClient) QueryQuantityChanges(s *Subscription) *SubscriptionQuantityChangeQuery {
+	query := (&SubscriptionQuantityChangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(subscriptionquantitychange.Table, subscriptionquantitychange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, subscription.QuantityChangesTable, subscription.QuantityChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SubscriptionClient) Hooks() []Hook {
 	return c.hooks.Subscription
@@ -2862,41 +4217,190 @@ func (c *SubscriptionPauseClient) UpdateOneID(id string) *SubscriptionPauseUpdat
 	return &SubscriptionPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SubscriptionPause.
-func (c *SubscriptionPauseClient) Delete() *SubscriptionPauseDelete {
-	mutation := newSubscriptionPauseMutation(c.config, OpDelete)
-	return &SubscriptionPauseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SubscriptionPause.
+func (c *SubscriptionPauseClient) Delete() *SubscriptionPauseDelete {
+	mutation := newSubscriptionPauseMutation(c.config, OpDelete)
+	return &SubscriptionPauseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubscriptionPauseClient) DeleteOne(sp *SubscriptionPause) *SubscriptionPauseDeleteOne {
+	return c.DeleteOneID(sp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubscriptionPauseClient) DeleteOneID(id string) *SubscriptionPauseDeleteOne {
+	builder := c.Delete().Where(subscriptionpause.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubscriptionPauseDeleteOne{builder}
+}
+
+// Query returns a query builder for SubscriptionPause.
+func (c *SubscriptionPauseClient) Query() *SubscriptionPauseQuery {
+	return &SubscriptionPauseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubscriptionPause},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SubscriptionPause entity by its id.
+func (c *SubscriptionPauseClient) Get(ctx context.Context, id string) (*SubscriptionPause, error) {
+	return c.Query().Where(subscriptionpause.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubscriptionPauseClient) GetX(ctx context.Context, id string) *SubscriptionPause {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubscription queries the subscription edge of a SubscriptionPause.
+func (c *SubscriptionPauseClient) QuerySubscription(sp *SubscriptionPause) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscriptionpause.Table, subscriptionpause.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscriptionpause.SubscriptionTable, subscriptionpause.SubscriptionColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SubscriptionPauseClient) Hooks() []Hook {
+	return c.hooks.SubscriptionPause
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubscriptionPauseClient) Interceptors() []Interceptor {
+	return c.inters.SubscriptionPause
+}
+
+func (c *SubscriptionPauseClient) mutate(ctx context.Context, m *SubscriptionPauseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubscriptionPauseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubscriptionPauseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubscriptionPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubscriptionPauseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SubscriptionPause mutation op: %q", m.Op())
+	}
+}
+
+// SubscriptionQuantityChangeClient is a client for the SubscriptionQuantityChange schema.
+type SubscriptionQuantityChangeClient struct {
+	config
+}
+
+// NewSubscriptionQuantityChangeClient returns a client for the SubscriptionQuantityChange from the given config.
+func NewSubscriptionQuantityChangeClient(c config) *SubscriptionQuantityChangeClient {
+	return &SubscriptionQuantityChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subscriptionquantitychange.Hooks(f(g(h())))`.
+func (c *SubscriptionQuantityChangeClient) Use(hooks ...Hook) {
+	c.hooks.SubscriptionQuantityChange = append(c.hooks.SubscriptionQuantityChange, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subscriptionquantitychange.Intercept(f(g(h())))`.
+func (c *SubscriptionQuantityChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SubscriptionQuantityChange = append(c.inters.SubscriptionQuantityChange, interceptors...)
+}
+
+// Create returns a builder for creating a SubscriptionQuantityChange entity.
+func (c *SubscriptionQuantityChangeClient) Create() *SubscriptionQuantityChangeCreate {
+	mutation := newSubscriptionQuantityChangeMutation(c.config, OpCreate)
+	return &SubscriptionQuantityChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SubscriptionQuantityChange entities.
+func (c *SubscriptionQuantityChangeClient) CreateBulk(builders ...*SubscriptionQuantityChangeCreate) *SubscriptionQuantityChangeCreateBulk {
+	return &SubscriptionQuantityChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubscriptionQuantityChangeClient) MapCreateBulk(slice any, setFunc func(*SubscriptionQuantityChangeCreate, int)) *SubscriptionQuantityChangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubscriptionQuantityChangeCreateBulk{err: fmt.Errorf("calling to SubscriptionQuantityChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubscriptionQuantityChangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubscriptionQuantityChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SubscriptionQuantityChange.
+func (c *SubscriptionQuantityChangeClient) Update() *SubscriptionQuantityChangeUpdate {
+	mutation := newSubscriptionQuantityChangeMutation(c.config, OpUpdate)
+	return &SubscriptionQuantityChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubscriptionQuantityChangeClient) UpdateOne(sqc *SubscriptionQuantityChange) *SubscriptionQuantityChangeUpdateOne {
+	mutation := newSubscriptionQuantityChangeMutation(c.config, OpUpdateOne, withSubscriptionQuantityChange(sqc))
+	return &SubscriptionQuantityChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubscriptionQuantityChangeClient) UpdateOneID(id string) *SubscriptionQuantityChangeUpdateOne {
+	mutation := newSubscriptionQuantityChangeMutation(c.config, OpUpdateOne, withSubscriptionQuantityChangeID(id))
+	return &SubscriptionQuantityChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SubscriptionQuantityChange.
+func (c *SubscriptionQuantityChangeClient) Delete() *SubscriptionQuantityChangeDelete {
+	mutation := newSubscriptionQuantityChangeMutation(c.config, OpDelete)
+	return &SubscriptionQuantityChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SubscriptionPauseClient) DeleteOne(sp *SubscriptionPause) *SubscriptionPauseDeleteOne {
-	return c.DeleteOneID(sp.ID)
+func (c *SubscriptionQuantityChangeClient) DeleteOne(sqc *SubscriptionQuantityChange) *SubscriptionQuantityChangeDeleteOne {
+	return c.DeleteOneID(sqc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SubscriptionPauseClient) DeleteOneID(id string) *SubscriptionPauseDeleteOne {
-	builder := c.Delete().Where(subscriptionpause.ID(id))
+func (c *SubscriptionQuantityChangeClient) DeleteOneID(id string) *SubscriptionQuantityChangeDeleteOne {
+	builder := c.Delete().Where(subscriptionquantitychange.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SubscriptionPauseDeleteOne{builder}
+	return &SubscriptionQuantityChangeDeleteOne{builder}
 }
 
-// Query returns a query builder for SubscriptionPause.
-func (c *SubscriptionPauseClient) Query() *SubscriptionPauseQuery {
-	return &SubscriptionPauseQuery{
+// Query returns a query builder for SubscriptionQuantityChange.
+func (c *SubscriptionQuantityChangeClient) Query() *SubscriptionQuantityChangeQuery {
+	return &SubscriptionQuantityChangeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSubscriptionPause},
+		ctx:    &QueryContext{Type: TypeSubscriptionQuantityChange},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SubscriptionPause entity by its id.
-func (c *SubscriptionPauseClient) Get(ctx context.Context, id string) (*SubscriptionPause, error) {
-	return c.Query().Where(subscriptionpause.ID(id)).Only(ctx)
+// Get returns a SubscriptionQuantityChange entity by its id.
+func (c *SubscriptionQuantityChangeClient) Get(ctx context.Context, id string) (*SubscriptionQuantityChange, error) {
+	return c.Query().Where(subscriptionquantitychange.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SubscriptionPauseClient) GetX(ctx context.Context, id string) *SubscriptionPause {
+func (c *SubscriptionQuantityChangeClient) GetX(ctx context.Context, id string) *SubscriptionQuantityChange {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2904,44 +4408,44 @@ func (c *SubscriptionPauseClient) GetX(ctx context.Context, id string) *Subscrip
 	return obj
 }
 
-// QuerySubscription queries the subscription edge of a SubscriptionPause.
-func (c *SubscriptionPauseClient) QuerySubscription(sp *SubscriptionPause) *SubscriptionQuery {
+// QuerySubscription queries the subscription edge of a SubscriptionQuantityChange.
+func (c *SubscriptionQuantityChangeClient) QuerySubscription(sqc *SubscriptionQuantityChange) *SubscriptionQuery {
 	query := (&SubscriptionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sp.ID
+		id := sqc.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(subscriptionpause.Table, subscriptionpause.FieldID, id),
+			sqlgraph.From(subscriptionquantitychange.Table, subscriptionquantitychange.FieldID, id),
 			sqlgraph.To(subscription.Table, subscription.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, subscriptionpause.SubscriptionTable, subscriptionpause.SubscriptionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscriptionquantitychange.SubscriptionTable, subscriptionquantitychange.SubscriptionColumn),
 		)
-		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sqc.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SubscriptionPauseClient) Hooks() []Hook {
-	return c.hooks.SubscriptionPause
+func (c *SubscriptionQuantityChangeClient) Hooks() []Hook {
+	return c.hooks.SubscriptionQuantityChange
 }
 
 // Interceptors returns the client interceptors.
-func (c *SubscriptionPauseClient) Interceptors() []Interceptor {
-	return c.inters.SubscriptionPause
+func (c *SubscriptionQuantityChangeClient) Interceptors() []Interceptor {
+	return c.inters.SubscriptionQuantityChange
 }
 
-func (c *SubscriptionPauseClient) mutate(ctx context.Context, m *SubscriptionPauseMutation) (Value, error) {
+func (c *SubscriptionQuantityChangeClient) mutate(ctx context.Context, m *SubscriptionQuantityChangeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SubscriptionPauseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SubscriptionQuantityChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SubscriptionPauseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SubscriptionQuantityChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SubscriptionPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SubscriptionQuantityChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SubscriptionPauseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SubscriptionQuantityChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SubscriptionPause mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SubscriptionQuantityChange mutation op: %q", m.Op())
 	}
 }
 
@@ -3078,6 +4582,139 @@ func (c *TaskClient) mutate(ctx context.Context, m *TaskMutation) (Value, error)
 	}
 }
 
+// TaxRateClient is a client for the TaxRate schema.
+type TaxRateClient struct {
+	config
+}
+
+// NewTaxRateClient returns a client for the TaxRate from the given config.
+func NewTaxRateClient(c config) *TaxRateClient {
+	return &TaxRateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taxrate.Hooks(f(g(h())))`.
+func (c *TaxRateClient) Use(hooks ...Hook) {
+	c.hooks.TaxRate = append(c.hooks.TaxRate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taxrate.Intercept(f(g(h())))`.
+func (c *TaxRateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaxRate = append(c.inters.TaxRate, interceptors...)
+}
+
+// Create returns a builder for creating a TaxRate entity.
+func (c *TaxRateClient) Create() *TaxRateCreate {
+	mutation := newTaxRateMutation(c.config, OpCreate)
+	return &TaxRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaxRate entities.
+func (c *TaxRateClient) CreateBulk(builders ...*TaxRateCreate) *TaxRateCreateBulk {
+	return &TaxRateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaxRateClient) MapCreateBulk(slice any, setFunc func(*TaxRateCreate, int)) *TaxRateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaxRateCreateBulk{err: fmt.Errorf("calling to TaxRateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaxRateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaxRateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaxRate.
+func (c *TaxRateClient) Update() *TaxRateUpdate {
+	mutation := newTaxRateMutation(c.config, OpUpdate)
+	return &TaxRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaxRateClient) UpdateOne(tr *TaxRate) *TaxRateUpdateOne {
+	mutation := newTaxRateMutation(c.config, OpUpdateOne, withTaxRate(tr))
+	return &TaxRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaxRateClient) UpdateOneID(id string) *TaxRateUpdateOne {
+	mutation := newTaxRateMutation(c.config, OpUpdateOne, withTaxRateID(id))
+	return &TaxRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaxRate.
+func (c *TaxRateClient) Delete() *TaxRateDelete {
+	mutation := newTaxRateMutation(c.config, OpDelete)
+	return &TaxRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaxRateClient) DeleteOne(tr *TaxRate) *TaxRateDeleteOne {
+	return c.DeleteOneID(tr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaxRateClient) DeleteOneID(id string) *TaxRateDeleteOne {
+	builder := c.Delete().Where(taxrate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaxRateDeleteOne{builder}
+}
+
+// Query returns a query builder for TaxRate.
+func (c *TaxRateClient) Query() *TaxRateQuery {
+	return &TaxRateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaxRate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaxRate entity by its id.
+func (c *TaxRateClient) Get(ctx context.Context, id string) (*TaxRate, error) {
+	return c.Query().Where(taxrate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaxRateClient) GetX(ctx context.Context, id string) *TaxRate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaxRateClient) Hooks() []Hook {
+	return c.hooks.TaxRate
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaxRateClient) Interceptors() []Interceptor {
+	return c.inters.TaxRate
+}
+
+func (c *TaxRateClient) mutate(ctx context.Context, m *TaxRateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaxRateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaxRateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaxRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaxRateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaxRate mutation op: %q", m.Op())
+	}
+}
+
 // TenantClient is a client for the Tenant schema.
 type TenantClient struct {
 	config
@@ -3610,19 +5247,291 @@ func (c *WalletTransactionClient) mutate(ctx context.Context, m *WalletTransacti
 	}
 }
 
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id string) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id string) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id string) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id string) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// WebhookEndpointClient is a client for the WebhookEndpoint schema.
+type WebhookEndpointClient struct {
+	config
+}
+
+// NewWebhookEndpointClient returns a client for the WebhookEndpoint from the given config.
+func NewWebhookEndpointClient(c config) *WebhookEndpointClient {
+	return &WebhookEndpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookendpoint.Hooks(f(g(h())))`.
+func (c *WebhookEndpointClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEndpoint = append(c.hooks.WebhookEndpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookendpoint.Intercept(f(g(h())))`.
+func (c *WebhookEndpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEndpoint = append(c.inters.WebhookEndpoint, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookEndpoint entity.
+func (c *WebhookEndpointClient) Create() *WebhookEndpointCreate {
+	mutation := newWebhookEndpointMutation(c.config, OpCreate)
+	return &WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookEndpoint entities.
+func (c *WebhookEndpointClient) CreateBulk(builders ...*WebhookEndpointCreate) *WebhookEndpointCreateBulk {
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookEndpointClient) MapCreateBulk(slice any, setFunc func(*WebhookEndpointCreate, int)) *WebhookEndpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookEndpointCreateBulk{err: fmt.Errorf("calling to WebhookEndpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookEndpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Update() *WebhookEndpointUpdate {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdate)
+	return &WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookEndpointClient) UpdateOne(we *WebhookEndpoint) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpoint(we))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookEndpointClient) UpdateOneID(id string) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpointID(id))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Delete() *WebhookEndpointDelete {
+	mutation := newWebhookEndpointMutation(c.config, OpDelete)
+	return &WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookEndpointClient) DeleteOne(we *WebhookEndpoint) *WebhookEndpointDeleteOne {
+	return c.DeleteOneID(we.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookEndpointClient) DeleteOneID(id string) *WebhookEndpointDeleteOne {
+	builder := c.Delete().Where(webhookendpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookEndpointDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Query() *WebhookEndpointQuery {
+	return &WebhookEndpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookEndpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookEndpoint entity by its id.
+func (c *WebhookEndpointClient) Get(ctx context.Context, id string) (*WebhookEndpoint, error) {
+	return c.Query().Where(webhookendpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookEndpointClient) GetX(ctx context.Context, id string) *WebhookEndpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookEndpointClient) Hooks() []Hook {
+	return c.hooks.WebhookEndpoint
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookEndpointClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEndpoint
+}
+
+func (c *WebhookEndpointClient) mutate(ctx context.Context, m *WebhookEndpointMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookEndpoint mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Auth, BillingSequence, Customer, Entitlement, Environment, Feature, Invoice,
-		InvoiceLineItem, InvoiceSequence, Meter, Payment, PaymentAttempt, Plan, Price,
-		Secret, Subscription, SubscriptionLineItem, SubscriptionPause, Task, Tenant,
-		User, Wallet, WalletTransaction []ent.Hook
+		AlertEvent, AlertRule, Auth, BillingSequence, Coupon, CouponApplication,
+		CreditNote, CreditNoteLineItem, CreditNoteSequence, Customer, DunningAttempt,
+		Entitlement, Environment, Feature, Invoice, InvoiceLineItem, InvoiceSequence,
+		Meter, OutboxEvent, Payment, PaymentAttempt, Plan, Price, Secret, Subscription,
+		SubscriptionLineItem, SubscriptionPause, SubscriptionQuantityChange, Task,
+		TaxRate, Tenant, User, Wallet, WalletTransaction, WebhookDelivery,
+		WebhookEndpoint []ent.Hook
 	}
 	inters struct {
-		Auth, BillingSequence, Customer, Entitlement, Environment, Feature, Invoice,
-		InvoiceLineItem, InvoiceSequence, Meter, Payment, PaymentAttempt, Plan, Price,
-		Secret, Subscription, SubscriptionLineItem, SubscriptionPause, Task, Tenant,
-		User, Wallet, WalletTransaction []ent.Interceptor
+		AlertEvent, AlertRule, Auth, BillingSequence, Coupon, CouponApplication,
+		CreditNote, CreditNoteLineItem, CreditNoteSequence, Customer, DunningAttempt,
+		Entitlement, Environment, Feature, Invoice, InvoiceLineItem, InvoiceSequence,
+		Meter, OutboxEvent, Payment, PaymentAttempt, Plan, Price, Secret, Subscription,
+		SubscriptionLineItem, SubscriptionPause, SubscriptionQuantityChange, Task,
+		TaxRate, Tenant, User, Wallet, WalletTransaction, WebhookDelivery,
+		WebhookEndpoint []ent.Interceptor
 	}
 )
 
@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/coupon"
+	"github.com/shopspring/decimal"
+)
+
+// Coupon is the model entity for the Coupon schema.
+type Coupon struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// DiscountType holds the value of the "discount_type" field.
+	DiscountType string `json:"discount_type,omitempty"`
+	// PercentOff holds the value of the "percent_off" field.
+	PercentOff *decimal.Decimal `json:"percent_off,omitempty"`
+	// AmountOff holds the value of the "amount_off" field.
+	AmountOff *decimal.Decimal `json:"amount_off,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration string `json:"duration,omitempty"`
+	// DurationInPeriods holds the value of the "duration_in_periods" field.
+	DurationInPeriods *int `json:"duration_in_periods,omitempty"`
+	// MaxRedemptions holds the value of the "max_redemptions" field.
+	MaxRedemptions *int `json:"max_redemptions,omitempty"`
+	// TimesRedeemed holds the value of the "times_redeemed" field.
+	TimesRedeemed int `json:"times_redeemed,omitempty"`
+	// RedeemBy holds the value of the "redeem_by" field.
+	RedeemBy *time.Time `json:"redeem_by,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Coupon) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coupon.FieldPercentOff, coupon.FieldAmountOff:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case coupon.FieldMetadata:
+			values[i] = new([]byte)
+		case coupon.FieldDurationInPeriods, coupon.FieldMaxRedemptions, coupon.FieldTimesRedeemed:
+			values[i] = new(sql.NullInt64)
+		case coupon.FieldID, coupon.FieldTenantID, coupon.FieldStatus, coupon.FieldCreatedBy, coupon.FieldUpdatedBy, coupon.FieldEnvironmentID, coupon.FieldName, coupon.FieldCode, coupon.FieldDiscountType, coupon.FieldCurrency, coupon.FieldDuration:
+			values[i] = new(sql.NullString)
+		case coupon.FieldCreatedAt, coupon.FieldUpdatedAt, coupon.FieldRedeemBy:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Coupon fields.
+func (c *Coupon) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coupon.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				c.ID = value.String
+			}
+		case coupon.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				c.TenantID = value.String
+			}
+		case coupon.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				c.Status = value.String
+			}
+		case coupon.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case coupon.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case coupon.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				c.CreatedBy = value.String
+			}
+		case coupon.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				c.UpdatedBy = value.String
+			}
+		case coupon.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				c.EnvironmentID = value.String
+			}
+		case coupon.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case coupon.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				c.Code = value.String
+			}
+		case coupon.FieldDiscountType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_type", values[i])
+			} else if value.Valid {
+				c.DiscountType = value.String
+			}
+		case coupon.FieldPercentOff:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field percent_off", values[i])
+			} else if value.Valid {
+				c.PercentOff = new(decimal.Decimal)
+				*c.PercentOff = *value.S.(*decimal.Decimal)
+			}
+		case coupon.FieldAmountOff:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_off", values[i])
+			} else if value.Valid {
+				c.AmountOff = new(decimal.Decimal)
+				*c.AmountOff = *value.S.(*decimal.Decimal)
+			}
+		case coupon.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				c.Currency = value.String
+			}
+		case coupon.FieldDuration:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				c.Duration = value.String
+			}
+		case coupon.FieldDurationInPeriods:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_in_periods", values[i])
+			} else if value.Valid {
+				c.DurationInPeriods = new(int)
+				*c.DurationInPeriods = int(value.Int64)
+			}
+		case coupon.FieldMaxRedemptions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_redemptions", values[i])
+			} else if value.Valid {
+				c.MaxRedemptions = new(int)
+				*c.MaxRedemptions = int(value.Int64)
+			}
+		case coupon.FieldTimesRedeemed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field times_redeemed", values[i])
+			} else if value.Valid {
+				c.TimesRedeemed = int(value.Int64)
+			}
+		case coupon.FieldRedeemBy:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field redeem_by", values[i])
+			} else if value.Valid {
+				c.RedeemBy = new(time.Time)
+				*c.RedeemBy = value.Time
+			}
+		case coupon.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Coupon.
+// This includes values selected through modifiers, order, etc.
+func (c *Coupon) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Coupon.
+// Note that you need to call Coupon.Unwrap() before calling this method if this Coupon
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Coupon) Update() *CouponUpdateOne {
+	return NewCouponClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Coupon entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Coupon) Unwrap() *Coupon {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Coupon is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Coupon) String() string {
+	var builder strings.Builder
+	builder.WriteString("Coupon(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(c.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(c.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(c.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(c.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(c.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(c.Code)
+	builder.WriteString(", ")
+	builder.WriteString("discount_type=")
+	builder.WriteString(c.DiscountType)
+	builder.WriteString(", ")
+	if v := c.PercentOff; v != nil {
+		builder.WriteString("percent_off=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := c.AmountOff; v != nil {
+		builder.WriteString("amount_off=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(c.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(c.Duration)
+	builder.WriteString(", ")
+	if v := c.DurationInPeriods; v != nil {
+		builder.WriteString("duration_in_periods=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := c.MaxRedemptions; v != nil {
+		builder.WriteString("max_redemptions=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("times_redeemed=")
+	builder.WriteString(fmt.Sprintf("%v", c.TimesRedeemed))
+	builder.WriteString(", ")
+	if v := c.RedeemBy; v != nil {
+		builder.WriteString("redeem_by=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", c.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Coupons is a parsable slice of Coupon.
+type Coupons []*Coupon
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coupon
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the coupon type in the database.
+	Label = "coupon"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldEnvironmentID holds the string denoting the environment_id field in the database.
+	FieldEnvironmentID = "environment_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldDiscountType holds the string denoting the discount_type field in the database.
+	FieldDiscountType = "discount_type"
+	// FieldPercentOff holds the string denoting the percent_off field in the database.
+	FieldPercentOff = "percent_off"
+	// FieldAmountOff holds the string denoting the amount_off field in the database.
+	FieldAmountOff = "amount_off"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldDuration holds the string denoting the duration field in the database.
+	FieldDuration = "duration"
+	// FieldDurationInPeriods holds the string denoting the duration_in_periods field in the database.
+	FieldDurationInPeriods = "duration_in_periods"
+	// FieldMaxRedemptions holds the string denoting the max_redemptions field in the database.
+	FieldMaxRedemptions = "max_redemptions"
+	// FieldTimesRedeemed holds the string denoting the times_redeemed field in the database.
+	FieldTimesRedeemed = "times_redeemed"
+	// FieldRedeemBy holds the string denoting the redeem_by field in the database.
+	FieldRedeemBy = "redeem_by"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// Table holds the table name of the coupon in the database.
+	Table = "coupons"
+)
+
+// Columns holds all SQL columns for coupon fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldEnvironmentID,
+	FieldName,
+	FieldCode,
+	FieldDiscountType,
+	FieldPercentOff,
+	FieldAmountOff,
+	FieldCurrency,
+	FieldDuration,
+	FieldDurationInPeriods,
+	FieldMaxRedemptions,
+	FieldTimesRedeemed,
+	FieldRedeemBy,
+	FieldMetadata,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultEnvironmentID holds the default value on creation for the "environment_id" field.
+	DefaultEnvironmentID string
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DiscountTypeValidator is a validator for the "discount_type" field. It is called by the builders before save.
+	DiscountTypeValidator func(string) error
+	// DurationValidator is a validator for the "duration" field. It is called by the builders before save.
+	DurationValidator func(string) error
+	// DefaultTimesRedeemed holds the default value on creation for the "times_redeemed" field.
+	DefaultTimesRedeemed int
+)
+
+// OrderOption defines the ordering options for the Coupon queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByEnvironmentID orders the results by the environment_id field.
+func ByEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvironmentID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByDiscountType orders the results by the discount_type field.
+func ByDiscountType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountType, opts...).ToFunc()
+}
+
+// ByPercentOff orders the results by the percent_off field.
+func ByPercentOff(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPercentOff, opts...).ToFunc()
+}
+
+// ByAmountOff orders the results by the amount_off field.
+func ByAmountOff(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountOff, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByDuration orders the results by the duration field.
+func ByDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuration, opts...).ToFunc()
+}
+
+// ByDurationInPeriods orders the results by the duration_in_periods field.
+func ByDurationInPeriods(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationInPeriods, opts...).ToFunc()
+}
+
+// ByMaxRedemptions orders the results by the max_redemptions field.
+func ByMaxRedemptions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRedemptions, opts...).ToFunc()
+}
+
+// ByTimesRedeemed orders the results by the times_redeemed field.
+func ByTimesRedeemed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimesRedeemed, opts...).ToFunc()
+}
+
+// ByRedeemBy orders the results by the redeem_by field.
+func ByRedeemBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeemBy, opts...).ToFunc()
+}
@@ -0,0 +1,1251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coupon
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldName, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCode, v))
+}
+
+// DiscountType applies equality check predicate on the "discount_type" field. It's identical to DiscountTypeEQ.
+func DiscountType(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDiscountType, v))
+}
+
+// PercentOff applies equality check predicate on the "percent_off" field. It's identical to PercentOffEQ.
+func PercentOff(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldPercentOff, v))
+}
+
+// AmountOff applies equality check predicate on the "amount_off" field. It's identical to AmountOffEQ.
+func AmountOff(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldAmountOff, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationInPeriods applies equality check predicate on the "duration_in_periods" field. It's identical to DurationInPeriodsEQ.
+func DurationInPeriods(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDurationInPeriods, v))
+}
+
+// MaxRedemptions applies equality check predicate on the "max_redemptions" field. It's identical to MaxRedemptionsEQ.
+func MaxRedemptions(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldMaxRedemptions, v))
+}
+
+// TimesRedeemed applies equality check predicate on the "times_redeemed" field. It's identical to TimesRedeemedEQ.
+func TimesRedeemed(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldTimesRedeemed, v))
+}
+
+// RedeemBy applies equality check predicate on the "redeem_by" field. It's identical to RedeemByEQ.
+func RedeemBy(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldRedeemBy, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldName, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeIsNil applies the IsNil predicate on the "code" field.
+func CodeIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldCode))
+}
+
+// CodeNotNil applies the NotNil predicate on the "code" field.
+func CodeNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldCode))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldCode, v))
+}
+
+// DiscountTypeEQ applies the EQ predicate on the "discount_type" field.
+func DiscountTypeEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDiscountType, v))
+}
+
+// DiscountTypeNEQ applies the NEQ predicate on the "discount_type" field.
+func DiscountTypeNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldDiscountType, v))
+}
+
+// DiscountTypeIn applies the In predicate on the "discount_type" field.
+func DiscountTypeIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldDiscountType, vs...))
+}
+
+// DiscountTypeNotIn applies the NotIn predicate on the "discount_type" field.
+func DiscountTypeNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldDiscountType, vs...))
+}
+
+// DiscountTypeGT applies the GT predicate on the "discount_type" field.
+func DiscountTypeGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldDiscountType, v))
+}
+
+// DiscountTypeGTE applies the GTE predicate on the "discount_type" field.
+func DiscountTypeGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldDiscountType, v))
+}
+
+// DiscountTypeLT applies the LT predicate on the "discount_type" field.
+func DiscountTypeLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldDiscountType, v))
+}
+
+// DiscountTypeLTE applies the LTE predicate on the "discount_type" field.
+func DiscountTypeLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldDiscountType, v))
+}
+
+// DiscountTypeContains applies the Contains predicate on the "discount_type" field.
+func DiscountTypeContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldDiscountType, v))
+}
+
+// DiscountTypeHasPrefix applies the HasPrefix predicate on the "discount_type" field.
+func DiscountTypeHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldDiscountType, v))
+}
+
+// DiscountTypeHasSuffix applies the HasSuffix predicate on the "discount_type" field.
+func DiscountTypeHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldDiscountType, v))
+}
+
+// DiscountTypeEqualFold applies the EqualFold predicate on the "discount_type" field.
+func DiscountTypeEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldDiscountType, v))
+}
+
+// DiscountTypeContainsFold applies the ContainsFold predicate on the "discount_type" field.
+func DiscountTypeContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldDiscountType, v))
+}
+
+// PercentOffEQ applies the EQ predicate on the "percent_off" field.
+func PercentOffEQ(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldPercentOff, v))
+}
+
+// PercentOffNEQ applies the NEQ predicate on the "percent_off" field.
+func PercentOffNEQ(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldPercentOff, v))
+}
+
+// PercentOffIn applies the In predicate on the "percent_off" field.
+func PercentOffIn(vs ...decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldPercentOff, vs...))
+}
+
+// PercentOffNotIn applies the NotIn predicate on the "percent_off" field.
+func PercentOffNotIn(vs ...decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldPercentOff, vs...))
+}
+
+// PercentOffGT applies the GT predicate on the "percent_off" field.
+func PercentOffGT(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldPercentOff, v))
+}
+
+// PercentOffGTE applies the GTE predicate on the "percent_off" field.
+func PercentOffGTE(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldPercentOff, v))
+}
+
+// PercentOffLT applies the LT predicate on the "percent_off" field.
+func PercentOffLT(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldPercentOff, v))
+}
+
+// PercentOffLTE applies the LTE predicate on the "percent_off" field.
+func PercentOffLTE(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldPercentOff, v))
+}
+
+// PercentOffIsNil applies the IsNil predicate on the "percent_off" field.
+func PercentOffIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldPercentOff))
+}
+
+// PercentOffNotNil applies the NotNil predicate on the "percent_off" field.
+func PercentOffNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldPercentOff))
+}
+
+// AmountOffEQ applies the EQ predicate on the "amount_off" field.
+func AmountOffEQ(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldAmountOff, v))
+}
+
+// AmountOffNEQ applies the NEQ predicate on the "amount_off" field.
+func AmountOffNEQ(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldAmountOff, v))
+}
+
+// AmountOffIn applies the In predicate on the "amount_off" field.
+func AmountOffIn(vs ...decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldAmountOff, vs...))
+}
+
+// AmountOffNotIn applies the NotIn predicate on the "amount_off" field.
+func AmountOffNotIn(vs ...decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldAmountOff, vs...))
+}
+
+// AmountOffGT applies the GT predicate on the "amount_off" field.
+func AmountOffGT(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldAmountOff, v))
+}
+
+// AmountOffGTE applies the GTE predicate on the "amount_off" field.
+func AmountOffGTE(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldAmountOff, v))
+}
+
+// AmountOffLT applies the LT predicate on the "amount_off" field.
+func AmountOffLT(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldAmountOff, v))
+}
+
+// AmountOffLTE applies the LTE predicate on the "amount_off" field.
+func AmountOffLTE(v decimal.Decimal) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldAmountOff, v))
+}
+
+// AmountOffIsNil applies the IsNil predicate on the "amount_off" field.
+func AmountOffIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldAmountOff))
+}
+
+// AmountOffNotNil applies the NotNil predicate on the "amount_off" field.
+func AmountOffNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldAmountOff))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldDuration, v))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldDuration, vs...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldDuration, vs...))
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldDuration, v))
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldDuration, v))
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldDuration, v))
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldDuration, v))
+}
+
+// DurationContains applies the Contains predicate on the "duration" field.
+func DurationContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldDuration, v))
+}
+
+// DurationHasPrefix applies the HasPrefix predicate on the "duration" field.
+func DurationHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldDuration, v))
+}
+
+// DurationHasSuffix applies the HasSuffix predicate on the "duration" field.
+func DurationHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldDuration, v))
+}
+
+// DurationEqualFold applies the EqualFold predicate on the "duration" field.
+func DurationEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldDuration, v))
+}
+
+// DurationContainsFold applies the ContainsFold predicate on the "duration" field.
+func DurationContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldDuration, v))
+}
+
+// DurationInPeriodsEQ applies the EQ predicate on the "duration_in_periods" field.
+func DurationInPeriodsEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDurationInPeriods, v))
+}
+
+// DurationInPeriodsNEQ applies the NEQ predicate on the "duration_in_periods" field.
+func DurationInPeriodsNEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldDurationInPeriods, v))
+}
+
+// DurationInPeriodsIn applies the In predicate on the "duration_in_periods" field.
+func DurationInPeriodsIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldDurationInPeriods, vs...))
+}
+
+// DurationInPeriodsNotIn applies the NotIn predicate on the "duration_in_periods" field.
+func DurationInPeriodsNotIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldDurationInPeriods, vs...))
+}
+
+// DurationInPeriodsGT applies the GT predicate on the "duration_in_periods" field.
+func DurationInPeriodsGT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldDurationInPeriods, v))
+}
+
+// DurationInPeriodsGTE applies the GTE predicate on the "duration_in_periods" field.
+func DurationInPeriodsGTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldDurationInPeriods, v))
+}
+
+// DurationInPeriodsLT applies the LT predicate on the "duration_in_periods" field.
+func DurationInPeriodsLT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldDurationInPeriods, v))
+}
+
+// DurationInPeriodsLTE applies the LTE predicate on the "duration_in_periods" field.
+func DurationInPeriodsLTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldDurationInPeriods, v))
+}
+
+// DurationInPeriodsIsNil applies the IsNil predicate on the "duration_in_periods" field.
+func DurationInPeriodsIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldDurationInPeriods))
+}
+
+// DurationInPeriodsNotNil applies the NotNil predicate on the "duration_in_periods" field.
+func DurationInPeriodsNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldDurationInPeriods))
+}
+
+// MaxRedemptionsEQ applies the EQ predicate on the "max_redemptions" field.
+func MaxRedemptionsEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsNEQ applies the NEQ predicate on the "max_redemptions" field.
+func MaxRedemptionsNEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsIn applies the In predicate on the "max_redemptions" field.
+func MaxRedemptionsIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldMaxRedemptions, vs...))
+}
+
+// MaxRedemptionsNotIn applies the NotIn predicate on the "max_redemptions" field.
+func MaxRedemptionsNotIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldMaxRedemptions, vs...))
+}
+
+// MaxRedemptionsGT applies the GT predicate on the "max_redemptions" field.
+func MaxRedemptionsGT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsGTE applies the GTE predicate on the "max_redemptions" field.
+func MaxRedemptionsGTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsLT applies the LT predicate on the "max_redemptions" field.
+func MaxRedemptionsLT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsLTE applies the LTE predicate on the "max_redemptions" field.
+func MaxRedemptionsLTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsIsNil applies the IsNil predicate on the "max_redemptions" field.
+func MaxRedemptionsIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldMaxRedemptions))
+}
+
+// MaxRedemptionsNotNil applies the NotNil predicate on the "max_redemptions" field.
+func MaxRedemptionsNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldMaxRedemptions))
+}
+
+// TimesRedeemedEQ applies the EQ predicate on the "times_redeemed" field.
+func TimesRedeemedEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldTimesRedeemed, v))
+}
+
+// TimesRedeemedNEQ applies the NEQ predicate on the "times_redeemed" field.
+func TimesRedeemedNEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldTimesRedeemed, v))
+}
+
+// TimesRedeemedIn applies the In predicate on the "times_redeemed" field.
+func TimesRedeemedIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldTimesRedeemed, vs...))
+}
+
+// TimesRedeemedNotIn applies the NotIn predicate on the "times_redeemed" field.
+func TimesRedeemedNotIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldTimesRedeemed, vs...))
+}
+
+// TimesRedeemedGT applies the GT predicate on the "times_redeemed" field.
+func TimesRedeemedGT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldTimesRedeemed, v))
+}
+
+// TimesRedeemedGTE applies the GTE predicate on the "times_redeemed" field.
+func TimesRedeemedGTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldTimesRedeemed, v))
+}
+
+// TimesRedeemedLT applies the LT predicate on the "times_redeemed" field.
+func TimesRedeemedLT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldTimesRedeemed, v))
+}
+
+// TimesRedeemedLTE applies the LTE predicate on the "times_redeemed" field.
+func TimesRedeemedLTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldTimesRedeemed, v))
+}
+
+// RedeemByEQ applies the EQ predicate on the "redeem_by" field.
+func RedeemByEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldRedeemBy, v))
+}
+
+// RedeemByNEQ applies the NEQ predicate on the "redeem_by" field.
+func RedeemByNEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldRedeemBy, v))
+}
+
+// RedeemByIn applies the In predicate on the "redeem_by" field.
+func RedeemByIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldRedeemBy, vs...))
+}
+
+// RedeemByNotIn applies the NotIn predicate on the "redeem_by" field.
+func RedeemByNotIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldRedeemBy, vs...))
+}
+
+// RedeemByGT applies the GT predicate on the "redeem_by" field.
+func RedeemByGT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldRedeemBy, v))
+}
+
+// RedeemByGTE applies the GTE predicate on the "redeem_by" field.
+func RedeemByGTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldRedeemBy, v))
+}
+
+// RedeemByLT applies the LT predicate on the "redeem_by" field.
+func RedeemByLT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldRedeemBy, v))
+}
+
+// RedeemByLTE applies the LTE predicate on the "redeem_by" field.
+func RedeemByLTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldRedeemBy, v))
+}
+
+// RedeemByIsNil applies the IsNil predicate on the "redeem_by" field.
+func RedeemByIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldRedeemBy))
+}
+
+// RedeemByNotNil applies the NotNil predicate on the "redeem_by" field.
+func RedeemByNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldRedeemBy))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldMetadata))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Coupon) predicate.Coupon {
+	return predicate.Coupon(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Coupon) predicate.Coupon {
+	return predicate.Coupon(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Coupon) predicate.Coupon {
+	return predicate.Coupon(sql.NotPredicates(p))
+}
@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/coupon"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CouponUpdate is the builder for updating Coupon entities.
+type CouponUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CouponMutation
+}
+
+// Where appends a list predicates to the CouponUpdate builder.
+func (cu *CouponUpdate) Where(ps ...predicate.Coupon) *CouponUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetStatus sets the "status" field.
+func (cu *CouponUpdate) SetStatus(s string) *CouponUpdate {
+	cu.mutation.SetStatus(s)
+	return cu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableStatus(s *string) *CouponUpdate {
+	if s != nil {
+		cu.SetStatus(*s)
+	}
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CouponUpdate) SetUpdatedAt(t time.Time) *CouponUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cu *CouponUpdate) SetUpdatedBy(s string) *CouponUpdate {
+	cu.mutation.SetUpdatedBy(s)
+	return cu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableUpdatedBy(s *string) *CouponUpdate {
+	if s != nil {
+		cu.SetUpdatedBy(*s)
+	}
+	return cu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (cu *CouponUpdate) ClearUpdatedBy() *CouponUpdate {
+	cu.mutation.ClearUpdatedBy()
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CouponUpdate) SetName(s string) *CouponUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableName(s *string) *CouponUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (cu *CouponUpdate) SetMaxRedemptions(i int) *CouponUpdate {
+	cu.mutation.ResetMaxRedemptions()
+	cu.mutation.SetMaxRedemptions(i)
+	return cu
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableMaxRedemptions(i *int) *CouponUpdate {
+	if i != nil {
+		cu.SetMaxRedemptions(*i)
+	}
+	return cu
+}
+
+// AddMaxRedemptions adds i to the "max_redemptions" field.
+func (cu *CouponUpdate) AddMaxRedemptions(i int) *CouponUpdate {
+	cu.mutation.AddMaxRedemptions(i)
+	return cu
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (cu *CouponUpdate) ClearMaxRedemptions() *CouponUpdate {
+	cu.mutation.ClearMaxRedemptions()
+	return cu
+}
+
+// SetTimesRedeemed sets the "times_redeemed" field.
+func (cu *CouponUpdate) SetTimesRedeemed(i int) *CouponUpdate {
+	cu.mutation.ResetTimesRedeemed()
+	cu.mutation.SetTimesRedeemed(i)
+	return cu
+}
+
+// SetNillableTimesRedeemed sets the "times_redeemed" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableTimesRedeemed(i *int) *CouponUpdate {
+	if i != nil {
+		cu.SetTimesRedeemed(*i)
+	}
+	return cu
+}
+
+// AddTimesRedeemed adds i to the "times_redeemed" field.
+func (cu *CouponUpdate) AddTimesRedeemed(i int) *CouponUpdate {
+	cu.mutation.AddTimesRedeemed(i)
+	return cu
+}
+
+// SetRedeemBy sets the "redeem_by" field.
+func (cu *CouponUpdate) SetRedeemBy(t time.Time) *CouponUpdate {
+	cu.mutation.SetRedeemBy(t)
+	return cu
+}
+
+// SetNillableRedeemBy sets the "redeem_by" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableRedeemBy(t *time.Time) *CouponUpdate {
+	if t != nil {
+		cu.SetRedeemBy(*t)
+	}
+	return cu
+}
+
+// ClearRedeemBy clears the value of the "redeem_by" field.
+func (cu *CouponUpdate) ClearRedeemBy() *CouponUpdate {
+	cu.mutation.ClearRedeemBy()
+	return cu
+}
+
+// SetMetadata sets the "metadata" field.
+func (cu *CouponUpdate) SetMetadata(m map[string]string) *CouponUpdate {
+	cu.mutation.SetMetadata(m)
+	return cu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (cu *CouponUpdate) ClearMetadata() *CouponUpdate {
+	cu.mutation.ClearMetadata()
+	return cu
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (cu *CouponUpdate) Mutation() *CouponMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CouponUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CouponUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CouponUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CouponUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CouponUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := coupon.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CouponUpdate) check() error {
+	if v, ok := cu.mutation.Name(); ok {
+		if err := coupon.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Coupon.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cu *CouponUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupon.Table, coupon.Columns, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeString))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Status(); ok {
+		_spec.SetField(coupon.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(coupon.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cu.mutation.CreatedByCleared() {
+		_spec.ClearField(coupon.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := cu.mutation.UpdatedBy(); ok {
+		_spec.SetField(coupon.FieldUpdatedBy, field.TypeString, value)
+	}
+	if cu.mutation.UpdatedByCleared() {
+		_spec.ClearField(coupon.FieldUpdatedBy, field.TypeString)
+	}
+	if cu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(coupon.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(coupon.FieldName, field.TypeString, value)
+	}
+	if cu.mutation.CodeCleared() {
+		_spec.ClearField(coupon.FieldCode, field.TypeString)
+	}
+	if cu.mutation.PercentOffCleared() {
+		_spec.ClearField(coupon.FieldPercentOff, field.TypeOther)
+	}
+	if cu.mutation.AmountOffCleared() {
+		_spec.ClearField(coupon.FieldAmountOff, field.TypeOther)
+	}
+	if cu.mutation.CurrencyCleared() {
+		_spec.ClearField(coupon.FieldCurrency, field.TypeString)
+	}
+	if cu.mutation.DurationInPeriodsCleared() {
+		_spec.ClearField(coupon.FieldDurationInPeriods, field.TypeInt)
+	}
+	if value, ok := cu.mutation.MaxRedemptions(); ok {
+		_spec.SetField(coupon.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(coupon.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if cu.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(coupon.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := cu.mutation.TimesRedeemed(); ok {
+		_spec.SetField(coupon.FieldTimesRedeemed, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedTimesRedeemed(); ok {
+		_spec.AddField(coupon.FieldTimesRedeemed, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.RedeemBy(); ok {
+		_spec.SetField(coupon.FieldRedeemBy, field.TypeTime, value)
+	}
+	if cu.mutation.RedeemByCleared() {
+		_spec.ClearField(coupon.FieldRedeemBy, field.TypeTime)
+	}
+	if value, ok := cu.mutation.Metadata(); ok {
+		_spec.SetField(coupon.FieldMetadata, field.TypeJSON, value)
+	}
+	if cu.mutation.MetadataCleared() {
+		_spec.ClearField(coupon.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CouponUpdateOne is the builder for updating a single Coupon entity.
+type CouponUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CouponMutation
+}
+
+// SetStatus sets the "status" field.
+func (cuo *CouponUpdateOne) SetStatus(s string) *CouponUpdateOne {
+	cuo.mutation.SetStatus(s)
+	return cuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableStatus(s *string) *CouponUpdateOne {
+	if s != nil {
+		cuo.SetStatus(*s)
+	}
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CouponUpdateOne) SetUpdatedAt(t time.Time) *CouponUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cuo *CouponUpdateOne) SetUpdatedBy(s string) *CouponUpdateOne {
+	cuo.mutation.SetUpdatedBy(s)
+	return cuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableUpdatedBy(s *string) *CouponUpdateOne {
+	if s != nil {
+		cuo.SetUpdatedBy(*s)
+	}
+	return cuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (cuo *CouponUpdateOne) ClearUpdatedBy() *CouponUpdateOne {
+	cuo.mutation.ClearUpdatedBy()
+	return cuo
+}
+
+// SetName sets the "name" field.
+func (cuo *CouponUpdateOne) SetName(s string) *CouponUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableName(s *string) *CouponUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (cuo *CouponUpdateOne) SetMaxRedemptions(i int) *CouponUpdateOne {
+	cuo.mutation.ResetMaxRedemptions()
+	cuo.mutation.SetMaxRedemptions(i)
+	return cuo
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableMaxRedemptions(i *int) *CouponUpdateOne {
+	if i != nil {
+		cuo.SetMaxRedemptions(*i)
+	}
+	return cuo
+}
+
+// AddMaxRedemptions adds i to the "max_redemptions" field.
+func (cuo *CouponUpdateOne) AddMaxRedemptions(i int) *CouponUpdateOne {
+	cuo.mutation.AddMaxRedemptions(i)
+	return cuo
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (cuo *CouponUpdateOne) ClearMaxRedemptions() *CouponUpdateOne {
+	cuo.mutation.ClearMaxRedemptions()
+	return cuo
+}
+
+// SetTimesRedeemed sets the "times_redeemed" field.
+func (cuo *CouponUpdateOne) SetTimesRedeemed(i int) *CouponUpdateOne {
+	cuo.mutation.ResetTimesRedeemed()
+	cuo.mutation.SetTimesRedeemed(i)
+	return cuo
+}
+
+// SetNillableTimesRedeemed sets the "times_redeemed" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableTimesRedeemed(i *int) *CouponUpdateOne {
+	if i != nil {
+		cuo.SetTimesRedeemed(*i)
+	}
+	return cuo
+}
+
+// AddTimesRedeemed adds i to the "times_redeemed" field.
+func (cuo *CouponUpdateOne) AddTimesRedeemed(i int) *CouponUpdateOne {
+	cuo.mutation.AddTimesRedeemed(i)
+	return cuo
+}
+
+// SetRedeemBy sets the "redeem_by" field.
+func (cuo *CouponUpdateOne) SetRedeemBy(t time.Time) *CouponUpdateOne {
+	cuo.mutation.SetRedeemBy(t)
+	return cuo
+}
+
+// SetNillableRedeemBy sets the "redeem_by" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableRedeemBy(t *time.Time) *CouponUpdateOne {
+	if t != nil {
+		cuo.SetRedeemBy(*t)
+	}
+	return cuo
+}
+
+// ClearRedeemBy clears the value of the "redeem_by" field.
+func (cuo *CouponUpdateOne) ClearRedeemBy() *CouponUpdateOne {
+	cuo.mutation.ClearRedeemBy()
+	return cuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (cuo *CouponUpdateOne) SetMetadata(m map[string]string) *CouponUpdateOne {
+	cuo.mutation.SetMetadata(m)
+	return cuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (cuo *CouponUpdateOne) ClearMetadata() *CouponUpdateOne {
+	cuo.mutation.ClearMetadata()
+	return cuo
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (cuo *CouponUpdateOne) Mutation() *CouponMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CouponUpdate builder.
+func (cuo *CouponUpdateOne) Where(ps ...predicate.Coupon) *CouponUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CouponUpdateOne) Select(field string, fields ...string) *CouponUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Coupon entity.
+func (cuo *CouponUpdateOne) Save(ctx context.Context) (*Coupon, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CouponUpdateOne) SaveX(ctx context.Context) *Coupon {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CouponUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CouponUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CouponUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := coupon.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CouponUpdateOne) check() error {
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := coupon.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Coupon.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cuo *CouponUpdateOne) sqlSave(ctx context.Context) (_node *Coupon, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupon.Table, coupon.Columns, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeString))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Coupon.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coupon.FieldID)
+		for _, f := range fields {
+			if !coupon.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coupon.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Status(); ok {
+		_spec.SetField(coupon.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(coupon.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.CreatedByCleared() {
+		_spec.ClearField(coupon.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := cuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(coupon.FieldUpdatedBy, field.TypeString, value)
+	}
+	if cuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(coupon.FieldUpdatedBy, field.TypeString)
+	}
+	if cuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(coupon.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(coupon.FieldName, field.TypeString, value)
+	}
+	if cuo.mutation.CodeCleared() {
+		_spec.ClearField(coupon.FieldCode, field.TypeString)
+	}
+	if cuo.mutation.PercentOffCleared() {
+		_spec.ClearField(coupon.FieldPercentOff, field.TypeOther)
+	}
+	if cuo.mutation.AmountOffCleared() {
+		_spec.ClearField(coupon.FieldAmountOff, field.TypeOther)
+	}
+	if cuo.mutation.CurrencyCleared() {
+		_spec.ClearField(coupon.FieldCurrency, field.TypeString)
+	}
+	if cuo.mutation.DurationInPeriodsCleared() {
+		_spec.ClearField(coupon.FieldDurationInPeriods, field.TypeInt)
+	}
+	if value, ok := cuo.mutation.MaxRedemptions(); ok {
+		_spec.SetField(coupon.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(coupon.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if cuo.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(coupon.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := cuo.mutation.TimesRedeemed(); ok {
+		_spec.SetField(coupon.FieldTimesRedeemed, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedTimesRedeemed(); ok {
+		_spec.AddField(coupon.FieldTimesRedeemed, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.RedeemBy(); ok {
+		_spec.SetField(coupon.FieldRedeemBy, field.TypeTime, value)
+	}
+	if cuo.mutation.RedeemByCleared() {
+		_spec.ClearField(coupon.FieldRedeemBy, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.Metadata(); ok {
+		_spec.SetField(coupon.FieldMetadata, field.TypeJSON, value)
+	}
+	if cuo.mutation.MetadataCleared() {
+		_spec.ClearField(coupon.FieldMetadata, field.TypeJSON)
+	}
+	_node = &Coupon{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
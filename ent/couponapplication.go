@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/couponapplication"
+)
+
+// CouponApplication is the model entity for the CouponApplication schema.
+type CouponApplication struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// CouponID holds the value of the "coupon_id" field.
+	CouponID string `json:"coupon_id,omitempty"`
+	// CustomerID holds the value of the "customer_id" field.
+	CustomerID string `json:"customer_id,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+	// PeriodsApplied holds the value of the "periods_applied" field.
+	PeriodsApplied int `json:"periods_applied,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CouponApplication) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case couponapplication.FieldMetadata:
+			values[i] = new([]byte)
+		case couponapplication.FieldPeriodsApplied:
+			values[i] = new(sql.NullInt64)
+		case couponapplication.FieldID, couponapplication.FieldTenantID, couponapplication.FieldStatus, couponapplication.FieldCreatedBy, couponapplication.FieldUpdatedBy, couponapplication.FieldEnvironmentID, couponapplication.FieldCouponID, couponapplication.FieldCustomerID, couponapplication.FieldSubscriptionID:
+			values[i] = new(sql.NullString)
+		case couponapplication.FieldCreatedAt, couponapplication.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CouponApplication fields.
+func (ca *CouponApplication) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case couponapplication.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ca.ID = value.String
+			}
+		case couponapplication.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ca.TenantID = value.String
+			}
+		case couponapplication.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ca.Status = value.String
+			}
+		case couponapplication.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ca.CreatedAt = value.Time
+			}
+		case couponapplication.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ca.UpdatedAt = value.Time
+			}
+		case couponapplication.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ca.CreatedBy = value.String
+			}
+		case couponapplication.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ca.UpdatedBy = value.String
+			}
+		case couponapplication.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				ca.EnvironmentID = value.String
+			}
+		case couponapplication.FieldCouponID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_id", values[i])
+			} else if value.Valid {
+				ca.CouponID = value.String
+			}
+		case couponapplication.FieldCustomerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value.Valid {
+				ca.CustomerID = value.String
+			}
+		case couponapplication.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				ca.SubscriptionID = new(string)
+				*ca.SubscriptionID = value.String
+			}
+		case couponapplication.FieldPeriodsApplied:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field periods_applied", values[i])
+			} else if value.Valid {
+				ca.PeriodsApplied = int(value.Int64)
+			}
+		case couponapplication.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ca.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			ca.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CouponApplication.
+// This includes values selected through modifiers, order, etc.
+func (ca *CouponApplication) Value(name string) (ent.Value, error) {
+	return ca.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CouponApplication.
+// Note that you need to call CouponApplication.Unwrap() before calling this method if this CouponApplication
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ca *CouponApplication) Update() *CouponApplicationUpdateOne {
+	return NewCouponApplicationClient(ca.config).UpdateOne(ca)
+}
+
+// Unwrap unwraps the CouponApplication entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ca *CouponApplication) Unwrap() *CouponApplication {
+	_tx, ok := ca.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CouponApplication is not a transactional entity")
+	}
+	ca.config.driver = _tx.drv
+	return ca
+}
+
+// String implements the fmt.Stringer.
+func (ca *CouponApplication) String() string {
+	var builder strings.Builder
+	builder.WriteString("CouponApplication(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ca.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(ca.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(ca.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ca.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ca.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ca.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ca.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(ca.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("coupon_id=")
+	builder.WriteString(ca.CouponID)
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(ca.CustomerID)
+	builder.WriteString(", ")
+	if v := ca.SubscriptionID; v != nil {
+		builder.WriteString("subscription_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("periods_applied=")
+	builder.WriteString(fmt.Sprintf("%v", ca.PeriodsApplied))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", ca.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CouponApplications is a parsable slice of CouponApplication.
+type CouponApplications []*CouponApplication
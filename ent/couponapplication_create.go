@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/couponapplication"
+)
+
+// CouponApplicationCreate is the builder for creating a CouponApplication entity.
+type CouponApplicationCreate struct {
+	config
+	mutation *CouponApplicationMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cac *CouponApplicationCreate) SetTenantID(s string) *CouponApplicationCreate {
+	cac.mutation.SetTenantID(s)
+	return cac
+}
+
+// SetStatus sets the "status" field.
+func (cac *CouponApplicationCreate) SetStatus(s string) *CouponApplicationCreate {
+	cac.mutation.SetStatus(s)
+	return cac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cac *CouponApplicationCreate) SetNillableStatus(s *string) *CouponApplicationCreate {
+	if s != nil {
+		cac.SetStatus(*s)
+	}
+	return cac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cac *CouponApplicationCreate) SetCreatedAt(t time.Time) *CouponApplicationCreate {
+	cac.mutation.SetCreatedAt(t)
+	return cac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cac *CouponApplicationCreate) SetNillableCreatedAt(t *time.Time) *CouponApplicationCreate {
+	if t != nil {
+		cac.SetCreatedAt(*t)
+	}
+	return cac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cac *CouponApplicationCreate) SetUpdatedAt(t time.Time) *CouponApplicationCreate {
+	cac.mutation.SetUpdatedAt(t)
+	return cac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cac *CouponApplicationCreate) SetNillableUpdatedAt(t *time.Time) *CouponApplicationCreate {
+	if t != nil {
+		cac.SetUpdatedAt(*t)
+	}
+	return cac
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cac *CouponApplicationCreate) SetCreatedBy(s string) *CouponApplicationCreate {
+	cac.mutation.SetCreatedBy(s)
+	return cac
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cac *CouponApplicationCreate) SetNillableCreatedBy(s *string) *CouponApplicationCreate {
+	if s != nil {
+		cac.SetCreatedBy(*s)
+	}
+	return cac
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cac *CouponApplicationCreate) SetUpdatedBy(s string) *CouponApplicationCreate {
+	cac.mutation.SetUpdatedBy(s)
+	return cac
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cac *CouponApplicationCreate) SetNillableUpdatedBy(s *string) *CouponApplicationCreate {
+	if s != nil {
+		cac.SetUpdatedBy(*s)
+	}
+	return cac
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (cac *CouponApplicationCreate) SetEnvironmentID(s string) *CouponApplicationCreate {
+	cac.mutation.SetEnvironmentID(s)
+	return cac
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (cac *CouponApplicationCreate) SetNillableEnvironmentID(s *string) *CouponApplicationCreate {
+	if s != nil {
+		cac.SetEnvironmentID(*s)
+	}
+	return cac
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (cac *CouponApplicationCreate) SetCouponID(s string) *CouponApplicationCreate {
+	cac.mutation.SetCouponID(s)
+	return cac
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (cac *CouponApplicationCreate) SetCustomerID(s string) *CouponApplicationCreate {
+	cac.mutation.SetCustomerID(s)
+	return cac
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (cac *CouponApplicationCreate) SetSubscriptionID(s string) *CouponApplicationCreate {
+	cac.mutation.SetSubscriptionID(s)
+	return cac
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (cac *CouponApplicationCreate) SetNillableSubscriptionID(s *string) *CouponApplicationCreate {
+	if s != nil {
+		cac.SetSubscriptionID(*s)
+	}
+	return cac
+}
+
+// SetPeriodsApplied sets the "periods_applied" field.
+func (cac *CouponApplicationCreate) SetPeriodsApplied(i int) *CouponApplicationCreate {
+	cac.mutation.SetPeriodsApplied(i)
+	return cac
+}
+
+// SetNillablePeriodsApplied sets the "periods_applied" field if the given value is not nil.
+func (cac *CouponApplicationCreate) SetNillablePeriodsApplied(i *int) *CouponApplicationCreate {
+	if i != nil {
+		cac.SetPeriodsApplied(*i)
+	}
+	return cac
+}
+
+// SetMetadata sets the "metadata" field.
+func (cac *CouponApplicationCreate) SetMetadata(m map[string]string) *CouponApplicationCreate {
+	cac.mutation.SetMetadata(m)
+	return cac
+}
+
+// SetID sets the "id" field.
+func (cac *CouponApplicationCreate) SetID(s string) *CouponApplicationCreate {
+	cac.mutation.SetID(s)
+	return cac
+}
+
+// Mutation returns the CouponApplicationMutation object of the builder.
+func (cac *CouponApplicationCreate) Mutation() *CouponApplicationMutation {
+	return cac.mutation
+}
+
+// Save creates the CouponApplication in the database.
+func (cac *CouponApplicationCreate) Save(ctx context.Context) (*CouponApplication, error) {
+	cac.defaults()
+	return withHooks(ctx, cac.sqlSave, cac.mutation, cac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cac *CouponApplicationCreate) SaveX(ctx context.Context) *CouponApplication {
+	v, err := cac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cac *CouponApplicationCreate) Exec(ctx context.Context) error {
+	_, err := cac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cac *CouponApplicationCreate) ExecX(ctx context.Context) {
+	if err := cac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cac *CouponApplicationCreate) defaults() {
+	if _, ok := cac.mutation.Status(); !ok {
+		v := couponapplication.DefaultStatus
+		cac.mutation.SetStatus(v)
+	}
+	if _, ok := cac.mutation.CreatedAt(); !ok {
+		v := couponapplication.DefaultCreatedAt()
+		cac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cac.mutation.UpdatedAt(); !ok {
+		v := couponapplication.DefaultUpdatedAt()
+		cac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cac.mutation.EnvironmentID(); !ok {
+		v := couponapplication.DefaultEnvironmentID
+		cac.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := cac.mutation.PeriodsApplied(); !ok {
+		v := couponapplication.DefaultPeriodsApplied
+		cac.mutation.SetPeriodsApplied(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cac *CouponApplicationCreate) check() error {
+	if _, ok := cac.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "CouponApplication.tenant_id"`)}
+	}
+	if v, ok := cac.mutation.TenantID(); ok {
+		if err := couponapplication.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "CouponApplication.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CouponApplication.status"`)}
+	}
+	if _, ok := cac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CouponApplication.created_at"`)}
+	}
+	if _, ok := cac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CouponApplication.updated_at"`)}
+	}
+	if _, ok := cac.mutation.CouponID(); !ok {
+		return &ValidationError{Name: "coupon_id", err: errors.New(`ent: missing required field "CouponApplication.coupon_id"`)}
+	}
+	if v, ok := cac.mutation.CouponID(); ok {
+		if err := couponapplication.CouponIDValidator(v); err != nil {
+			return &ValidationError{Name: "coupon_id", err: fmt.Errorf(`ent: validator failed for field "CouponApplication.coupon_id": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "CouponApplication.customer_id"`)}
+	}
+	if v, ok := cac.mutation.CustomerID(); ok {
+		if err := couponapplication.CustomerIDValidator(v); err != nil {
+			return &ValidationError{Name: "customer_id", err: fmt.Errorf(`ent: validator failed for field "CouponApplication.customer_id": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.PeriodsApplied(); !ok {
+		return &ValidationError{Name: "periods_applied", err: errors.New(`ent: missing required field "CouponApplication.periods_applied"`)}
+	}
+	return nil
+}
+
+func (cac *CouponApplicationCreate) sqlSave(ctx context.Context) (*CouponApplication, error) {
+	if err := cac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CouponApplication.ID type: %T", _spec.ID.Value)
+		}
+	}
+	cac.mutation.id = &_node.ID
+	cac.mutation.done = true
+	return _node, nil
+}
+
+func (cac *CouponApplicationCreate) createSpec() (*CouponApplication, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CouponApplication{config: cac.config}
+		_spec = sqlgraph.NewCreateSpec(couponapplication.Table, sqlgraph.NewFieldSpec(couponapplication.FieldID, field.TypeString))
+	)
+	if id, ok := cac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cac.mutation.TenantID(); ok {
+		_spec.SetField(couponapplication.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := cac.mutation.Status(); ok {
+		_spec.SetField(couponapplication.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := cac.mutation.CreatedAt(); ok {
+		_spec.SetField(couponapplication.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cac.mutation.UpdatedAt(); ok {
+		_spec.SetField(couponapplication.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cac.mutation.CreatedBy(); ok {
+		_spec.SetField(couponapplication.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := cac.mutation.UpdatedBy(); ok {
+		_spec.SetField(couponapplication.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := cac.mutation.EnvironmentID(); ok {
+		_spec.SetField(couponapplication.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := cac.mutation.CouponID(); ok {
+		_spec.SetField(couponapplication.FieldCouponID, field.TypeString, value)
+		_node.CouponID = value
+	}
+	if value, ok := cac.mutation.CustomerID(); ok {
+		_spec.SetField(couponapplication.FieldCustomerID, field.TypeString, value)
+		_node.CustomerID = value
+	}
+	if value, ok := cac.mutation.SubscriptionID(); ok {
+		_spec.SetField(couponapplication.FieldSubscriptionID, field.TypeString, value)
+		_node.SubscriptionID = &value
+	}
+	if value, ok := cac.mutation.PeriodsApplied(); ok {
+		_spec.SetField(couponapplication.FieldPeriodsApplied, field.TypeInt, value)
+		_node.PeriodsApplied = value
+	}
+	if value, ok := cac.mutation.Metadata(); ok {
+		_spec.SetField(couponapplication.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	return _node, _spec
+}
+
+// CouponApplicationCreateBulk is the builder for creating many CouponApplication entities in bulk.
+type CouponApplicationCreateBulk struct {
+	config
+	err      error
+	builders []*CouponApplicationCreate
+}
+
+// Save creates the CouponApplication entities in the database.
+func (cacb *CouponApplicationCreateBulk) Save(ctx context.Context) ([]*CouponApplication, error) {
+	if cacb.err != nil {
+		return nil, cacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cacb.builders))
+	nodes := make([]*CouponApplication, len(cacb.builders))
+	mutators := make([]Mutator, len(cacb.builders))
+	for i := range cacb.builders {
+		func(i int, root context.Context) {
+			builder := cacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CouponApplicationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cacb *CouponApplicationCreateBulk) SaveX(ctx context.Context) []*CouponApplication {
+	v, err := cacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cacb *CouponApplicationCreateBulk) Exec(ctx context.Context) error {
+	_, err := cacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cacb *CouponApplicationCreateBulk) ExecX(ctx context.Context) {
+	if err := cacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
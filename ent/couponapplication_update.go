@@ -0,0 +1,406 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/couponapplication"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CouponApplicationUpdate is the builder for updating CouponApplication entities.
+type CouponApplicationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CouponApplicationMutation
+}
+
+// Where appends a list predicates to the CouponApplicationUpdate builder.
+func (cau *CouponApplicationUpdate) Where(ps ...predicate.CouponApplication) *CouponApplicationUpdate {
+	cau.mutation.Where(ps...)
+	return cau
+}
+
+// SetStatus sets the "status" field.
+func (cau *CouponApplicationUpdate) SetStatus(s string) *CouponApplicationUpdate {
+	cau.mutation.SetStatus(s)
+	return cau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cau *CouponApplicationUpdate) SetNillableStatus(s *string) *CouponApplicationUpdate {
+	if s != nil {
+		cau.SetStatus(*s)
+	}
+	return cau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cau *CouponApplicationUpdate) SetUpdatedAt(t time.Time) *CouponApplicationUpdate {
+	cau.mutation.SetUpdatedAt(t)
+	return cau
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cau *CouponApplicationUpdate) SetUpdatedBy(s string) *CouponApplicationUpdate {
+	cau.mutation.SetUpdatedBy(s)
+	return cau
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cau *CouponApplicationUpdate) SetNillableUpdatedBy(s *string) *CouponApplicationUpdate {
+	if s != nil {
+		cau.SetUpdatedBy(*s)
+	}
+	return cau
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (cau *CouponApplicationUpdate) ClearUpdatedBy() *CouponApplicationUpdate {
+	cau.mutation.ClearUpdatedBy()
+	return cau
+}
+
+// SetPeriodsApplied sets the "periods_applied" field.
+func (cau *CouponApplicationUpdate) SetPeriodsApplied(i int) *CouponApplicationUpdate {
+	cau.mutation.ResetPeriodsApplied()
+	cau.mutation.SetPeriodsApplied(i)
+	return cau
+}
+
+// SetNillablePeriodsApplied sets the "periods_applied" field if the given value is not nil.
+func (cau *CouponApplicationUpdate) SetNillablePeriodsApplied(i *int) *CouponApplicationUpdate {
+	if i != nil {
+		cau.SetPeriodsApplied(*i)
+	}
+	return cau
+}
+
+// AddPeriodsApplied adds i to the "periods_applied" field.
+func (cau *CouponApplicationUpdate) AddPeriodsApplied(i int) *CouponApplicationUpdate {
+	cau.mutation.AddPeriodsApplied(i)
+	return cau
+}
+
+// SetMetadata sets the "metadata" field.
+func (cau *CouponApplicationUpdate) SetMetadata(m map[string]string) *CouponApplicationUpdate {
+	cau.mutation.SetMetadata(m)
+	return cau
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (cau *CouponApplicationUpdate) ClearMetadata() *CouponApplicationUpdate {
+	cau.mutation.ClearMetadata()
+	return cau
+}
+
+// Mutation returns the CouponApplicationMutation object of the builder.
+func (cau *CouponApplicationUpdate) Mutation() *CouponApplicationMutation {
+	return cau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cau *CouponApplicationUpdate) Save(ctx context.Context) (int, error) {
+	cau.defaults()
+	return withHooks(ctx, cau.sqlSave, cau.mutation, cau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cau *CouponApplicationUpdate) SaveX(ctx context.Context) int {
+	affected, err := cau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cau *CouponApplicationUpdate) Exec(ctx context.Context) error {
+	_, err := cau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cau *CouponApplicationUpdate) ExecX(ctx context.Context) {
+	if err := cau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cau *CouponApplicationUpdate) defaults() {
+	if _, ok := cau.mutation.UpdatedAt(); !ok {
+		v := couponapplication.UpdateDefaultUpdatedAt()
+		cau.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cau *CouponApplicationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(couponapplication.Table, couponapplication.Columns, sqlgraph.NewFieldSpec(couponapplication.FieldID, field.TypeString))
+	if ps := cau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cau.mutation.Status(); ok {
+		_spec.SetField(couponapplication.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cau.mutation.UpdatedAt(); ok {
+		_spec.SetField(couponapplication.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cau.mutation.CreatedByCleared() {
+		_spec.ClearField(couponapplication.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := cau.mutation.UpdatedBy(); ok {
+		_spec.SetField(couponapplication.FieldUpdatedBy, field.TypeString, value)
+	}
+	if cau.mutation.UpdatedByCleared() {
+		_spec.ClearField(couponapplication.FieldUpdatedBy, field.TypeString)
+	}
+	if cau.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(couponapplication.FieldEnvironmentID, field.TypeString)
+	}
+	if cau.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(couponapplication.FieldSubscriptionID, field.TypeString)
+	}
+	if value, ok := cau.mutation.PeriodsApplied(); ok {
+		_spec.SetField(couponapplication.FieldPeriodsApplied, field.TypeInt, value)
+	}
+	if value, ok := cau.mutation.AddedPeriodsApplied(); ok {
+		_spec.AddField(couponapplication.FieldPeriodsApplied, field.TypeInt, value)
+	}
+	if value, ok := cau.mutation.Metadata(); ok {
+		_spec.SetField(couponapplication.FieldMetadata, field.TypeJSON, value)
+	}
+	if cau.mutation.MetadataCleared() {
+		_spec.ClearField(couponapplication.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{couponapplication.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cau.mutation.done = true
+	return n, nil
+}
+
+// CouponApplicationUpdateOne is the builder for updating a single CouponApplication entity.
+type CouponApplicationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CouponApplicationMutation
+}
+
+// SetStatus sets the "status" field.
+func (cauo *CouponApplicationUpdateOne) SetStatus(s string) *CouponApplicationUpdateOne {
+	cauo.mutation.SetStatus(s)
+	return cauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cauo *CouponApplicationUpdateOne) SetNillableStatus(s *string) *CouponApplicationUpdateOne {
+	if s != nil {
+		cauo.SetStatus(*s)
+	}
+	return cauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cauo *CouponApplicationUpdateOne) SetUpdatedAt(t time.Time) *CouponApplicationUpdateOne {
+	cauo.mutation.SetUpdatedAt(t)
+	return cauo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cauo *CouponApplicationUpdateOne) SetUpdatedBy(s string) *CouponApplicationUpdateOne {
+	cauo.mutation.SetUpdatedBy(s)
+	return cauo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cauo *CouponApplicationUpdateOne) SetNillableUpdatedBy(s *string) *CouponApplicationUpdateOne {
+	if s != nil {
+		cauo.SetUpdatedBy(*s)
+	}
+	return cauo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (cauo *CouponApplicationUpdateOne) ClearUpdatedBy() *CouponApplicationUpdateOne {
+	cauo.mutation.ClearUpdatedBy()
+	return cauo
+}
+
+// SetPeriodsApplied sets the "periods_applied" field.
+func (cauo *CouponApplicationUpdateOne) SetPeriodsApplied(i int) *CouponApplicationUpdateOne {
+	cauo.mutation.ResetPeriodsApplied()
+	cauo.mutation.SetPeriodsApplied(i)
+	return cauo
+}
+
+// SetNillablePeriodsApplied sets the "periods_applied" field if the given value is not nil.
+func (cauo *CouponApplicationUpdateOne) SetNillablePeriodsApplied(i *int) *CouponApplicationUpdateOne {
+	if i != nil {
+		cauo.SetPeriodsApplied(*i)
+	}
+	return cauo
+}
+
+// AddPeriodsApplied adds i to the "periods_applied" field.
+func (cauo *CouponApplicationUpdateOne) AddPeriodsApplied(i int) *CouponApplicationUpdateOne {
+	cauo.mutation.AddPeriodsApplied(i)
+	return cauo
+}
+
+// SetMetadata sets the "metadata" field.
+func (cauo *CouponApplicationUpdateOne) SetMetadata(m map[string]string) *CouponApplicationUpdateOne {
+	cauo.mutation.SetMetadata(m)
+	return cauo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (cauo *CouponApplicationUpdateOne) ClearMetadata() *CouponApplicationUpdateOne {
+	cauo.mutation.ClearMetadata()
+	return cauo
+}
+
+// Mutation returns the CouponApplicationMutation object of the builder.
+func (cauo *CouponApplicationUpdateOne) Mutation() *CouponApplicationMutation {
+	return cauo.mutation
+}
+
+// Where appends a list predicates to the CouponApplicationUpdate builder.
+func (cauo *CouponApplicationUpdateOne) Where(ps ...predicate.CouponApplication) *CouponApplicationUpdateOne {
+	cauo.mutation.Where(ps...)
+	return cauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cauo *CouponApplicationUpdateOne) Select(field string, fields ...string) *CouponApplicationUpdateOne {
+	cauo.fields = append([]string{field}, fields...)
+	return cauo
+}
+
+// Save executes the query and returns the updated CouponApplication entity.
+func (cauo *CouponApplicationUpdateOne) Save(ctx context.Context) (*CouponApplication, error) {
+	cauo.defaults()
+	return withHooks(ctx, cauo.sqlSave, cauo.mutation, cauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cauo *CouponApplicationUpdateOne) SaveX(ctx context.Context) *CouponApplication {
+	node, err := cauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cauo *CouponApplicationUpdateOne) Exec(ctx context.Context) error {
+	_, err := cauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cauo *CouponApplicationUpdateOne) ExecX(ctx context.Context) {
+	if err := cauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cauo *CouponApplicationUpdateOne) defaults() {
+	if _, ok := cauo.mutation.UpdatedAt(); !ok {
+		v := couponapplication.UpdateDefaultUpdatedAt()
+		cauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cauo *CouponApplicationUpdateOne) sqlSave(ctx context.Context) (_node *CouponApplication, err error) {
+	_spec := sqlgraph.NewUpdateSpec(couponapplication.Table, couponapplication.Columns, sqlgraph.NewFieldSpec(couponapplication.FieldID, field.TypeString))
+	id, ok := cauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CouponApplication.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, couponapplication.FieldID)
+		for _, f := range fields {
+			if !couponapplication.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != couponapplication.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cauo.mutation.Status(); ok {
+		_spec.SetField(couponapplication.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(couponapplication.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cauo.mutation.CreatedByCleared() {
+		_spec.ClearField(couponapplication.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := cauo.mutation.UpdatedBy(); ok {
+		_spec.SetField(couponapplication.FieldUpdatedBy, field.TypeString, value)
+	}
+	if cauo.mutation.UpdatedByCleared() {
+		_spec.ClearField(couponapplication.FieldUpdatedBy, field.TypeString)
+	}
+	if cauo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(couponapplication.FieldEnvironmentID, field.TypeString)
+	}
+	if cauo.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(couponapplication.FieldSubscriptionID, field.TypeString)
+	}
+	if value, ok := cauo.mutation.PeriodsApplied(); ok {
+		_spec.SetField(couponapplication.FieldPeriodsApplied, field.TypeInt, value)
+	}
+	if value, ok := cauo.mutation.AddedPeriodsApplied(); ok {
+		_spec.AddField(couponapplication.FieldPeriodsApplied, field.TypeInt, value)
+	}
+	if value, ok := cauo.mutation.Metadata(); ok {
+		_spec.SetField(couponapplication.FieldMetadata, field.TypeJSON, value)
+	}
+	if cauo.mutation.MetadataCleared() {
+		_spec.ClearField(couponapplication.FieldMetadata, field.TypeJSON)
+	}
+	_node = &CouponApplication{config: cauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{couponapplication.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/creditnote"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CreditNoteDelete is the builder for deleting a CreditNote entity.
+type CreditNoteDelete struct {
+	config
+	hooks    []Hook
+	mutation *CreditNoteMutation
+}
+
+// Where appends a list predicates to the CreditNoteDelete builder.
+func (cnd *CreditNoteDelete) Where(ps ...predicate.CreditNote) *CreditNoteDelete {
+	cnd.mutation.Where(ps...)
+	return cnd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cnd *CreditNoteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cnd.sqlExec, cnd.mutation, cnd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cnd *CreditNoteDelete) ExecX(ctx context.Context) int {
+	n, err := cnd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cnd *CreditNoteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(creditnote.Table, sqlgraph.NewFieldSpec(creditnote.FieldID, field.TypeString))
+	if ps := cnd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cnd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cnd.mutation.done = true
+	return affected, err
+}
+
+// CreditNoteDeleteOne is the builder for deleting a single CreditNote entity.
+type CreditNoteDeleteOne struct {
+	cnd *CreditNoteDelete
+}
+
+// Where appends a list predicates to the CreditNoteDelete builder.
+func (cndo *CreditNoteDeleteOne) Where(ps ...predicate.CreditNote) *CreditNoteDeleteOne {
+	cndo.cnd.mutation.Where(ps...)
+	return cndo
+}
+
+// Exec executes the deletion query.
+func (cndo *CreditNoteDeleteOne) Exec(ctx context.Context) error {
+	n, err := cndo.cnd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{creditnote.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cndo *CreditNoteDeleteOne) ExecX(ctx context.Context) {
+	if err := cndo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
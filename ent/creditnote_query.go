@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/creditnote"
+	"github.com/flexprice/flexprice/ent/creditnotelineitem"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CreditNoteQuery is the builder for querying CreditNote entities.
+type CreditNoteQuery struct {
+	config
+	ctx           *QueryContext
+	order         []creditnote.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.CreditNote
+	withLineItems *CreditNoteLineItemQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CreditNoteQuery builder.
+func (cnq *CreditNoteQuery) Where(ps ...predicate.CreditNote) *CreditNoteQuery {
+	cnq.predicates = append(cnq.predicates, ps...)
+	return cnq
+}
+
+// Limit the number of records to be returned by this query.
+func (cnq *CreditNoteQuery) Limit(limit int) *CreditNoteQuery {
+	cnq.ctx.Limit = &limit
+	return cnq
+}
+
+// Offset to start from.
+func (cnq *CreditNoteQuery) Offset(offset int) *CreditNoteQuery {
+	cnq.ctx.Offset = &offset
+	return cnq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cnq *CreditNoteQuery) Unique(unique bool) *CreditNoteQuery {
+	cnq.ctx.Unique = &unique
+	return cnq
+}
+
+// Order specifies how the records should be ordered.
+func (cnq *CreditNoteQuery) Order(o ...creditnote.OrderOption) *CreditNoteQuery {
+	cnq.order = append(cnq.order, o...)
+	return cnq
+}
+
+// QueryLineItems chains the current query on the "line_items" edge.
+func (cnq *CreditNoteQuery) QueryLineItems() *CreditNoteLineItemQuery {
+	query := (&CreditNoteLineItemClient{config: cnq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cnq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cnq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(creditnote.Table, creditnote.FieldID, selector),
+			sqlgraph.To(creditnotelineitem.Table, creditnotelineitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, creditnote.LineItemsTable, creditnote.LineItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cnq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CreditNote entity from the query.
+// Returns a *NotFoundError when no CreditNote was found.
+func (cnq *CreditNoteQuery) First(ctx context.Context) (*CreditNote, error) {
+	nodes, err := cnq.Limit(1).All(setContextOp(ctx, cnq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{creditnote.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cnq *CreditNoteQuery) FirstX(ctx context.Context) *CreditNote {
+	node, err := cnq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CreditNote ID from the query.
+// Returns a *NotFoundError when no CreditNote ID was found.
+func (cnq *CreditNoteQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cnq.Limit(1).IDs(setContextOp(ctx, cnq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{creditnote.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cnq *CreditNoteQuery) FirstIDX(ctx context.Context) string {
+	id, err := cnq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CreditNote entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CreditNote entity is found.
+// Returns a *NotFoundError when no CreditNote entities are found.
+func (cnq *CreditNoteQuery) Only(ctx context.Context) (*CreditNote, error) {
+	nodes, err := cnq.Limit(2).All(setContextOp(ctx, cnq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{creditnote.Label}
+	default:
+		return nil, &NotSingularError{creditnote.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cnq *CreditNoteQuery) OnlyX(ctx context.Context) *CreditNote {
+	node, err := cnq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CreditNote ID in the query.
+// Returns a *NotSingularError when more than one CreditNote ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cnq *CreditNoteQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cnq.Limit(2).IDs(setContextOp(ctx, cnq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{creditnote.Label}
+	default:
+		err = &NotSingularError{creditnote.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cnq *CreditNoteQuery) OnlyIDX(ctx context.Context) string {
+	id, err := cnq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CreditNotes.
+func (cnq *CreditNoteQuery) All(ctx context.Context) ([]*CreditNote, error) {
+	ctx = setContextOp(ctx, cnq.ctx, ent.OpQueryAll)
+	if err := cnq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CreditNote, *CreditNoteQuery]()
+	return withInterceptors[[]*CreditNote](ctx, cnq, qr, cnq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cnq *CreditNoteQuery) AllX(ctx context.Context) []*CreditNote {
+	nodes, err := cnq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CreditNote IDs.
+func (cnq *CreditNoteQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if cnq.ctx.Unique == nil && cnq.path != nil {
+		cnq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cnq.ctx, ent.OpQueryIDs)
+	if err = cnq.Select(creditnote.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cnq *CreditNoteQuery) IDsX(ctx context.Context) []string {
+	ids, err := cnq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cnq *CreditNoteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cnq.ctx, ent.OpQueryCount)
+	if err := cnq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cnq, querierCount[*CreditNoteQuery](), cnq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cnq *CreditNoteQuery) CountX(ctx context.Context) int {
+	count, err := cnq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cnq *CreditNoteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cnq.ctx, ent.OpQueryExist)
+	switch _, err := cnq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cnq *CreditNoteQuery) ExistX(ctx context.Context) bool {
+	exist, err := cnq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CreditNoteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cnq *CreditNoteQuery) Clone() *CreditNoteQuery {
+	if cnq == nil {
+		return nil
+	}
+	return &CreditNoteQuery{
+		config:        cnq.config,
+		ctx:           cnq.ctx.Clone(),
+		order:         append([]creditnote.OrderOption{}, cnq.order...),
+		inters:        append([]Interceptor{}, cnq.inters...),
+		predicates:    append([]predicate.CreditNote{}, cnq.predicates...),
+		withLineItems: cnq.withLineItems.Clone(),
+		// clone intermediate query.
+		sql:  cnq.sql.Clone(),
+		path: cnq.path,
+	}
+}
+
+// WithLineItems tells the query-builder to eager-load the nodes that are connected to
+// the "line_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (cnq *CreditNoteQuery) WithLineItems(opts ...func(*CreditNoteLineItemQuery)) *CreditNoteQuery {
+	query := (&CreditNoteLineItemClient{config: cnq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cnq.withLineItems = query
+	return cnq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CreditNote.Query().
+//		GroupBy(creditnote.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cnq *CreditNoteQuery) GroupBy(field string, fields ...string) *CreditNoteGroupBy {
+	cnq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CreditNoteGroupBy{build: cnq}
+	grbuild.flds = &cnq.ctx.Fields
+	grbuild.label = creditnote.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.CreditNote.Query().
+//		Select(creditnote.FieldTenantID).
+//		Scan(ctx, &v)
+func (cnq *CreditNoteQuery) Select(fields ...string) *CreditNoteSelect {
+	cnq.ctx.Fields = append(cnq.ctx.Fields, fields...)
+	sbuild := &CreditNoteSelect{CreditNoteQuery: cnq}
+	sbuild.label = creditnote.Label
+	sbuild.flds, sbuild.scan = &cnq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CreditNoteSelect configured with the given aggregations.
+func (cnq *CreditNoteQuery) Aggregate(fns ...AggregateFunc) *CreditNoteSelect {
+	return cnq.Select().Aggregate(fns...)
+}
+
+func (cnq *CreditNoteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cnq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cnq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cnq.ctx.Fields {
+		if !creditnote.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cnq.path != nil {
+		prev, err := cnq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cnq.sql = prev
+	}
+	return nil
+}
+
+func (cnq *CreditNoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CreditNote, error) {
+	var (
+		nodes       = []*CreditNote{}
+		_spec       = cnq.querySpec()
+		loadedTypes = [1]bool{
+			cnq.withLineItems != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CreditNote).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CreditNote{config: cnq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cnq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cnq.withLineItems; query != nil {
+		if err := cnq.loadLineItems(ctx, query, nodes,
+			func(n *CreditNote) { n.Edges.LineItems = []*CreditNoteLineItem{} },
+			func(n *CreditNote, e *CreditNoteLineItem) { n.Edges.LineItems = append(n.Edges.LineItems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cnq *CreditNoteQuery) loadLineItems(ctx context.Context, query *CreditNoteLineItemQuery, nodes []*CreditNote, init func(*CreditNote), assign func(*CreditNote, *CreditNoteLineItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*CreditNote)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(creditnotelineitem.FieldCreditNoteID)
+	}
+	query.Where(predicate.CreditNoteLineItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(creditnote.LineItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreditNoteID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "credit_note_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (cnq *CreditNoteQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cnq.querySpec()
+	_spec.Node.Columns = cnq.ctx.Fields
+	if len(cnq.ctx.Fields) > 0 {
+		_spec.Unique = cnq.ctx.Unique != nil && *cnq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cnq.driver, _spec)
+}
+
+func (cnq *CreditNoteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(creditnote.Table, creditnote.Columns, sqlgraph.NewFieldSpec(creditnote.FieldID, field.TypeString))
+	_spec.From = cnq.sql
+	if unique := cnq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cnq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cnq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, creditnote.FieldID)
+		for i := range fields {
+			if fields[i] != creditnote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cnq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cnq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cnq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cnq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cnq *CreditNoteQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cnq.driver.Dialect())
+	t1 := builder.Table(creditnote.Table)
+	columns := cnq.ctx.Fields
+	if len(columns) == 0 {
+		columns = creditnote.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cnq.sql != nil {
+		selector = cnq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cnq.ctx.Unique != nil && *cnq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cnq.predicates {
+		p(selector)
+	}
+	for _, p := range cnq.order {
+		p(selector)
+	}
+	if offset := cnq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cnq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CreditNoteGroupBy is the group-by builder for CreditNote entities.
+type CreditNoteGroupBy struct {
+	selector
+	build *CreditNoteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cngb *CreditNoteGroupBy) Aggregate(fns ...AggregateFunc) *CreditNoteGroupBy {
+	cngb.fns = append(cngb.fns, fns...)
+	return cngb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cngb *CreditNoteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cngb.build.ctx, ent.OpQueryGroupBy)
+	if err := cngb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditNoteQuery, *CreditNoteGroupBy](ctx, cngb.build, cngb, cngb.build.inters, v)
+}
+
+func (cngb *CreditNoteGroupBy) sqlScan(ctx context.Context, root *CreditNoteQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cngb.fns))
+	for _, fn := range cngb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cngb.flds)+len(cngb.fns))
+		for _, f := range *cngb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cngb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cngb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CreditNoteSelect is the builder for selecting fields of CreditNote entities.
+type CreditNoteSelect struct {
+	*CreditNoteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cns *CreditNoteSelect) Aggregate(fns ...AggregateFunc) *CreditNoteSelect {
+	cns.fns = append(cns.fns, fns...)
+	return cns
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cns *CreditNoteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cns.ctx, ent.OpQuerySelect)
+	if err := cns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditNoteQuery, *CreditNoteSelect](ctx, cns.CreditNoteQuery, cns, cns.inters, v)
+}
+
+func (cns *CreditNoteSelect) sqlScan(ctx context.Context, root *CreditNoteQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cns.fns))
+	for _, fn := range cns.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cns.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/creditnotelineitem"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CreditNoteLineItemDelete is the builder for deleting a CreditNoteLineItem entity.
+type CreditNoteLineItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *CreditNoteLineItemMutation
+}
+
+// Where appends a list predicates to the CreditNoteLineItemDelete builder.
+func (cnlid *CreditNoteLineItemDelete) Where(ps ...predicate.CreditNoteLineItem) *CreditNoteLineItemDelete {
+	cnlid.mutation.Where(ps...)
+	return cnlid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cnlid *CreditNoteLineItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cnlid.sqlExec, cnlid.mutation, cnlid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cnlid *CreditNoteLineItemDelete) ExecX(ctx context.Context) int {
+	n, err := cnlid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cnlid *CreditNoteLineItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(creditnotelineitem.Table, sqlgraph.NewFieldSpec(creditnotelineitem.FieldID, field.TypeString))
+	if ps := cnlid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cnlid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cnlid.mutation.done = true
+	return affected, err
+}
+
+// CreditNoteLineItemDeleteOne is the builder for deleting a single CreditNoteLineItem entity.
+type CreditNoteLineItemDeleteOne struct {
+	cnlid *CreditNoteLineItemDelete
+}
+
+// Where appends a list predicates to the CreditNoteLineItemDelete builder.
+func (cnlido *CreditNoteLineItemDeleteOne) Where(ps ...predicate.CreditNoteLineItem) *CreditNoteLineItemDeleteOne {
+	cnlido.cnlid.mutation.Where(ps...)
+	return cnlido
+}
+
+// Exec executes the deletion query.
+func (cnlido *CreditNoteLineItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := cnlido.cnlid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{creditnotelineitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cnlido *CreditNoteLineItemDeleteOne) ExecX(ctx context.Context) {
+	if err := cnlido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/creditnote"
+	"github.com/flexprice/flexprice/ent/creditnotelineitem"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CreditNoteLineItemQuery is the builder for querying CreditNoteLineItem entities.
+type CreditNoteLineItemQuery struct {
+	config
+	ctx            *QueryContext
+	order          []creditnotelineitem.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.CreditNoteLineItem
+	withCreditNote *CreditNoteQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CreditNoteLineItemQuery builder.
+func (cnliq *CreditNoteLineItemQuery) Where(ps ...predicate.CreditNoteLineItem) *CreditNoteLineItemQuery {
+	cnliq.predicates = append(cnliq.predicates, ps...)
+	return cnliq
+}
+
+// Limit the number of records to be returned by this query.
+func (cnliq *CreditNoteLineItemQuery) Limit(limit int) *CreditNoteLineItemQuery {
+	cnliq.ctx.Limit = &limit
+	return cnliq
+}
+
+// Offset to start from.
+func (cnliq *CreditNoteLineItemQuery) Offset(offset int) *CreditNoteLineItemQuery {
+	cnliq.ctx.Offset = &offset
+	return cnliq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cnliq *CreditNoteLineItemQuery) Unique(unique bool) *CreditNoteLineItemQuery {
+	cnliq.ctx.Unique = &unique
+	return cnliq
+}
+
+// Order specifies how the records should be ordered.
+func (cnliq *CreditNoteLineItemQuery) Order(o ...creditnotelineitem.OrderOption) *CreditNoteLineItemQuery {
+	cnliq.order = append(cnliq.order, o...)
+	return cnliq
+}
+
+// QueryCreditNote chains the current query on the "credit_note" edge.
+func (cnliq *CreditNoteLineItemQuery) QueryCreditNote() *CreditNoteQuery {
+	query := (&CreditNoteClient{config: cnliq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cnliq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cnliq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(creditnotelineitem.Table, creditnotelineitem.FieldID, selector),
+			sqlgraph.To(creditnote.Table, creditnote.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, creditnotelineitem.CreditNoteTable, creditnotelineitem.CreditNoteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cnliq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CreditNoteLineItem entity from the query.
+// Returns a *NotFoundError when no CreditNoteLineItem was found.
+func (cnliq *CreditNoteLineItemQuery) First(ctx context.Context) (*CreditNoteLineItem, error) {
+	nodes, err := cnliq.Limit(1).All(setContextOp(ctx, cnliq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{creditnotelineitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cnliq *CreditNoteLineItemQuery) FirstX(ctx context.Context) *CreditNoteLineItem {
+	node, err := cnliq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CreditNoteLineItem ID from the query.
+// Returns a *NotFoundError when no CreditNoteLineItem ID was found.
+func (cnliq *CreditNoteLineItemQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cnliq.Limit(1).IDs(setContextOp(ctx, cnliq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{creditnotelineitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cnliq *CreditNoteLineItemQuery) FirstIDX(ctx context.Context) string {
+	id, err := cnliq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CreditNoteLineItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CreditNoteLineItem entity is found.
+// Returns a *NotFoundError when no CreditNoteLineItem entities are found.
+func (cnliq *CreditNoteLineItemQuery) Only(ctx context.Context) (*CreditNoteLineItem, error) {
+	nodes, err := cnliq.Limit(2).All(setContextOp(ctx, cnliq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{creditnotelineitem.Label}
+	default:
+		return nil, &NotSingularError{creditnotelineitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cnliq *CreditNoteLineItemQuery) OnlyX(ctx context.Context) *CreditNoteLineItem {
+	node, err := cnliq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CreditNoteLineItem ID in the query.
+// Returns a *NotSingularError when more than one CreditNoteLineItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cnliq *CreditNoteLineItemQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cnliq.Limit(2).IDs(setContextOp(ctx, cnliq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{creditnotelineitem.Label}
+	default:
+		err = &NotSingularError{creditnotelineitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cnliq *CreditNoteLineItemQuery) OnlyIDX(ctx context.Context) string {
+	id, err := cnliq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CreditNoteLineItems.
+func (cnliq *CreditNoteLineItemQuery) All(ctx context.Context) ([]*CreditNoteLineItem, error) {
+	ctx = setContextOp(ctx, cnliq.ctx, ent.OpQueryAll)
+	if err := cnliq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CreditNoteLineItem, *CreditNoteLineItemQuery]()
+	return withInterceptors[[]*CreditNoteLineItem](ctx, cnliq, qr, cnliq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cnliq *CreditNoteLineItemQuery) AllX(ctx context.Context) []*CreditNoteLineItem {
+	nodes, err := cnliq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CreditNoteLineItem IDs.
+func (cnliq *CreditNoteLineItemQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if cnliq.ctx.Unique == nil && cnliq.path != nil {
+		cnliq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cnliq.ctx, ent.OpQueryIDs)
+	if err = cnliq.Select(creditnotelineitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cnliq *CreditNoteLineItemQuery) IDsX(ctx context.Context) []string {
+	ids, err := cnliq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cnliq *CreditNoteLineItemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cnliq.ctx, ent.OpQueryCount)
+	if err := cnliq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cnliq, querierCount[*CreditNoteLineItemQuery](), cnliq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cnliq *CreditNoteLineItemQuery) CountX(ctx context.Context) int {
+	count, err := cnliq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cnliq *CreditNoteLineItemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cnliq.ctx, ent.OpQueryExist)
+	switch _, err := cnliq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cnliq *CreditNoteLineItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := cnliq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CreditNoteLineItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cnliq *CreditNoteLineItemQuery) Clone() *CreditNoteLineItemQuery {
+	if cnliq == nil {
+		return nil
+	}
+	return &CreditNoteLineItemQuery{
+		config:         cnliq.config,
+		ctx:            cnliq.ctx.Clone(),
+		order:          append([]creditnotelineitem.OrderOption{}, cnliq.order...),
+		inters:         append([]Interceptor{}, cnliq.inters...),
+		predicates:     append([]predicate.CreditNoteLineItem{}, cnliq.predicates...),
+		withCreditNote: cnliq.withCreditNote.Clone(),
+		// clone intermediate query.
+		sql:  cnliq.sql.Clone(),
+		path: cnliq.path,
+	}
+}
+
+// WithCreditNote tells the query-builder to eager-load the nodes that are connected to
+// the "credit_note" edge. The optional arguments are used to configure the query builder of the edge.
+func (cnliq *CreditNoteLineItemQuery) WithCreditNote(opts ...func(*CreditNoteQuery)) *CreditNoteLineItemQuery {
+	query := (&CreditNoteClient{config: cnliq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cnliq.withCreditNote = query
+	return cnliq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CreditNoteLineItem.Query().
+//		GroupBy(creditnotelineitem.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cnliq *CreditNoteLineItemQuery) GroupBy(field string, fields ...string) *CreditNoteLineItemGroupBy {
+	cnliq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CreditNoteLineItemGroupBy{build: cnliq}
+	grbuild.flds = &cnliq.ctx.Fields
+	grbuild.label = creditnotelineitem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.CreditNoteLineItem.Query().
+//		Select(creditnotelineitem.FieldTenantID).
+//		Scan(ctx, &v)
+func (cnliq *CreditNoteLineItemQuery) Select(fields ...string) *CreditNoteLineItemSelect {
+	cnliq.ctx.Fields = append(cnliq.ctx.Fields, fields...)
+	sbuild := &CreditNoteLineItemSelect{CreditNoteLineItemQuery: cnliq}
+	sbuild.label = creditnotelineitem.Label
+	sbuild.flds, sbuild.scan = &cnliq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CreditNoteLineItemSelect configured with the given aggregations.
+func (cnliq *CreditNoteLineItemQuery) Aggregate(fns ...AggregateFunc) *CreditNoteLineItemSelect {
+	return cnliq.Select().Aggregate(fns...)
+}
+
+func (cnliq *CreditNoteLineItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cnliq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cnliq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cnliq.ctx.Fields {
+		if !creditnotelineitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cnliq.path != nil {
+		prev, err := cnliq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cnliq.sql = prev
+	}
+	return nil
+}
+
+func (cnliq *CreditNoteLineItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CreditNoteLineItem, error) {
+	var (
+		nodes       = []*CreditNoteLineItem{}
+		_spec       = cnliq.querySpec()
+		loadedTypes = [1]bool{
+			cnliq.withCreditNote != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CreditNoteLineItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CreditNoteLineItem{config: cnliq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cnliq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cnliq.withCreditNote; query != nil {
+		if err := cnliq.loadCreditNote(ctx, query, nodes, nil,
+			func(n *CreditNoteLineItem, e *CreditNote) { n.Edges.CreditNote = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cnliq *CreditNoteLineItemQuery) loadCreditNote(ctx context.Context, query *CreditNoteQuery, nodes []*CreditNoteLineItem, init func(*CreditNoteLineItem), assign func(*CreditNoteLineItem, *CreditNote)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*CreditNoteLineItem)
+	for i := range nodes {
+		fk := nodes[i].CreditNoteID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(creditnote.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "credit_note_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cnliq *CreditNoteLineItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cnliq.querySpec()
+	_spec.Node.Columns = cnliq.ctx.Fields
+	if len(cnliq.ctx.Fields) > 0 {
+		_spec.Unique = cnliq.ctx.Unique != nil && *cnliq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cnliq.driver, _spec)
+}
+
+func (cnliq *CreditNoteLineItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(creditnotelineitem.Table, creditnotelineitem.Columns, sqlgraph.NewFieldSpec(creditnotelineitem.FieldID, field.TypeString))
+	_spec.From = cnliq.sql
+	if unique := cnliq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cnliq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cnliq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, creditnotelineitem.FieldID)
+		for i := range fields {
+			if fields[i] != creditnotelineitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cnliq.withCreditNote != nil {
+			_spec.Node.AddColumnOnce(creditnotelineitem.FieldCreditNoteID)
+		}
+	}
+	if ps := cnliq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cnliq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cnliq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cnliq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cnliq *CreditNoteLineItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cnliq.driver.Dialect())
+	t1 := builder.Table(creditnotelineitem.Table)
+	columns := cnliq.ctx.Fields
+	if len(columns) == 0 {
+		columns = creditnotelineitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cnliq.sql != nil {
+		selector = cnliq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cnliq.ctx.Unique != nil && *cnliq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cnliq.predicates {
+		p(selector)
+	}
+	for _, p := range cnliq.order {
+		p(selector)
+	}
+	if offset := cnliq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cnliq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CreditNoteLineItemGroupBy is the group-by builder for CreditNoteLineItem entities.
+type CreditNoteLineItemGroupBy struct {
+	selector
+	build *CreditNoteLineItemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cnligb *CreditNoteLineItemGroupBy) Aggregate(fns ...AggregateFunc) *CreditNoteLineItemGroupBy {
+	cnligb.fns = append(cnligb.fns, fns...)
+	return cnligb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cnligb *CreditNoteLineItemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cnligb.build.ctx, ent.OpQueryGroupBy)
+	if err := cnligb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditNoteLineItemQuery, *CreditNoteLineItemGroupBy](ctx, cnligb.build, cnligb, cnligb.build.inters, v)
+}
+
+func (cnligb *CreditNoteLineItemGroupBy) sqlScan(ctx context.Context, root *CreditNoteLineItemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cnligb.fns))
+	for _, fn := range cnligb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cnligb.flds)+len(cnligb.fns))
+		for _, f := range *cnligb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cnligb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cnligb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CreditNoteLineItemSelect is the builder for selecting fields of CreditNoteLineItem entities.
+type CreditNoteLineItemSelect struct {
+	*CreditNoteLineItemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cnlis *CreditNoteLineItemSelect) Aggregate(fns ...AggregateFunc) *CreditNoteLineItemSelect {
+	cnlis.fns = append(cnlis.fns, fns...)
+	return cnlis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cnlis *CreditNoteLineItemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cnlis.ctx, ent.OpQuerySelect)
+	if err := cnlis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditNoteLineItemQuery, *CreditNoteLineItemSelect](ctx, cnlis.CreditNoteLineItemQuery, cnlis, cnlis.inters, v)
+}
+
+func (cnlis *CreditNoteLineItemSelect) sqlScan(ctx context.Context, root *CreditNoteLineItemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cnlis.fns))
+	for _, fn := range cnlis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cnlis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cnlis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
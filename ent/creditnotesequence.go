@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/creditnotesequence"
+)
+
+// CreditNoteSequence is the model entity for the CreditNoteSequence schema.
+type CreditNoteSequence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// YearMonth holds the value of the "year_month" field.
+	YearMonth string `json:"year_month,omitempty"`
+	// LastValue holds the value of the "last_value" field.
+	LastValue int64 `json:"last_value,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CreditNoteSequence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case creditnotesequence.FieldID, creditnotesequence.FieldLastValue:
+			values[i] = new(sql.NullInt64)
+		case creditnotesequence.FieldTenantID, creditnotesequence.FieldYearMonth:
+			values[i] = new(sql.NullString)
+		case creditnotesequence.FieldCreatedAt, creditnotesequence.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CreditNoteSequence fields.
+func (cns *CreditNoteSequence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case creditnotesequence.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cns.ID = int(value.Int64)
+		case creditnotesequence.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				cns.TenantID = value.String
+			}
+		case creditnotesequence.FieldYearMonth:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field year_month", values[i])
+			} else if value.Valid {
+				cns.YearMonth = value.String
+			}
+		case creditnotesequence.FieldLastValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_value", values[i])
+			} else if value.Valid {
+				cns.LastValue = value.Int64
+			}
+		case creditnotesequence.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cns.CreatedAt = value.Time
+			}
+		case creditnotesequence.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cns.UpdatedAt = value.Time
+			}
+		default:
+			cns.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CreditNoteSequence.
+// This includes values selected through modifiers, order, etc.
+func (cns *CreditNoteSequence) Value(name string) (ent.Value, error) {
+	return cns.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CreditNoteSequence.
+// Note that you need to call CreditNoteSequence.Unwrap() before calling this method if this CreditNoteSequence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cns *CreditNoteSequence) Update() *CreditNoteSequenceUpdateOne {
+	return NewCreditNoteSequenceClient(cns.config).UpdateOne(cns)
+}
+
+// Unwrap unwraps the CreditNoteSequence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cns *CreditNoteSequence) Unwrap() *CreditNoteSequence {
+	_tx, ok := cns.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CreditNoteSequence is not a transactional entity")
+	}
+	cns.config.driver = _tx.drv
+	return cns
+}
+
+// String implements the fmt.Stringer.
+func (cns *CreditNoteSequence) String() string {
+	var builder strings.Builder
+	builder.WriteString("CreditNoteSequence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cns.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(cns.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("year_month=")
+	builder.WriteString(cns.YearMonth)
+	builder.WriteString(", ")
+	builder.WriteString("last_value=")
+	builder.WriteString(fmt.Sprintf("%v", cns.LastValue))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cns.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cns.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CreditNoteSequences is a parsable slice of CreditNoteSequence.
+type CreditNoteSequences []*CreditNoteSequence
@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/creditnotesequence"
+)
+
+// CreditNoteSequenceCreate is the builder for creating a CreditNoteSequence entity.
+type CreditNoteSequenceCreate struct {
+	config
+	mutation *CreditNoteSequenceMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cnsc *CreditNoteSequenceCreate) SetTenantID(s string) *CreditNoteSequenceCreate {
+	cnsc.mutation.SetTenantID(s)
+	return cnsc
+}
+
+// SetYearMonth sets the "year_month" field.
+func (cnsc *CreditNoteSequenceCreate) SetYearMonth(s string) *CreditNoteSequenceCreate {
+	cnsc.mutation.SetYearMonth(s)
+	return cnsc
+}
+
+// SetLastValue sets the "last_value" field.
+func (cnsc *CreditNoteSequenceCreate) SetLastValue(i int64) *CreditNoteSequenceCreate {
+	cnsc.mutation.SetLastValue(i)
+	return cnsc
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (cnsc *CreditNoteSequenceCreate) SetNillableLastValue(i *int64) *CreditNoteSequenceCreate {
+	if i != nil {
+		cnsc.SetLastValue(*i)
+	}
+	return cnsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cnsc *CreditNoteSequenceCreate) SetCreatedAt(t time.Time) *CreditNoteSequenceCreate {
+	cnsc.mutation.SetCreatedAt(t)
+	return cnsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cnsc *CreditNoteSequenceCreate) SetNillableCreatedAt(t *time.Time) *CreditNoteSequenceCreate {
+	if t != nil {
+		cnsc.SetCreatedAt(*t)
+	}
+	return cnsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cnsc *CreditNoteSequenceCreate) SetUpdatedAt(t time.Time) *CreditNoteSequenceCreate {
+	cnsc.mutation.SetUpdatedAt(t)
+	return cnsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cnsc *CreditNoteSequenceCreate) SetNillableUpdatedAt(t *time.Time) *CreditNoteSequenceCreate {
+	if t != nil {
+		cnsc.SetUpdatedAt(*t)
+	}
+	return cnsc
+}
+
+// Mutation returns the CreditNoteSequenceMutation object of the builder.
+func (cnsc *CreditNoteSequenceCreate) Mutation() *CreditNoteSequenceMutation {
+	return cnsc.mutation
+}
+
+// Save creates the CreditNoteSequence in the database.
+func (cnsc *CreditNoteSequenceCreate) Save(ctx context.Context) (*CreditNoteSequence, error) {
+	cnsc.defaults()
+	return withHooks(ctx, cnsc.sqlSave, cnsc.mutation, cnsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cnsc *CreditNoteSequenceCreate) SaveX(ctx context.Context) *CreditNoteSequence {
+	v, err := cnsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cnsc *CreditNoteSequenceCreate) Exec(ctx context.Context) error {
+	_, err := cnsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cnsc *CreditNoteSequenceCreate) ExecX(ctx context.Context) {
+	if err := cnsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cnsc *CreditNoteSequenceCreate) defaults() {
+	if _, ok := cnsc.mutation.LastValue(); !ok {
+		v := creditnotesequence.DefaultLastValue
+		cnsc.mutation.SetLastValue(v)
+	}
+	if _, ok := cnsc.mutation.CreatedAt(); !ok {
+		v := creditnotesequence.DefaultCreatedAt()
+		cnsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cnsc.mutation.UpdatedAt(); !ok {
+		v := creditnotesequence.DefaultUpdatedAt()
+		cnsc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cnsc *CreditNoteSequenceCreate) check() error {
+	if _, ok := cnsc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "CreditNoteSequence.tenant_id"`)}
+	}
+	if v, ok := cnsc.mutation.TenantID(); ok {
+		if err := creditnotesequence.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "CreditNoteSequence.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := cnsc.mutation.YearMonth(); !ok {
+		return &ValidationError{Name: "year_month", err: errors.New(`ent: missing required field "CreditNoteSequence.year_month"`)}
+	}
+	if v, ok := cnsc.mutation.YearMonth(); ok {
+		if err := creditnotesequence.YearMonthValidator(v); err != nil {
+			return &ValidationError{Name: "year_month", err: fmt.Errorf(`ent: validator failed for field "CreditNoteSequence.year_month": %w`, err)}
+		}
+	}
+	if _, ok := cnsc.mutation.LastValue(); !ok {
+		return &ValidationError{Name: "last_value", err: errors.New(`ent: missing required field "CreditNoteSequence.last_value"`)}
+	}
+	if _, ok := cnsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CreditNoteSequence.created_at"`)}
+	}
+	if _, ok := cnsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CreditNoteSequence.updated_at"`)}
+	}
+	return nil
+}
+
+func (cnsc *CreditNoteSequenceCreate) sqlSave(ctx context.Context) (*CreditNoteSequence, error) {
+	if err := cnsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cnsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cnsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cnsc.mutation.id = &_node.ID
+	cnsc.mutation.done = true
+	return _node, nil
+}
+
+func (cnsc *CreditNoteSequenceCreate) createSpec() (*CreditNoteSequence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CreditNoteSequence{config: cnsc.config}
+		_spec = sqlgraph.NewCreateSpec(creditnotesequence.Table, sqlgraph.NewFieldSpec(creditnotesequence.FieldID, field.TypeInt))
+	)
+	if value, ok := cnsc.mutation.TenantID(); ok {
+		_spec.SetField(creditnotesequence.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := cnsc.mutation.YearMonth(); ok {
+		_spec.SetField(creditnotesequence.FieldYearMonth, field.TypeString, value)
+		_node.YearMonth = value
+	}
+	if value, ok := cnsc.mutation.LastValue(); ok {
+		_spec.SetField(creditnotesequence.FieldLastValue, field.TypeInt64, value)
+		_node.LastValue = value
+	}
+	if value, ok := cnsc.mutation.CreatedAt(); ok {
+		_spec.SetField(creditnotesequence.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cnsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(creditnotesequence.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// CreditNoteSequenceCreateBulk is the builder for creating many CreditNoteSequence entities in bulk.
+type CreditNoteSequenceCreateBulk struct {
+	config
+	err      error
+	builders []*CreditNoteSequenceCreate
+}
+
+// Save creates the CreditNoteSequence entities in the database.
+func (cnscb *CreditNoteSequenceCreateBulk) Save(ctx context.Context) ([]*CreditNoteSequence, error) {
+	if cnscb.err != nil {
+		return nil, cnscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cnscb.builders))
+	nodes := make([]*CreditNoteSequence, len(cnscb.builders))
+	mutators := make([]Mutator, len(cnscb.builders))
+	for i := range cnscb.builders {
+		func(i int, root context.Context) {
+			builder := cnscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CreditNoteSequenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cnscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cnscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cnscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cnscb *CreditNoteSequenceCreateBulk) SaveX(ctx context.Context) []*CreditNoteSequence {
+	v, err := cnscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cnscb *CreditNoteSequenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := cnscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cnscb *CreditNoteSequenceCreateBulk) ExecX(ctx context.Context) {
+	if err := cnscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
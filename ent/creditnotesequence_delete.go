@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/creditnotesequence"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CreditNoteSequenceDelete is the builder for deleting a CreditNoteSequence entity.
+type CreditNoteSequenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *CreditNoteSequenceMutation
+}
+
+// Where appends a list predicates to the CreditNoteSequenceDelete builder.
+func (cnsd *CreditNoteSequenceDelete) Where(ps ...predicate.CreditNoteSequence) *CreditNoteSequenceDelete {
+	cnsd.mutation.Where(ps...)
+	return cnsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cnsd *CreditNoteSequenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cnsd.sqlExec, cnsd.mutation, cnsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cnsd *CreditNoteSequenceDelete) ExecX(ctx context.Context) int {
+	n, err := cnsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cnsd *CreditNoteSequenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(creditnotesequence.Table, sqlgraph.NewFieldSpec(creditnotesequence.FieldID, field.TypeInt))
+	if ps := cnsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cnsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cnsd.mutation.done = true
+	return affected, err
+}
+
+// CreditNoteSequenceDeleteOne is the builder for deleting a single CreditNoteSequence entity.
+type CreditNoteSequenceDeleteOne struct {
+	cnsd *CreditNoteSequenceDelete
+}
+
+// Where appends a list predicates to the CreditNoteSequenceDelete builder.
+func (cnsdo *CreditNoteSequenceDeleteOne) Where(ps ...predicate.CreditNoteSequence) *CreditNoteSequenceDeleteOne {
+	cnsdo.cnsd.mutation.Where(ps...)
+	return cnsdo
+}
+
+// Exec executes the deletion query.
+func (cnsdo *CreditNoteSequenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := cnsdo.cnsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{creditnotesequence.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cnsdo *CreditNoteSequenceDeleteOne) ExecX(ctx context.Context) {
+	if err := cnsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/creditnotesequence"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CreditNoteSequenceQuery is the builder for querying CreditNoteSequence entities.
+type CreditNoteSequenceQuery struct {
+	config
+	ctx        *QueryContext
+	order      []creditnotesequence.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CreditNoteSequence
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CreditNoteSequenceQuery builder.
+func (cnsq *CreditNoteSequenceQuery) Where(ps ...predicate.CreditNoteSequence) *CreditNoteSequenceQuery {
+	cnsq.predicates = append(cnsq.predicates, ps...)
+	return cnsq
+}
+
+// Limit the number of records to be returned by this query.
+func (cnsq *CreditNoteSequenceQuery) Limit(limit int) *CreditNoteSequenceQuery {
+	cnsq.ctx.Limit = &limit
+	return cnsq
+}
+
+// Offset to start from.
+func (cnsq *CreditNoteSequenceQuery) Offset(offset int) *CreditNoteSequenceQuery {
+	cnsq.ctx.Offset = &offset
+	return cnsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cnsq *CreditNoteSequenceQuery) Unique(unique bool) *CreditNoteSequenceQuery {
+	cnsq.ctx.Unique = &unique
+	return cnsq
+}
+
+// Order specifies how the records should be ordered.
+func (cnsq *CreditNoteSequenceQuery) Order(o ...creditnotesequence.OrderOption) *CreditNoteSequenceQuery {
+	cnsq.order = append(cnsq.order, o...)
+	return cnsq
+}
+
+// First returns the first CreditNoteSequence entity from the query.
+// Returns a *NotFoundError when no CreditNoteSequence was found.
+func (cnsq *CreditNoteSequenceQuery) First(ctx context.Context) (*CreditNoteSequence, error) {
+	nodes, err := cnsq.Limit(1).All(setContextOp(ctx, cnsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{creditnotesequence.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cnsq *CreditNoteSequenceQuery) FirstX(ctx context.Context) *CreditNoteSequence {
+	node, err := cnsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CreditNoteSequence ID from the query.
+// Returns a *NotFoundError when no CreditNoteSequence ID was found.
+func (cnsq *CreditNoteSequenceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cnsq.Limit(1).IDs(setContextOp(ctx, cnsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{creditnotesequence.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cnsq *CreditNoteSequenceQuery) FirstIDX(ctx context.Context) int {
+	id, err := cnsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CreditNoteSequence entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CreditNoteSequence entity is found.
+// Returns a *NotFoundError when no CreditNoteSequence entities are found.
+func (cnsq *CreditNoteSequenceQuery) Only(ctx context.Context) (*CreditNoteSequence, error) {
+	nodes, err := cnsq.Limit(2).All(setContextOp(ctx, cnsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{creditnotesequence.Label}
+	default:
+		return nil, &NotSingularError{creditnotesequence.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cnsq *CreditNoteSequenceQuery) OnlyX(ctx context.Context) *CreditNoteSequence {
+	node, err := cnsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CreditNoteSequence ID in the query.
+// Returns a *NotSingularError when more than one CreditNoteSequence ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cnsq *CreditNoteSequenceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cnsq.Limit(2).IDs(setContextOp(ctx, cnsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{creditnotesequence.Label}
+	default:
+		err = &NotSingularError{creditnotesequence.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cnsq *CreditNoteSequenceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cnsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CreditNoteSequences.
+func (cnsq *CreditNoteSequenceQuery) All(ctx context.Context) ([]*CreditNoteSequence, error) {
+	ctx = setContextOp(ctx, cnsq.ctx, ent.OpQueryAll)
+	if err := cnsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CreditNoteSequence, *CreditNoteSequenceQuery]()
+	return withInterceptors[[]*CreditNoteSequence](ctx, cnsq, qr, cnsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cnsq *CreditNoteSequenceQuery) AllX(ctx context.Context) []*CreditNoteSequence {
+	nodes, err := cnsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CreditNoteSequence IDs.
+func (cnsq *CreditNoteSequenceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if cnsq.ctx.Unique == nil && cnsq.path != nil {
+		cnsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cnsq.ctx, ent.OpQueryIDs)
+	if err = cnsq.Select(creditnotesequence.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cnsq *CreditNoteSequenceQuery) IDsX(ctx context.Context) []int {
+	ids, err := cnsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cnsq *CreditNoteSequenceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cnsq.ctx, ent.OpQueryCount)
+	if err := cnsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cnsq, querierCount[*CreditNoteSequenceQuery](), cnsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cnsq *CreditNoteSequenceQuery) CountX(ctx context.Context) int {
+	count, err := cnsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cnsq *CreditNoteSequenceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cnsq.ctx, ent.OpQueryExist)
+	switch _, err := cnsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cnsq *CreditNoteSequenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := cnsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CreditNoteSequenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cnsq *CreditNoteSequenceQuery) Clone() *CreditNoteSequenceQuery {
+	if cnsq == nil {
+		return nil
+	}
+	return &CreditNoteSequenceQuery{
+		config:     cnsq.config,
+		ctx:        cnsq.ctx.Clone(),
+		order:      append([]creditnotesequence.OrderOption{}, cnsq.order...),
+		inters:     append([]Interceptor{}, cnsq.inters...),
+		predicates: append([]predicate.CreditNoteSequence{}, cnsq.predicates...),
+		// clone intermediate query.
+		sql:  cnsq.sql.Clone(),
+		path: cnsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CreditNoteSequence.Query().
+//		GroupBy(creditnotesequence.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cnsq *CreditNoteSequenceQuery) GroupBy(field string, fields ...string) *CreditNoteSequenceGroupBy {
+	cnsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CreditNoteSequenceGroupBy{build: cnsq}
+	grbuild.flds = &cnsq.ctx.Fields
+	grbuild.label = creditnotesequence.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.CreditNoteSequence.Query().
+//		Select(creditnotesequence.FieldTenantID).
+//		Scan(ctx, &v)
+func (cnsq *CreditNoteSequenceQuery) Select(fields ...string) *CreditNoteSequenceSelect {
+	cnsq.ctx.Fields = append(cnsq.ctx.Fields, fields...)
+	sbuild := &CreditNoteSequenceSelect{CreditNoteSequenceQuery: cnsq}
+	sbuild.label = creditnotesequence.Label
+	sbuild.flds, sbuild.scan = &cnsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CreditNoteSequenceSelect configured with the given aggregations.
+func (cnsq *CreditNoteSequenceQuery) Aggregate(fns ...AggregateFunc) *CreditNoteSequenceSelect {
+	return cnsq.Select().Aggregate(fns...)
+}
+
+func (cnsq *CreditNoteSequenceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cnsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cnsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cnsq.ctx.Fields {
+		if !creditnotesequence.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cnsq.path != nil {
+		prev, err := cnsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cnsq.sql = prev
+	}
+	return nil
+}
+
+func (cnsq *CreditNoteSequenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CreditNoteSequence, error) {
+	var (
+		nodes = []*CreditNoteSequence{}
+		_spec = cnsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CreditNoteSequence).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CreditNoteSequence{config: cnsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cnsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (cnsq *CreditNoteSequenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cnsq.querySpec()
+	_spec.Node.Columns = cnsq.ctx.Fields
+	if len(cnsq.ctx.Fields) > 0 {
+		_spec.Unique = cnsq.ctx.Unique != nil && *cnsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cnsq.driver, _spec)
+}
+
+func (cnsq *CreditNoteSequenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(creditnotesequence.Table, creditnotesequence.Columns, sqlgraph.NewFieldSpec(creditnotesequence.FieldID, field.TypeInt))
+	_spec.From = cnsq.sql
+	if unique := cnsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cnsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cnsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, creditnotesequence.FieldID)
+		for i := range fields {
+			if fields[i] != creditnotesequence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cnsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cnsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cnsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cnsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cnsq *CreditNoteSequenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cnsq.driver.Dialect())
+	t1 := builder.Table(creditnotesequence.Table)
+	columns := cnsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = creditnotesequence.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cnsq.sql != nil {
+		selector = cnsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cnsq.ctx.Unique != nil && *cnsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cnsq.predicates {
+		p(selector)
+	}
+	for _, p := range cnsq.order {
+		p(selector)
+	}
+	if offset := cnsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cnsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CreditNoteSequenceGroupBy is the group-by builder for CreditNoteSequence entities.
+type CreditNoteSequenceGroupBy struct {
+	selector
+	build *CreditNoteSequenceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cnsgb *CreditNoteSequenceGroupBy) Aggregate(fns ...AggregateFunc) *CreditNoteSequenceGroupBy {
+	cnsgb.fns = append(cnsgb.fns, fns...)
+	return cnsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cnsgb *CreditNoteSequenceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cnsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cnsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditNoteSequenceQuery, *CreditNoteSequenceGroupBy](ctx, cnsgb.build, cnsgb, cnsgb.build.inters, v)
+}
+
+func (cnsgb *CreditNoteSequenceGroupBy) sqlScan(ctx context.Context, root *CreditNoteSequenceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cnsgb.fns))
+	for _, fn := range cnsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cnsgb.flds)+len(cnsgb.fns))
+		for _, f := range *cnsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cnsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cnsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CreditNoteSequenceSelect is the builder for selecting fields of CreditNoteSequence entities.
+type CreditNoteSequenceSelect struct {
+	*CreditNoteSequenceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cnss *CreditNoteSequenceSelect) Aggregate(fns ...AggregateFunc) *CreditNoteSequenceSelect {
+	cnss.fns = append(cnss.fns, fns...)
+	return cnss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cnss *CreditNoteSequenceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cnss.ctx, ent.OpQuerySelect)
+	if err := cnss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditNoteSequenceQuery, *CreditNoteSequenceSelect](ctx, cnss.CreditNoteSequenceQuery, cnss, cnss.inters, v)
+}
+
+func (cnss *CreditNoteSequenceSelect) sqlScan(ctx context.Context, root *CreditNoteSequenceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cnss.fns))
+	for _, fn := range cnss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cnss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cnss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
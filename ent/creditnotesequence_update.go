@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/creditnotesequence"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CreditNoteSequenceUpdate is the builder for updating CreditNoteSequence entities.
+type CreditNoteSequenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CreditNoteSequenceMutation
+}
+
+// Where appends a list predicates to the CreditNoteSequenceUpdate builder.
+func (cnsu *CreditNoteSequenceUpdate) Where(ps ...predicate.CreditNoteSequence) *CreditNoteSequenceUpdate {
+	cnsu.mutation.Where(ps...)
+	return cnsu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cnsu *CreditNoteSequenceUpdate) SetTenantID(s string) *CreditNoteSequenceUpdate {
+	cnsu.mutation.SetTenantID(s)
+	return cnsu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (cnsu *CreditNoteSequenceUpdate) SetNillableTenantID(s *string) *CreditNoteSequenceUpdate {
+	if s != nil {
+		cnsu.SetTenantID(*s)
+	}
+	return cnsu
+}
+
+// SetYearMonth sets the "year_month" field.
+func (cnsu *CreditNoteSequenceUpdate) SetYearMonth(s string) *CreditNoteSequenceUpdate {
+	cnsu.mutation.SetYearMonth(s)
+	return cnsu
+}
+
+// SetNillableYearMonth sets the "year_month" field if the given value is not nil.
+func (cnsu *CreditNoteSequenceUpdate) SetNillableYearMonth(s *string) *CreditNoteSequenceUpdate {
+	if s != nil {
+		cnsu.SetYearMonth(*s)
+	}
+	return cnsu
+}
+
+// SetLastValue sets the "last_value" field.
+func (cnsu *CreditNoteSequenceUpdate) SetLastValue(i int64) *CreditNoteSequenceUpdate {
+	cnsu.mutation.ResetLastValue()
+	cnsu.mutation.SetLastValue(i)
+	return cnsu
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (cnsu *CreditNoteSequenceUpdate) SetNillableLastValue(i *int64) *CreditNoteSequenceUpdate {
+	if i != nil {
+		cnsu.SetLastValue(*i)
+	}
+	return cnsu
+}
+
+// AddLastValue adds i to the "last_value" field.
+func (cnsu *CreditNoteSequenceUpdate) AddLastValue(i int64) *CreditNoteSequenceUpdate {
+	cnsu.mutation.AddLastValue(i)
+	return cnsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cnsu *CreditNoteSequenceUpdate) SetUpdatedAt(t time.Time) *CreditNoteSequenceUpdate {
+	cnsu.mutation.SetUpdatedAt(t)
+	return cnsu
+}
+
+// Mutation returns the CreditNoteSequenceMutation object of the builder.
+func (cnsu *CreditNoteSequenceUpdate) Mutation() *CreditNoteSequenceMutation {
+	return cnsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cnsu *CreditNoteSequenceUpdate) Save(ctx context.Context) (int, error) {
+	cnsu.defaults()
+	return withHooks(ctx, cnsu.sqlSave, cnsu.mutation, cnsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cnsu *CreditNoteSequenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := cnsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cnsu *CreditNoteSequenceUpdate) Exec(ctx context.Context) error {
+	_, err := cnsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cnsu *CreditNoteSequenceUpdate) ExecX(ctx context.Context) {
+	if err := cnsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cnsu *CreditNoteSequenceUpdate) defaults() {
+	if _, ok := cnsu.mutation.UpdatedAt(); !ok {
+		v := creditnotesequence.UpdateDefaultUpdatedAt()
+		cnsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cnsu *CreditNoteSequenceUpdate) check() error {
+	if v, ok := cnsu.mutation.TenantID(); ok {
+		if err := creditnotesequence.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "CreditNoteSequence.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := cnsu.mutation.YearMonth(); ok {
+		if err := creditnotesequence.YearMonthValidator(v); err != nil {
+			return &ValidationError{Name: "year_month", err: fmt.Errorf(`ent: validator failed for field "CreditNoteSequence.year_month": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cnsu *CreditNoteSequenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cnsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(creditnotesequence.Table, creditnotesequence.Columns, sqlgraph.NewFieldSpec(creditnotesequence.FieldID, field.TypeInt))
+	if ps := cnsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cnsu.mutation.TenantID(); ok {
+		_spec.SetField(creditnotesequence.FieldTenantID, field.TypeString, value)
+	}
+	if value, ok := cnsu.mutation.YearMonth(); ok {
+		_spec.SetField(creditnotesequence.FieldYearMonth, field.TypeString, value)
+	}
+	if value, ok := cnsu.mutation.LastValue(); ok {
+		_spec.SetField(creditnotesequence.FieldLastValue, field.TypeInt64, value)
+	}
+	if value, ok := cnsu.mutation.AddedLastValue(); ok {
+		_spec.AddField(creditnotesequence.FieldLastValue, field.TypeInt64, value)
+	}
+	if value, ok := cnsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(creditnotesequence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cnsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{creditnotesequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cnsu.mutation.done = true
+	return n, nil
+}
+
+// CreditNoteSequenceUpdateOne is the builder for updating a single CreditNoteSequence entity.
+type CreditNoteSequenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CreditNoteSequenceMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cnsuo *CreditNoteSequenceUpdateOne) SetTenantID(s string) *CreditNoteSequenceUpdateOne {
+	cnsuo.mutation.SetTenantID(s)
+	return cnsuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (cnsuo *CreditNoteSequenceUpdateOne) SetNillableTenantID(s *string) *CreditNoteSequenceUpdateOne {
+	if s != nil {
+		cnsuo.SetTenantID(*s)
+	}
+	return cnsuo
+}
+
+// SetYearMonth sets the "year_month" field.
+func (cnsuo *CreditNoteSequenceUpdateOne) SetYearMonth(s string) *CreditNoteSequenceUpdateOne {
+	cnsuo.mutation.SetYearMonth(s)
+	return cnsuo
+}
+
+// SetNillableYearMonth sets the "year_month" field if the given value is not nil.
+func (cnsuo *CreditNoteSequenceUpdateOne) SetNillableYearMonth(s *string) *CreditNoteSequenceUpdateOne {
+	if s != nil {
+		cnsuo.SetYearMonth(*s)
+	}
+	return cnsuo
+}
+
+// SetLastValue sets the "last_value" field.
+func (cnsuo *CreditNoteSequenceUpdateOne) SetLastValue(i int64) *CreditNoteSequenceUpdateOne {
+	cnsuo.mutation.ResetLastValue()
+	cnsuo.mutation.SetLastValue(i)
+	return cnsuo
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (cnsuo *CreditNoteSequenceUpdateOne) SetNillableLastValue(i *int64) *CreditNoteSequenceUpdateOne {
+	if i != nil {
+		cnsuo.SetLastValue(*i)
+	}
+	return cnsuo
+}
+
+// AddLastValue adds i to the "last_value" field.
+func (cnsuo *CreditNoteSequenceUpdateOne) AddLastValue(i int64) *CreditNoteSequenceUpdateOne {
+	cnsuo.mutation.AddLastValue(i)
+	return cnsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cnsuo *CreditNoteSequenceUpdateOne) SetUpdatedAt(t time.Time) *CreditNoteSequenceUpdateOne {
+	cnsuo.mutation.SetUpdatedAt(t)
+	return cnsuo
+}
+
+// Mutation returns the CreditNoteSequenceMutation object of the builder.
+func (cnsuo *CreditNoteSequenceUpdateOne) Mutation() *CreditNoteSequenceMutation {
+	return cnsuo.mutation
+}
+
+// Where appends a list predicates to the CreditNoteSequenceUpdate builder.
+func (cnsuo *CreditNoteSequenceUpdateOne) Where(ps ...predicate.CreditNoteSequence) *CreditNoteSequenceUpdateOne {
+	cnsuo.mutation.Where(ps...)
+	return cnsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cnsuo *CreditNoteSequenceUpdateOne) Select(field string, fields ...string) *CreditNoteSequenceUpdateOne {
+	cnsuo.fields = append([]string{field}, fields...)
+	return cnsuo
+}
+
+// Save executes the query and returns the updated CreditNoteSequence entity.
+func (cnsuo *CreditNoteSequenceUpdateOne) Save(ctx context.Context) (*CreditNoteSequence, error) {
+	cnsuo.defaults()
+	return withHooks(ctx, cnsuo.sqlSave, cnsuo.mutation, cnsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cnsuo *CreditNoteSequenceUpdateOne) SaveX(ctx context.Context) *CreditNoteSequence {
+	node, err := cnsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cnsuo *CreditNoteSequenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := cnsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cnsuo *CreditNoteSequenceUpdateOne) ExecX(ctx context.Context) {
+	if err := cnsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cnsuo *CreditNoteSequenceUpdateOne) defaults() {
+	if _, ok := cnsuo.mutation.UpdatedAt(); !ok {
+		v := creditnotesequence.UpdateDefaultUpdatedAt()
+		cnsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cnsuo *CreditNoteSequenceUpdateOne) check() error {
+	if v, ok := cnsuo.mutation.TenantID(); ok {
+		if err := creditnotesequence.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "CreditNoteSequence.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := cnsuo.mutation.YearMonth(); ok {
+		if err := creditnotesequence.YearMonthValidator(v); err != nil {
+			return &ValidationError{Name: "year_month", err: fmt.Errorf(`ent: validator failed for field "CreditNoteSequence.year_month": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cnsuo *CreditNoteSequenceUpdateOne) sqlSave(ctx context.Context) (_node *CreditNoteSequence, err error) {
+	if err := cnsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(creditnotesequence.Table, creditnotesequence.Columns, sqlgraph.NewFieldSpec(creditnotesequence.FieldID, field.TypeInt))
+	id, ok := cnsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CreditNoteSequence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cnsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, creditnotesequence.FieldID)
+		for _, f := range fields {
+			if !creditnotesequence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != creditnotesequence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cnsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cnsuo.mutation.TenantID(); ok {
+		_spec.SetField(creditnotesequence.FieldTenantID, field.TypeString, value)
+	}
+	if value, ok := cnsuo.mutation.YearMonth(); ok {
+		_spec.SetField(creditnotesequence.FieldYearMonth, field.TypeString, value)
+	}
+	if value, ok := cnsuo.mutation.LastValue(); ok {
+		_spec.SetField(creditnotesequence.FieldLastValue, field.TypeInt64, value)
+	}
+	if value, ok := cnsuo.mutation.AddedLastValue(); ok {
+		_spec.AddField(creditnotesequence.FieldLastValue, field.TypeInt64, value)
+	}
+	if value, ok := cnsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(creditnotesequence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &CreditNoteSequence{config: cnsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cnsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{creditnotesequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cnsuo.mutation.done = true
+	return _node, nil
+}
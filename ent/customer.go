@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/customer"
+	"github.com/flexprice/flexprice/ent/schema"
 )
 
 // Customer is the model entity for the Customer schema.
@@ -50,6 +51,12 @@ type Customer struct {
 	AddressPostalCode string `json:"address_postal_code,omitempty"`
 	// AddressCountry holds the value of the "address_country" field.
 	AddressCountry string `json:"address_country,omitempty"`
+	// TaxIds holds the value of the "tax_ids" field.
+	TaxIds []schema.CustomerTaxID `json:"tax_ids,omitempty"`
+	// TaxExempt holds the value of the "tax_exempt" field.
+	TaxExempt bool `json:"tax_exempt,omitempty"`
+	// AutoApplyCredits holds the value of the "auto_apply_credits" field.
+	AutoApplyCredits bool `json:"auto_apply_credits,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata     map[string]string `json:"metadata,omitempty"`
 	selectValues sql.SelectValues
@@ -60,8 +67,10 @@ func (*Customer) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case customer.FieldMetadata:
+		case customer.FieldTaxIds, customer.FieldMetadata:
 			values[i] = new([]byte)
+		case customer.FieldTaxExempt, customer.FieldAutoApplyCredits:
+			values[i] = new(sql.NullBool)
 		case customer.FieldID, customer.FieldTenantID, customer.FieldStatus, customer.FieldCreatedBy, customer.FieldUpdatedBy, customer.FieldEnvironmentID, customer.FieldExternalID, customer.FieldName, customer.FieldEmail, customer.FieldAddressLine1, customer.FieldAddressLine2, customer.FieldAddressCity, customer.FieldAddressState, customer.FieldAddressPostalCode, customer.FieldAddressCountry:
 			values[i] = new(sql.NullString)
 		case customer.FieldCreatedAt, customer.FieldUpdatedAt:
@@ -183,6 +192,26 @@ func (c *Customer) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.AddressCountry = value.String
 			}
+		case customer.FieldTaxIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.TaxIds); err != nil {
+					return fmt.Errorf("unmarshal field tax_ids: %w", err)
+				}
+			}
+		case customer.FieldTaxExempt:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_exempt", values[i])
+			} else if value.Valid {
+				c.TaxExempt = value.Bool
+			}
+		case customer.FieldAutoApplyCredits:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_apply_credits", values[i])
+			} else if value.Valid {
+				c.AutoApplyCredits = value.Bool
+			}
 		case customer.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -275,6 +304,15 @@ func (c *Customer) String() string {
 	builder.WriteString("address_country=")
 	builder.WriteString(c.AddressCountry)
 	builder.WriteString(", ")
+	builder.WriteString("tax_ids=")
+	builder.WriteString(fmt.Sprintf("%v", c.TaxIds))
+	builder.WriteString(", ")
+	builder.WriteString("tax_exempt=")
+	builder.WriteString(fmt.Sprintf("%v", c.TaxExempt))
+	builder.WriteString(", ")
+	builder.WriteString("auto_apply_credits=")
+	builder.WriteString(fmt.Sprintf("%v", c.AutoApplyCredits))
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", c.Metadata))
 	builder.WriteByte(')')
@@ -45,6 +45,12 @@ const (
 	FieldAddressPostalCode = "address_postal_code"
 	// FieldAddressCountry holds the string denoting the address_country field in the database.
 	FieldAddressCountry = "address_country"
+	// FieldTaxIds holds the string denoting the tax_ids field in the database.
+	FieldTaxIds = "tax_ids"
+	// FieldTaxExempt holds the string denoting the tax_exempt field in the database.
+	FieldTaxExempt = "tax_exempt"
+	// FieldAutoApplyCredits holds the string denoting the auto_apply_credits field in the database.
+	FieldAutoApplyCredits = "auto_apply_credits"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// Table holds the table name of the customer in the database.
@@ -70,6 +76,9 @@ var Columns = []string{
 	FieldAddressState,
 	FieldAddressPostalCode,
 	FieldAddressCountry,
+	FieldTaxIds,
+	FieldTaxExempt,
+	FieldAutoApplyCredits,
 	FieldMetadata,
 }
 
@@ -100,6 +109,10 @@ var (
 	ExternalIDValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultTaxExempt holds the default value on creation for the "tax_exempt" field.
+	DefaultTaxExempt bool
+	// DefaultAutoApplyCredits holds the default value on creation for the "auto_apply_credits" field.
+	DefaultAutoApplyCredits bool
 )
 
 // OrderOption defines the ordering options for the Customer queries.
@@ -189,3 +202,13 @@ func ByAddressPostalCode(opts ...sql.OrderTermOption) OrderOption {
 func ByAddressCountry(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAddressCountry, opts...).ToFunc()
 }
+
+// ByTaxExempt orders the results by the tax_exempt field.
+func ByTaxExempt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxExempt, opts...).ToFunc()
+}
+
+// ByAutoApplyCredits orders the results by the auto_apply_credits field.
+func ByAutoApplyCredits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoApplyCredits, opts...).ToFunc()
+}
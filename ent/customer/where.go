@@ -144,6 +144,16 @@ func AddressCountry(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldAddressCountry, v))
 }
 
+// TaxExempt applies equality check predicate on the "tax_exempt" field. It's identical to TaxExemptEQ.
+func TaxExempt(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldTaxExempt, v))
+}
+
+// AutoApplyCredits applies equality check predicate on the "auto_apply_credits" field. It's identical to AutoApplyCreditsEQ.
+func AutoApplyCredits(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldAutoApplyCredits, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldTenantID, v))
@@ -1234,6 +1244,36 @@ func AddressCountryContainsFold(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldContainsFold(FieldAddressCountry, v))
 }
 
+// TaxIdsIsNil applies the IsNil predicate on the "tax_ids" field.
+func TaxIdsIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldTaxIds))
+}
+
+// TaxIdsNotNil applies the NotNil predicate on the "tax_ids" field.
+func TaxIdsNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldTaxIds))
+}
+
+// TaxExemptEQ applies the EQ predicate on the "tax_exempt" field.
+func TaxExemptEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldTaxExempt, v))
+}
+
+// TaxExemptNEQ applies the NEQ predicate on the "tax_exempt" field.
+func TaxExemptNEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldTaxExempt, v))
+}
+
+// AutoApplyCreditsEQ applies the EQ predicate on the "auto_apply_credits" field.
+func AutoApplyCreditsEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldAutoApplyCredits, v))
+}
+
+// AutoApplyCreditsNEQ applies the NEQ predicate on the "auto_apply_credits" field.
+func AutoApplyCreditsNEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldAutoApplyCredits, v))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.Customer {
 	return predicate.Customer(sql.FieldIsNull(FieldMetadata))
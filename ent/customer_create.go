@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/customer"
+	"github.com/flexprice/flexprice/ent/schema"
 )
 
 // CustomerCreate is the builder for creating a Customer entity.
@@ -220,6 +221,40 @@ func (cc *CustomerCreate) SetNillableAddressCountry(s *string) *CustomerCreate {
 	return cc
 }
 
+// SetTaxIds sets the "tax_ids" field.
+func (cc *CustomerCreate) SetTaxIds(sti []schema.CustomerTaxID) *CustomerCreate {
+	cc.mutation.SetTaxIds(sti)
+	return cc
+}
+
+// SetTaxExempt sets the "tax_exempt" field.
+func (cc *CustomerCreate) SetTaxExempt(b bool) *CustomerCreate {
+	cc.mutation.SetTaxExempt(b)
+	return cc
+}
+
+// SetNillableTaxExempt sets the "tax_exempt" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableTaxExempt(b *bool) *CustomerCreate {
+	if b != nil {
+		cc.SetTaxExempt(*b)
+	}
+	return cc
+}
+
+// SetAutoApplyCredits sets the "auto_apply_credits" field.
+func (cc *CustomerCreate) SetAutoApplyCredits(b bool) *CustomerCreate {
+	cc.mutation.SetAutoApplyCredits(b)
+	return cc
+}
+
+// SetNillableAutoApplyCredits sets the "auto_apply_credits" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableAutoApplyCredits(b *bool) *CustomerCreate {
+	if b != nil {
+		cc.SetAutoApplyCredits(*b)
+	}
+	return cc
+}
+
 // SetMetadata sets the "metadata" field.
 func (cc *CustomerCreate) SetMetadata(m map[string]string) *CustomerCreate {
 	cc.mutation.SetMetadata(m)
@@ -283,6 +318,14 @@ func (cc *CustomerCreate) defaults() {
 		v := customer.DefaultEnvironmentID
 		cc.mutation.SetEnvironmentID(v)
 	}
+	if _, ok := cc.mutation.TaxExempt(); !ok {
+		v := customer.DefaultTaxExempt
+		cc.mutation.SetTaxExempt(v)
+	}
+	if _, ok := cc.mutation.AutoApplyCredits(); !ok {
+		v := customer.DefaultAutoApplyCredits
+		cc.mutation.SetAutoApplyCredits(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -320,6 +363,12 @@ func (cc *CustomerCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Customer.name": %w`, err)}
 		}
 	}
+	if _, ok := cc.mutation.TaxExempt(); !ok {
+		return &ValidationError{Name: "tax_exempt", err: errors.New(`ent: missing required field "Customer.tax_exempt"`)}
+	}
+	if _, ok := cc.mutation.AutoApplyCredits(); !ok {
+		return &ValidationError{Name: "auto_apply_credits", err: errors.New(`ent: missing required field "Customer.auto_apply_credits"`)}
+	}
 	return nil
 }
 
@@ -419,6 +468,18 @@ func (cc *CustomerCreate) createSpec() (*Customer, *sqlgraph.CreateSpec) {
 		_spec.SetField(customer.FieldAddressCountry, field.TypeString, value)
 		_node.AddressCountry = value
 	}
+	if value, ok := cc.mutation.TaxIds(); ok {
+		_spec.SetField(customer.FieldTaxIds, field.TypeJSON, value)
+		_node.TaxIds = value
+	}
+	if value, ok := cc.mutation.TaxExempt(); ok {
+		_spec.SetField(customer.FieldTaxExempt, field.TypeBool, value)
+		_node.TaxExempt = value
+	}
+	if value, ok := cc.mutation.AutoApplyCredits(); ok {
+		_spec.SetField(customer.FieldAutoApplyCredits, field.TypeBool, value)
+		_node.AutoApplyCredits = value
+	}
 	if value, ok := cc.mutation.Metadata(); ok {
 		_spec.SetField(customer.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
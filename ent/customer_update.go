@@ -10,9 +10,11 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/customer"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/schema"
 )
 
 // CustomerUpdate is the builder for updating Customer entities.
@@ -236,6 +238,52 @@ func (cu *CustomerUpdate) ClearAddressCountry() *CustomerUpdate {
 	return cu
 }
 
+// SetTaxIds sets the "tax_ids" field.
+func (cu *CustomerUpdate) SetTaxIds(sti []schema.CustomerTaxID) *CustomerUpdate {
+	cu.mutation.SetTaxIds(sti)
+	return cu
+}
+
+// AppendTaxIds appends sti to the "tax_ids" field.
+func (cu *CustomerUpdate) AppendTaxIds(sti []schema.CustomerTaxID) *CustomerUpdate {
+	cu.mutation.AppendTaxIds(sti)
+	return cu
+}
+
+// ClearTaxIds clears the value of the "tax_ids" field.
+func (cu *CustomerUpdate) ClearTaxIds() *CustomerUpdate {
+	cu.mutation.ClearTaxIds()
+	return cu
+}
+
+// SetTaxExempt sets the "tax_exempt" field.
+func (cu *CustomerUpdate) SetTaxExempt(b bool) *CustomerUpdate {
+	cu.mutation.SetTaxExempt(b)
+	return cu
+}
+
+// SetNillableTaxExempt sets the "tax_exempt" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableTaxExempt(b *bool) *CustomerUpdate {
+	if b != nil {
+		cu.SetTaxExempt(*b)
+	}
+	return cu
+}
+
+// SetAutoApplyCredits sets the "auto_apply_credits" field.
+func (cu *CustomerUpdate) SetAutoApplyCredits(b bool) *CustomerUpdate {
+	cu.mutation.SetAutoApplyCredits(b)
+	return cu
+}
+
+// SetNillableAutoApplyCredits sets the "auto_apply_credits" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableAutoApplyCredits(b *bool) *CustomerUpdate {
+	if b != nil {
+		cu.SetAutoApplyCredits(*b)
+	}
+	return cu
+}
+
 // SetMetadata sets the "metadata" field.
 func (cu *CustomerUpdate) SetMetadata(m map[string]string) *CustomerUpdate {
 	cu.mutation.SetMetadata(m)
@@ -382,6 +430,23 @@ func (cu *CustomerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.AddressCountryCleared() {
 		_spec.ClearField(customer.FieldAddressCountry, field.TypeString)
 	}
+	if value, ok := cu.mutation.TaxIds(); ok {
+		_spec.SetField(customer.FieldTaxIds, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedTaxIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, customer.FieldTaxIds, value)
+		})
+	}
+	if cu.mutation.TaxIdsCleared() {
+		_spec.ClearField(customer.FieldTaxIds, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.TaxExempt(); ok {
+		_spec.SetField(customer.FieldTaxExempt, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.AutoApplyCredits(); ok {
+		_spec.SetField(customer.FieldAutoApplyCredits, field.TypeBool, value)
+	}
 	if value, ok := cu.mutation.Metadata(); ok {
 		_spec.SetField(customer.FieldMetadata, field.TypeJSON, value)
 	}
@@ -616,6 +681,52 @@ func (cuo *CustomerUpdateOne) ClearAddressCountry() *CustomerUpdateOne {
 	return cuo
 }
 
+// SetTaxIds sets the "tax_ids" field.
+func (cuo *CustomerUpdateOne) SetTaxIds(sti []schema.CustomerTaxID) *CustomerUpdateOne {
+	cuo.mutation.SetTaxIds(sti)
+	return cuo
+}
+
+// AppendTaxIds appends sti to the "tax_ids" field.
+func (cuo *CustomerUpdateOne) AppendTaxIds(sti []schema.CustomerTaxID) *CustomerUpdateOne {
+	cuo.mutation.AppendTaxIds(sti)
+	return cuo
+}
+
+// ClearTaxIds clears the value of the "tax_ids" field.
+func (cuo *CustomerUpdateOne) ClearTaxIds() *CustomerUpdateOne {
+	cuo.mutation.ClearTaxIds()
+	return cuo
+}
+
+// SetTaxExempt sets the "tax_exempt" field.
+func (cuo *CustomerUpdateOne) SetTaxExempt(b bool) *CustomerUpdateOne {
+	cuo.mutation.SetTaxExempt(b)
+	return cuo
+}
+
+// SetNillableTaxExempt sets the "tax_exempt" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableTaxExempt(b *bool) *CustomerUpdateOne {
+	if b != nil {
+		cuo.SetTaxExempt(*b)
+	}
+	return cuo
+}
+
+// SetAutoApplyCredits sets the "auto_apply_credits" field.
+func (cuo *CustomerUpdateOne) SetAutoApplyCredits(b bool) *CustomerUpdateOne {
+	cuo.mutation.SetAutoApplyCredits(b)
+	return cuo
+}
+
+// SetNillableAutoApplyCredits sets the "auto_apply_credits" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableAutoApplyCredits(b *bool) *CustomerUpdateOne {
+	if b != nil {
+		cuo.SetAutoApplyCredits(*b)
+	}
+	return cuo
+}
+
 // SetMetadata sets the "metadata" field.
 func (cuo *CustomerUpdateOne) SetMetadata(m map[string]string) *CustomerUpdateOne {
 	cuo.mutation.SetMetadata(m)
@@ -792,6 +903,23 @@ func (cuo *CustomerUpdateOne) sqlSave(ctx context.Context) (_node *Customer, err
 	if cuo.mutation.AddressCountryCleared() {
 		_spec.ClearField(customer.FieldAddressCountry, field.TypeString)
 	}
+	if value, ok := cuo.mutation.TaxIds(); ok {
+		_spec.SetField(customer.FieldTaxIds, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedTaxIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, customer.FieldTaxIds, value)
+		})
+	}
+	if cuo.mutation.TaxIdsCleared() {
+		_spec.ClearField(customer.FieldTaxIds, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.TaxExempt(); ok {
+		_spec.SetField(customer.FieldTaxExempt, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.AutoApplyCredits(); ok {
+		_spec.SetField(customer.FieldAutoApplyCredits, field.TypeBool, value)
+	}
 	if value, ok := cuo.mutation.Metadata(); ok {
 		_spec.SetField(customer.FieldMetadata, field.TypeJSON, value)
 	}
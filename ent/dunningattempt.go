@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/dunningattempt"
+	"github.com/shopspring/decimal"
+)
+
+// DunningAttempt is the model entity for the DunningAttempt schema.
+type DunningAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// InvoiceID holds the value of the "invoice_id" field.
+	InvoiceID string `json:"invoice_id,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+	// AttemptNumber holds the value of the "attempt_number" field.
+	AttemptNumber int `json:"attempt_number,omitempty"`
+	// AttemptStatus holds the value of the "attempt_status" field.
+	AttemptStatus string `json:"attempt_status,omitempty"`
+	// AmountPaid holds the value of the "amount_paid" field.
+	AmountPaid decimal.Decimal `json:"amount_paid,omitempty"`
+	// AmountRemaining holds the value of the "amount_remaining" field.
+	AmountRemaining decimal.Decimal `json:"amount_remaining,omitempty"`
+	// ErrorMessage holds the value of the "error_message" field.
+	ErrorMessage *string `json:"error_message,omitempty"`
+	// AttemptedAt holds the value of the "attempted_at" field.
+	AttemptedAt  time.Time `json:"attempted_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DunningAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dunningattempt.FieldAmountPaid, dunningattempt.FieldAmountRemaining:
+			values[i] = new(decimal.Decimal)
+		case dunningattempt.FieldAttemptNumber:
+			values[i] = new(sql.NullInt64)
+		case dunningattempt.FieldID, dunningattempt.FieldTenantID, dunningattempt.FieldStatus, dunningattempt.FieldCreatedBy, dunningattempt.FieldUpdatedBy, dunningattempt.FieldEnvironmentID, dunningattempt.FieldInvoiceID, dunningattempt.FieldSubscriptionID, dunningattempt.FieldAttemptStatus, dunningattempt.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case dunningattempt.FieldCreatedAt, dunningattempt.FieldUpdatedAt, dunningattempt.FieldAttemptedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DunningAttempt fields.
+func (da *DunningAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dunningattempt.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				da.ID = value.String
+			}
+		case dunningattempt.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				da.TenantID = value.String
+			}
+		case dunningattempt.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				da.Status = value.String
+			}
+		case dunningattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				da.CreatedAt = value.Time
+			}
+		case dunningattempt.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				da.UpdatedAt = value.Time
+			}
+		case dunningattempt.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				da.CreatedBy = value.String
+			}
+		case dunningattempt.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				da.UpdatedBy = value.String
+			}
+		case dunningattempt.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				da.EnvironmentID = value.String
+			}
+		case dunningattempt.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				da.InvoiceID = value.String
+			}
+		case dunningattempt.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				da.SubscriptionID = new(string)
+				*da.SubscriptionID = value.String
+			}
+		case dunningattempt.FieldAttemptNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_number", values[i])
+			} else if value.Valid {
+				da.AttemptNumber = int(value.Int64)
+			}
+		case dunningattempt.FieldAttemptStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_status", values[i])
+			} else if value.Valid {
+				da.AttemptStatus = value.String
+			}
+		case dunningattempt.FieldAmountPaid:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_paid", values[i])
+			} else if value != nil {
+				da.AmountPaid = *value
+			}
+		case dunningattempt.FieldAmountRemaining:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_remaining", values[i])
+			} else if value != nil {
+				da.AmountRemaining = *value
+			}
+		case dunningattempt.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				da.ErrorMessage = new(string)
+				*da.ErrorMessage = value.String
+			}
+		case dunningattempt.FieldAttemptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field attempted_at", values[i])
+			} else if value.Valid {
+				da.AttemptedAt = value.Time
+			}
+		default:
+			da.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DunningAttempt.
+// This includes values selected through modifiers, order, etc.
+func (da *DunningAttempt) Value(name string) (ent.Value, error) {
+	return da.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DunningAttempt.
+// Note that you need to call DunningAttempt.Unwrap() before calling this method if this DunningAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (da *DunningAttempt) Update() *DunningAttemptUpdateOne {
+	return NewDunningAttemptClient(da.config).UpdateOne(da)
+}
+
+// Unwrap unwraps the DunningAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (da *DunningAttempt) Unwrap() *DunningAttempt {
+	_tx, ok := da.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DunningAttempt is not a transactional entity")
+	}
+	da.config.driver = _tx.drv
+	return da
+}
+
+// String implements the fmt.Stringer.
+func (da *DunningAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("DunningAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", da.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(da.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(da.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(da.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(da.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(da.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(da.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(da.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(da.InvoiceID)
+	builder.WriteString(", ")
+	if v := da.SubscriptionID; v != nil {
+		builder.WriteString("subscription_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempt_number=")
+	builder.WriteString(fmt.Sprintf("%v", da.AttemptNumber))
+	builder.WriteString(", ")
+	builder.WriteString("attempt_status=")
+	builder.WriteString(da.AttemptStatus)
+	builder.WriteString(", ")
+	builder.WriteString("amount_paid=")
+	builder.WriteString(fmt.Sprintf("%v", da.AmountPaid))
+	builder.WriteString(", ")
+	builder.WriteString("amount_remaining=")
+	builder.WriteString(fmt.Sprintf("%v", da.AmountRemaining))
+	builder.WriteString(", ")
+	if v := da.ErrorMessage; v != nil {
+		builder.WriteString("error_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempted_at=")
+	builder.WriteString(da.AttemptedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DunningAttempts is a parsable slice of DunningAttempt.
+type DunningAttempts []*DunningAttempt
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dunningattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the dunningattempt type in the database.
+	Label = "dunning_attempt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldEnvironmentID holds the string denoting the environment_id field in the database.
+	FieldEnvironmentID = "environment_id"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldSubscriptionID holds the string denoting the subscription_id field in the database.
+	FieldSubscriptionID = "subscription_id"
+	// FieldAttemptNumber holds the string denoting the attempt_number field in the database.
+	FieldAttemptNumber = "attempt_number"
+	// FieldAttemptStatus holds the string denoting the attempt_status field in the database.
+	FieldAttemptStatus = "attempt_status"
+	// FieldAmountPaid holds the string denoting the amount_paid field in the database.
+	FieldAmountPaid = "amount_paid"
+	// FieldAmountRemaining holds the string denoting the amount_remaining field in the database.
+	FieldAmountRemaining = "amount_remaining"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// FieldAttemptedAt holds the string denoting the attempted_at field in the database.
+	FieldAttemptedAt = "attempted_at"
+	// Table holds the table name of the dunningattempt in the database.
+	Table = "dunning_attempts"
+)
+
+// Columns holds all SQL columns for dunningattempt fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldEnvironmentID,
+	FieldInvoiceID,
+	FieldSubscriptionID,
+	FieldAttemptNumber,
+	FieldAttemptStatus,
+	FieldAmountPaid,
+	FieldAmountRemaining,
+	FieldErrorMessage,
+	FieldAttemptedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultEnvironmentID holds the default value on creation for the "environment_id" field.
+	DefaultEnvironmentID string
+	// InvoiceIDValidator is a validator for the "invoice_id" field. It is called by the builders before save.
+	InvoiceIDValidator func(string) error
+	// AttemptStatusValidator is a validator for the "attempt_status" field. It is called by the builders before save.
+	AttemptStatusValidator func(string) error
+	// DefaultAmountPaid holds the default value on creation for the "amount_paid" field.
+	DefaultAmountPaid decimal.Decimal
+	// DefaultAmountRemaining holds the default value on creation for the "amount_remaining" field.
+	DefaultAmountRemaining decimal.Decimal
+)
+
+// OrderOption defines the ordering options for the DunningAttempt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByEnvironmentID orders the results by the environment_id field.
+func ByEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvironmentID, opts...).ToFunc()
+}
+
+// ByInvoiceID orders the results by the invoice_id field.
+func ByInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceID, opts...).ToFunc()
+}
+
+// BySubscriptionID orders the results by the subscription_id field.
+func BySubscriptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionID, opts...).ToFunc()
+}
+
+// ByAttemptNumber orders the results by the attempt_number field.
+func ByAttemptNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptNumber, opts...).ToFunc()
+}
+
+// ByAttemptStatus orders the results by the attempt_status field.
+func ByAttemptStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptStatus, opts...).ToFunc()
+}
+
+// ByAmountPaid orders the results by the amount_paid field.
+func ByAmountPaid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountPaid, opts...).ToFunc()
+}
+
+// ByAmountRemaining orders the results by the amount_remaining field.
+func ByAmountRemaining(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountRemaining, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// ByAttemptedAt orders the results by the attempted_at field.
+func ByAttemptedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptedAt, opts...).ToFunc()
+}
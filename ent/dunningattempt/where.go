@@ -0,0 +1,1031 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dunningattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// AttemptNumber applies equality check predicate on the "attempt_number" field. It's identical to AttemptNumberEQ.
+func AttemptNumber(v int) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldAttemptNumber, v))
+}
+
+// AttemptStatus applies equality check predicate on the "attempt_status" field. It's identical to AttemptStatusEQ.
+func AttemptStatus(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldAttemptStatus, v))
+}
+
+// AmountPaid applies equality check predicate on the "amount_paid" field. It's identical to AmountPaidEQ.
+func AmountPaid(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldAmountPaid, v))
+}
+
+// AmountRemaining applies equality check predicate on the "amount_remaining" field. It's identical to AmountRemainingEQ.
+func AmountRemaining(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldAmountRemaining, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// AttemptedAt applies equality check predicate on the "attempted_at" field. It's identical to AttemptedAtEQ.
+func AttemptedAt(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldAttemptedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldInvoiceID, v))
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldInvoiceID, v))
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDContains applies the Contains predicate on the "invoice_id" field.
+func InvoiceIDContains(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContains(FieldInvoiceID, v))
+}
+
+// InvoiceIDHasPrefix applies the HasPrefix predicate on the "invoice_id" field.
+func InvoiceIDHasPrefix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasPrefix(FieldInvoiceID, v))
+}
+
+// InvoiceIDHasSuffix applies the HasSuffix predicate on the "invoice_id" field.
+func InvoiceIDHasSuffix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasSuffix(FieldInvoiceID, v))
+}
+
+// InvoiceIDEqualFold applies the EqualFold predicate on the "invoice_id" field.
+func InvoiceIDEqualFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEqualFold(FieldInvoiceID, v))
+}
+
+// InvoiceIDContainsFold applies the ContainsFold predicate on the "invoice_id" field.
+func InvoiceIDContainsFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContainsFold(FieldInvoiceID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContains applies the Contains predicate on the "subscription_id" field.
+func SubscriptionIDContains(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContains(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasPrefix applies the HasPrefix predicate on the "subscription_id" field.
+func SubscriptionIDHasPrefix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasPrefix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasSuffix applies the HasSuffix predicate on the "subscription_id" field.
+func SubscriptionIDHasSuffix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasSuffix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIsNil applies the IsNil predicate on the "subscription_id" field.
+func SubscriptionIDIsNil() predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIsNull(FieldSubscriptionID))
+}
+
+// SubscriptionIDNotNil applies the NotNil predicate on the "subscription_id" field.
+func SubscriptionIDNotNil() predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotNull(FieldSubscriptionID))
+}
+
+// SubscriptionIDEqualFold applies the EqualFold predicate on the "subscription_id" field.
+func SubscriptionIDEqualFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEqualFold(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContainsFold applies the ContainsFold predicate on the "subscription_id" field.
+func SubscriptionIDContainsFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContainsFold(FieldSubscriptionID, v))
+}
+
+// AttemptNumberEQ applies the EQ predicate on the "attempt_number" field.
+func AttemptNumberEQ(v int) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldAttemptNumber, v))
+}
+
+// AttemptNumberNEQ applies the NEQ predicate on the "attempt_number" field.
+func AttemptNumberNEQ(v int) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldAttemptNumber, v))
+}
+
+// AttemptNumberIn applies the In predicate on the "attempt_number" field.
+func AttemptNumberIn(vs ...int) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldAttemptNumber, vs...))
+}
+
+// AttemptNumberNotIn applies the NotIn predicate on the "attempt_number" field.
+func AttemptNumberNotIn(vs ...int) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldAttemptNumber, vs...))
+}
+
+// AttemptNumberGT applies the GT predicate on the "attempt_number" field.
+func AttemptNumberGT(v int) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldAttemptNumber, v))
+}
+
+// AttemptNumberGTE applies the GTE predicate on the "attempt_number" field.
+func AttemptNumberGTE(v int) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldAttemptNumber, v))
+}
+
+// AttemptNumberLT applies the LT predicate on the "attempt_number" field.
+func AttemptNumberLT(v int) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldAttemptNumber, v))
+}
+
+// AttemptNumberLTE applies the LTE predicate on the "attempt_number" field.
+func AttemptNumberLTE(v int) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldAttemptNumber, v))
+}
+
+// AttemptStatusEQ applies the EQ predicate on the "attempt_status" field.
+func AttemptStatusEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldAttemptStatus, v))
+}
+
+// AttemptStatusNEQ applies the NEQ predicate on the "attempt_status" field.
+func AttemptStatusNEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldAttemptStatus, v))
+}
+
+// AttemptStatusIn applies the In predicate on the "attempt_status" field.
+func AttemptStatusIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldAttemptStatus, vs...))
+}
+
+// AttemptStatusNotIn applies the NotIn predicate on the "attempt_status" field.
+func AttemptStatusNotIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldAttemptStatus, vs...))
+}
+
+// AttemptStatusGT applies the GT predicate on the "attempt_status" field.
+func AttemptStatusGT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldAttemptStatus, v))
+}
+
+// AttemptStatusGTE applies the GTE predicate on the "attempt_status" field.
+func AttemptStatusGTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldAttemptStatus, v))
+}
+
+// AttemptStatusLT applies the LT predicate on the "attempt_status" field.
+func AttemptStatusLT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldAttemptStatus, v))
+}
+
+// AttemptStatusLTE applies the LTE predicate on the "attempt_status" field.
+func AttemptStatusLTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldAttemptStatus, v))
+}
+
+// AttemptStatusContains applies the Contains predicate on the "attempt_status" field.
+func AttemptStatusContains(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContains(FieldAttemptStatus, v))
+}
+
+// AttemptStatusHasPrefix applies the HasPrefix predicate on the "attempt_status" field.
+func AttemptStatusHasPrefix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasPrefix(FieldAttemptStatus, v))
+}
+
+// AttemptStatusHasSuffix applies the HasSuffix predicate on the "attempt_status" field.
+func AttemptStatusHasSuffix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasSuffix(FieldAttemptStatus, v))
+}
+
+// AttemptStatusEqualFold applies the EqualFold predicate on the "attempt_status" field.
+func AttemptStatusEqualFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEqualFold(FieldAttemptStatus, v))
+}
+
+// AttemptStatusContainsFold applies the ContainsFold predicate on the "attempt_status" field.
+func AttemptStatusContainsFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContainsFold(FieldAttemptStatus, v))
+}
+
+// AmountPaidEQ applies the EQ predicate on the "amount_paid" field.
+func AmountPaidEQ(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldAmountPaid, v))
+}
+
+// AmountPaidNEQ applies the NEQ predicate on the "amount_paid" field.
+func AmountPaidNEQ(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldAmountPaid, v))
+}
+
+// AmountPaidIn applies the In predicate on the "amount_paid" field.
+func AmountPaidIn(vs ...decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldAmountPaid, vs...))
+}
+
+// AmountPaidNotIn applies the NotIn predicate on the "amount_paid" field.
+func AmountPaidNotIn(vs ...decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldAmountPaid, vs...))
+}
+
+// AmountPaidGT applies the GT predicate on the "amount_paid" field.
+func AmountPaidGT(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldAmountPaid, v))
+}
+
+// AmountPaidGTE applies the GTE predicate on the "amount_paid" field.
+func AmountPaidGTE(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldAmountPaid, v))
+}
+
+// AmountPaidLT applies the LT predicate on the "amount_paid" field.
+func AmountPaidLT(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldAmountPaid, v))
+}
+
+// AmountPaidLTE applies the LTE predicate on the "amount_paid" field.
+func AmountPaidLTE(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldAmountPaid, v))
+}
+
+// AmountRemainingEQ applies the EQ predicate on the "amount_remaining" field.
+func AmountRemainingEQ(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldAmountRemaining, v))
+}
+
+// AmountRemainingNEQ applies the NEQ predicate on the "amount_remaining" field.
+func AmountRemainingNEQ(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldAmountRemaining, v))
+}
+
+// AmountRemainingIn applies the In predicate on the "amount_remaining" field.
+func AmountRemainingIn(vs ...decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldAmountRemaining, vs...))
+}
+
+// AmountRemainingNotIn applies the NotIn predicate on the "amount_remaining" field.
+func AmountRemainingNotIn(vs ...decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldAmountRemaining, vs...))
+}
+
+// AmountRemainingGT applies the GT predicate on the "amount_remaining" field.
+func AmountRemainingGT(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldAmountRemaining, v))
+}
+
+// AmountRemainingGTE applies the GTE predicate on the "amount_remaining" field.
+func AmountRemainingGTE(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldAmountRemaining, v))
+}
+
+// AmountRemainingLT applies the LT predicate on the "amount_remaining" field.
+func AmountRemainingLT(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldAmountRemaining, v))
+}
+
+// AmountRemainingLTE applies the LTE predicate on the "amount_remaining" field.
+func AmountRemainingLTE(v decimal.Decimal) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldAmountRemaining, v))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// AttemptedAtEQ applies the EQ predicate on the "attempted_at" field.
+func AttemptedAtEQ(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldEQ(FieldAttemptedAt, v))
+}
+
+// AttemptedAtNEQ applies the NEQ predicate on the "attempted_at" field.
+func AttemptedAtNEQ(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNEQ(FieldAttemptedAt, v))
+}
+
+// AttemptedAtIn applies the In predicate on the "attempted_at" field.
+func AttemptedAtIn(vs ...time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldIn(FieldAttemptedAt, vs...))
+}
+
+// AttemptedAtNotIn applies the NotIn predicate on the "attempted_at" field.
+func AttemptedAtNotIn(vs ...time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldNotIn(FieldAttemptedAt, vs...))
+}
+
+// AttemptedAtGT applies the GT predicate on the "attempted_at" field.
+func AttemptedAtGT(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGT(FieldAttemptedAt, v))
+}
+
+// AttemptedAtGTE applies the GTE predicate on the "attempted_at" field.
+func AttemptedAtGTE(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldGTE(FieldAttemptedAt, v))
+}
+
+// AttemptedAtLT applies the LT predicate on the "attempted_at" field.
+func AttemptedAtLT(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLT(FieldAttemptedAt, v))
+}
+
+// AttemptedAtLTE applies the LTE predicate on the "attempted_at" field.
+func AttemptedAtLTE(v time.Time) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.FieldLTE(FieldAttemptedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DunningAttempt) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DunningAttempt) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DunningAttempt) predicate.DunningAttempt {
+	return predicate.DunningAttempt(sql.NotPredicates(p))
+}
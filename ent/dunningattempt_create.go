@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/dunningattempt"
+	"github.com/shopspring/decimal"
+)
+
+// DunningAttemptCreate is the builder for creating a DunningAttempt entity.
+type DunningAttemptCreate struct {
+	config
+	mutation *DunningAttemptMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (dac *DunningAttemptCreate) SetTenantID(s string) *DunningAttemptCreate {
+	dac.mutation.SetTenantID(s)
+	return dac
+}
+
+// SetStatus sets the "status" field.
+func (dac *DunningAttemptCreate) SetStatus(s string) *DunningAttemptCreate {
+	dac.mutation.SetStatus(s)
+	return dac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dac *DunningAttemptCreate) SetNillableStatus(s *string) *DunningAttemptCreate {
+	if s != nil {
+		dac.SetStatus(*s)
+	}
+	return dac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dac *DunningAttemptCreate) SetCreatedAt(t time.Time) *DunningAttemptCreate {
+	dac.mutation.SetCreatedAt(t)
+	return dac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dac *DunningAttemptCreate) SetNillableCreatedAt(t *time.Time) *DunningAttemptCreate {
+	if t != nil {
+		dac.SetCreatedAt(*t)
+	}
+	return dac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dac *DunningAttemptCreate) SetUpdatedAt(t time.Time) *DunningAttemptCreate {
+	dac.mutation.SetUpdatedAt(t)
+	return dac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dac *DunningAttemptCreate) SetNillableUpdatedAt(t *time.Time) *DunningAttemptCreate {
+	if t != nil {
+		dac.SetUpdatedAt(*t)
+	}
+	return dac
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (dac *DunningAttemptCreate) SetCreatedBy(s string) *DunningAttemptCreate {
+	dac.mutation.SetCreatedBy(s)
+	return dac
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (dac *DunningAttemptCreate) SetNillableCreatedBy(s *string) *DunningAttemptCreate {
+	if s != nil {
+		dac.SetCreatedBy(*s)
+	}
+	return dac
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (dac *DunningAttemptCreate) SetUpdatedBy(s string) *DunningAttemptCreate {
+	dac.mutation.SetUpdatedBy(s)
+	return dac
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (dac *DunningAttemptCreate) SetNillableUpdatedBy(s *string) *DunningAttemptCreate {
+	if s != nil {
+		dac.SetUpdatedBy(*s)
+	}
+	return dac
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (dac *DunningAttemptCreate) SetEnvironmentID(s string) *DunningAttemptCreate {
+	dac.mutation.SetEnvironmentID(s)
+	return dac
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (dac *DunningAttemptCreate) SetNillableEnvironmentID(s *string) *DunningAttemptCreate {
+	if s != nil {
+		dac.SetEnvironmentID(*s)
+	}
+	return dac
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (dac *DunningAttemptCreate) SetInvoiceID(s string) *DunningAttemptCreate {
+	dac.mutation.SetInvoiceID(s)
+	return dac
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (dac *DunningAttemptCreate) SetSubscriptionID(s string) *DunningAttemptCreate {
+	dac.mutation.SetSubscriptionID(s)
+	return dac
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (dac *DunningAttemptCreate) SetNillableSubscriptionID(s *string) *DunningAttemptCreate {
+	if s != nil {
+		dac.SetSubscriptionID(*s)
+	}
+	return dac
+}
+
+// SetAttemptNumber sets the "attempt_number" field.
+func (dac *DunningAttemptCreate) SetAttemptNumber(i int) *DunningAttemptCreate {
+	dac.mutation.SetAttemptNumber(i)
+	return dac
+}
+
+// SetAttemptStatus sets the "attempt_status" field.
+func (dac *DunningAttemptCreate) SetAttemptStatus(s string) *DunningAttemptCreate {
+	dac.mutation.SetAttemptStatus(s)
+	return dac
+}
+
+// SetAmountPaid sets the "amount_paid" field.
+func (dac *DunningAttemptCreate) SetAmountPaid(d decimal.Decimal) *DunningAttemptCreate {
+	dac.mutation.SetAmountPaid(d)
+	return dac
+}
+
+// SetNillableAmountPaid sets the "amount_paid" field if the given value is not nil.
+func (dac *DunningAttemptCreate) SetNillableAmountPaid(d *decimal.Decimal) *DunningAttemptCreate {
+	if d != nil {
+		dac.SetAmountPaid(*d)
+	}
+	return dac
+}
+
+// SetAmountRemaining sets the "amount_remaining" field.
+func (dac *DunningAttemptCreate) SetAmountRemaining(d decimal.Decimal) *DunningAttemptCreate {
+	dac.mutation.SetAmountRemaining(d)
+	return dac
+}
+
+// SetNillableAmountRemaining sets the "amount_remaining" field if the given value is not nil.
+func (dac *DunningAttemptCreate) SetNillableAmountRemaining(d *decimal.Decimal) *DunningAttemptCreate {
+	if d != nil {
+		dac.SetAmountRemaining(*d)
+	}
+	return dac
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (dac *DunningAttemptCreate) SetErrorMessage(s string) *DunningAttemptCreate {
+	dac.mutation.SetErrorMessage(s)
+	return dac
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (dac *DunningAttemptCreate) SetNillableErrorMessage(s *string) *DunningAttemptCreate {
+	if s != nil {
+		dac.SetErrorMessage(*s)
+	}
+	return dac
+}
+
+// SetAttemptedAt sets the "attempted_at" field.
+func (dac *DunningAttemptCreate) SetAttemptedAt(t time.Time) *DunningAttemptCreate {
+	dac.mutation.SetAttemptedAt(t)
+	return dac
+}
+
+// SetID sets the "id" field.
+func (dac *DunningAttemptCreate) SetID(s string) *DunningAttemptCreate {
+	dac.mutation.SetID(s)
+	return dac
+}
+
+// Mutation returns the DunningAttemptMutation object of the builder.
+func (dac *DunningAttemptCreate) Mutation() *DunningAttemptMutation {
+	return dac.mutation
+}
+
+// Save creates the DunningAttempt in the database.
+func (dac *DunningAttemptCreate) Save(ctx context.Context) (*DunningAttempt, error) {
+	dac.defaults()
+	return withHooks(ctx, dac.sqlSave, dac.mutation, dac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dac *DunningAttemptCreate) SaveX(ctx context.Context) *DunningAttempt {
+	v, err := dac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dac *DunningAttemptCreate) Exec(ctx context.Context) error {
+	_, err := dac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dac *DunningAttemptCreate) ExecX(ctx context.Context) {
+	if err := dac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dac *DunningAttemptCreate) defaults() {
+	if _, ok := dac.mutation.Status(); !ok {
+		v := dunningattempt.DefaultStatus
+		dac.mutation.SetStatus(v)
+	}
+	if _, ok := dac.mutation.CreatedAt(); !ok {
+		v := dunningattempt.DefaultCreatedAt()
+		dac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dac.mutation.UpdatedAt(); !ok {
+		v := dunningattempt.DefaultUpdatedAt()
+		dac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dac.mutation.EnvironmentID(); !ok {
+		v := dunningattempt.DefaultEnvironmentID
+		dac.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := dac.mutation.AmountPaid(); !ok {
+		v := dunningattempt.DefaultAmountPaid
+		dac.mutation.SetAmountPaid(v)
+	}
+	if _, ok := dac.mutation.AmountRemaining(); !ok {
+		v := dunningattempt.DefaultAmountRemaining
+		dac.mutation.SetAmountRemaining(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dac *DunningAttemptCreate) check() error {
+	if _, ok := dac.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "DunningAttempt.tenant_id"`)}
+	}
+	if v, ok := dac.mutation.TenantID(); ok {
+		if err := dunningattempt.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "DunningAttempt.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := dac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DunningAttempt.status"`)}
+	}
+	if _, ok := dac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DunningAttempt.created_at"`)}
+	}
+	if _, ok := dac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DunningAttempt.updated_at"`)}
+	}
+	if _, ok := dac.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "DunningAttempt.invoice_id"`)}
+	}
+	if v, ok := dac.mutation.InvoiceID(); ok {
+		if err := dunningattempt.InvoiceIDValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_id", err: fmt.Errorf(`ent: validator failed for field "DunningAttempt.invoice_id": %w`, err)}
+		}
+	}
+	if _, ok := dac.mutation.AttemptNumber(); !ok {
+		return &ValidationError{Name: "attempt_number", err: errors.New(`ent: missing required field "DunningAttempt.attempt_number"`)}
+	}
+	if _, ok := dac.mutation.AttemptStatus(); !ok {
+		return &ValidationError{Name: "attempt_status", err: errors.New(`ent: missing required field "DunningAttempt.attempt_status"`)}
+	}
+	if v, ok := dac.mutation.AttemptStatus(); ok {
+		if err := dunningattempt.AttemptStatusValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_status", err: fmt.Errorf(`ent: validator failed for field "DunningAttempt.attempt_status": %w`, err)}
+		}
+	}
+	if _, ok := dac.mutation.AmountPaid(); !ok {
+		return &ValidationError{Name: "amount_paid", err: errors.New(`ent: missing required field "DunningAttempt.amount_paid"`)}
+	}
+	if _, ok := dac.mutation.AmountRemaining(); !ok {
+		return &ValidationError{Name: "amount_remaining", err: errors.New(`ent: missing required field "DunningAttempt.amount_remaining"`)}
+	}
+	if _, ok := dac.mutation.AttemptedAt(); !ok {
+		return &ValidationError{Name: "attempted_at", err: errors.New(`ent: missing required field "DunningAttempt.attempted_at"`)}
+	}
+	return nil
+}
+
+func (dac *DunningAttemptCreate) sqlSave(ctx context.Context) (*DunningAttempt, error) {
+	if err := dac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DunningAttempt.ID type: %T", _spec.ID.Value)
+		}
+	}
+	dac.mutation.id = &_node.ID
+	dac.mutation.done = true
+	return _node, nil
+}
+
+func (dac *DunningAttemptCreate) createSpec() (*DunningAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DunningAttempt{config: dac.config}
+		_spec = sqlgraph.NewCreateSpec(dunningattempt.Table, sqlgraph.NewFieldSpec(dunningattempt.FieldID, field.TypeString))
+	)
+	if id, ok := dac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dac.mutation.TenantID(); ok {
+		_spec.SetField(dunningattempt.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := dac.mutation.Status(); ok {
+		_spec.SetField(dunningattempt.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := dac.mutation.CreatedAt(); ok {
+		_spec.SetField(dunningattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dac.mutation.UpdatedAt(); ok {
+		_spec.SetField(dunningattempt.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dac.mutation.CreatedBy(); ok {
+		_spec.SetField(dunningattempt.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := dac.mutation.UpdatedBy(); ok {
+		_spec.SetField(dunningattempt.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := dac.mutation.EnvironmentID(); ok {
+		_spec.SetField(dunningattempt.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := dac.mutation.InvoiceID(); ok {
+		_spec.SetField(dunningattempt.FieldInvoiceID, field.TypeString, value)
+		_node.InvoiceID = value
+	}
+	if value, ok := dac.mutation.SubscriptionID(); ok {
+		_spec.SetField(dunningattempt.FieldSubscriptionID, field.TypeString, value)
+		_node.SubscriptionID = &value
+	}
+	if value, ok := dac.mutation.AttemptNumber(); ok {
+		_spec.SetField(dunningattempt.FieldAttemptNumber, field.TypeInt, value)
+		_node.AttemptNumber = value
+	}
+	if value, ok := dac.mutation.AttemptStatus(); ok {
+		_spec.SetField(dunningattempt.FieldAttemptStatus, field.TypeString, value)
+		_node.AttemptStatus = value
+	}
+	if value, ok := dac.mutation.AmountPaid(); ok {
+		_spec.SetField(dunningattempt.FieldAmountPaid, field.TypeOther, value)
+		_node.AmountPaid = value
+	}
+	if value, ok := dac.mutation.AmountRemaining(); ok {
+		_spec.SetField(dunningattempt.FieldAmountRemaining, field.TypeOther, value)
+		_node.AmountRemaining = value
+	}
+	if value, ok := dac.mutation.ErrorMessage(); ok {
+		_spec.SetField(dunningattempt.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := dac.mutation.AttemptedAt(); ok {
+		_spec.SetField(dunningattempt.FieldAttemptedAt, field.TypeTime, value)
+		_node.AttemptedAt = value
+	}
+	return _node, _spec
+}
+
+// DunningAttemptCreateBulk is the builder for creating many DunningAttempt entities in bulk.
+type DunningAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*DunningAttemptCreate
+}
+
+// Save creates the DunningAttempt entities in the database.
+func (dacb *DunningAttemptCreateBulk) Save(ctx context.Context) ([]*DunningAttempt, error) {
+	if dacb.err != nil {
+		return nil, dacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dacb.builders))
+	nodes := make([]*DunningAttempt, len(dacb.builders))
+	mutators := make([]Mutator, len(dacb.builders))
+	for i := range dacb.builders {
+		func(i int, root context.Context) {
+			builder := dacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DunningAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dacb *DunningAttemptCreateBulk) SaveX(ctx context.Context) []*DunningAttempt {
+	v, err := dacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dacb *DunningAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := dacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dacb *DunningAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := dacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
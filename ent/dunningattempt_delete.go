@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/dunningattempt"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// DunningAttemptDelete is the builder for deleting a DunningAttempt entity.
+type DunningAttemptDelete struct {
+	config
+	hooks    []Hook
+	mutation *DunningAttemptMutation
+}
+
+// Where appends a list predicates to the DunningAttemptDelete builder.
+func (dad *DunningAttemptDelete) Where(ps ...predicate.DunningAttempt) *DunningAttemptDelete {
+	dad.mutation.Where(ps...)
+	return dad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dad *DunningAttemptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dad.sqlExec, dad.mutation, dad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dad *DunningAttemptDelete) ExecX(ctx context.Context) int {
+	n, err := dad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dad *DunningAttemptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dunningattempt.Table, sqlgraph.NewFieldSpec(dunningattempt.FieldID, field.TypeString))
+	if ps := dad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dad.mutation.done = true
+	return affected, err
+}
+
+// DunningAttemptDeleteOne is the builder for deleting a single DunningAttempt entity.
+type DunningAttemptDeleteOne struct {
+	dad *DunningAttemptDelete
+}
+
+// Where appends a list predicates to the DunningAttemptDelete builder.
+func (dado *DunningAttemptDeleteOne) Where(ps ...predicate.DunningAttempt) *DunningAttemptDeleteOne {
+	dado.dad.mutation.Where(ps...)
+	return dado
+}
+
+// Exec executes the deletion query.
+func (dado *DunningAttemptDeleteOne) Exec(ctx context.Context) error {
+	n, err := dado.dad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dunningattempt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dado *DunningAttemptDeleteOne) ExecX(ctx context.Context) {
+	if err := dado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
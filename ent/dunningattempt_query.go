@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/dunningattempt"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// DunningAttemptQuery is the builder for querying DunningAttempt entities.
+type DunningAttemptQuery struct {
+	config
+	ctx        *QueryContext
+	order      []dunningattempt.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DunningAttempt
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DunningAttemptQuery builder.
+func (daq *DunningAttemptQuery) Where(ps ...predicate.DunningAttempt) *DunningAttemptQuery {
+	daq.predicates = append(daq.predicates, ps...)
+	return daq
+}
+
+// Limit the number of records to be returned by this query.
+func (daq *DunningAttemptQuery) Limit(limit int) *DunningAttemptQuery {
+	daq.ctx.Limit = &limit
+	return daq
+}
+
+// Offset to start from.
+func (daq *DunningAttemptQuery) Offset(offset int) *DunningAttemptQuery {
+	daq.ctx.Offset = &offset
+	return daq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (daq *DunningAttemptQuery) Unique(unique bool) *DunningAttemptQuery {
+	daq.ctx.Unique = &unique
+	return daq
+}
+
+// Order specifies how the records should be ordered.
+func (daq *DunningAttemptQuery) Order(o ...dunningattempt.OrderOption) *DunningAttemptQuery {
+	daq.order = append(daq.order, o...)
+	return daq
+}
+
+// First returns the first DunningAttempt entity from the query.
+// Returns a *NotFoundError when no DunningAttempt was found.
+func (daq *DunningAttemptQuery) First(ctx context.Context) (*DunningAttempt, error) {
+	nodes, err := daq.Limit(1).All(setContextOp(ctx, daq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{dunningattempt.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (daq *DunningAttemptQuery) FirstX(ctx context.Context) *DunningAttempt {
+	node, err := daq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DunningAttempt ID from the query.
+// Returns a *NotFoundError when no DunningAttempt ID was found.
+func (daq *DunningAttemptQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = daq.Limit(1).IDs(setContextOp(ctx, daq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{dunningattempt.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (daq *DunningAttemptQuery) FirstIDX(ctx context.Context) string {
+	id, err := daq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DunningAttempt entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DunningAttempt entity is found.
+// Returns a *NotFoundError when no DunningAttempt entities are found.
+func (daq *DunningAttemptQuery) Only(ctx context.Context) (*DunningAttempt, error) {
+	nodes, err := daq.Limit(2).All(setContextOp(ctx, daq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{dunningattempt.Label}
+	default:
+		return nil, &NotSingularError{dunningattempt.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (daq *DunningAttemptQuery) OnlyX(ctx context.Context) *DunningAttempt {
+	node, err := daq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DunningAttempt ID in the query.
+// Returns a *NotSingularError when more than one DunningAttempt ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (daq *DunningAttemptQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = daq.Limit(2).IDs(setContextOp(ctx, daq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{dunningattempt.Label}
+	default:
+		err = &NotSingularError{dunningattempt.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (daq *DunningAttemptQuery) OnlyIDX(ctx context.Context) string {
+	id, err := daq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DunningAttempts.
+func (daq *DunningAttemptQuery) All(ctx context.Context) ([]*DunningAttempt, error) {
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryAll)
+	if err := daq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DunningAttempt, *DunningAttemptQuery]()
+	return withInterceptors[[]*DunningAttempt](ctx, daq, qr, daq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (daq *DunningAttemptQuery) AllX(ctx context.Context) []*DunningAttempt {
+	nodes, err := daq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DunningAttempt IDs.
+func (daq *DunningAttemptQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if daq.ctx.Unique == nil && daq.path != nil {
+		daq.Unique(true)
+	}
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryIDs)
+	if err = daq.Select(dunningattempt.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (daq *DunningAttemptQuery) IDsX(ctx context.Context) []string {
+	ids, err := daq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (daq *DunningAttemptQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryCount)
+	if err := daq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, daq, querierCount[*DunningAttemptQuery](), daq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (daq *DunningAttemptQuery) CountX(ctx context.Context) int {
+	count, err := daq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (daq *DunningAttemptQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryExist)
+	switch _, err := daq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (daq *DunningAttemptQuery) ExistX(ctx context.Context) bool {
+	exist, err := daq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DunningAttemptQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (daq *DunningAttemptQuery) Clone() *DunningAttemptQuery {
+	if daq == nil {
+		return nil
+	}
+	return &DunningAttemptQuery{
+		config:     daq.config,
+		ctx:        daq.ctx.Clone(),
+		order:      append([]dunningattempt.OrderOption{}, daq.order...),
+		inters:     append([]Interceptor{}, daq.inters...),
+		predicates: append([]predicate.DunningAttempt{}, daq.predicates...),
+		// clone intermediate query.
+		sql:  daq.sql.Clone(),
+		path: daq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DunningAttempt.Query().
+//		GroupBy(dunningattempt.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (daq *DunningAttemptQuery) GroupBy(field string, fields ...string) *DunningAttemptGroupBy {
+	daq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DunningAttemptGroupBy{build: daq}
+	grbuild.flds = &daq.ctx.Fields
+	grbuild.label = dunningattempt.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.DunningAttempt.Query().
+//		Select(dunningattempt.FieldTenantID).
+//		Scan(ctx, &v)
+func (daq *DunningAttemptQuery) Select(fields ...string) *DunningAttemptSelect {
+	daq.ctx.Fields = append(daq.ctx.Fields, fields...)
+	sbuild := &DunningAttemptSelect{DunningAttemptQuery: daq}
+	sbuild.label = dunningattempt.Label
+	sbuild.flds, sbuild.scan = &daq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DunningAttemptSelect configured with the given aggregations.
+func (daq *DunningAttemptQuery) Aggregate(fns ...AggregateFunc) *DunningAttemptSelect {
+	return daq.Select().Aggregate(fns...)
+}
+
+func (daq *DunningAttemptQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range daq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, daq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range daq.ctx.Fields {
+		if !dunningattempt.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if daq.path != nil {
+		prev, err := daq.path(ctx)
+		if err != nil {
+			return err
+		}
+		daq.sql = prev
+	}
+	return nil
+}
+
+func (daq *DunningAttemptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DunningAttempt, error) {
+	var (
+		nodes = []*DunningAttempt{}
+		_spec = daq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DunningAttempt).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DunningAttempt{config: daq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, daq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (daq *DunningAttemptQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := daq.querySpec()
+	_spec.Node.Columns = daq.ctx.Fields
+	if len(daq.ctx.Fields) > 0 {
+		_spec.Unique = daq.ctx.Unique != nil && *daq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, daq.driver, _spec)
+}
+
+func (daq *DunningAttemptQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(dunningattempt.Table, dunningattempt.Columns, sqlgraph.NewFieldSpec(dunningattempt.FieldID, field.TypeString))
+	_spec.From = daq.sql
+	if unique := daq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if daq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := daq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dunningattempt.FieldID)
+		for i := range fields {
+			if fields[i] != dunningattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := daq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := daq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := daq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := daq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (daq *DunningAttemptQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(daq.driver.Dialect())
+	t1 := builder.Table(dunningattempt.Table)
+	columns := daq.ctx.Fields
+	if len(columns) == 0 {
+		columns = dunningattempt.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if daq.sql != nil {
+		selector = daq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if daq.ctx.Unique != nil && *daq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range daq.predicates {
+		p(selector)
+	}
+	for _, p := range daq.order {
+		p(selector)
+	}
+	if offset := daq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := daq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DunningAttemptGroupBy is the group-by builder for DunningAttempt entities.
+type DunningAttemptGroupBy struct {
+	selector
+	build *DunningAttemptQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dagb *DunningAttemptGroupBy) Aggregate(fns ...AggregateFunc) *DunningAttemptGroupBy {
+	dagb.fns = append(dagb.fns, fns...)
+	return dagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dagb *DunningAttemptGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dagb.build.ctx, ent.OpQueryGroupBy)
+	if err := dagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DunningAttemptQuery, *DunningAttemptGroupBy](ctx, dagb.build, dagb, dagb.build.inters, v)
+}
+
+func (dagb *DunningAttemptGroupBy) sqlScan(ctx context.Context, root *DunningAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dagb.fns))
+	for _, fn := range dagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dagb.flds)+len(dagb.fns))
+		for _, f := range *dagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DunningAttemptSelect is the builder for selecting fields of DunningAttempt entities.
+type DunningAttemptSelect struct {
+	*DunningAttemptQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (das *DunningAttemptSelect) Aggregate(fns ...AggregateFunc) *DunningAttemptSelect {
+	das.fns = append(das.fns, fns...)
+	return das
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (das *DunningAttemptSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, das.ctx, ent.OpQuerySelect)
+	if err := das.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DunningAttemptQuery, *DunningAttemptSelect](ctx, das.DunningAttemptQuery, das, das.inters, v)
+}
+
+func (das *DunningAttemptSelect) sqlScan(ctx context.Context, root *DunningAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(das.fns))
+	for _, fn := range das.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*das.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := das.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/dunningattempt"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// DunningAttemptUpdate is the builder for updating DunningAttempt entities.
+type DunningAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DunningAttemptMutation
+}
+
+// Where appends a list predicates to the DunningAttemptUpdate builder.
+func (dau *DunningAttemptUpdate) Where(ps ...predicate.DunningAttempt) *DunningAttemptUpdate {
+	dau.mutation.Where(ps...)
+	return dau
+}
+
+// SetStatus sets the "status" field.
+func (dau *DunningAttemptUpdate) SetStatus(s string) *DunningAttemptUpdate {
+	dau.mutation.SetStatus(s)
+	return dau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dau *DunningAttemptUpdate) SetNillableStatus(s *string) *DunningAttemptUpdate {
+	if s != nil {
+		dau.SetStatus(*s)
+	}
+	return dau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dau *DunningAttemptUpdate) SetUpdatedAt(t time.Time) *DunningAttemptUpdate {
+	dau.mutation.SetUpdatedAt(t)
+	return dau
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (dau *DunningAttemptUpdate) SetUpdatedBy(s string) *DunningAttemptUpdate {
+	dau.mutation.SetUpdatedBy(s)
+	return dau
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (dau *DunningAttemptUpdate) SetNillableUpdatedBy(s *string) *DunningAttemptUpdate {
+	if s != nil {
+		dau.SetUpdatedBy(*s)
+	}
+	return dau
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (dau *DunningAttemptUpdate) ClearUpdatedBy() *DunningAttemptUpdate {
+	dau.mutation.ClearUpdatedBy()
+	return dau
+}
+
+// Mutation returns the DunningAttemptMutation object of the builder.
+func (dau *DunningAttemptUpdate) Mutation() *DunningAttemptMutation {
+	return dau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dau *DunningAttemptUpdate) Save(ctx context.Context) (int, error) {
+	dau.defaults()
+	return withHooks(ctx, dau.sqlSave, dau.mutation, dau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dau *DunningAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := dau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dau *DunningAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := dau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dau *DunningAttemptUpdate) ExecX(ctx context.Context) {
+	if err := dau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dau *DunningAttemptUpdate) defaults() {
+	if _, ok := dau.mutation.UpdatedAt(); !ok {
+		v := dunningattempt.UpdateDefaultUpdatedAt()
+		dau.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (dau *DunningAttemptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dunningattempt.Table, dunningattempt.Columns, sqlgraph.NewFieldSpec(dunningattempt.FieldID, field.TypeString))
+	if ps := dau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dau.mutation.Status(); ok {
+		_spec.SetField(dunningattempt.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := dau.mutation.UpdatedAt(); ok {
+		_spec.SetField(dunningattempt.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if dau.mutation.CreatedByCleared() {
+		_spec.ClearField(dunningattempt.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := dau.mutation.UpdatedBy(); ok {
+		_spec.SetField(dunningattempt.FieldUpdatedBy, field.TypeString, value)
+	}
+	if dau.mutation.UpdatedByCleared() {
+		_spec.ClearField(dunningattempt.FieldUpdatedBy, field.TypeString)
+	}
+	if dau.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(dunningattempt.FieldEnvironmentID, field.TypeString)
+	}
+	if dau.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(dunningattempt.FieldSubscriptionID, field.TypeString)
+	}
+	if dau.mutation.ErrorMessageCleared() {
+		_spec.ClearField(dunningattempt.FieldErrorMessage, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dunningattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dau.mutation.done = true
+	return n, nil
+}
+
+// DunningAttemptUpdateOne is the builder for updating a single DunningAttempt entity.
+type DunningAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DunningAttemptMutation
+}
+
+// SetStatus sets the "status" field.
+func (dauo *DunningAttemptUpdateOne) SetStatus(s string) *DunningAttemptUpdateOne {
+	dauo.mutation.SetStatus(s)
+	return dauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dauo *DunningAttemptUpdateOne) SetNillableStatus(s *string) *DunningAttemptUpdateOne {
+	if s != nil {
+		dauo.SetStatus(*s)
+	}
+	return dauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dauo *DunningAttemptUpdateOne) SetUpdatedAt(t time.Time) *DunningAttemptUpdateOne {
+	dauo.mutation.SetUpdatedAt(t)
+	return dauo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (dauo *DunningAttemptUpdateOne) SetUpdatedBy(s string) *DunningAttemptUpdateOne {
+	dauo.mutation.SetUpdatedBy(s)
+	return dauo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (dauo *DunningAttemptUpdateOne) SetNillableUpdatedBy(s *string) *DunningAttemptUpdateOne {
+	if s != nil {
+		dauo.SetUpdatedBy(*s)
+	}
+	return dauo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (dauo *DunningAttemptUpdateOne) ClearUpdatedBy() *DunningAttemptUpdateOne {
+	dauo.mutation.ClearUpdatedBy()
+	return dauo
+}
+
+// Mutation returns the DunningAttemptMutation object of the builder.
+func (dauo *DunningAttemptUpdateOne) Mutation() *DunningAttemptMutation {
+	return dauo.mutation
+}
+
+// Where appends a list predicates to the DunningAttemptUpdate builder.
+func (dauo *DunningAttemptUpdateOne) Where(ps ...predicate.DunningAttempt) *DunningAttemptUpdateOne {
+	dauo.mutation.Where(ps...)
+	return dauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dauo *DunningAttemptUpdateOne) Select(field string, fields ...string) *DunningAttemptUpdateOne {
+	dauo.fields = append([]string{field}, fields...)
+	return dauo
+}
+
+// Save executes the query and returns the updated DunningAttempt entity.
+func (dauo *DunningAttemptUpdateOne) Save(ctx context.Context) (*DunningAttempt, error) {
+	dauo.defaults()
+	return withHooks(ctx, dauo.sqlSave, dauo.mutation, dauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dauo *DunningAttemptUpdateOne) SaveX(ctx context.Context) *DunningAttempt {
+	node, err := dauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dauo *DunningAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := dauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dauo *DunningAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := dauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dauo *DunningAttemptUpdateOne) defaults() {
+	if _, ok := dauo.mutation.UpdatedAt(); !ok {
+		v := dunningattempt.UpdateDefaultUpdatedAt()
+		dauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (dauo *DunningAttemptUpdateOne) sqlSave(ctx context.Context) (_node *DunningAttempt, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dunningattempt.Table, dunningattempt.Columns, sqlgraph.NewFieldSpec(dunningattempt.FieldID, field.TypeString))
+	id, ok := dauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DunningAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dunningattempt.FieldID)
+		for _, f := range fields {
+			if !dunningattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dunningattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dauo.mutation.Status(); ok {
+		_spec.SetField(dunningattempt.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := dauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(dunningattempt.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if dauo.mutation.CreatedByCleared() {
+		_spec.ClearField(dunningattempt.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := dauo.mutation.UpdatedBy(); ok {
+		_spec.SetField(dunningattempt.FieldUpdatedBy, field.TypeString, value)
+	}
+	if dauo.mutation.UpdatedByCleared() {
+		_spec.ClearField(dunningattempt.FieldUpdatedBy, field.TypeString)
+	}
+	if dauo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(dunningattempt.FieldEnvironmentID, field.TypeString)
+	}
+	if dauo.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(dunningattempt.FieldSubscriptionID, field.TypeString)
+	}
+	if dauo.mutation.ErrorMessageCleared() {
+		_spec.ClearField(dunningattempt.FieldErrorMessage, field.TypeString)
+	}
+	_node = &DunningAttempt{config: dauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dunningattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dauo.mutation.done = true
+	return _node, nil
+}
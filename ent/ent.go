@@ -12,9 +12,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/flexprice/flexprice/ent/alertevent"
+	"github.com/flexprice/flexprice/ent/alertrule"
 	"github.com/flexprice/flexprice/ent/auth"
 	"github.com/flexprice/flexprice/ent/billingsequence"
+	"github.com/flexprice/flexprice/ent/coupon"
+	"github.com/flexprice/flexprice/ent/couponapplication"
+	"github.com/flexprice/flexprice/ent/creditnote"
+	"github.com/flexprice/flexprice/ent/creditnotelineitem"
+	"github.com/flexprice/flexprice/ent/creditnotesequence"
 	"github.com/flexprice/flexprice/ent/customer"
+	"github.com/flexprice/flexprice/ent/dunningattempt"
 	"github.com/flexprice/flexprice/ent/entitlement"
 	"github.com/flexprice/flexprice/ent/environment"
 	"github.com/flexprice/flexprice/ent/feature"
@@ -22,6 +30,7 @@ import (
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
 	"github.com/flexprice/flexprice/ent/invoicesequence"
 	"github.com/flexprice/flexprice/ent/meter"
+	"github.com/flexprice/flexprice/ent/outboxevent"
 	"github.com/flexprice/flexprice/ent/payment"
 	"github.com/flexprice/flexprice/ent/paymentattempt"
 	"github.com/flexprice/flexprice/ent/plan"
@@ -30,11 +39,15 @@ import (
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
 	"github.com/flexprice/flexprice/ent/task"
+	"github.com/flexprice/flexprice/ent/taxrate"
 	"github.com/flexprice/flexprice/ent/tenant"
 	"github.com/flexprice/flexprice/ent/user"
 	"github.com/flexprice/flexprice/ent/wallet"
 	"github.com/flexprice/flexprice/ent/wallettransaction"
+	"github.com/flexprice/flexprice/ent/webhookdelivery"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -95,29 +108,42 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			auth.Table:                 auth.ValidColumn,
-			billingsequence.Table:      billingsequence.ValidColumn,
-			customer.Table:             customer.ValidColumn,
-			entitlement.Table:          entitlement.ValidColumn,
-			environment.Table:          environment.ValidColumn,
-			feature.Table:              feature.ValidColumn,
-			invoice.Table:              invoice.ValidColumn,
-			invoicelineitem.Table:      invoicelineitem.ValidColumn,
-			invoicesequence.Table:      invoicesequence.ValidColumn,
-			meter.Table:                meter.ValidColumn,
-			payment.Table:              payment.ValidColumn,
-			paymentattempt.Table:       paymentattempt.ValidColumn,
-			plan.Table:                 plan.ValidColumn,
-			price.Table:                price.ValidColumn,
-			secret.Table:               secret.ValidColumn,
-			subscription.Table:         subscription.ValidColumn,
-			subscriptionlineitem.Table: subscriptionlineitem.ValidColumn,
-			subscriptionpause.Table:    subscriptionpause.ValidColumn,
-			task.Table:                 task.ValidColumn,
-			tenant.Table:               tenant.ValidColumn,
-			user.Table:                 user.ValidColumn,
-			wallet.Table:               wallet.ValidColumn,
-			wallettransaction.Table:    wallettransaction.ValidColumn,
+			alertevent.Table:                 alertevent.ValidColumn,
+			alertrule.Table:                  alertrule.ValidColumn,
+			auth.Table:                       auth.ValidColumn,
+			billingsequence.Table:            billingsequence.ValidColumn,
+			coupon.Table:                     coupon.ValidColumn,
+			couponapplication.Table:          couponapplication.ValidColumn,
+			creditnote.Table:                 creditnote.ValidColumn,
+			creditnotelineitem.Table:         creditnotelineitem.ValidColumn,
+			creditnotesequence.Table:         creditnotesequence.ValidColumn,
+			customer.Table:                   customer.ValidColumn,
+			dunningattempt.Table:             dunningattempt.ValidColumn,
+			entitlement.Table:                entitlement.ValidColumn,
+			environment.Table:                environment.ValidColumn,
+			feature.Table:                    feature.ValidColumn,
+			invoice.Table:                    invoice.ValidColumn,
+			invoicelineitem.Table:            invoicelineitem.ValidColumn,
+			invoicesequence.Table:            invoicesequence.ValidColumn,
+			meter.Table:                      meter.ValidColumn,
+			outboxevent.Table:                outboxevent.ValidColumn,
+			payment.Table:                    payment.ValidColumn,
+			paymentattempt.Table:             paymentattempt.ValidColumn,
+			plan.Table:                       plan.ValidColumn,
+			price.Table:                      price.ValidColumn,
+			secret.Table:                     secret.ValidColumn,
+			subscription.Table:               subscription.ValidColumn,
+			subscriptionlineitem.Table:       subscriptionlineitem.ValidColumn,
+			subscriptionpause.Table:          subscriptionpause.ValidColumn,
+			subscriptionquantitychange.Table: subscriptionquantitychange.ValidColumn,
+			task.Table:                       task.ValidColumn,
+			taxrate.Table:                    taxrate.ValidColumn,
+			tenant.Table:                     tenant.ValidColumn,
+			user.Table:                       user.ValidColumn,
+			wallet.Table:                     wallet.ValidColumn,
+			wallettransaction.Table:          wallettransaction.ValidColumn,
+			webhookdelivery.Table:            webhookdelivery.ValidColumn,
+			webhookendpoint.Table:            webhookendpoint.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
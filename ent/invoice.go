@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/invoice"
+	"github.com/flexprice/flexprice/ent/schema"
 	"github.com/shopspring/decimal"
 )
 
@@ -51,6 +52,20 @@ type Invoice struct {
 	AmountPaid decimal.Decimal `json:"amount_paid,omitempty"`
 	// AmountRemaining holds the value of the "amount_remaining" field.
 	AmountRemaining decimal.Decimal `json:"amount_remaining,omitempty"`
+	// AmountCredited holds the value of the "amount_credited" field.
+	AmountCredited decimal.Decimal `json:"amount_credited,omitempty"`
+	// Subtotal holds the value of the "subtotal" field.
+	Subtotal decimal.Decimal `json:"subtotal,omitempty"`
+	// TotalDiscount holds the value of the "total_discount" field.
+	TotalDiscount decimal.Decimal `json:"total_discount,omitempty"`
+	// Total holds the value of the "total" field.
+	Total decimal.Decimal `json:"total,omitempty"`
+	// Discounts holds the value of the "discounts" field.
+	Discounts []schema.InvoiceDiscount `json:"discounts,omitempty"`
+	// TotalTax holds the value of the "total_tax" field.
+	TotalTax decimal.Decimal `json:"total_tax,omitempty"`
+	// Taxes holds the value of the "taxes" field.
+	Taxes []schema.InvoiceTax `json:"taxes,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
 	// DueDate holds the value of the "due_date" field.
@@ -110,9 +125,9 @@ func (*Invoice) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case invoice.FieldMetadata:
+		case invoice.FieldDiscounts, invoice.FieldTaxes, invoice.FieldMetadata:
 			values[i] = new([]byte)
-		case invoice.FieldAmountDue, invoice.FieldAmountPaid, invoice.FieldAmountRemaining:
+		case invoice.FieldAmountDue, invoice.FieldAmountPaid, invoice.FieldAmountRemaining, invoice.FieldAmountCredited, invoice.FieldSubtotal, invoice.FieldTotalDiscount, invoice.FieldTotal, invoice.FieldTotalTax:
 			values[i] = new(decimal.Decimal)
 		case invoice.FieldVersion, invoice.FieldBillingSequence:
 			values[i] = new(sql.NullInt64)
@@ -238,6 +253,52 @@ func (i *Invoice) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				i.AmountRemaining = *value
 			}
+		case invoice.FieldAmountCredited:
+			if value, ok := values[j].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_credited", values[j])
+			} else if value != nil {
+				i.AmountCredited = *value
+			}
+		case invoice.FieldSubtotal:
+			if value, ok := values[j].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[j])
+			} else if value != nil {
+				i.Subtotal = *value
+			}
+		case invoice.FieldTotalDiscount:
+			if value, ok := values[j].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total_discount", values[j])
+			} else if value != nil {
+				i.TotalDiscount = *value
+			}
+		case invoice.FieldTotal:
+			if value, ok := values[j].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[j])
+			} else if value != nil {
+				i.Total = *value
+			}
+		case invoice.FieldDiscounts:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field discounts", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.Discounts); err != nil {
+					return fmt.Errorf("unmarshal field discounts: %w", err)
+				}
+			}
+		case invoice.FieldTotalTax:
+			if value, ok := values[j].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total_tax", values[j])
+			} else if value != nil {
+				i.TotalTax = *value
+			}
+		case invoice.FieldTaxes:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field taxes", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.Taxes); err != nil {
+					return fmt.Errorf("unmarshal field taxes: %w", err)
+				}
+			}
 		case invoice.FieldDescription:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[j])
@@ -432,6 +493,27 @@ func (i *Invoice) String() string {
 	builder.WriteString("amount_remaining=")
 	builder.WriteString(fmt.Sprintf("%v", i.AmountRemaining))
 	builder.WriteString(", ")
+	builder.WriteString("amount_credited=")
+	builder.WriteString(fmt.Sprintf("%v", i.AmountCredited))
+	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", i.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("total_discount=")
+	builder.WriteString(fmt.Sprintf("%v", i.TotalDiscount))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", i.Total))
+	builder.WriteString(", ")
+	builder.WriteString("discounts=")
+	builder.WriteString(fmt.Sprintf("%v", i.Discounts))
+	builder.WriteString(", ")
+	builder.WriteString("total_tax=")
+	builder.WriteString(fmt.Sprintf("%v", i.TotalTax))
+	builder.WriteString(", ")
+	builder.WriteString("taxes=")
+	builder.WriteString(fmt.Sprintf("%v", i.Taxes))
+	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(i.Description)
 	builder.WriteString(", ")
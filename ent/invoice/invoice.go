@@ -47,6 +47,20 @@ const (
 	FieldAmountPaid = "amount_paid"
 	// FieldAmountRemaining holds the string denoting the amount_remaining field in the database.
 	FieldAmountRemaining = "amount_remaining"
+	// FieldAmountCredited holds the string denoting the amount_credited field in the database.
+	FieldAmountCredited = "amount_credited"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldTotalDiscount holds the string denoting the total_discount field in the database.
+	FieldTotalDiscount = "total_discount"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldDiscounts holds the string denoting the discounts field in the database.
+	FieldDiscounts = "discounts"
+	// FieldTotalTax holds the string denoting the total_tax field in the database.
+	FieldTotalTax = "total_tax"
+	// FieldTaxes holds the string denoting the taxes field in the database.
+	FieldTaxes = "taxes"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
 	// FieldDueDate holds the string denoting the due_date field in the database.
@@ -109,6 +123,13 @@ var Columns = []string{
 	FieldAmountDue,
 	FieldAmountPaid,
 	FieldAmountRemaining,
+	FieldAmountCredited,
+	FieldSubtotal,
+	FieldTotalDiscount,
+	FieldTotal,
+	FieldDiscounts,
+	FieldTotalTax,
+	FieldTaxes,
 	FieldDescription,
 	FieldDueDate,
 	FieldPaidAt,
@@ -165,6 +186,16 @@ var (
 	DefaultAmountPaid decimal.Decimal
 	// DefaultAmountRemaining holds the default value on creation for the "amount_remaining" field.
 	DefaultAmountRemaining decimal.Decimal
+	// DefaultAmountCredited holds the default value on creation for the "amount_credited" field.
+	DefaultAmountCredited decimal.Decimal
+	// DefaultSubtotal holds the default value on creation for the "subtotal" field.
+	DefaultSubtotal decimal.Decimal
+	// DefaultTotalDiscount holds the default value on creation for the "total_discount" field.
+	DefaultTotalDiscount decimal.Decimal
+	// DefaultTotal holds the default value on creation for the "total" field.
+	DefaultTotal decimal.Decimal
+	// DefaultTotalTax holds the default value on creation for the "total_tax" field.
+	DefaultTotalTax decimal.Decimal
 	// DefaultVersion holds the default value on creation for the "version" field.
 	DefaultVersion int
 )
@@ -257,6 +288,31 @@ func ByAmountRemaining(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmountRemaining, opts...).ToFunc()
 }
 
+// ByAmountCredited orders the results by the amount_credited field.
+func ByAmountCredited(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountCredited, opts...).ToFunc()
+}
+
+// BySubtotal orders the results by the subtotal field.
+func BySubtotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtotal, opts...).ToFunc()
+}
+
+// ByTotalDiscount orders the results by the total_discount field.
+func ByTotalDiscount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalDiscount, opts...).ToFunc()
+}
+
+// ByTotal orders the results by the total field.
+func ByTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotal, opts...).ToFunc()
+}
+
+// ByTotalTax orders the results by the total_tax field.
+func ByTotalTax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalTax, opts...).ToFunc()
+}
+
 // ByDescription orders the results by the description field.
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
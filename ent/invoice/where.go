@@ -146,6 +146,31 @@ func AmountRemaining(v decimal.Decimal) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldAmountRemaining, v))
 }
 
+// AmountCredited applies equality check predicate on the "amount_credited" field. It's identical to AmountCreditedEQ.
+func AmountCredited(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldAmountCredited, v))
+}
+
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// TotalDiscount applies equality check predicate on the "total_discount" field. It's identical to TotalDiscountEQ.
+func TotalDiscount(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotalDiscount, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalTax applies equality check predicate on the "total_tax" field. It's identical to TotalTaxEQ.
+func TotalTax(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotalTax, v))
+}
+
 // Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
 func Description(v string) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldDescription, v))
@@ -1171,6 +1196,226 @@ func AmountRemainingLTE(v decimal.Decimal) predicate.Invoice {
 	return predicate.Invoice(sql.FieldLTE(FieldAmountRemaining, v))
 }
 
+// AmountCreditedEQ applies the EQ predicate on the "amount_credited" field.
+func AmountCreditedEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldAmountCredited, v))
+}
+
+// AmountCreditedNEQ applies the NEQ predicate on the "amount_credited" field.
+func AmountCreditedNEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldAmountCredited, v))
+}
+
+// AmountCreditedIn applies the In predicate on the "amount_credited" field.
+func AmountCreditedIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldAmountCredited, vs...))
+}
+
+// AmountCreditedNotIn applies the NotIn predicate on the "amount_credited" field.
+func AmountCreditedNotIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldAmountCredited, vs...))
+}
+
+// AmountCreditedGT applies the GT predicate on the "amount_credited" field.
+func AmountCreditedGT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldAmountCredited, v))
+}
+
+// AmountCreditedGTE applies the GTE predicate on the "amount_credited" field.
+func AmountCreditedGTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldAmountCredited, v))
+}
+
+// AmountCreditedLT applies the LT predicate on the "amount_credited" field.
+func AmountCreditedLT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldAmountCredited, v))
+}
+
+// AmountCreditedLTE applies the LTE predicate on the "amount_credited" field.
+func AmountCreditedLTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldAmountCredited, v))
+}
+
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSubtotal, v))
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSubtotal, vs...))
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSubtotal, vs...))
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSubtotal, v))
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSubtotal, v))
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSubtotal, v))
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSubtotal, v))
+}
+
+// TotalDiscountEQ applies the EQ predicate on the "total_discount" field.
+func TotalDiscountEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotalDiscount, v))
+}
+
+// TotalDiscountNEQ applies the NEQ predicate on the "total_discount" field.
+func TotalDiscountNEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldTotalDiscount, v))
+}
+
+// TotalDiscountIn applies the In predicate on the "total_discount" field.
+func TotalDiscountIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldTotalDiscount, vs...))
+}
+
+// TotalDiscountNotIn applies the NotIn predicate on the "total_discount" field.
+func TotalDiscountNotIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldTotalDiscount, vs...))
+}
+
+// TotalDiscountGT applies the GT predicate on the "total_discount" field.
+func TotalDiscountGT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldTotalDiscount, v))
+}
+
+// TotalDiscountGTE applies the GTE predicate on the "total_discount" field.
+func TotalDiscountGTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldTotalDiscount, v))
+}
+
+// TotalDiscountLT applies the LT predicate on the "total_discount" field.
+func TotalDiscountLT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldTotalDiscount, v))
+}
+
+// TotalDiscountLTE applies the LTE predicate on the "total_discount" field.
+func TotalDiscountLTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldTotalDiscount, v))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldTotal, v))
+}
+
+// DiscountsIsNil applies the IsNil predicate on the "discounts" field.
+func DiscountsIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldDiscounts))
+}
+
+// DiscountsNotNil applies the NotNil predicate on the "discounts" field.
+func DiscountsNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldDiscounts))
+}
+
+// TotalTaxEQ applies the EQ predicate on the "total_tax" field.
+func TotalTaxEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotalTax, v))
+}
+
+// TotalTaxNEQ applies the NEQ predicate on the "total_tax" field.
+func TotalTaxNEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldTotalTax, v))
+}
+
+// TotalTaxIn applies the In predicate on the "total_tax" field.
+func TotalTaxIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldTotalTax, vs...))
+}
+
+// TotalTaxNotIn applies the NotIn predicate on the "total_tax" field.
+func TotalTaxNotIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldTotalTax, vs...))
+}
+
+// TotalTaxGT applies the GT predicate on the "total_tax" field.
+func TotalTaxGT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldTotalTax, v))
+}
+
+// TotalTaxGTE applies the GTE predicate on the "total_tax" field.
+func TotalTaxGTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldTotalTax, v))
+}
+
+// TotalTaxLT applies the LT predicate on the "total_tax" field.
+func TotalTaxLT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldTotalTax, v))
+}
+
+// TotalTaxLTE applies the LTE predicate on the "total_tax" field.
+func TotalTaxLTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldTotalTax, v))
+}
+
+// TaxesIsNil applies the IsNil predicate on the "taxes" field.
+func TaxesIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldTaxes))
+}
+
+// TaxesNotNil applies the NotNil predicate on the "taxes" field.
+func TaxesNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldTaxes))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldDescription, v))
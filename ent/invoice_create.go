@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/invoice"
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
+	"github.com/flexprice/flexprice/ent/schema"
 	"github.com/shopspring/decimal"
 )
 
@@ -214,6 +215,88 @@ func (ic *InvoiceCreate) SetNillableAmountRemaining(d *decimal.Decimal) *Invoice
 	return ic
 }
 
+// SetAmountCredited sets the "amount_credited" field.
+func (ic *InvoiceCreate) SetAmountCredited(d decimal.Decimal) *InvoiceCreate {
+	ic.mutation.SetAmountCredited(d)
+	return ic
+}
+
+// SetNillableAmountCredited sets the "amount_credited" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableAmountCredited(d *decimal.Decimal) *InvoiceCreate {
+	if d != nil {
+		ic.SetAmountCredited(*d)
+	}
+	return ic
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (ic *InvoiceCreate) SetSubtotal(d decimal.Decimal) *InvoiceCreate {
+	ic.mutation.SetSubtotal(d)
+	return ic
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableSubtotal(d *decimal.Decimal) *InvoiceCreate {
+	if d != nil {
+		ic.SetSubtotal(*d)
+	}
+	return ic
+}
+
+// SetTotalDiscount sets the "total_discount" field.
+func (ic *InvoiceCreate) SetTotalDiscount(d decimal.Decimal) *InvoiceCreate {
+	ic.mutation.SetTotalDiscount(d)
+	return ic
+}
+
+// SetNillableTotalDiscount sets the "total_discount" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableTotalDiscount(d *decimal.Decimal) *InvoiceCreate {
+	if d != nil {
+		ic.SetTotalDiscount(*d)
+	}
+	return ic
+}
+
+// SetTotal sets the "total" field.
+func (ic *InvoiceCreate) SetTotal(d decimal.Decimal) *InvoiceCreate {
+	ic.mutation.SetTotal(d)
+	return ic
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableTotal(d *decimal.Decimal) *InvoiceCreate {
+	if d != nil {
+		ic.SetTotal(*d)
+	}
+	return ic
+}
+
+// SetDiscounts sets the "discounts" field.
+func (ic *InvoiceCreate) SetDiscounts(sd []schema.InvoiceDiscount) *InvoiceCreate {
+	ic.mutation.SetDiscounts(sd)
+	return ic
+}
+
+// SetTotalTax sets the "total_tax" field.
+func (ic *InvoiceCreate) SetTotalTax(d decimal.Decimal) *InvoiceCreate {
+	ic.mutation.SetTotalTax(d)
+	return ic
+}
+
+// SetNillableTotalTax sets the "total_tax" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableTotalTax(d *decimal.Decimal) *InvoiceCreate {
+	if d != nil {
+		ic.SetTotalTax(*d)
+	}
+	return ic
+}
+
+// SetTaxes sets the "taxes" field.
+func (ic *InvoiceCreate) SetTaxes(st []schema.InvoiceTax) *InvoiceCreate {
+	ic.mutation.SetTaxes(st)
+	return ic
+}
+
 // SetDescription sets the "description" field.
 func (ic *InvoiceCreate) SetDescription(s string) *InvoiceCreate {
 	ic.mutation.SetDescription(s)
@@ -508,6 +591,26 @@ func (ic *InvoiceCreate) defaults() {
 		v := invoice.DefaultAmountRemaining
 		ic.mutation.SetAmountRemaining(v)
 	}
+	if _, ok := ic.mutation.AmountCredited(); !ok {
+		v := invoice.DefaultAmountCredited
+		ic.mutation.SetAmountCredited(v)
+	}
+	if _, ok := ic.mutation.Subtotal(); !ok {
+		v := invoice.DefaultSubtotal
+		ic.mutation.SetSubtotal(v)
+	}
+	if _, ok := ic.mutation.TotalDiscount(); !ok {
+		v := invoice.DefaultTotalDiscount
+		ic.mutation.SetTotalDiscount(v)
+	}
+	if _, ok := ic.mutation.Total(); !ok {
+		v := invoice.DefaultTotal
+		ic.mutation.SetTotal(v)
+	}
+	if _, ok := ic.mutation.TotalTax(); !ok {
+		v := invoice.DefaultTotalTax
+		ic.mutation.SetTotalTax(v)
+	}
 	if _, ok := ic.mutation.Version(); !ok {
 		v := invoice.DefaultVersion
 		ic.mutation.SetVersion(v)
@@ -572,6 +675,21 @@ func (ic *InvoiceCreate) check() error {
 	if _, ok := ic.mutation.AmountRemaining(); !ok {
 		return &ValidationError{Name: "amount_remaining", err: errors.New(`ent: missing required field "Invoice.amount_remaining"`)}
 	}
+	if _, ok := ic.mutation.AmountCredited(); !ok {
+		return &ValidationError{Name: "amount_credited", err: errors.New(`ent: missing required field "Invoice.amount_credited"`)}
+	}
+	if _, ok := ic.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "Invoice.subtotal"`)}
+	}
+	if _, ok := ic.mutation.TotalDiscount(); !ok {
+		return &ValidationError{Name: "total_discount", err: errors.New(`ent: missing required field "Invoice.total_discount"`)}
+	}
+	if _, ok := ic.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "Invoice.total"`)}
+	}
+	if _, ok := ic.mutation.TotalTax(); !ok {
+		return &ValidationError{Name: "total_tax", err: errors.New(`ent: missing required field "Invoice.total_tax"`)}
+	}
 	if _, ok := ic.mutation.Version(); !ok {
 		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Invoice.version"`)}
 	}
@@ -674,6 +792,34 @@ func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		_spec.SetField(invoice.FieldAmountRemaining, field.TypeOther, value)
 		_node.AmountRemaining = value
 	}
+	if value, ok := ic.mutation.AmountCredited(); ok {
+		_spec.SetField(invoice.FieldAmountCredited, field.TypeOther, value)
+		_node.AmountCredited = value
+	}
+	if value, ok := ic.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeOther, value)
+		_node.Subtotal = value
+	}
+	if value, ok := ic.mutation.TotalDiscount(); ok {
+		_spec.SetField(invoice.FieldTotalDiscount, field.TypeOther, value)
+		_node.TotalDiscount = value
+	}
+	if value, ok := ic.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeOther, value)
+		_node.Total = value
+	}
+	if value, ok := ic.mutation.Discounts(); ok {
+		_spec.SetField(invoice.FieldDiscounts, field.TypeJSON, value)
+		_node.Discounts = value
+	}
+	if value, ok := ic.mutation.TotalTax(); ok {
+		_spec.SetField(invoice.FieldTotalTax, field.TypeOther, value)
+		_node.TotalTax = value
+	}
+	if value, ok := ic.mutation.Taxes(); ok {
+		_spec.SetField(invoice.FieldTaxes, field.TypeJSON, value)
+		_node.Taxes = value
+	}
 	if value, ok := ic.mutation.Description(); ok {
 		_spec.SetField(invoice.FieldDescription, field.TypeString, value)
 		_node.Description = value
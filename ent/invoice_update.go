@@ -10,10 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/invoice"
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/schema"
 	"github.com/shopspring/decimal"
 )
 
@@ -160,6 +162,112 @@ func (iu *InvoiceUpdate) SetNillableAmountRemaining(d *decimal.Decimal) *Invoice
 	return iu
 }
 
+// SetAmountCredited sets the "amount_credited" field.
+func (iu *InvoiceUpdate) SetAmountCredited(d decimal.Decimal) *InvoiceUpdate {
+	iu.mutation.SetAmountCredited(d)
+	return iu
+}
+
+// SetNillableAmountCredited sets the "amount_credited" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableAmountCredited(d *decimal.Decimal) *InvoiceUpdate {
+	if d != nil {
+		iu.SetAmountCredited(*d)
+	}
+	return iu
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (iu *InvoiceUpdate) SetSubtotal(d decimal.Decimal) *InvoiceUpdate {
+	iu.mutation.SetSubtotal(d)
+	return iu
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableSubtotal(d *decimal.Decimal) *InvoiceUpdate {
+	if d != nil {
+		iu.SetSubtotal(*d)
+	}
+	return iu
+}
+
+// SetTotalDiscount sets the "total_discount" field.
+func (iu *InvoiceUpdate) SetTotalDiscount(d decimal.Decimal) *InvoiceUpdate {
+	iu.mutation.SetTotalDiscount(d)
+	return iu
+}
+
+// SetNillableTotalDiscount sets the "total_discount" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableTotalDiscount(d *decimal.Decimal) *InvoiceUpdate {
+	if d != nil {
+		iu.SetTotalDiscount(*d)
+	}
+	return iu
+}
+
+// SetTotal sets the "total" field.
+func (iu *InvoiceUpdate) SetTotal(d decimal.Decimal) *InvoiceUpdate {
+	iu.mutation.SetTotal(d)
+	return iu
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableTotal(d *decimal.Decimal) *InvoiceUpdate {
+	if d != nil {
+		iu.SetTotal(*d)
+	}
+	return iu
+}
+
+// SetDiscounts sets the "discounts" field.
+func (iu *InvoiceUpdate) SetDiscounts(sd []schema.InvoiceDiscount) *InvoiceUpdate {
+	iu.mutation.SetDiscounts(sd)
+	return iu
+}
+
+// AppendDiscounts appends sd to the "discounts" field.
+func (iu *InvoiceUpdate) AppendDiscounts(sd []schema.InvoiceDiscount) *InvoiceUpdate {
+	iu.mutation.AppendDiscounts(sd)
+	return iu
+}
+
+// ClearDiscounts clears the value of the "discounts" field.
+func (iu *InvoiceUpdate) ClearDiscounts() *InvoiceUpdate {
+	iu.mutation.ClearDiscounts()
+	return iu
+}
+
+// SetTotalTax sets the "total_tax" field.
+func (iu *InvoiceUpdate) SetTotalTax(d decimal.Decimal) *InvoiceUpdate {
+	iu.mutation.SetTotalTax(d)
+	return iu
+}
+
+// SetNillableTotalTax sets the "total_tax" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableTotalTax(d *decimal.Decimal) *InvoiceUpdate {
+	if d != nil {
+		iu.SetTotalTax(*d)
+	}
+	return iu
+}
+
+// SetTaxes sets the "taxes" field.
+func (iu *InvoiceUpdate) SetTaxes(st []schema.InvoiceTax) *InvoiceUpdate {
+	iu.mutation.SetTaxes(st)
+	return iu
+}
+
+// AppendTaxes appends st to the "taxes" field.
+func (iu *InvoiceUpdate) AppendTaxes(st []schema.InvoiceTax) *InvoiceUpdate {
+	iu.mutation.AppendTaxes(st)
+	return iu
+}
+
+// ClearTaxes clears the value of the "taxes" field.
+func (iu *InvoiceUpdate) ClearTaxes() *InvoiceUpdate {
+	iu.mutation.ClearTaxes()
+	return iu
+}
+
 // SetDescription sets the "description" field.
 func (iu *InvoiceUpdate) SetDescription(s string) *InvoiceUpdate {
 	iu.mutation.SetDescription(s)
@@ -525,6 +633,43 @@ func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.AmountRemaining(); ok {
 		_spec.SetField(invoice.FieldAmountRemaining, field.TypeOther, value)
 	}
+	if value, ok := iu.mutation.AmountCredited(); ok {
+		_spec.SetField(invoice.FieldAmountCredited, field.TypeOther, value)
+	}
+	if value, ok := iu.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeOther, value)
+	}
+	if value, ok := iu.mutation.TotalDiscount(); ok {
+		_spec.SetField(invoice.FieldTotalDiscount, field.TypeOther, value)
+	}
+	if value, ok := iu.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := iu.mutation.Discounts(); ok {
+		_spec.SetField(invoice.FieldDiscounts, field.TypeJSON, value)
+	}
+	if value, ok := iu.mutation.AppendedDiscounts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldDiscounts, value)
+		})
+	}
+	if iu.mutation.DiscountsCleared() {
+		_spec.ClearField(invoice.FieldDiscounts, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.TotalTax(); ok {
+		_spec.SetField(invoice.FieldTotalTax, field.TypeOther, value)
+	}
+	if value, ok := iu.mutation.Taxes(); ok {
+		_spec.SetField(invoice.FieldTaxes, field.TypeJSON, value)
+	}
+	if value, ok := iu.mutation.AppendedTaxes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldTaxes, value)
+		})
+	}
+	if iu.mutation.TaxesCleared() {
+		_spec.ClearField(invoice.FieldTaxes, field.TypeJSON)
+	}
 	if value, ok := iu.mutation.Description(); ok {
 		_spec.SetField(invoice.FieldDescription, field.TypeString, value)
 	}
@@ -804,6 +949,112 @@ func (iuo *InvoiceUpdateOne) SetNillableAmountRemaining(d *decimal.Decimal) *Inv
 	return iuo
 }
 
+// SetAmountCredited sets the "amount_credited" field.
+func (iuo *InvoiceUpdateOne) SetAmountCredited(d decimal.Decimal) *InvoiceUpdateOne {
+	iuo.mutation.SetAmountCredited(d)
+	return iuo
+}
+
+// SetNillableAmountCredited sets the "amount_credited" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableAmountCredited(d *decimal.Decimal) *InvoiceUpdateOne {
+	if d != nil {
+		iuo.SetAmountCredited(*d)
+	}
+	return iuo
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (iuo *InvoiceUpdateOne) SetSubtotal(d decimal.Decimal) *InvoiceUpdateOne {
+	iuo.mutation.SetSubtotal(d)
+	return iuo
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableSubtotal(d *decimal.Decimal) *InvoiceUpdateOne {
+	if d != nil {
+		iuo.SetSubtotal(*d)
+	}
+	return iuo
+}
+
+// SetTotalDiscount sets the "total_discount" field.
+func (iuo *InvoiceUpdateOne) SetTotalDiscount(d decimal.Decimal) *InvoiceUpdateOne {
+	iuo.mutation.SetTotalDiscount(d)
+	return iuo
+}
+
+// SetNillableTotalDiscount sets the "total_discount" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableTotalDiscount(d *decimal.Decimal) *InvoiceUpdateOne {
+	if d != nil {
+		iuo.SetTotalDiscount(*d)
+	}
+	return iuo
+}
+
+// SetTotal sets the "total" field.
+func (iuo *InvoiceUpdateOne) SetTotal(d decimal.Decimal) *InvoiceUpdateOne {
+	iuo.mutation.SetTotal(d)
+	return iuo
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableTotal(d *decimal.Decimal) *InvoiceUpdateOne {
+	if d != nil {
+		iuo.SetTotal(*d)
+	}
+	return iuo
+}
+
+// SetDiscounts sets the "discounts" field.
+func (iuo *InvoiceUpdateOne) SetDiscounts(sd []schema.InvoiceDiscount) *InvoiceUpdateOne {
+	iuo.mutation.SetDiscounts(sd)
+	return iuo
+}
+
+// AppendDiscounts appends sd to the "discounts" field.
+func (iuo *InvoiceUpdateOne) AppendDiscounts(sd []schema.InvoiceDiscount) *InvoiceUpdateOne {
+	iuo.mutation.AppendDiscounts(sd)
+	return iuo
+}
+
+// ClearDiscounts clears the value of the "discounts" field.
+func (iuo *InvoiceUpdateOne) ClearDiscounts() *InvoiceUpdateOne {
+	iuo.mutation.ClearDiscounts()
+	return iuo
+}
+
+// SetTotalTax sets the "total_tax" field.
+func (iuo *InvoiceUpdateOne) SetTotalTax(d decimal.Decimal) *InvoiceUpdateOne {
+	iuo.mutation.SetTotalTax(d)
+	return iuo
+}
+
+// SetNillableTotalTax sets the "total_tax" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableTotalTax(d *decimal.Decimal) *InvoiceUpdateOne {
+	if d != nil {
+		iuo.SetTotalTax(*d)
+	}
+	return iuo
+}
+
+// SetTaxes sets the "taxes" field.
+func (iuo *InvoiceUpdateOne) SetTaxes(st []schema.InvoiceTax) *InvoiceUpdateOne {
+	iuo.mutation.SetTaxes(st)
+	return iuo
+}
+
+// AppendTaxes appends st to the "taxes" field.
+func (iuo *InvoiceUpdateOne) AppendTaxes(st []schema.InvoiceTax) *InvoiceUpdateOne {
+	iuo.mutation.AppendTaxes(st)
+	return iuo
+}
+
+// ClearTaxes clears the value of the "taxes" field.
+func (iuo *InvoiceUpdateOne) ClearTaxes() *InvoiceUpdateOne {
+	iuo.mutation.ClearTaxes()
+	return iuo
+}
+
 // SetDescription sets the "description" field.
 func (iuo *InvoiceUpdateOne) SetDescription(s string) *InvoiceUpdateOne {
 	iuo.mutation.SetDescription(s)
@@ -1199,6 +1450,43 @@ func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err e
 	if value, ok := iuo.mutation.AmountRemaining(); ok {
 		_spec.SetField(invoice.FieldAmountRemaining, field.TypeOther, value)
 	}
+	if value, ok := iuo.mutation.AmountCredited(); ok {
+		_spec.SetField(invoice.FieldAmountCredited, field.TypeOther, value)
+	}
+	if value, ok := iuo.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeOther, value)
+	}
+	if value, ok := iuo.mutation.TotalDiscount(); ok {
+		_spec.SetField(invoice.FieldTotalDiscount, field.TypeOther, value)
+	}
+	if value, ok := iuo.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := iuo.mutation.Discounts(); ok {
+		_spec.SetField(invoice.FieldDiscounts, field.TypeJSON, value)
+	}
+	if value, ok := iuo.mutation.AppendedDiscounts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldDiscounts, value)
+		})
+	}
+	if iuo.mutation.DiscountsCleared() {
+		_spec.ClearField(invoice.FieldDiscounts, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.TotalTax(); ok {
+		_spec.SetField(invoice.FieldTotalTax, field.TypeOther, value)
+	}
+	if value, ok := iuo.mutation.Taxes(); ok {
+		_spec.SetField(invoice.FieldTaxes, field.TypeJSON, value)
+	}
+	if value, ok := iuo.mutation.AppendedTaxes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldTaxes, value)
+		})
+	}
+	if iuo.mutation.TaxesCleared() {
+		_spec.ClearField(invoice.FieldTaxes, field.TypeJSON)
+	}
 	if value, ok := iuo.mutation.Description(); ok {
 		_spec.SetField(invoice.FieldDescription, field.TypeString, value)
 	}
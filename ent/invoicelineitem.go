@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/invoice"
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
+	"github.com/flexprice/flexprice/ent/schema"
 	"github.com/shopspring/decimal"
 )
 
@@ -64,6 +65,8 @@ type InvoiceLineItem struct {
 	PeriodStart *time.Time `json:"period_start,omitempty"`
 	// PeriodEnd holds the value of the "period_end" field.
 	PeriodEnd *time.Time `json:"period_end,omitempty"`
+	// TierBreakdown holds the value of the "tier_breakdown" field.
+	TierBreakdown []schema.InvoiceLineItemTier `json:"tier_breakdown,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -97,7 +100,7 @@ func (*InvoiceLineItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case invoicelineitem.FieldMetadata:
+		case invoicelineitem.FieldTierBreakdown, invoicelineitem.FieldMetadata:
 			values[i] = new([]byte)
 		case invoicelineitem.FieldAmount, invoicelineitem.FieldQuantity:
 			values[i] = new(decimal.Decimal)
@@ -268,6 +271,14 @@ func (ili *InvoiceLineItem) assignValues(columns []string, values []any) error {
 				ili.PeriodEnd = new(time.Time)
 				*ili.PeriodEnd = value.Time
 			}
+		case invoicelineitem.FieldTierBreakdown:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tier_breakdown", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ili.TierBreakdown); err != nil {
+					return fmt.Errorf("unmarshal field tier_breakdown: %w", err)
+				}
+			}
 		case invoicelineitem.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -403,6 +414,9 @@ func (ili *InvoiceLineItem) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("tier_breakdown=")
+	builder.WriteString(fmt.Sprintf("%v", ili.TierBreakdown))
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", ili.Metadata))
 	builder.WriteByte(')')
@@ -59,6 +59,8 @@ const (
 	FieldPeriodStart = "period_start"
 	// FieldPeriodEnd holds the string denoting the period_end field in the database.
 	FieldPeriodEnd = "period_end"
+	// FieldTierBreakdown holds the string denoting the tier_breakdown field in the database.
+	FieldTierBreakdown = "tier_breakdown"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
@@ -99,6 +101,7 @@ var Columns = []string{
 	FieldCurrency,
 	FieldPeriodStart,
 	FieldPeriodEnd,
+	FieldTierBreakdown,
 	FieldMetadata,
 }
 
@@ -1586,6 +1586,16 @@ func PeriodEndNotNil() predicate.InvoiceLineItem {
 	return predicate.InvoiceLineItem(sql.FieldNotNull(FieldPeriodEnd))
 }
 
+// TierBreakdownIsNil applies the IsNil predicate on the "tier_breakdown" field.
+func TierBreakdownIsNil() predicate.InvoiceLineItem {
+	return predicate.InvoiceLineItem(sql.FieldIsNull(FieldTierBreakdown))
+}
+
+// TierBreakdownNotNil applies the NotNil predicate on the "tier_breakdown" field.
+func TierBreakdownNotNil() predicate.InvoiceLineItem {
+	return predicate.InvoiceLineItem(sql.FieldNotNull(FieldTierBreakdown))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.InvoiceLineItem {
 	return predicate.InvoiceLineItem(sql.FieldIsNull(FieldMetadata))
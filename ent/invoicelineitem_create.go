@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/invoice"
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
+	"github.com/flexprice/flexprice/ent/schema"
 	"github.com/shopspring/decimal"
 )
 
@@ -298,6 +299,12 @@ func (ilic *InvoiceLineItemCreate) SetNillablePeriodEnd(t *time.Time) *InvoiceLi
 	return ilic
 }
 
+// SetTierBreakdown sets the "tier_breakdown" field.
+func (ilic *InvoiceLineItemCreate) SetTierBreakdown(slit []schema.InvoiceLineItemTier) *InvoiceLineItemCreate {
+	ilic.mutation.SetTierBreakdown(slit)
+	return ilic
+}
+
 // SetMetadata sets the "metadata" field.
 func (ilic *InvoiceLineItemCreate) SetMetadata(m map[string]string) *InvoiceLineItemCreate {
 	ilic.mutation.SetMetadata(m)
@@ -547,6 +554,10 @@ func (ilic *InvoiceLineItemCreate) createSpec() (*InvoiceLineItem, *sqlgraph.Cre
 		_spec.SetField(invoicelineitem.FieldPeriodEnd, field.TypeTime, value)
 		_node.PeriodEnd = &value
 	}
+	if value, ok := ilic.mutation.TierBreakdown(); ok {
+		_spec.SetField(invoicelineitem.FieldTierBreakdown, field.TypeJSON, value)
+		_node.TierBreakdown = value
+	}
 	if value, ok := ilic.mutation.Metadata(); ok {
 		_spec.SetField(invoicelineitem.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
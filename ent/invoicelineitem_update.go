@@ -10,9 +10,11 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/schema"
 )
 
 // InvoiceLineItemUpdate is the builder for updating InvoiceLineItem entities.
@@ -108,6 +110,24 @@ func (iliu *InvoiceLineItemUpdate) ClearPeriodEnd() *InvoiceLineItemUpdate {
 	return iliu
 }
 
+// SetTierBreakdown sets the "tier_breakdown" field.
+func (iliu *InvoiceLineItemUpdate) SetTierBreakdown(slit []schema.InvoiceLineItemTier) *InvoiceLineItemUpdate {
+	iliu.mutation.SetTierBreakdown(slit)
+	return iliu
+}
+
+// AppendTierBreakdown appends slit to the "tier_breakdown" field.
+func (iliu *InvoiceLineItemUpdate) AppendTierBreakdown(slit []schema.InvoiceLineItemTier) *InvoiceLineItemUpdate {
+	iliu.mutation.AppendTierBreakdown(slit)
+	return iliu
+}
+
+// ClearTierBreakdown clears the value of the "tier_breakdown" field.
+func (iliu *InvoiceLineItemUpdate) ClearTierBreakdown() *InvoiceLineItemUpdate {
+	iliu.mutation.ClearTierBreakdown()
+	return iliu
+}
+
 // SetMetadata sets the "metadata" field.
 func (iliu *InvoiceLineItemUpdate) SetMetadata(m map[string]string) *InvoiceLineItemUpdate {
 	iliu.mutation.SetMetadata(m)
@@ -235,6 +255,17 @@ func (iliu *InvoiceLineItemUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if iliu.mutation.PeriodEndCleared() {
 		_spec.ClearField(invoicelineitem.FieldPeriodEnd, field.TypeTime)
 	}
+	if value, ok := iliu.mutation.TierBreakdown(); ok {
+		_spec.SetField(invoicelineitem.FieldTierBreakdown, field.TypeJSON, value)
+	}
+	if value, ok := iliu.mutation.AppendedTierBreakdown(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoicelineitem.FieldTierBreakdown, value)
+		})
+	}
+	if iliu.mutation.TierBreakdownCleared() {
+		_spec.ClearField(invoicelineitem.FieldTierBreakdown, field.TypeJSON)
+	}
 	if value, ok := iliu.mutation.Metadata(); ok {
 		_spec.SetField(invoicelineitem.FieldMetadata, field.TypeJSON, value)
 	}
@@ -341,6 +372,24 @@ func (iliuo *InvoiceLineItemUpdateOne) ClearPeriodEnd() *InvoiceLineItemUpdateOn
 	return iliuo
 }
 
+// SetTierBreakdown sets the "tier_breakdown" field.
+func (iliuo *InvoiceLineItemUpdateOne) SetTierBreakdown(slit []schema.InvoiceLineItemTier) *InvoiceLineItemUpdateOne {
+	iliuo.mutation.SetTierBreakdown(slit)
+	return iliuo
+}
+
+// AppendTierBreakdown appends slit to the "tier_breakdown" field.
+func (iliuo *InvoiceLineItemUpdateOne) AppendTierBreakdown(slit []schema.InvoiceLineItemTier) *InvoiceLineItemUpdateOne {
+	iliuo.mutation.AppendTierBreakdown(slit)
+	return iliuo
+}
+
+// ClearTierBreakdown clears the value of the "tier_breakdown" field.
+func (iliuo *InvoiceLineItemUpdateOne) ClearTierBreakdown() *InvoiceLineItemUpdateOne {
+	iliuo.mutation.ClearTierBreakdown()
+	return iliuo
+}
+
 // SetMetadata sets the "metadata" field.
 func (iliuo *InvoiceLineItemUpdateOne) SetMetadata(m map[string]string) *InvoiceLineItemUpdateOne {
 	iliuo.mutation.SetMetadata(m)
@@ -498,6 +547,17 @@ func (iliuo *InvoiceLineItemUpdateOne) sqlSave(ctx context.Context) (_node *Invo
 	if iliuo.mutation.PeriodEndCleared() {
 		_spec.ClearField(invoicelineitem.FieldPeriodEnd, field.TypeTime)
 	}
+	if value, ok := iliuo.mutation.TierBreakdown(); ok {
+		_spec.SetField(invoicelineitem.FieldTierBreakdown, field.TypeJSON, value)
+	}
+	if value, ok := iliuo.mutation.AppendedTierBreakdown(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoicelineitem.FieldTierBreakdown, value)
+		})
+	}
+	if iliuo.mutation.TierBreakdownCleared() {
+		_spec.ClearField(invoicelineitem.FieldTierBreakdown, field.TypeJSON)
+	}
 	if value, ok := iliuo.mutation.Metadata(); ok {
 		_spec.SetField(invoicelineitem.FieldMetadata, field.TypeJSON, value)
 	}
@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/outboxevent"
+)
+
+// OutboxEventCreate is the builder for creating a OutboxEvent entity.
+type OutboxEventCreate struct {
+	config
+	mutation *OutboxEventMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (oec *OutboxEventCreate) SetTenantID(s string) *OutboxEventCreate {
+	oec.mutation.SetTenantID(s)
+	return oec
+}
+
+// SetStatus sets the "status" field.
+func (oec *OutboxEventCreate) SetStatus(s string) *OutboxEventCreate {
+	oec.mutation.SetStatus(s)
+	return oec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableStatus(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetStatus(*s)
+	}
+	return oec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oec *OutboxEventCreate) SetCreatedAt(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetCreatedAt(t)
+	return oec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableCreatedAt(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetCreatedAt(*t)
+	}
+	return oec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oec *OutboxEventCreate) SetUpdatedAt(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetUpdatedAt(t)
+	return oec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableUpdatedAt(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetUpdatedAt(*t)
+	}
+	return oec
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (oec *OutboxEventCreate) SetCreatedBy(s string) *OutboxEventCreate {
+	oec.mutation.SetCreatedBy(s)
+	return oec
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableCreatedBy(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetCreatedBy(*s)
+	}
+	return oec
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (oec *OutboxEventCreate) SetUpdatedBy(s string) *OutboxEventCreate {
+	oec.mutation.SetUpdatedBy(s)
+	return oec
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableUpdatedBy(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetUpdatedBy(*s)
+	}
+	return oec
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (oec *OutboxEventCreate) SetEnvironmentID(s string) *OutboxEventCreate {
+	oec.mutation.SetEnvironmentID(s)
+	return oec
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableEnvironmentID(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetEnvironmentID(*s)
+	}
+	return oec
+}
+
+// SetTopic sets the "topic" field.
+func (oec *OutboxEventCreate) SetTopic(s string) *OutboxEventCreate {
+	oec.mutation.SetTopic(s)
+	return oec
+}
+
+// SetMessageID sets the "message_id" field.
+func (oec *OutboxEventCreate) SetMessageID(s string) *OutboxEventCreate {
+	oec.mutation.SetMessageID(s)
+	return oec
+}
+
+// SetPayload sets the "payload" field.
+func (oec *OutboxEventCreate) SetPayload(s string) *OutboxEventCreate {
+	oec.mutation.SetPayload(s)
+	return oec
+}
+
+// SetMetadata sets the "metadata" field.
+func (oec *OutboxEventCreate) SetMetadata(m map[string]string) *OutboxEventCreate {
+	oec.mutation.SetMetadata(m)
+	return oec
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (oec *OutboxEventCreate) SetDeliveryStatus(s string) *OutboxEventCreate {
+	oec.mutation.SetDeliveryStatus(s)
+	return oec
+}
+
+// SetAttempts sets the "attempts" field.
+func (oec *OutboxEventCreate) SetAttempts(i int) *OutboxEventCreate {
+	oec.mutation.SetAttempts(i)
+	return oec
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableAttempts(i *int) *OutboxEventCreate {
+	if i != nil {
+		oec.SetAttempts(*i)
+	}
+	return oec
+}
+
+// SetLastError sets the "last_error" field.
+func (oec *OutboxEventCreate) SetLastError(s string) *OutboxEventCreate {
+	oec.mutation.SetLastError(s)
+	return oec
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableLastError(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetLastError(*s)
+	}
+	return oec
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (oec *OutboxEventCreate) SetNextAttemptAt(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetNextAttemptAt(t)
+	return oec
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (oec *OutboxEventCreate) SetDeliveredAt(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetDeliveredAt(t)
+	return oec
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableDeliveredAt(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetDeliveredAt(*t)
+	}
+	return oec
+}
+
+// SetID sets the "id" field.
+func (oec *OutboxEventCreate) SetID(s string) *OutboxEventCreate {
+	oec.mutation.SetID(s)
+	return oec
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oec *OutboxEventCreate) Mutation() *OutboxEventMutation {
+	return oec.mutation
+}
+
+// Save creates the OutboxEvent in the database.
+func (oec *OutboxEventCreate) Save(ctx context.Context) (*OutboxEvent, error) {
+	oec.defaults()
+	return withHooks(ctx, oec.sqlSave, oec.mutation, oec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oec *OutboxEventCreate) SaveX(ctx context.Context) *OutboxEvent {
+	v, err := oec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oec *OutboxEventCreate) Exec(ctx context.Context) error {
+	_, err := oec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oec *OutboxEventCreate) ExecX(ctx context.Context) {
+	if err := oec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oec *OutboxEventCreate) defaults() {
+	if _, ok := oec.mutation.Status(); !ok {
+		v := outboxevent.DefaultStatus
+		oec.mutation.SetStatus(v)
+	}
+	if _, ok := oec.mutation.CreatedAt(); !ok {
+		v := outboxevent.DefaultCreatedAt()
+		oec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oec.mutation.UpdatedAt(); !ok {
+		v := outboxevent.DefaultUpdatedAt()
+		oec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := oec.mutation.EnvironmentID(); !ok {
+		v := outboxevent.DefaultEnvironmentID
+		oec.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := oec.mutation.Attempts(); !ok {
+		v := outboxevent.DefaultAttempts
+		oec.mutation.SetAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oec *OutboxEventCreate) check() error {
+	if _, ok := oec.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "OutboxEvent.tenant_id"`)}
+	}
+	if v, ok := oec.mutation.TenantID(); ok {
+		if err := outboxevent.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := oec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OutboxEvent.status"`)}
+	}
+	if _, ok := oec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OutboxEvent.created_at"`)}
+	}
+	if _, ok := oec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OutboxEvent.updated_at"`)}
+	}
+	if _, ok := oec.mutation.Topic(); !ok {
+		return &ValidationError{Name: "topic", err: errors.New(`ent: missing required field "OutboxEvent.topic"`)}
+	}
+	if v, ok := oec.mutation.Topic(); ok {
+		if err := outboxevent.TopicValidator(v); err != nil {
+			return &ValidationError{Name: "topic", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.topic": %w`, err)}
+		}
+	}
+	if _, ok := oec.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "OutboxEvent.message_id"`)}
+	}
+	if v, ok := oec.mutation.MessageID(); ok {
+		if err := outboxevent.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.message_id": %w`, err)}
+		}
+	}
+	if _, ok := oec.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "OutboxEvent.payload"`)}
+	}
+	if _, ok := oec.mutation.DeliveryStatus(); !ok {
+		return &ValidationError{Name: "delivery_status", err: errors.New(`ent: missing required field "OutboxEvent.delivery_status"`)}
+	}
+	if v, ok := oec.mutation.DeliveryStatus(); ok {
+		if err := outboxevent.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.delivery_status": %w`, err)}
+		}
+	}
+	if _, ok := oec.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "OutboxEvent.attempts"`)}
+	}
+	if _, ok := oec.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "OutboxEvent.next_attempt_at"`)}
+	}
+	return nil
+}
+
+func (oec *OutboxEventCreate) sqlSave(ctx context.Context) (*OutboxEvent, error) {
+	if err := oec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OutboxEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	oec.mutation.id = &_node.ID
+	oec.mutation.done = true
+	return _node, nil
+}
+
+func (oec *OutboxEventCreate) createSpec() (*OutboxEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutboxEvent{config: oec.config}
+		_spec = sqlgraph.NewCreateSpec(outboxevent.Table, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeString))
+	)
+	if id, ok := oec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := oec.mutation.TenantID(); ok {
+		_spec.SetField(outboxevent.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := oec.mutation.Status(); ok {
+		_spec.SetField(outboxevent.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := oec.mutation.CreatedAt(); ok {
+		_spec.SetField(outboxevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := oec.mutation.UpdatedAt(); ok {
+		_spec.SetField(outboxevent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := oec.mutation.CreatedBy(); ok {
+		_spec.SetField(outboxevent.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := oec.mutation.UpdatedBy(); ok {
+		_spec.SetField(outboxevent.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := oec.mutation.EnvironmentID(); ok {
+		_spec.SetField(outboxevent.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := oec.mutation.Topic(); ok {
+		_spec.SetField(outboxevent.FieldTopic, field.TypeString, value)
+		_node.Topic = value
+	}
+	if value, ok := oec.mutation.MessageID(); ok {
+		_spec.SetField(outboxevent.FieldMessageID, field.TypeString, value)
+		_node.MessageID = value
+	}
+	if value, ok := oec.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := oec.mutation.Metadata(); ok {
+		_spec.SetField(outboxevent.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := oec.mutation.DeliveryStatus(); ok {
+		_spec.SetField(outboxevent.FieldDeliveryStatus, field.TypeString, value)
+		_node.DeliveryStatus = value
+	}
+	if value, ok := oec.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := oec.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := oec.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxevent.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := oec.mutation.DeliveredAt(); ok {
+		_spec.SetField(outboxevent.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	return _node, _spec
+}
+
+// OutboxEventCreateBulk is the builder for creating many OutboxEvent entities in bulk.
+type OutboxEventCreateBulk struct {
+	config
+	err      error
+	builders []*OutboxEventCreate
+}
+
+// Save creates the OutboxEvent entities in the database.
+func (oecb *OutboxEventCreateBulk) Save(ctx context.Context) ([]*OutboxEvent, error) {
+	if oecb.err != nil {
+		return nil, oecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oecb.builders))
+	nodes := make([]*OutboxEvent, len(oecb.builders))
+	mutators := make([]Mutator, len(oecb.builders))
+	for i := range oecb.builders {
+		func(i int, root context.Context) {
+			builder := oecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oecb *OutboxEventCreateBulk) SaveX(ctx context.Context) []*OutboxEvent {
+	v, err := oecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oecb *OutboxEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := oecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oecb *OutboxEventCreateBulk) ExecX(ctx context.Context) {
+	if err := oecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
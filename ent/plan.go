@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/plan"
+	"github.com/shopspring/decimal"
 )
 
 // Plan is the model entity for the Plan schema.
@@ -37,6 +38,12 @@ type Plan struct {
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// CommitmentAmount holds the value of the "commitment_amount" field.
+	CommitmentAmount *decimal.Decimal `json:"commitment_amount,omitempty"`
+	// CommitmentInterval holds the value of the "commitment_interval" field.
+	CommitmentInterval string `json:"commitment_interval,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PlanQuery when eager-loading is set.
 	Edges        PlanEdges `json:"edges"`
@@ -66,7 +73,11 @@ func (*Plan) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case plan.FieldID, plan.FieldTenantID, plan.FieldStatus, plan.FieldCreatedBy, plan.FieldUpdatedBy, plan.FieldEnvironmentID, plan.FieldLookupKey, plan.FieldName, plan.FieldDescription:
+		case plan.FieldCommitmentAmount:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case plan.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case plan.FieldID, plan.FieldTenantID, plan.FieldStatus, plan.FieldCreatedBy, plan.FieldUpdatedBy, plan.FieldEnvironmentID, plan.FieldLookupKey, plan.FieldName, plan.FieldDescription, plan.FieldCommitmentInterval:
 			values[i] = new(sql.NullString)
 		case plan.FieldCreatedAt, plan.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -151,6 +162,25 @@ func (pl *Plan) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pl.Description = value.String
 			}
+		case plan.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pl.Version = int(value.Int64)
+			}
+		case plan.FieldCommitmentAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field commitment_amount", values[i])
+			} else if value.Valid {
+				pl.CommitmentAmount = new(decimal.Decimal)
+				*pl.CommitmentAmount = *value.S.(*decimal.Decimal)
+			}
+		case plan.FieldCommitmentInterval:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commitment_interval", values[i])
+			} else if value.Valid {
+				pl.CommitmentInterval = value.String
+			}
 		default:
 			pl.selectValues.Set(columns[i], values[i])
 		}
@@ -221,6 +251,17 @@ func (pl *Plan) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(pl.Description)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Version))
+	builder.WriteString(", ")
+	if v := pl.CommitmentAmount; v != nil {
+		builder.WriteString("commitment_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("commitment_interval=")
+	builder.WriteString(pl.CommitmentInterval)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -34,6 +34,12 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldCommitmentAmount holds the string denoting the commitment_amount field in the database.
+	FieldCommitmentAmount = "commitment_amount"
+	// FieldCommitmentInterval holds the string denoting the commitment_interval field in the database.
+	FieldCommitmentInterval = "commitment_interval"
 	// EdgeEntitlements holds the string denoting the entitlements edge name in mutations.
 	EdgeEntitlements = "entitlements"
 	// Table holds the table name of the plan in the database.
@@ -60,6 +66,9 @@ var Columns = []string{
 	FieldLookupKey,
 	FieldName,
 	FieldDescription,
+	FieldVersion,
+	FieldCommitmentAmount,
+	FieldCommitmentInterval,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -87,6 +96,8 @@ var (
 	DefaultEnvironmentID string
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 )
 
 // OrderOption defines the ordering options for the Plan queries.
@@ -147,6 +158,21 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByCommitmentAmount orders the results by the commitment_amount field.
+func ByCommitmentAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitmentAmount, opts...).ToFunc()
+}
+
+// ByCommitmentInterval orders the results by the commitment_interval field.
+func ByCommitmentInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitmentInterval, opts...).ToFunc()
+}
+
 // ByEntitlementsCount orders the results by entitlements count.
 func ByEntitlementsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -115,6 +116,21 @@ func Description(v string) predicate.Plan {
 	return predicate.Plan(sql.FieldEQ(FieldDescription, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldVersion, v))
+}
+
+// CommitmentAmount applies equality check predicate on the "commitment_amount" field. It's identical to CommitmentAmountEQ.
+func CommitmentAmount(v decimal.Decimal) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCommitmentAmount, v))
+}
+
+// CommitmentInterval applies equality check predicate on the "commitment_interval" field. It's identical to CommitmentIntervalEQ.
+func CommitmentInterval(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCommitmentInterval, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.Plan {
 	return predicate.Plan(sql.FieldEQ(FieldTenantID, v))
@@ -765,6 +781,171 @@ func DescriptionContainsFold(v string) predicate.Plan {
 	return predicate.Plan(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldVersion, v))
+}
+
+// CommitmentAmountEQ applies the EQ predicate on the "commitment_amount" field.
+func CommitmentAmountEQ(v decimal.Decimal) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountNEQ applies the NEQ predicate on the "commitment_amount" field.
+func CommitmentAmountNEQ(v decimal.Decimal) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountIn applies the In predicate on the "commitment_amount" field.
+func CommitmentAmountIn(vs ...decimal.Decimal) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldCommitmentAmount, vs...))
+}
+
+// CommitmentAmountNotIn applies the NotIn predicate on the "commitment_amount" field.
+func CommitmentAmountNotIn(vs ...decimal.Decimal) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldCommitmentAmount, vs...))
+}
+
+// CommitmentAmountGT applies the GT predicate on the "commitment_amount" field.
+func CommitmentAmountGT(v decimal.Decimal) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountGTE applies the GTE predicate on the "commitment_amount" field.
+func CommitmentAmountGTE(v decimal.Decimal) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountLT applies the LT predicate on the "commitment_amount" field.
+func CommitmentAmountLT(v decimal.Decimal) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountLTE applies the LTE predicate on the "commitment_amount" field.
+func CommitmentAmountLTE(v decimal.Decimal) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountIsNil applies the IsNil predicate on the "commitment_amount" field.
+func CommitmentAmountIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldCommitmentAmount))
+}
+
+// CommitmentAmountNotNil applies the NotNil predicate on the "commitment_amount" field.
+func CommitmentAmountNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldCommitmentAmount))
+}
+
+// CommitmentIntervalEQ applies the EQ predicate on the "commitment_interval" field.
+func CommitmentIntervalEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalNEQ applies the NEQ predicate on the "commitment_interval" field.
+func CommitmentIntervalNEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalIn applies the In predicate on the "commitment_interval" field.
+func CommitmentIntervalIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldCommitmentInterval, vs...))
+}
+
+// CommitmentIntervalNotIn applies the NotIn predicate on the "commitment_interval" field.
+func CommitmentIntervalNotIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldCommitmentInterval, vs...))
+}
+
+// CommitmentIntervalGT applies the GT predicate on the "commitment_interval" field.
+func CommitmentIntervalGT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalGTE applies the GTE predicate on the "commitment_interval" field.
+func CommitmentIntervalGTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalLT applies the LT predicate on the "commitment_interval" field.
+func CommitmentIntervalLT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalLTE applies the LTE predicate on the "commitment_interval" field.
+func CommitmentIntervalLTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalContains applies the Contains predicate on the "commitment_interval" field.
+func CommitmentIntervalContains(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContains(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalHasPrefix applies the HasPrefix predicate on the "commitment_interval" field.
+func CommitmentIntervalHasPrefix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasPrefix(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalHasSuffix applies the HasSuffix predicate on the "commitment_interval" field.
+func CommitmentIntervalHasSuffix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasSuffix(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalIsNil applies the IsNil predicate on the "commitment_interval" field.
+func CommitmentIntervalIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldCommitmentInterval))
+}
+
+// CommitmentIntervalNotNil applies the NotNil predicate on the "commitment_interval" field.
+func CommitmentIntervalNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldCommitmentInterval))
+}
+
+// CommitmentIntervalEqualFold applies the EqualFold predicate on the "commitment_interval" field.
+func CommitmentIntervalEqualFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalContainsFold applies the ContainsFold predicate on the "commitment_interval" field.
+func CommitmentIntervalContainsFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldCommitmentInterval, v))
+}
+
 // HasEntitlements applies the HasEdge predicate on the "entitlements" edge.
 func HasEntitlements() predicate.Plan {
 	return predicate.Plan(func(s *sql.Selector) {
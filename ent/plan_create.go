@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/entitlement"
 	"github.com/flexprice/flexprice/ent/plan"
+	"github.com/shopspring/decimal"
 )
 
 // PlanCreate is the builder for creating a Plan entity.
@@ -145,6 +146,48 @@ func (pc *PlanCreate) SetNillableDescription(s *string) *PlanCreate {
 	return pc
 }
 
+// SetVersion sets the "version" field.
+func (pc *PlanCreate) SetVersion(i int) *PlanCreate {
+	pc.mutation.SetVersion(i)
+	return pc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableVersion(i *int) *PlanCreate {
+	if i != nil {
+		pc.SetVersion(*i)
+	}
+	return pc
+}
+
+// SetCommitmentAmount sets the "commitment_amount" field.
+func (pc *PlanCreate) SetCommitmentAmount(d decimal.Decimal) *PlanCreate {
+	pc.mutation.SetCommitmentAmount(d)
+	return pc
+}
+
+// SetNillableCommitmentAmount sets the "commitment_amount" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableCommitmentAmount(d *decimal.Decimal) *PlanCreate {
+	if d != nil {
+		pc.SetCommitmentAmount(*d)
+	}
+	return pc
+}
+
+// SetCommitmentInterval sets the "commitment_interval" field.
+func (pc *PlanCreate) SetCommitmentInterval(s string) *PlanCreate {
+	pc.mutation.SetCommitmentInterval(s)
+	return pc
+}
+
+// SetNillableCommitmentInterval sets the "commitment_interval" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableCommitmentInterval(s *string) *PlanCreate {
+	if s != nil {
+		pc.SetCommitmentInterval(*s)
+	}
+	return pc
+}
+
 // SetID sets the "id" field.
 func (pc *PlanCreate) SetID(s string) *PlanCreate {
 	pc.mutation.SetID(s)
@@ -217,6 +260,10 @@ func (pc *PlanCreate) defaults() {
 		v := plan.DefaultEnvironmentID
 		pc.mutation.SetEnvironmentID(v)
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		v := plan.DefaultVersion
+		pc.mutation.SetVersion(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -246,6 +293,9 @@ func (pc *PlanCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Plan.name": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Plan.version"`)}
+	}
 	return nil
 }
 
@@ -321,6 +371,18 @@ func (pc *PlanCreate) createSpec() (*Plan, *sqlgraph.CreateSpec) {
 		_spec.SetField(plan.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.SetField(plan.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := pc.mutation.CommitmentAmount(); ok {
+		_spec.SetField(plan.FieldCommitmentAmount, field.TypeOther, value)
+		_node.CommitmentAmount = &value
+	}
+	if value, ok := pc.mutation.CommitmentInterval(); ok {
+		_spec.SetField(plan.FieldCommitmentInterval, field.TypeString, value)
+		_node.CommitmentInterval = value
+	}
 	if nodes := pc.mutation.EntitlementsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
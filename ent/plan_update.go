@@ -14,6 +14,7 @@ import (
 	"github.com/flexprice/flexprice/ent/entitlement"
 	"github.com/flexprice/flexprice/ent/plan"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
 )
 
 // PlanUpdate is the builder for updating Plan entities.
@@ -123,6 +124,67 @@ func (pu *PlanUpdate) ClearDescription() *PlanUpdate {
 	return pu
 }
 
+// SetVersion sets the "version" field.
+func (pu *PlanUpdate) SetVersion(i int) *PlanUpdate {
+	pu.mutation.ResetVersion()
+	pu.mutation.SetVersion(i)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableVersion(i *int) *PlanUpdate {
+	if i != nil {
+		pu.SetVersion(*i)
+	}
+	return pu
+}
+
+// AddVersion adds i to the "version" field.
+func (pu *PlanUpdate) AddVersion(i int) *PlanUpdate {
+	pu.mutation.AddVersion(i)
+	return pu
+}
+
+// SetCommitmentAmount sets the "commitment_amount" field.
+func (pu *PlanUpdate) SetCommitmentAmount(d decimal.Decimal) *PlanUpdate {
+	pu.mutation.SetCommitmentAmount(d)
+	return pu
+}
+
+// SetNillableCommitmentAmount sets the "commitment_amount" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableCommitmentAmount(d *decimal.Decimal) *PlanUpdate {
+	if d != nil {
+		pu.SetCommitmentAmount(*d)
+	}
+	return pu
+}
+
+// ClearCommitmentAmount clears the value of the "commitment_amount" field.
+func (pu *PlanUpdate) ClearCommitmentAmount() *PlanUpdate {
+	pu.mutation.ClearCommitmentAmount()
+	return pu
+}
+
+// SetCommitmentInterval sets the "commitment_interval" field.
+func (pu *PlanUpdate) SetCommitmentInterval(s string) *PlanUpdate {
+	pu.mutation.SetCommitmentInterval(s)
+	return pu
+}
+
+// SetNillableCommitmentInterval sets the "commitment_interval" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableCommitmentInterval(s *string) *PlanUpdate {
+	if s != nil {
+		pu.SetCommitmentInterval(*s)
+	}
+	return pu
+}
+
+// ClearCommitmentInterval clears the value of the "commitment_interval" field.
+func (pu *PlanUpdate) ClearCommitmentInterval() *PlanUpdate {
+	pu.mutation.ClearCommitmentInterval()
+	return pu
+}
+
 // AddEntitlementIDs adds the "entitlements" edge to the Entitlement entity by IDs.
 func (pu *PlanUpdate) AddEntitlementIDs(ids ...string) *PlanUpdate {
 	pu.mutation.AddEntitlementIDs(ids...)
@@ -255,6 +317,24 @@ func (pu *PlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.DescriptionCleared() {
 		_spec.ClearField(plan.FieldDescription, field.TypeString)
 	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.SetField(plan.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedVersion(); ok {
+		_spec.AddField(plan.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.CommitmentAmount(); ok {
+		_spec.SetField(plan.FieldCommitmentAmount, field.TypeOther, value)
+	}
+	if pu.mutation.CommitmentAmountCleared() {
+		_spec.ClearField(plan.FieldCommitmentAmount, field.TypeOther)
+	}
+	if value, ok := pu.mutation.CommitmentInterval(); ok {
+		_spec.SetField(plan.FieldCommitmentInterval, field.TypeString, value)
+	}
+	if pu.mutation.CommitmentIntervalCleared() {
+		_spec.ClearField(plan.FieldCommitmentInterval, field.TypeString)
+	}
 	if pu.mutation.EntitlementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -414,6 +494,67 @@ func (puo *PlanUpdateOne) ClearDescription() *PlanUpdateOne {
 	return puo
 }
 
+// SetVersion sets the "version" field.
+func (puo *PlanUpdateOne) SetVersion(i int) *PlanUpdateOne {
+	puo.mutation.ResetVersion()
+	puo.mutation.SetVersion(i)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableVersion(i *int) *PlanUpdateOne {
+	if i != nil {
+		puo.SetVersion(*i)
+	}
+	return puo
+}
+
+// AddVersion adds i to the "version" field.
+func (puo *PlanUpdateOne) AddVersion(i int) *PlanUpdateOne {
+	puo.mutation.AddVersion(i)
+	return puo
+}
+
+// SetCommitmentAmount sets the "commitment_amount" field.
+func (puo *PlanUpdateOne) SetCommitmentAmount(d decimal.Decimal) *PlanUpdateOne {
+	puo.mutation.SetCommitmentAmount(d)
+	return puo
+}
+
+// SetNillableCommitmentAmount sets the "commitment_amount" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableCommitmentAmount(d *decimal.Decimal) *PlanUpdateOne {
+	if d != nil {
+		puo.SetCommitmentAmount(*d)
+	}
+	return puo
+}
+
+// ClearCommitmentAmount clears the value of the "commitment_amount" field.
+func (puo *PlanUpdateOne) ClearCommitmentAmount() *PlanUpdateOne {
+	puo.mutation.ClearCommitmentAmount()
+	return puo
+}
+
+// SetCommitmentInterval sets the "commitment_interval" field.
+func (puo *PlanUpdateOne) SetCommitmentInterval(s string) *PlanUpdateOne {
+	puo.mutation.SetCommitmentInterval(s)
+	return puo
+}
+
+// SetNillableCommitmentInterval sets the "commitment_interval" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableCommitmentInterval(s *string) *PlanUpdateOne {
+	if s != nil {
+		puo.SetCommitmentInterval(*s)
+	}
+	return puo
+}
+
+// ClearCommitmentInterval clears the value of the "commitment_interval" field.
+func (puo *PlanUpdateOne) ClearCommitmentInterval() *PlanUpdateOne {
+	puo.mutation.ClearCommitmentInterval()
+	return puo
+}
+
 // AddEntitlementIDs adds the "entitlements" edge to the Entitlement entity by IDs.
 func (puo *PlanUpdateOne) AddEntitlementIDs(ids ...string) *PlanUpdateOne {
 	puo.mutation.AddEntitlementIDs(ids...)
@@ -576,6 +717,24 @@ func (puo *PlanUpdateOne) sqlSave(ctx context.Context) (_node *Plan, err error)
 	if puo.mutation.DescriptionCleared() {
 		_spec.ClearField(plan.FieldDescription, field.TypeString)
 	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.SetField(plan.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedVersion(); ok {
+		_spec.AddField(plan.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.CommitmentAmount(); ok {
+		_spec.SetField(plan.FieldCommitmentAmount, field.TypeOther, value)
+	}
+	if puo.mutation.CommitmentAmountCleared() {
+		_spec.ClearField(plan.FieldCommitmentAmount, field.TypeOther)
+	}
+	if value, ok := puo.mutation.CommitmentInterval(); ok {
+		_spec.SetField(plan.FieldCommitmentInterval, field.TypeString, value)
+	}
+	if puo.mutation.CommitmentIntervalCleared() {
+		_spec.ClearField(plan.FieldCommitmentInterval, field.TypeString)
+	}
 	if puo.mutation.EntitlementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -6,15 +6,39 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AlertEvent is the predicate function for alertevent builders.
+type AlertEvent func(*sql.Selector)
+
+// AlertRule is the predicate function for alertrule builders.
+type AlertRule func(*sql.Selector)
+
 // Auth is the predicate function for auth builders.
 type Auth func(*sql.Selector)
 
 // BillingSequence is the predicate function for billingsequence builders.
 type BillingSequence func(*sql.Selector)
 
+// Coupon is the predicate function for coupon builders.
+type Coupon func(*sql.Selector)
+
+// CouponApplication is the predicate function for couponapplication builders.
+type CouponApplication func(*sql.Selector)
+
+// CreditNote is the predicate function for creditnote builders.
+type CreditNote func(*sql.Selector)
+
+// CreditNoteLineItem is the predicate function for creditnotelineitem builders.
+type CreditNoteLineItem func(*sql.Selector)
+
+// CreditNoteSequence is the predicate function for creditnotesequence builders.
+type CreditNoteSequence func(*sql.Selector)
+
 // Customer is the predicate function for customer builders.
 type Customer func(*sql.Selector)
 
+// DunningAttempt is the predicate function for dunningattempt builders.
+type DunningAttempt func(*sql.Selector)
+
 // Entitlement is the predicate function for entitlement builders.
 type Entitlement func(*sql.Selector)
 
@@ -36,6 +60,9 @@ type InvoiceSequence func(*sql.Selector)
 // Meter is the predicate function for meter builders.
 type Meter func(*sql.Selector)
 
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // Payment is the predicate function for payment builders.
 type Payment func(*sql.Selector)
 
@@ -60,9 +87,15 @@ type SubscriptionLineItem func(*sql.Selector)
 // SubscriptionPause is the predicate function for subscriptionpause builders.
 type SubscriptionPause func(*sql.Selector)
 
+// SubscriptionQuantityChange is the predicate function for subscriptionquantitychange builders.
+type SubscriptionQuantityChange func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
+// TaxRate is the predicate function for taxrate builders.
+type TaxRate func(*sql.Selector)
+
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
@@ -74,3 +107,9 @@ type Wallet func(*sql.Selector)
 
 // WalletTransaction is the predicate function for wallettransaction builders.
 type WalletTransaction func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookEndpoint is the predicate function for webhookendpoint builders.
+type WebhookEndpoint func(*sql.Selector)
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/schema"
+	"github.com/shopspring/decimal"
 )
 
 // Price is the model entity for the Price schema.
@@ -41,6 +42,12 @@ type Price struct {
 	DisplayAmount string `json:"display_amount,omitempty"`
 	// PlanID holds the value of the "plan_id" field.
 	PlanID string `json:"plan_id,omitempty"`
+	// PlanVersion holds the value of the "plan_version" field.
+	PlanVersion int `json:"plan_version,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+	// ParentPriceID holds the value of the "parent_price_id" field.
+	ParentPriceID *string `json:"parent_price_id,omitempty"`
 	// Type holds the value of the "type" field.
 	Type string `json:"type,omitempty"`
 	// BillingPeriod holds the value of the "billing_period" field.
@@ -65,6 +72,8 @@ type Price struct {
 	Tiers []schema.PriceTier `json:"tiers,omitempty"`
 	// TransformQuantity holds the value of the "transform_quantity" field.
 	TransformQuantity schema.TransformQuantity `json:"transform_quantity,omitempty"`
+	// MinimumCharge holds the value of the "minimum_charge" field.
+	MinimumCharge *decimal.Decimal `json:"minimum_charge,omitempty"`
 	// LookupKey holds the value of the "lookup_key" field.
 	LookupKey string `json:"lookup_key,omitempty"`
 	// Description holds the value of the "description" field.
@@ -79,13 +88,15 @@ func (*Price) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case price.FieldMinimumCharge:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case price.FieldFilterValues, price.FieldTiers, price.FieldTransformQuantity, price.FieldMetadata:
 			values[i] = new([]byte)
 		case price.FieldAmount:
 			values[i] = new(sql.NullFloat64)
-		case price.FieldBillingPeriodCount, price.FieldTrialPeriod:
+		case price.FieldPlanVersion, price.FieldBillingPeriodCount, price.FieldTrialPeriod:
 			values[i] = new(sql.NullInt64)
-		case price.FieldID, price.FieldTenantID, price.FieldStatus, price.FieldCreatedBy, price.FieldUpdatedBy, price.FieldEnvironmentID, price.FieldCurrency, price.FieldDisplayAmount, price.FieldPlanID, price.FieldType, price.FieldBillingPeriod, price.FieldBillingModel, price.FieldBillingCadence, price.FieldInvoiceCadence, price.FieldMeterID, price.FieldTierMode, price.FieldLookupKey, price.FieldDescription:
+		case price.FieldID, price.FieldTenantID, price.FieldStatus, price.FieldCreatedBy, price.FieldUpdatedBy, price.FieldEnvironmentID, price.FieldCurrency, price.FieldDisplayAmount, price.FieldPlanID, price.FieldSubscriptionID, price.FieldParentPriceID, price.FieldType, price.FieldBillingPeriod, price.FieldBillingModel, price.FieldBillingCadence, price.FieldInvoiceCadence, price.FieldMeterID, price.FieldTierMode, price.FieldLookupKey, price.FieldDescription:
 			values[i] = new(sql.NullString)
 		case price.FieldCreatedAt, price.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -176,6 +187,26 @@ func (pr *Price) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.PlanID = value.String
 			}
+		case price.FieldPlanVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_version", values[i])
+			} else if value.Valid {
+				pr.PlanVersion = int(value.Int64)
+			}
+		case price.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				pr.SubscriptionID = new(string)
+				*pr.SubscriptionID = value.String
+			}
+		case price.FieldParentPriceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_price_id", values[i])
+			} else if value.Valid {
+				pr.ParentPriceID = new(string)
+				*pr.ParentPriceID = value.String
+			}
 		case price.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -256,6 +287,13 @@ func (pr *Price) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field transform_quantity: %w", err)
 				}
 			}
+		case price.FieldMinimumCharge:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field minimum_charge", values[i])
+			} else if value.Valid {
+				pr.MinimumCharge = new(decimal.Decimal)
+				*pr.MinimumCharge = *value.S.(*decimal.Decimal)
+			}
 		case price.FieldLookupKey:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field lookup_key", values[i])
@@ -345,6 +383,19 @@ func (pr *Price) String() string {
 	builder.WriteString("plan_id=")
 	builder.WriteString(pr.PlanID)
 	builder.WriteString(", ")
+	builder.WriteString("plan_version=")
+	builder.WriteString(fmt.Sprintf("%v", pr.PlanVersion))
+	builder.WriteString(", ")
+	if v := pr.SubscriptionID; v != nil {
+		builder.WriteString("subscription_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.ParentPriceID; v != nil {
+		builder.WriteString("parent_price_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(pr.Type)
 	builder.WriteString(", ")
@@ -385,6 +436,11 @@ func (pr *Price) String() string {
 	builder.WriteString("transform_quantity=")
 	builder.WriteString(fmt.Sprintf("%v", pr.TransformQuantity))
 	builder.WriteString(", ")
+	if v := pr.MinimumCharge; v != nil {
+		builder.WriteString("minimum_charge=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("lookup_key=")
 	builder.WriteString(pr.LookupKey)
 	builder.WriteString(", ")
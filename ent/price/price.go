@@ -35,6 +35,12 @@ const (
 	FieldDisplayAmount = "display_amount"
 	// FieldPlanID holds the string denoting the plan_id field in the database.
 	FieldPlanID = "plan_id"
+	// FieldPlanVersion holds the string denoting the plan_version field in the database.
+	FieldPlanVersion = "plan_version"
+	// FieldSubscriptionID holds the string denoting the subscription_id field in the database.
+	FieldSubscriptionID = "subscription_id"
+	// FieldParentPriceID holds the string denoting the parent_price_id field in the database.
+	FieldParentPriceID = "parent_price_id"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldBillingPeriod holds the string denoting the billing_period field in the database.
@@ -59,6 +65,8 @@ const (
 	FieldTiers = "tiers"
 	// FieldTransformQuantity holds the string denoting the transform_quantity field in the database.
 	FieldTransformQuantity = "transform_quantity"
+	// FieldMinimumCharge holds the string denoting the minimum_charge field in the database.
+	FieldMinimumCharge = "minimum_charge"
 	// FieldLookupKey holds the string denoting the lookup_key field in the database.
 	FieldLookupKey = "lookup_key"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -83,6 +91,9 @@ var Columns = []string{
 	FieldCurrency,
 	FieldDisplayAmount,
 	FieldPlanID,
+	FieldPlanVersion,
+	FieldSubscriptionID,
+	FieldParentPriceID,
 	FieldType,
 	FieldBillingPeriod,
 	FieldBillingPeriodCount,
@@ -95,6 +106,7 @@ var Columns = []string{
 	FieldTierMode,
 	FieldTiers,
 	FieldTransformQuantity,
+	FieldMinimumCharge,
 	FieldLookupKey,
 	FieldDescription,
 	FieldMetadata,
@@ -129,6 +141,8 @@ var (
 	DisplayAmountValidator func(string) error
 	// PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
 	PlanIDValidator func(string) error
+	// DefaultPlanVersion holds the default value on creation for the "plan_version" field.
+	DefaultPlanVersion int
 	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	TypeValidator func(string) error
 	// BillingPeriodValidator is a validator for the "billing_period" field. It is called by the builders before save.
@@ -206,6 +220,21 @@ func ByPlanID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPlanID, opts...).ToFunc()
 }
 
+// ByPlanVersion orders the results by the plan_version field.
+func ByPlanVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlanVersion, opts...).ToFunc()
+}
+
+// BySubscriptionID orders the results by the subscription_id field.
+func BySubscriptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionID, opts...).ToFunc()
+}
+
+// ByParentPriceID orders the results by the parent_price_id field.
+func ByParentPriceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentPriceID, opts...).ToFunc()
+}
+
 // ByType orders the results by the type field.
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
@@ -251,6 +280,11 @@ func ByTierMode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTierMode, opts...).ToFunc()
 }
 
+// ByMinimumCharge orders the results by the minimum_charge field.
+func ByMinimumCharge(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinimumCharge, opts...).ToFunc()
+}
+
 // ByLookupKey orders the results by the lookup_key field.
 func ByLookupKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLookupKey, opts...).ToFunc()
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -119,6 +120,21 @@ func PlanID(v string) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldPlanID, v))
 }
 
+// PlanVersion applies equality check predicate on the "plan_version" field. It's identical to PlanVersionEQ.
+func PlanVersion(v int) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldPlanVersion, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v string) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// ParentPriceID applies equality check predicate on the "parent_price_id" field. It's identical to ParentPriceIDEQ.
+func ParentPriceID(v string) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldParentPriceID, v))
+}
+
 // Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
 func Type(v string) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldType, v))
@@ -164,6 +180,11 @@ func TierMode(v string) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldTierMode, v))
 }
 
+// MinimumCharge applies equality check predicate on the "minimum_charge" field. It's identical to MinimumChargeEQ.
+func MinimumCharge(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldMinimumCharge, v))
+}
+
 // LookupKey applies equality check predicate on the "lookup_key" field. It's identical to LookupKeyEQ.
 func LookupKey(v string) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldLookupKey, v))
@@ -844,6 +865,196 @@ func PlanIDContainsFold(v string) predicate.Price {
 	return predicate.Price(sql.FieldContainsFold(FieldPlanID, v))
 }
 
+// PlanVersionEQ applies the EQ predicate on the "plan_version" field.
+func PlanVersionEQ(v int) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldPlanVersion, v))
+}
+
+// PlanVersionNEQ applies the NEQ predicate on the "plan_version" field.
+func PlanVersionNEQ(v int) predicate.Price {
+	return predicate.Price(sql.FieldNEQ(FieldPlanVersion, v))
+}
+
+// PlanVersionIn applies the In predicate on the "plan_version" field.
+func PlanVersionIn(vs ...int) predicate.Price {
+	return predicate.Price(sql.FieldIn(FieldPlanVersion, vs...))
+}
+
+// PlanVersionNotIn applies the NotIn predicate on the "plan_version" field.
+func PlanVersionNotIn(vs ...int) predicate.Price {
+	return predicate.Price(sql.FieldNotIn(FieldPlanVersion, vs...))
+}
+
+// PlanVersionGT applies the GT predicate on the "plan_version" field.
+func PlanVersionGT(v int) predicate.Price {
+	return predicate.Price(sql.FieldGT(FieldPlanVersion, v))
+}
+
+// PlanVersionGTE applies the GTE predicate on the "plan_version" field.
+func PlanVersionGTE(v int) predicate.Price {
+	return predicate.Price(sql.FieldGTE(FieldPlanVersion, v))
+}
+
+// PlanVersionLT applies the LT predicate on the "plan_version" field.
+func PlanVersionLT(v int) predicate.Price {
+	return predicate.Price(sql.FieldLT(FieldPlanVersion, v))
+}
+
+// PlanVersionLTE applies the LTE predicate on the "plan_version" field.
+func PlanVersionLTE(v int) predicate.Price {
+	return predicate.Price(sql.FieldLTE(FieldPlanVersion, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v string) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v string) predicate.Price {
+	return predicate.Price(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...string) predicate.Price {
+	return predicate.Price(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...string) predicate.Price {
+	return predicate.Price(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v string) predicate.Price {
+	return predicate.Price(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v string) predicate.Price {
+	return predicate.Price(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v string) predicate.Price {
+	return predicate.Price(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v string) predicate.Price {
+	return predicate.Price(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContains applies the Contains predicate on the "subscription_id" field.
+func SubscriptionIDContains(v string) predicate.Price {
+	return predicate.Price(sql.FieldContains(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasPrefix applies the HasPrefix predicate on the "subscription_id" field.
+func SubscriptionIDHasPrefix(v string) predicate.Price {
+	return predicate.Price(sql.FieldHasPrefix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasSuffix applies the HasSuffix predicate on the "subscription_id" field.
+func SubscriptionIDHasSuffix(v string) predicate.Price {
+	return predicate.Price(sql.FieldHasSuffix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIsNil applies the IsNil predicate on the "subscription_id" field.
+func SubscriptionIDIsNil() predicate.Price {
+	return predicate.Price(sql.FieldIsNull(FieldSubscriptionID))
+}
+
+// SubscriptionIDNotNil applies the NotNil predicate on the "subscription_id" field.
+func SubscriptionIDNotNil() predicate.Price {
+	return predicate.Price(sql.FieldNotNull(FieldSubscriptionID))
+}
+
+// SubscriptionIDEqualFold applies the EqualFold predicate on the "subscription_id" field.
+func SubscriptionIDEqualFold(v string) predicate.Price {
+	return predicate.Price(sql.FieldEqualFold(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContainsFold applies the ContainsFold predicate on the "subscription_id" field.
+func SubscriptionIDContainsFold(v string) predicate.Price {
+	return predicate.Price(sql.FieldContainsFold(FieldSubscriptionID, v))
+}
+
+// ParentPriceIDEQ applies the EQ predicate on the "parent_price_id" field.
+func ParentPriceIDEQ(v string) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldParentPriceID, v))
+}
+
+// ParentPriceIDNEQ applies the NEQ predicate on the "parent_price_id" field.
+func ParentPriceIDNEQ(v string) predicate.Price {
+	return predicate.Price(sql.FieldNEQ(FieldParentPriceID, v))
+}
+
+// ParentPriceIDIn applies the In predicate on the "parent_price_id" field.
+func ParentPriceIDIn(vs ...string) predicate.Price {
+	return predicate.Price(sql.FieldIn(FieldParentPriceID, vs...))
+}
+
+// ParentPriceIDNotIn applies the NotIn predicate on the "parent_price_id" field.
+func ParentPriceIDNotIn(vs ...string) predicate.Price {
+	return predicate.Price(sql.FieldNotIn(FieldParentPriceID, vs...))
+}
+
+// ParentPriceIDGT applies the GT predicate on the "parent_price_id" field.
+func ParentPriceIDGT(v string) predicate.Price {
+	return predicate.Price(sql.FieldGT(FieldParentPriceID, v))
+}
+
+// ParentPriceIDGTE applies the GTE predicate on the "parent_price_id" field.
+func ParentPriceIDGTE(v string) predicate.Price {
+	return predicate.Price(sql.FieldGTE(FieldParentPriceID, v))
+}
+
+// ParentPriceIDLT applies the LT predicate on the "parent_price_id" field.
+func ParentPriceIDLT(v string) predicate.Price {
+	return predicate.Price(sql.FieldLT(FieldParentPriceID, v))
+}
+
+// ParentPriceIDLTE applies the LTE predicate on the "parent_price_id" field.
+func ParentPriceIDLTE(v string) predicate.Price {
+	return predicate.Price(sql.FieldLTE(FieldParentPriceID, v))
+}
+
+// ParentPriceIDContains applies the Contains predicate on the "parent_price_id" field.
+func ParentPriceIDContains(v string) predicate.Price {
+	return predicate.Price(sql.FieldContains(FieldParentPriceID, v))
+}
+
+// ParentPriceIDHasPrefix applies the HasPrefix predicate on the "parent_price_id" field.
+func ParentPriceIDHasPrefix(v string) predicate.Price {
+	return predicate.Price(sql.FieldHasPrefix(FieldParentPriceID, v))
+}
+
+// ParentPriceIDHasSuffix applies the HasSuffix predicate on the "parent_price_id" field.
+func ParentPriceIDHasSuffix(v string) predicate.Price {
+	return predicate.Price(sql.FieldHasSuffix(FieldParentPriceID, v))
+}
+
+// ParentPriceIDIsNil applies the IsNil predicate on the "parent_price_id" field.
+func ParentPriceIDIsNil() predicate.Price {
+	return predicate.Price(sql.FieldIsNull(FieldParentPriceID))
+}
+
+// ParentPriceIDNotNil applies the NotNil predicate on the "parent_price_id" field.
+func ParentPriceIDNotNil() predicate.Price {
+	return predicate.Price(sql.FieldNotNull(FieldParentPriceID))
+}
+
+// ParentPriceIDEqualFold applies the EqualFold predicate on the "parent_price_id" field.
+func ParentPriceIDEqualFold(v string) predicate.Price {
+	return predicate.Price(sql.FieldEqualFold(FieldParentPriceID, v))
+}
+
+// ParentPriceIDContainsFold applies the ContainsFold predicate on the "parent_price_id" field.
+func ParentPriceIDContainsFold(v string) predicate.Price {
+	return predicate.Price(sql.FieldContainsFold(FieldParentPriceID, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v string) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldType, v))
@@ -1439,6 +1650,56 @@ func TransformQuantityNotNil() predicate.Price {
 	return predicate.Price(sql.FieldNotNull(FieldTransformQuantity))
 }
 
+// MinimumChargeEQ applies the EQ predicate on the "minimum_charge" field.
+func MinimumChargeEQ(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldMinimumCharge, v))
+}
+
+// MinimumChargeNEQ applies the NEQ predicate on the "minimum_charge" field.
+func MinimumChargeNEQ(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldNEQ(FieldMinimumCharge, v))
+}
+
+// MinimumChargeIn applies the In predicate on the "minimum_charge" field.
+func MinimumChargeIn(vs ...decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldIn(FieldMinimumCharge, vs...))
+}
+
+// MinimumChargeNotIn applies the NotIn predicate on the "minimum_charge" field.
+func MinimumChargeNotIn(vs ...decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldNotIn(FieldMinimumCharge, vs...))
+}
+
+// MinimumChargeGT applies the GT predicate on the "minimum_charge" field.
+func MinimumChargeGT(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldGT(FieldMinimumCharge, v))
+}
+
+// MinimumChargeGTE applies the GTE predicate on the "minimum_charge" field.
+func MinimumChargeGTE(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldGTE(FieldMinimumCharge, v))
+}
+
+// MinimumChargeLT applies the LT predicate on the "minimum_charge" field.
+func MinimumChargeLT(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldLT(FieldMinimumCharge, v))
+}
+
+// MinimumChargeLTE applies the LTE predicate on the "minimum_charge" field.
+func MinimumChargeLTE(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldLTE(FieldMinimumCharge, v))
+}
+
+// MinimumChargeIsNil applies the IsNil predicate on the "minimum_charge" field.
+func MinimumChargeIsNil() predicate.Price {
+	return predicate.Price(sql.FieldIsNull(FieldMinimumCharge))
+}
+
+// MinimumChargeNotNil applies the NotNil predicate on the "minimum_charge" field.
+func MinimumChargeNotNil() predicate.Price {
+	return predicate.Price(sql.FieldNotNull(FieldMinimumCharge))
+}
+
 // LookupKeyEQ applies the EQ predicate on the "lookup_key" field.
 func LookupKeyEQ(v string) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldLookupKey, v))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/schema"
+	"github.com/shopspring/decimal"
 )
 
 // PriceCreate is the builder for creating a Price entity.
@@ -135,6 +136,48 @@ func (pc *PriceCreate) SetPlanID(s string) *PriceCreate {
 	return pc
 }
 
+// SetPlanVersion sets the "plan_version" field.
+func (pc *PriceCreate) SetPlanVersion(i int) *PriceCreate {
+	pc.mutation.SetPlanVersion(i)
+	return pc
+}
+
+// SetNillablePlanVersion sets the "plan_version" field if the given value is not nil.
+func (pc *PriceCreate) SetNillablePlanVersion(i *int) *PriceCreate {
+	if i != nil {
+		pc.SetPlanVersion(*i)
+	}
+	return pc
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (pc *PriceCreate) SetSubscriptionID(s string) *PriceCreate {
+	pc.mutation.SetSubscriptionID(s)
+	return pc
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (pc *PriceCreate) SetNillableSubscriptionID(s *string) *PriceCreate {
+	if s != nil {
+		pc.SetSubscriptionID(*s)
+	}
+	return pc
+}
+
+// SetParentPriceID sets the "parent_price_id" field.
+func (pc *PriceCreate) SetParentPriceID(s string) *PriceCreate {
+	pc.mutation.SetParentPriceID(s)
+	return pc
+}
+
+// SetNillableParentPriceID sets the "parent_price_id" field if the given value is not nil.
+func (pc *PriceCreate) SetNillableParentPriceID(s *string) *PriceCreate {
+	if s != nil {
+		pc.SetParentPriceID(*s)
+	}
+	return pc
+}
+
 // SetType sets the "type" field.
 func (pc *PriceCreate) SetType(s string) *PriceCreate {
 	pc.mutation.SetType(s)
@@ -247,6 +290,20 @@ func (pc *PriceCreate) SetNillableTransformQuantity(sq *schema.TransformQuantity
 	return pc
 }
 
+// SetMinimumCharge sets the "minimum_charge" field.
+func (pc *PriceCreate) SetMinimumCharge(d decimal.Decimal) *PriceCreate {
+	pc.mutation.SetMinimumCharge(d)
+	return pc
+}
+
+// SetNillableMinimumCharge sets the "minimum_charge" field if the given value is not nil.
+func (pc *PriceCreate) SetNillableMinimumCharge(d *decimal.Decimal) *PriceCreate {
+	if d != nil {
+		pc.SetMinimumCharge(*d)
+	}
+	return pc
+}
+
 // SetLookupKey sets the "lookup_key" field.
 func (pc *PriceCreate) SetLookupKey(s string) *PriceCreate {
 	pc.mutation.SetLookupKey(s)
@@ -338,6 +395,10 @@ func (pc *PriceCreate) defaults() {
 		v := price.DefaultEnvironmentID
 		pc.mutation.SetEnvironmentID(v)
 	}
+	if _, ok := pc.mutation.PlanVersion(); !ok {
+		v := price.DefaultPlanVersion
+		pc.mutation.SetPlanVersion(v)
+	}
 	if _, ok := pc.mutation.TrialPeriod(); !ok {
 		v := price.DefaultTrialPeriod
 		pc.mutation.SetTrialPeriod(v)
@@ -390,6 +451,9 @@ func (pc *PriceCreate) check() error {
 			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "Price.plan_id": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.PlanVersion(); !ok {
+		return &ValidationError{Name: "plan_version", err: errors.New(`ent: missing required field "Price.plan_version"`)}
+	}
 	if _, ok := pc.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Price.type"`)}
 	}
@@ -512,6 +576,18 @@ func (pc *PriceCreate) createSpec() (*Price, *sqlgraph.CreateSpec) {
 		_spec.SetField(price.FieldPlanID, field.TypeString, value)
 		_node.PlanID = value
 	}
+	if value, ok := pc.mutation.PlanVersion(); ok {
+		_spec.SetField(price.FieldPlanVersion, field.TypeInt, value)
+		_node.PlanVersion = value
+	}
+	if value, ok := pc.mutation.SubscriptionID(); ok {
+		_spec.SetField(price.FieldSubscriptionID, field.TypeString, value)
+		_node.SubscriptionID = &value
+	}
+	if value, ok := pc.mutation.ParentPriceID(); ok {
+		_spec.SetField(price.FieldParentPriceID, field.TypeString, value)
+		_node.ParentPriceID = &value
+	}
 	if value, ok := pc.mutation.GetType(); ok {
 		_spec.SetField(price.FieldType, field.TypeString, value)
 		_node.Type = value
@@ -560,6 +636,10 @@ func (pc *PriceCreate) createSpec() (*Price, *sqlgraph.CreateSpec) {
 		_spec.SetField(price.FieldTransformQuantity, field.TypeJSON, value)
 		_node.TransformQuantity = value
 	}
+	if value, ok := pc.mutation.MinimumCharge(); ok {
+		_spec.SetField(price.FieldMinimumCharge, field.TypeOther, value)
+		_node.MinimumCharge = &value
+	}
 	if value, ok := pc.mutation.LookupKey(); ok {
 		_spec.SetField(price.FieldLookupKey, field.TypeString, value)
 		_node.LookupKey = value
@@ -15,6 +15,7 @@ import (
 	"github.com/flexprice/flexprice/ent/predicate"
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/schema"
+	"github.com/shopspring/decimal"
 )
 
 // PriceUpdate is the builder for updating Price entities.
@@ -300,6 +301,26 @@ func (pu *PriceUpdate) ClearTransformQuantity() *PriceUpdate {
 	return pu
 }
 
+// SetMinimumCharge sets the "minimum_charge" field.
+func (pu *PriceUpdate) SetMinimumCharge(d decimal.Decimal) *PriceUpdate {
+	pu.mutation.SetMinimumCharge(d)
+	return pu
+}
+
+// SetNillableMinimumCharge sets the "minimum_charge" field if the given value is not nil.
+func (pu *PriceUpdate) SetNillableMinimumCharge(d *decimal.Decimal) *PriceUpdate {
+	if d != nil {
+		pu.SetMinimumCharge(*d)
+	}
+	return pu
+}
+
+// ClearMinimumCharge clears the value of the "minimum_charge" field.
+func (pu *PriceUpdate) ClearMinimumCharge() *PriceUpdate {
+	pu.mutation.ClearMinimumCharge()
+	return pu
+}
+
 // SetLookupKey sets the "lookup_key" field.
 func (pu *PriceUpdate) SetLookupKey(s string) *PriceUpdate {
 	pu.mutation.SetLookupKey(s)
@@ -483,6 +504,12 @@ func (pu *PriceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.PlanID(); ok {
 		_spec.SetField(price.FieldPlanID, field.TypeString, value)
 	}
+	if pu.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(price.FieldSubscriptionID, field.TypeString)
+	}
+	if pu.mutation.ParentPriceIDCleared() {
+		_spec.ClearField(price.FieldParentPriceID, field.TypeString)
+	}
 	if value, ok := pu.mutation.GetType(); ok {
 		_spec.SetField(price.FieldType, field.TypeString, value)
 	}
@@ -539,6 +566,12 @@ func (pu *PriceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.TransformQuantityCleared() {
 		_spec.ClearField(price.FieldTransformQuantity, field.TypeJSON)
 	}
+	if value, ok := pu.mutation.MinimumCharge(); ok {
+		_spec.SetField(price.FieldMinimumCharge, field.TypeOther, value)
+	}
+	if pu.mutation.MinimumChargeCleared() {
+		_spec.ClearField(price.FieldMinimumCharge, field.TypeOther)
+	}
 	if value, ok := pu.mutation.LookupKey(); ok {
 		_spec.SetField(price.FieldLookupKey, field.TypeString, value)
 	}
@@ -847,6 +880,26 @@ func (puo *PriceUpdateOne) ClearTransformQuantity() *PriceUpdateOne {
 	return puo
 }
 
+// SetMinimumCharge sets the "minimum_charge" field.
+func (puo *PriceUpdateOne) SetMinimumCharge(d decimal.Decimal) *PriceUpdateOne {
+	puo.mutation.SetMinimumCharge(d)
+	return puo
+}
+
+// SetNillableMinimumCharge sets the "minimum_charge" field if the given value is not nil.
+func (puo *PriceUpdateOne) SetNillableMinimumCharge(d *decimal.Decimal) *PriceUpdateOne {
+	if d != nil {
+		puo.SetMinimumCharge(*d)
+	}
+	return puo
+}
+
+// ClearMinimumCharge clears the value of the "minimum_charge" field.
+func (puo *PriceUpdateOne) ClearMinimumCharge() *PriceUpdateOne {
+	puo.mutation.ClearMinimumCharge()
+	return puo
+}
+
 // SetLookupKey sets the "lookup_key" field.
 func (puo *PriceUpdateOne) SetLookupKey(s string) *PriceUpdateOne {
 	puo.mutation.SetLookupKey(s)
@@ -1060,6 +1113,12 @@ func (puo *PriceUpdateOne) sqlSave(ctx context.Context) (_node *Price, err error
 	if value, ok := puo.mutation.PlanID(); ok {
 		_spec.SetField(price.FieldPlanID, field.TypeString, value)
 	}
+	if puo.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(price.FieldSubscriptionID, field.TypeString)
+	}
+	if puo.mutation.ParentPriceIDCleared() {
+		_spec.ClearField(price.FieldParentPriceID, field.TypeString)
+	}
 	if value, ok := puo.mutation.GetType(); ok {
 		_spec.SetField(price.FieldType, field.TypeString, value)
 	}
@@ -1116,6 +1175,12 @@ func (puo *PriceUpdateOne) sqlSave(ctx context.Context) (_node *Price, err error
 	if puo.mutation.TransformQuantityCleared() {
 		_spec.ClearField(price.FieldTransformQuantity, field.TypeJSON)
 	}
+	if value, ok := puo.mutation.MinimumCharge(); ok {
+		_spec.SetField(price.FieldMinimumCharge, field.TypeOther, value)
+	}
+	if puo.mutation.MinimumChargeCleared() {
+		_spec.ClearField(price.FieldMinimumCharge, field.TypeOther)
+	}
 	if value, ok := puo.mutation.LookupKey(); ok {
 		_spec.SetField(price.FieldLookupKey, field.TypeString, value)
 	}
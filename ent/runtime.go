@@ -5,9 +5,17 @@ package ent
 import (
 	"time"
 
+	"github.com/flexprice/flexprice/ent/alertevent"
+	"github.com/flexprice/flexprice/ent/alertrule"
 	"github.com/flexprice/flexprice/ent/auth"
 	"github.com/flexprice/flexprice/ent/billingsequence"
+	"github.com/flexprice/flexprice/ent/coupon"
+	"github.com/flexprice/flexprice/ent/couponapplication"
+	"github.com/flexprice/flexprice/ent/creditnote"
+	"github.com/flexprice/flexprice/ent/creditnotelineitem"
+	"github.com/flexprice/flexprice/ent/creditnotesequence"
 	"github.com/flexprice/flexprice/ent/customer"
+	"github.com/flexprice/flexprice/ent/dunningattempt"
 	"github.com/flexprice/flexprice/ent/entitlement"
 	"github.com/flexprice/flexprice/ent/environment"
 	"github.com/flexprice/flexprice/ent/feature"
@@ -15,6 +23,7 @@ import (
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
 	"github.com/flexprice/flexprice/ent/invoicesequence"
 	"github.com/flexprice/flexprice/ent/meter"
+	"github.com/flexprice/flexprice/ent/outboxevent"
 	"github.com/flexprice/flexprice/ent/payment"
 	"github.com/flexprice/flexprice/ent/paymentattempt"
 	"github.com/flexprice/flexprice/ent/plan"
@@ -24,11 +33,15 @@ import (
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
 	"github.com/flexprice/flexprice/ent/task"
+	"github.com/flexprice/flexprice/ent/taxrate"
 	"github.com/flexprice/flexprice/ent/tenant"
 	"github.com/flexprice/flexprice/ent/user"
 	"github.com/flexprice/flexprice/ent/wallet"
 	"github.com/flexprice/flexprice/ent/wallettransaction"
+	"github.com/flexprice/flexprice/ent/webhookdelivery"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
 	"github.com/shopspring/decimal"
 )
 
@@ -36,6 +49,88 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	alerteventMixin := schema.AlertEvent{}.Mixin()
+	alerteventMixinFields0 := alerteventMixin[0].Fields()
+	_ = alerteventMixinFields0
+	alerteventMixinFields1 := alerteventMixin[1].Fields()
+	_ = alerteventMixinFields1
+	alerteventFields := schema.AlertEvent{}.Fields()
+	_ = alerteventFields
+	// alerteventDescTenantID is the schema descriptor for tenant_id field.
+	alerteventDescTenantID := alerteventMixinFields0[0].Descriptor()
+	// alertevent.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	alertevent.TenantIDValidator = alerteventDescTenantID.Validators[0].(func(string) error)
+	// alerteventDescStatus is the schema descriptor for status field.
+	alerteventDescStatus := alerteventMixinFields0[1].Descriptor()
+	// alertevent.DefaultStatus holds the default value on creation for the status field.
+	alertevent.DefaultStatus = alerteventDescStatus.Default.(string)
+	// alerteventDescCreatedAt is the schema descriptor for created_at field.
+	alerteventDescCreatedAt := alerteventMixinFields0[2].Descriptor()
+	// alertevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	alertevent.DefaultCreatedAt = alerteventDescCreatedAt.Default.(func() time.Time)
+	// alerteventDescUpdatedAt is the schema descriptor for updated_at field.
+	alerteventDescUpdatedAt := alerteventMixinFields0[3].Descriptor()
+	// alertevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	alertevent.DefaultUpdatedAt = alerteventDescUpdatedAt.Default.(func() time.Time)
+	// alertevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	alertevent.UpdateDefaultUpdatedAt = alerteventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// alerteventDescEnvironmentID is the schema descriptor for environment_id field.
+	alerteventDescEnvironmentID := alerteventMixinFields1[0].Descriptor()
+	// alertevent.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	alertevent.DefaultEnvironmentID = alerteventDescEnvironmentID.Default.(string)
+	// alerteventDescAlertRuleID is the schema descriptor for alert_rule_id field.
+	alerteventDescAlertRuleID := alerteventFields[1].Descriptor()
+	// alertevent.AlertRuleIDValidator is a validator for the "alert_rule_id" field. It is called by the builders before save.
+	alertevent.AlertRuleIDValidator = alerteventDescAlertRuleID.Validators[0].(func(string) error)
+	// alerteventDescAlertType is the schema descriptor for alert_type field.
+	alerteventDescAlertType := alerteventFields[2].Descriptor()
+	// alertevent.AlertTypeValidator is a validator for the "alert_type" field. It is called by the builders before save.
+	alertevent.AlertTypeValidator = alerteventDescAlertType.Validators[0].(func(string) error)
+	// alerteventDescCustomerID is the schema descriptor for customer_id field.
+	alerteventDescCustomerID := alerteventFields[3].Descriptor()
+	// alertevent.CustomerIDValidator is a validator for the "customer_id" field. It is called by the builders before save.
+	alertevent.CustomerIDValidator = alerteventDescCustomerID.Validators[0].(func(string) error)
+	// alerteventDescSubscriptionID is the schema descriptor for subscription_id field.
+	alerteventDescSubscriptionID := alerteventFields[4].Descriptor()
+	// alertevent.SubscriptionIDValidator is a validator for the "subscription_id" field. It is called by the builders before save.
+	alertevent.SubscriptionIDValidator = alerteventDescSubscriptionID.Validators[0].(func(string) error)
+	alertruleMixin := schema.AlertRule{}.Mixin()
+	alertruleMixinFields0 := alertruleMixin[0].Fields()
+	_ = alertruleMixinFields0
+	alertruleMixinFields1 := alertruleMixin[1].Fields()
+	_ = alertruleMixinFields1
+	alertruleFields := schema.AlertRule{}.Fields()
+	_ = alertruleFields
+	// alertruleDescTenantID is the schema descriptor for tenant_id field.
+	alertruleDescTenantID := alertruleMixinFields0[0].Descriptor()
+	// alertrule.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	alertrule.TenantIDValidator = alertruleDescTenantID.Validators[0].(func(string) error)
+	// alertruleDescStatus is the schema descriptor for status field.
+	alertruleDescStatus := alertruleMixinFields0[1].Descriptor()
+	// alertrule.DefaultStatus holds the default value on creation for the status field.
+	alertrule.DefaultStatus = alertruleDescStatus.Default.(string)
+	// alertruleDescCreatedAt is the schema descriptor for created_at field.
+	alertruleDescCreatedAt := alertruleMixinFields0[2].Descriptor()
+	// alertrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	alertrule.DefaultCreatedAt = alertruleDescCreatedAt.Default.(func() time.Time)
+	// alertruleDescUpdatedAt is the schema descriptor for updated_at field.
+	alertruleDescUpdatedAt := alertruleMixinFields0[3].Descriptor()
+	// alertrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	alertrule.DefaultUpdatedAt = alertruleDescUpdatedAt.Default.(func() time.Time)
+	// alertrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	alertrule.UpdateDefaultUpdatedAt = alertruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// alertruleDescEnvironmentID is the schema descriptor for environment_id field.
+	alertruleDescEnvironmentID := alertruleMixinFields1[0].Descriptor()
+	// alertrule.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	alertrule.DefaultEnvironmentID = alertruleDescEnvironmentID.Default.(string)
+	// alertruleDescName is the schema descriptor for name field.
+	alertruleDescName := alertruleFields[1].Descriptor()
+	// alertrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	alertrule.NameValidator = alertruleDescName.Validators[0].(func(string) error)
+	// alertruleDescAlertType is the schema descriptor for alert_type field.
+	alertruleDescAlertType := alertruleFields[2].Descriptor()
+	// alertrule.AlertTypeValidator is a validator for the "alert_type" field. It is called by the builders before save.
+	alertrule.AlertTypeValidator = alertruleDescAlertType.Validators[0].(func(string) error)
 	authFields := schema.Auth{}.Fields()
 	_ = authFields
 	// authDescProvider is the schema descriptor for provider field.
@@ -84,6 +179,218 @@ func init() {
 	billingsequence.DefaultUpdatedAt = billingsequenceDescUpdatedAt.Default.(func() time.Time)
 	// billingsequence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	billingsequence.UpdateDefaultUpdatedAt = billingsequenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	couponMixin := schema.Coupon{}.Mixin()
+	couponMixinFields0 := couponMixin[0].Fields()
+	_ = couponMixinFields0
+	couponMixinFields1 := couponMixin[1].Fields()
+	_ = couponMixinFields1
+	couponFields := schema.Coupon{}.Fields()
+	_ = couponFields
+	// couponDescTenantID is the schema descriptor for tenant_id field.
+	couponDescTenantID := couponMixinFields0[0].Descriptor()
+	// coupon.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	coupon.TenantIDValidator = couponDescTenantID.Validators[0].(func(string) error)
+	// couponDescStatus is the schema descriptor for status field.
+	couponDescStatus := couponMixinFields0[1].Descriptor()
+	// coupon.DefaultStatus holds the default value on creation for the status field.
+	coupon.DefaultStatus = couponDescStatus.Default.(string)
+	// couponDescCreatedAt is the schema descriptor for created_at field.
+	couponDescCreatedAt := couponMixinFields0[2].Descriptor()
+	// coupon.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coupon.DefaultCreatedAt = couponDescCreatedAt.Default.(func() time.Time)
+	// couponDescUpdatedAt is the schema descriptor for updated_at field.
+	couponDescUpdatedAt := couponMixinFields0[3].Descriptor()
+	// coupon.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coupon.DefaultUpdatedAt = couponDescUpdatedAt.Default.(func() time.Time)
+	// coupon.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coupon.UpdateDefaultUpdatedAt = couponDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// couponDescEnvironmentID is the schema descriptor for environment_id field.
+	couponDescEnvironmentID := couponMixinFields1[0].Descriptor()
+	// coupon.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	coupon.DefaultEnvironmentID = couponDescEnvironmentID.Default.(string)
+	// couponDescName is the schema descriptor for name field.
+	couponDescName := couponFields[1].Descriptor()
+	// coupon.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	coupon.NameValidator = couponDescName.Validators[0].(func(string) error)
+	// couponDescDiscountType is the schema descriptor for discount_type field.
+	couponDescDiscountType := couponFields[3].Descriptor()
+	// coupon.DiscountTypeValidator is a validator for the "discount_type" field. It is called by the builders before save.
+	coupon.DiscountTypeValidator = couponDescDiscountType.Validators[0].(func(string) error)
+	// couponDescDuration is the schema descriptor for duration field.
+	couponDescDuration := couponFields[7].Descriptor()
+	// coupon.DurationValidator is a validator for the "duration" field. It is called by the builders before save.
+	coupon.DurationValidator = couponDescDuration.Validators[0].(func(string) error)
+	// couponDescTimesRedeemed is the schema descriptor for times_redeemed field.
+	couponDescTimesRedeemed := couponFields[10].Descriptor()
+	// coupon.DefaultTimesRedeemed holds the default value on creation for the times_redeemed field.
+	coupon.DefaultTimesRedeemed = couponDescTimesRedeemed.Default.(int)
+	couponapplicationMixin := schema.CouponApplication{}.Mixin()
+	couponapplicationMixinFields0 := couponapplicationMixin[0].Fields()
+	_ = couponapplicationMixinFields0
+	couponapplicationMixinFields1 := couponapplicationMixin[1].Fields()
+	_ = couponapplicationMixinFields1
+	couponapplicationFields := schema.CouponApplication{}.Fields()
+	_ = couponapplicationFields
+	// couponapplicationDescTenantID is the schema descriptor for tenant_id field.
+	couponapplicationDescTenantID := couponapplicationMixinFields0[0].Descriptor()
+	// couponapplication.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	couponapplication.TenantIDValidator = couponapplicationDescTenantID.Validators[0].(func(string) error)
+	// couponapplicationDescStatus is the schema descriptor for status field.
+	couponapplicationDescStatus := couponapplicationMixinFields0[1].Descriptor()
+	// couponapplication.DefaultStatus holds the default value on creation for the status field.
+	couponapplication.DefaultStatus = couponapplicationDescStatus.Default.(string)
+	// couponapplicationDescCreatedAt is the schema descriptor for created_at field.
+	couponapplicationDescCreatedAt := couponapplicationMixinFields0[2].Descriptor()
+	// couponapplication.DefaultCreatedAt holds the default value on creation for the created_at field.
+	couponapplication.DefaultCreatedAt = couponapplicationDescCreatedAt.Default.(func() time.Time)
+	// couponapplicationDescUpdatedAt is the schema descriptor for updated_at field.
+	couponapplicationDescUpdatedAt := couponapplicationMixinFields0[3].Descriptor()
+	// couponapplication.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	couponapplication.DefaultUpdatedAt = couponapplicationDescUpdatedAt.Default.(func() time.Time)
+	// couponapplication.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	couponapplication.UpdateDefaultUpdatedAt = couponapplicationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// couponapplicationDescEnvironmentID is the schema descriptor for environment_id field.
+	couponapplicationDescEnvironmentID := couponapplicationMixinFields1[0].Descriptor()
+	// couponapplication.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	couponapplication.DefaultEnvironmentID = couponapplicationDescEnvironmentID.Default.(string)
+	// couponapplicationDescCouponID is the schema descriptor for coupon_id field.
+	couponapplicationDescCouponID := couponapplicationFields[1].Descriptor()
+	// couponapplication.CouponIDValidator is a validator for the "coupon_id" field. It is called by the builders before save.
+	couponapplication.CouponIDValidator = couponapplicationDescCouponID.Validators[0].(func(string) error)
+	// couponapplicationDescCustomerID is the schema descriptor for customer_id field.
+	couponapplicationDescCustomerID := couponapplicationFields[2].Descriptor()
+	// couponapplication.CustomerIDValidator is a validator for the "customer_id" field. It is called by the builders before save.
+	couponapplication.CustomerIDValidator = couponapplicationDescCustomerID.Validators[0].(func(string) error)
+	// couponapplicationDescPeriodsApplied is the schema descriptor for periods_applied field.
+	couponapplicationDescPeriodsApplied := couponapplicationFields[4].Descriptor()
+	// couponapplication.DefaultPeriodsApplied holds the default value on creation for the periods_applied field.
+	couponapplication.DefaultPeriodsApplied = couponapplicationDescPeriodsApplied.Default.(int)
+	creditnoteMixin := schema.CreditNote{}.Mixin()
+	creditnoteMixinFields0 := creditnoteMixin[0].Fields()
+	_ = creditnoteMixinFields0
+	creditnoteMixinFields1 := creditnoteMixin[1].Fields()
+	_ = creditnoteMixinFields1
+	creditnoteFields := schema.CreditNote{}.Fields()
+	_ = creditnoteFields
+	// creditnoteDescTenantID is the schema descriptor for tenant_id field.
+	creditnoteDescTenantID := creditnoteMixinFields0[0].Descriptor()
+	// creditnote.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	creditnote.TenantIDValidator = creditnoteDescTenantID.Validators[0].(func(string) error)
+	// creditnoteDescStatus is the schema descriptor for status field.
+	creditnoteDescStatus := creditnoteMixinFields0[1].Descriptor()
+	// creditnote.DefaultStatus holds the default value on creation for the status field.
+	creditnote.DefaultStatus = creditnoteDescStatus.Default.(string)
+	// creditnoteDescCreatedAt is the schema descriptor for created_at field.
+	creditnoteDescCreatedAt := creditnoteMixinFields0[2].Descriptor()
+	// creditnote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	creditnote.DefaultCreatedAt = creditnoteDescCreatedAt.Default.(func() time.Time)
+	// creditnoteDescUpdatedAt is the schema descriptor for updated_at field.
+	creditnoteDescUpdatedAt := creditnoteMixinFields0[3].Descriptor()
+	// creditnote.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	creditnote.DefaultUpdatedAt = creditnoteDescUpdatedAt.Default.(func() time.Time)
+	// creditnote.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	creditnote.UpdateDefaultUpdatedAt = creditnoteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// creditnoteDescEnvironmentID is the schema descriptor for environment_id field.
+	creditnoteDescEnvironmentID := creditnoteMixinFields1[0].Descriptor()
+	// creditnote.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	creditnote.DefaultEnvironmentID = creditnoteDescEnvironmentID.Default.(string)
+	// creditnoteDescCreditNoteNumber is the schema descriptor for credit_note_number field.
+	creditnoteDescCreditNoteNumber := creditnoteFields[1].Descriptor()
+	// creditnote.CreditNoteNumberValidator is a validator for the "credit_note_number" field. It is called by the builders before save.
+	creditnote.CreditNoteNumberValidator = creditnoteDescCreditNoteNumber.Validators[0].(func(string) error)
+	// creditnoteDescInvoiceID is the schema descriptor for invoice_id field.
+	creditnoteDescInvoiceID := creditnoteFields[2].Descriptor()
+	// creditnote.InvoiceIDValidator is a validator for the "invoice_id" field. It is called by the builders before save.
+	creditnote.InvoiceIDValidator = creditnoteDescInvoiceID.Validators[0].(func(string) error)
+	// creditnoteDescCustomerID is the schema descriptor for customer_id field.
+	creditnoteDescCustomerID := creditnoteFields[3].Descriptor()
+	// creditnote.CustomerIDValidator is a validator for the "customer_id" field. It is called by the builders before save.
+	creditnote.CustomerIDValidator = creditnoteDescCustomerID.Validators[0].(func(string) error)
+	// creditnoteDescReason is the schema descriptor for reason field.
+	creditnoteDescReason := creditnoteFields[5].Descriptor()
+	// creditnote.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	creditnote.ReasonValidator = creditnoteDescReason.Validators[0].(func(string) error)
+	// creditnoteDescOutcome is the schema descriptor for outcome field.
+	creditnoteDescOutcome := creditnoteFields[6].Descriptor()
+	// creditnote.OutcomeValidator is a validator for the "outcome" field. It is called by the builders before save.
+	creditnote.OutcomeValidator = creditnoteDescOutcome.Validators[0].(func(string) error)
+	// creditnoteDescCurrency is the schema descriptor for currency field.
+	creditnoteDescCurrency := creditnoteFields[8].Descriptor()
+	// creditnote.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	creditnote.CurrencyValidator = creditnoteDescCurrency.Validators[0].(func(string) error)
+	// creditnoteDescTotalAmount is the schema descriptor for total_amount field.
+	creditnoteDescTotalAmount := creditnoteFields[9].Descriptor()
+	// creditnote.DefaultTotalAmount holds the default value on creation for the total_amount field.
+	creditnote.DefaultTotalAmount = creditnoteDescTotalAmount.Default.(decimal.Decimal)
+	creditnotelineitemMixin := schema.CreditNoteLineItem{}.Mixin()
+	creditnotelineitemMixinFields0 := creditnotelineitemMixin[0].Fields()
+	_ = creditnotelineitemMixinFields0
+	creditnotelineitemMixinFields1 := creditnotelineitemMixin[1].Fields()
+	_ = creditnotelineitemMixinFields1
+	creditnotelineitemFields := schema.CreditNoteLineItem{}.Fields()
+	_ = creditnotelineitemFields
+	// creditnotelineitemDescTenantID is the schema descriptor for tenant_id field.
+	creditnotelineitemDescTenantID := creditnotelineitemMixinFields0[0].Descriptor()
+	// creditnotelineitem.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	creditnotelineitem.TenantIDValidator = creditnotelineitemDescTenantID.Validators[0].(func(string) error)
+	// creditnotelineitemDescStatus is the schema descriptor for status field.
+	creditnotelineitemDescStatus := creditnotelineitemMixinFields0[1].Descriptor()
+	// creditnotelineitem.DefaultStatus holds the default value on creation for the status field.
+	creditnotelineitem.DefaultStatus = creditnotelineitemDescStatus.Default.(string)
+	// creditnotelineitemDescCreatedAt is the schema descriptor for created_at field.
+	creditnotelineitemDescCreatedAt := creditnotelineitemMixinFields0[2].Descriptor()
+	// creditnotelineitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	creditnotelineitem.DefaultCreatedAt = creditnotelineitemDescCreatedAt.Default.(func() time.Time)
+	// creditnotelineitemDescUpdatedAt is the schema descriptor for updated_at field.
+	creditnotelineitemDescUpdatedAt := creditnotelineitemMixinFields0[3].Descriptor()
+	// creditnotelineitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	creditnotelineitem.DefaultUpdatedAt = creditnotelineitemDescUpdatedAt.Default.(func() time.Time)
+	// creditnotelineitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	creditnotelineitem.UpdateDefaultUpdatedAt = creditnotelineitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// creditnotelineitemDescEnvironmentID is the schema descriptor for environment_id field.
+	creditnotelineitemDescEnvironmentID := creditnotelineitemMixinFields1[0].Descriptor()
+	// creditnotelineitem.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	creditnotelineitem.DefaultEnvironmentID = creditnotelineitemDescEnvironmentID.Default.(string)
+	// creditnotelineitemDescCreditNoteID is the schema descriptor for credit_note_id field.
+	creditnotelineitemDescCreditNoteID := creditnotelineitemFields[1].Descriptor()
+	// creditnotelineitem.CreditNoteIDValidator is a validator for the "credit_note_id" field. It is called by the builders before save.
+	creditnotelineitem.CreditNoteIDValidator = creditnotelineitemDescCreditNoteID.Validators[0].(func(string) error)
+	// creditnotelineitemDescDisplayName is the schema descriptor for display_name field.
+	creditnotelineitemDescDisplayName := creditnotelineitemFields[3].Descriptor()
+	// creditnotelineitem.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	creditnotelineitem.DisplayNameValidator = creditnotelineitemDescDisplayName.Validators[0].(func(string) error)
+	// creditnotelineitemDescAmount is the schema descriptor for amount field.
+	creditnotelineitemDescAmount := creditnotelineitemFields[4].Descriptor()
+	// creditnotelineitem.DefaultAmount holds the default value on creation for the amount field.
+	creditnotelineitem.DefaultAmount = creditnotelineitemDescAmount.Default.(decimal.Decimal)
+	// creditnotelineitemDescCurrency is the schema descriptor for currency field.
+	creditnotelineitemDescCurrency := creditnotelineitemFields[5].Descriptor()
+	// creditnotelineitem.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	creditnotelineitem.CurrencyValidator = creditnotelineitemDescCurrency.Validators[0].(func(string) error)
+	creditnotesequenceFields := schema.CreditNoteSequence{}.Fields()
+	_ = creditnotesequenceFields
+	// creditnotesequenceDescTenantID is the schema descriptor for tenant_id field.
+	creditnotesequenceDescTenantID := creditnotesequenceFields[0].Descriptor()
+	// creditnotesequence.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	creditnotesequence.TenantIDValidator = creditnotesequenceDescTenantID.Validators[0].(func(string) error)
+	// creditnotesequenceDescYearMonth is the schema descriptor for year_month field.
+	creditnotesequenceDescYearMonth := creditnotesequenceFields[1].Descriptor()
+	// creditnotesequence.YearMonthValidator is a validator for the "year_month" field. It is called by the builders before save.
+	creditnotesequence.YearMonthValidator = creditnotesequenceDescYearMonth.Validators[0].(func(string) error)
+	// creditnotesequenceDescLastValue is the schema descriptor for last_value field.
+	creditnotesequenceDescLastValue := creditnotesequenceFields[2].Descriptor()
+	// creditnotesequence.DefaultLastValue holds the default value on creation for the last_value field.
+	creditnotesequence.DefaultLastValue = creditnotesequenceDescLastValue.Default.(int64)
+	// creditnotesequenceDescCreatedAt is the schema descriptor for created_at field.
+	creditnotesequenceDescCreatedAt := creditnotesequenceFields[3].Descriptor()
+	// creditnotesequence.DefaultCreatedAt holds the default value on creation for the created_at field.
+	creditnotesequence.DefaultCreatedAt = creditnotesequenceDescCreatedAt.Default.(func() time.Time)
+	// creditnotesequenceDescUpdatedAt is the schema descriptor for updated_at field.
+	creditnotesequenceDescUpdatedAt := creditnotesequenceFields[4].Descriptor()
+	// creditnotesequence.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	creditnotesequence.DefaultUpdatedAt = creditnotesequenceDescUpdatedAt.Default.(func() time.Time)
+	// creditnotesequence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	creditnotesequence.UpdateDefaultUpdatedAt = creditnotesequenceDescUpdatedAt.UpdateDefault.(func() time.Time)
 	customerMixin := schema.Customer{}.Mixin()
 	customerMixinFields0 := customerMixin[0].Fields()
 	_ = customerMixinFields0
@@ -121,6 +428,59 @@ func init() {
 	customerDescName := customerFields[2].Descriptor()
 	// customer.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	customer.NameValidator = customerDescName.Validators[0].(func(string) error)
+	// customerDescTaxExempt is the schema descriptor for tax_exempt field.
+	customerDescTaxExempt := customerFields[11].Descriptor()
+	// customer.DefaultTaxExempt holds the default value on creation for the tax_exempt field.
+	customer.DefaultTaxExempt = customerDescTaxExempt.Default.(bool)
+	// customerDescAutoApplyCredits is the schema descriptor for auto_apply_credits field.
+	customerDescAutoApplyCredits := customerFields[12].Descriptor()
+	// customer.DefaultAutoApplyCredits holds the default value on creation for the auto_apply_credits field.
+	customer.DefaultAutoApplyCredits = customerDescAutoApplyCredits.Default.(bool)
+	dunningattemptMixin := schema.DunningAttempt{}.Mixin()
+	dunningattemptMixinFields0 := dunningattemptMixin[0].Fields()
+	_ = dunningattemptMixinFields0
+	dunningattemptMixinFields1 := dunningattemptMixin[1].Fields()
+	_ = dunningattemptMixinFields1
+	dunningattemptFields := schema.DunningAttempt{}.Fields()
+	_ = dunningattemptFields
+	// dunningattemptDescTenantID is the schema descriptor for tenant_id field.
+	dunningattemptDescTenantID := dunningattemptMixinFields0[0].Descriptor()
+	// dunningattempt.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	dunningattempt.TenantIDValidator = dunningattemptDescTenantID.Validators[0].(func(string) error)
+	// dunningattemptDescStatus is the schema descriptor for status field.
+	dunningattemptDescStatus := dunningattemptMixinFields0[1].Descriptor()
+	// dunningattempt.DefaultStatus holds the default value on creation for the status field.
+	dunningattempt.DefaultStatus = dunningattemptDescStatus.Default.(string)
+	// dunningattemptDescCreatedAt is the schema descriptor for created_at field.
+	dunningattemptDescCreatedAt := dunningattemptMixinFields0[2].Descriptor()
+	// dunningattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dunningattempt.DefaultCreatedAt = dunningattemptDescCreatedAt.Default.(func() time.Time)
+	// dunningattemptDescUpdatedAt is the schema descriptor for updated_at field.
+	dunningattemptDescUpdatedAt := dunningattemptMixinFields0[3].Descriptor()
+	// dunningattempt.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dunningattempt.DefaultUpdatedAt = dunningattemptDescUpdatedAt.Default.(func() time.Time)
+	// dunningattempt.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dunningattempt.UpdateDefaultUpdatedAt = dunningattemptDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// dunningattemptDescEnvironmentID is the schema descriptor for environment_id field.
+	dunningattemptDescEnvironmentID := dunningattemptMixinFields1[0].Descriptor()
+	// dunningattempt.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	dunningattempt.DefaultEnvironmentID = dunningattemptDescEnvironmentID.Default.(string)
+	// dunningattemptDescInvoiceID is the schema descriptor for invoice_id field.
+	dunningattemptDescInvoiceID := dunningattemptFields[1].Descriptor()
+	// dunningattempt.InvoiceIDValidator is a validator for the "invoice_id" field. It is called by the builders before save.
+	dunningattempt.InvoiceIDValidator = dunningattemptDescInvoiceID.Validators[0].(func(string) error)
+	// dunningattemptDescAttemptStatus is the schema descriptor for attempt_status field.
+	dunningattemptDescAttemptStatus := dunningattemptFields[4].Descriptor()
+	// dunningattempt.AttemptStatusValidator is a validator for the "attempt_status" field. It is called by the builders before save.
+	dunningattempt.AttemptStatusValidator = dunningattemptDescAttemptStatus.Validators[0].(func(string) error)
+	// dunningattemptDescAmountPaid is the schema descriptor for amount_paid field.
+	dunningattemptDescAmountPaid := dunningattemptFields[5].Descriptor()
+	// dunningattempt.DefaultAmountPaid holds the default value on creation for the amount_paid field.
+	dunningattempt.DefaultAmountPaid = dunningattemptDescAmountPaid.Default.(decimal.Decimal)
+	// dunningattemptDescAmountRemaining is the schema descriptor for amount_remaining field.
+	dunningattemptDescAmountRemaining := dunningattemptFields[6].Descriptor()
+	// dunningattempt.DefaultAmountRemaining holds the default value on creation for the amount_remaining field.
+	dunningattempt.DefaultAmountRemaining = dunningattemptDescAmountRemaining.Default.(decimal.Decimal)
 	entitlementMixin := schema.Entitlement{}.Mixin()
 	entitlementMixinFields0 := entitlementMixin[0].Fields()
 	_ = entitlementMixinFields0
@@ -303,8 +663,28 @@ func init() {
 	invoiceDescAmountRemaining := invoiceFields[9].Descriptor()
 	// invoice.DefaultAmountRemaining holds the default value on creation for the amount_remaining field.
 	invoice.DefaultAmountRemaining = invoiceDescAmountRemaining.Default.(decimal.Decimal)
+	// invoiceDescAmountCredited is the schema descriptor for amount_credited field.
+	invoiceDescAmountCredited := invoiceFields[10].Descriptor()
+	// invoice.DefaultAmountCredited holds the default value on creation for the amount_credited field.
+	invoice.DefaultAmountCredited = invoiceDescAmountCredited.Default.(decimal.Decimal)
+	// invoiceDescSubtotal is the schema descriptor for subtotal field.
+	invoiceDescSubtotal := invoiceFields[11].Descriptor()
+	// invoice.DefaultSubtotal holds the default value on creation for the subtotal field.
+	invoice.DefaultSubtotal = invoiceDescSubtotal.Default.(decimal.Decimal)
+	// invoiceDescTotalDiscount is the schema descriptor for total_discount field.
+	invoiceDescTotalDiscount := invoiceFields[12].Descriptor()
+	// invoice.DefaultTotalDiscount holds the default value on creation for the total_discount field.
+	invoice.DefaultTotalDiscount = invoiceDescTotalDiscount.Default.(decimal.Decimal)
+	// invoiceDescTotal is the schema descriptor for total field.
+	invoiceDescTotal := invoiceFields[13].Descriptor()
+	// invoice.DefaultTotal holds the default value on creation for the total field.
+	invoice.DefaultTotal = invoiceDescTotal.Default.(decimal.Decimal)
+	// invoiceDescTotalTax is the schema descriptor for total_tax field.
+	invoiceDescTotalTax := invoiceFields[15].Descriptor()
+	// invoice.DefaultTotalTax holds the default value on creation for the total_tax field.
+	invoice.DefaultTotalTax = invoiceDescTotalTax.Default.(decimal.Decimal)
 	// invoiceDescVersion is the schema descriptor for version field.
-	invoiceDescVersion := invoiceFields[21].Descriptor()
+	invoiceDescVersion := invoiceFields[28].Descriptor()
 	// invoice.DefaultVersion holds the default value on creation for the version field.
 	invoice.DefaultVersion = invoiceDescVersion.Default.(int)
 	invoicelineitemMixin := schema.InvoiceLineItem{}.Mixin()
@@ -429,6 +809,51 @@ func init() {
 	meterDescResetUsage := meterFields[5].Descriptor()
 	// meter.DefaultResetUsage holds the default value on creation for the reset_usage field.
 	meter.DefaultResetUsage = meterDescResetUsage.Default.(string)
+	outboxeventMixin := schema.OutboxEvent{}.Mixin()
+	outboxeventMixinFields0 := outboxeventMixin[0].Fields()
+	_ = outboxeventMixinFields0
+	outboxeventMixinFields1 := outboxeventMixin[1].Fields()
+	_ = outboxeventMixinFields1
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescTenantID is the schema descriptor for tenant_id field.
+	outboxeventDescTenantID := outboxeventMixinFields0[0].Descriptor()
+	// outboxevent.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	outboxevent.TenantIDValidator = outboxeventDescTenantID.Validators[0].(func(string) error)
+	// outboxeventDescStatus is the schema descriptor for status field.
+	outboxeventDescStatus := outboxeventMixinFields0[1].Descriptor()
+	// outboxevent.DefaultStatus holds the default value on creation for the status field.
+	outboxevent.DefaultStatus = outboxeventDescStatus.Default.(string)
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventMixinFields0[2].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescUpdatedAt is the schema descriptor for updated_at field.
+	outboxeventDescUpdatedAt := outboxeventMixinFields0[3].Descriptor()
+	// outboxevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	outboxevent.DefaultUpdatedAt = outboxeventDescUpdatedAt.Default.(func() time.Time)
+	// outboxevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	outboxevent.UpdateDefaultUpdatedAt = outboxeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// outboxeventDescEnvironmentID is the schema descriptor for environment_id field.
+	outboxeventDescEnvironmentID := outboxeventMixinFields1[0].Descriptor()
+	// outboxevent.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	outboxevent.DefaultEnvironmentID = outboxeventDescEnvironmentID.Default.(string)
+	// outboxeventDescTopic is the schema descriptor for topic field.
+	outboxeventDescTopic := outboxeventFields[1].Descriptor()
+	// outboxevent.TopicValidator is a validator for the "topic" field. It is called by the builders before save.
+	outboxevent.TopicValidator = outboxeventDescTopic.Validators[0].(func(string) error)
+	// outboxeventDescMessageID is the schema descriptor for message_id field.
+	outboxeventDescMessageID := outboxeventFields[2].Descriptor()
+	// outboxevent.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	outboxevent.MessageIDValidator = outboxeventDescMessageID.Validators[0].(func(string) error)
+	// outboxeventDescDeliveryStatus is the schema descriptor for delivery_status field.
+	outboxeventDescDeliveryStatus := outboxeventFields[5].Descriptor()
+	// outboxevent.DeliveryStatusValidator is a validator for the "delivery_status" field. It is called by the builders before save.
+	outboxevent.DeliveryStatusValidator = outboxeventDescDeliveryStatus.Validators[0].(func(string) error)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[6].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
 	paymentMixin := schema.Payment{}.Mixin()
 	paymentMixinFields0 := paymentMixin[0].Fields()
 	_ = paymentMixinFields0
@@ -562,6 +987,10 @@ func init() {
 	planDescName := planFields[2].Descriptor()
 	// plan.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	plan.NameValidator = planDescName.Validators[0].(func(string) error)
+	// planDescVersion is the schema descriptor for version field.
+	planDescVersion := planFields[4].Descriptor()
+	// plan.DefaultVersion holds the default value on creation for the version field.
+	plan.DefaultVersion = planDescVersion.Default.(int)
 	priceMixin := schema.Price{}.Mixin()
 	priceMixinFields0 := priceMixin[0].Fields()
 	_ = priceMixinFields0
@@ -603,28 +1032,32 @@ func init() {
 	priceDescPlanID := priceFields[4].Descriptor()
 	// price.PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
 	price.PlanIDValidator = priceDescPlanID.Validators[0].(func(string) error)
+	// priceDescPlanVersion is the schema descriptor for plan_version field.
+	priceDescPlanVersion := priceFields[5].Descriptor()
+	// price.DefaultPlanVersion holds the default value on creation for the plan_version field.
+	price.DefaultPlanVersion = priceDescPlanVersion.Default.(int)
 	// priceDescType is the schema descriptor for type field.
-	priceDescType := priceFields[5].Descriptor()
+	priceDescType := priceFields[8].Descriptor()
 	// price.TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	price.TypeValidator = priceDescType.Validators[0].(func(string) error)
 	// priceDescBillingPeriod is the schema descriptor for billing_period field.
-	priceDescBillingPeriod := priceFields[6].Descriptor()
+	priceDescBillingPeriod := priceFields[9].Descriptor()
 	// price.BillingPeriodValidator is a validator for the "billing_period" field. It is called by the builders before save.
 	price.BillingPeriodValidator = priceDescBillingPeriod.Validators[0].(func(string) error)
 	// priceDescBillingPeriodCount is the schema descriptor for billing_period_count field.
-	priceDescBillingPeriodCount := priceFields[7].Descriptor()
+	priceDescBillingPeriodCount := priceFields[10].Descriptor()
 	// price.BillingPeriodCountValidator is a validator for the "billing_period_count" field. It is called by the builders before save.
 	price.BillingPeriodCountValidator = priceDescBillingPeriodCount.Validators[0].(func(int) error)
 	// priceDescBillingModel is the schema descriptor for billing_model field.
-	priceDescBillingModel := priceFields[8].Descriptor()
+	priceDescBillingModel := priceFields[11].Descriptor()
 	// price.BillingModelValidator is a validator for the "billing_model" field. It is called by the builders before save.
 	price.BillingModelValidator = priceDescBillingModel.Validators[0].(func(string) error)
 	// priceDescBillingCadence is the schema descriptor for billing_cadence field.
-	priceDescBillingCadence := priceFields[9].Descriptor()
+	priceDescBillingCadence := priceFields[12].Descriptor()
 	// price.BillingCadenceValidator is a validator for the "billing_cadence" field. It is called by the builders before save.
 	price.BillingCadenceValidator = priceDescBillingCadence.Validators[0].(func(string) error)
 	// priceDescTrialPeriod is the schema descriptor for trial_period field.
-	priceDescTrialPeriod := priceFields[11].Descriptor()
+	priceDescTrialPeriod := priceFields[14].Descriptor()
 	// price.DefaultTrialPeriod holds the default value on creation for the trial_period field.
 	price.DefaultTrialPeriod = priceDescTrialPeriod.Default.(int)
 	secretMixin := schema.Secret{}.Mixin()
@@ -709,52 +1142,60 @@ func init() {
 	subscriptionDescPlanID := subscriptionFields[3].Descriptor()
 	// subscription.PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
 	subscription.PlanIDValidator = subscriptionDescPlanID.Validators[0].(func(string) error)
+	// subscriptionDescPlanVersion is the schema descriptor for plan_version field.
+	subscriptionDescPlanVersion := subscriptionFields[4].Descriptor()
+	// subscription.DefaultPlanVersion holds the default value on creation for the plan_version field.
+	subscription.DefaultPlanVersion = subscriptionDescPlanVersion.Default.(int)
 	// subscriptionDescSubscriptionStatus is the schema descriptor for subscription_status field.
-	subscriptionDescSubscriptionStatus := subscriptionFields[4].Descriptor()
+	subscriptionDescSubscriptionStatus := subscriptionFields[6].Descriptor()
 	// subscription.DefaultSubscriptionStatus holds the default value on creation for the subscription_status field.
 	subscription.DefaultSubscriptionStatus = subscriptionDescSubscriptionStatus.Default.(string)
 	// subscriptionDescCurrency is the schema descriptor for currency field.
-	subscriptionDescCurrency := subscriptionFields[5].Descriptor()
+	subscriptionDescCurrency := subscriptionFields[7].Descriptor()
 	// subscription.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
 	subscription.CurrencyValidator = subscriptionDescCurrency.Validators[0].(func(string) error)
 	// subscriptionDescBillingAnchor is the schema descriptor for billing_anchor field.
-	subscriptionDescBillingAnchor := subscriptionFields[6].Descriptor()
+	subscriptionDescBillingAnchor := subscriptionFields[8].Descriptor()
 	// subscription.DefaultBillingAnchor holds the default value on creation for the billing_anchor field.
 	subscription.DefaultBillingAnchor = subscriptionDescBillingAnchor.Default.(func() time.Time)
 	// subscriptionDescStartDate is the schema descriptor for start_date field.
-	subscriptionDescStartDate := subscriptionFields[7].Descriptor()
+	subscriptionDescStartDate := subscriptionFields[9].Descriptor()
 	// subscription.DefaultStartDate holds the default value on creation for the start_date field.
 	subscription.DefaultStartDate = subscriptionDescStartDate.Default.(func() time.Time)
 	// subscriptionDescCurrentPeriodStart is the schema descriptor for current_period_start field.
-	subscriptionDescCurrentPeriodStart := subscriptionFields[9].Descriptor()
+	subscriptionDescCurrentPeriodStart := subscriptionFields[11].Descriptor()
 	// subscription.DefaultCurrentPeriodStart holds the default value on creation for the current_period_start field.
 	subscription.DefaultCurrentPeriodStart = subscriptionDescCurrentPeriodStart.Default.(func() time.Time)
 	// subscriptionDescCurrentPeriodEnd is the schema descriptor for current_period_end field.
-	subscriptionDescCurrentPeriodEnd := subscriptionFields[10].Descriptor()
+	subscriptionDescCurrentPeriodEnd := subscriptionFields[12].Descriptor()
 	// subscription.DefaultCurrentPeriodEnd holds the default value on creation for the current_period_end field.
 	subscription.DefaultCurrentPeriodEnd = subscriptionDescCurrentPeriodEnd.Default.(func() time.Time)
 	// subscriptionDescCancelAtPeriodEnd is the schema descriptor for cancel_at_period_end field.
-	subscriptionDescCancelAtPeriodEnd := subscriptionFields[13].Descriptor()
+	subscriptionDescCancelAtPeriodEnd := subscriptionFields[15].Descriptor()
 	// subscription.DefaultCancelAtPeriodEnd holds the default value on creation for the cancel_at_period_end field.
 	subscription.DefaultCancelAtPeriodEnd = subscriptionDescCancelAtPeriodEnd.Default.(bool)
+	// subscriptionDescTrialRequiresPaymentMethod is the schema descriptor for trial_requires_payment_method field.
+	subscriptionDescTrialRequiresPaymentMethod := subscriptionFields[18].Descriptor()
+	// subscription.DefaultTrialRequiresPaymentMethod holds the default value on creation for the trial_requires_payment_method field.
+	subscription.DefaultTrialRequiresPaymentMethod = subscriptionDescTrialRequiresPaymentMethod.Default.(bool)
 	// subscriptionDescBillingCadence is the schema descriptor for billing_cadence field.
-	subscriptionDescBillingCadence := subscriptionFields[16].Descriptor()
+	subscriptionDescBillingCadence := subscriptionFields[24].Descriptor()
 	// subscription.BillingCadenceValidator is a validator for the "billing_cadence" field. It is called by the builders before save.
 	subscription.BillingCadenceValidator = subscriptionDescBillingCadence.Validators[0].(func(string) error)
 	// subscriptionDescBillingPeriod is the schema descriptor for billing_period field.
-	subscriptionDescBillingPeriod := subscriptionFields[17].Descriptor()
+	subscriptionDescBillingPeriod := subscriptionFields[25].Descriptor()
 	// subscription.BillingPeriodValidator is a validator for the "billing_period" field. It is called by the builders before save.
 	subscription.BillingPeriodValidator = subscriptionDescBillingPeriod.Validators[0].(func(string) error)
 	// subscriptionDescBillingPeriodCount is the schema descriptor for billing_period_count field.
-	subscriptionDescBillingPeriodCount := subscriptionFields[18].Descriptor()
+	subscriptionDescBillingPeriodCount := subscriptionFields[26].Descriptor()
 	// subscription.DefaultBillingPeriodCount holds the default value on creation for the billing_period_count field.
 	subscription.DefaultBillingPeriodCount = subscriptionDescBillingPeriodCount.Default.(int)
 	// subscriptionDescVersion is the schema descriptor for version field.
-	subscriptionDescVersion := subscriptionFields[19].Descriptor()
+	subscriptionDescVersion := subscriptionFields[27].Descriptor()
 	// subscription.DefaultVersion holds the default value on creation for the version field.
 	subscription.DefaultVersion = subscriptionDescVersion.Default.(int)
 	// subscriptionDescPauseStatus is the schema descriptor for pause_status field.
-	subscriptionDescPauseStatus := subscriptionFields[21].Descriptor()
+	subscriptionDescPauseStatus := subscriptionFields[29].Descriptor()
 	// subscription.DefaultPauseStatus holds the default value on creation for the pause_status field.
 	subscription.DefaultPauseStatus = subscriptionDescPauseStatus.Default.(string)
 	subscriptionlineitemMixin := schema.SubscriptionLineItem{}.Mixin()
@@ -869,6 +1310,47 @@ func init() {
 	subscriptionpauseDescOriginalPeriodEnd := subscriptionpauseFields[9].Descriptor()
 	// subscriptionpause.DefaultOriginalPeriodEnd holds the default value on creation for the original_period_end field.
 	subscriptionpause.DefaultOriginalPeriodEnd = subscriptionpauseDescOriginalPeriodEnd.Default.(func() time.Time)
+	subscriptionquantitychangeMixin := schema.SubscriptionQuantityChange{}.Mixin()
+	subscriptionquantitychangeMixinFields0 := subscriptionquantitychangeMixin[0].Fields()
+	_ = subscriptionquantitychangeMixinFields0
+	subscriptionquantitychangeMixinFields1 := subscriptionquantitychangeMixin[1].Fields()
+	_ = subscriptionquantitychangeMixinFields1
+	subscriptionquantitychangeFields := schema.SubscriptionQuantityChange{}.Fields()
+	_ = subscriptionquantitychangeFields
+	// subscriptionquantitychangeDescTenantID is the schema descriptor for tenant_id field.
+	subscriptionquantitychangeDescTenantID := subscriptionquantitychangeMixinFields0[0].Descriptor()
+	// subscriptionquantitychange.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	subscriptionquantitychange.TenantIDValidator = subscriptionquantitychangeDescTenantID.Validators[0].(func(string) error)
+	// subscriptionquantitychangeDescStatus is the schema descriptor for status field.
+	subscriptionquantitychangeDescStatus := subscriptionquantitychangeMixinFields0[1].Descriptor()
+	// subscriptionquantitychange.DefaultStatus holds the default value on creation for the status field.
+	subscriptionquantitychange.DefaultStatus = subscriptionquantitychangeDescStatus.Default.(string)
+	// subscriptionquantitychangeDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionquantitychangeDescCreatedAt := subscriptionquantitychangeMixinFields0[2].Descriptor()
+	// subscriptionquantitychange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscriptionquantitychange.DefaultCreatedAt = subscriptionquantitychangeDescCreatedAt.Default.(func() time.Time)
+	// subscriptionquantitychangeDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionquantitychangeDescUpdatedAt := subscriptionquantitychangeMixinFields0[3].Descriptor()
+	// subscriptionquantitychange.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscriptionquantitychange.DefaultUpdatedAt = subscriptionquantitychangeDescUpdatedAt.Default.(func() time.Time)
+	// subscriptionquantitychange.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscriptionquantitychange.UpdateDefaultUpdatedAt = subscriptionquantitychangeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionquantitychangeDescEnvironmentID is the schema descriptor for environment_id field.
+	subscriptionquantitychangeDescEnvironmentID := subscriptionquantitychangeMixinFields1[0].Descriptor()
+	// subscriptionquantitychange.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	subscriptionquantitychange.DefaultEnvironmentID = subscriptionquantitychangeDescEnvironmentID.Default.(string)
+	// subscriptionquantitychangeDescSubscriptionID is the schema descriptor for subscription_id field.
+	subscriptionquantitychangeDescSubscriptionID := subscriptionquantitychangeFields[1].Descriptor()
+	// subscriptionquantitychange.SubscriptionIDValidator is a validator for the "subscription_id" field. It is called by the builders before save.
+	subscriptionquantitychange.SubscriptionIDValidator = subscriptionquantitychangeDescSubscriptionID.Validators[0].(func(string) error)
+	// subscriptionquantitychangeDescSubscriptionLineItemID is the schema descriptor for subscription_line_item_id field.
+	subscriptionquantitychangeDescSubscriptionLineItemID := subscriptionquantitychangeFields[2].Descriptor()
+	// subscriptionquantitychange.SubscriptionLineItemIDValidator is a validator for the "subscription_line_item_id" field. It is called by the builders before save.
+	subscriptionquantitychange.SubscriptionLineItemIDValidator = subscriptionquantitychangeDescSubscriptionLineItemID.Validators[0].(func(string) error)
+	// subscriptionquantitychangeDescPriceID is the schema descriptor for price_id field.
+	subscriptionquantitychangeDescPriceID := subscriptionquantitychangeFields[3].Descriptor()
+	// subscriptionquantitychange.PriceIDValidator is a validator for the "price_id" field. It is called by the builders before save.
+	subscriptionquantitychange.PriceIDValidator = subscriptionquantitychangeDescPriceID.Validators[0].(func(string) error)
 	taskMixin := schema.Task{}.Mixin()
 	taskMixinFields0 := taskMixin[0].Fields()
 	_ = taskMixinFields0
@@ -908,8 +1390,8 @@ func init() {
 	task.EntityTypeValidator = taskDescEntityType.Validators[0].(func(string) error)
 	// taskDescFileURL is the schema descriptor for file_url field.
 	taskDescFileURL := taskFields[3].Descriptor()
-	// task.FileURLValidator is a validator for the "file_url" field. It is called by the builders before save.
-	task.FileURLValidator = taskDescFileURL.Validators[0].(func(string) error)
+	// task.DefaultFileURL holds the default value on creation for the file_url field.
+	task.DefaultFileURL = taskDescFileURL.Default.(string)
 	// taskDescFileType is the schema descriptor for file_type field.
 	taskDescFileType := taskFields[5].Descriptor()
 	// task.FileTypeValidator is a validator for the "file_type" field. It is called by the builders before save.
@@ -930,6 +1412,47 @@ func init() {
 	taskDescFailedRecords := taskFields[10].Descriptor()
 	// task.DefaultFailedRecords holds the default value on creation for the failed_records field.
 	task.DefaultFailedRecords = taskDescFailedRecords.Default.(int)
+	taxrateMixin := schema.TaxRate{}.Mixin()
+	taxrateMixinFields0 := taxrateMixin[0].Fields()
+	_ = taxrateMixinFields0
+	taxrateMixinFields1 := taxrateMixin[1].Fields()
+	_ = taxrateMixinFields1
+	taxrateFields := schema.TaxRate{}.Fields()
+	_ = taxrateFields
+	// taxrateDescTenantID is the schema descriptor for tenant_id field.
+	taxrateDescTenantID := taxrateMixinFields0[0].Descriptor()
+	// taxrate.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	taxrate.TenantIDValidator = taxrateDescTenantID.Validators[0].(func(string) error)
+	// taxrateDescStatus is the schema descriptor for status field.
+	taxrateDescStatus := taxrateMixinFields0[1].Descriptor()
+	// taxrate.DefaultStatus holds the default value on creation for the status field.
+	taxrate.DefaultStatus = taxrateDescStatus.Default.(string)
+	// taxrateDescCreatedAt is the schema descriptor for created_at field.
+	taxrateDescCreatedAt := taxrateMixinFields0[2].Descriptor()
+	// taxrate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taxrate.DefaultCreatedAt = taxrateDescCreatedAt.Default.(func() time.Time)
+	// taxrateDescUpdatedAt is the schema descriptor for updated_at field.
+	taxrateDescUpdatedAt := taxrateMixinFields0[3].Descriptor()
+	// taxrate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	taxrate.DefaultUpdatedAt = taxrateDescUpdatedAt.Default.(func() time.Time)
+	// taxrate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	taxrate.UpdateDefaultUpdatedAt = taxrateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// taxrateDescEnvironmentID is the schema descriptor for environment_id field.
+	taxrateDescEnvironmentID := taxrateMixinFields1[0].Descriptor()
+	// taxrate.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	taxrate.DefaultEnvironmentID = taxrateDescEnvironmentID.Default.(string)
+	// taxrateDescName is the schema descriptor for name field.
+	taxrateDescName := taxrateFields[1].Descriptor()
+	// taxrate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	taxrate.NameValidator = taxrateDescName.Validators[0].(func(string) error)
+	// taxrateDescTaxBehavior is the schema descriptor for tax_behavior field.
+	taxrateDescTaxBehavior := taxrateFields[4].Descriptor()
+	// taxrate.TaxBehaviorValidator is a validator for the "tax_behavior" field. It is called by the builders before save.
+	taxrate.TaxBehaviorValidator = taxrateDescTaxBehavior.Validators[0].(func(string) error)
+	// taxrateDescCountry is the schema descriptor for country field.
+	taxrateDescCountry := taxrateFields[5].Descriptor()
+	// taxrate.CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	taxrate.CountryValidator = taxrateDescCountry.Validators[0].(func(string) error)
 	tenantFields := schema.Tenant{}.Fields()
 	_ = tenantFields
 	// tenantDescName is the schema descriptor for name field.
@@ -954,6 +1477,10 @@ func init() {
 	tenantDescBillingDetails := tenantFields[5].Descriptor()
 	// tenant.DefaultBillingDetails holds the default value on creation for the billing_details field.
 	tenant.DefaultBillingDetails = tenantDescBillingDetails.Default.(schema.TenantBillingDetails)
+	// tenantDescDunningPolicy is the schema descriptor for dunning_policy field.
+	tenantDescDunningPolicy := tenantFields[6].Descriptor()
+	// tenant.DefaultDunningPolicy holds the default value on creation for the dunning_policy field.
+	tenant.DefaultDunningPolicy = tenantDescDunningPolicy.Default.(schema.TenantDunningPolicy)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -1030,8 +1557,12 @@ func init() {
 	walletDescAutoTopupTrigger := walletFields[9].Descriptor()
 	// wallet.DefaultAutoTopupTrigger holds the default value on creation for the auto_topup_trigger field.
 	wallet.DefaultAutoTopupTrigger = walletDescAutoTopupTrigger.Default.(string)
+	// walletDescAutoTopupMethod is the schema descriptor for auto_topup_method field.
+	walletDescAutoTopupMethod := walletFields[12].Descriptor()
+	// wallet.DefaultAutoTopupMethod holds the default value on creation for the auto_topup_method field.
+	wallet.DefaultAutoTopupMethod = walletDescAutoTopupMethod.Default.(string)
 	// walletDescWalletType is the schema descriptor for wallet_type field.
-	walletDescWalletType := walletFields[12].Descriptor()
+	walletDescWalletType := walletFields[15].Descriptor()
 	// wallet.DefaultWalletType holds the default value on creation for the wallet_type field.
 	wallet.DefaultWalletType = walletDescWalletType.Default.(string)
 	wallettransactionMixin := schema.WalletTransaction{}.Mixin()
@@ -1081,4 +1612,90 @@ func init() {
 	wallettransactionDescTransactionReason := wallettransactionFields[14].Descriptor()
 	// wallettransaction.DefaultTransactionReason holds the default value on creation for the transaction_reason field.
 	wallettransaction.DefaultTransactionReason = wallettransactionDescTransactionReason.Default.(string)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryMixinFields1 := webhookdeliveryMixin[1].Fields()
+	_ = webhookdeliveryMixinFields1
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescTenantID is the schema descriptor for tenant_id field.
+	webhookdeliveryDescTenantID := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	webhookdelivery.TenantIDValidator = webhookdeliveryDescTenantID.Validators[0].(func(string) error)
+	// webhookdeliveryDescStatus is the schema descriptor for status field.
+	webhookdeliveryDescStatus := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultStatus holds the default value on creation for the status field.
+	webhookdelivery.DefaultStatus = webhookdeliveryDescStatus.Default.(string)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields0[2].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields0[3].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescEnvironmentID is the schema descriptor for environment_id field.
+	webhookdeliveryDescEnvironmentID := webhookdeliveryMixinFields1[0].Descriptor()
+	// webhookdelivery.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	webhookdelivery.DefaultEnvironmentID = webhookdeliveryDescEnvironmentID.Default.(string)
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = webhookdeliveryDescEventID.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventName is the schema descriptor for event_name field.
+	webhookdeliveryDescEventName := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.EventNameValidator is a validator for the "event_name" field. It is called by the builders before save.
+	webhookdelivery.EventNameValidator = webhookdeliveryDescEventName.Validators[0].(func(string) error)
+	// webhookdeliveryDescLatencyMs is the schema descriptor for latency_ms field.
+	webhookdeliveryDescLatencyMs := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultLatencyMs holds the default value on creation for the latency_ms field.
+	webhookdelivery.DefaultLatencyMs = webhookdeliveryDescLatencyMs.Default.(int64)
+	// webhookdeliveryDescAttempt is the schema descriptor for attempt field.
+	webhookdeliveryDescAttempt := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultAttempt holds the default value on creation for the attempt field.
+	webhookdelivery.DefaultAttempt = webhookdeliveryDescAttempt.Default.(int)
+	// webhookdeliveryDescDeliveryStatus is the schema descriptor for delivery_status field.
+	webhookdeliveryDescDeliveryStatus := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DeliveryStatusValidator is a validator for the "delivery_status" field. It is called by the builders before save.
+	webhookdelivery.DeliveryStatusValidator = webhookdeliveryDescDeliveryStatus.Validators[0].(func(string) error)
+	webhookendpointMixin := schema.WebhookEndpoint{}.Mixin()
+	webhookendpointMixinFields0 := webhookendpointMixin[0].Fields()
+	_ = webhookendpointMixinFields0
+	webhookendpointMixinFields1 := webhookendpointMixin[1].Fields()
+	_ = webhookendpointMixinFields1
+	webhookendpointFields := schema.WebhookEndpoint{}.Fields()
+	_ = webhookendpointFields
+	// webhookendpointDescTenantID is the schema descriptor for tenant_id field.
+	webhookendpointDescTenantID := webhookendpointMixinFields0[0].Descriptor()
+	// webhookendpoint.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	webhookendpoint.TenantIDValidator = webhookendpointDescTenantID.Validators[0].(func(string) error)
+	// webhookendpointDescStatus is the schema descriptor for status field.
+	webhookendpointDescStatus := webhookendpointMixinFields0[1].Descriptor()
+	// webhookendpoint.DefaultStatus holds the default value on creation for the status field.
+	webhookendpoint.DefaultStatus = webhookendpointDescStatus.Default.(string)
+	// webhookendpointDescCreatedAt is the schema descriptor for created_at field.
+	webhookendpointDescCreatedAt := webhookendpointMixinFields0[2].Descriptor()
+	// webhookendpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookendpoint.DefaultCreatedAt = webhookendpointDescCreatedAt.Default.(func() time.Time)
+	// webhookendpointDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookendpointDescUpdatedAt := webhookendpointMixinFields0[3].Descriptor()
+	// webhookendpoint.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookendpoint.DefaultUpdatedAt = webhookendpointDescUpdatedAt.Default.(func() time.Time)
+	// webhookendpoint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookendpoint.UpdateDefaultUpdatedAt = webhookendpointDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookendpointDescEnvironmentID is the schema descriptor for environment_id field.
+	webhookendpointDescEnvironmentID := webhookendpointMixinFields1[0].Descriptor()
+	// webhookendpoint.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	webhookendpoint.DefaultEnvironmentID = webhookendpointDescEnvironmentID.Default.(string)
+	// webhookendpointDescURL is the schema descriptor for url field.
+	webhookendpointDescURL := webhookendpointFields[1].Descriptor()
+	// webhookendpoint.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhookendpoint.URLValidator = webhookendpointDescURL.Validators[0].(func(string) error)
+	// webhookendpointDescEnabled is the schema descriptor for enabled field.
+	webhookendpointDescEnabled := webhookendpointFields[5].Descriptor()
+	// webhookendpoint.DefaultEnabled holds the default value on creation for the enabled field.
+	webhookendpoint.DefaultEnabled = webhookendpointDescEnabled.Default.(bool)
 }
@@ -0,0 +1,101 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// AlertEvent holds the schema definition for a threshold of an alert rule crossed by a
+// subscription in a billing period.
+type AlertEvent struct {
+	ent.Schema
+}
+
+// Mixin of the AlertEvent.
+func (AlertEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the AlertEvent.
+func (AlertEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("alert_rule_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("alert_type").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("customer_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("subscription_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("feature_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Other("threshold", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Immutable(),
+		field.Other("value", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Immutable(),
+		field.String("currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			Optional().
+			Immutable(),
+		field.Time("period_start").
+			Immutable(),
+		field.Time("period_end").
+			Immutable(),
+	}
+}
+
+// Edges of the AlertEvent.
+func (AlertEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AlertEvent.
+func (AlertEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// a threshold is crossed at most once per subscription and billing period
+		index.Fields("tenant_id", "environment_id", "alert_rule_id", "subscription_id", "period_start", "threshold").
+			Unique(),
+		index.Fields("tenant_id", "environment_id", "subscription_id"),
+	}
+}
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// AlertRule holds the schema definition for a usage threshold alert rule.
+type AlertRule struct {
+	ent.Schema
+}
+
+// Mixin of the AlertRule.
+func (AlertRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the AlertRule.
+func (AlertRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("name").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty(),
+		field.String("alert_type").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("feature_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("customer_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("subscription_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			Optional().
+			Immutable(),
+		field.JSON("thresholds", []decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+	}
+}
+
+// Edges of the AlertRule.
+func (AlertRule) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AlertRule.
+func (AlertRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "status"),
+		index.Fields("tenant_id", "environment_id", "alert_type"),
+	}
+}
@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// Coupon holds the schema definition for the Coupon entity.
+type Coupon struct {
+	ent.Schema
+}
+
+// Mixin of the Coupon.
+func (Coupon) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the Coupon.
+func (Coupon) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("name").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty(),
+		field.String("code").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Immutable(),
+		field.String("discount_type").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.Other("percent_off", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(5,2)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Other("amount_off", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			Optional().
+			Immutable(),
+		field.String("duration").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.Int("duration_in_periods").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int("max_redemptions").
+			Optional().
+			Nillable(),
+		field.Int("times_redeemed").
+			Default(0),
+		field.Time("redeem_by").
+			Optional().
+			Nillable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+	}
+}
+
+// Edges of the Coupon.
+func (Coupon) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Coupon.
+func (Coupon) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "code").
+			Unique().
+			Annotations(entsql.IndexWhere("code IS NOT NULL AND code != '' AND status = 'published'")),
+		index.Fields("tenant_id", "environment_id", "status"),
+	}
+}
@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// CreditNoteLineItem holds the schema definition for the amount credited against an invoice line item.
+type CreditNoteLineItem struct {
+	ent.Schema
+}
+
+// Mixin of the CreditNoteLineItem.
+func (CreditNoteLineItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the CreditNoteLineItem.
+func (CreditNoteLineItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("credit_note_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("invoice_line_item_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("display_name").
+			NotEmpty().
+			Immutable(),
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero).
+			Immutable(),
+		field.String("currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+	}
+}
+
+// Edges of the CreditNoteLineItem.
+func (CreditNoteLineItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("credit_note", CreditNote.Type).
+			Ref("line_items").
+			Field("credit_note_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the CreditNoteLineItem.
+func (CreditNoteLineItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "credit_note_id", "status"),
+		index.Fields("tenant_id", "environment_id", "invoice_line_item_id", "status"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CreditNoteSequence holds the schema definition for the CreditNoteSequence entity.
+type CreditNoteSequence struct {
+	ent.Schema
+}
+
+// Fields of the CreditNoteSequence.
+func (CreditNoteSequence) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tenant_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty(),
+		field.String("year_month").
+			SchemaType(map[string]string{
+				"postgres": "varchar(6)",
+			}).
+			NotEmpty(),
+		field.Int64("last_value").
+			SchemaType(map[string]string{
+				"postgres": "bigint",
+			}).
+			Default(0),
+		field.Time("created_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}).
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			SchemaType(map[string]string{
+				"postgres": "timestamp",
+			}).
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the CreditNoteSequence.
+func (CreditNoteSequence) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CreditNoteSequence.
+func (CreditNoteSequence) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "year_month").
+			Unique(),
+	}
+}
@@ -76,6 +76,13 @@ func (Customer) Fields() []ent.Field {
 				"postgres": "varchar(2)",
 			}).
 			Optional(),
+		field.JSON("tax_ids", []CustomerTaxID{}).
+			Optional(),
+		field.Bool("tax_exempt").
+			Default(false),
+		// auto_apply_credits pays the invoices of the customer from the wallet balance when they are finalized
+		field.Bool("auto_apply_credits").
+			Default(false),
 		// Metadata as JSON field
 		field.JSON("metadata", map[string]string{}).
 			Optional(),
@@ -96,3 +103,9 @@ func (Customer) Indexes() []ent.Index {
 		index.Fields("tenant_id", "environment_id"),
 	}
 }
+
+// CustomerTaxID is a tax registration number of the customer ex a VAT or GST number
+type CustomerTaxID struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// DunningAttempt holds the schema definition for a payment retry of an unpaid invoice.
+type DunningAttempt struct {
+	ent.Schema
+}
+
+// Mixin of the DunningAttempt.
+func (DunningAttempt) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the DunningAttempt.
+func (DunningAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("invoice_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("subscription_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int("attempt_number").
+			Immutable(),
+		field.String("attempt_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.Other("amount_paid", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero).
+			Immutable(),
+		field.Other("amount_remaining", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero).
+			Immutable(),
+		field.String("error_message").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("attempted_at").
+			Immutable(),
+	}
+}
+
+// Indexes of the DunningAttempt.
+func (DunningAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "invoice_id", "attempt_number").
+			Unique(),
+		index.Fields("tenant_id", "environment_id", "subscription_id"),
+	}
+}
@@ -82,6 +82,41 @@ func (Invoice) Fields() []ent.Field {
 				"postgres": "numeric(20,8)",
 			}).
 			Default(decimal.Zero),
+		field.Other("amount_credited", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.Other("subtotal", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.Other("total_discount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.Other("total", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.JSON("discounts", []InvoiceDiscount{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+		field.Other("total_tax", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.JSON("taxes", []InvoiceTax{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
 		field.String("description").
 			Optional(),
 		field.Time("due_date").
@@ -174,3 +209,21 @@ func (Invoice) Indexes() []ent.Index {
 			Annotations(entsql.IndexWhere("invoice_status != 'VOIDED' AND subscription_id IS NOT NULL")),
 	}
 }
+
+// InvoiceDiscount is a discount line applied to the invoice subtotal
+type InvoiceDiscount struct {
+	CouponID            string          `json:"coupon_id"`
+	CouponApplicationID string          `json:"coupon_application_id"`
+	Description         string          `json:"description"`
+	Amount              decimal.Decimal `json:"amount"`
+}
+
+// InvoiceTax is a tax line computed from a tax rate of the customer's jurisdiction
+type InvoiceTax struct {
+	TaxRateID     string          `json:"tax_rate_id"`
+	Name          string          `json:"name"`
+	Percentage    decimal.Decimal `json:"percentage"`
+	TaxBehavior   string          `json:"tax_behavior"`
+	TaxableAmount decimal.Decimal `json:"taxable_amount"`
+	Amount        decimal.Decimal `json:"amount"`
+}
@@ -114,6 +114,11 @@ func (InvoiceLineItem) Fields() []ent.Field {
 		field.Time("period_end").
 			Optional().
 			Nillable(),
+		field.JSON("tier_breakdown", []InvoiceLineItemTier{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
 		field.JSON("metadata", map[string]string{}).
 			Optional().
 			SchemaType(map[string]string{
@@ -145,3 +150,13 @@ func (InvoiceLineItem) Indexes() []ent.Index {
 		index.Fields("period_start", "period_end"),
 	}
 }
+
+// InvoiceLineItemTier is the quantity and amount attributed to a single price tier
+type InvoiceLineItemTier struct {
+	Tier       int              `json:"tier"`
+	UpTo       *uint64          `json:"up_to"`
+	Quantity   decimal.Decimal  `json:"quantity"`
+	UnitAmount decimal.Decimal  `json:"unit_amount"`
+	FlatAmount *decimal.Decimal `json:"flat_amount,omitempty"`
+	Amount     decimal.Decimal  `json:"amount"`
+}
@@ -69,8 +69,9 @@ func (Meter) Indexes() []ent.Index {
 
 // Additional types needed for JSON fields
 type MeterFilter struct {
-	Key    string   `json:"key"`
-	Values []string `json:"values"`
+	Key      string               `json:"key"`
+	Operator types.FilterOperator `json:"operator,omitempty"`
+	Values   []string             `json:"values"`
 }
 
 type MeterAggregation struct {
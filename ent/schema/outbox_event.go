@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+)
+
+// OutboxEvent holds the schema definition for a message written in the transaction of the state
+// change it reports and relayed to the pubsub once the transaction is committed.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Mixin of the OutboxEvent.
+func (OutboxEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("topic").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty().
+			Immutable(),
+		// message_id is the id of the relayed message, consumers deduplicate redeliveries on it
+		field.String("message_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(100)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("payload").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Immutable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Immutable(),
+		field.String("delivery_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty(),
+		field.Int("attempts").
+			Default(0),
+		field.String("last_error").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Optional().
+			Nillable(),
+		// next_attempt_at is pushed back while a relay publishes the event and after a failed attempt
+		field.Time("next_attempt_at"),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the OutboxEvent.
+func (OutboxEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the OutboxEvent.
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("delivery_status", "next_attempt_at"),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
 )
 
 // Plan holds the schema definition for the Plan entity.
@@ -43,6 +44,23 @@ func (Plan) Fields() []ent.Field {
 			NotEmpty(),
 		field.Text("description").
 			Optional(),
+		// version is the current version of the plan, it is incremented every time the
+		// prices of the plan change
+		field.Int("version").
+			Default(1),
+		// commitment_amount is the default minimum usage spend per commitment interval of the
+		// subscriptions on the plan
+		field.Other("commitment_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(25,15)",
+			}).
+			Optional().
+			Nillable(),
+		field.String("commitment_interval").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			Optional(),
 	}
 }
 
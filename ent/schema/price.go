@@ -50,6 +50,26 @@ func (Price) Fields() []ent.Field {
 				"postgres": "varchar(50)",
 			}).
 			NotEmpty(),
+		// plan_version is the version of the plan that introduced the price
+		field.Int("plan_version").
+			Default(1).
+			Immutable(),
+		// subscription_id is set for the prices negotiated for a single subscription, they override
+		// the plan price they are created from for that subscription only
+		field.String("subscription_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("parent_price_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
 		field.String("type").
 			SchemaType(map[string]string{
 				"postgres": "varchar(20)",
@@ -99,6 +119,12 @@ func (Price) Fields() []ent.Field {
 			Optional(),
 		field.JSON("transform_quantity", TransformQuantity{}).
 			Optional(),
+		field.Other("minimum_charge", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(25,15)",
+			}).
+			Optional().
+			Nillable(),
 		field.String("lookup_key").
 			SchemaType(map[string]string{
 				"postgres": "varchar(255)",
@@ -135,6 +161,8 @@ type PriceTier struct {
 }
 
 type TransformQuantity struct {
-	DivideBy int    `json:"divide_by,omitempty"`
-	Round    string `json:"round,omitempty"`
+	DivideBy         int    `json:"divide_by,omitempty"`
+	Round            string `json:"round,omitempty"`
+	IncludedQuantity int64  `json:"included_quantity,omitempty"`
+	MinPackages      int64  `json:"min_packages,omitempty"`
 }
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/schema/index"
 	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // Subscription holds the schema definition for the Subscription entity.
@@ -45,8 +46,15 @@ func (Subscription) Fields() []ent.Field {
 			SchemaType(map[string]string{
 				"postgres": "varchar(50)",
 			}).
-			NotEmpty().
-			Immutable(),
+			NotEmpty(),
+		// plan_version is the version of the plan the subscription is billed with
+		field.Int("plan_version").
+			Default(1),
+		// pending_plan_version is the plan version the subscription migrates to at the end of
+		// the current period
+		field.Int("pending_plan_version").
+			Optional().
+			Nillable(),
 		field.String("subscription_status").
 			SchemaType(map[string]string{
 				"postgres": "varchar(50)",
@@ -85,6 +93,34 @@ func (Subscription) Fields() []ent.Field {
 		field.Time("trial_end").
 			Optional().
 			Nillable(),
+		field.Bool("trial_requires_payment_method").
+			Default(false),
+		field.Time("trial_will_end_notified_at").
+			Optional().
+			Nillable(),
+		field.String("payment_method_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Nillable(),
+		// commitment_amount is the minimum usage spend per commitment interval, the shortfall
+		// is invoiced as a true-up charge
+		field.Other("commitment_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(25,15)",
+			}).
+			Optional().
+			Nillable(),
+		field.String("commitment_interval").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			Optional(),
+		// auto_apply_credits overrides the setting of the customer for the invoices of the subscription
+		field.Bool("auto_apply_credits").
+			Optional().
+			Nillable(),
 		field.String("billing_cadence").
 			NotEmpty().
 			Immutable(),
@@ -120,6 +156,7 @@ func (Subscription) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("line_items", SubscriptionLineItem.Type),
 		edge.To("pauses", SubscriptionPause.Type),
+		edge.To("quantity_changes", SubscriptionQuantityChange.Type),
 	}
 }
 
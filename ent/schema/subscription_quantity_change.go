@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionQuantityChange holds the schema definition for the SubscriptionQuantityChange entity.
+type SubscriptionQuantityChange struct {
+	ent.Schema
+}
+
+// Mixin of the SubscriptionQuantityChange.
+func (SubscriptionQuantityChange) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the SubscriptionQuantityChange.
+func (SubscriptionQuantityChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("subscription_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("subscription_line_item_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("price_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.Other("previous_quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Immutable(),
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Immutable(),
+		field.Time("effective_date").
+			Immutable(),
+		field.String("credit_note_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+	}
+}
+
+// Edges of the SubscriptionQuantityChange.
+func (SubscriptionQuantityChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", Subscription.Type).
+			Ref("quantity_changes").
+			Unique().
+			Field("subscription_id").
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the SubscriptionQuantityChange.
+func (SubscriptionQuantityChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "subscription_id", "effective_date"),
+		index.Fields("tenant_id", "environment_id", "subscription_line_item_id", "effective_date"),
+	}
+}
@@ -43,7 +43,7 @@ func (Task) Fields() []ent.Field {
 			SchemaType(map[string]string{
 				"postgres": "varchar(255)",
 			}).
-			NotEmpty(),
+			Default(""),
 		field.String("file_name").
 			SchemaType(map[string]string{
 				"postgres": "varchar(255)",
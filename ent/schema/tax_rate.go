@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// TaxRate holds the schema definition for the TaxRate entity.
+type TaxRate struct {
+	ent.Schema
+}
+
+// Mixin of the TaxRate.
+func (TaxRate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the TaxRate.
+func (TaxRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("name").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty(),
+		field.Text("description").
+			Optional(),
+		field.Other("percentage", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(9,6)",
+			}).
+			Immutable(),
+		field.String("tax_behavior").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("country").
+			SchemaType(map[string]string{
+				"postgres": "varchar(2)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("state").
+			SchemaType(map[string]string{
+				"postgres": "varchar(100)",
+			}).
+			Optional().
+			Immutable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional(),
+	}
+}
+
+// Edges of the TaxRate.
+func (TaxRate) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the TaxRate.
+func (TaxRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "country", "state"),
+		index.Fields("tenant_id", "environment_id"),
+	}
+}
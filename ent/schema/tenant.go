@@ -26,6 +26,14 @@ type TenantAddress struct {
 	Country    string `json:"address_country,omitempty"`
 }
 
+// TenantDunningPolicy structure for the dunning policy applied to unpaid invoices
+type TenantDunningPolicy struct {
+	Enabled     bool   `json:"enabled,omitempty"`
+	RetryDays   []int  `json:"retry_days,omitempty"`
+	GraceDays   int    `json:"grace_days,omitempty"`
+	FinalAction string `json:"final_action,omitempty"`
+}
+
 // Tenant holds the schema definition for the Tenant entity.
 type Tenant struct {
 	ent.Schema
@@ -61,6 +69,12 @@ func (Tenant) Fields() []ent.Field {
 			SchemaType(map[string]string{
 				"postgres": "jsonb",
 			}),
+		field.JSON("dunning_policy", TenantDunningPolicy{}).
+			Optional().
+			Default(TenantDunningPolicy{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
 	}
 }
 
@@ -91,6 +91,23 @@ func (Wallet) Fields() []ent.Field {
 			}).
 			Optional().
 			Nillable(),
+		field.String("auto_topup_method").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Default(string(types.AutoTopupMethodInvoice)),
+		field.String("auto_topup_payment_method_type").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable(),
+		field.String("auto_topup_payment_method_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable(),
 		field.String("wallet_type").
 			SchemaType(map[string]string{
 				"postgres": "varchar(50)",
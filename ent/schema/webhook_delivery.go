@@ -0,0 +1,118 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+)
+
+// WebhookDelivery holds the schema definition for an attempt to deliver a webhook event to a destination.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookDelivery.
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		// webhook_endpoint_id is empty for the deliveries to the static tenant configuration
+		field.String("webhook_endpoint_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("event_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("event_name").
+			SchemaType(map[string]string{
+				"postgres": "varchar(100)",
+			}).
+			NotEmpty().
+			Immutable(),
+		// url is empty for the events that failed before being delivered to any destination
+		field.String("url").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Immutable(),
+		// request_headers only keeps the values of the headers that carry no credentials
+		field.JSON("request_headers", map[string]string{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Immutable(),
+		field.String("request_body").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Immutable(),
+		field.Int("response_status").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int64("latency_ms").
+			Default(0).
+			Immutable(),
+		// error is a short description of the failure, the response body is never stored
+		field.String("error").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		// attempt is the number of the attempt to deliver the event to the destination, starting at 1
+		field.Int("attempt").
+			Default(1).
+			Immutable(),
+		// delivery_status becomes dead_lettered when the delivery still failed after all the retries
+		field.String("delivery_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty(),
+		// replay_of is the delivery replayed by this delivery
+		field.String("replay_of").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "created_at"),
+		index.Fields("tenant_id", "environment_id", "delivery_status", "created_at"),
+		index.Fields("tenant_id", "environment_id", "event_id"),
+	}
+}
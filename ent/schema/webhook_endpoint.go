@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+)
+
+// WebhookEndpoint holds the schema definition for a destination of the webhook events of a tenant.
+type WebhookEndpoint struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookEndpoint.
+func (WebhookEndpoint) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the WebhookEndpoint.
+func (WebhookEndpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("url").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			NotEmpty(),
+		field.String("description").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Optional(),
+		// enabled_events are the events sent to the endpoint, all events are sent when empty
+		field.JSON("enabled_events", []string{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+		field.JSON("headers", map[string]string{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+		field.Bool("enabled").
+			Default(true),
+		// signing_secret is the encrypted secret the deliveries to the endpoint are signed with
+		field.String("signing_secret").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Optional().
+			Sensitive(),
+		// previous_signing_secret is the encrypted secret replaced by the last rotation, deliveries
+		// are also signed with it until previous_signing_secret_expires_at
+		field.String("previous_signing_secret").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Optional().
+			Nillable().
+			Sensitive(),
+		field.Time("previous_signing_secret_expires_at").
+			Optional().
+			Nillable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+	}
+}
+
+// Edges of the WebhookEndpoint.
+func (WebhookEndpoint) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the WebhookEndpoint.
+func (WebhookEndpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "status"),
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/subscription"
+	"github.com/shopspring/decimal"
 )
 
 // Subscription is the model entity for the Subscription schema.
@@ -38,6 +39,10 @@ type Subscription struct {
 	CustomerID string `json:"customer_id,omitempty"`
 	// PlanID holds the value of the "plan_id" field.
 	PlanID string `json:"plan_id,omitempty"`
+	// PlanVersion holds the value of the "plan_version" field.
+	PlanVersion int `json:"plan_version,omitempty"`
+	// PendingPlanVersion holds the value of the "pending_plan_version" field.
+	PendingPlanVersion *int `json:"pending_plan_version,omitempty"`
 	// SubscriptionStatus holds the value of the "subscription_status" field.
 	SubscriptionStatus string `json:"subscription_status,omitempty"`
 	// Currency holds the value of the "currency" field.
@@ -62,6 +67,18 @@ type Subscription struct {
 	TrialStart *time.Time `json:"trial_start,omitempty"`
 	// TrialEnd holds the value of the "trial_end" field.
 	TrialEnd *time.Time `json:"trial_end,omitempty"`
+	// TrialRequiresPaymentMethod holds the value of the "trial_requires_payment_method" field.
+	TrialRequiresPaymentMethod bool `json:"trial_requires_payment_method,omitempty"`
+	// TrialWillEndNotifiedAt holds the value of the "trial_will_end_notified_at" field.
+	TrialWillEndNotifiedAt *time.Time `json:"trial_will_end_notified_at,omitempty"`
+	// PaymentMethodID holds the value of the "payment_method_id" field.
+	PaymentMethodID *string `json:"payment_method_id,omitempty"`
+	// CommitmentAmount holds the value of the "commitment_amount" field.
+	CommitmentAmount *decimal.Decimal `json:"commitment_amount,omitempty"`
+	// CommitmentInterval holds the value of the "commitment_interval" field.
+	CommitmentInterval string `json:"commitment_interval,omitempty"`
+	// AutoApplyCredits holds the value of the "auto_apply_credits" field.
+	AutoApplyCredits *bool `json:"auto_apply_credits,omitempty"`
 	// BillingCadence holds the value of the "billing_cadence" field.
 	BillingCadence string `json:"billing_cadence,omitempty"`
 	// BillingPeriod holds the value of the "billing_period" field.
@@ -88,9 +105,11 @@ type SubscriptionEdges struct {
 	LineItems []*SubscriptionLineItem `json:"line_items,omitempty"`
 	// Pauses holds the value of the pauses edge.
 	Pauses []*SubscriptionPause `json:"pauses,omitempty"`
+	// QuantityChanges holds the value of the quantity_changes edge.
+	QuantityChanges []*SubscriptionQuantityChange `json:"quantity_changes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // LineItemsOrErr returns the LineItems value or an error if the edge
@@ -111,20 +130,31 @@ func (e SubscriptionEdges) PausesOrErr() ([]*SubscriptionPause, error) {
 	return nil, &NotLoadedError{edge: "pauses"}
 }
 
+// QuantityChangesOrErr returns the QuantityChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e SubscriptionEdges) QuantityChangesOrErr() ([]*SubscriptionQuantityChange, error) {
+	if e.loadedTypes[2] {
+		return e.QuantityChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "quantity_changes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Subscription) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case subscription.FieldCommitmentAmount:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case subscription.FieldMetadata:
 			values[i] = new([]byte)
-		case subscription.FieldCancelAtPeriodEnd:
+		case subscription.FieldCancelAtPeriodEnd, subscription.FieldTrialRequiresPaymentMethod, subscription.FieldAutoApplyCredits:
 			values[i] = new(sql.NullBool)
-		case subscription.FieldBillingPeriodCount, subscription.FieldVersion:
+		case subscription.FieldPlanVersion, subscription.FieldPendingPlanVersion, subscription.FieldBillingPeriodCount, subscription.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case subscription.FieldID, subscription.FieldTenantID, subscription.FieldStatus, subscription.FieldCreatedBy, subscription.FieldUpdatedBy, subscription.FieldEnvironmentID, subscription.FieldLookupKey, subscription.FieldCustomerID, subscription.FieldPlanID, subscription.FieldSubscriptionStatus, subscription.FieldCurrency, subscription.FieldBillingCadence, subscription.FieldBillingPeriod, subscription.FieldPauseStatus, subscription.FieldActivePauseID:
+		case subscription.FieldID, subscription.FieldTenantID, subscription.FieldStatus, subscription.FieldCreatedBy, subscription.FieldUpdatedBy, subscription.FieldEnvironmentID, subscription.FieldLookupKey, subscription.FieldCustomerID, subscription.FieldPlanID, subscription.FieldSubscriptionStatus, subscription.FieldCurrency, subscription.FieldPaymentMethodID, subscription.FieldCommitmentInterval, subscription.FieldBillingCadence, subscription.FieldBillingPeriod, subscription.FieldPauseStatus, subscription.FieldActivePauseID:
 			values[i] = new(sql.NullString)
-		case subscription.FieldCreatedAt, subscription.FieldUpdatedAt, subscription.FieldBillingAnchor, subscription.FieldStartDate, subscription.FieldEndDate, subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldCancelledAt, subscription.FieldCancelAt, subscription.FieldTrialStart, subscription.FieldTrialEnd:
+		case subscription.FieldCreatedAt, subscription.FieldUpdatedAt, subscription.FieldBillingAnchor, subscription.FieldStartDate, subscription.FieldEndDate, subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldCancelledAt, subscription.FieldCancelAt, subscription.FieldTrialStart, subscription.FieldTrialEnd, subscription.FieldTrialWillEndNotifiedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -207,6 +237,19 @@ func (s *Subscription) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.PlanID = value.String
 			}
+		case subscription.FieldPlanVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_version", values[i])
+			} else if value.Valid {
+				s.PlanVersion = int(value.Int64)
+			}
+		case subscription.FieldPendingPlanVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_plan_version", values[i])
+			} else if value.Valid {
+				s.PendingPlanVersion = new(int)
+				*s.PendingPlanVersion = int(value.Int64)
+			}
 		case subscription.FieldSubscriptionStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field subscription_status", values[i])
@@ -284,6 +327,46 @@ func (s *Subscription) assignValues(columns []string, values []any) error {
 				s.TrialEnd = new(time.Time)
 				*s.TrialEnd = value.Time
 			}
+		case subscription.FieldTrialRequiresPaymentMethod:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_requires_payment_method", values[i])
+			} else if value.Valid {
+				s.TrialRequiresPaymentMethod = value.Bool
+			}
+		case subscription.FieldTrialWillEndNotifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_will_end_notified_at", values[i])
+			} else if value.Valid {
+				s.TrialWillEndNotifiedAt = new(time.Time)
+				*s.TrialWillEndNotifiedAt = value.Time
+			}
+		case subscription.FieldPaymentMethodID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_method_id", values[i])
+			} else if value.Valid {
+				s.PaymentMethodID = new(string)
+				*s.PaymentMethodID = value.String
+			}
+		case subscription.FieldCommitmentAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field commitment_amount", values[i])
+			} else if value.Valid {
+				s.CommitmentAmount = new(decimal.Decimal)
+				*s.CommitmentAmount = *value.S.(*decimal.Decimal)
+			}
+		case subscription.FieldCommitmentInterval:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commitment_interval", values[i])
+			} else if value.Valid {
+				s.CommitmentInterval = value.String
+			}
+		case subscription.FieldAutoApplyCredits:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_apply_credits", values[i])
+			} else if value.Valid {
+				s.AutoApplyCredits = new(bool)
+				*s.AutoApplyCredits = value.Bool
+			}
 		case subscription.FieldBillingCadence:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field billing_cadence", values[i])
@@ -352,6 +435,11 @@ func (s *Subscription) QueryPauses() *SubscriptionPauseQuery {
 	return NewSubscriptionClient(s.config).QueryPauses(s)
 }
 
+// QueryQuantityChanges queries the "quantity_changes" edge of the Subscription entity.
+func (s *Subscription) QueryQuantityChanges() *SubscriptionQuantityChangeQuery {
+	return NewSubscriptionClient(s.config).QueryQuantityChanges(s)
+}
+
 // Update returns a builder for updating this Subscription.
 // Note that you need to call Subscription.Unwrap() before calling this method if this Subscription
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -405,6 +493,14 @@ func (s *Subscription) String() string {
 	builder.WriteString("plan_id=")
 	builder.WriteString(s.PlanID)
 	builder.WriteString(", ")
+	builder.WriteString("plan_version=")
+	builder.WriteString(fmt.Sprintf("%v", s.PlanVersion))
+	builder.WriteString(", ")
+	if v := s.PendingPlanVersion; v != nil {
+		builder.WriteString("pending_plan_version=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("subscription_status=")
 	builder.WriteString(s.SubscriptionStatus)
 	builder.WriteString(", ")
@@ -451,6 +547,32 @@ func (s *Subscription) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("trial_requires_payment_method=")
+	builder.WriteString(fmt.Sprintf("%v", s.TrialRequiresPaymentMethod))
+	builder.WriteString(", ")
+	if v := s.TrialWillEndNotifiedAt; v != nil {
+		builder.WriteString("trial_will_end_notified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.PaymentMethodID; v != nil {
+		builder.WriteString("payment_method_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.CommitmentAmount; v != nil {
+		builder.WriteString("commitment_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("commitment_interval=")
+	builder.WriteString(s.CommitmentInterval)
+	builder.WriteString(", ")
+	if v := s.AutoApplyCredits; v != nil {
+		builder.WriteString("auto_apply_credits=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("billing_cadence=")
 	builder.WriteString(s.BillingCadence)
 	builder.WriteString(", ")
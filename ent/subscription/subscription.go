@@ -34,6 +34,10 @@ const (
 	FieldCustomerID = "customer_id"
 	// FieldPlanID holds the string denoting the plan_id field in the database.
 	FieldPlanID = "plan_id"
+	// FieldPlanVersion holds the string denoting the plan_version field in the database.
+	FieldPlanVersion = "plan_version"
+	// FieldPendingPlanVersion holds the string denoting the pending_plan_version field in the database.
+	FieldPendingPlanVersion = "pending_plan_version"
 	// FieldSubscriptionStatus holds the string denoting the subscription_status field in the database.
 	FieldSubscriptionStatus = "subscription_status"
 	// FieldCurrency holds the string denoting the currency field in the database.
@@ -58,6 +62,18 @@ const (
 	FieldTrialStart = "trial_start"
 	// FieldTrialEnd holds the string denoting the trial_end field in the database.
 	FieldTrialEnd = "trial_end"
+	// FieldTrialRequiresPaymentMethod holds the string denoting the trial_requires_payment_method field in the database.
+	FieldTrialRequiresPaymentMethod = "trial_requires_payment_method"
+	// FieldTrialWillEndNotifiedAt holds the string denoting the trial_will_end_notified_at field in the database.
+	FieldTrialWillEndNotifiedAt = "trial_will_end_notified_at"
+	// FieldPaymentMethodID holds the string denoting the payment_method_id field in the database.
+	FieldPaymentMethodID = "payment_method_id"
+	// FieldCommitmentAmount holds the string denoting the commitment_amount field in the database.
+	FieldCommitmentAmount = "commitment_amount"
+	// FieldCommitmentInterval holds the string denoting the commitment_interval field in the database.
+	FieldCommitmentInterval = "commitment_interval"
+	// FieldAutoApplyCredits holds the string denoting the auto_apply_credits field in the database.
+	FieldAutoApplyCredits = "auto_apply_credits"
 	// FieldBillingCadence holds the string denoting the billing_cadence field in the database.
 	FieldBillingCadence = "billing_cadence"
 	// FieldBillingPeriod holds the string denoting the billing_period field in the database.
@@ -76,6 +92,8 @@ const (
 	EdgeLineItems = "line_items"
 	// EdgePauses holds the string denoting the pauses edge name in mutations.
 	EdgePauses = "pauses"
+	// EdgeQuantityChanges holds the string denoting the quantity_changes edge name in mutations.
+	EdgeQuantityChanges = "quantity_changes"
 	// Table holds the table name of the subscription in the database.
 	Table = "subscriptions"
 	// LineItemsTable is the table that holds the line_items relation/edge.
@@ -92,6 +110,13 @@ const (
 	PausesInverseTable = "subscription_pauses"
 	// PausesColumn is the table column denoting the pauses relation/edge.
 	PausesColumn = "subscription_id"
+	// QuantityChangesTable is the table that holds the quantity_changes relation/edge.
+	QuantityChangesTable = "subscription_quantity_changes"
+	// QuantityChangesInverseTable is the table name for the SubscriptionQuantityChange entity.
+	// It exists in this package in order to avoid circular dependency with the "subscriptionquantitychange" package.
+	QuantityChangesInverseTable = "subscription_quantity_changes"
+	// QuantityChangesColumn is the table column denoting the quantity_changes relation/edge.
+	QuantityChangesColumn = "subscription_id"
 )
 
 // Columns holds all SQL columns for subscription fields.
@@ -107,6 +132,8 @@ var Columns = []string{
 	FieldLookupKey,
 	FieldCustomerID,
 	FieldPlanID,
+	FieldPlanVersion,
+	FieldPendingPlanVersion,
 	FieldSubscriptionStatus,
 	FieldCurrency,
 	FieldBillingAnchor,
@@ -119,6 +146,12 @@ var Columns = []string{
 	FieldCancelAtPeriodEnd,
 	FieldTrialStart,
 	FieldTrialEnd,
+	FieldTrialRequiresPaymentMethod,
+	FieldTrialWillEndNotifiedAt,
+	FieldPaymentMethodID,
+	FieldCommitmentAmount,
+	FieldCommitmentInterval,
+	FieldAutoApplyCredits,
 	FieldBillingCadence,
 	FieldBillingPeriod,
 	FieldBillingPeriodCount,
@@ -155,6 +188,8 @@ var (
 	CustomerIDValidator func(string) error
 	// PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
 	PlanIDValidator func(string) error
+	// DefaultPlanVersion holds the default value on creation for the "plan_version" field.
+	DefaultPlanVersion int
 	// DefaultSubscriptionStatus holds the default value on creation for the "subscription_status" field.
 	DefaultSubscriptionStatus string
 	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
@@ -169,6 +204,8 @@ var (
 	DefaultCurrentPeriodEnd func() time.Time
 	// DefaultCancelAtPeriodEnd holds the default value on creation for the "cancel_at_period_end" field.
 	DefaultCancelAtPeriodEnd bool
+	// DefaultTrialRequiresPaymentMethod holds the default value on creation for the "trial_requires_payment_method" field.
+	DefaultTrialRequiresPaymentMethod bool
 	// BillingCadenceValidator is a validator for the "billing_cadence" field. It is called by the builders before save.
 	BillingCadenceValidator func(string) error
 	// BillingPeriodValidator is a validator for the "billing_period" field. It is called by the builders before save.
@@ -239,6 +276,16 @@ func ByPlanID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPlanID, opts...).ToFunc()
 }
 
+// ByPlanVersion orders the results by the plan_version field.
+func ByPlanVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlanVersion, opts...).ToFunc()
+}
+
+// ByPendingPlanVersion orders the results by the pending_plan_version field.
+func ByPendingPlanVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingPlanVersion, opts...).ToFunc()
+}
+
 // BySubscriptionStatus orders the results by the subscription_status field.
 func BySubscriptionStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSubscriptionStatus, opts...).ToFunc()
@@ -299,6 +346,36 @@ func ByTrialEnd(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTrialEnd, opts...).ToFunc()
 }
 
+// ByTrialRequiresPaymentMethod orders the results by the trial_requires_payment_method field.
+func ByTrialRequiresPaymentMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialRequiresPaymentMethod, opts...).ToFunc()
+}
+
+// ByTrialWillEndNotifiedAt orders the results by the trial_will_end_notified_at field.
+func ByTrialWillEndNotifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialWillEndNotifiedAt, opts...).ToFunc()
+}
+
+// ByPaymentMethodID orders the results by the payment_method_id field.
+func ByPaymentMethodID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentMethodID, opts...).ToFunc()
+}
+
+// ByCommitmentAmount orders the results by the commitment_amount field.
+func ByCommitmentAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitmentAmount, opts...).ToFunc()
+}
+
+// ByCommitmentInterval orders the results by the commitment_interval field.
+func ByCommitmentInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitmentInterval, opts...).ToFunc()
+}
+
+// ByAutoApplyCredits orders the results by the auto_apply_credits field.
+func ByAutoApplyCredits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoApplyCredits, opts...).ToFunc()
+}
+
 // ByBillingCadence orders the results by the billing_cadence field.
 func ByBillingCadence(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBillingCadence, opts...).ToFunc()
@@ -356,6 +433,20 @@ func ByPauses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPausesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByQuantityChangesCount orders the results by quantity_changes count.
+func ByQuantityChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQuantityChangesStep(), opts...)
+	}
+}
+
+// ByQuantityChanges orders the results by quantity_changes terms.
+func ByQuantityChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuantityChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newLineItemsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -370,3 +461,10 @@ func newPausesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PausesTable, PausesColumn),
 	)
 }
+func newQuantityChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuantityChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QuantityChangesTable, QuantityChangesColumn),
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -115,6 +116,16 @@ func PlanID(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldPlanID, v))
 }
 
+// PlanVersion applies equality check predicate on the "plan_version" field. It's identical to PlanVersionEQ.
+func PlanVersion(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPlanVersion, v))
+}
+
+// PendingPlanVersion applies equality check predicate on the "pending_plan_version" field. It's identical to PendingPlanVersionEQ.
+func PendingPlanVersion(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPendingPlanVersion, v))
+}
+
 // SubscriptionStatus applies equality check predicate on the "subscription_status" field. It's identical to SubscriptionStatusEQ.
 func SubscriptionStatus(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldSubscriptionStatus, v))
@@ -175,6 +186,36 @@ func TrialEnd(v time.Time) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldTrialEnd, v))
 }
 
+// TrialRequiresPaymentMethod applies equality check predicate on the "trial_requires_payment_method" field. It's identical to TrialRequiresPaymentMethodEQ.
+func TrialRequiresPaymentMethod(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTrialRequiresPaymentMethod, v))
+}
+
+// TrialWillEndNotifiedAt applies equality check predicate on the "trial_will_end_notified_at" field. It's identical to TrialWillEndNotifiedAtEQ.
+func TrialWillEndNotifiedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTrialWillEndNotifiedAt, v))
+}
+
+// PaymentMethodID applies equality check predicate on the "payment_method_id" field. It's identical to PaymentMethodIDEQ.
+func PaymentMethodID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPaymentMethodID, v))
+}
+
+// CommitmentAmount applies equality check predicate on the "commitment_amount" field. It's identical to CommitmentAmountEQ.
+func CommitmentAmount(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentAmount, v))
+}
+
+// CommitmentInterval applies equality check predicate on the "commitment_interval" field. It's identical to CommitmentIntervalEQ.
+func CommitmentInterval(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentInterval, v))
+}
+
+// AutoApplyCredits applies equality check predicate on the "auto_apply_credits" field. It's identical to AutoApplyCreditsEQ.
+func AutoApplyCredits(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldAutoApplyCredits, v))
+}
+
 // BillingCadence applies equality check predicate on the "billing_cadence" field. It's identical to BillingCadenceEQ.
 func BillingCadence(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldBillingCadence, v))
@@ -845,6 +886,96 @@ func PlanIDContainsFold(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldContainsFold(FieldPlanID, v))
 }
 
+// PlanVersionEQ applies the EQ predicate on the "plan_version" field.
+func PlanVersionEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPlanVersion, v))
+}
+
+// PlanVersionNEQ applies the NEQ predicate on the "plan_version" field.
+func PlanVersionNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPlanVersion, v))
+}
+
+// PlanVersionIn applies the In predicate on the "plan_version" field.
+func PlanVersionIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPlanVersion, vs...))
+}
+
+// PlanVersionNotIn applies the NotIn predicate on the "plan_version" field.
+func PlanVersionNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPlanVersion, vs...))
+}
+
+// PlanVersionGT applies the GT predicate on the "plan_version" field.
+func PlanVersionGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPlanVersion, v))
+}
+
+// PlanVersionGTE applies the GTE predicate on the "plan_version" field.
+func PlanVersionGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPlanVersion, v))
+}
+
+// PlanVersionLT applies the LT predicate on the "plan_version" field.
+func PlanVersionLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPlanVersion, v))
+}
+
+// PlanVersionLTE applies the LTE predicate on the "plan_version" field.
+func PlanVersionLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPlanVersion, v))
+}
+
+// PendingPlanVersionEQ applies the EQ predicate on the "pending_plan_version" field.
+func PendingPlanVersionEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPendingPlanVersion, v))
+}
+
+// PendingPlanVersionNEQ applies the NEQ predicate on the "pending_plan_version" field.
+func PendingPlanVersionNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPendingPlanVersion, v))
+}
+
+// PendingPlanVersionIn applies the In predicate on the "pending_plan_version" field.
+func PendingPlanVersionIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPendingPlanVersion, vs...))
+}
+
+// PendingPlanVersionNotIn applies the NotIn predicate on the "pending_plan_version" field.
+func PendingPlanVersionNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPendingPlanVersion, vs...))
+}
+
+// PendingPlanVersionGT applies the GT predicate on the "pending_plan_version" field.
+func PendingPlanVersionGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPendingPlanVersion, v))
+}
+
+// PendingPlanVersionGTE applies the GTE predicate on the "pending_plan_version" field.
+func PendingPlanVersionGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPendingPlanVersion, v))
+}
+
+// PendingPlanVersionLT applies the LT predicate on the "pending_plan_version" field.
+func PendingPlanVersionLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPendingPlanVersion, v))
+}
+
+// PendingPlanVersionLTE applies the LTE predicate on the "pending_plan_version" field.
+func PendingPlanVersionLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPendingPlanVersion, v))
+}
+
+// PendingPlanVersionIsNil applies the IsNil predicate on the "pending_plan_version" field.
+func PendingPlanVersionIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldPendingPlanVersion))
+}
+
+// PendingPlanVersionNotNil applies the NotNil predicate on the "pending_plan_version" field.
+func PendingPlanVersionNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldPendingPlanVersion))
+}
+
 // SubscriptionStatusEQ applies the EQ predicate on the "subscription_status" field.
 func SubscriptionStatusEQ(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldSubscriptionStatus, v))
@@ -1395,6 +1526,286 @@ func TrialEndNotNil() predicate.Subscription {
 	return predicate.Subscription(sql.FieldNotNull(FieldTrialEnd))
 }
 
+// TrialRequiresPaymentMethodEQ applies the EQ predicate on the "trial_requires_payment_method" field.
+func TrialRequiresPaymentMethodEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTrialRequiresPaymentMethod, v))
+}
+
+// TrialRequiresPaymentMethodNEQ applies the NEQ predicate on the "trial_requires_payment_method" field.
+func TrialRequiresPaymentMethodNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldTrialRequiresPaymentMethod, v))
+}
+
+// TrialWillEndNotifiedAtEQ applies the EQ predicate on the "trial_will_end_notified_at" field.
+func TrialWillEndNotifiedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTrialWillEndNotifiedAt, v))
+}
+
+// TrialWillEndNotifiedAtNEQ applies the NEQ predicate on the "trial_will_end_notified_at" field.
+func TrialWillEndNotifiedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldTrialWillEndNotifiedAt, v))
+}
+
+// TrialWillEndNotifiedAtIn applies the In predicate on the "trial_will_end_notified_at" field.
+func TrialWillEndNotifiedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldTrialWillEndNotifiedAt, vs...))
+}
+
+// TrialWillEndNotifiedAtNotIn applies the NotIn predicate on the "trial_will_end_notified_at" field.
+func TrialWillEndNotifiedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldTrialWillEndNotifiedAt, vs...))
+}
+
+// TrialWillEndNotifiedAtGT applies the GT predicate on the "trial_will_end_notified_at" field.
+func TrialWillEndNotifiedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldTrialWillEndNotifiedAt, v))
+}
+
+// TrialWillEndNotifiedAtGTE applies the GTE predicate on the "trial_will_end_notified_at" field.
+func TrialWillEndNotifiedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldTrialWillEndNotifiedAt, v))
+}
+
+// TrialWillEndNotifiedAtLT applies the LT predicate on the "trial_will_end_notified_at" field.
+func TrialWillEndNotifiedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldTrialWillEndNotifiedAt, v))
+}
+
+// TrialWillEndNotifiedAtLTE applies the LTE predicate on the "trial_will_end_notified_at" field.
+func TrialWillEndNotifiedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldTrialWillEndNotifiedAt, v))
+}
+
+// TrialWillEndNotifiedAtIsNil applies the IsNil predicate on the "trial_will_end_notified_at" field.
+func TrialWillEndNotifiedAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldTrialWillEndNotifiedAt))
+}
+
+// TrialWillEndNotifiedAtNotNil applies the NotNil predicate on the "trial_will_end_notified_at" field.
+func TrialWillEndNotifiedAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldTrialWillEndNotifiedAt))
+}
+
+// PaymentMethodIDEQ applies the EQ predicate on the "payment_method_id" field.
+func PaymentMethodIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPaymentMethodID, v))
+}
+
+// PaymentMethodIDNEQ applies the NEQ predicate on the "payment_method_id" field.
+func PaymentMethodIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPaymentMethodID, v))
+}
+
+// PaymentMethodIDIn applies the In predicate on the "payment_method_id" field.
+func PaymentMethodIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPaymentMethodID, vs...))
+}
+
+// PaymentMethodIDNotIn applies the NotIn predicate on the "payment_method_id" field.
+func PaymentMethodIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPaymentMethodID, vs...))
+}
+
+// PaymentMethodIDGT applies the GT predicate on the "payment_method_id" field.
+func PaymentMethodIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPaymentMethodID, v))
+}
+
+// PaymentMethodIDGTE applies the GTE predicate on the "payment_method_id" field.
+func PaymentMethodIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPaymentMethodID, v))
+}
+
+// PaymentMethodIDLT applies the LT predicate on the "payment_method_id" field.
+func PaymentMethodIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPaymentMethodID, v))
+}
+
+// PaymentMethodIDLTE applies the LTE predicate on the "payment_method_id" field.
+func PaymentMethodIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPaymentMethodID, v))
+}
+
+// PaymentMethodIDContains applies the Contains predicate on the "payment_method_id" field.
+func PaymentMethodIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldPaymentMethodID, v))
+}
+
+// PaymentMethodIDHasPrefix applies the HasPrefix predicate on the "payment_method_id" field.
+func PaymentMethodIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldPaymentMethodID, v))
+}
+
+// PaymentMethodIDHasSuffix applies the HasSuffix predicate on the "payment_method_id" field.
+func PaymentMethodIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldPaymentMethodID, v))
+}
+
+// PaymentMethodIDIsNil applies the IsNil predicate on the "payment_method_id" field.
+func PaymentMethodIDIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldPaymentMethodID))
+}
+
+// PaymentMethodIDNotNil applies the NotNil predicate on the "payment_method_id" field.
+func PaymentMethodIDNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldPaymentMethodID))
+}
+
+// PaymentMethodIDEqualFold applies the EqualFold predicate on the "payment_method_id" field.
+func PaymentMethodIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldPaymentMethodID, v))
+}
+
+// PaymentMethodIDContainsFold applies the ContainsFold predicate on the "payment_method_id" field.
+func PaymentMethodIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldPaymentMethodID, v))
+}
+
+// CommitmentAmountEQ applies the EQ predicate on the "commitment_amount" field.
+func CommitmentAmountEQ(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountNEQ applies the NEQ predicate on the "commitment_amount" field.
+func CommitmentAmountNEQ(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountIn applies the In predicate on the "commitment_amount" field.
+func CommitmentAmountIn(vs ...decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCommitmentAmount, vs...))
+}
+
+// CommitmentAmountNotIn applies the NotIn predicate on the "commitment_amount" field.
+func CommitmentAmountNotIn(vs ...decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCommitmentAmount, vs...))
+}
+
+// CommitmentAmountGT applies the GT predicate on the "commitment_amount" field.
+func CommitmentAmountGT(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountGTE applies the GTE predicate on the "commitment_amount" field.
+func CommitmentAmountGTE(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountLT applies the LT predicate on the "commitment_amount" field.
+func CommitmentAmountLT(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountLTE applies the LTE predicate on the "commitment_amount" field.
+func CommitmentAmountLTE(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountIsNil applies the IsNil predicate on the "commitment_amount" field.
+func CommitmentAmountIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCommitmentAmount))
+}
+
+// CommitmentAmountNotNil applies the NotNil predicate on the "commitment_amount" field.
+func CommitmentAmountNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCommitmentAmount))
+}
+
+// CommitmentIntervalEQ applies the EQ predicate on the "commitment_interval" field.
+func CommitmentIntervalEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalNEQ applies the NEQ predicate on the "commitment_interval" field.
+func CommitmentIntervalNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalIn applies the In predicate on the "commitment_interval" field.
+func CommitmentIntervalIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCommitmentInterval, vs...))
+}
+
+// CommitmentIntervalNotIn applies the NotIn predicate on the "commitment_interval" field.
+func CommitmentIntervalNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCommitmentInterval, vs...))
+}
+
+// CommitmentIntervalGT applies the GT predicate on the "commitment_interval" field.
+func CommitmentIntervalGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalGTE applies the GTE predicate on the "commitment_interval" field.
+func CommitmentIntervalGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalLT applies the LT predicate on the "commitment_interval" field.
+func CommitmentIntervalLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalLTE applies the LTE predicate on the "commitment_interval" field.
+func CommitmentIntervalLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalContains applies the Contains predicate on the "commitment_interval" field.
+func CommitmentIntervalContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalHasPrefix applies the HasPrefix predicate on the "commitment_interval" field.
+func CommitmentIntervalHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalHasSuffix applies the HasSuffix predicate on the "commitment_interval" field.
+func CommitmentIntervalHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalIsNil applies the IsNil predicate on the "commitment_interval" field.
+func CommitmentIntervalIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCommitmentInterval))
+}
+
+// CommitmentIntervalNotNil applies the NotNil predicate on the "commitment_interval" field.
+func CommitmentIntervalNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCommitmentInterval))
+}
+
+// CommitmentIntervalEqualFold applies the EqualFold predicate on the "commitment_interval" field.
+func CommitmentIntervalEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldCommitmentInterval, v))
+}
+
+// CommitmentIntervalContainsFold applies the ContainsFold predicate on the "commitment_interval" field.
+func CommitmentIntervalContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldCommitmentInterval, v))
+}
+
+// AutoApplyCreditsEQ applies the EQ predicate on the "auto_apply_credits" field.
+func AutoApplyCreditsEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldAutoApplyCredits, v))
+}
+
+// AutoApplyCreditsNEQ applies the NEQ predicate on the "auto_apply_credits" field.
+func AutoApplyCreditsNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldAutoApplyCredits, v))
+}
+
+// AutoApplyCreditsIsNil applies the IsNil predicate on the "auto_apply_credits" field.
+func AutoApplyCreditsIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldAutoApplyCredits))
+}
+
+// AutoApplyCreditsNotNil applies the NotNil predicate on the "auto_apply_credits" field.
+func AutoApplyCreditsNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldAutoApplyCredits))
+}
+
 // BillingCadenceEQ applies the EQ predicate on the "billing_cadence" field.
 func BillingCadenceEQ(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldBillingCadence, v))
@@ -1801,6 +2212,29 @@ func HasPausesWith(preds ...predicate.SubscriptionPause) predicate.Subscription
 	})
 }
 
+// HasQuantityChanges applies the HasEdge predicate on the "quantity_changes" edge.
+func HasQuantityChanges() predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, QuantityChangesTable, QuantityChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuantityChangesWith applies the HasEdge predicate on the "quantity_changes" edge with a given conditions (other predicates).
+func HasQuantityChangesWith(preds ...predicate.SubscriptionQuantityChange) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := newQuantityChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Subscription) predicate.Subscription {
 	return predicate.Subscription(sql.AndPredicates(predicates...))
@@ -13,6 +13,8 @@ import (
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
+	"github.com/shopspring/decimal"
 )
 
 // SubscriptionCreate is the builder for creating a Subscription entity.
@@ -138,6 +140,34 @@ func (sc *SubscriptionCreate) SetPlanID(s string) *SubscriptionCreate {
 	return sc
 }
 
+// SetPlanVersion sets the "plan_version" field.
+func (sc *SubscriptionCreate) SetPlanVersion(i int) *SubscriptionCreate {
+	sc.mutation.SetPlanVersion(i)
+	return sc
+}
+
+// SetNillablePlanVersion sets the "plan_version" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillablePlanVersion(i *int) *SubscriptionCreate {
+	if i != nil {
+		sc.SetPlanVersion(*i)
+	}
+	return sc
+}
+
+// SetPendingPlanVersion sets the "pending_plan_version" field.
+func (sc *SubscriptionCreate) SetPendingPlanVersion(i int) *SubscriptionCreate {
+	sc.mutation.SetPendingPlanVersion(i)
+	return sc
+}
+
+// SetNillablePendingPlanVersion sets the "pending_plan_version" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillablePendingPlanVersion(i *int) *SubscriptionCreate {
+	if i != nil {
+		sc.SetPendingPlanVersion(*i)
+	}
+	return sc
+}
+
 // SetSubscriptionStatus sets the "subscription_status" field.
 func (sc *SubscriptionCreate) SetSubscriptionStatus(s string) *SubscriptionCreate {
 	sc.mutation.SetSubscriptionStatus(s)
@@ -298,6 +328,90 @@ func (sc *SubscriptionCreate) SetNillableTrialEnd(t *time.Time) *SubscriptionCre
 	return sc
 }
 
+// SetTrialRequiresPaymentMethod sets the "trial_requires_payment_method" field.
+func (sc *SubscriptionCreate) SetTrialRequiresPaymentMethod(b bool) *SubscriptionCreate {
+	sc.mutation.SetTrialRequiresPaymentMethod(b)
+	return sc
+}
+
+// SetNillableTrialRequiresPaymentMethod sets the "trial_requires_payment_method" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableTrialRequiresPaymentMethod(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetTrialRequiresPaymentMethod(*b)
+	}
+	return sc
+}
+
+// SetTrialWillEndNotifiedAt sets the "trial_will_end_notified_at" field.
+func (sc *SubscriptionCreate) SetTrialWillEndNotifiedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetTrialWillEndNotifiedAt(t)
+	return sc
+}
+
+// SetNillableTrialWillEndNotifiedAt sets the "trial_will_end_notified_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableTrialWillEndNotifiedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetTrialWillEndNotifiedAt(*t)
+	}
+	return sc
+}
+
+// SetPaymentMethodID sets the "payment_method_id" field.
+func (sc *SubscriptionCreate) SetPaymentMethodID(s string) *SubscriptionCreate {
+	sc.mutation.SetPaymentMethodID(s)
+	return sc
+}
+
+// SetNillablePaymentMethodID sets the "payment_method_id" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillablePaymentMethodID(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetPaymentMethodID(*s)
+	}
+	return sc
+}
+
+// SetCommitmentAmount sets the "commitment_amount" field.
+func (sc *SubscriptionCreate) SetCommitmentAmount(d decimal.Decimal) *SubscriptionCreate {
+	sc.mutation.SetCommitmentAmount(d)
+	return sc
+}
+
+// SetNillableCommitmentAmount sets the "commitment_amount" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCommitmentAmount(d *decimal.Decimal) *SubscriptionCreate {
+	if d != nil {
+		sc.SetCommitmentAmount(*d)
+	}
+	return sc
+}
+
+// SetCommitmentInterval sets the "commitment_interval" field.
+func (sc *SubscriptionCreate) SetCommitmentInterval(s string) *SubscriptionCreate {
+	sc.mutation.SetCommitmentInterval(s)
+	return sc
+}
+
+// SetNillableCommitmentInterval sets the "commitment_interval" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCommitmentInterval(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetCommitmentInterval(*s)
+	}
+	return sc
+}
+
+// SetAutoApplyCredits sets the "auto_apply_credits" field.
+func (sc *SubscriptionCreate) SetAutoApplyCredits(b bool) *SubscriptionCreate {
+	sc.mutation.SetAutoApplyCredits(b)
+	return sc
+}
+
+// SetNillableAutoApplyCredits sets the "auto_apply_credits" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableAutoApplyCredits(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetAutoApplyCredits(*b)
+	}
+	return sc
+}
+
 // SetBillingCadence sets the "billing_cadence" field.
 func (sc *SubscriptionCreate) SetBillingCadence(s string) *SubscriptionCreate {
 	sc.mutation.SetBillingCadence(s)
@@ -408,6 +522,21 @@ func (sc *SubscriptionCreate) AddPauses(s ...*SubscriptionPause) *SubscriptionCr
 	return sc.AddPauseIDs(ids...)
 }
 
+// AddQuantityChangeIDs adds the "quantity_changes" edge to the SubscriptionQuantityChange entity by IDs.
+func (sc *SubscriptionCreate) AddQuantityChangeIDs(ids ...string) *SubscriptionCreate {
+	sc.mutation.AddQuantityChangeIDs(ids...)
+	return sc
+}
+
+// AddQuantityChanges adds the "quantity_changes" edges to the SubscriptionQuantityChange entity.
+func (sc *SubscriptionCreate) AddQuantityChanges(s ...*SubscriptionQuantityChange) *SubscriptionCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddQuantityChangeIDs(ids...)
+}
+
 // Mutation returns the SubscriptionMutation object of the builder.
 func (sc *SubscriptionCreate) Mutation() *SubscriptionMutation {
 	return sc.mutation
@@ -459,6 +588,10 @@ func (sc *SubscriptionCreate) defaults() {
 		v := subscription.DefaultEnvironmentID
 		sc.mutation.SetEnvironmentID(v)
 	}
+	if _, ok := sc.mutation.PlanVersion(); !ok {
+		v := subscription.DefaultPlanVersion
+		sc.mutation.SetPlanVersion(v)
+	}
 	if _, ok := sc.mutation.SubscriptionStatus(); !ok {
 		v := subscription.DefaultSubscriptionStatus
 		sc.mutation.SetSubscriptionStatus(v)
@@ -483,6 +616,10 @@ func (sc *SubscriptionCreate) defaults() {
 		v := subscription.DefaultCancelAtPeriodEnd
 		sc.mutation.SetCancelAtPeriodEnd(v)
 	}
+	if _, ok := sc.mutation.TrialRequiresPaymentMethod(); !ok {
+		v := subscription.DefaultTrialRequiresPaymentMethod
+		sc.mutation.SetTrialRequiresPaymentMethod(v)
+	}
 	if _, ok := sc.mutation.BillingPeriodCount(); !ok {
 		v := subscription.DefaultBillingPeriodCount
 		sc.mutation.SetBillingPeriodCount(v)
@@ -532,6 +669,9 @@ func (sc *SubscriptionCreate) check() error {
 			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "Subscription.plan_id": %w`, err)}
 		}
 	}
+	if _, ok := sc.mutation.PlanVersion(); !ok {
+		return &ValidationError{Name: "plan_version", err: errors.New(`ent: missing required field "Subscription.plan_version"`)}
+	}
 	if _, ok := sc.mutation.SubscriptionStatus(); !ok {
 		return &ValidationError{Name: "subscription_status", err: errors.New(`ent: missing required field "Subscription.subscription_status"`)}
 	}
@@ -558,6 +698,9 @@ func (sc *SubscriptionCreate) check() error {
 	if _, ok := sc.mutation.CancelAtPeriodEnd(); !ok {
 		return &ValidationError{Name: "cancel_at_period_end", err: errors.New(`ent: missing required field "Subscription.cancel_at_period_end"`)}
 	}
+	if _, ok := sc.mutation.TrialRequiresPaymentMethod(); !ok {
+		return &ValidationError{Name: "trial_requires_payment_method", err: errors.New(`ent: missing required field "Subscription.trial_requires_payment_method"`)}
+	}
 	if _, ok := sc.mutation.BillingCadence(); !ok {
 		return &ValidationError{Name: "billing_cadence", err: errors.New(`ent: missing required field "Subscription.billing_cadence"`)}
 	}
@@ -658,6 +801,14 @@ func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_spec.SetField(subscription.FieldPlanID, field.TypeString, value)
 		_node.PlanID = value
 	}
+	if value, ok := sc.mutation.PlanVersion(); ok {
+		_spec.SetField(subscription.FieldPlanVersion, field.TypeInt, value)
+		_node.PlanVersion = value
+	}
+	if value, ok := sc.mutation.PendingPlanVersion(); ok {
+		_spec.SetField(subscription.FieldPendingPlanVersion, field.TypeInt, value)
+		_node.PendingPlanVersion = &value
+	}
 	if value, ok := sc.mutation.SubscriptionStatus(); ok {
 		_spec.SetField(subscription.FieldSubscriptionStatus, field.TypeString, value)
 		_node.SubscriptionStatus = value
@@ -706,6 +857,30 @@ func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_spec.SetField(subscription.FieldTrialEnd, field.TypeTime, value)
 		_node.TrialEnd = &value
 	}
+	if value, ok := sc.mutation.TrialRequiresPaymentMethod(); ok {
+		_spec.SetField(subscription.FieldTrialRequiresPaymentMethod, field.TypeBool, value)
+		_node.TrialRequiresPaymentMethod = value
+	}
+	if value, ok := sc.mutation.TrialWillEndNotifiedAt(); ok {
+		_spec.SetField(subscription.FieldTrialWillEndNotifiedAt, field.TypeTime, value)
+		_node.TrialWillEndNotifiedAt = &value
+	}
+	if value, ok := sc.mutation.PaymentMethodID(); ok {
+		_spec.SetField(subscription.FieldPaymentMethodID, field.TypeString, value)
+		_node.PaymentMethodID = &value
+	}
+	if value, ok := sc.mutation.CommitmentAmount(); ok {
+		_spec.SetField(subscription.FieldCommitmentAmount, field.TypeOther, value)
+		_node.CommitmentAmount = &value
+	}
+	if value, ok := sc.mutation.CommitmentInterval(); ok {
+		_spec.SetField(subscription.FieldCommitmentInterval, field.TypeString, value)
+		_node.CommitmentInterval = value
+	}
+	if value, ok := sc.mutation.AutoApplyCredits(); ok {
+		_spec.SetField(subscription.FieldAutoApplyCredits, field.TypeBool, value)
+		_node.AutoApplyCredits = &value
+	}
 	if value, ok := sc.mutation.BillingCadence(); ok {
 		_spec.SetField(subscription.FieldBillingCadence, field.TypeString, value)
 		_node.BillingCadence = value
@@ -766,6 +941,22 @@ func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.QuantityChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.QuantityChangesTable,
+			Columns: []string{subscription.QuantityChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
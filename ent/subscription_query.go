@@ -16,17 +16,19 @@ import (
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
 )
 
 // SubscriptionQuery is the builder for querying Subscription entities.
 type SubscriptionQuery struct {
 	config
-	ctx           *QueryContext
-	order         []subscription.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Subscription
-	withLineItems *SubscriptionLineItemQuery
-	withPauses    *SubscriptionPauseQuery
+	ctx                 *QueryContext
+	order               []subscription.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Subscription
+	withLineItems       *SubscriptionLineItemQuery
+	withPauses          *SubscriptionPauseQuery
+	withQuantityChanges *SubscriptionQuantityChangeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,28 @@ func (sq *SubscriptionQuery) QueryPauses() *SubscriptionPauseQuery {
 	return query
 }
 
+// QueryQuantityChanges chains the current query on the "quantity_changes" edge.
+func (sq *SubscriptionQuery) QueryQuantityChanges() *SubscriptionQuantityChangeQuery {
+	query := (&SubscriptionQuantityChangeClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, selector),
+			sqlgraph.To(subscriptionquantitychange.Table, subscriptionquantitychange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, subscription.QuantityChangesTable, subscription.QuantityChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Subscription entity from the query.
 // Returns a *NotFoundError when no Subscription was found.
 func (sq *SubscriptionQuery) First(ctx context.Context) (*Subscription, error) {
@@ -294,13 +318,14 @@ func (sq *SubscriptionQuery) Clone() *SubscriptionQuery {
 		return nil
 	}
 	return &SubscriptionQuery{
-		config:        sq.config,
-		ctx:           sq.ctx.Clone(),
-		order:         append([]subscription.OrderOption{}, sq.order...),
-		inters:        append([]Interceptor{}, sq.inters...),
-		predicates:    append([]predicate.Subscription{}, sq.predicates...),
-		withLineItems: sq.withLineItems.Clone(),
-		withPauses:    sq.withPauses.Clone(),
+		config:              sq.config,
+		ctx:                 sq.ctx.Clone(),
+		order:               append([]subscription.OrderOption{}, sq.order...),
+		inters:              append([]Interceptor{}, sq.inters...),
+		predicates:          append([]predicate.Subscription{}, sq.predicates...),
+		withLineItems:       sq.withLineItems.Clone(),
+		withPauses:          sq.withPauses.Clone(),
+		withQuantityChanges: sq.withQuantityChanges.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -329,6 +354,17 @@ func (sq *SubscriptionQuery) WithPauses(opts ...func(*SubscriptionPauseQuery)) *
 	return sq
 }
 
+// WithQuantityChanges tells the query-builder to eager-load the nodes that are connected to
+// the "quantity_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SubscriptionQuery) WithQuantityChanges(opts ...func(*SubscriptionQuantityChangeQuery)) *SubscriptionQuery {
+	query := (&SubscriptionQuantityChangeClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withQuantityChanges = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +443,10 @@ func (sq *SubscriptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*Subscription{}
 		_spec       = sq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			sq.withLineItems != nil,
 			sq.withPauses != nil,
+			sq.withQuantityChanges != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +481,15 @@ func (sq *SubscriptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := sq.withQuantityChanges; query != nil {
+		if err := sq.loadQuantityChanges(ctx, query, nodes,
+			func(n *Subscription) { n.Edges.QuantityChanges = []*SubscriptionQuantityChange{} },
+			func(n *Subscription, e *SubscriptionQuantityChange) {
+				n.Edges.QuantityChanges = append(n.Edges.QuantityChanges, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -507,6 +553,36 @@ func (sq *SubscriptionQuery) loadPauses(ctx context.Context, query *Subscription
 	}
 	return nil
 }
+func (sq *SubscriptionQuery) loadQuantityChanges(ctx context.Context, query *SubscriptionQuantityChangeQuery, nodes []*Subscription, init func(*Subscription), assign func(*Subscription, *SubscriptionQuantityChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Subscription)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(subscriptionquantitychange.FieldSubscriptionID)
+	}
+	query.Where(predicate.SubscriptionQuantityChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(subscription.QuantityChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SubscriptionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "subscription_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (sq *SubscriptionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
@@ -15,6 +15,8 @@ import (
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
+	"github.com/shopspring/decimal"
 )
 
 // SubscriptionUpdate is the builder for updating Subscription entities.
@@ -90,6 +92,68 @@ func (su *SubscriptionUpdate) ClearLookupKey() *SubscriptionUpdate {
 	return su
 }
 
+// SetPlanID sets the "plan_id" field.
+func (su *SubscriptionUpdate) SetPlanID(s string) *SubscriptionUpdate {
+	su.mutation.SetPlanID(s)
+	return su
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillablePlanID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetPlanID(*s)
+	}
+	return su
+}
+
+// SetPlanVersion sets the "plan_version" field.
+func (su *SubscriptionUpdate) SetPlanVersion(i int) *SubscriptionUpdate {
+	su.mutation.ResetPlanVersion()
+	su.mutation.SetPlanVersion(i)
+	return su
+}
+
+// SetNillablePlanVersion sets the "plan_version" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillablePlanVersion(i *int) *SubscriptionUpdate {
+	if i != nil {
+		su.SetPlanVersion(*i)
+	}
+	return su
+}
+
+// AddPlanVersion adds i to the "plan_version" field.
+func (su *SubscriptionUpdate) AddPlanVersion(i int) *SubscriptionUpdate {
+	su.mutation.AddPlanVersion(i)
+	return su
+}
+
+// SetPendingPlanVersion sets the "pending_plan_version" field.
+func (su *SubscriptionUpdate) SetPendingPlanVersion(i int) *SubscriptionUpdate {
+	su.mutation.ResetPendingPlanVersion()
+	su.mutation.SetPendingPlanVersion(i)
+	return su
+}
+
+// SetNillablePendingPlanVersion sets the "pending_plan_version" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillablePendingPlanVersion(i *int) *SubscriptionUpdate {
+	if i != nil {
+		su.SetPendingPlanVersion(*i)
+	}
+	return su
+}
+
+// AddPendingPlanVersion adds i to the "pending_plan_version" field.
+func (su *SubscriptionUpdate) AddPendingPlanVersion(i int) *SubscriptionUpdate {
+	su.mutation.AddPendingPlanVersion(i)
+	return su
+}
+
+// ClearPendingPlanVersion clears the value of the "pending_plan_version" field.
+func (su *SubscriptionUpdate) ClearPendingPlanVersion() *SubscriptionUpdate {
+	su.mutation.ClearPendingPlanVersion()
+	return su
+}
+
 // SetSubscriptionStatus sets the "subscription_status" field.
 func (su *SubscriptionUpdate) SetSubscriptionStatus(s string) *SubscriptionUpdate {
 	su.mutation.SetSubscriptionStatus(s)
@@ -246,6 +310,120 @@ func (su *SubscriptionUpdate) ClearTrialEnd() *SubscriptionUpdate {
 	return su
 }
 
+// SetTrialRequiresPaymentMethod sets the "trial_requires_payment_method" field.
+func (su *SubscriptionUpdate) SetTrialRequiresPaymentMethod(b bool) *SubscriptionUpdate {
+	su.mutation.SetTrialRequiresPaymentMethod(b)
+	return su
+}
+
+// SetNillableTrialRequiresPaymentMethod sets the "trial_requires_payment_method" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableTrialRequiresPaymentMethod(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetTrialRequiresPaymentMethod(*b)
+	}
+	return su
+}
+
+// SetTrialWillEndNotifiedAt sets the "trial_will_end_notified_at" field.
+func (su *SubscriptionUpdate) SetTrialWillEndNotifiedAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetTrialWillEndNotifiedAt(t)
+	return su
+}
+
+// SetNillableTrialWillEndNotifiedAt sets the "trial_will_end_notified_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableTrialWillEndNotifiedAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetTrialWillEndNotifiedAt(*t)
+	}
+	return su
+}
+
+// ClearTrialWillEndNotifiedAt clears the value of the "trial_will_end_notified_at" field.
+func (su *SubscriptionUpdate) ClearTrialWillEndNotifiedAt() *SubscriptionUpdate {
+	su.mutation.ClearTrialWillEndNotifiedAt()
+	return su
+}
+
+// SetPaymentMethodID sets the "payment_method_id" field.
+func (su *SubscriptionUpdate) SetPaymentMethodID(s string) *SubscriptionUpdate {
+	su.mutation.SetPaymentMethodID(s)
+	return su
+}
+
+// SetNillablePaymentMethodID sets the "payment_method_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillablePaymentMethodID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetPaymentMethodID(*s)
+	}
+	return su
+}
+
+// ClearPaymentMethodID clears the value of the "payment_method_id" field.
+func (su *SubscriptionUpdate) ClearPaymentMethodID() *SubscriptionUpdate {
+	su.mutation.ClearPaymentMethodID()
+	return su
+}
+
+// SetCommitmentAmount sets the "commitment_amount" field.
+func (su *SubscriptionUpdate) SetCommitmentAmount(d decimal.Decimal) *SubscriptionUpdate {
+	su.mutation.SetCommitmentAmount(d)
+	return su
+}
+
+// SetNillableCommitmentAmount sets the "commitment_amount" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCommitmentAmount(d *decimal.Decimal) *SubscriptionUpdate {
+	if d != nil {
+		su.SetCommitmentAmount(*d)
+	}
+	return su
+}
+
+// ClearCommitmentAmount clears the value of the "commitment_amount" field.
+func (su *SubscriptionUpdate) ClearCommitmentAmount() *SubscriptionUpdate {
+	su.mutation.ClearCommitmentAmount()
+	return su
+}
+
+// SetCommitmentInterval sets the "commitment_interval" field.
+func (su *SubscriptionUpdate) SetCommitmentInterval(s string) *SubscriptionUpdate {
+	su.mutation.SetCommitmentInterval(s)
+	return su
+}
+
+// SetNillableCommitmentInterval sets the "commitment_interval" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCommitmentInterval(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetCommitmentInterval(*s)
+	}
+	return su
+}
+
+// ClearCommitmentInterval clears the value of the "commitment_interval" field.
+func (su *SubscriptionUpdate) ClearCommitmentInterval() *SubscriptionUpdate {
+	su.mutation.ClearCommitmentInterval()
+	return su
+}
+
+// SetAutoApplyCredits sets the "auto_apply_credits" field.
+func (su *SubscriptionUpdate) SetAutoApplyCredits(b bool) *SubscriptionUpdate {
+	su.mutation.SetAutoApplyCredits(b)
+	return su
+}
+
+// SetNillableAutoApplyCredits sets the "auto_apply_credits" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableAutoApplyCredits(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetAutoApplyCredits(*b)
+	}
+	return su
+}
+
+// ClearAutoApplyCredits clears the value of the "auto_apply_credits" field.
+func (su *SubscriptionUpdate) ClearAutoApplyCredits() *SubscriptionUpdate {
+	su.mutation.ClearAutoApplyCredits()
+	return su
+}
+
 // SetVersion sets the "version" field.
 func (su *SubscriptionUpdate) SetVersion(i int) *SubscriptionUpdate {
 	su.mutation.ResetVersion()
@@ -343,6 +521,21 @@ func (su *SubscriptionUpdate) AddPauses(s ...*SubscriptionPause) *SubscriptionUp
 	return su.AddPauseIDs(ids...)
 }
 
+// AddQuantityChangeIDs adds the "quantity_changes" edge to the SubscriptionQuantityChange entity by IDs.
+func (su *SubscriptionUpdate) AddQuantityChangeIDs(ids ...string) *SubscriptionUpdate {
+	su.mutation.AddQuantityChangeIDs(ids...)
+	return su
+}
+
+// AddQuantityChanges adds the "quantity_changes" edges to the SubscriptionQuantityChange entity.
+func (su *SubscriptionUpdate) AddQuantityChanges(s ...*SubscriptionQuantityChange) *SubscriptionUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddQuantityChangeIDs(ids...)
+}
+
 // Mutation returns the SubscriptionMutation object of the builder.
 func (su *SubscriptionUpdate) Mutation() *SubscriptionMutation {
 	return su.mutation
@@ -390,6 +583,27 @@ func (su *SubscriptionUpdate) RemovePauses(s ...*SubscriptionPause) *Subscriptio
 	return su.RemovePauseIDs(ids...)
 }
 
+// ClearQuantityChanges clears all "quantity_changes" edges to the SubscriptionQuantityChange entity.
+func (su *SubscriptionUpdate) ClearQuantityChanges() *SubscriptionUpdate {
+	su.mutation.ClearQuantityChanges()
+	return su
+}
+
+// RemoveQuantityChangeIDs removes the "quantity_changes" edge to SubscriptionQuantityChange entities by IDs.
+func (su *SubscriptionUpdate) RemoveQuantityChangeIDs(ids ...string) *SubscriptionUpdate {
+	su.mutation.RemoveQuantityChangeIDs(ids...)
+	return su
+}
+
+// RemoveQuantityChanges removes "quantity_changes" edges to SubscriptionQuantityChange entities.
+func (su *SubscriptionUpdate) RemoveQuantityChanges(s ...*SubscriptionQuantityChange) *SubscriptionUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveQuantityChangeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SubscriptionUpdate) Save(ctx context.Context) (int, error) {
 	su.defaults()
@@ -426,7 +640,20 @@ func (su *SubscriptionUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (su *SubscriptionUpdate) check() error {
+	if v, ok := su.mutation.PlanID(); ok {
+		if err := subscription.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "Subscription.plan_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
 	if ps := su.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -459,6 +686,24 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.LookupKeyCleared() {
 		_spec.ClearField(subscription.FieldLookupKey, field.TypeString)
 	}
+	if value, ok := su.mutation.PlanID(); ok {
+		_spec.SetField(subscription.FieldPlanID, field.TypeString, value)
+	}
+	if value, ok := su.mutation.PlanVersion(); ok {
+		_spec.SetField(subscription.FieldPlanVersion, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedPlanVersion(); ok {
+		_spec.AddField(subscription.FieldPlanVersion, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.PendingPlanVersion(); ok {
+		_spec.SetField(subscription.FieldPendingPlanVersion, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedPendingPlanVersion(); ok {
+		_spec.AddField(subscription.FieldPendingPlanVersion, field.TypeInt, value)
+	}
+	if su.mutation.PendingPlanVersionCleared() {
+		_spec.ClearField(subscription.FieldPendingPlanVersion, field.TypeInt)
+	}
 	if value, ok := su.mutation.SubscriptionStatus(); ok {
 		_spec.SetField(subscription.FieldSubscriptionStatus, field.TypeString, value)
 	}
@@ -501,6 +746,39 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.TrialEndCleared() {
 		_spec.ClearField(subscription.FieldTrialEnd, field.TypeTime)
 	}
+	if value, ok := su.mutation.TrialRequiresPaymentMethod(); ok {
+		_spec.SetField(subscription.FieldTrialRequiresPaymentMethod, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.TrialWillEndNotifiedAt(); ok {
+		_spec.SetField(subscription.FieldTrialWillEndNotifiedAt, field.TypeTime, value)
+	}
+	if su.mutation.TrialWillEndNotifiedAtCleared() {
+		_spec.ClearField(subscription.FieldTrialWillEndNotifiedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.PaymentMethodID(); ok {
+		_spec.SetField(subscription.FieldPaymentMethodID, field.TypeString, value)
+	}
+	if su.mutation.PaymentMethodIDCleared() {
+		_spec.ClearField(subscription.FieldPaymentMethodID, field.TypeString)
+	}
+	if value, ok := su.mutation.CommitmentAmount(); ok {
+		_spec.SetField(subscription.FieldCommitmentAmount, field.TypeOther, value)
+	}
+	if su.mutation.CommitmentAmountCleared() {
+		_spec.ClearField(subscription.FieldCommitmentAmount, field.TypeOther)
+	}
+	if value, ok := su.mutation.CommitmentInterval(); ok {
+		_spec.SetField(subscription.FieldCommitmentInterval, field.TypeString, value)
+	}
+	if su.mutation.CommitmentIntervalCleared() {
+		_spec.ClearField(subscription.FieldCommitmentInterval, field.TypeString)
+	}
+	if value, ok := su.mutation.AutoApplyCredits(); ok {
+		_spec.SetField(subscription.FieldAutoApplyCredits, field.TypeBool, value)
+	}
+	if su.mutation.AutoApplyCreditsCleared() {
+		_spec.ClearField(subscription.FieldAutoApplyCredits, field.TypeBool)
+	}
 	if value, ok := su.mutation.Version(); ok {
 		_spec.SetField(subscription.FieldVersion, field.TypeInt, value)
 	}
@@ -612,6 +890,51 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.QuantityChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.QuantityChangesTable,
+			Columns: []string{subscription.QuantityChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedQuantityChangesIDs(); len(nodes) > 0 && !su.mutation.QuantityChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.QuantityChangesTable,
+			Columns: []string{subscription.QuantityChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.QuantityChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.QuantityChangesTable,
+			Columns: []string{subscription.QuantityChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{subscription.Label}
@@ -692,6 +1015,68 @@ func (suo *SubscriptionUpdateOne) ClearLookupKey() *SubscriptionUpdateOne {
 	return suo
 }
 
+// SetPlanID sets the "plan_id" field.
+func (suo *SubscriptionUpdateOne) SetPlanID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetPlanID(s)
+	return suo
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillablePlanID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetPlanID(*s)
+	}
+	return suo
+}
+
+// SetPlanVersion sets the "plan_version" field.
+func (suo *SubscriptionUpdateOne) SetPlanVersion(i int) *SubscriptionUpdateOne {
+	suo.mutation.ResetPlanVersion()
+	suo.mutation.SetPlanVersion(i)
+	return suo
+}
+
+// SetNillablePlanVersion sets the "plan_version" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillablePlanVersion(i *int) *SubscriptionUpdateOne {
+	if i != nil {
+		suo.SetPlanVersion(*i)
+	}
+	return suo
+}
+
+// AddPlanVersion adds i to the "plan_version" field.
+func (suo *SubscriptionUpdateOne) AddPlanVersion(i int) *SubscriptionUpdateOne {
+	suo.mutation.AddPlanVersion(i)
+	return suo
+}
+
+// SetPendingPlanVersion sets the "pending_plan_version" field.
+func (suo *SubscriptionUpdateOne) SetPendingPlanVersion(i int) *SubscriptionUpdateOne {
+	suo.mutation.ResetPendingPlanVersion()
+	suo.mutation.SetPendingPlanVersion(i)
+	return suo
+}
+
+// SetNillablePendingPlanVersion sets the "pending_plan_version" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillablePendingPlanVersion(i *int) *SubscriptionUpdateOne {
+	if i != nil {
+		suo.SetPendingPlanVersion(*i)
+	}
+	return suo
+}
+
+// AddPendingPlanVersion adds i to the "pending_plan_version" field.
+func (suo *SubscriptionUpdateOne) AddPendingPlanVersion(i int) *SubscriptionUpdateOne {
+	suo.mutation.AddPendingPlanVersion(i)
+	return suo
+}
+
+// ClearPendingPlanVersion clears the value of the "pending_plan_version" field.
+func (suo *SubscriptionUpdateOne) ClearPendingPlanVersion() *SubscriptionUpdateOne {
+	suo.mutation.ClearPendingPlanVersion()
+	return suo
+}
+
 // SetSubscriptionStatus sets the "subscription_status" field.
 func (suo *SubscriptionUpdateOne) SetSubscriptionStatus(s string) *SubscriptionUpdateOne {
 	suo.mutation.SetSubscriptionStatus(s)
@@ -848,6 +1233,120 @@ func (suo *SubscriptionUpdateOne) ClearTrialEnd() *SubscriptionUpdateOne {
 	return suo
 }
 
+// SetTrialRequiresPaymentMethod sets the "trial_requires_payment_method" field.
+func (suo *SubscriptionUpdateOne) SetTrialRequiresPaymentMethod(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetTrialRequiresPaymentMethod(b)
+	return suo
+}
+
+// SetNillableTrialRequiresPaymentMethod sets the "trial_requires_payment_method" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableTrialRequiresPaymentMethod(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetTrialRequiresPaymentMethod(*b)
+	}
+	return suo
+}
+
+// SetTrialWillEndNotifiedAt sets the "trial_will_end_notified_at" field.
+func (suo *SubscriptionUpdateOne) SetTrialWillEndNotifiedAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetTrialWillEndNotifiedAt(t)
+	return suo
+}
+
+// SetNillableTrialWillEndNotifiedAt sets the "trial_will_end_notified_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableTrialWillEndNotifiedAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetTrialWillEndNotifiedAt(*t)
+	}
+	return suo
+}
+
+// ClearTrialWillEndNotifiedAt clears the value of the "trial_will_end_notified_at" field.
+func (suo *SubscriptionUpdateOne) ClearTrialWillEndNotifiedAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearTrialWillEndNotifiedAt()
+	return suo
+}
+
+// SetPaymentMethodID sets the "payment_method_id" field.
+func (suo *SubscriptionUpdateOne) SetPaymentMethodID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetPaymentMethodID(s)
+	return suo
+}
+
+// SetNillablePaymentMethodID sets the "payment_method_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillablePaymentMethodID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetPaymentMethodID(*s)
+	}
+	return suo
+}
+
+// ClearPaymentMethodID clears the value of the "payment_method_id" field.
+func (suo *SubscriptionUpdateOne) ClearPaymentMethodID() *SubscriptionUpdateOne {
+	suo.mutation.ClearPaymentMethodID()
+	return suo
+}
+
+// SetCommitmentAmount sets the "commitment_amount" field.
+func (suo *SubscriptionUpdateOne) SetCommitmentAmount(d decimal.Decimal) *SubscriptionUpdateOne {
+	suo.mutation.SetCommitmentAmount(d)
+	return suo
+}
+
+// SetNillableCommitmentAmount sets the "commitment_amount" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCommitmentAmount(d *decimal.Decimal) *SubscriptionUpdateOne {
+	if d != nil {
+		suo.SetCommitmentAmount(*d)
+	}
+	return suo
+}
+
+// ClearCommitmentAmount clears the value of the "commitment_amount" field.
+func (suo *SubscriptionUpdateOne) ClearCommitmentAmount() *SubscriptionUpdateOne {
+	suo.mutation.ClearCommitmentAmount()
+	return suo
+}
+
+// SetCommitmentInterval sets the "commitment_interval" field.
+func (suo *SubscriptionUpdateOne) SetCommitmentInterval(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetCommitmentInterval(s)
+	return suo
+}
+
+// SetNillableCommitmentInterval sets the "commitment_interval" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCommitmentInterval(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetCommitmentInterval(*s)
+	}
+	return suo
+}
+
+// ClearCommitmentInterval clears the value of the "commitment_interval" field.
+func (suo *SubscriptionUpdateOne) ClearCommitmentInterval() *SubscriptionUpdateOne {
+	suo.mutation.ClearCommitmentInterval()
+	return suo
+}
+
+// SetAutoApplyCredits sets the "auto_apply_credits" field.
+func (suo *SubscriptionUpdateOne) SetAutoApplyCredits(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetAutoApplyCredits(b)
+	return suo
+}
+
+// SetNillableAutoApplyCredits sets the "auto_apply_credits" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableAutoApplyCredits(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetAutoApplyCredits(*b)
+	}
+	return suo
+}
+
+// ClearAutoApplyCredits clears the value of the "auto_apply_credits" field.
+func (suo *SubscriptionUpdateOne) ClearAutoApplyCredits() *SubscriptionUpdateOne {
+	suo.mutation.ClearAutoApplyCredits()
+	return suo
+}
+
 // SetVersion sets the "version" field.
 func (suo *SubscriptionUpdateOne) SetVersion(i int) *SubscriptionUpdateOne {
 	suo.mutation.ResetVersion()
@@ -945,6 +1444,21 @@ func (suo *SubscriptionUpdateOne) AddPauses(s ...*SubscriptionPause) *Subscripti
 	return suo.AddPauseIDs(ids...)
 }
 
+// AddQuantityChangeIDs adds the "quantity_changes" edge to the SubscriptionQuantityChange entity by IDs.
+func (suo *SubscriptionUpdateOne) AddQuantityChangeIDs(ids ...string) *SubscriptionUpdateOne {
+	suo.mutation.AddQuantityChangeIDs(ids...)
+	return suo
+}
+
+// AddQuantityChanges adds the "quantity_changes" edges to the SubscriptionQuantityChange entity.
+func (suo *SubscriptionUpdateOne) AddQuantityChanges(s ...*SubscriptionQuantityChange) *SubscriptionUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddQuantityChangeIDs(ids...)
+}
+
 // Mutation returns the SubscriptionMutation object of the builder.
 func (suo *SubscriptionUpdateOne) Mutation() *SubscriptionMutation {
 	return suo.mutation
@@ -992,6 +1506,27 @@ func (suo *SubscriptionUpdateOne) RemovePauses(s ...*SubscriptionPause) *Subscri
 	return suo.RemovePauseIDs(ids...)
 }
 
+// ClearQuantityChanges clears all "quantity_changes" edges to the SubscriptionQuantityChange entity.
+func (suo *SubscriptionUpdateOne) ClearQuantityChanges() *SubscriptionUpdateOne {
+	suo.mutation.ClearQuantityChanges()
+	return suo
+}
+
+// RemoveQuantityChangeIDs removes the "quantity_changes" edge to SubscriptionQuantityChange entities by IDs.
+func (suo *SubscriptionUpdateOne) RemoveQuantityChangeIDs(ids ...string) *SubscriptionUpdateOne {
+	suo.mutation.RemoveQuantityChangeIDs(ids...)
+	return suo
+}
+
+// RemoveQuantityChanges removes "quantity_changes" edges to SubscriptionQuantityChange entities.
+func (suo *SubscriptionUpdateOne) RemoveQuantityChanges(s ...*SubscriptionQuantityChange) *SubscriptionUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveQuantityChangeIDs(ids...)
+}
+
 // Where appends a list predicates to the SubscriptionUpdate builder.
 func (suo *SubscriptionUpdateOne) Where(ps ...predicate.Subscription) *SubscriptionUpdateOne {
 	suo.mutation.Where(ps...)
@@ -1041,7 +1576,20 @@ func (suo *SubscriptionUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (suo *SubscriptionUpdateOne) check() error {
+	if v, ok := suo.mutation.PlanID(); ok {
+		if err := subscription.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "Subscription.plan_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
 	id, ok := suo.mutation.ID()
 	if !ok {
@@ -1091,6 +1639,24 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 	if suo.mutation.LookupKeyCleared() {
 		_spec.ClearField(subscription.FieldLookupKey, field.TypeString)
 	}
+	if value, ok := suo.mutation.PlanID(); ok {
+		_spec.SetField(subscription.FieldPlanID, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.PlanVersion(); ok {
+		_spec.SetField(subscription.FieldPlanVersion, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedPlanVersion(); ok {
+		_spec.AddField(subscription.FieldPlanVersion, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.PendingPlanVersion(); ok {
+		_spec.SetField(subscription.FieldPendingPlanVersion, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedPendingPlanVersion(); ok {
+		_spec.AddField(subscription.FieldPendingPlanVersion, field.TypeInt, value)
+	}
+	if suo.mutation.PendingPlanVersionCleared() {
+		_spec.ClearField(subscription.FieldPendingPlanVersion, field.TypeInt)
+	}
 	if value, ok := suo.mutation.SubscriptionStatus(); ok {
 		_spec.SetField(subscription.FieldSubscriptionStatus, field.TypeString, value)
 	}
@@ -1133,6 +1699,39 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 	if suo.mutation.TrialEndCleared() {
 		_spec.ClearField(subscription.FieldTrialEnd, field.TypeTime)
 	}
+	if value, ok := suo.mutation.TrialRequiresPaymentMethod(); ok {
+		_spec.SetField(subscription.FieldTrialRequiresPaymentMethod, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.TrialWillEndNotifiedAt(); ok {
+		_spec.SetField(subscription.FieldTrialWillEndNotifiedAt, field.TypeTime, value)
+	}
+	if suo.mutation.TrialWillEndNotifiedAtCleared() {
+		_spec.ClearField(subscription.FieldTrialWillEndNotifiedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.PaymentMethodID(); ok {
+		_spec.SetField(subscription.FieldPaymentMethodID, field.TypeString, value)
+	}
+	if suo.mutation.PaymentMethodIDCleared() {
+		_spec.ClearField(subscription.FieldPaymentMethodID, field.TypeString)
+	}
+	if value, ok := suo.mutation.CommitmentAmount(); ok {
+		_spec.SetField(subscription.FieldCommitmentAmount, field.TypeOther, value)
+	}
+	if suo.mutation.CommitmentAmountCleared() {
+		_spec.ClearField(subscription.FieldCommitmentAmount, field.TypeOther)
+	}
+	if value, ok := suo.mutation.CommitmentInterval(); ok {
+		_spec.SetField(subscription.FieldCommitmentInterval, field.TypeString, value)
+	}
+	if suo.mutation.CommitmentIntervalCleared() {
+		_spec.ClearField(subscription.FieldCommitmentInterval, field.TypeString)
+	}
+	if value, ok := suo.mutation.AutoApplyCredits(); ok {
+		_spec.SetField(subscription.FieldAutoApplyCredits, field.TypeBool, value)
+	}
+	if suo.mutation.AutoApplyCreditsCleared() {
+		_spec.ClearField(subscription.FieldAutoApplyCredits, field.TypeBool)
+	}
 	if value, ok := suo.mutation.Version(); ok {
 		_spec.SetField(subscription.FieldVersion, field.TypeInt, value)
 	}
@@ -1244,6 +1843,51 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.QuantityChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.QuantityChangesTable,
+			Columns: []string{subscription.QuantityChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedQuantityChangesIDs(); len(nodes) > 0 && !suo.mutation.QuantityChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.QuantityChangesTable,
+			Columns: []string{subscription.QuantityChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.QuantityChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.QuantityChangesTable,
+			Columns: []string{subscription.QuantityChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Subscription{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/subscription"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionQuantityChange is the model entity for the SubscriptionQuantityChange schema.
+type SubscriptionQuantityChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID string `json:"subscription_id,omitempty"`
+	// SubscriptionLineItemID holds the value of the "subscription_line_item_id" field.
+	SubscriptionLineItemID string `json:"subscription_line_item_id,omitempty"`
+	// PriceID holds the value of the "price_id" field.
+	PriceID string `json:"price_id,omitempty"`
+	// PreviousQuantity holds the value of the "previous_quantity" field.
+	PreviousQuantity decimal.Decimal `json:"previous_quantity,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// EffectiveDate holds the value of the "effective_date" field.
+	EffectiveDate time.Time `json:"effective_date,omitempty"`
+	// CreditNoteID holds the value of the "credit_note_id" field.
+	CreditNoteID *string `json:"credit_note_id,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SubscriptionQuantityChangeQuery when eager-loading is set.
+	Edges        SubscriptionQuantityChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SubscriptionQuantityChangeEdges holds the relations/edges for other nodes in the graph.
+type SubscriptionQuantityChangeEdges struct {
+	// Subscription holds the value of the subscription edge.
+	Subscription *Subscription `json:"subscription,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SubscriptionOrErr returns the Subscription value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubscriptionQuantityChangeEdges) SubscriptionOrErr() (*Subscription, error) {
+	if e.Subscription != nil {
+		return e.Subscription, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: subscription.Label}
+	}
+	return nil, &NotLoadedError{edge: "subscription"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SubscriptionQuantityChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionquantitychange.FieldMetadata:
+			values[i] = new([]byte)
+		case subscriptionquantitychange.FieldPreviousQuantity, subscriptionquantitychange.FieldQuantity:
+			values[i] = new(decimal.Decimal)
+		case subscriptionquantitychange.FieldID, subscriptionquantitychange.FieldTenantID, subscriptionquantitychange.FieldStatus, subscriptionquantitychange.FieldCreatedBy, subscriptionquantitychange.FieldUpdatedBy, subscriptionquantitychange.FieldEnvironmentID, subscriptionquantitychange.FieldSubscriptionID, subscriptionquantitychange.FieldSubscriptionLineItemID, subscriptionquantitychange.FieldPriceID, subscriptionquantitychange.FieldCreditNoteID:
+			values[i] = new(sql.NullString)
+		case subscriptionquantitychange.FieldCreatedAt, subscriptionquantitychange.FieldUpdatedAt, subscriptionquantitychange.FieldEffectiveDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SubscriptionQuantityChange fields.
+func (sqc *SubscriptionQuantityChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionquantitychange.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sqc.ID = value.String
+			}
+		case subscriptionquantitychange.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				sqc.TenantID = value.String
+			}
+		case subscriptionquantitychange.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sqc.Status = value.String
+			}
+		case subscriptionquantitychange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sqc.CreatedAt = value.Time
+			}
+		case subscriptionquantitychange.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sqc.UpdatedAt = value.Time
+			}
+		case subscriptionquantitychange.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				sqc.CreatedBy = value.String
+			}
+		case subscriptionquantitychange.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				sqc.UpdatedBy = value.String
+			}
+		case subscriptionquantitychange.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				sqc.EnvironmentID = value.String
+			}
+		case subscriptionquantitychange.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				sqc.SubscriptionID = value.String
+			}
+		case subscriptionquantitychange.FieldSubscriptionLineItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_line_item_id", values[i])
+			} else if value.Valid {
+				sqc.SubscriptionLineItemID = value.String
+			}
+		case subscriptionquantitychange.FieldPriceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field price_id", values[i])
+			} else if value.Valid {
+				sqc.PriceID = value.String
+			}
+		case subscriptionquantitychange.FieldPreviousQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_quantity", values[i])
+			} else if value != nil {
+				sqc.PreviousQuantity = *value
+			}
+		case subscriptionquantitychange.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				sqc.Quantity = *value
+			}
+		case subscriptionquantitychange.FieldEffectiveDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_date", values[i])
+			} else if value.Valid {
+				sqc.EffectiveDate = value.Time
+			}
+		case subscriptionquantitychange.FieldCreditNoteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_note_id", values[i])
+			} else if value.Valid {
+				sqc.CreditNoteID = new(string)
+				*sqc.CreditNoteID = value.String
+			}
+		case subscriptionquantitychange.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sqc.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			sqc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SubscriptionQuantityChange.
+// This includes values selected through modifiers, order, etc.
+func (sqc *SubscriptionQuantityChange) Value(name string) (ent.Value, error) {
+	return sqc.selectValues.Get(name)
+}
+
+// QuerySubscription queries the "subscription" edge of the SubscriptionQuantityChange entity.
+func (sqc *SubscriptionQuantityChange) QuerySubscription() *SubscriptionQuery {
+	return NewSubscriptionQuantityChangeClient(sqc.config).QuerySubscription(sqc)
+}
+
+// Update returns a builder for updating this SubscriptionQuantityChange.
+// Note that you need to call SubscriptionQuantityChange.Unwrap() before calling this method if this SubscriptionQuantityChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sqc *SubscriptionQuantityChange) Update() *SubscriptionQuantityChangeUpdateOne {
+	return NewSubscriptionQuantityChangeClient(sqc.config).UpdateOne(sqc)
+}
+
+// Unwrap unwraps the SubscriptionQuantityChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sqc *SubscriptionQuantityChange) Unwrap() *SubscriptionQuantityChange {
+	_tx, ok := sqc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SubscriptionQuantityChange is not a transactional entity")
+	}
+	sqc.config.driver = _tx.drv
+	return sqc
+}
+
+// String implements the fmt.Stringer.
+func (sqc *SubscriptionQuantityChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("SubscriptionQuantityChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sqc.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(sqc.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(sqc.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sqc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sqc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(sqc.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(sqc.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(sqc.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(sqc.SubscriptionID)
+	builder.WriteString(", ")
+	builder.WriteString("subscription_line_item_id=")
+	builder.WriteString(sqc.SubscriptionLineItemID)
+	builder.WriteString(", ")
+	builder.WriteString("price_id=")
+	builder.WriteString(sqc.PriceID)
+	builder.WriteString(", ")
+	builder.WriteString("previous_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", sqc.PreviousQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", sqc.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("effective_date=")
+	builder.WriteString(sqc.EffectiveDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := sqc.CreditNoteID; v != nil {
+		builder.WriteString("credit_note_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", sqc.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SubscriptionQuantityChanges is a parsable slice of SubscriptionQuantityChange.
+type SubscriptionQuantityChanges []*SubscriptionQuantityChange
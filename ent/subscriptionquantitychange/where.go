@@ -0,0 +1,1010 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscriptionquantitychange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionLineItemID applies equality check predicate on the "subscription_line_item_id" field. It's identical to SubscriptionLineItemIDEQ.
+func SubscriptionLineItemID(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldSubscriptionLineItemID, v))
+}
+
+// PriceID applies equality check predicate on the "price_id" field. It's identical to PriceIDEQ.
+func PriceID(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldPriceID, v))
+}
+
+// PreviousQuantity applies equality check predicate on the "previous_quantity" field. It's identical to PreviousQuantityEQ.
+func PreviousQuantity(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldPreviousQuantity, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldQuantity, v))
+}
+
+// EffectiveDate applies equality check predicate on the "effective_date" field. It's identical to EffectiveDateEQ.
+func EffectiveDate(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldEffectiveDate, v))
+}
+
+// CreditNoteID applies equality check predicate on the "credit_note_id" field. It's identical to CreditNoteIDEQ.
+func CreditNoteID(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldCreditNoteID, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContains applies the Contains predicate on the "subscription_id" field.
+func SubscriptionIDContains(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContains(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasPrefix applies the HasPrefix predicate on the "subscription_id" field.
+func SubscriptionIDHasPrefix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasPrefix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasSuffix applies the HasSuffix predicate on the "subscription_id" field.
+func SubscriptionIDHasSuffix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasSuffix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDEqualFold applies the EqualFold predicate on the "subscription_id" field.
+func SubscriptionIDEqualFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEqualFold(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContainsFold applies the ContainsFold predicate on the "subscription_id" field.
+func SubscriptionIDContainsFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContainsFold(FieldSubscriptionID, v))
+}
+
+// SubscriptionLineItemIDEQ applies the EQ predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldSubscriptionLineItemID, v))
+}
+
+// SubscriptionLineItemIDNEQ applies the NEQ predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDNEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldSubscriptionLineItemID, v))
+}
+
+// SubscriptionLineItemIDIn applies the In predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldSubscriptionLineItemID, vs...))
+}
+
+// SubscriptionLineItemIDNotIn applies the NotIn predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDNotIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldSubscriptionLineItemID, vs...))
+}
+
+// SubscriptionLineItemIDGT applies the GT predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDGT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldSubscriptionLineItemID, v))
+}
+
+// SubscriptionLineItemIDGTE applies the GTE predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDGTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldSubscriptionLineItemID, v))
+}
+
+// SubscriptionLineItemIDLT applies the LT predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDLT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldSubscriptionLineItemID, v))
+}
+
+// SubscriptionLineItemIDLTE applies the LTE predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDLTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldSubscriptionLineItemID, v))
+}
+
+// SubscriptionLineItemIDContains applies the Contains predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDContains(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContains(FieldSubscriptionLineItemID, v))
+}
+
+// SubscriptionLineItemIDHasPrefix applies the HasPrefix predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDHasPrefix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasPrefix(FieldSubscriptionLineItemID, v))
+}
+
+// SubscriptionLineItemIDHasSuffix applies the HasSuffix predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDHasSuffix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasSuffix(FieldSubscriptionLineItemID, v))
+}
+
+// SubscriptionLineItemIDEqualFold applies the EqualFold predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDEqualFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEqualFold(FieldSubscriptionLineItemID, v))
+}
+
+// SubscriptionLineItemIDContainsFold applies the ContainsFold predicate on the "subscription_line_item_id" field.
+func SubscriptionLineItemIDContainsFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContainsFold(FieldSubscriptionLineItemID, v))
+}
+
+// PriceIDEQ applies the EQ predicate on the "price_id" field.
+func PriceIDEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldPriceID, v))
+}
+
+// PriceIDNEQ applies the NEQ predicate on the "price_id" field.
+func PriceIDNEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldPriceID, v))
+}
+
+// PriceIDIn applies the In predicate on the "price_id" field.
+func PriceIDIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldPriceID, vs...))
+}
+
+// PriceIDNotIn applies the NotIn predicate on the "price_id" field.
+func PriceIDNotIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldPriceID, vs...))
+}
+
+// PriceIDGT applies the GT predicate on the "price_id" field.
+func PriceIDGT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldPriceID, v))
+}
+
+// PriceIDGTE applies the GTE predicate on the "price_id" field.
+func PriceIDGTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldPriceID, v))
+}
+
+// PriceIDLT applies the LT predicate on the "price_id" field.
+func PriceIDLT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldPriceID, v))
+}
+
+// PriceIDLTE applies the LTE predicate on the "price_id" field.
+func PriceIDLTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldPriceID, v))
+}
+
+// PriceIDContains applies the Contains predicate on the "price_id" field.
+func PriceIDContains(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContains(FieldPriceID, v))
+}
+
+// PriceIDHasPrefix applies the HasPrefix predicate on the "price_id" field.
+func PriceIDHasPrefix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasPrefix(FieldPriceID, v))
+}
+
+// PriceIDHasSuffix applies the HasSuffix predicate on the "price_id" field.
+func PriceIDHasSuffix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasSuffix(FieldPriceID, v))
+}
+
+// PriceIDEqualFold applies the EqualFold predicate on the "price_id" field.
+func PriceIDEqualFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEqualFold(FieldPriceID, v))
+}
+
+// PriceIDContainsFold applies the ContainsFold predicate on the "price_id" field.
+func PriceIDContainsFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContainsFold(FieldPriceID, v))
+}
+
+// PreviousQuantityEQ applies the EQ predicate on the "previous_quantity" field.
+func PreviousQuantityEQ(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldPreviousQuantity, v))
+}
+
+// PreviousQuantityNEQ applies the NEQ predicate on the "previous_quantity" field.
+func PreviousQuantityNEQ(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldPreviousQuantity, v))
+}
+
+// PreviousQuantityIn applies the In predicate on the "previous_quantity" field.
+func PreviousQuantityIn(vs ...decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldPreviousQuantity, vs...))
+}
+
+// PreviousQuantityNotIn applies the NotIn predicate on the "previous_quantity" field.
+func PreviousQuantityNotIn(vs ...decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldPreviousQuantity, vs...))
+}
+
+// PreviousQuantityGT applies the GT predicate on the "previous_quantity" field.
+func PreviousQuantityGT(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldPreviousQuantity, v))
+}
+
+// PreviousQuantityGTE applies the GTE predicate on the "previous_quantity" field.
+func PreviousQuantityGTE(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldPreviousQuantity, v))
+}
+
+// PreviousQuantityLT applies the LT predicate on the "previous_quantity" field.
+func PreviousQuantityLT(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldPreviousQuantity, v))
+}
+
+// PreviousQuantityLTE applies the LTE predicate on the "previous_quantity" field.
+func PreviousQuantityLTE(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldPreviousQuantity, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldQuantity, v))
+}
+
+// EffectiveDateEQ applies the EQ predicate on the "effective_date" field.
+func EffectiveDateEQ(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldEffectiveDate, v))
+}
+
+// EffectiveDateNEQ applies the NEQ predicate on the "effective_date" field.
+func EffectiveDateNEQ(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldEffectiveDate, v))
+}
+
+// EffectiveDateIn applies the In predicate on the "effective_date" field.
+func EffectiveDateIn(vs ...time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldEffectiveDate, vs...))
+}
+
+// EffectiveDateNotIn applies the NotIn predicate on the "effective_date" field.
+func EffectiveDateNotIn(vs ...time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldEffectiveDate, vs...))
+}
+
+// EffectiveDateGT applies the GT predicate on the "effective_date" field.
+func EffectiveDateGT(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldEffectiveDate, v))
+}
+
+// EffectiveDateGTE applies the GTE predicate on the "effective_date" field.
+func EffectiveDateGTE(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldEffectiveDate, v))
+}
+
+// EffectiveDateLT applies the LT predicate on the "effective_date" field.
+func EffectiveDateLT(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldEffectiveDate, v))
+}
+
+// EffectiveDateLTE applies the LTE predicate on the "effective_date" field.
+func EffectiveDateLTE(v time.Time) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldEffectiveDate, v))
+}
+
+// CreditNoteIDEQ applies the EQ predicate on the "credit_note_id" field.
+func CreditNoteIDEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEQ(FieldCreditNoteID, v))
+}
+
+// CreditNoteIDNEQ applies the NEQ predicate on the "credit_note_id" field.
+func CreditNoteIDNEQ(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNEQ(FieldCreditNoteID, v))
+}
+
+// CreditNoteIDIn applies the In predicate on the "credit_note_id" field.
+func CreditNoteIDIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIn(FieldCreditNoteID, vs...))
+}
+
+// CreditNoteIDNotIn applies the NotIn predicate on the "credit_note_id" field.
+func CreditNoteIDNotIn(vs ...string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotIn(FieldCreditNoteID, vs...))
+}
+
+// CreditNoteIDGT applies the GT predicate on the "credit_note_id" field.
+func CreditNoteIDGT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGT(FieldCreditNoteID, v))
+}
+
+// CreditNoteIDGTE applies the GTE predicate on the "credit_note_id" field.
+func CreditNoteIDGTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldGTE(FieldCreditNoteID, v))
+}
+
+// CreditNoteIDLT applies the LT predicate on the "credit_note_id" field.
+func CreditNoteIDLT(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLT(FieldCreditNoteID, v))
+}
+
+// CreditNoteIDLTE applies the LTE predicate on the "credit_note_id" field.
+func CreditNoteIDLTE(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldLTE(FieldCreditNoteID, v))
+}
+
+// CreditNoteIDContains applies the Contains predicate on the "credit_note_id" field.
+func CreditNoteIDContains(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContains(FieldCreditNoteID, v))
+}
+
+// CreditNoteIDHasPrefix applies the HasPrefix predicate on the "credit_note_id" field.
+func CreditNoteIDHasPrefix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasPrefix(FieldCreditNoteID, v))
+}
+
+// CreditNoteIDHasSuffix applies the HasSuffix predicate on the "credit_note_id" field.
+func CreditNoteIDHasSuffix(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldHasSuffix(FieldCreditNoteID, v))
+}
+
+// CreditNoteIDIsNil applies the IsNil predicate on the "credit_note_id" field.
+func CreditNoteIDIsNil() predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIsNull(FieldCreditNoteID))
+}
+
+// CreditNoteIDNotNil applies the NotNil predicate on the "credit_note_id" field.
+func CreditNoteIDNotNil() predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotNull(FieldCreditNoteID))
+}
+
+// CreditNoteIDEqualFold applies the EqualFold predicate on the "credit_note_id" field.
+func CreditNoteIDEqualFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldEqualFold(FieldCreditNoteID, v))
+}
+
+// CreditNoteIDContainsFold applies the ContainsFold predicate on the "credit_note_id" field.
+func CreditNoteIDContainsFold(v string) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldContainsFold(FieldCreditNoteID, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.FieldNotNull(FieldMetadata))
+}
+
+// HasSubscription applies the HasEdge predicate on the "subscription" edge.
+func HasSubscription() predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SubscriptionTable, SubscriptionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubscriptionWith applies the HasEdge predicate on the "subscription" edge with a given conditions (other predicates).
+func HasSubscriptionWith(preds ...predicate.Subscription) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(func(s *sql.Selector) {
+		step := newSubscriptionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SubscriptionQuantityChange) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SubscriptionQuantityChange) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SubscriptionQuantityChange) predicate.SubscriptionQuantityChange {
+	return predicate.SubscriptionQuantityChange(sql.NotPredicates(p))
+}
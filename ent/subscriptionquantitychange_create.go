@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/subscription"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionQuantityChangeCreate is the builder for creating a SubscriptionQuantityChange entity.
+type SubscriptionQuantityChangeCreate struct {
+	config
+	mutation *SubscriptionQuantityChangeMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetTenantID(s string) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetTenantID(s)
+	return sqcc
+}
+
+// SetStatus sets the "status" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetStatus(s string) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetStatus(s)
+	return sqcc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sqcc *SubscriptionQuantityChangeCreate) SetNillableStatus(s *string) *SubscriptionQuantityChangeCreate {
+	if s != nil {
+		sqcc.SetStatus(*s)
+	}
+	return sqcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetCreatedAt(t time.Time) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetCreatedAt(t)
+	return sqcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sqcc *SubscriptionQuantityChangeCreate) SetNillableCreatedAt(t *time.Time) *SubscriptionQuantityChangeCreate {
+	if t != nil {
+		sqcc.SetCreatedAt(*t)
+	}
+	return sqcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetUpdatedAt(t time.Time) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetUpdatedAt(t)
+	return sqcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sqcc *SubscriptionQuantityChangeCreate) SetNillableUpdatedAt(t *time.Time) *SubscriptionQuantityChangeCreate {
+	if t != nil {
+		sqcc.SetUpdatedAt(*t)
+	}
+	return sqcc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetCreatedBy(s string) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetCreatedBy(s)
+	return sqcc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (sqcc *SubscriptionQuantityChangeCreate) SetNillableCreatedBy(s *string) *SubscriptionQuantityChangeCreate {
+	if s != nil {
+		sqcc.SetCreatedBy(*s)
+	}
+	return sqcc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetUpdatedBy(s string) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetUpdatedBy(s)
+	return sqcc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sqcc *SubscriptionQuantityChangeCreate) SetNillableUpdatedBy(s *string) *SubscriptionQuantityChangeCreate {
+	if s != nil {
+		sqcc.SetUpdatedBy(*s)
+	}
+	return sqcc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetEnvironmentID(s string) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetEnvironmentID(s)
+	return sqcc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (sqcc *SubscriptionQuantityChangeCreate) SetNillableEnvironmentID(s *string) *SubscriptionQuantityChangeCreate {
+	if s != nil {
+		sqcc.SetEnvironmentID(*s)
+	}
+	return sqcc
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetSubscriptionID(s string) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetSubscriptionID(s)
+	return sqcc
+}
+
+// SetSubscriptionLineItemID sets the "subscription_line_item_id" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetSubscriptionLineItemID(s string) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetSubscriptionLineItemID(s)
+	return sqcc
+}
+
+// SetPriceID sets the "price_id" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetPriceID(s string) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetPriceID(s)
+	return sqcc
+}
+
+// SetPreviousQuantity sets the "previous_quantity" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetPreviousQuantity(d decimal.Decimal) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetPreviousQuantity(d)
+	return sqcc
+}
+
+// SetQuantity sets the "quantity" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetQuantity(d decimal.Decimal) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetQuantity(d)
+	return sqcc
+}
+
+// SetEffectiveDate sets the "effective_date" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetEffectiveDate(t time.Time) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetEffectiveDate(t)
+	return sqcc
+}
+
+// SetCreditNoteID sets the "credit_note_id" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetCreditNoteID(s string) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetCreditNoteID(s)
+	return sqcc
+}
+
+// SetNillableCreditNoteID sets the "credit_note_id" field if the given value is not nil.
+func (sqcc *SubscriptionQuantityChangeCreate) SetNillableCreditNoteID(s *string) *SubscriptionQuantityChangeCreate {
+	if s != nil {
+		sqcc.SetCreditNoteID(*s)
+	}
+	return sqcc
+}
+
+// SetMetadata sets the "metadata" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetMetadata(m map[string]string) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetMetadata(m)
+	return sqcc
+}
+
+// SetID sets the "id" field.
+func (sqcc *SubscriptionQuantityChangeCreate) SetID(s string) *SubscriptionQuantityChangeCreate {
+	sqcc.mutation.SetID(s)
+	return sqcc
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (sqcc *SubscriptionQuantityChangeCreate) SetSubscription(s *Subscription) *SubscriptionQuantityChangeCreate {
+	return sqcc.SetSubscriptionID(s.ID)
+}
+
+// Mutation returns the SubscriptionQuantityChangeMutation object of the builder.
+func (sqcc *SubscriptionQuantityChangeCreate) Mutation() *SubscriptionQuantityChangeMutation {
+	return sqcc.mutation
+}
+
+// Save creates the SubscriptionQuantityChange in the database.
+func (sqcc *SubscriptionQuantityChangeCreate) Save(ctx context.Context) (*SubscriptionQuantityChange, error) {
+	sqcc.defaults()
+	return withHooks(ctx, sqcc.sqlSave, sqcc.mutation, sqcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sqcc *SubscriptionQuantityChangeCreate) SaveX(ctx context.Context) *SubscriptionQuantityChange {
+	v, err := sqcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sqcc *SubscriptionQuantityChangeCreate) Exec(ctx context.Context) error {
+	_, err := sqcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sqcc *SubscriptionQuantityChangeCreate) ExecX(ctx context.Context) {
+	if err := sqcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sqcc *SubscriptionQuantityChangeCreate) defaults() {
+	if _, ok := sqcc.mutation.Status(); !ok {
+		v := subscriptionquantitychange.DefaultStatus
+		sqcc.mutation.SetStatus(v)
+	}
+	if _, ok := sqcc.mutation.CreatedAt(); !ok {
+		v := subscriptionquantitychange.DefaultCreatedAt()
+		sqcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sqcc.mutation.UpdatedAt(); !ok {
+		v := subscriptionquantitychange.DefaultUpdatedAt()
+		sqcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sqcc.mutation.EnvironmentID(); !ok {
+		v := subscriptionquantitychange.DefaultEnvironmentID
+		sqcc.mutation.SetEnvironmentID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sqcc *SubscriptionQuantityChangeCreate) check() error {
+	if _, ok := sqcc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SubscriptionQuantityChange.tenant_id"`)}
+	}
+	if v, ok := sqcc.mutation.TenantID(); ok {
+		if err := subscriptionquantitychange.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionQuantityChange.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := sqcc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SubscriptionQuantityChange.status"`)}
+	}
+	if _, ok := sqcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SubscriptionQuantityChange.created_at"`)}
+	}
+	if _, ok := sqcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SubscriptionQuantityChange.updated_at"`)}
+	}
+	if _, ok := sqcc.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "SubscriptionQuantityChange.subscription_id"`)}
+	}
+	if v, ok := sqcc.mutation.SubscriptionID(); ok {
+		if err := subscriptionquantitychange.SubscriptionIDValidator(v); err != nil {
+			return &ValidationError{Name: "subscription_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionQuantityChange.subscription_id": %w`, err)}
+		}
+	}
+	if _, ok := sqcc.mutation.SubscriptionLineItemID(); !ok {
+		return &ValidationError{Name: "subscription_line_item_id", err: errors.New(`ent: missing required field "SubscriptionQuantityChange.subscription_line_item_id"`)}
+	}
+	if v, ok := sqcc.mutation.SubscriptionLineItemID(); ok {
+		if err := subscriptionquantitychange.SubscriptionLineItemIDValidator(v); err != nil {
+			return &ValidationError{Name: "subscription_line_item_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionQuantityChange.subscription_line_item_id": %w`, err)}
+		}
+	}
+	if _, ok := sqcc.mutation.PriceID(); !ok {
+		return &ValidationError{Name: "price_id", err: errors.New(`ent: missing required field "SubscriptionQuantityChange.price_id"`)}
+	}
+	if v, ok := sqcc.mutation.PriceID(); ok {
+		if err := subscriptionquantitychange.PriceIDValidator(v); err != nil {
+			return &ValidationError{Name: "price_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionQuantityChange.price_id": %w`, err)}
+		}
+	}
+	if _, ok := sqcc.mutation.PreviousQuantity(); !ok {
+		return &ValidationError{Name: "previous_quantity", err: errors.New(`ent: missing required field "SubscriptionQuantityChange.previous_quantity"`)}
+	}
+	if _, ok := sqcc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "SubscriptionQuantityChange.quantity"`)}
+	}
+	if _, ok := sqcc.mutation.EffectiveDate(); !ok {
+		return &ValidationError{Name: "effective_date", err: errors.New(`ent: missing required field "SubscriptionQuantityChange.effective_date"`)}
+	}
+	if len(sqcc.mutation.SubscriptionIDs()) == 0 {
+		return &ValidationError{Name: "subscription", err: errors.New(`ent: missing required edge "SubscriptionQuantityChange.subscription"`)}
+	}
+	return nil
+}
+
+func (sqcc *SubscriptionQuantityChangeCreate) sqlSave(ctx context.Context) (*SubscriptionQuantityChange, error) {
+	if err := sqcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sqcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sqcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SubscriptionQuantityChange.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sqcc.mutation.id = &_node.ID
+	sqcc.mutation.done = true
+	return _node, nil
+}
+
+func (sqcc *SubscriptionQuantityChangeCreate) createSpec() (*SubscriptionQuantityChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubscriptionQuantityChange{config: sqcc.config}
+		_spec = sqlgraph.NewCreateSpec(subscriptionquantitychange.Table, sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString))
+	)
+	if id, ok := sqcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sqcc.mutation.TenantID(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := sqcc.mutation.Status(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := sqcc.mutation.CreatedAt(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sqcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sqcc.mutation.CreatedBy(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := sqcc.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := sqcc.mutation.EnvironmentID(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := sqcc.mutation.SubscriptionLineItemID(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldSubscriptionLineItemID, field.TypeString, value)
+		_node.SubscriptionLineItemID = value
+	}
+	if value, ok := sqcc.mutation.PriceID(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldPriceID, field.TypeString, value)
+		_node.PriceID = value
+	}
+	if value, ok := sqcc.mutation.PreviousQuantity(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldPreviousQuantity, field.TypeOther, value)
+		_node.PreviousQuantity = value
+	}
+	if value, ok := sqcc.mutation.Quantity(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := sqcc.mutation.EffectiveDate(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldEffectiveDate, field.TypeTime, value)
+		_node.EffectiveDate = value
+	}
+	if value, ok := sqcc.mutation.CreditNoteID(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldCreditNoteID, field.TypeString, value)
+		_node.CreditNoteID = &value
+	}
+	if value, ok := sqcc.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if nodes := sqcc.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionquantitychange.SubscriptionTable,
+			Columns: []string{subscriptionquantitychange.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SubscriptionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SubscriptionQuantityChangeCreateBulk is the builder for creating many SubscriptionQuantityChange entities in bulk.
+type SubscriptionQuantityChangeCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionQuantityChangeCreate
+}
+
+// Save creates the SubscriptionQuantityChange entities in the database.
+func (sqccb *SubscriptionQuantityChangeCreateBulk) Save(ctx context.Context) ([]*SubscriptionQuantityChange, error) {
+	if sqccb.err != nil {
+		return nil, sqccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sqccb.builders))
+	nodes := make([]*SubscriptionQuantityChange, len(sqccb.builders))
+	mutators := make([]Mutator, len(sqccb.builders))
+	for i := range sqccb.builders {
+		func(i int, root context.Context) {
+			builder := sqccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionQuantityChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sqccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sqccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sqccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sqccb *SubscriptionQuantityChangeCreateBulk) SaveX(ctx context.Context) []*SubscriptionQuantityChange {
+	v, err := sqccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sqccb *SubscriptionQuantityChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := sqccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sqccb *SubscriptionQuantityChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := sqccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
+)
+
+// SubscriptionQuantityChangeDelete is the builder for deleting a SubscriptionQuantityChange entity.
+type SubscriptionQuantityChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionQuantityChangeMutation
+}
+
+// Where appends a list predicates to the SubscriptionQuantityChangeDelete builder.
+func (sqcd *SubscriptionQuantityChangeDelete) Where(ps ...predicate.SubscriptionQuantityChange) *SubscriptionQuantityChangeDelete {
+	sqcd.mutation.Where(ps...)
+	return sqcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sqcd *SubscriptionQuantityChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sqcd.sqlExec, sqcd.mutation, sqcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sqcd *SubscriptionQuantityChangeDelete) ExecX(ctx context.Context) int {
+	n, err := sqcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sqcd *SubscriptionQuantityChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(subscriptionquantitychange.Table, sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString))
+	if ps := sqcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sqcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sqcd.mutation.done = true
+	return affected, err
+}
+
+// SubscriptionQuantityChangeDeleteOne is the builder for deleting a single SubscriptionQuantityChange entity.
+type SubscriptionQuantityChangeDeleteOne struct {
+	sqcd *SubscriptionQuantityChangeDelete
+}
+
+// Where appends a list predicates to the SubscriptionQuantityChangeDelete builder.
+func (sqcdo *SubscriptionQuantityChangeDeleteOne) Where(ps ...predicate.SubscriptionQuantityChange) *SubscriptionQuantityChangeDeleteOne {
+	sqcdo.sqcd.mutation.Where(ps...)
+	return sqcdo
+}
+
+// Exec executes the deletion query.
+func (sqcdo *SubscriptionQuantityChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := sqcdo.sqcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{subscriptionquantitychange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sqcdo *SubscriptionQuantityChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := sqcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/subscription"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
+)
+
+// SubscriptionQuantityChangeQuery is the builder for querying SubscriptionQuantityChange entities.
+type SubscriptionQuantityChangeQuery struct {
+	config
+	ctx              *QueryContext
+	order            []subscriptionquantitychange.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.SubscriptionQuantityChange
+	withSubscription *SubscriptionQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SubscriptionQuantityChangeQuery builder.
+func (sqcq *SubscriptionQuantityChangeQuery) Where(ps ...predicate.SubscriptionQuantityChange) *SubscriptionQuantityChangeQuery {
+	sqcq.predicates = append(sqcq.predicates, ps...)
+	return sqcq
+}
+
+// Limit the number of records to be returned by this query.
+func (sqcq *SubscriptionQuantityChangeQuery) Limit(limit int) *SubscriptionQuantityChangeQuery {
+	sqcq.ctx.Limit = &limit
+	return sqcq
+}
+
+// Offset to start from.
+func (sqcq *SubscriptionQuantityChangeQuery) Offset(offset int) *SubscriptionQuantityChangeQuery {
+	sqcq.ctx.Offset = &offset
+	return sqcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sqcq *SubscriptionQuantityChangeQuery) Unique(unique bool) *SubscriptionQuantityChangeQuery {
+	sqcq.ctx.Unique = &unique
+	return sqcq
+}
+
+// Order specifies how the records should be ordered.
+func (sqcq *SubscriptionQuantityChangeQuery) Order(o ...subscriptionquantitychange.OrderOption) *SubscriptionQuantityChangeQuery {
+	sqcq.order = append(sqcq.order, o...)
+	return sqcq
+}
+
+// QuerySubscription chains the current query on the "subscription" edge.
+func (sqcq *SubscriptionQuantityChangeQuery) QuerySubscription() *SubscriptionQuery {
+	query := (&SubscriptionClient{config: sqcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sqcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sqcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscriptionquantitychange.Table, subscriptionquantitychange.FieldID, selector),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscriptionquantitychange.SubscriptionTable, subscriptionquantitychange.SubscriptionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sqcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SubscriptionQuantityChange entity from the query.
+// Returns a *NotFoundError when no SubscriptionQuantityChange was found.
+func (sqcq *SubscriptionQuantityChangeQuery) First(ctx context.Context) (*SubscriptionQuantityChange, error) {
+	nodes, err := sqcq.Limit(1).All(setContextOp(ctx, sqcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{subscriptionquantitychange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sqcq *SubscriptionQuantityChangeQuery) FirstX(ctx context.Context) *SubscriptionQuantityChange {
+	node, err := sqcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SubscriptionQuantityChange ID from the query.
+// Returns a *NotFoundError when no SubscriptionQuantityChange ID was found.
+func (sqcq *SubscriptionQuantityChangeQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = sqcq.Limit(1).IDs(setContextOp(ctx, sqcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{subscriptionquantitychange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sqcq *SubscriptionQuantityChangeQuery) FirstIDX(ctx context.Context) string {
+	id, err := sqcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SubscriptionQuantityChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SubscriptionQuantityChange entity is found.
+// Returns a *NotFoundError when no SubscriptionQuantityChange entities are found.
+func (sqcq *SubscriptionQuantityChangeQuery) Only(ctx context.Context) (*SubscriptionQuantityChange, error) {
+	nodes, err := sqcq.Limit(2).All(setContextOp(ctx, sqcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{subscriptionquantitychange.Label}
+	default:
+		return nil, &NotSingularError{subscriptionquantitychange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sqcq *SubscriptionQuantityChangeQuery) OnlyX(ctx context.Context) *SubscriptionQuantityChange {
+	node, err := sqcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SubscriptionQuantityChange ID in the query.
+// Returns a *NotSingularError when more than one SubscriptionQuantityChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sqcq *SubscriptionQuantityChangeQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = sqcq.Limit(2).IDs(setContextOp(ctx, sqcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{subscriptionquantitychange.Label}
+	default:
+		err = &NotSingularError{subscriptionquantitychange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sqcq *SubscriptionQuantityChangeQuery) OnlyIDX(ctx context.Context) string {
+	id, err := sqcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SubscriptionQuantityChanges.
+func (sqcq *SubscriptionQuantityChangeQuery) All(ctx context.Context) ([]*SubscriptionQuantityChange, error) {
+	ctx = setContextOp(ctx, sqcq.ctx, ent.OpQueryAll)
+	if err := sqcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SubscriptionQuantityChange, *SubscriptionQuantityChangeQuery]()
+	return withInterceptors[[]*SubscriptionQuantityChange](ctx, sqcq, qr, sqcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sqcq *SubscriptionQuantityChangeQuery) AllX(ctx context.Context) []*SubscriptionQuantityChange {
+	nodes, err := sqcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SubscriptionQuantityChange IDs.
+func (sqcq *SubscriptionQuantityChangeQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if sqcq.ctx.Unique == nil && sqcq.path != nil {
+		sqcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sqcq.ctx, ent.OpQueryIDs)
+	if err = sqcq.Select(subscriptionquantitychange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sqcq *SubscriptionQuantityChangeQuery) IDsX(ctx context.Context) []string {
+	ids, err := sqcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sqcq *SubscriptionQuantityChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sqcq.ctx, ent.OpQueryCount)
+	if err := sqcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sqcq, querierCount[*SubscriptionQuantityChangeQuery](), sqcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sqcq *SubscriptionQuantityChangeQuery) CountX(ctx context.Context) int {
+	count, err := sqcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sqcq *SubscriptionQuantityChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sqcq.ctx, ent.OpQueryExist)
+	switch _, err := sqcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sqcq *SubscriptionQuantityChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := sqcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SubscriptionQuantityChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sqcq *SubscriptionQuantityChangeQuery) Clone() *SubscriptionQuantityChangeQuery {
+	if sqcq == nil {
+		return nil
+	}
+	return &SubscriptionQuantityChangeQuery{
+		config:           sqcq.config,
+		ctx:              sqcq.ctx.Clone(),
+		order:            append([]subscriptionquantitychange.OrderOption{}, sqcq.order...),
+		inters:           append([]Interceptor{}, sqcq.inters...),
+		predicates:       append([]predicate.SubscriptionQuantityChange{}, sqcq.predicates...),
+		withSubscription: sqcq.withSubscription.Clone(),
+		// clone intermediate query.
+		sql:  sqcq.sql.Clone(),
+		path: sqcq.path,
+	}
+}
+
+// WithSubscription tells the query-builder to eager-load the nodes that are connected to
+// the "subscription" edge. The optional arguments are used to configure the query builder of the edge.
+func (sqcq *SubscriptionQuantityChangeQuery) WithSubscription(opts ...func(*SubscriptionQuery)) *SubscriptionQuantityChangeQuery {
+	query := (&SubscriptionClient{config: sqcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sqcq.withSubscription = query
+	return sqcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SubscriptionQuantityChange.Query().
+//		GroupBy(subscriptionquantitychange.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (sqcq *SubscriptionQuantityChangeQuery) GroupBy(field string, fields ...string) *SubscriptionQuantityChangeGroupBy {
+	sqcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SubscriptionQuantityChangeGroupBy{build: sqcq}
+	grbuild.flds = &sqcq.ctx.Fields
+	grbuild.label = subscriptionquantitychange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.SubscriptionQuantityChange.Query().
+//		Select(subscriptionquantitychange.FieldTenantID).
+//		Scan(ctx, &v)
+func (sqcq *SubscriptionQuantityChangeQuery) Select(fields ...string) *SubscriptionQuantityChangeSelect {
+	sqcq.ctx.Fields = append(sqcq.ctx.Fields, fields...)
+	sbuild := &SubscriptionQuantityChangeSelect{SubscriptionQuantityChangeQuery: sqcq}
+	sbuild.label = subscriptionquantitychange.Label
+	sbuild.flds, sbuild.scan = &sqcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SubscriptionQuantityChangeSelect configured with the given aggregations.
+func (sqcq *SubscriptionQuantityChangeQuery) Aggregate(fns ...AggregateFunc) *SubscriptionQuantityChangeSelect {
+	return sqcq.Select().Aggregate(fns...)
+}
+
+func (sqcq *SubscriptionQuantityChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sqcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sqcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sqcq.ctx.Fields {
+		if !subscriptionquantitychange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sqcq.path != nil {
+		prev, err := sqcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sqcq.sql = prev
+	}
+	return nil
+}
+
+func (sqcq *SubscriptionQuantityChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SubscriptionQuantityChange, error) {
+	var (
+		nodes       = []*SubscriptionQuantityChange{}
+		_spec       = sqcq.querySpec()
+		loadedTypes = [1]bool{
+			sqcq.withSubscription != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SubscriptionQuantityChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SubscriptionQuantityChange{config: sqcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sqcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := sqcq.withSubscription; query != nil {
+		if err := sqcq.loadSubscription(ctx, query, nodes, nil,
+			func(n *SubscriptionQuantityChange, e *Subscription) { n.Edges.Subscription = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (sqcq *SubscriptionQuantityChangeQuery) loadSubscription(ctx context.Context, query *SubscriptionQuery, nodes []*SubscriptionQuantityChange, init func(*SubscriptionQuantityChange), assign func(*SubscriptionQuantityChange, *Subscription)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SubscriptionQuantityChange)
+	for i := range nodes {
+		fk := nodes[i].SubscriptionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(subscription.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "subscription_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (sqcq *SubscriptionQuantityChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sqcq.querySpec()
+	_spec.Node.Columns = sqcq.ctx.Fields
+	if len(sqcq.ctx.Fields) > 0 {
+		_spec.Unique = sqcq.ctx.Unique != nil && *sqcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sqcq.driver, _spec)
+}
+
+func (sqcq *SubscriptionQuantityChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(subscriptionquantitychange.Table, subscriptionquantitychange.Columns, sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString))
+	_spec.From = sqcq.sql
+	if unique := sqcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sqcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sqcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionquantitychange.FieldID)
+		for i := range fields {
+			if fields[i] != subscriptionquantitychange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if sqcq.withSubscription != nil {
+			_spec.Node.AddColumnOnce(subscriptionquantitychange.FieldSubscriptionID)
+		}
+	}
+	if ps := sqcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sqcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sqcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sqcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sqcq *SubscriptionQuantityChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sqcq.driver.Dialect())
+	t1 := builder.Table(subscriptionquantitychange.Table)
+	columns := sqcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = subscriptionquantitychange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sqcq.sql != nil {
+		selector = sqcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sqcq.ctx.Unique != nil && *sqcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range sqcq.predicates {
+		p(selector)
+	}
+	for _, p := range sqcq.order {
+		p(selector)
+	}
+	if offset := sqcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sqcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SubscriptionQuantityChangeGroupBy is the group-by builder for SubscriptionQuantityChange entities.
+type SubscriptionQuantityChangeGroupBy struct {
+	selector
+	build *SubscriptionQuantityChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sqcgb *SubscriptionQuantityChangeGroupBy) Aggregate(fns ...AggregateFunc) *SubscriptionQuantityChangeGroupBy {
+	sqcgb.fns = append(sqcgb.fns, fns...)
+	return sqcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sqcgb *SubscriptionQuantityChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sqcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := sqcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SubscriptionQuantityChangeQuery, *SubscriptionQuantityChangeGroupBy](ctx, sqcgb.build, sqcgb, sqcgb.build.inters, v)
+}
+
+func (sqcgb *SubscriptionQuantityChangeGroupBy) sqlScan(ctx context.Context, root *SubscriptionQuantityChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sqcgb.fns))
+	for _, fn := range sqcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sqcgb.flds)+len(sqcgb.fns))
+		for _, f := range *sqcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sqcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sqcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SubscriptionQuantityChangeSelect is the builder for selecting fields of SubscriptionQuantityChange entities.
+type SubscriptionQuantityChangeSelect struct {
+	*SubscriptionQuantityChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sqcs *SubscriptionQuantityChangeSelect) Aggregate(fns ...AggregateFunc) *SubscriptionQuantityChangeSelect {
+	sqcs.fns = append(sqcs.fns, fns...)
+	return sqcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sqcs *SubscriptionQuantityChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sqcs.ctx, ent.OpQuerySelect)
+	if err := sqcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SubscriptionQuantityChangeQuery, *SubscriptionQuantityChangeSelect](ctx, sqcs.SubscriptionQuantityChangeQuery, sqcs, sqcs.inters, v)
+}
+
+func (sqcs *SubscriptionQuantityChangeSelect) sqlScan(ctx context.Context, root *SubscriptionQuantityChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sqcs.fns))
+	for _, fn := range sqcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sqcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sqcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
+)
+
+// SubscriptionQuantityChangeUpdate is the builder for updating SubscriptionQuantityChange entities.
+type SubscriptionQuantityChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionQuantityChangeMutation
+}
+
+// Where appends a list predicates to the SubscriptionQuantityChangeUpdate builder.
+func (sqcu *SubscriptionQuantityChangeUpdate) Where(ps ...predicate.SubscriptionQuantityChange) *SubscriptionQuantityChangeUpdate {
+	sqcu.mutation.Where(ps...)
+	return sqcu
+}
+
+// SetStatus sets the "status" field.
+func (sqcu *SubscriptionQuantityChangeUpdate) SetStatus(s string) *SubscriptionQuantityChangeUpdate {
+	sqcu.mutation.SetStatus(s)
+	return sqcu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sqcu *SubscriptionQuantityChangeUpdate) SetNillableStatus(s *string) *SubscriptionQuantityChangeUpdate {
+	if s != nil {
+		sqcu.SetStatus(*s)
+	}
+	return sqcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sqcu *SubscriptionQuantityChangeUpdate) SetUpdatedAt(t time.Time) *SubscriptionQuantityChangeUpdate {
+	sqcu.mutation.SetUpdatedAt(t)
+	return sqcu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sqcu *SubscriptionQuantityChangeUpdate) SetUpdatedBy(s string) *SubscriptionQuantityChangeUpdate {
+	sqcu.mutation.SetUpdatedBy(s)
+	return sqcu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sqcu *SubscriptionQuantityChangeUpdate) SetNillableUpdatedBy(s *string) *SubscriptionQuantityChangeUpdate {
+	if s != nil {
+		sqcu.SetUpdatedBy(*s)
+	}
+	return sqcu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (sqcu *SubscriptionQuantityChangeUpdate) ClearUpdatedBy() *SubscriptionQuantityChangeUpdate {
+	sqcu.mutation.ClearUpdatedBy()
+	return sqcu
+}
+
+// SetCreditNoteID sets the "credit_note_id" field.
+func (sqcu *SubscriptionQuantityChangeUpdate) SetCreditNoteID(s string) *SubscriptionQuantityChangeUpdate {
+	sqcu.mutation.SetCreditNoteID(s)
+	return sqcu
+}
+
+// SetNillableCreditNoteID sets the "credit_note_id" field if the given value is not nil.
+func (sqcu *SubscriptionQuantityChangeUpdate) SetNillableCreditNoteID(s *string) *SubscriptionQuantityChangeUpdate {
+	if s != nil {
+		sqcu.SetCreditNoteID(*s)
+	}
+	return sqcu
+}
+
+// ClearCreditNoteID clears the value of the "credit_note_id" field.
+func (sqcu *SubscriptionQuantityChangeUpdate) ClearCreditNoteID() *SubscriptionQuantityChangeUpdate {
+	sqcu.mutation.ClearCreditNoteID()
+	return sqcu
+}
+
+// SetMetadata sets the "metadata" field.
+func (sqcu *SubscriptionQuantityChangeUpdate) SetMetadata(m map[string]string) *SubscriptionQuantityChangeUpdate {
+	sqcu.mutation.SetMetadata(m)
+	return sqcu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (sqcu *SubscriptionQuantityChangeUpdate) ClearMetadata() *SubscriptionQuantityChangeUpdate {
+	sqcu.mutation.ClearMetadata()
+	return sqcu
+}
+
+// Mutation returns the SubscriptionQuantityChangeMutation object of the builder.
+func (sqcu *SubscriptionQuantityChangeUpdate) Mutation() *SubscriptionQuantityChangeMutation {
+	return sqcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sqcu *SubscriptionQuantityChangeUpdate) Save(ctx context.Context) (int, error) {
+	sqcu.defaults()
+	return withHooks(ctx, sqcu.sqlSave, sqcu.mutation, sqcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sqcu *SubscriptionQuantityChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := sqcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sqcu *SubscriptionQuantityChangeUpdate) Exec(ctx context.Context) error {
+	_, err := sqcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sqcu *SubscriptionQuantityChangeUpdate) ExecX(ctx context.Context) {
+	if err := sqcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sqcu *SubscriptionQuantityChangeUpdate) defaults() {
+	if _, ok := sqcu.mutation.UpdatedAt(); !ok {
+		v := subscriptionquantitychange.UpdateDefaultUpdatedAt()
+		sqcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sqcu *SubscriptionQuantityChangeUpdate) check() error {
+	if sqcu.mutation.SubscriptionCleared() && len(sqcu.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SubscriptionQuantityChange.subscription"`)
+	}
+	return nil
+}
+
+func (sqcu *SubscriptionQuantityChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sqcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionquantitychange.Table, subscriptionquantitychange.Columns, sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString))
+	if ps := sqcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sqcu.mutation.Status(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sqcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sqcu.mutation.CreatedByCleared() {
+		_spec.ClearField(subscriptionquantitychange.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := sqcu.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldUpdatedBy, field.TypeString, value)
+	}
+	if sqcu.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscriptionquantitychange.FieldUpdatedBy, field.TypeString)
+	}
+	if sqcu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(subscriptionquantitychange.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := sqcu.mutation.CreditNoteID(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldCreditNoteID, field.TypeString, value)
+	}
+	if sqcu.mutation.CreditNoteIDCleared() {
+		_spec.ClearField(subscriptionquantitychange.FieldCreditNoteID, field.TypeString)
+	}
+	if value, ok := sqcu.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldMetadata, field.TypeJSON, value)
+	}
+	if sqcu.mutation.MetadataCleared() {
+		_spec.ClearField(subscriptionquantitychange.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sqcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionquantitychange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sqcu.mutation.done = true
+	return n, nil
+}
+
+// SubscriptionQuantityChangeUpdateOne is the builder for updating a single SubscriptionQuantityChange entity.
+type SubscriptionQuantityChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionQuantityChangeMutation
+}
+
+// SetStatus sets the "status" field.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) SetStatus(s string) *SubscriptionQuantityChangeUpdateOne {
+	sqcuo.mutation.SetStatus(s)
+	return sqcuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) SetNillableStatus(s *string) *SubscriptionQuantityChangeUpdateOne {
+	if s != nil {
+		sqcuo.SetStatus(*s)
+	}
+	return sqcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) SetUpdatedAt(t time.Time) *SubscriptionQuantityChangeUpdateOne {
+	sqcuo.mutation.SetUpdatedAt(t)
+	return sqcuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) SetUpdatedBy(s string) *SubscriptionQuantityChangeUpdateOne {
+	sqcuo.mutation.SetUpdatedBy(s)
+	return sqcuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) SetNillableUpdatedBy(s *string) *SubscriptionQuantityChangeUpdateOne {
+	if s != nil {
+		sqcuo.SetUpdatedBy(*s)
+	}
+	return sqcuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) ClearUpdatedBy() *SubscriptionQuantityChangeUpdateOne {
+	sqcuo.mutation.ClearUpdatedBy()
+	return sqcuo
+}
+
+// SetCreditNoteID sets the "credit_note_id" field.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) SetCreditNoteID(s string) *SubscriptionQuantityChangeUpdateOne {
+	sqcuo.mutation.SetCreditNoteID(s)
+	return sqcuo
+}
+
+// SetNillableCreditNoteID sets the "credit_note_id" field if the given value is not nil.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) SetNillableCreditNoteID(s *string) *SubscriptionQuantityChangeUpdateOne {
+	if s != nil {
+		sqcuo.SetCreditNoteID(*s)
+	}
+	return sqcuo
+}
+
+// ClearCreditNoteID clears the value of the "credit_note_id" field.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) ClearCreditNoteID() *SubscriptionQuantityChangeUpdateOne {
+	sqcuo.mutation.ClearCreditNoteID()
+	return sqcuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) SetMetadata(m map[string]string) *SubscriptionQuantityChangeUpdateOne {
+	sqcuo.mutation.SetMetadata(m)
+	return sqcuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) ClearMetadata() *SubscriptionQuantityChangeUpdateOne {
+	sqcuo.mutation.ClearMetadata()
+	return sqcuo
+}
+
+// Mutation returns the SubscriptionQuantityChangeMutation object of the builder.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) Mutation() *SubscriptionQuantityChangeMutation {
+	return sqcuo.mutation
+}
+
+// Where appends a list predicates to the SubscriptionQuantityChangeUpdate builder.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) Where(ps ...predicate.SubscriptionQuantityChange) *SubscriptionQuantityChangeUpdateOne {
+	sqcuo.mutation.Where(ps...)
+	return sqcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) Select(field string, fields ...string) *SubscriptionQuantityChangeUpdateOne {
+	sqcuo.fields = append([]string{field}, fields...)
+	return sqcuo
+}
+
+// Save executes the query and returns the updated SubscriptionQuantityChange entity.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) Save(ctx context.Context) (*SubscriptionQuantityChange, error) {
+	sqcuo.defaults()
+	return withHooks(ctx, sqcuo.sqlSave, sqcuo.mutation, sqcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) SaveX(ctx context.Context) *SubscriptionQuantityChange {
+	node, err := sqcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := sqcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := sqcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) defaults() {
+	if _, ok := sqcuo.mutation.UpdatedAt(); !ok {
+		v := subscriptionquantitychange.UpdateDefaultUpdatedAt()
+		sqcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) check() error {
+	if sqcuo.mutation.SubscriptionCleared() && len(sqcuo.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SubscriptionQuantityChange.subscription"`)
+	}
+	return nil
+}
+
+func (sqcuo *SubscriptionQuantityChangeUpdateOne) sqlSave(ctx context.Context) (_node *SubscriptionQuantityChange, err error) {
+	if err := sqcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionquantitychange.Table, subscriptionquantitychange.Columns, sqlgraph.NewFieldSpec(subscriptionquantitychange.FieldID, field.TypeString))
+	id, ok := sqcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SubscriptionQuantityChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sqcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionquantitychange.FieldID)
+		for _, f := range fields {
+			if !subscriptionquantitychange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscriptionquantitychange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sqcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sqcuo.mutation.Status(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sqcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sqcuo.mutation.CreatedByCleared() {
+		_spec.ClearField(subscriptionquantitychange.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := sqcuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldUpdatedBy, field.TypeString, value)
+	}
+	if sqcuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscriptionquantitychange.FieldUpdatedBy, field.TypeString)
+	}
+	if sqcuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(subscriptionquantitychange.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := sqcuo.mutation.CreditNoteID(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldCreditNoteID, field.TypeString, value)
+	}
+	if sqcuo.mutation.CreditNoteIDCleared() {
+		_spec.ClearField(subscriptionquantitychange.FieldCreditNoteID, field.TypeString)
+	}
+	if value, ok := sqcuo.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionquantitychange.FieldMetadata, field.TypeJSON, value)
+	}
+	if sqcuo.mutation.MetadataCleared() {
+		_spec.ClearField(subscriptionquantitychange.FieldMetadata, field.TypeJSON)
+	}
+	_node = &SubscriptionQuantityChange{config: sqcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sqcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionquantitychange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sqcuo.mutation.done = true
+	return _node, nil
+}
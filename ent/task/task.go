@@ -115,8 +115,8 @@ var (
 	TaskTypeValidator func(string) error
 	// EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
 	EntityTypeValidator func(string) error
-	// FileURLValidator is a validator for the "file_url" field. It is called by the builders before save.
-	FileURLValidator func(string) error
+	// DefaultFileURL holds the default value on creation for the "file_url" field.
+	DefaultFileURL string
 	// FileTypeValidator is a validator for the "file_type" field. It is called by the builders before save.
 	FileTypeValidator func(string) error
 	// DefaultTaskStatus holds the default value on creation for the "task_status" field.
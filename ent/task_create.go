@@ -128,6 +128,14 @@ func (tc *TaskCreate) SetFileURL(s string) *TaskCreate {
 	return tc
 }
 
+// SetNillableFileURL sets the "file_url" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableFileURL(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetFileURL(*s)
+	}
+	return tc
+}
+
 // SetFileName sets the "file_name" field.
 func (tc *TaskCreate) SetFileName(s string) *TaskCreate {
 	tc.mutation.SetFileName(s)
@@ -337,6 +345,10 @@ func (tc *TaskCreate) defaults() {
 		v := task.DefaultEnvironmentID
 		tc.mutation.SetEnvironmentID(v)
 	}
+	if _, ok := tc.mutation.FileURL(); !ok {
+		v := task.DefaultFileURL
+		tc.mutation.SetFileURL(v)
+	}
 	if _, ok := tc.mutation.TaskStatus(); !ok {
 		v := task.DefaultTaskStatus
 		tc.mutation.SetTaskStatus(v)
@@ -393,11 +405,6 @@ func (tc *TaskCreate) check() error {
 	if _, ok := tc.mutation.FileURL(); !ok {
 		return &ValidationError{Name: "file_url", err: errors.New(`ent: missing required field "Task.file_url"`)}
 	}
-	if v, ok := tc.mutation.FileURL(); ok {
-		if err := task.FileURLValidator(v); err != nil {
-			return &ValidationError{Name: "file_url", err: fmt.Errorf(`ent: validator failed for field "Task.file_url": %w`, err)}
-		}
-	}
 	if _, ok := tc.mutation.FileType(); !ok {
 		return &ValidationError{Name: "file_type", err: errors.New(`ent: missing required field "Task.file_type"`)}
 	}
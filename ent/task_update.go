@@ -393,11 +393,6 @@ func (tu *TaskUpdate) check() error {
 			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "Task.entity_type": %w`, err)}
 		}
 	}
-	if v, ok := tu.mutation.FileURL(); ok {
-		if err := task.FileURLValidator(v); err != nil {
-			return &ValidationError{Name: "file_url", err: fmt.Errorf(`ent: validator failed for field "Task.file_url": %w`, err)}
-		}
-	}
 	if v, ok := tu.mutation.FileType(); ok {
 		if err := task.FileTypeValidator(v); err != nil {
 			return &ValidationError{Name: "file_type", err: fmt.Errorf(`ent: validator failed for field "Task.file_type": %w`, err)}
@@ -912,11 +907,6 @@ func (tuo *TaskUpdateOne) check() error {
 			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "Task.entity_type": %w`, err)}
 		}
 	}
-	if v, ok := tuo.mutation.FileURL(); ok {
-		if err := task.FileURLValidator(v); err != nil {
-			return &ValidationError{Name: "file_url", err: fmt.Errorf(`ent: validator failed for field "Task.file_url": %w`, err)}
-		}
-	}
 	if v, ok := tuo.mutation.FileType(); ok {
 		if err := task.FileTypeValidator(v); err != nil {
 			return &ValidationError{Name: "file_type", err: fmt.Errorf(`ent: validator failed for field "Task.file_type": %w`, err)}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/taxrate"
+)
+
+// TaxRateDelete is the builder for deleting a TaxRate entity.
+type TaxRateDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaxRateMutation
+}
+
+// Where appends a list predicates to the TaxRateDelete builder.
+func (trd *TaxRateDelete) Where(ps ...predicate.TaxRate) *TaxRateDelete {
+	trd.mutation.Where(ps...)
+	return trd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (trd *TaxRateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, trd.sqlExec, trd.mutation, trd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trd *TaxRateDelete) ExecX(ctx context.Context) int {
+	n, err := trd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (trd *TaxRateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taxrate.Table, sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeString))
+	if ps := trd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, trd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	trd.mutation.done = true
+	return affected, err
+}
+
+// TaxRateDeleteOne is the builder for deleting a single TaxRate entity.
+type TaxRateDeleteOne struct {
+	trd *TaxRateDelete
+}
+
+// Where appends a list predicates to the TaxRateDelete builder.
+func (trdo *TaxRateDeleteOne) Where(ps ...predicate.TaxRate) *TaxRateDeleteOne {
+	trdo.trd.mutation.Where(ps...)
+	return trdo
+}
+
+// Exec executes the deletion query.
+func (trdo *TaxRateDeleteOne) Exec(ctx context.Context) error {
+	n, err := trdo.trd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taxrate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trdo *TaxRateDeleteOne) ExecX(ctx context.Context) {
+	if err := trdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
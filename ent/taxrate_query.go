@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/taxrate"
+)
+
+// TaxRateQuery is the builder for querying TaxRate entities.
+type TaxRateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []taxrate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TaxRate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaxRateQuery builder.
+func (trq *TaxRateQuery) Where(ps ...predicate.TaxRate) *TaxRateQuery {
+	trq.predicates = append(trq.predicates, ps...)
+	return trq
+}
+
+// Limit the number of records to be returned by this query.
+func (trq *TaxRateQuery) Limit(limit int) *TaxRateQuery {
+	trq.ctx.Limit = &limit
+	return trq
+}
+
+// Offset to start from.
+func (trq *TaxRateQuery) Offset(offset int) *TaxRateQuery {
+	trq.ctx.Offset = &offset
+	return trq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (trq *TaxRateQuery) Unique(unique bool) *TaxRateQuery {
+	trq.ctx.Unique = &unique
+	return trq
+}
+
+// Order specifies how the records should be ordered.
+func (trq *TaxRateQuery) Order(o ...taxrate.OrderOption) *TaxRateQuery {
+	trq.order = append(trq.order, o...)
+	return trq
+}
+
+// First returns the first TaxRate entity from the query.
+// Returns a *NotFoundError when no TaxRate was found.
+func (trq *TaxRateQuery) First(ctx context.Context) (*TaxRate, error) {
+	nodes, err := trq.Limit(1).All(setContextOp(ctx, trq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taxrate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (trq *TaxRateQuery) FirstX(ctx context.Context) *TaxRate {
+	node, err := trq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaxRate ID from the query.
+// Returns a *NotFoundError when no TaxRate ID was found.
+func (trq *TaxRateQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = trq.Limit(1).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taxrate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (trq *TaxRateQuery) FirstIDX(ctx context.Context) string {
+	id, err := trq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaxRate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaxRate entity is found.
+// Returns a *NotFoundError when no TaxRate entities are found.
+func (trq *TaxRateQuery) Only(ctx context.Context) (*TaxRate, error) {
+	nodes, err := trq.Limit(2).All(setContextOp(ctx, trq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taxrate.Label}
+	default:
+		return nil, &NotSingularError{taxrate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (trq *TaxRateQuery) OnlyX(ctx context.Context) *TaxRate {
+	node, err := trq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaxRate ID in the query.
+// Returns a *NotSingularError when more than one TaxRate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (trq *TaxRateQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = trq.Limit(2).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taxrate.Label}
+	default:
+		err = &NotSingularError{taxrate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (trq *TaxRateQuery) OnlyIDX(ctx context.Context) string {
+	id, err := trq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaxRates.
+func (trq *TaxRateQuery) All(ctx context.Context) ([]*TaxRate, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryAll)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaxRate, *TaxRateQuery]()
+	return withInterceptors[[]*TaxRate](ctx, trq, qr, trq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (trq *TaxRateQuery) AllX(ctx context.Context) []*TaxRate {
+	nodes, err := trq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaxRate IDs.
+func (trq *TaxRateQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if trq.ctx.Unique == nil && trq.path != nil {
+		trq.Unique(true)
+	}
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryIDs)
+	if err = trq.Select(taxrate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (trq *TaxRateQuery) IDsX(ctx context.Context) []string {
+	ids, err := trq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (trq *TaxRateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryCount)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, trq, querierCount[*TaxRateQuery](), trq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (trq *TaxRateQuery) CountX(ctx context.Context) int {
+	count, err := trq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (trq *TaxRateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryExist)
+	switch _, err := trq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (trq *TaxRateQuery) ExistX(ctx context.Context) bool {
+	exist, err := trq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaxRateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (trq *TaxRateQuery) Clone() *TaxRateQuery {
+	if trq == nil {
+		return nil
+	}
+	return &TaxRateQuery{
+		config:     trq.config,
+		ctx:        trq.ctx.Clone(),
+		order:      append([]taxrate.OrderOption{}, trq.order...),
+		inters:     append([]Interceptor{}, trq.inters...),
+		predicates: append([]predicate.TaxRate{}, trq.predicates...),
+		// clone intermediate query.
+		sql:  trq.sql.Clone(),
+		path: trq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaxRate.Query().
+//		GroupBy(taxrate.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (trq *TaxRateQuery) GroupBy(field string, fields ...string) *TaxRateGroupBy {
+	trq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaxRateGroupBy{build: trq}
+	grbuild.flds = &trq.ctx.Fields
+	grbuild.label = taxrate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.TaxRate.Query().
+//		Select(taxrate.FieldTenantID).
+//		Scan(ctx, &v)
+func (trq *TaxRateQuery) Select(fields ...string) *TaxRateSelect {
+	trq.ctx.Fields = append(trq.ctx.Fields, fields...)
+	sbuild := &TaxRateSelect{TaxRateQuery: trq}
+	sbuild.label = taxrate.Label
+	sbuild.flds, sbuild.scan = &trq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaxRateSelect configured with the given aggregations.
+func (trq *TaxRateQuery) Aggregate(fns ...AggregateFunc) *TaxRateSelect {
+	return trq.Select().Aggregate(fns...)
+}
+
+func (trq *TaxRateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range trq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, trq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range trq.ctx.Fields {
+		if !taxrate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if trq.path != nil {
+		prev, err := trq.path(ctx)
+		if err != nil {
+			return err
+		}
+		trq.sql = prev
+	}
+	return nil
+}
+
+func (trq *TaxRateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaxRate, error) {
+	var (
+		nodes = []*TaxRate{}
+		_spec = trq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaxRate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaxRate{config: trq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, trq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (trq *TaxRateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := trq.querySpec()
+	_spec.Node.Columns = trq.ctx.Fields
+	if len(trq.ctx.Fields) > 0 {
+		_spec.Unique = trq.ctx.Unique != nil && *trq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, trq.driver, _spec)
+}
+
+func (trq *TaxRateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taxrate.Table, taxrate.Columns, sqlgraph.NewFieldSpec(taxrate.FieldID, field.TypeString))
+	_spec.From = trq.sql
+	if unique := trq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if trq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := trq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taxrate.FieldID)
+		for i := range fields {
+			if fields[i] != taxrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := trq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := trq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (trq *TaxRateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(trq.driver.Dialect())
+	t1 := builder.Table(taxrate.Table)
+	columns := trq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taxrate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if trq.sql != nil {
+		selector = trq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if trq.ctx.Unique != nil && *trq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range trq.predicates {
+		p(selector)
+	}
+	for _, p := range trq.order {
+		p(selector)
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TaxRateGroupBy is the group-by builder for TaxRate entities.
+type TaxRateGroupBy struct {
+	selector
+	build *TaxRateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (trgb *TaxRateGroupBy) Aggregate(fns ...AggregateFunc) *TaxRateGroupBy {
+	trgb.fns = append(trgb.fns, fns...)
+	return trgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trgb *TaxRateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trgb.build.ctx, ent.OpQueryGroupBy)
+	if err := trgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaxRateQuery, *TaxRateGroupBy](ctx, trgb.build, trgb, trgb.build.inters, v)
+}
+
+func (trgb *TaxRateGroupBy) sqlScan(ctx context.Context, root *TaxRateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(trgb.fns))
+	for _, fn := range trgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*trgb.flds)+len(trgb.fns))
+		for _, f := range *trgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*trgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaxRateSelect is the builder for selecting fields of TaxRate entities.
+type TaxRateSelect struct {
+	*TaxRateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (trs *TaxRateSelect) Aggregate(fns ...AggregateFunc) *TaxRateSelect {
+	trs.fns = append(trs.fns, fns...)
+	return trs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trs *TaxRateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trs.ctx, ent.OpQuerySelect)
+	if err := trs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaxRateQuery, *TaxRateSelect](ctx, trs.TaxRateQuery, trs, trs.inters, v)
+}
+
+func (trs *TaxRateSelect) sqlScan(ctx context.Context, root *TaxRateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(trs.fns))
+	for _, fn := range trs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*trs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
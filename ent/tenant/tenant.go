@@ -24,6 +24,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldBillingDetails holds the string denoting the billing_details field in the database.
 	FieldBillingDetails = "billing_details"
+	// FieldDunningPolicy holds the string denoting the dunning_policy field in the database.
+	FieldDunningPolicy = "dunning_policy"
 	// Table holds the table name of the tenant in the database.
 	Table = "tenants"
 )
@@ -36,6 +38,7 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldBillingDetails,
+	FieldDunningPolicy,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -61,6 +64,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultBillingDetails holds the default value on creation for the "billing_details" field.
 	DefaultBillingDetails schema.TenantBillingDetails
+	// DefaultDunningPolicy holds the default value on creation for the "dunning_policy" field.
+	DefaultDunningPolicy schema.TenantDunningPolicy
 )
 
 // OrderOption defines the ordering options for the Tenant queries.
@@ -304,6 +304,16 @@ func BillingDetailsNotNil() predicate.Tenant {
 	return predicate.Tenant(sql.FieldNotNull(FieldBillingDetails))
 }
 
+// DunningPolicyIsNil applies the IsNil predicate on the "dunning_policy" field.
+func DunningPolicyIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldDunningPolicy))
+}
+
+// DunningPolicyNotNil applies the NotNil predicate on the "dunning_policy" field.
+func DunningPolicyNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldDunningPolicy))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Tenant) predicate.Tenant {
 	return predicate.Tenant(sql.AndPredicates(predicates...))
@@ -83,6 +83,20 @@ func (tc *TenantCreate) SetNillableBillingDetails(sbd *schema.TenantBillingDetai
 	return tc
 }
 
+// SetDunningPolicy sets the "dunning_policy" field.
+func (tc *TenantCreate) SetDunningPolicy(sdp schema.TenantDunningPolicy) *TenantCreate {
+	tc.mutation.SetDunningPolicy(sdp)
+	return tc
+}
+
+// SetNillableDunningPolicy sets the "dunning_policy" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableDunningPolicy(sdp *schema.TenantDunningPolicy) *TenantCreate {
+	if sdp != nil {
+		tc.SetDunningPolicy(*sdp)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TenantCreate) SetID(s string) *TenantCreate {
 	tc.mutation.SetID(s)
@@ -140,6 +154,10 @@ func (tc *TenantCreate) defaults() {
 		v := tenant.DefaultBillingDetails
 		tc.mutation.SetBillingDetails(v)
 	}
+	if _, ok := tc.mutation.DunningPolicy(); !ok {
+		v := tenant.DefaultDunningPolicy
+		tc.mutation.SetDunningPolicy(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -216,6 +234,10 @@ func (tc *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldBillingDetails, field.TypeJSON, value)
 		_node.BillingDetails = value
 	}
+	if value, ok := tc.mutation.DunningPolicy(); ok {
+		_spec.SetField(tenant.FieldDunningPolicy, field.TypeJSON, value)
+		_node.DunningPolicy = value
+	}
 	return _node, _spec
 }
 
@@ -83,6 +83,26 @@ func (tu *TenantUpdate) ClearBillingDetails() *TenantUpdate {
 	return tu
 }
 
+// SetDunningPolicy sets the "dunning_policy" field.
+func (tu *TenantUpdate) SetDunningPolicy(sdp schema.TenantDunningPolicy) *TenantUpdate {
+	tu.mutation.SetDunningPolicy(sdp)
+	return tu
+}
+
+// SetNillableDunningPolicy sets the "dunning_policy" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableDunningPolicy(sdp *schema.TenantDunningPolicy) *TenantUpdate {
+	if sdp != nil {
+		tu.SetDunningPolicy(*sdp)
+	}
+	return tu
+}
+
+// ClearDunningPolicy clears the value of the "dunning_policy" field.
+func (tu *TenantUpdate) ClearDunningPolicy() *TenantUpdate {
+	tu.mutation.ClearDunningPolicy()
+	return tu
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (tu *TenantUpdate) Mutation() *TenantMutation {
 	return tu.mutation
@@ -161,6 +181,12 @@ func (tu *TenantUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.BillingDetailsCleared() {
 		_spec.ClearField(tenant.FieldBillingDetails, field.TypeJSON)
 	}
+	if value, ok := tu.mutation.DunningPolicy(); ok {
+		_spec.SetField(tenant.FieldDunningPolicy, field.TypeJSON, value)
+	}
+	if tu.mutation.DunningPolicyCleared() {
+		_spec.ClearField(tenant.FieldDunningPolicy, field.TypeJSON)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tenant.Label}
@@ -235,6 +261,26 @@ func (tuo *TenantUpdateOne) ClearBillingDetails() *TenantUpdateOne {
 	return tuo
 }
 
+// SetDunningPolicy sets the "dunning_policy" field.
+func (tuo *TenantUpdateOne) SetDunningPolicy(sdp schema.TenantDunningPolicy) *TenantUpdateOne {
+	tuo.mutation.SetDunningPolicy(sdp)
+	return tuo
+}
+
+// SetNillableDunningPolicy sets the "dunning_policy" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableDunningPolicy(sdp *schema.TenantDunningPolicy) *TenantUpdateOne {
+	if sdp != nil {
+		tuo.SetDunningPolicy(*sdp)
+	}
+	return tuo
+}
+
+// ClearDunningPolicy clears the value of the "dunning_policy" field.
+func (tuo *TenantUpdateOne) ClearDunningPolicy() *TenantUpdateOne {
+	tuo.mutation.ClearDunningPolicy()
+	return tuo
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (tuo *TenantUpdateOne) Mutation() *TenantMutation {
 	return tuo.mutation
@@ -343,6 +389,12 @@ func (tuo *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err err
 	if tuo.mutation.BillingDetailsCleared() {
 		_spec.ClearField(tenant.FieldBillingDetails, field.TypeJSON)
 	}
+	if value, ok := tuo.mutation.DunningPolicy(); ok {
+		_spec.SetField(tenant.FieldDunningPolicy, field.TypeJSON, value)
+	}
+	if tuo.mutation.DunningPolicyCleared() {
+		_spec.ClearField(tenant.FieldDunningPolicy, field.TypeJSON)
+	}
 	_node = &Tenant{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -56,6 +56,12 @@ type Wallet struct {
 	AutoTopupMinBalance *decimal.Decimal `json:"auto_topup_min_balance,omitempty"`
 	// AutoTopupAmount holds the value of the "auto_topup_amount" field.
 	AutoTopupAmount *decimal.Decimal `json:"auto_topup_amount,omitempty"`
+	// AutoTopupMethod holds the value of the "auto_topup_method" field.
+	AutoTopupMethod string `json:"auto_topup_method,omitempty"`
+	// AutoTopupPaymentMethodType holds the value of the "auto_topup_payment_method_type" field.
+	AutoTopupPaymentMethodType *string `json:"auto_topup_payment_method_type,omitempty"`
+	// AutoTopupPaymentMethodID holds the value of the "auto_topup_payment_method_id" field.
+	AutoTopupPaymentMethodID *string `json:"auto_topup_payment_method_id,omitempty"`
 	// WalletType holds the value of the "wallet_type" field.
 	WalletType string `json:"wallet_type,omitempty"`
 	// ConversionRate holds the value of the "conversion_rate" field.
@@ -76,7 +82,7 @@ func (*Wallet) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case wallet.FieldBalance, wallet.FieldCreditBalance, wallet.FieldConversionRate:
 			values[i] = new(decimal.Decimal)
-		case wallet.FieldID, wallet.FieldTenantID, wallet.FieldStatus, wallet.FieldCreatedBy, wallet.FieldUpdatedBy, wallet.FieldEnvironmentID, wallet.FieldName, wallet.FieldCustomerID, wallet.FieldCurrency, wallet.FieldDescription, wallet.FieldWalletStatus, wallet.FieldAutoTopupTrigger, wallet.FieldWalletType:
+		case wallet.FieldID, wallet.FieldTenantID, wallet.FieldStatus, wallet.FieldCreatedBy, wallet.FieldUpdatedBy, wallet.FieldEnvironmentID, wallet.FieldName, wallet.FieldCustomerID, wallet.FieldCurrency, wallet.FieldDescription, wallet.FieldWalletStatus, wallet.FieldAutoTopupTrigger, wallet.FieldAutoTopupMethod, wallet.FieldAutoTopupPaymentMethodType, wallet.FieldAutoTopupPaymentMethodID, wallet.FieldWalletType:
 			values[i] = new(sql.NullString)
 		case wallet.FieldCreatedAt, wallet.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -214,6 +220,26 @@ func (w *Wallet) assignValues(columns []string, values []any) error {
 				w.AutoTopupAmount = new(decimal.Decimal)
 				*w.AutoTopupAmount = *value.S.(*decimal.Decimal)
 			}
+		case wallet.FieldAutoTopupMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_topup_method", values[i])
+			} else if value.Valid {
+				w.AutoTopupMethod = value.String
+			}
+		case wallet.FieldAutoTopupPaymentMethodType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_topup_payment_method_type", values[i])
+			} else if value.Valid {
+				w.AutoTopupPaymentMethodType = new(string)
+				*w.AutoTopupPaymentMethodType = value.String
+			}
+		case wallet.FieldAutoTopupPaymentMethodID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_topup_payment_method_id", values[i])
+			} else if value.Valid {
+				w.AutoTopupPaymentMethodID = new(string)
+				*w.AutoTopupPaymentMethodID = value.String
+			}
 		case wallet.FieldWalletType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field wallet_type", values[i])
@@ -330,6 +356,19 @@ func (w *Wallet) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("auto_topup_method=")
+	builder.WriteString(w.AutoTopupMethod)
+	builder.WriteString(", ")
+	if v := w.AutoTopupPaymentMethodType; v != nil {
+		builder.WriteString("auto_topup_payment_method_type=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := w.AutoTopupPaymentMethodID; v != nil {
+		builder.WriteString("auto_topup_payment_method_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("wallet_type=")
 	builder.WriteString(w.WalletType)
 	builder.WriteString(", ")
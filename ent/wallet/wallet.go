@@ -50,6 +50,12 @@ const (
 	FieldAutoTopupMinBalance = "auto_topup_min_balance"
 	// FieldAutoTopupAmount holds the string denoting the auto_topup_amount field in the database.
 	FieldAutoTopupAmount = "auto_topup_amount"
+	// FieldAutoTopupMethod holds the string denoting the auto_topup_method field in the database.
+	FieldAutoTopupMethod = "auto_topup_method"
+	// FieldAutoTopupPaymentMethodType holds the string denoting the auto_topup_payment_method_type field in the database.
+	FieldAutoTopupPaymentMethodType = "auto_topup_payment_method_type"
+	// FieldAutoTopupPaymentMethodID holds the string denoting the auto_topup_payment_method_id field in the database.
+	FieldAutoTopupPaymentMethodID = "auto_topup_payment_method_id"
 	// FieldWalletType holds the string denoting the wallet_type field in the database.
 	FieldWalletType = "wallet_type"
 	// FieldConversionRate holds the string denoting the conversion_rate field in the database.
@@ -81,6 +87,9 @@ var Columns = []string{
 	FieldAutoTopupTrigger,
 	FieldAutoTopupMinBalance,
 	FieldAutoTopupAmount,
+	FieldAutoTopupMethod,
+	FieldAutoTopupPaymentMethodType,
+	FieldAutoTopupPaymentMethodID,
 	FieldWalletType,
 	FieldConversionRate,
 	FieldConfig,
@@ -119,6 +128,8 @@ var (
 	DefaultWalletStatus string
 	// DefaultAutoTopupTrigger holds the default value on creation for the "auto_topup_trigger" field.
 	DefaultAutoTopupTrigger string
+	// DefaultAutoTopupMethod holds the default value on creation for the "auto_topup_method" field.
+	DefaultAutoTopupMethod string
 	// DefaultWalletType holds the default value on creation for the "wallet_type" field.
 	DefaultWalletType string
 )
@@ -216,6 +227,21 @@ func ByAutoTopupAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAutoTopupAmount, opts...).ToFunc()
 }
 
+// ByAutoTopupMethod orders the results by the auto_topup_method field.
+func ByAutoTopupMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoTopupMethod, opts...).ToFunc()
+}
+
+// ByAutoTopupPaymentMethodType orders the results by the auto_topup_payment_method_type field.
+func ByAutoTopupPaymentMethodType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoTopupPaymentMethodType, opts...).ToFunc()
+}
+
+// ByAutoTopupPaymentMethodID orders the results by the auto_topup_payment_method_id field.
+func ByAutoTopupPaymentMethodID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoTopupPaymentMethodID, opts...).ToFunc()
+}
+
 // ByWalletType orders the results by the wallet_type field.
 func ByWalletType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWalletType, opts...).ToFunc()
@@ -150,6 +150,21 @@ func AutoTopupAmount(v decimal.Decimal) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldAutoTopupAmount, v))
 }
 
+// AutoTopupMethod applies equality check predicate on the "auto_topup_method" field. It's identical to AutoTopupMethodEQ.
+func AutoTopupMethod(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupPaymentMethodType applies equality check predicate on the "auto_topup_payment_method_type" field. It's identical to AutoTopupPaymentMethodTypeEQ.
+func AutoTopupPaymentMethodType(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodID applies equality check predicate on the "auto_topup_payment_method_id" field. It's identical to AutoTopupPaymentMethodIDEQ.
+func AutoTopupPaymentMethodID(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldAutoTopupPaymentMethodID, v))
+}
+
 // WalletType applies equality check predicate on the "wallet_type" field. It's identical to WalletTypeEQ.
 func WalletType(v string) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldWalletType, v))
@@ -1205,6 +1220,221 @@ func AutoTopupAmountNotNil() predicate.Wallet {
 	return predicate.Wallet(sql.FieldNotNull(FieldAutoTopupAmount))
 }
 
+// AutoTopupMethodEQ applies the EQ predicate on the "auto_topup_method" field.
+func AutoTopupMethodEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupMethodNEQ applies the NEQ predicate on the "auto_topup_method" field.
+func AutoTopupMethodNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupMethodIn applies the In predicate on the "auto_topup_method" field.
+func AutoTopupMethodIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldAutoTopupMethod, vs...))
+}
+
+// AutoTopupMethodNotIn applies the NotIn predicate on the "auto_topup_method" field.
+func AutoTopupMethodNotIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldAutoTopupMethod, vs...))
+}
+
+// AutoTopupMethodGT applies the GT predicate on the "auto_topup_method" field.
+func AutoTopupMethodGT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupMethodGTE applies the GTE predicate on the "auto_topup_method" field.
+func AutoTopupMethodGTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupMethodLT applies the LT predicate on the "auto_topup_method" field.
+func AutoTopupMethodLT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupMethodLTE applies the LTE predicate on the "auto_topup_method" field.
+func AutoTopupMethodLTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupMethodContains applies the Contains predicate on the "auto_topup_method" field.
+func AutoTopupMethodContains(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContains(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupMethodHasPrefix applies the HasPrefix predicate on the "auto_topup_method" field.
+func AutoTopupMethodHasPrefix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasPrefix(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupMethodHasSuffix applies the HasSuffix predicate on the "auto_topup_method" field.
+func AutoTopupMethodHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasSuffix(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupMethodEqualFold applies the EqualFold predicate on the "auto_topup_method" field.
+func AutoTopupMethodEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEqualFold(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupMethodContainsFold applies the ContainsFold predicate on the "auto_topup_method" field.
+func AutoTopupMethodContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContainsFold(FieldAutoTopupMethod, v))
+}
+
+// AutoTopupPaymentMethodTypeEQ applies the EQ predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodTypeNEQ applies the NEQ predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodTypeIn applies the In predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldAutoTopupPaymentMethodType, vs...))
+}
+
+// AutoTopupPaymentMethodTypeNotIn applies the NotIn predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeNotIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldAutoTopupPaymentMethodType, vs...))
+}
+
+// AutoTopupPaymentMethodTypeGT applies the GT predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeGT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodTypeGTE applies the GTE predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeGTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodTypeLT applies the LT predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeLT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodTypeLTE applies the LTE predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeLTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodTypeContains applies the Contains predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeContains(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContains(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodTypeHasPrefix applies the HasPrefix predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeHasPrefix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasPrefix(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodTypeHasSuffix applies the HasSuffix predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasSuffix(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodTypeIsNil applies the IsNil predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldAutoTopupPaymentMethodType))
+}
+
+// AutoTopupPaymentMethodTypeNotNil applies the NotNil predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldAutoTopupPaymentMethodType))
+}
+
+// AutoTopupPaymentMethodTypeEqualFold applies the EqualFold predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEqualFold(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodTypeContainsFold applies the ContainsFold predicate on the "auto_topup_payment_method_type" field.
+func AutoTopupPaymentMethodTypeContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContainsFold(FieldAutoTopupPaymentMethodType, v))
+}
+
+// AutoTopupPaymentMethodIDEQ applies the EQ predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldAutoTopupPaymentMethodID, v))
+}
+
+// AutoTopupPaymentMethodIDNEQ applies the NEQ predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldAutoTopupPaymentMethodID, v))
+}
+
+// AutoTopupPaymentMethodIDIn applies the In predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldAutoTopupPaymentMethodID, vs...))
+}
+
+// AutoTopupPaymentMethodIDNotIn applies the NotIn predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDNotIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldAutoTopupPaymentMethodID, vs...))
+}
+
+// AutoTopupPaymentMethodIDGT applies the GT predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDGT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldAutoTopupPaymentMethodID, v))
+}
+
+// AutoTopupPaymentMethodIDGTE applies the GTE predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDGTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldAutoTopupPaymentMethodID, v))
+}
+
+// AutoTopupPaymentMethodIDLT applies the LT predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDLT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldAutoTopupPaymentMethodID, v))
+}
+
+// AutoTopupPaymentMethodIDLTE applies the LTE predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDLTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldAutoTopupPaymentMethodID, v))
+}
+
+// AutoTopupPaymentMethodIDContains applies the Contains predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDContains(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContains(FieldAutoTopupPaymentMethodID, v))
+}
+
+// AutoTopupPaymentMethodIDHasPrefix applies the HasPrefix predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDHasPrefix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasPrefix(FieldAutoTopupPaymentMethodID, v))
+}
+
+// AutoTopupPaymentMethodIDHasSuffix applies the HasSuffix predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasSuffix(FieldAutoTopupPaymentMethodID, v))
+}
+
+// AutoTopupPaymentMethodIDIsNil applies the IsNil predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldAutoTopupPaymentMethodID))
+}
+
+// AutoTopupPaymentMethodIDNotNil applies the NotNil predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldAutoTopupPaymentMethodID))
+}
+
+// AutoTopupPaymentMethodIDEqualFold applies the EqualFold predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEqualFold(FieldAutoTopupPaymentMethodID, v))
+}
+
+// AutoTopupPaymentMethodIDContainsFold applies the ContainsFold predicate on the "auto_topup_payment_method_id" field.
+func AutoTopupPaymentMethodIDContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContainsFold(FieldAutoTopupPaymentMethodID, v))
+}
+
 // WalletTypeEQ applies the EQ predicate on the "wallet_type" field.
 func WalletTypeEQ(v string) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldWalletType, v))
@@ -234,6 +234,48 @@ func (wc *WalletCreate) SetNillableAutoTopupAmount(d *decimal.Decimal) *WalletCr
 	return wc
 }
 
+// SetAutoTopupMethod sets the "auto_topup_method" field.
+func (wc *WalletCreate) SetAutoTopupMethod(s string) *WalletCreate {
+	wc.mutation.SetAutoTopupMethod(s)
+	return wc
+}
+
+// SetNillableAutoTopupMethod sets the "auto_topup_method" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableAutoTopupMethod(s *string) *WalletCreate {
+	if s != nil {
+		wc.SetAutoTopupMethod(*s)
+	}
+	return wc
+}
+
+// SetAutoTopupPaymentMethodType sets the "auto_topup_payment_method_type" field.
+func (wc *WalletCreate) SetAutoTopupPaymentMethodType(s string) *WalletCreate {
+	wc.mutation.SetAutoTopupPaymentMethodType(s)
+	return wc
+}
+
+// SetNillableAutoTopupPaymentMethodType sets the "auto_topup_payment_method_type" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableAutoTopupPaymentMethodType(s *string) *WalletCreate {
+	if s != nil {
+		wc.SetAutoTopupPaymentMethodType(*s)
+	}
+	return wc
+}
+
+// SetAutoTopupPaymentMethodID sets the "auto_topup_payment_method_id" field.
+func (wc *WalletCreate) SetAutoTopupPaymentMethodID(s string) *WalletCreate {
+	wc.mutation.SetAutoTopupPaymentMethodID(s)
+	return wc
+}
+
+// SetNillableAutoTopupPaymentMethodID sets the "auto_topup_payment_method_id" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableAutoTopupPaymentMethodID(s *string) *WalletCreate {
+	if s != nil {
+		wc.SetAutoTopupPaymentMethodID(*s)
+	}
+	return wc
+}
+
 // SetWalletType sets the "wallet_type" field.
 func (wc *WalletCreate) SetWalletType(s string) *WalletCreate {
 	wc.mutation.SetWalletType(s)
@@ -337,6 +379,10 @@ func (wc *WalletCreate) defaults() {
 		v := wallet.DefaultAutoTopupTrigger
 		wc.mutation.SetAutoTopupTrigger(v)
 	}
+	if _, ok := wc.mutation.AutoTopupMethod(); !ok {
+		v := wallet.DefaultAutoTopupMethod
+		wc.mutation.SetAutoTopupMethod(v)
+	}
 	if _, ok := wc.mutation.WalletType(); !ok {
 		v := wallet.DefaultWalletType
 		wc.mutation.SetWalletType(v)
@@ -387,6 +433,9 @@ func (wc *WalletCreate) check() error {
 	if _, ok := wc.mutation.WalletStatus(); !ok {
 		return &ValidationError{Name: "wallet_status", err: errors.New(`ent: missing required field "Wallet.wallet_status"`)}
 	}
+	if _, ok := wc.mutation.AutoTopupMethod(); !ok {
+		return &ValidationError{Name: "auto_topup_method", err: errors.New(`ent: missing required field "Wallet.auto_topup_method"`)}
+	}
 	if _, ok := wc.mutation.WalletType(); !ok {
 		return &ValidationError{Name: "wallet_type", err: errors.New(`ent: missing required field "Wallet.wallet_type"`)}
 	}
@@ -505,6 +554,18 @@ func (wc *WalletCreate) createSpec() (*Wallet, *sqlgraph.CreateSpec) {
 		_spec.SetField(wallet.FieldAutoTopupAmount, field.TypeOther, value)
 		_node.AutoTopupAmount = &value
 	}
+	if value, ok := wc.mutation.AutoTopupMethod(); ok {
+		_spec.SetField(wallet.FieldAutoTopupMethod, field.TypeString, value)
+		_node.AutoTopupMethod = value
+	}
+	if value, ok := wc.mutation.AutoTopupPaymentMethodType(); ok {
+		_spec.SetField(wallet.FieldAutoTopupPaymentMethodType, field.TypeString, value)
+		_node.AutoTopupPaymentMethodType = &value
+	}
+	if value, ok := wc.mutation.AutoTopupPaymentMethodID(); ok {
+		_spec.SetField(wallet.FieldAutoTopupPaymentMethodID, field.TypeString, value)
+		_node.AutoTopupPaymentMethodID = &value
+	}
 	if value, ok := wc.mutation.WalletType(); ok {
 		_spec.SetField(wallet.FieldWalletType, field.TypeString, value)
 		_node.WalletType = value
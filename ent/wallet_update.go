@@ -238,6 +238,60 @@ func (wu *WalletUpdate) ClearAutoTopupAmount() *WalletUpdate {
 	return wu
 }
 
+// SetAutoTopupMethod sets the "auto_topup_method" field.
+func (wu *WalletUpdate) SetAutoTopupMethod(s string) *WalletUpdate {
+	wu.mutation.SetAutoTopupMethod(s)
+	return wu
+}
+
+// SetNillableAutoTopupMethod sets the "auto_topup_method" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableAutoTopupMethod(s *string) *WalletUpdate {
+	if s != nil {
+		wu.SetAutoTopupMethod(*s)
+	}
+	return wu
+}
+
+// SetAutoTopupPaymentMethodType sets the "auto_topup_payment_method_type" field.
+func (wu *WalletUpdate) SetAutoTopupPaymentMethodType(s string) *WalletUpdate {
+	wu.mutation.SetAutoTopupPaymentMethodType(s)
+	return wu
+}
+
+// SetNillableAutoTopupPaymentMethodType sets the "auto_topup_payment_method_type" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableAutoTopupPaymentMethodType(s *string) *WalletUpdate {
+	if s != nil {
+		wu.SetAutoTopupPaymentMethodType(*s)
+	}
+	return wu
+}
+
+// ClearAutoTopupPaymentMethodType clears the value of the "auto_topup_payment_method_type" field.
+func (wu *WalletUpdate) ClearAutoTopupPaymentMethodType() *WalletUpdate {
+	wu.mutation.ClearAutoTopupPaymentMethodType()
+	return wu
+}
+
+// SetAutoTopupPaymentMethodID sets the "auto_topup_payment_method_id" field.
+func (wu *WalletUpdate) SetAutoTopupPaymentMethodID(s string) *WalletUpdate {
+	wu.mutation.SetAutoTopupPaymentMethodID(s)
+	return wu
+}
+
+// SetNillableAutoTopupPaymentMethodID sets the "auto_topup_payment_method_id" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableAutoTopupPaymentMethodID(s *string) *WalletUpdate {
+	if s != nil {
+		wu.SetAutoTopupPaymentMethodID(*s)
+	}
+	return wu
+}
+
+// ClearAutoTopupPaymentMethodID clears the value of the "auto_topup_payment_method_id" field.
+func (wu *WalletUpdate) ClearAutoTopupPaymentMethodID() *WalletUpdate {
+	wu.mutation.ClearAutoTopupPaymentMethodID()
+	return wu
+}
+
 // SetConfig sets the "config" field.
 func (wu *WalletUpdate) SetConfig(tc types.WalletConfig) *WalletUpdate {
 	wu.mutation.SetConfig(tc)
@@ -392,6 +446,21 @@ func (wu *WalletUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if wu.mutation.AutoTopupAmountCleared() {
 		_spec.ClearField(wallet.FieldAutoTopupAmount, field.TypeOther)
 	}
+	if value, ok := wu.mutation.AutoTopupMethod(); ok {
+		_spec.SetField(wallet.FieldAutoTopupMethod, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.AutoTopupPaymentMethodType(); ok {
+		_spec.SetField(wallet.FieldAutoTopupPaymentMethodType, field.TypeString, value)
+	}
+	if wu.mutation.AutoTopupPaymentMethodTypeCleared() {
+		_spec.ClearField(wallet.FieldAutoTopupPaymentMethodType, field.TypeString)
+	}
+	if value, ok := wu.mutation.AutoTopupPaymentMethodID(); ok {
+		_spec.SetField(wallet.FieldAutoTopupPaymentMethodID, field.TypeString, value)
+	}
+	if wu.mutation.AutoTopupPaymentMethodIDCleared() {
+		_spec.ClearField(wallet.FieldAutoTopupPaymentMethodID, field.TypeString)
+	}
 	if value, ok := wu.mutation.Config(); ok {
 		_spec.SetField(wallet.FieldConfig, field.TypeJSON, value)
 	}
@@ -626,6 +695,60 @@ func (wuo *WalletUpdateOne) ClearAutoTopupAmount() *WalletUpdateOne {
 	return wuo
 }
 
+// SetAutoTopupMethod sets the "auto_topup_method" field.
+func (wuo *WalletUpdateOne) SetAutoTopupMethod(s string) *WalletUpdateOne {
+	wuo.mutation.SetAutoTopupMethod(s)
+	return wuo
+}
+
+// SetNillableAutoTopupMethod sets the "auto_topup_method" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableAutoTopupMethod(s *string) *WalletUpdateOne {
+	if s != nil {
+		wuo.SetAutoTopupMethod(*s)
+	}
+	return wuo
+}
+
+// SetAutoTopupPaymentMethodType sets the "auto_topup_payment_method_type" field.
+func (wuo *WalletUpdateOne) SetAutoTopupPaymentMethodType(s string) *WalletUpdateOne {
+	wuo.mutation.SetAutoTopupPaymentMethodType(s)
+	return wuo
+}
+
+// SetNillableAutoTopupPaymentMethodType sets the "auto_topup_payment_method_type" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableAutoTopupPaymentMethodType(s *string) *WalletUpdateOne {
+	if s != nil {
+		wuo.SetAutoTopupPaymentMethodType(*s)
+	}
+	return wuo
+}
+
+// ClearAutoTopupPaymentMethodType clears the value of the "auto_topup_payment_method_type" field.
+func (wuo *WalletUpdateOne) ClearAutoTopupPaymentMethodType() *WalletUpdateOne {
+	wuo.mutation.ClearAutoTopupPaymentMethodType()
+	return wuo
+}
+
+// SetAutoTopupPaymentMethodID sets the "auto_topup_payment_method_id" field.
+func (wuo *WalletUpdateOne) SetAutoTopupPaymentMethodID(s string) *WalletUpdateOne {
+	wuo.mutation.SetAutoTopupPaymentMethodID(s)
+	return wuo
+}
+
+// SetNillableAutoTopupPaymentMethodID sets the "auto_topup_payment_method_id" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableAutoTopupPaymentMethodID(s *string) *WalletUpdateOne {
+	if s != nil {
+		wuo.SetAutoTopupPaymentMethodID(*s)
+	}
+	return wuo
+}
+
+// ClearAutoTopupPaymentMethodID clears the value of the "auto_topup_payment_method_id" field.
+func (wuo *WalletUpdateOne) ClearAutoTopupPaymentMethodID() *WalletUpdateOne {
+	wuo.mutation.ClearAutoTopupPaymentMethodID()
+	return wuo
+}
+
 // SetConfig sets the "config" field.
 func (wuo *WalletUpdateOne) SetConfig(tc types.WalletConfig) *WalletUpdateOne {
 	wuo.mutation.SetConfig(tc)
@@ -810,6 +933,21 @@ func (wuo *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err err
 	if wuo.mutation.AutoTopupAmountCleared() {
 		_spec.ClearField(wallet.FieldAutoTopupAmount, field.TypeOther)
 	}
+	if value, ok := wuo.mutation.AutoTopupMethod(); ok {
+		_spec.SetField(wallet.FieldAutoTopupMethod, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.AutoTopupPaymentMethodType(); ok {
+		_spec.SetField(wallet.FieldAutoTopupPaymentMethodType, field.TypeString, value)
+	}
+	if wuo.mutation.AutoTopupPaymentMethodTypeCleared() {
+		_spec.ClearField(wallet.FieldAutoTopupPaymentMethodType, field.TypeString)
+	}
+	if value, ok := wuo.mutation.AutoTopupPaymentMethodID(); ok {
+		_spec.SetField(wallet.FieldAutoTopupPaymentMethodID, field.TypeString, value)
+	}
+	if wuo.mutation.AutoTopupPaymentMethodIDCleared() {
+		_spec.ClearField(wallet.FieldAutoTopupPaymentMethodID, field.TypeString)
+	}
 	if value, ok := wuo.mutation.Config(); ok {
 		_spec.SetField(wallet.FieldConfig, field.TypeJSON, value)
 	}
@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/webhookdelivery"
+)
+
+// WebhookDeliveryCreate is the builder for creating a WebhookDelivery entity.
+type WebhookDeliveryCreate struct {
+	config
+	mutation *WebhookDeliveryMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (wdc *WebhookDeliveryCreate) SetTenantID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetTenantID(s)
+	return wdc
+}
+
+// SetStatus sets the "status" field.
+func (wdc *WebhookDeliveryCreate) SetStatus(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetStatus(s)
+	return wdc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableStatus(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetStatus(*s)
+	}
+	return wdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdc *WebhookDeliveryCreate) SetCreatedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreatedAt(t)
+	return wdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetCreatedAt(*t)
+	}
+	return wdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdc *WebhookDeliveryCreate) SetUpdatedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetUpdatedAt(t)
+	return wdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableUpdatedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetUpdatedAt(*t)
+	}
+	return wdc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wdc *WebhookDeliveryCreate) SetCreatedBy(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreatedBy(s)
+	return wdc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreatedBy(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetCreatedBy(*s)
+	}
+	return wdc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wdc *WebhookDeliveryCreate) SetUpdatedBy(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetUpdatedBy(s)
+	return wdc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableUpdatedBy(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetUpdatedBy(*s)
+	}
+	return wdc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (wdc *WebhookDeliveryCreate) SetEnvironmentID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEnvironmentID(s)
+	return wdc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableEnvironmentID(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetEnvironmentID(*s)
+	}
+	return wdc
+}
+
+// SetWebhookEndpointID sets the "webhook_endpoint_id" field.
+func (wdc *WebhookDeliveryCreate) SetWebhookEndpointID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetWebhookEndpointID(s)
+	return wdc
+}
+
+// SetNillableWebhookEndpointID sets the "webhook_endpoint_id" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableWebhookEndpointID(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetWebhookEndpointID(*s)
+	}
+	return wdc
+}
+
+// SetEventID sets the "event_id" field.
+func (wdc *WebhookDeliveryCreate) SetEventID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventID(s)
+	return wdc
+}
+
+// SetEventName sets the "event_name" field.
+func (wdc *WebhookDeliveryCreate) SetEventName(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventName(s)
+	return wdc
+}
+
+// SetURL sets the "url" field.
+func (wdc *WebhookDeliveryCreate) SetURL(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetURL(s)
+	return wdc
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (wdc *WebhookDeliveryCreate) SetRequestHeaders(m map[string]string) *WebhookDeliveryCreate {
+	wdc.mutation.SetRequestHeaders(m)
+	return wdc
+}
+
+// SetRequestBody sets the "request_body" field.
+func (wdc *WebhookDeliveryCreate) SetRequestBody(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetRequestBody(s)
+	return wdc
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdc *WebhookDeliveryCreate) SetResponseStatus(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetResponseStatus(i)
+	return wdc
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableResponseStatus(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetResponseStatus(*i)
+	}
+	return wdc
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (wdc *WebhookDeliveryCreate) SetLatencyMs(i int64) *WebhookDeliveryCreate {
+	wdc.mutation.SetLatencyMs(i)
+	return wdc
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableLatencyMs(i *int64) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetLatencyMs(*i)
+	}
+	return wdc
+}
+
+// SetError sets the "error" field.
+func (wdc *WebhookDeliveryCreate) SetError(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetError(s)
+	return wdc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableError(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetError(*s)
+	}
+	return wdc
+}
+
+// SetAttempt sets the "attempt" field.
+func (wdc *WebhookDeliveryCreate) SetAttempt(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetAttempt(i)
+	return wdc
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableAttempt(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetAttempt(*i)
+	}
+	return wdc
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (wdc *WebhookDeliveryCreate) SetDeliveryStatus(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetDeliveryStatus(s)
+	return wdc
+}
+
+// SetReplayOf sets the "replay_of" field.
+func (wdc *WebhookDeliveryCreate) SetReplayOf(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetReplayOf(s)
+	return wdc
+}
+
+// SetNillableReplayOf sets the "replay_of" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableReplayOf(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetReplayOf(*s)
+	}
+	return wdc
+}
+
+// SetID sets the "id" field.
+func (wdc *WebhookDeliveryCreate) SetID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetID(s)
+	return wdc
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdc *WebhookDeliveryCreate) Mutation() *WebhookDeliveryMutation {
+	return wdc.mutation
+}
+
+// Save creates the WebhookDelivery in the database.
+func (wdc *WebhookDeliveryCreate) Save(ctx context.Context) (*WebhookDelivery, error) {
+	wdc.defaults()
+	return withHooks(ctx, wdc.sqlSave, wdc.mutation, wdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WebhookDeliveryCreate) SaveX(ctx context.Context) *WebhookDelivery {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdc *WebhookDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := wdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdc *WebhookDeliveryCreate) ExecX(ctx context.Context) {
+	if err := wdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdc *WebhookDeliveryCreate) defaults() {
+	if _, ok := wdc.mutation.Status(); !ok {
+		v := webhookdelivery.DefaultStatus
+		wdc.mutation.SetStatus(v)
+	}
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		v := webhookdelivery.DefaultCreatedAt()
+		wdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wdc.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.DefaultUpdatedAt()
+		wdc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wdc.mutation.EnvironmentID(); !ok {
+		v := webhookdelivery.DefaultEnvironmentID
+		wdc.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := wdc.mutation.LatencyMs(); !ok {
+		v := webhookdelivery.DefaultLatencyMs
+		wdc.mutation.SetLatencyMs(v)
+	}
+	if _, ok := wdc.mutation.Attempt(); !ok {
+		v := webhookdelivery.DefaultAttempt
+		wdc.mutation.SetAttempt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdc *WebhookDeliveryCreate) check() error {
+	if _, ok := wdc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "WebhookDelivery.tenant_id"`)}
+	}
+	if v, ok := wdc.mutation.TenantID(); ok {
+		if err := webhookdelivery.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WebhookDelivery.status"`)}
+	}
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookDelivery.created_at"`)}
+	}
+	if _, ok := wdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebhookDelivery.updated_at"`)}
+	}
+	if _, ok := wdc.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "WebhookDelivery.event_id"`)}
+	}
+	if v, ok := wdc.mutation.EventID(); ok {
+		if err := webhookdelivery.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.event_id": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.EventName(); !ok {
+		return &ValidationError{Name: "event_name", err: errors.New(`ent: missing required field "WebhookDelivery.event_name"`)}
+	}
+	if v, ok := wdc.mutation.EventName(); ok {
+		if err := webhookdelivery.EventNameValidator(v); err != nil {
+			return &ValidationError{Name: "event_name", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.event_name": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "WebhookDelivery.url"`)}
+	}
+	if _, ok := wdc.mutation.RequestBody(); !ok {
+		return &ValidationError{Name: "request_body", err: errors.New(`ent: missing required field "WebhookDelivery.request_body"`)}
+	}
+	if _, ok := wdc.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "WebhookDelivery.latency_ms"`)}
+	}
+	if _, ok := wdc.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required field "WebhookDelivery.attempt"`)}
+	}
+	if _, ok := wdc.mutation.DeliveryStatus(); !ok {
+		return &ValidationError{Name: "delivery_status", err: errors.New(`ent: missing required field "WebhookDelivery.delivery_status"`)}
+	}
+	if v, ok := wdc.mutation.DeliveryStatus(); ok {
+		if err := webhookdelivery.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.delivery_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wdc *WebhookDeliveryCreate) sqlSave(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WebhookDelivery.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wdc.mutation.id = &_node.ID
+	wdc.mutation.done = true
+	return _node, nil
+}
+
+func (wdc *WebhookDeliveryCreate) createSpec() (*WebhookDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDelivery{config: wdc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdelivery.Table, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString))
+	)
+	if id, ok := wdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wdc.mutation.TenantID(); ok {
+		_spec.SetField(webhookdelivery.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := wdc.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := wdc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wdc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wdc.mutation.CreatedBy(); ok {
+		_spec.SetField(webhookdelivery.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := wdc.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := wdc.mutation.EnvironmentID(); ok {
+		_spec.SetField(webhookdelivery.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := wdc.mutation.WebhookEndpointID(); ok {
+		_spec.SetField(webhookdelivery.FieldWebhookEndpointID, field.TypeString, value)
+		_node.WebhookEndpointID = &value
+	}
+	if value, ok := wdc.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := wdc.mutation.EventName(); ok {
+		_spec.SetField(webhookdelivery.FieldEventName, field.TypeString, value)
+		_node.EventName = value
+	}
+	if value, ok := wdc.mutation.URL(); ok {
+		_spec.SetField(webhookdelivery.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := wdc.mutation.RequestHeaders(); ok {
+		_spec.SetField(webhookdelivery.FieldRequestHeaders, field.TypeJSON, value)
+		_node.RequestHeaders = value
+	}
+	if value, ok := wdc.mutation.RequestBody(); ok {
+		_spec.SetField(webhookdelivery.FieldRequestBody, field.TypeString, value)
+		_node.RequestBody = value
+	}
+	if value, ok := wdc.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+		_node.ResponseStatus = &value
+	}
+	if value, ok := wdc.mutation.LatencyMs(); ok {
+		_spec.SetField(webhookdelivery.FieldLatencyMs, field.TypeInt64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := wdc.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := wdc.mutation.Attempt(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := wdc.mutation.DeliveryStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveryStatus, field.TypeString, value)
+		_node.DeliveryStatus = value
+	}
+	if value, ok := wdc.mutation.ReplayOf(); ok {
+		_spec.SetField(webhookdelivery.FieldReplayOf, field.TypeString, value)
+		_node.ReplayOf = &value
+	}
+	return _node, _spec
+}
+
+// WebhookDeliveryCreateBulk is the builder for creating many WebhookDelivery entities in bulk.
+type WebhookDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookDeliveryCreate
+}
+
+// Save creates the WebhookDelivery entities in the database.
+func (wdcb *WebhookDeliveryCreateBulk) Save(ctx context.Context) ([]*WebhookDelivery, error) {
+	if wdcb.err != nil {
+		return nil, wdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wdcb.builders))
+	nodes := make([]*WebhookDelivery, len(wdcb.builders))
+	mutators := make([]Mutator, len(wdcb.builders))
+	for i := range wdcb.builders {
+		func(i int, root context.Context) {
+			builder := wdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) SaveX(ctx context.Context) []*WebhookDelivery {
+	v, err := wdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdcb *WebhookDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := wdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
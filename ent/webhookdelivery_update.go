@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/webhookdelivery"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetStatus sets the "status" field.
+func (wdu *WebhookDeliveryUpdate) SetStatus(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetStatus(s)
+	return wdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatus(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetStatus(*s)
+	}
+	return wdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdatedAt(t)
+	return wdu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdatedBy(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdatedBy(s)
+	return wdu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableUpdatedBy(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetUpdatedBy(*s)
+	}
+	return wdu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wdu *WebhookDeliveryUpdate) ClearUpdatedBy() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearUpdatedBy()
+	return wdu
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (wdu *WebhookDeliveryUpdate) SetDeliveryStatus(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetDeliveryStatus(s)
+	return wdu
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDeliveryStatus(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetDeliveryStatus(*s)
+	}
+	return wdu
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	wdu.defaults()
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDeliveryUpdate) defaults() {
+	if _, ok := wdu.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wdu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdu *WebhookDeliveryUpdate) check() error {
+	if v, ok := wdu.mutation.DeliveryStatus(); ok {
+		if err := webhookdelivery.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.delivery_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wdu.mutation.CreatedByCleared() {
+		_spec.ClearField(webhookdelivery.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wdu.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wdu.mutation.UpdatedByCleared() {
+		_spec.ClearField(webhookdelivery.FieldUpdatedBy, field.TypeString)
+	}
+	if wdu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(webhookdelivery.FieldEnvironmentID, field.TypeString)
+	}
+	if wdu.mutation.WebhookEndpointIDCleared() {
+		_spec.ClearField(webhookdelivery.FieldWebhookEndpointID, field.TypeString)
+	}
+	if wdu.mutation.RequestHeadersCleared() {
+		_spec.ClearField(webhookdelivery.FieldRequestHeaders, field.TypeJSON)
+	}
+	if wdu.mutation.ResponseStatusCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatus, field.TypeInt)
+	}
+	if wdu.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := wdu.mutation.DeliveryStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveryStatus, field.TypeString, value)
+	}
+	if wdu.mutation.ReplayOfCleared() {
+		_spec.ClearField(webhookdelivery.FieldReplayOf, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetStatus sets the "status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatus(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetStatus(s)
+	return wduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatus(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetStatus(*s)
+	}
+	return wduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdatedAt(t)
+	return wduo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdatedBy(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdatedBy(s)
+	return wduo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableUpdatedBy(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetUpdatedBy(*s)
+	}
+	return wduo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearUpdatedBy() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearUpdatedBy()
+	return wduo
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetDeliveryStatus(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetDeliveryStatus(s)
+	return wduo
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDeliveryStatus(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetDeliveryStatus(*s)
+	}
+	return wduo
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	wduo.defaults()
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDeliveryUpdateOne) defaults() {
+	if _, ok := wduo.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wduo *WebhookDeliveryUpdateOne) check() error {
+	if v, ok := wduo.mutation.DeliveryStatus(); ok {
+		if err := webhookdelivery.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.delivery_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := wduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wduo.mutation.CreatedByCleared() {
+		_spec.ClearField(webhookdelivery.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wduo.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wduo.mutation.UpdatedByCleared() {
+		_spec.ClearField(webhookdelivery.FieldUpdatedBy, field.TypeString)
+	}
+	if wduo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(webhookdelivery.FieldEnvironmentID, field.TypeString)
+	}
+	if wduo.mutation.WebhookEndpointIDCleared() {
+		_spec.ClearField(webhookdelivery.FieldWebhookEndpointID, field.TypeString)
+	}
+	if wduo.mutation.RequestHeadersCleared() {
+		_spec.ClearField(webhookdelivery.FieldRequestHeaders, field.TypeJSON)
+	}
+	if wduo.mutation.ResponseStatusCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatus, field.TypeInt)
+	}
+	if wduo.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := wduo.mutation.DeliveryStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveryStatus, field.TypeString, value)
+	}
+	if wduo.mutation.ReplayOfCleared() {
+		_spec.ClearField(webhookdelivery.FieldReplayOf, field.TypeString)
+	}
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
+)
+
+// WebhookEndpoint is the model entity for the WebhookEndpoint schema.
+type WebhookEndpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// EnabledEvents holds the value of the "enabled_events" field.
+	EnabledEvents []string `json:"enabled_events,omitempty"`
+	// Headers holds the value of the "headers" field.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// SigningSecret holds the value of the "signing_secret" field.
+	SigningSecret string `json:"-"`
+	// PreviousSigningSecret holds the value of the "previous_signing_secret" field.
+	PreviousSigningSecret *string `json:"-"`
+	// PreviousSigningSecretExpiresAt holds the value of the "previous_signing_secret_expires_at" field.
+	PreviousSigningSecretExpiresAt *time.Time `json:"previous_signing_secret_expires_at,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookEndpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookendpoint.FieldEnabledEvents, webhookendpoint.FieldHeaders, webhookendpoint.FieldMetadata:
+			values[i] = new([]byte)
+		case webhookendpoint.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case webhookendpoint.FieldID, webhookendpoint.FieldTenantID, webhookendpoint.FieldStatus, webhookendpoint.FieldCreatedBy, webhookendpoint.FieldUpdatedBy, webhookendpoint.FieldEnvironmentID, webhookendpoint.FieldURL, webhookendpoint.FieldDescription, webhookendpoint.FieldSigningSecret, webhookendpoint.FieldPreviousSigningSecret:
+			values[i] = new(sql.NullString)
+		case webhookendpoint.FieldCreatedAt, webhookendpoint.FieldUpdatedAt, webhookendpoint.FieldPreviousSigningSecretExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookEndpoint fields.
+func (we *WebhookEndpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookendpoint.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				we.ID = value.String
+			}
+		case webhookendpoint.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				we.TenantID = value.String
+			}
+		case webhookendpoint.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				we.Status = value.String
+			}
+		case webhookendpoint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				we.CreatedAt = value.Time
+			}
+		case webhookendpoint.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				we.UpdatedAt = value.Time
+			}
+		case webhookendpoint.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				we.CreatedBy = value.String
+			}
+		case webhookendpoint.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				we.UpdatedBy = value.String
+			}
+		case webhookendpoint.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				we.EnvironmentID = value.String
+			}
+		case webhookendpoint.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				we.URL = value.String
+			}
+		case webhookendpoint.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				we.Description = value.String
+			}
+		case webhookendpoint.FieldEnabledEvents:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled_events", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &we.EnabledEvents); err != nil {
+					return fmt.Errorf("unmarshal field enabled_events: %w", err)
+				}
+			}
+		case webhookendpoint.FieldHeaders:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field headers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &we.Headers); err != nil {
+					return fmt.Errorf("unmarshal field headers: %w", err)
+				}
+			}
+		case webhookendpoint.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				we.Enabled = value.Bool
+			}
+		case webhookendpoint.FieldSigningSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_secret", values[i])
+			} else if value.Valid {
+				we.SigningSecret = value.String
+			}
+		case webhookendpoint.FieldPreviousSigningSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_signing_secret", values[i])
+			} else if value.Valid {
+				we.PreviousSigningSecret = new(string)
+				*we.PreviousSigningSecret = value.String
+			}
+		case webhookendpoint.FieldPreviousSigningSecretExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_signing_secret_expires_at", values[i])
+			} else if value.Valid {
+				we.PreviousSigningSecretExpiresAt = new(time.Time)
+				*we.PreviousSigningSecretExpiresAt = value.Time
+			}
+		case webhookendpoint.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &we.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			we.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookEndpoint.
+// This includes values selected through modifiers, order, etc.
+func (we *WebhookEndpoint) Value(name string) (ent.Value, error) {
+	return we.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WebhookEndpoint.
+// Note that you need to call WebhookEndpoint.Unwrap() before calling this method if this WebhookEndpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (we *WebhookEndpoint) Update() *WebhookEndpointUpdateOne {
+	return NewWebhookEndpointClient(we.config).UpdateOne(we)
+}
+
+// Unwrap unwraps the WebhookEndpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (we *WebhookEndpoint) Unwrap() *WebhookEndpoint {
+	_tx, ok := we.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookEndpoint is not a transactional entity")
+	}
+	we.config.driver = _tx.drv
+	return we
+}
+
+// String implements the fmt.Stringer.
+func (we *WebhookEndpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookEndpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", we.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(we.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(we.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(we.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(we.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(we.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(we.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(we.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(we.URL)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(we.Description)
+	builder.WriteString(", ")
+	builder.WriteString("enabled_events=")
+	builder.WriteString(fmt.Sprintf("%v", we.EnabledEvents))
+	builder.WriteString(", ")
+	builder.WriteString("headers=")
+	builder.WriteString(fmt.Sprintf("%v", we.Headers))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", we.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("signing_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("previous_signing_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := we.PreviousSigningSecretExpiresAt; v != nil {
+		builder.WriteString("previous_signing_secret_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", we.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookEndpoints is a parsable slice of WebhookEndpoint.
+type WebhookEndpoints []*WebhookEndpoint
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
+)
+
+// WebhookEndpointQuery is the builder for querying WebhookEndpoint entities.
+type WebhookEndpointQuery struct {
+	config
+	ctx        *QueryContext
+	order      []webhookendpoint.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WebhookEndpoint
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebhookEndpointQuery builder.
+func (weq *WebhookEndpointQuery) Where(ps ...predicate.WebhookEndpoint) *WebhookEndpointQuery {
+	weq.predicates = append(weq.predicates, ps...)
+	return weq
+}
+
+// Limit the number of records to be returned by this query.
+func (weq *WebhookEndpointQuery) Limit(limit int) *WebhookEndpointQuery {
+	weq.ctx.Limit = &limit
+	return weq
+}
+
+// Offset to start from.
+func (weq *WebhookEndpointQuery) Offset(offset int) *WebhookEndpointQuery {
+	weq.ctx.Offset = &offset
+	return weq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (weq *WebhookEndpointQuery) Unique(unique bool) *WebhookEndpointQuery {
+	weq.ctx.Unique = &unique
+	return weq
+}
+
+// Order specifies how the records should be ordered.
+func (weq *WebhookEndpointQuery) Order(o ...webhookendpoint.OrderOption) *WebhookEndpointQuery {
+	weq.order = append(weq.order, o...)
+	return weq
+}
+
+// First returns the first WebhookEndpoint entity from the query.
+// Returns a *NotFoundError when no WebhookEndpoint was found.
+func (weq *WebhookEndpointQuery) First(ctx context.Context) (*WebhookEndpoint, error) {
+	nodes, err := weq.Limit(1).All(setContextOp(ctx, weq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhookendpoint.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) FirstX(ctx context.Context) *WebhookEndpoint {
+	node, err := weq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebhookEndpoint ID from the query.
+// Returns a *NotFoundError when no WebhookEndpoint ID was found.
+func (weq *WebhookEndpointQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = weq.Limit(1).IDs(setContextOp(ctx, weq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhookendpoint.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) FirstIDX(ctx context.Context) string {
+	id, err := weq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebhookEndpoint entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WebhookEndpoint entity is found.
+// Returns a *NotFoundError when no WebhookEndpoint entities are found.
+func (weq *WebhookEndpointQuery) Only(ctx context.Context) (*WebhookEndpoint, error) {
+	nodes, err := weq.Limit(2).All(setContextOp(ctx, weq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhookendpoint.Label}
+	default:
+		return nil, &NotSingularError{webhookendpoint.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) OnlyX(ctx context.Context) *WebhookEndpoint {
+	node, err := weq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebhookEndpoint ID in the query.
+// Returns a *NotSingularError when more than one WebhookEndpoint ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (weq *WebhookEndpointQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = weq.Limit(2).IDs(setContextOp(ctx, weq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhookendpoint.Label}
+	default:
+		err = &NotSingularError{webhookendpoint.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) OnlyIDX(ctx context.Context) string {
+	id, err := weq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebhookEndpoints.
+func (weq *WebhookEndpointQuery) All(ctx context.Context) ([]*WebhookEndpoint, error) {
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryAll)
+	if err := weq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WebhookEndpoint, *WebhookEndpointQuery]()
+	return withInterceptors[[]*WebhookEndpoint](ctx, weq, qr, weq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) AllX(ctx context.Context) []*WebhookEndpoint {
+	nodes, err := weq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebhookEndpoint IDs.
+func (weq *WebhookEndpointQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if weq.ctx.Unique == nil && weq.path != nil {
+		weq.Unique(true)
+	}
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryIDs)
+	if err = weq.Select(webhookendpoint.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) IDsX(ctx context.Context) []string {
+	ids, err := weq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (weq *WebhookEndpointQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryCount)
+	if err := weq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, weq, querierCount[*WebhookEndpointQuery](), weq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) CountX(ctx context.Context) int {
+	count, err := weq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (weq *WebhookEndpointQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryExist)
+	switch _, err := weq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (weq *WebhookEndpointQuery) ExistX(ctx context.Context) bool {
+	exist, err := weq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebhookEndpointQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (weq *WebhookEndpointQuery) Clone() *WebhookEndpointQuery {
+	if weq == nil {
+		return nil
+	}
+	return &WebhookEndpointQuery{
+		config:     weq.config,
+		ctx:        weq.ctx.Clone(),
+		order:      append([]webhookendpoint.OrderOption{}, weq.order...),
+		inters:     append([]Interceptor{}, weq.inters...),
+		predicates: append([]predicate.WebhookEndpoint{}, weq.predicates...),
+		// clone intermediate query.
+		sql:  weq.sql.Clone(),
+		path: weq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebhookEndpoint.Query().
+//		GroupBy(webhookendpoint.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (weq *WebhookEndpointQuery) GroupBy(field string, fields ...string) *WebhookEndpointGroupBy {
+	weq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WebhookEndpointGroupBy{build: weq}
+	grbuild.flds = &weq.ctx.Fields
+	grbuild.label = webhookendpoint.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.WebhookEndpoint.Query().
+//		Select(webhookendpoint.FieldTenantID).
+//		Scan(ctx, &v)
+func (weq *WebhookEndpointQuery) Select(fields ...string) *WebhookEndpointSelect {
+	weq.ctx.Fields = append(weq.ctx.Fields, fields...)
+	sbuild := &WebhookEndpointSelect{WebhookEndpointQuery: weq}
+	sbuild.label = webhookendpoint.Label
+	sbuild.flds, sbuild.scan = &weq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WebhookEndpointSelect configured with the given aggregations.
+func (weq *WebhookEndpointQuery) Aggregate(fns ...AggregateFunc) *WebhookEndpointSelect {
+	return weq.Select().Aggregate(fns...)
+}
+
+func (weq *WebhookEndpointQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range weq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, weq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range weq.ctx.Fields {
+		if !webhookendpoint.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if weq.path != nil {
+		prev, err := weq.path(ctx)
+		if err != nil {
+			return err
+		}
+		weq.sql = prev
+	}
+	return nil
+}
+
+func (weq *WebhookEndpointQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WebhookEndpoint, error) {
+	var (
+		nodes = []*WebhookEndpoint{}
+		_spec = weq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WebhookEndpoint).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WebhookEndpoint{config: weq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, weq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (weq *WebhookEndpointQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := weq.querySpec()
+	_spec.Node.Columns = weq.ctx.Fields
+	if len(weq.ctx.Fields) > 0 {
+		_spec.Unique = weq.ctx.Unique != nil && *weq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, weq.driver, _spec)
+}
+
+func (weq *WebhookEndpointQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(webhookendpoint.Table, webhookendpoint.Columns, sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeString))
+	_spec.From = weq.sql
+	if unique := weq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if weq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := weq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookendpoint.FieldID)
+		for i := range fields {
+			if fields[i] != webhookendpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := weq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := weq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := weq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := weq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (weq *WebhookEndpointQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(weq.driver.Dialect())
+	t1 := builder.Table(webhookendpoint.Table)
+	columns := weq.ctx.Fields
+	if len(columns) == 0 {
+		columns = webhookendpoint.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if weq.sql != nil {
+		selector = weq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if weq.ctx.Unique != nil && *weq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range weq.predicates {
+		p(selector)
+	}
+	for _, p := range weq.order {
+		p(selector)
+	}
+	if offset := weq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := weq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebhookEndpointGroupBy is the group-by builder for WebhookEndpoint entities.
+type WebhookEndpointGroupBy struct {
+	selector
+	build *WebhookEndpointQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wegb *WebhookEndpointGroupBy) Aggregate(fns ...AggregateFunc) *WebhookEndpointGroupBy {
+	wegb.fns = append(wegb.fns, fns...)
+	return wegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wegb *WebhookEndpointGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wegb.build.ctx, ent.OpQueryGroupBy)
+	if err := wegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebhookEndpointQuery, *WebhookEndpointGroupBy](ctx, wegb.build, wegb, wegb.build.inters, v)
+}
+
+func (wegb *WebhookEndpointGroupBy) sqlScan(ctx context.Context, root *WebhookEndpointQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wegb.fns))
+	for _, fn := range wegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wegb.flds)+len(wegb.fns))
+		for _, f := range *wegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WebhookEndpointSelect is the builder for selecting fields of WebhookEndpoint entities.
+type WebhookEndpointSelect struct {
+	*WebhookEndpointQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wes *WebhookEndpointSelect) Aggregate(fns ...AggregateFunc) *WebhookEndpointSelect {
+	wes.fns = append(wes.fns, fns...)
+	return wes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wes *WebhookEndpointSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wes.ctx, ent.OpQuerySelect)
+	if err := wes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebhookEndpointQuery, *WebhookEndpointSelect](ctx, wes.WebhookEndpointQuery, wes, wes.inters, v)
+}
+
+func (wes *WebhookEndpointSelect) sqlScan(ctx context.Context, root *WebhookEndpointQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wes.fns))
+	for _, fn := range wes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
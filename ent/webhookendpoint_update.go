@@ -0,0 +1,743 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
+)
+
+// WebhookEndpointUpdate is the builder for updating WebhookEndpoint entities.
+type WebhookEndpointUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookEndpointMutation
+}
+
+// Where appends a list predicates to the WebhookEndpointUpdate builder.
+func (weu *WebhookEndpointUpdate) Where(ps ...predicate.WebhookEndpoint) *WebhookEndpointUpdate {
+	weu.mutation.Where(ps...)
+	return weu
+}
+
+// SetStatus sets the "status" field.
+func (weu *WebhookEndpointUpdate) SetStatus(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetStatus(s)
+	return weu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableStatus(s *string) *WebhookEndpointUpdate {
+	if s != nil {
+		weu.SetStatus(*s)
+	}
+	return weu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (weu *WebhookEndpointUpdate) SetUpdatedAt(t time.Time) *WebhookEndpointUpdate {
+	weu.mutation.SetUpdatedAt(t)
+	return weu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (weu *WebhookEndpointUpdate) SetUpdatedBy(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetUpdatedBy(s)
+	return weu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableUpdatedBy(s *string) *WebhookEndpointUpdate {
+	if s != nil {
+		weu.SetUpdatedBy(*s)
+	}
+	return weu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (weu *WebhookEndpointUpdate) ClearUpdatedBy() *WebhookEndpointUpdate {
+	weu.mutation.ClearUpdatedBy()
+	return weu
+}
+
+// SetURL sets the "url" field.
+func (weu *WebhookEndpointUpdate) SetURL(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetURL(s)
+	return weu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableURL(s *string) *WebhookEndpointUpdate {
+	if s != nil {
+		weu.SetURL(*s)
+	}
+	return weu
+}
+
+// SetDescription sets the "description" field.
+func (weu *WebhookEndpointUpdate) SetDescription(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetDescription(s)
+	return weu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableDescription(s *string) *WebhookEndpointUpdate {
+	if s != nil {
+		weu.SetDescription(*s)
+	}
+	return weu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (weu *WebhookEndpointUpdate) ClearDescription() *WebhookEndpointUpdate {
+	weu.mutation.ClearDescription()
+	return weu
+}
+
+// SetEnabledEvents sets the "enabled_events" field.
+func (weu *WebhookEndpointUpdate) SetEnabledEvents(s []string) *WebhookEndpointUpdate {
+	weu.mutation.SetEnabledEvents(s)
+	return weu
+}
+
+// AppendEnabledEvents appends s to the "enabled_events" field.
+func (weu *WebhookEndpointUpdate) AppendEnabledEvents(s []string) *WebhookEndpointUpdate {
+	weu.mutation.AppendEnabledEvents(s)
+	return weu
+}
+
+// ClearEnabledEvents clears the value of the "enabled_events" field.
+func (weu *WebhookEndpointUpdate) ClearEnabledEvents() *WebhookEndpointUpdate {
+	weu.mutation.ClearEnabledEvents()
+	return weu
+}
+
+// SetHeaders sets the "headers" field.
+func (weu *WebhookEndpointUpdate) SetHeaders(m map[string]string) *WebhookEndpointUpdate {
+	weu.mutation.SetHeaders(m)
+	return weu
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (weu *WebhookEndpointUpdate) ClearHeaders() *WebhookEndpointUpdate {
+	weu.mutation.ClearHeaders()
+	return weu
+}
+
+// SetEnabled sets the "enabled" field.
+func (weu *WebhookEndpointUpdate) SetEnabled(b bool) *WebhookEndpointUpdate {
+	weu.mutation.SetEnabled(b)
+	return weu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableEnabled(b *bool) *WebhookEndpointUpdate {
+	if b != nil {
+		weu.SetEnabled(*b)
+	}
+	return weu
+}
+
+// SetSigningSecret sets the "signing_secret" field.
+func (weu *WebhookEndpointUpdate) SetSigningSecret(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetSigningSecret(s)
+	return weu
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableSigningSecret(s *string) *WebhookEndpointUpdate {
+	if s != nil {
+		weu.SetSigningSecret(*s)
+	}
+	return weu
+}
+
+// ClearSigningSecret clears the value of the "signing_secret" field.
+func (weu *WebhookEndpointUpdate) ClearSigningSecret() *WebhookEndpointUpdate {
+	weu.mutation.ClearSigningSecret()
+	return weu
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (weu *WebhookEndpointUpdate) SetPreviousSigningSecret(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetPreviousSigningSecret(s)
+	return weu
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillablePreviousSigningSecret(s *string) *WebhookEndpointUpdate {
+	if s != nil {
+		weu.SetPreviousSigningSecret(*s)
+	}
+	return weu
+}
+
+// ClearPreviousSigningSecret clears the value of the "previous_signing_secret" field.
+func (weu *WebhookEndpointUpdate) ClearPreviousSigningSecret() *WebhookEndpointUpdate {
+	weu.mutation.ClearPreviousSigningSecret()
+	return weu
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (weu *WebhookEndpointUpdate) SetPreviousSigningSecretExpiresAt(t time.Time) *WebhookEndpointUpdate {
+	weu.mutation.SetPreviousSigningSecretExpiresAt(t)
+	return weu
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillablePreviousSigningSecretExpiresAt(t *time.Time) *WebhookEndpointUpdate {
+	if t != nil {
+		weu.SetPreviousSigningSecretExpiresAt(*t)
+	}
+	return weu
+}
+
+// ClearPreviousSigningSecretExpiresAt clears the value of the "previous_signing_secret_expires_at" field.
+func (weu *WebhookEndpointUpdate) ClearPreviousSigningSecretExpiresAt() *WebhookEndpointUpdate {
+	weu.mutation.ClearPreviousSigningSecretExpiresAt()
+	return weu
+}
+
+// SetMetadata sets the "metadata" field.
+func (weu *WebhookEndpointUpdate) SetMetadata(m map[string]string) *WebhookEndpointUpdate {
+	weu.mutation.SetMetadata(m)
+	return weu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (weu *WebhookEndpointUpdate) ClearMetadata() *WebhookEndpointUpdate {
+	weu.mutation.ClearMetadata()
+	return weu
+}
+
+// Mutation returns the WebhookEndpointMutation object of the builder.
+func (weu *WebhookEndpointUpdate) Mutation() *WebhookEndpointMutation {
+	return weu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (weu *WebhookEndpointUpdate) Save(ctx context.Context) (int, error) {
+	weu.defaults()
+	return withHooks(ctx, weu.sqlSave, weu.mutation, weu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weu *WebhookEndpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := weu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (weu *WebhookEndpointUpdate) Exec(ctx context.Context) error {
+	_, err := weu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weu *WebhookEndpointUpdate) ExecX(ctx context.Context) {
+	if err := weu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (weu *WebhookEndpointUpdate) defaults() {
+	if _, ok := weu.mutation.UpdatedAt(); !ok {
+		v := webhookendpoint.UpdateDefaultUpdatedAt()
+		weu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weu *WebhookEndpointUpdate) check() error {
+	if v, ok := weu.mutation.URL(); ok {
+		if err := webhookendpoint.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookEndpoint.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weu *WebhookEndpointUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := weu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookendpoint.Table, webhookendpoint.Columns, sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeString))
+	if ps := weu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weu.mutation.Status(); ok {
+		_spec.SetField(webhookendpoint.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookendpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if weu.mutation.CreatedByCleared() {
+		_spec.ClearField(webhookendpoint.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := weu.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookendpoint.FieldUpdatedBy, field.TypeString, value)
+	}
+	if weu.mutation.UpdatedByCleared() {
+		_spec.ClearField(webhookendpoint.FieldUpdatedBy, field.TypeString)
+	}
+	if weu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(webhookendpoint.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := weu.mutation.URL(); ok {
+		_spec.SetField(webhookendpoint.FieldURL, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.Description(); ok {
+		_spec.SetField(webhookendpoint.FieldDescription, field.TypeString, value)
+	}
+	if weu.mutation.DescriptionCleared() {
+		_spec.ClearField(webhookendpoint.FieldDescription, field.TypeString)
+	}
+	if value, ok := weu.mutation.EnabledEvents(); ok {
+		_spec.SetField(webhookendpoint.FieldEnabledEvents, field.TypeJSON, value)
+	}
+	if value, ok := weu.mutation.AppendedEnabledEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookendpoint.FieldEnabledEvents, value)
+		})
+	}
+	if weu.mutation.EnabledEventsCleared() {
+		_spec.ClearField(webhookendpoint.FieldEnabledEvents, field.TypeJSON)
+	}
+	if value, ok := weu.mutation.Headers(); ok {
+		_spec.SetField(webhookendpoint.FieldHeaders, field.TypeJSON, value)
+	}
+	if weu.mutation.HeadersCleared() {
+		_spec.ClearField(webhookendpoint.FieldHeaders, field.TypeJSON)
+	}
+	if value, ok := weu.mutation.Enabled(); ok {
+		_spec.SetField(webhookendpoint.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := weu.mutation.SigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldSigningSecret, field.TypeString, value)
+	}
+	if weu.mutation.SigningSecretCleared() {
+		_spec.ClearField(webhookendpoint.FieldSigningSecret, field.TypeString)
+	}
+	if value, ok := weu.mutation.PreviousSigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecret, field.TypeString, value)
+	}
+	if weu.mutation.PreviousSigningSecretCleared() {
+		_spec.ClearField(webhookendpoint.FieldPreviousSigningSecret, field.TypeString)
+	}
+	if value, ok := weu.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime, value)
+	}
+	if weu.mutation.PreviousSigningSecretExpiresAtCleared() {
+		_spec.ClearField(webhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime)
+	}
+	if value, ok := weu.mutation.Metadata(); ok {
+		_spec.SetField(webhookendpoint.FieldMetadata, field.TypeJSON, value)
+	}
+	if weu.mutation.MetadataCleared() {
+		_spec.ClearField(webhookendpoint.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, weu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookendpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	weu.mutation.done = true
+	return n, nil
+}
+
+// WebhookEndpointUpdateOne is the builder for updating a single WebhookEndpoint entity.
+type WebhookEndpointUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookEndpointMutation
+}
+
+// SetStatus sets the "status" field.
+func (weuo *WebhookEndpointUpdateOne) SetStatus(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetStatus(s)
+	return weuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableStatus(s *string) *WebhookEndpointUpdateOne {
+	if s != nil {
+		weuo.SetStatus(*s)
+	}
+	return weuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (weuo *WebhookEndpointUpdateOne) SetUpdatedAt(t time.Time) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetUpdatedAt(t)
+	return weuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (weuo *WebhookEndpointUpdateOne) SetUpdatedBy(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetUpdatedBy(s)
+	return weuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableUpdatedBy(s *string) *WebhookEndpointUpdateOne {
+	if s != nil {
+		weuo.SetUpdatedBy(*s)
+	}
+	return weuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (weuo *WebhookEndpointUpdateOne) ClearUpdatedBy() *WebhookEndpointUpdateOne {
+	weuo.mutation.ClearUpdatedBy()
+	return weuo
+}
+
+// SetURL sets the "url" field.
+func (weuo *WebhookEndpointUpdateOne) SetURL(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetURL(s)
+	return weuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableURL(s *string) *WebhookEndpointUpdateOne {
+	if s != nil {
+		weuo.SetURL(*s)
+	}
+	return weuo
+}
+
+// SetDescription sets the "description" field.
+func (weuo *WebhookEndpointUpdateOne) SetDescription(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetDescription(s)
+	return weuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableDescription(s *string) *WebhookEndpointUpdateOne {
+	if s != nil {
+		weuo.SetDescription(*s)
+	}
+	return weuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (weuo *WebhookEndpointUpdateOne) ClearDescription() *WebhookEndpointUpdateOne {
+	weuo.mutation.ClearDescription()
+	return weuo
+}
+
+// SetEnabledEvents sets the "enabled_events" field.
+func (weuo *WebhookEndpointUpdateOne) SetEnabledEvents(s []string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetEnabledEvents(s)
+	return weuo
+}
+
+// AppendEnabledEvents appends s to the "enabled_events" field.
+func (weuo *WebhookEndpointUpdateOne) AppendEnabledEvents(s []string) *WebhookEndpointUpdateOne {
+	weuo.mutation.AppendEnabledEvents(s)
+	return weuo
+}
+
+// ClearEnabledEvents clears the value of the "enabled_events" field.
+func (weuo *WebhookEndpointUpdateOne) ClearEnabledEvents() *WebhookEndpointUpdateOne {
+	weuo.mutation.ClearEnabledEvents()
+	return weuo
+}
+
+// SetHeaders sets the "headers" field.
+func (weuo *WebhookEndpointUpdateOne) SetHeaders(m map[string]string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetHeaders(m)
+	return weuo
+}
+
+// ClearHeaders clears the value of the "headers" field.
+func (weuo *WebhookEndpointUpdateOne) ClearHeaders() *WebhookEndpointUpdateOne {
+	weuo.mutation.ClearHeaders()
+	return weuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (weuo *WebhookEndpointUpdateOne) SetEnabled(b bool) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetEnabled(b)
+	return weuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableEnabled(b *bool) *WebhookEndpointUpdateOne {
+	if b != nil {
+		weuo.SetEnabled(*b)
+	}
+	return weuo
+}
+
+// SetSigningSecret sets the "signing_secret" field.
+func (weuo *WebhookEndpointUpdateOne) SetSigningSecret(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetSigningSecret(s)
+	return weuo
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableSigningSecret(s *string) *WebhookEndpointUpdateOne {
+	if s != nil {
+		weuo.SetSigningSecret(*s)
+	}
+	return weuo
+}
+
+// ClearSigningSecret clears the value of the "signing_secret" field.
+func (weuo *WebhookEndpointUpdateOne) ClearSigningSecret() *WebhookEndpointUpdateOne {
+	weuo.mutation.ClearSigningSecret()
+	return weuo
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (weuo *WebhookEndpointUpdateOne) SetPreviousSigningSecret(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetPreviousSigningSecret(s)
+	return weuo
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillablePreviousSigningSecret(s *string) *WebhookEndpointUpdateOne {
+	if s != nil {
+		weuo.SetPreviousSigningSecret(*s)
+	}
+	return weuo
+}
+
+// ClearPreviousSigningSecret clears the value of the "previous_signing_secret" field.
+func (weuo *WebhookEndpointUpdateOne) ClearPreviousSigningSecret() *WebhookEndpointUpdateOne {
+	weuo.mutation.ClearPreviousSigningSecret()
+	return weuo
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (weuo *WebhookEndpointUpdateOne) SetPreviousSigningSecretExpiresAt(t time.Time) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetPreviousSigningSecretExpiresAt(t)
+	return weuo
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillablePreviousSigningSecretExpiresAt(t *time.Time) *WebhookEndpointUpdateOne {
+	if t != nil {
+		weuo.SetPreviousSigningSecretExpiresAt(*t)
+	}
+	return weuo
+}
+
+// ClearPreviousSigningSecretExpiresAt clears the value of the "previous_signing_secret_expires_at" field.
+func (weuo *WebhookEndpointUpdateOne) ClearPreviousSigningSecretExpiresAt() *WebhookEndpointUpdateOne {
+	weuo.mutation.ClearPreviousSigningSecretExpiresAt()
+	return weuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (weuo *WebhookEndpointUpdateOne) SetMetadata(m map[string]string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetMetadata(m)
+	return weuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (weuo *WebhookEndpointUpdateOne) ClearMetadata() *WebhookEndpointUpdateOne {
+	weuo.mutation.ClearMetadata()
+	return weuo
+}
+
+// Mutation returns the WebhookEndpointMutation object of the builder.
+func (weuo *WebhookEndpointUpdateOne) Mutation() *WebhookEndpointMutation {
+	return weuo.mutation
+}
+
+// Where appends a list predicates to the WebhookEndpointUpdate builder.
+func (weuo *WebhookEndpointUpdateOne) Where(ps ...predicate.WebhookEndpoint) *WebhookEndpointUpdateOne {
+	weuo.mutation.Where(ps...)
+	return weuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (weuo *WebhookEndpointUpdateOne) Select(field string, fields ...string) *WebhookEndpointUpdateOne {
+	weuo.fields = append([]string{field}, fields...)
+	return weuo
+}
+
+// Save executes the query and returns the updated WebhookEndpoint entity.
+func (weuo *WebhookEndpointUpdateOne) Save(ctx context.Context) (*WebhookEndpoint, error) {
+	weuo.defaults()
+	return withHooks(ctx, weuo.sqlSave, weuo.mutation, weuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weuo *WebhookEndpointUpdateOne) SaveX(ctx context.Context) *WebhookEndpoint {
+	node, err := weuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (weuo *WebhookEndpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := weuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weuo *WebhookEndpointUpdateOne) ExecX(ctx context.Context) {
+	if err := weuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (weuo *WebhookEndpointUpdateOne) defaults() {
+	if _, ok := weuo.mutation.UpdatedAt(); !ok {
+		v := webhookendpoint.UpdateDefaultUpdatedAt()
+		weuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weuo *WebhookEndpointUpdateOne) check() error {
+	if v, ok := weuo.mutation.URL(); ok {
+		if err := webhookendpoint.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookEndpoint.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weuo *WebhookEndpointUpdateOne) sqlSave(ctx context.Context) (_node *WebhookEndpoint, err error) {
+	if err := weuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookendpoint.Table, webhookendpoint.Columns, sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeString))
+	id, ok := weuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookEndpoint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := weuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookendpoint.FieldID)
+		for _, f := range fields {
+			if !webhookendpoint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookendpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := weuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weuo.mutation.Status(); ok {
+		_spec.SetField(webhookendpoint.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookendpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if weuo.mutation.CreatedByCleared() {
+		_spec.ClearField(webhookendpoint.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := weuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookendpoint.FieldUpdatedBy, field.TypeString, value)
+	}
+	if weuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(webhookendpoint.FieldUpdatedBy, field.TypeString)
+	}
+	if weuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(webhookendpoint.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := weuo.mutation.URL(); ok {
+		_spec.SetField(webhookendpoint.FieldURL, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.Description(); ok {
+		_spec.SetField(webhookendpoint.FieldDescription, field.TypeString, value)
+	}
+	if weuo.mutation.DescriptionCleared() {
+		_spec.ClearField(webhookendpoint.FieldDescription, field.TypeString)
+	}
+	if value, ok := weuo.mutation.EnabledEvents(); ok {
+		_spec.SetField(webhookendpoint.FieldEnabledEvents, field.TypeJSON, value)
+	}
+	if value, ok := weuo.mutation.AppendedEnabledEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookendpoint.FieldEnabledEvents, value)
+		})
+	}
+	if weuo.mutation.EnabledEventsCleared() {
+		_spec.ClearField(webhookendpoint.FieldEnabledEvents, field.TypeJSON)
+	}
+	if value, ok := weuo.mutation.Headers(); ok {
+		_spec.SetField(webhookendpoint.FieldHeaders, field.TypeJSON, value)
+	}
+	if weuo.mutation.HeadersCleared() {
+		_spec.ClearField(webhookendpoint.FieldHeaders, field.TypeJSON)
+	}
+	if value, ok := weuo.mutation.Enabled(); ok {
+		_spec.SetField(webhookendpoint.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := weuo.mutation.SigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldSigningSecret, field.TypeString, value)
+	}
+	if weuo.mutation.SigningSecretCleared() {
+		_spec.ClearField(webhookendpoint.FieldSigningSecret, field.TypeString)
+	}
+	if value, ok := weuo.mutation.PreviousSigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecret, field.TypeString, value)
+	}
+	if weuo.mutation.PreviousSigningSecretCleared() {
+		_spec.ClearField(webhookendpoint.FieldPreviousSigningSecret, field.TypeString)
+	}
+	if value, ok := weuo.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime, value)
+	}
+	if weuo.mutation.PreviousSigningSecretExpiresAtCleared() {
+		_spec.ClearField(webhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime)
+	}
+	if value, ok := weuo.mutation.Metadata(); ok {
+		_spec.SetField(webhookendpoint.FieldMetadata, field.TypeJSON, value)
+	}
+	if weuo.mutation.MetadataCleared() {
+		_spec.ClearField(webhookendpoint.FieldMetadata, field.TypeJSON)
+	}
+	_node = &WebhookEndpoint{config: weuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, weuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookendpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	weuo.mutation.done = true
+	return _node, nil
+}
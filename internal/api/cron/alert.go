@@ -0,0 +1,41 @@
+package cron
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// AlertHandler handles usage alert related cron jobs
+type AlertHandler struct {
+	alertService service.AlertService
+	logger       *logger.Logger
+}
+
+// NewAlertHandler creates a new alert handler
+func NewAlertHandler(
+	alertService service.AlertService,
+	logger *logger.Logger,
+) *AlertHandler {
+	return &AlertHandler{
+		alertService: alertService,
+		logger:       logger,
+	}
+}
+
+// EvaluateAlerts evaluates the alert rules of all tenants and notifies the thresholds crossed
+func (h *AlertHandler) EvaluateAlerts(c *gin.Context) {
+	ctx := c.Request.Context()
+	response, err := h.alertService.EvaluateAlerts(ctx)
+	if err != nil {
+		h.logger.Errorw("failed to evaluate alerts",
+			"error", err)
+
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,41 @@
+package cron
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// InvoiceHandler handles invoice related cron jobs
+type InvoiceHandler struct {
+	dunningService service.DunningService
+	logger         *logger.Logger
+}
+
+// NewInvoiceHandler creates a new invoice handler
+func NewInvoiceHandler(
+	dunningService service.DunningService,
+	logger *logger.Logger,
+) *InvoiceHandler {
+	return &InvoiceHandler{
+		dunningService: dunningService,
+		logger:         logger,
+	}
+}
+
+// ProcessDunning retries the payment of unpaid invoices and applies the dunning policy of each tenant
+func (h *InvoiceHandler) ProcessDunning(c *gin.Context) {
+	ctx := c.Request.Context()
+	response, err := h.dunningService.ProcessDunning(ctx)
+	if err != nil {
+		h.logger.Errorw("failed to process dunning",
+			"error", err)
+
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
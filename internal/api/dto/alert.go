@@ -0,0 +1,165 @@
+package dto
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/alert"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+type CreateAlertRuleRequest struct {
+	Name           string            `json:"name" validate:"required"`
+	AlertType      types.AlertType   `json:"alert_type" validate:"required"`
+	FeatureID      *string           `json:"feature_id,omitempty"`
+	CustomerID     *string           `json:"customer_id,omitempty"`
+	SubscriptionID *string           `json:"subscription_id,omitempty"`
+	Currency       string            `json:"currency,omitempty"`
+	Thresholds     []decimal.Decimal `json:"thresholds" validate:"required"`
+	Metadata       types.Metadata    `json:"metadata,omitempty"`
+}
+
+func (r *CreateAlertRuleRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if err := r.AlertType.Validate(); err != nil {
+		return err
+	}
+
+	switch r.AlertType {
+	case types.AlertTypeEntitlementUsage:
+		if lo.FromPtr(r.FeatureID) == "" {
+			return ierr.NewError("feature_id is required for entitlement usage alerts").
+				WithHint("Please provide the metered feature to alert on").
+				Mark(ierr.ErrValidation)
+		}
+		if r.Currency != "" {
+			return ierr.NewError("currency is not allowed for entitlement usage alerts").
+				WithHint("Entitlement usage thresholds are percents of the usage limit").
+				Mark(ierr.ErrValidation)
+		}
+	case types.AlertTypeSubscriptionSpend:
+		if r.FeatureID != nil {
+			return ierr.NewError("feature_id is not allowed for subscription spend alerts").
+				WithHint("Subscription spend alerts apply to all usage charges of the subscription").
+				Mark(ierr.ErrValidation)
+		}
+		if r.Currency != "" {
+			if err := types.ValidateCurrencyCode(r.Currency); err != nil {
+				return err
+			}
+		}
+	}
+
+	return validateAlertThresholds(r.Thresholds)
+}
+
+func (r *CreateAlertRuleRequest) ToAlertRule(ctx context.Context) *alert.Rule {
+	return &alert.Rule{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_ALERT_RULE),
+		Name:           r.Name,
+		AlertType:      r.AlertType,
+		FeatureID:      r.FeatureID,
+		CustomerID:     r.CustomerID,
+		SubscriptionID: r.SubscriptionID,
+		Currency:       strings.ToLower(r.Currency),
+		Thresholds:     alert.SortThresholds(r.Thresholds),
+		Metadata:       r.Metadata,
+		EnvironmentID:  types.GetEnvironmentID(ctx),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+}
+
+type UpdateAlertRuleRequest struct {
+	Name       *string           `json:"name,omitempty"`
+	Thresholds []decimal.Decimal `json:"thresholds,omitempty"`
+	Metadata   *types.Metadata   `json:"metadata,omitempty"`
+}
+
+func (r *UpdateAlertRuleRequest) Validate() error {
+	if r.Name != nil && *r.Name == "" {
+		return ierr.NewError("name cannot be empty").
+			WithHint("Please provide a name for the alert rule").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Thresholds != nil {
+		return validateAlertThresholds(r.Thresholds)
+	}
+
+	return nil
+}
+
+// validateAlertThresholds checks the thresholds are positive and distinct
+func validateAlertThresholds(thresholds []decimal.Decimal) error {
+	if len(thresholds) == 0 {
+		return ierr.NewError("at least one threshold is required").
+			WithHint("Please provide the thresholds to alert on").
+			Mark(ierr.ErrValidation)
+	}
+
+	seen := make(map[string]bool, len(thresholds))
+	for _, threshold := range thresholds {
+		if !threshold.IsPositive() {
+			return ierr.NewError("thresholds must be greater than 0").
+				WithHint("Thresholds must be positive numbers").
+				WithReportableDetails(map[string]any{
+					"threshold": threshold.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		key := threshold.String()
+		if seen[key] {
+			return ierr.NewError("thresholds must be unique").
+				WithHint("Remove the duplicated threshold").
+				WithReportableDetails(map[string]any{
+					"threshold": key,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+type AlertRuleResponse struct {
+	*alert.Rule
+}
+
+// ListAlertRulesResponse represents a paginated list of alert rules
+type ListAlertRulesResponse = types.ListResponse[*AlertRuleResponse]
+
+type AlertEventResponse struct {
+	*alert.Event
+}
+
+// ListAlertEventsResponse represents a paginated list of alert events
+type ListAlertEventsResponse = types.ListResponse[*AlertEventResponse]
+
+// EvaluateAlertsResponse summarizes a run of the alert evaluation
+type EvaluateAlertsResponse struct {
+	// TotalCrossed is the number of thresholds crossed during the run
+	TotalCrossed int `json:"total_crossed"`
+	// TotalFailed is the number of alert rules that could not be evaluated
+	TotalFailed int                   `json:"total_failed"`
+	Items       []*AlertEventResponse `json:"items"`
+	StartAt     time.Time             `json:"start_at"`
+
+	// TenantErrors are the tenants whose alert rules could not be listed, the other tenants are still evaluated
+	TenantErrors []*EvaluateAlertsTenantError `json:"tenant_errors,omitempty"`
+}
+
+// EvaluateAlertsTenantError is the error that stopped the alert evaluation of a tenant
+type EvaluateAlertsTenantError struct {
+	TenantID string `json:"tenant_id"`
+	Error    string `json:"error"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// validateCommitment validates the minimum commitment of a plan or a subscription, the interval
+// can only be set along with the amount
+func validateCommitment(amount *decimal.Decimal, interval types.CommitmentInterval) error {
+	if amount == nil {
+		if interval != "" {
+			return ierr.NewError("commitment_amount is required").
+				WithHint("Please provide the commitment amount for the commitment interval").
+				WithReportableDetails(map[string]interface{}{
+					"commitment_interval": interval,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		return nil
+	}
+
+	if amount.LessThan(decimal.Zero) {
+		return ierr.NewError("commitment_amount cannot be negative").
+			WithHint("Commitment amount cannot be negative").
+			WithReportableDetails(map[string]interface{}{
+				"commitment_amount": amount.String(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if interval != "" {
+		return interval.Validate()
+	}
+
+	return nil
+}
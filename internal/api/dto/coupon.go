@@ -0,0 +1,183 @@
+package dto
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/shopspring/decimal"
+)
+
+type CreateCouponRequest struct {
+	Name              string                   `json:"name" validate:"required"`
+	Code              string                   `json:"code,omitempty"`
+	DiscountType      types.CouponDiscountType `json:"discount_type" validate:"required"`
+	PercentOff        *decimal.Decimal         `json:"percent_off,omitempty"`
+	AmountOff         *decimal.Decimal         `json:"amount_off,omitempty"`
+	Currency          string                   `json:"currency,omitempty"`
+	Duration          types.CouponDuration     `json:"duration" validate:"required"`
+	DurationInPeriods *int                     `json:"duration_in_periods,omitempty"`
+	MaxRedemptions    *int                     `json:"max_redemptions,omitempty"`
+	RedeemBy          *time.Time               `json:"redeem_by,omitempty"`
+	Metadata          types.Metadata           `json:"metadata,omitempty"`
+}
+
+func (r *CreateCouponRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if err := r.DiscountType.Validate(); err != nil {
+		return err
+	}
+
+	if err := r.Duration.Validate(); err != nil {
+		return err
+	}
+
+	switch r.DiscountType {
+	case types.CouponDiscountTypePercentage:
+		if r.PercentOff == nil {
+			return ierr.NewError("percent_off is required for percentage coupons").
+				WithHint("Please provide the percentage to discount").
+				Mark(ierr.ErrValidation)
+		}
+		if !r.PercentOff.IsPositive() || r.PercentOff.GreaterThan(decimal.NewFromInt(100)) {
+			return ierr.NewError("percent_off must be greater than 0 and at most 100").
+				WithHint("Percent off must be between 0 and 100").
+				WithReportableDetails(map[string]any{
+					"percent_off": r.PercentOff.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if r.AmountOff != nil {
+			return ierr.NewError("amount_off is not allowed for percentage coupons").
+				WithHint("Please provide only percent off for percentage coupons").
+				Mark(ierr.ErrValidation)
+		}
+	case types.CouponDiscountTypeFixedAmount:
+		if r.AmountOff == nil || !r.AmountOff.IsPositive() {
+			return ierr.NewError("amount_off must be greater than 0 for fixed amount coupons").
+				WithHint("Please provide a positive amount to discount").
+				Mark(ierr.ErrValidation)
+		}
+		if r.PercentOff != nil {
+			return ierr.NewError("percent_off is not allowed for fixed amount coupons").
+				WithHint("Please provide only amount off for fixed amount coupons").
+				Mark(ierr.ErrValidation)
+		}
+		if err := types.ValidateCurrencyCode(r.Currency); err != nil {
+			return err
+		}
+	}
+
+	if r.Duration == types.CouponDurationRepeating {
+		if r.DurationInPeriods == nil || *r.DurationInPeriods <= 0 {
+			return ierr.NewError("duration_in_periods must be greater than 0 for repeating coupons").
+				WithHint("Please provide the number of billing periods the coupon applies to").
+				Mark(ierr.ErrValidation)
+		}
+	} else if r.DurationInPeriods != nil {
+		return ierr.NewError("duration_in_periods is only allowed for repeating coupons").
+			WithHint("Remove duration in periods or use a repeating duration").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.MaxRedemptions != nil && *r.MaxRedemptions <= 0 {
+		return ierr.NewError("max_redemptions must be greater than 0").
+			WithHint("Max redemptions must be a positive number").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.RedeemBy != nil && r.RedeemBy.Before(time.Now().UTC()) {
+		return ierr.NewError("redeem_by must be in the future").
+			WithHint("Redeem by date must be in the future").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+func (r *CreateCouponRequest) ToCoupon(ctx context.Context) *coupon.Coupon {
+	return &coupon.Coupon{
+		ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_COUPON),
+		Name:              r.Name,
+		Code:              r.Code,
+		DiscountType:      r.DiscountType,
+		PercentOff:        r.PercentOff,
+		AmountOff:         r.AmountOff,
+		Currency:          strings.ToLower(r.Currency),
+		Duration:          r.Duration,
+		DurationInPeriods: r.DurationInPeriods,
+		MaxRedemptions:    r.MaxRedemptions,
+		RedeemBy:          r.RedeemBy,
+		Metadata:          r.Metadata,
+		EnvironmentID:     types.GetEnvironmentID(ctx),
+		BaseModel:         types.GetDefaultBaseModel(ctx),
+	}
+}
+
+type UpdateCouponRequest struct {
+	Name           *string         `json:"name,omitempty"`
+	MaxRedemptions *int            `json:"max_redemptions,omitempty"`
+	RedeemBy       *time.Time      `json:"redeem_by,omitempty"`
+	Metadata       *types.Metadata `json:"metadata,omitempty"`
+}
+
+func (r *UpdateCouponRequest) Validate() error {
+	if r.Name != nil && *r.Name == "" {
+		return ierr.NewError("name cannot be empty").
+			WithHint("Please provide a name for the coupon").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.MaxRedemptions != nil && *r.MaxRedemptions <= 0 {
+		return ierr.NewError("max_redemptions must be greater than 0").
+			WithHint("Max redemptions must be a positive number").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type CouponResponse struct {
+	*coupon.Coupon
+}
+
+// ListCouponsResponse represents a paginated list of coupons
+type ListCouponsResponse = types.ListResponse[*CouponResponse]
+
+// ApplyCouponRequest applies a coupon to a customer, or to a single subscription of the customer
+type ApplyCouponRequest struct {
+	CustomerID     string         `json:"customer_id" validate:"required"`
+	SubscriptionID *string        `json:"subscription_id,omitempty"`
+	Metadata       types.Metadata `json:"metadata,omitempty"`
+}
+
+func (r *ApplyCouponRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+func (r *ApplyCouponRequest) ToCouponApplication(ctx context.Context, couponID string) *coupon.CouponApplication {
+	return &coupon.CouponApplication{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_COUPON_APPLICATION),
+		CouponID:       couponID,
+		CustomerID:     r.CustomerID,
+		SubscriptionID: r.SubscriptionID,
+		Metadata:       r.Metadata,
+		EnvironmentID:  types.GetEnvironmentID(ctx),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+}
+
+type CouponApplicationResponse struct {
+	*coupon.CouponApplication
+	Coupon *CouponResponse `json:"coupon,omitempty"`
+}
+
+// ListCouponApplicationsResponse represents a paginated list of coupon applications
+type ListCouponApplicationsResponse = types.ListResponse[*CouponApplicationResponse]
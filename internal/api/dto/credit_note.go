@@ -0,0 +1,123 @@
+package dto
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/creditnote"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/shopspring/decimal"
+)
+
+type CreateCreditNoteRequest struct {
+	InvoiceID string                  `json:"invoice_id" validate:"required"`
+	Reason    types.CreditNoteReason  `json:"reason" validate:"required"`
+	Outcome   types.CreditNoteOutcome `json:"outcome" validate:"required"`
+	Memo      string                  `json:"memo,omitempty"`
+
+	// WalletID is the wallet credited by WALLET_CREDIT credit notes, defaults to an active wallet
+	// of the customer in the invoice currency
+	WalletID *string `json:"wallet_id,omitempty"`
+
+	LineItems []CreateCreditNoteLineItemRequest `json:"line_items" validate:"required,min=1,dive"`
+	Metadata  types.Metadata                    `json:"metadata,omitempty"`
+}
+
+type CreateCreditNoteLineItemRequest struct {
+	// InvoiceLineItemID is the invoice line item being credited, leave empty for adjustments
+	// that are not tied to a single line item
+	InvoiceLineItemID *string `json:"invoice_line_item_id,omitempty"`
+
+	// DisplayName defaults to the display name of the invoice line item
+	DisplayName string          `json:"display_name,omitempty"`
+	Amount      decimal.Decimal `json:"amount" swaggertype:"string"`
+	Metadata    types.Metadata  `json:"metadata,omitempty"`
+}
+
+func (r *CreateCreditNoteRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if err := r.Reason.Validate(); err != nil {
+		return err
+	}
+
+	if err := r.Outcome.Validate(); err != nil {
+		return err
+	}
+
+	if r.WalletID != nil && r.Outcome != types.CreditNoteOutcomeWalletCredit {
+		return ierr.NewError("wallet_id is only allowed for wallet credits").
+			WithHint("Remove the wallet ID or use the WALLET_CREDIT outcome").
+			Mark(ierr.ErrValidation)
+	}
+
+	for i, item := range r.LineItems {
+		if !item.Amount.IsPositive() {
+			return ierr.NewError("line item amount must be greater than 0").
+				WithHint("Credited amounts must be greater than zero").
+				WithReportableDetails(map[string]any{
+					"line_item_index": i,
+					"amount":          item.Amount.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if item.InvoiceLineItemID == nil && item.DisplayName == "" {
+			return ierr.NewError("display_name is required for adjustments").
+				WithHint("Please provide a display name for line items that do not reference an invoice line item").
+				WithReportableDetails(map[string]any{
+					"line_item_index": i,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+// ToCreditNote converts the request to a credit note of the given invoice
+func (r *CreateCreditNoteRequest) ToCreditNote(ctx context.Context, inv *invoice.Invoice) *creditnote.CreditNote {
+	note := &creditnote.CreditNote{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_CREDIT_NOTE),
+		InvoiceID:      inv.ID,
+		CustomerID:     inv.CustomerID,
+		SubscriptionID: inv.SubscriptionID,
+		Reason:         r.Reason,
+		Outcome:        r.Outcome,
+		Memo:           r.Memo,
+		Currency:       inv.Currency,
+		TotalAmount:    decimal.Zero,
+		LineItems:      make([]*creditnote.CreditNoteLineItem, len(r.LineItems)),
+		Metadata:       r.Metadata,
+		EnvironmentID:  types.GetEnvironmentID(ctx),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+
+	for i, item := range r.LineItems {
+		note.LineItems[i] = &creditnote.CreditNoteLineItem{
+			ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_CREDIT_NOTE_LINE_ITEM),
+			CreditNoteID:      note.ID,
+			InvoiceLineItemID: item.InvoiceLineItemID,
+			DisplayName:       item.DisplayName,
+			Amount:            item.Amount,
+			Currency:          inv.Currency,
+			Metadata:          item.Metadata,
+			EnvironmentID:     note.EnvironmentID,
+			BaseModel:         types.GetDefaultBaseModel(ctx),
+		}
+		note.TotalAmount = note.TotalAmount.Add(item.Amount)
+	}
+
+	return note
+}
+
+type CreditNoteResponse struct {
+	*creditnote.CreditNote
+}
+
+// ListCreditNotesResponse represents a paginated list of credit notes
+type ListCreditNotesResponse = types.ListResponse[*CreditNoteResponse]
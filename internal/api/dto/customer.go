@@ -18,6 +18,9 @@ type CreateCustomerRequest struct {
 	AddressState      string            `json:"address_state" validate:"omitempty,max=100"`
 	AddressPostalCode string            `json:"address_postal_code" validate:"omitempty,max=20"`
 	AddressCountry    string            `json:"address_country" validate:"omitempty,len=2,iso3166_1_alpha2"`
+	TaxIDs            []customer.TaxID  `json:"tax_ids,omitempty" validate:"omitempty,dive"`
+	TaxExempt         bool              `json:"tax_exempt"`
+	AutoApplyCredits  bool              `json:"auto_apply_credits"`
 	Metadata          map[string]string `json:"metadata,omitempty"`
 }
 
@@ -31,6 +34,9 @@ type UpdateCustomerRequest struct {
 	AddressState      *string           `json:"address_state" validate:"omitempty,max=100"`
 	AddressPostalCode *string           `json:"address_postal_code" validate:"omitempty,max=20"`
 	AddressCountry    *string           `json:"address_country" validate:"omitempty,len=2,iso3166_1_alpha2"`
+	TaxIDs            []customer.TaxID  `json:"tax_ids,omitempty" validate:"omitempty,dive"`
+	TaxExempt         *bool             `json:"tax_exempt,omitempty"`
+	AutoApplyCredits  *bool             `json:"auto_apply_credits,omitempty"`
 	Metadata          map[string]string `json:"metadata,omitempty"`
 }
 
@@ -57,6 +63,9 @@ func (r *CreateCustomerRequest) ToCustomer(ctx context.Context) *customer.Custom
 		AddressState:      r.AddressState,
 		AddressPostalCode: r.AddressPostalCode,
 		AddressCountry:    r.AddressCountry,
+		TaxIDs:            r.TaxIDs,
+		TaxExempt:         r.TaxExempt,
+		AutoApplyCredits:  r.AutoApplyCredits,
 		Metadata:          r.Metadata,
 		EnvironmentID:     types.GetEnvironmentID(ctx),
 		BaseModel:         types.GetDefaultBaseModel(ctx),
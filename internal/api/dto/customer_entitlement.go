@@ -3,6 +3,7 @@ package dto
 import (
 	"time"
 
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/validator"
 	"github.com/shopspring/decimal"
@@ -100,3 +101,46 @@ type FeatureUsageSummary struct {
 	IsSoftLimit  bool                 `json:"is_soft_limit"`
 	Sources      []*EntitlementSource `json:"sources"`
 }
+
+// CheckEntitlementRequest represents the request for checking if a customer can use a quantity of a feature
+type CheckEntitlementRequest struct {
+	ExternalCustomerID string `json:"external_customer_id" validate:"required"`
+	FeatureID          string `json:"feature_id" validate:"required"`
+	// Quantity is the usage requested, defaults to 1
+	Quantity *decimal.Decimal `json:"quantity,omitempty"`
+}
+
+func (r *CheckEntitlementRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.Quantity != nil && r.Quantity.IsNegative() {
+		return ierr.NewError("quantity must not be negative").
+			WithHint("Please provide a positive quantity").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// GetQuantity returns the requested quantity, defaulting to 1
+func (r *CheckEntitlementRequest) GetQuantity() decimal.Decimal {
+	if r.Quantity == nil {
+		return decimal.NewFromInt(1)
+	}
+	return *r.Quantity
+}
+
+// CheckEntitlementResponse represents the outcome of an entitlement check
+type CheckEntitlementResponse struct {
+	Allowed      bool                         `json:"allowed"`
+	Reason       types.EntitlementCheckReason `json:"reason,omitempty"`
+	CustomerID   string                       `json:"customer_id"`
+	FeatureID    string                       `json:"feature_id"`
+	FeatureType  types.FeatureType            `json:"feature_type,omitempty"`
+	IsSoftLimit  bool                         `json:"is_soft_limit"`
+	UsageLimit   *int64                       `json:"usage_limit,omitempty"`
+	CurrentUsage decimal.Decimal              `json:"current_usage"`
+	// Remaining is the quantity left before reaching the usage limit, nil when the feature is unlimited
+	Remaining *decimal.Decimal `json:"remaining,omitempty"`
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/dunning"
+)
+
+// DunningAttemptResponse represents a payment retry of an unpaid invoice in API responses
+type DunningAttemptResponse struct {
+	*dunning.Attempt
+}
+
+// ListDunningAttemptsResponse represents the payment retries of an invoice in API responses
+type ListDunningAttemptsResponse struct {
+	Items []*DunningAttemptResponse `json:"items"`
+	Total int                       `json:"total"`
+}
+
+// NewListDunningAttemptsResponse creates a new list dunning attempts response
+func NewListDunningAttemptsResponse(attempts []*dunning.Attempt) *ListDunningAttemptsResponse {
+	items := make([]*DunningAttemptResponse, len(attempts))
+	for i, attempt := range attempts {
+		items[i] = &DunningAttemptResponse{
+			Attempt: attempt,
+		}
+	}
+
+	return &ListDunningAttemptsResponse{
+		Items: items,
+		Total: len(items),
+	}
+}
+
+// ProcessDunningResponse summarizes a run of the dunning process
+type ProcessDunningResponse struct {
+	TotalSuccess int                           `json:"total_success"`
+	TotalFailed  int                           `json:"total_failed"`
+	Items        []*ProcessDunningResponseItem `json:"items"`
+	StartAt      time.Time                     `json:"start_at"`
+
+	// TenantErrors are the tenants whose dunning could not be processed, the other tenants are still processed
+	TenantErrors []*ProcessDunningTenantError `json:"tenant_errors,omitempty"`
+}
+
+// ProcessDunningTenantError is the error that stopped the dunning of a tenant
+type ProcessDunningTenantError struct {
+	TenantID string `json:"tenant_id"`
+	Error    string `json:"error"`
+}
+
+// ProcessDunningResponseItem is the outcome of the dunning of an invoice
+type ProcessDunningResponseItem struct {
+	InvoiceID      string  `json:"invoice_id"`
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+
+	// Actions are what the dunning process did for the invoice, e.g. retry or past_due
+	Actions []string `json:"actions"`
+	Success bool     `json:"success"`
+	Error   string   `json:"error,omitempty"`
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/validator"
 )
@@ -56,6 +57,9 @@ type GetUsageRequest struct {
 	EndTime            time.Time             `form:"end_time" json:"end_time" example:"2024-03-20T00:00:00Z"`
 	WindowSize         types.WindowSize      `form:"window_size" json:"window_size"`
 	Filters            map[string][]string   `form:"filters,omitempty" json:"filters,omitempty"`
+	// FilterOperators optionally sets the operator per filter key, ex {"tokens": "GT"}
+	// Keys are matched with IN when no operator is provided
+	FilterOperators map[string]types.FilterOperator `form:"-" json:"filter_operators,omitempty"`
 }
 
 type GetUsageByMeterRequest struct {
@@ -133,7 +137,30 @@ func FromAggregationResult(result *events.AggregationResult) *GetUsageResponse {
 }
 
 func (r *GetUsageRequest) Validate() error {
-	return validator.ValidateRequest(r)
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	for key, operator := range r.FilterOperators {
+		if err := operator.Validate(); err != nil {
+			return err
+		}
+		if err := operator.ValidateValues(r.Filters[key]); err != nil {
+			return err
+		}
+	}
+
+	aggregationType := types.AggregationType(strings.ToUpper(string(r.AggregationType)))
+	if aggregationType.RequiresTimeRange() && (r.StartTime.IsZero() || r.EndTime.IsZero()) {
+		return ierr.NewError("start_time and end_time are required for this aggregation type").
+			WithHint("Please provide both start_time and end_time").
+			WithReportableDetails(map[string]interface{}{
+				"aggregation_type": r.AggregationType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
 }
 
 func (r *GetUsageRequest) ToUsageParams() *events.UsageParams {
@@ -151,6 +178,7 @@ func (r *GetUsageRequest) ToUsageParams() *events.UsageParams {
 		EndTime:            r.EndTime,
 		WindowSize:         r.WindowSize,
 		Filters:            r.Filters,
+		FilterOperators:    r.FilterOperators,
 	}
 }
 
@@ -30,8 +30,10 @@ type CreateInvoiceRequest struct {
 	PaymentStatus  *types.PaymentStatus           `json:"payment_status,omitempty"`
 	AmountPaid     *decimal.Decimal               `json:"amount_paid,omitempty"`
 	LineItems      []CreateInvoiceLineItemRequest `json:"line_items,omitempty"`
-	Metadata       types.Metadata                 `json:"metadata,omitempty"`
-	EnvironmentID  string                         `json:"environment_id,omitempty"`
+	// Discounts are the coupon discounts applied to the sum of the line items
+	Discounts     []invoice.InvoiceDiscount `json:"discounts,omitempty"`
+	Metadata      types.Metadata            `json:"metadata,omitempty"`
+	EnvironmentID string                    `json:"environment_id,omitempty"`
 }
 
 func (r *CreateInvoiceRequest) Validate() error {
@@ -83,6 +85,18 @@ func (r *CreateInvoiceRequest) Validate() error {
 		}
 	}
 
+	for _, discount := range r.Discounts {
+		if discount.Amount.IsNegative() {
+			return ierr.NewError("discount amount must be non-negative").
+				WithHint("discount amount is negative").
+				WithReportableDetails(map[string]any{
+					"coupon_id": discount.CouponID,
+					"amount":    discount.Amount.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
 	// Validate line items if present
 	if len(r.LineItems) > 0 {
 		var totalAmount decimal.Decimal
@@ -93,15 +107,25 @@ func (r *CreateInvoiceRequest) Validate() error {
 			totalAmount = totalAmount.Add(item.Amount)
 		}
 
-		// Verify total amount matches invoice amount
+		// Verify total amount after discounts matches invoice amount
+		totalAmount = totalAmount.Sub(r.GetTotalDiscount())
 		if !totalAmount.Equal(r.AmountDue) {
-			return ierr.NewError("sum of line item amounts must equal invoice amount_due").WithHintf("sum of line item amounts %s must equal invoice amount_due %s", totalAmount.String(), r.AmountDue.String()).Mark(ierr.ErrValidation)
+			return ierr.NewError("sum of line item amounts less discounts must equal invoice amount_due").WithHintf("sum of line item amounts less discounts %s must equal invoice amount_due %s", totalAmount.String(), r.AmountDue.String()).Mark(ierr.ErrValidation)
 		}
 	}
 
 	return nil
 }
 
+// GetTotalDiscount returns the sum of all discounts in the request
+func (r *CreateInvoiceRequest) GetTotalDiscount() decimal.Decimal {
+	total := decimal.Zero
+	for _, discount := range r.Discounts {
+		total = total.Add(discount.Amount)
+	}
+	return total
+}
+
 // ToInvoice converts a create invoice request to an invoice
 func (r *CreateInvoiceRequest) ToInvoice(ctx context.Context) (*invoice.Invoice, error) {
 	// Validate currency
@@ -115,6 +139,10 @@ func (r *CreateInvoiceRequest) ToInvoice(ctx context.Context) (*invoice.Invoice,
 		SubscriptionID:  r.SubscriptionID,
 		InvoiceType:     r.InvoiceType,
 		Currency:        strings.ToLower(r.Currency),
+		Subtotal:        r.AmountDue.Add(r.GetTotalDiscount()),
+		TotalDiscount:   r.GetTotalDiscount(),
+		Total:           r.AmountDue,
+		Discounts:       r.Discounts,
 		AmountDue:       r.AmountDue,
 		Description:     r.Description,
 		DueDate:         r.DueDate,
@@ -170,7 +198,9 @@ type CreateInvoiceLineItemRequest struct {
 	Quantity         decimal.Decimal `json:"quantity" validate:"required"`
 	PeriodStart      *time.Time      `json:"period_start,omitempty"`
 	PeriodEnd        *time.Time      `json:"period_end,omitempty"`
-	Metadata         types.Metadata  `json:"metadata,omitempty"`
+	// TierBreakdown is the per tier quantity and amount for tiered prices
+	TierBreakdown []invoice.LineItemTier `json:"tier_breakdown,omitempty"`
+	Metadata      types.Metadata         `json:"metadata,omitempty"`
 }
 
 func (r *CreateInvoiceLineItemRequest) Validate(invoiceType types.InvoiceType) error {
@@ -227,6 +257,7 @@ func (r *CreateInvoiceLineItemRequest) ToInvoiceLineItem(ctx context.Context, in
 		Currency:         inv.Currency,
 		PeriodStart:      r.PeriodStart,
 		PeriodEnd:        r.PeriodEnd,
+		TierBreakdown:    r.TierBreakdown,
 		Metadata:         r.Metadata,
 		EnvironmentID:    types.GetEnvironmentID(ctx),
 		BaseModel:        types.GetDefaultBaseModel(ctx),
@@ -251,13 +282,15 @@ type InvoiceLineItemResponse struct {
 	Currency         string          `json:"currency"`
 	PeriodStart      *time.Time      `json:"period_start,omitempty"`
 	PeriodEnd        *time.Time      `json:"period_end,omitempty"`
-	Metadata         types.Metadata  `json:"metadata,omitempty"`
-	TenantID         string          `json:"tenant_id"`
-	Status           string          `json:"status"`
-	CreatedAt        time.Time       `json:"created_at"`
-	UpdatedAt        time.Time       `json:"updated_at"`
-	CreatedBy        string          `json:"created_by,omitempty"`
-	UpdatedBy        string          `json:"updated_by,omitempty"`
+	// TierBreakdown is the per tier quantity and amount for tiered prices
+	TierBreakdown []invoice.LineItemTier `json:"tier_breakdown,omitempty"`
+	Metadata      types.Metadata         `json:"metadata,omitempty"`
+	TenantID      string                 `json:"tenant_id"`
+	Status        string                 `json:"status"`
+	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+	CreatedBy     string                 `json:"created_by,omitempty"`
+	UpdatedBy     string                 `json:"updated_by,omitempty"`
 }
 
 func NewInvoiceLineItemResponse(item *invoice.InvoiceLineItem) *InvoiceLineItemResponse {
@@ -282,6 +315,7 @@ func NewInvoiceLineItemResponse(item *invoice.InvoiceLineItem) *InvoiceLineItemR
 		Currency:         item.Currency,
 		PeriodStart:      item.PeriodStart,
 		PeriodEnd:        item.PeriodEnd,
+		TierBreakdown:    item.TierBreakdown,
 		Metadata:         item.Metadata,
 		TenantID:         item.TenantID,
 		Status:           string(item.Status),
@@ -333,9 +367,16 @@ type InvoiceResponse struct {
 	InvoiceStatus   types.InvoiceStatus        `json:"invoice_status"`
 	PaymentStatus   types.PaymentStatus        `json:"payment_status"`
 	Currency        string                     `json:"currency"`
+	Subtotal        decimal.Decimal            `json:"subtotal"`
+	TotalDiscount   decimal.Decimal            `json:"total_discount"`
+	Total           decimal.Decimal            `json:"total"`
+	Discounts       []invoice.InvoiceDiscount  `json:"discounts,omitempty"`
+	TotalTax        decimal.Decimal            `json:"total_tax"`
+	Taxes           []invoice.InvoiceTax       `json:"taxes,omitempty"`
 	AmountDue       decimal.Decimal            `json:"amount_due"`
 	AmountPaid      decimal.Decimal            `json:"amount_paid"`
 	AmountRemaining decimal.Decimal            `json:"amount_remaining"`
+	AmountCredited  decimal.Decimal            `json:"amount_credited"`
 	InvoiceNumber   *string                    `json:"invoice_number,omitempty"`
 	IdempotencyKey  *string                    `json:"idempotency_key,omitempty"`
 	BillingSequence *int                       `json:"billing_sequence,omitempty"`
@@ -377,9 +418,16 @@ func NewInvoiceResponse(inv *invoice.Invoice) *InvoiceResponse {
 		InvoiceStatus:   inv.InvoiceStatus,
 		PaymentStatus:   inv.PaymentStatus,
 		Currency:        inv.Currency,
+		Subtotal:        inv.Subtotal,
+		TotalDiscount:   inv.TotalDiscount,
+		Total:           inv.Total,
+		Discounts:       inv.Discounts,
+		TotalTax:        inv.TotalTax,
+		Taxes:           inv.Taxes,
 		AmountDue:       inv.AmountDue,
 		AmountPaid:      inv.AmountPaid,
 		AmountRemaining: inv.AmountRemaining,
+		AmountCredited:  inv.AmountCredited,
 		InvoiceNumber:   inv.InvoiceNumber,
 		IdempotencyKey:  inv.IdempotencyKey,
 		BillingSequence: inv.BillingSequence,
@@ -7,6 +7,8 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 type CreatePlanRequest struct {
@@ -15,6 +17,11 @@ type CreatePlanRequest struct {
 	Description  string                         `json:"description"`
 	Prices       []CreatePlanPriceRequest       `json:"prices"`
 	Entitlements []CreatePlanEntitlementRequest `json:"entitlements"`
+
+	// CommitmentAmount is the default minimum usage spend of the subscriptions on the plan,
+	// CommitmentInterval defaults to the billing period
+	CommitmentAmount   *decimal.Decimal         `json:"commitment_amount,omitempty" swaggertype:"string"`
+	CommitmentInterval types.CommitmentInterval `json:"commitment_interval,omitempty"`
 }
 
 type CreatePlanPriceRequest struct {
@@ -43,6 +50,10 @@ func (r *CreatePlanRequest) Validate() error {
 		}
 	}
 
+	if err := validateCommitment(r.CommitmentAmount, r.CommitmentInterval); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -52,9 +63,14 @@ func (r *CreatePlanRequest) ToPlan(ctx context.Context) *plan.Plan {
 		LookupKey:     r.LookupKey,
 		Name:          r.Name,
 		Description:   r.Description,
+		Version:       1,
 		EnvironmentID: types.GetEnvironmentID(ctx),
 		BaseModel:     types.GetDefaultBaseModel(ctx),
 	}
+	if r.CommitmentAmount != nil {
+		plan.CommitmentAmount = r.CommitmentAmount
+		plan.CommitmentInterval = lo.Ternary(r.CommitmentInterval != "", r.CommitmentInterval, types.CommitmentIntervalBillingPeriod)
+	}
 	return plan
 }
 
@@ -80,6 +96,20 @@ type UpdatePlanRequest struct {
 	Description  *string                        `json:"description,omitempty"`
 	Prices       []UpdatePlanPriceRequest       `json:"prices,omitempty"`
 	Entitlements []UpdatePlanEntitlementRequest `json:"entitlements,omitempty"`
+
+	// CommitmentAmount and CommitmentInterval change the default commitment of the plan, the
+	// subscriptions already on the plan keep their commitment
+	CommitmentAmount   *decimal.Decimal         `json:"commitment_amount,omitempty" swaggertype:"string"`
+	CommitmentInterval types.CommitmentInterval `json:"commitment_interval,omitempty"`
+}
+
+func (r *UpdatePlanRequest) Validate() error {
+	// The interval can be changed on its own for a plan that already has a commitment
+	if r.CommitmentAmount == nil && r.CommitmentInterval != "" {
+		return r.CommitmentInterval.Validate()
+	}
+
+	return validateCommitment(r.CommitmentAmount, r.CommitmentInterval)
 }
 
 type UpdatePlanPriceRequest struct {
@@ -30,6 +30,7 @@ type CreatePriceRequest struct {
 	TierMode           types.BillingTier        `json:"tier_mode,omitempty"`
 	Tiers              []CreatePriceTier        `json:"tiers,omitempty"`
 	TransformQuantity  *price.TransformQuantity `json:"transform_quantity,omitempty"`
+	MinimumCharge      *string                  `json:"minimum_charge,omitempty"`
 }
 
 type CreatePriceTier struct {
@@ -126,6 +127,39 @@ func (r *CreatePriceRequest) Validate() error {
 				WithHint("Please provide a valid number of units to set up package pricing").
 				Mark(ierr.ErrValidation)
 		}
+
+		if r.TransformQuantity.IncludedQuantity < 0 {
+			return ierr.NewError("transform_quantity.included_quantity cannot be negative").
+				WithHint("Please provide a non-negative number of included units").
+				Mark(ierr.ErrValidation)
+		}
+
+		if r.TransformQuantity.MinPackages < 0 {
+			return ierr.NewError("transform_quantity.min_packages cannot be negative").
+				WithHint("Please provide a non-negative minimum number of packages").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if r.MinimumCharge != nil {
+		minimumCharge, err := decimal.NewFromString(*r.MinimumCharge)
+		if err != nil {
+			return ierr.NewError("invalid minimum_charge").
+				WithHint("Minimum charge must be a valid decimal number").
+				WithReportableDetails(map[string]interface{}{
+					"minimum_charge": *r.MinimumCharge,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if minimumCharge.LessThan(decimal.Zero) {
+			return ierr.NewError("minimum_charge cannot be negative").
+				WithHint("Minimum charge cannot be negative").
+				WithReportableDetails(map[string]interface{}{
+					"minimum_charge": *r.MinimumCharge,
+				}).
+				Mark(ierr.ErrValidation)
+		}
 	}
 
 	switch r.Type {
@@ -148,46 +182,8 @@ func (r *CreatePriceRequest) Validate() error {
 
 	// Validate tiers if present
 	if len(r.Tiers) > 0 && r.BillingModel == types.BILLING_MODEL_TIERED {
-		for _, tier := range r.Tiers {
-			tierAmount, err := decimal.NewFromString(tier.UnitAmount)
-			if err != nil {
-				return ierr.WithError(err).
-					WithHint("Unit amount must be a valid decimal number").
-					WithReportableDetails(map[string]interface{}{
-						"unit_amount": tier.UnitAmount,
-					}).
-					Mark(ierr.ErrValidation)
-			}
-
-			if tierAmount.LessThan(decimal.Zero) {
-				return ierr.WithError(err).
-					WithHint("Unit amount must be greater than 0").
-					WithReportableDetails(map[string]interface{}{
-						"unit_amount": tier.UnitAmount,
-					}).
-					Mark(ierr.ErrValidation)
-			}
-
-			if tier.FlatAmount != nil {
-				flatAmount, err := decimal.NewFromString(*tier.FlatAmount)
-				if err != nil {
-					return ierr.WithError(err).
-						WithHint("Flat amount must be a valid decimal number").
-						WithReportableDetails(map[string]interface{}{
-							"flat_amount": tier.FlatAmount,
-						}).
-						Mark(ierr.ErrValidation)
-				}
-
-				if flatAmount.LessThan(decimal.Zero) {
-					return ierr.WithError(err).
-						WithHint("Flat amount must be greater than 0").
-						WithReportableDetails(map[string]interface{}{
-							"flat_amount": tier.FlatAmount,
-						}).
-						Mark(ierr.ErrValidation)
-				}
-			}
+		if err := validateTierAmounts(r.Tiers); err != nil {
+			return err
 		}
 	}
 
@@ -237,47 +233,28 @@ func (r *CreatePriceRequest) ToPrice(ctx context.Context) (*price.Price, error)
 		metadata = price.JSONBMetadata(r.Metadata)
 	}
 
+	var minimumCharge *decimal.Decimal
+	if r.MinimumCharge != nil {
+		parsed, err := decimal.NewFromString(*r.MinimumCharge)
+		if err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Minimum charge must be a valid decimal number").
+				WithReportableDetails(map[string]interface{}{
+					"minimum_charge": *r.MinimumCharge,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		minimumCharge = &parsed
+	}
+
 	var transformQuantity price.JSONBTransformQuantity
 	if r.TransformQuantity != nil {
 		transformQuantity = price.JSONBTransformQuantity(*r.TransformQuantity)
 	}
 
-	var tiers price.JSONBTiers
-	if r.Tiers != nil {
-		priceTiers := make([]price.PriceTier, len(r.Tiers))
-		for i, tier := range r.Tiers {
-			unitAmount, err := decimal.NewFromString(tier.UnitAmount)
-			if err != nil {
-				return nil, ierr.WithError(err).
-					WithHint("Unit amount must be a valid decimal number").
-					WithReportableDetails(map[string]interface{}{
-						"unit_amount": tier.UnitAmount,
-					}).
-					Mark(ierr.ErrValidation)
-			}
-
-			var flatAmount *decimal.Decimal
-			if tier.FlatAmount != nil {
-				parsed, err := decimal.NewFromString(*tier.FlatAmount)
-				if err != nil {
-					return nil, ierr.WithError(err).
-						WithHint("Unit amount must be a valid decimal number").
-						WithReportableDetails(map[string]interface{}{
-							"flat_amount": tier.FlatAmount,
-						}).
-						Mark(ierr.ErrValidation)
-				}
-				flatAmount = &parsed
-			}
-
-			priceTiers[i] = price.PriceTier{
-				UpTo:       tier.UpTo,
-				UnitAmount: unitAmount,
-				FlatAmount: flatAmount,
-			}
-		}
-
-		tiers = price.JSONBTiers(priceTiers)
+	tiers, err := toPriceTiers(r.Tiers)
+	if err != nil {
+		return nil, err
 	}
 
 	price := &price.Price{
@@ -300,6 +277,7 @@ func (r *CreatePriceRequest) ToPrice(ctx context.Context) (*price.Price, error)
 		TierMode:           r.TierMode,
 		Tiers:              tiers,
 		TransformQuantity:  transformQuantity,
+		MinimumCharge:      minimumCharge,
 		EnvironmentID:      types.GetEnvironmentID(ctx),
 		BaseModel:          types.GetDefaultBaseModel(ctx),
 	}
@@ -307,6 +285,131 @@ func (r *CreatePriceRequest) ToPrice(ctx context.Context) (*price.Price, error)
 	return price, nil
 }
 
+// validateOverrideTiers validates that the tiers of a price override are ordered by up_to, that
+// only the last tier is unbounded and that the tier amounts are not negative
+func validateOverrideTiers(tiers []CreatePriceTier) error {
+	for i, tier := range tiers {
+		isLast := i == len(tiers)-1
+		if tier.UpTo == nil && !isLast {
+			return ierr.NewError("only the last tier can be unbounded").
+				WithHint("Please set up_to for every tier except the last one").
+				WithReportableDetails(map[string]interface{}{
+					"tier": i,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if tier.UpTo != nil && isLast {
+			return ierr.NewError("the last tier must be unbounded").
+				WithHint("Please leave up_to empty for the last tier").
+				WithReportableDetails(map[string]interface{}{
+					"tier":  i,
+					"up_to": *tier.UpTo,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if i > 0 && tier.UpTo != nil && *tier.UpTo <= *tiers[i-1].UpTo {
+			return ierr.NewError("tiers must be ordered by up_to").
+				WithHint("Please provide the tiers in increasing order of up_to").
+				WithReportableDetails(map[string]interface{}{
+					"tier":  i,
+					"up_to": *tier.UpTo,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return validateTierAmounts(tiers)
+}
+
+// validateTierAmounts validates that the unit and flat amounts of the tiers are not negative
+func validateTierAmounts(tiers []CreatePriceTier) error {
+	for _, tier := range tiers {
+		tierAmount, err := decimal.NewFromString(tier.UnitAmount)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Unit amount must be a valid decimal number").
+				WithReportableDetails(map[string]interface{}{
+					"unit_amount": tier.UnitAmount,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if tierAmount.LessThan(decimal.Zero) {
+			return ierr.NewError("unit amount cannot be negative").
+				WithHint("Unit amount cannot be negative").
+				WithReportableDetails(map[string]interface{}{
+					"unit_amount": tier.UnitAmount,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if tier.FlatAmount != nil {
+			flatAmount, err := decimal.NewFromString(*tier.FlatAmount)
+			if err != nil {
+				return ierr.WithError(err).
+					WithHint("Flat amount must be a valid decimal number").
+					WithReportableDetails(map[string]interface{}{
+						"flat_amount": tier.FlatAmount,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+
+			if flatAmount.LessThan(decimal.Zero) {
+				return ierr.NewError("flat amount cannot be negative").
+					WithHint("Flat amount cannot be negative").
+					WithReportableDetails(map[string]interface{}{
+						"flat_amount": tier.FlatAmount,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+		}
+	}
+
+	return nil
+}
+
+// toPriceTiers parses the tiers of a price request
+func toPriceTiers(tiers []CreatePriceTier) (price.JSONBTiers, error) {
+	if tiers == nil {
+		return nil, nil
+	}
+
+	priceTiers := make([]price.PriceTier, len(tiers))
+	for i, tier := range tiers {
+		unitAmount, err := decimal.NewFromString(tier.UnitAmount)
+		if err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Unit amount must be a valid decimal number").
+				WithReportableDetails(map[string]interface{}{
+					"unit_amount": tier.UnitAmount,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		var flatAmount *decimal.Decimal
+		if tier.FlatAmount != nil {
+			parsed, err := decimal.NewFromString(*tier.FlatAmount)
+			if err != nil {
+				return nil, ierr.WithError(err).
+					WithHint("Unit amount must be a valid decimal number").
+					WithReportableDetails(map[string]interface{}{
+						"flat_amount": tier.FlatAmount,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+			flatAmount = &parsed
+		}
+
+		priceTiers[i] = price.PriceTier{
+			UpTo:       tier.UpTo,
+			UnitAmount: unitAmount,
+			FlatAmount: flatAmount,
+		}
+	}
+
+	return price.JSONBTiers(priceTiers), nil
+}
+
 type UpdatePriceRequest struct {
 	LookupKey   string            `json:"lookup_key"`
 	Description string            `json:"description"`
@@ -26,6 +26,26 @@ type CreateSubscriptionRequest struct {
 	BillingPeriod      types.BillingPeriod  `json:"billing_period" validate:"required"`
 	BillingPeriodCount int                  `json:"billing_period_count" validate:"required,min=1"`
 	Metadata           map[string]string    `json:"metadata,omitempty"`
+
+	// TrialRequiresPaymentMethod cancels the subscription at the end of the trial instead of
+	// converting it to active when no payment method is on file
+	TrialRequiresPaymentMethod bool `json:"trial_requires_payment_method,omitempty"`
+
+	// PaymentMethodID is the payment method on file for the subscription
+	PaymentMethodID *string `json:"payment_method_id,omitempty"`
+
+	// PriceOverrides are the rates negotiated for the subscription, they replace the plan prices
+	// they override for this subscription only
+	PriceOverrides []SubscriptionPriceOverrideRequest `json:"price_overrides,omitempty"`
+
+	// CommitmentAmount is the minimum usage spend of the subscription per commitment interval,
+	// the commitment of the plan is used when not set
+	CommitmentAmount   *decimal.Decimal         `json:"commitment_amount,omitempty" swaggertype:"string"`
+	CommitmentInterval types.CommitmentInterval `json:"commitment_interval,omitempty"`
+
+	// AutoApplyCredits overrides the setting of the customer to pay the invoices of the
+	// subscription from the wallet balance when they are finalized
+	AutoApplyCredits *bool `json:"auto_apply_credits,omitempty"`
 }
 
 type UpdateSubscriptionRequest struct {
@@ -34,6 +54,20 @@ type UpdateSubscriptionRequest struct {
 	CancelAtPeriodEnd bool                     `json:"cancel_at_period_end,omitempty"`
 }
 
+// UpdateSubscriptionPaymentMethodRequest sets or clears the payment method on file
+type UpdateSubscriptionPaymentMethodRequest struct {
+	PaymentMethodID *string `json:"payment_method_id"`
+}
+
+func (r *UpdateSubscriptionPaymentMethodRequest) Validate() error {
+	if r.PaymentMethodID != nil && *r.PaymentMethodID == "" {
+		return ierr.NewError("payment_method_id cannot be empty").
+			WithHint("Payment method ID cannot be empty, omit it to remove the payment method").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
 type SubscriptionResponse struct {
 	*subscription.Subscription
 	Plan     *PlanResponse     `json:"plan"`
@@ -106,6 +140,22 @@ func (r *CreateSubscriptionRequest) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
+	if r.TrialEnd != nil && !r.TrialEnd.After(r.StartDate) {
+		return ierr.NewError("trial_end must be after start_date").
+			WithHint("Trial end date must be after start date").
+			WithReportableDetails(map[string]interface{}{
+				"start_date": r.StartDate,
+				"trial_end":  *r.TrialEnd,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.PaymentMethodID != nil && *r.PaymentMethodID == "" {
+		return ierr.NewError("payment_method_id cannot be empty").
+			WithHint("Payment method ID cannot be empty").
+			Mark(ierr.ErrValidation)
+	}
+
 	if r.TrialEnd != nil && r.TrialEnd.Before(r.StartDate) {
 		return ierr.NewError("trial_end cannot be before start_date").
 			WithHint("Trial end date must be after or equal to start date").
@@ -116,6 +166,28 @@ func (r *CreateSubscriptionRequest) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
+	overriddenPriceIDs := make(map[string]bool, len(r.PriceOverrides))
+	for i := range r.PriceOverrides {
+		override := &r.PriceOverrides[i]
+		if err := override.Validate(); err != nil {
+			return err
+		}
+
+		if overriddenPriceIDs[override.PriceID] {
+			return ierr.NewError("price is overridden more than once").
+				WithHint("Each price of the plan can only be overridden once").
+				WithReportableDetails(map[string]interface{}{
+					"price_id": override.PriceID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		overriddenPriceIDs[override.PriceID] = true
+	}
+
+	if err := validateCommitment(r.CommitmentAmount, r.CommitmentInterval); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -143,6 +215,12 @@ func (r *CreateSubscriptionRequest) ToSubscription(ctx context.Context) *subscri
 		Metadata:           r.Metadata,
 		EnvironmentID:      types.GetEnvironmentID(ctx),
 		BaseModel:          types.GetDefaultBaseModel(ctx),
+
+		TrialRequiresPaymentMethod: r.TrialRequiresPaymentMethod,
+		PaymentMethodID:            r.PaymentMethodID,
+		CommitmentAmount:           r.CommitmentAmount,
+		CommitmentInterval:         r.CommitmentInterval,
+		AutoApplyCredits:           r.AutoApplyCredits,
 	}
 }
 
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// ChangeSubscriptionPlanRequest represents a request to move a subscription to another plan
+type ChangeSubscriptionPlanRequest struct {
+	PlanID string `json:"plan_id" validate:"required"`
+
+	// ProrationBehavior defaults to create_prorations
+	ProrationBehavior types.ProrationBehavior `json:"proration_behavior,omitempty"`
+
+	// InvoiceTiming defaults to immediate
+	InvoiceTiming types.PlanChangeInvoiceTiming `json:"invoice_timing,omitempty"`
+}
+
+// Validate validates the change plan request and applies the defaults
+func (r *ChangeSubscriptionPlanRequest) Validate() error {
+	if r.ProrationBehavior == "" {
+		r.ProrationBehavior = types.ProrationBehaviorCreateProrations
+	}
+
+	if r.InvoiceTiming == "" {
+		r.InvoiceTiming = types.PlanChangeInvoiceTimingImmediate
+	}
+
+	if err := r.ProrationBehavior.Validate(); err != nil {
+		return err
+	}
+
+	return r.InvoiceTiming.Validate()
+}
+
+// ChangeSubscriptionPlanResponse represents the response to a change plan request
+type ChangeSubscriptionPlanResponse struct {
+	Subscription *SubscriptionResponse `json:"subscription"`
+
+	// ChangedAt is the time the new plan took effect, prorations are computed from it
+	ChangedAt time.Time `json:"changed_at"`
+
+	// Invoice settles the usage of the previous plan up to the change and charges the
+	// prorated fixed prices, it is empty when there is nothing to charge
+	Invoice *InvoiceResponse `json:"invoice,omitempty"`
+
+	// CreditNotes credit the unused time of the fixed advance prices of the previous plan
+	CreditNotes []*CreditNoteResponse `json:"credit_notes,omitempty"`
+
+	// ProratedCredit is the total credited for the unused time of the previous plan
+	ProratedCredit decimal.Decimal `json:"prorated_credit" swaggertype:"string"`
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+// MigratePlanVersionRequest represents a request to move the subscriptions of a plan that are pinned
+// to a previous version of it to the current version
+type MigratePlanVersionRequest struct {
+	PlanID string `json:"plan_id" validate:"required"`
+
+	// SubscriptionIDs, CustomerIDs and PlanVersions narrow down the subscriptions to migrate,
+	// all the subscriptions on a previous version of the plan are migrated when none is set
+	SubscriptionIDs []string `json:"subscription_ids,omitempty"`
+	CustomerIDs     []string `json:"customer_ids,omitempty"`
+	PlanVersions    []int    `json:"plan_versions,omitempty"`
+}
+
+func (r *MigratePlanVersionRequest) Validate() error {
+	if r.PlanID == "" {
+		return ierr.NewError("plan_id is required").
+			WithHint("Please provide the plan to migrate the subscriptions of").
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, version := range r.PlanVersions {
+		if version < 1 {
+			return ierr.NewError("invalid plan version").
+				WithHint("Plan versions start at 1").
+				WithReportableDetails(map[string]interface{}{
+					"plan_version": version,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+// MigratePlanVersionResponse represents the response to a plan version migration request
+type MigratePlanVersionResponse struct {
+	PlanID      string                            `json:"plan_id"`
+	PlanVersion int                               `json:"plan_version"`
+	Items       []*MigratePlanVersionResponseItem `json:"items"`
+}
+
+// MigratePlanVersionResponseItem is a subscription scheduled to move to the current plan version
+type MigratePlanVersionResponseItem struct {
+	SubscriptionID string `json:"subscription_id"`
+	CustomerID     string `json:"customer_id"`
+	FromVersion    int    `json:"from_version"`
+
+	// EffectiveAt is the end of the current billing period, the line items of the subscription
+	// are replaced with the prices of the current version from then on
+	EffectiveAt time.Time `json:"effective_at"`
+}
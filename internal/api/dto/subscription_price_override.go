@@ -0,0 +1,134 @@
+package dto
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionPriceOverrideRequest overrides a price of the plan for a single subscription, the
+// fields that are not set are inherited from the plan price
+type SubscriptionPriceOverrideRequest struct {
+	// PriceID is the plan price to override
+	PriceID string `json:"price_id" validate:"required"`
+
+	Amount       *string             `json:"amount,omitempty"`
+	Tiers        []CreatePriceTier   `json:"tiers,omitempty"`
+	FilterValues map[string][]string `json:"filter_values,omitempty"`
+}
+
+func (r *SubscriptionPriceOverrideRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.Amount == nil && r.Tiers == nil && r.FilterValues == nil {
+		return ierr.NewError("price override is empty").
+			WithHint("Please provide the amount, tiers or filter values to override").
+			WithReportableDetails(map[string]interface{}{
+				"price_id": r.PriceID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Amount != nil {
+		amount, err := decimal.NewFromString(*r.Amount)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Amount must be a valid decimal number").
+				WithReportableDetails(map[string]interface{}{
+					"price_id": r.PriceID,
+					"amount":   *r.Amount,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if amount.LessThan(decimal.Zero) {
+			return ierr.NewError("amount cannot be negative").
+				WithHint("Amount cannot be negative").
+				WithReportableDetails(map[string]interface{}{
+					"price_id": r.PriceID,
+					"amount":   *r.Amount,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if r.Tiers != nil {
+		if len(r.Tiers) == 0 {
+			return ierr.NewError("tiers cannot be empty").
+				WithHint("Please provide at least one tier to override").
+				WithReportableDetails(map[string]interface{}{
+					"price_id": r.PriceID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if err := validateOverrideTiers(r.Tiers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ToPrice creates the price scoped to the subscription that overrides the plan price
+func (r *SubscriptionPriceOverrideRequest) ToPrice(ctx context.Context, sub *subscription.Subscription, planPrice *price.Price) (*price.Price, error) {
+	if r.Tiers != nil && planPrice.BillingModel != types.BILLING_MODEL_TIERED {
+		return nil, ierr.NewError("tiers can only be overridden for tiered prices").
+			WithHint("The plan price does not use tiered pricing").
+			WithReportableDetails(map[string]interface{}{
+				"price_id":      r.PriceID,
+				"billing_model": planPrice.BillingModel,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.FilterValues != nil && planPrice.Type != types.PRICE_TYPE_USAGE {
+		return nil, ierr.NewError("filter values can only be overridden for usage prices").
+			WithHint("The plan price is not a usage price").
+			WithReportableDetails(map[string]interface{}{
+				"price_id": r.PriceID,
+				"type":     planPrice.Type,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	override := *planPrice
+	override.ID = types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PRICE)
+	override.SubscriptionID = lo.ToPtr(sub.ID)
+	override.ParentPriceID = lo.ToPtr(planPrice.ID)
+	override.LookupKey = ""
+	override.EnvironmentID = types.GetEnvironmentID(ctx)
+	override.BaseModel = types.GetDefaultBaseModel(ctx)
+
+	if r.Amount != nil {
+		amount, err := decimal.NewFromString(*r.Amount)
+		if err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Amount must be a valid decimal number").
+				Mark(ierr.ErrValidation)
+		}
+		override.Amount = amount
+		override.DisplayAmount = override.GetDisplayAmount()
+	}
+
+	if r.Tiers != nil {
+		tiers, err := toPriceTiers(r.Tiers)
+		if err != nil {
+			return nil, err
+		}
+		override.Tiers = tiers
+	}
+
+	if r.FilterValues != nil {
+		override.FilterValues = price.JSONBFilters(r.FilterValues)
+	}
+
+	return &override, nil
+}
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// UpdateLineItemQuantityRequest represents a request to change the quantity of a fixed price line item
+type UpdateLineItemQuantityRequest struct {
+	Quantity decimal.Decimal `json:"quantity" swaggertype:"string"`
+
+	// EffectiveDate defaults to now and must fall within the current billing period
+	EffectiveDate *time.Time `json:"effective_date,omitempty"`
+
+	Metadata types.Metadata `json:"metadata,omitempty"`
+}
+
+// Validate validates the update line item quantity request
+func (r *UpdateLineItemQuantityRequest) Validate() error {
+	if r.Quantity.IsNegative() {
+		return ierr.NewError("quantity must be non-negative").
+			WithHint("Quantity cannot be negative").
+			WithReportableDetails(map[string]interface{}{
+				"quantity": r.Quantity,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// SubscriptionQuantityChangeResponse represents a subscription quantity change in API responses
+type SubscriptionQuantityChangeResponse struct {
+	*subscription.SubscriptionQuantityChange
+}
+
+// UpdateLineItemQuantityResponse represents the response to an update line item quantity request
+type UpdateLineItemQuantityResponse struct {
+	LineItem *subscription.SubscriptionLineItem  `json:"line_item"`
+	Change   *SubscriptionQuantityChangeResponse `json:"change"`
+
+	// CreditNote credits the unused time of a decrease of a charge invoiced in advance, increases are
+	// charged on the next invoice
+	CreditNote *CreditNoteResponse `json:"credit_note,omitempty"`
+}
+
+// ListSubscriptionQuantityChangesResponse represents a list of subscription quantity changes in API responses
+type ListSubscriptionQuantityChangesResponse struct {
+	Items []*SubscriptionQuantityChangeResponse `json:"items"`
+	Total int                                   `json:"total"`
+}
+
+// NewListSubscriptionQuantityChangesResponse creates a new list subscription quantity changes response
+func NewListSubscriptionQuantityChangesResponse(changes []*subscription.SubscriptionQuantityChange) *ListSubscriptionQuantityChangesResponse {
+	items := make([]*SubscriptionQuantityChangeResponse, len(changes))
+	for i, change := range changes {
+		items[i] = &SubscriptionQuantityChangeResponse{
+			SubscriptionQuantityChange: change,
+		}
+	}
+
+	return &ListSubscriptionQuantityChangesResponse{
+		Items: items,
+		Total: len(items),
+	}
+}
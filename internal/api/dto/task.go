@@ -2,22 +2,27 @@ package dto
 
 import (
 	"context"
+	"maps"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/task"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
 )
 
 // CreateTaskRequest represents the request to create a new task
 type CreateTaskRequest struct {
 	TaskType   types.TaskType         `json:"task_type" binding:"required"`
 	EntityType types.EntityType       `json:"entity_type" binding:"required"`
-	FileURL    string                 `json:"file_url" binding:"required"`
+	FileURL    string                 `json:"file_url,omitempty"`
 	FileName   *string                `json:"file_name,omitempty"`
 	FileType   types.FileType         `json:"file_type" binding:"required"`
 	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+
+	// Filters narrows down the records written by an export task
+	Filters *types.TaskExportFilter `json:"filters,omitempty"`
 }
 
 func (r *CreateTaskRequest) Validate() error {
@@ -27,20 +32,78 @@ func (r *CreateTaskRequest) Validate() error {
 	if err := r.EntityType.Validate(); err != nil {
 		return err
 	}
-	if r.FileURL == "" {
-		return ierr.NewError("file_url cannot be empty").
-			WithHint("File URL cannot be empty").
-			Mark(ierr.ErrValidation)
-	}
 	if err := r.FileType.Validate(); err != nil {
 		return err
 	}
 
+	switch r.TaskType {
+	case types.TaskTypeImport:
+		if r.FileURL == "" {
+			return ierr.NewError("file_url cannot be empty").
+				WithHint("File URL cannot be empty").
+				Mark(ierr.ErrValidation)
+		}
+	case types.TaskTypeExport:
+		if err := r.validateExport(); err != nil {
+			return err
+		}
+	}
+
 	return validator.ValidateRequest(r)
 }
 
+func (r *CreateTaskRequest) validateExport() error {
+	if r.FileURL != "" {
+		return ierr.NewError("file_url cannot be set for export tasks").
+			WithHint("File URL is set once the export completes").
+			Mark(ierr.ErrValidation)
+	}
+
+	allowedEntityTypes := []types.EntityType{
+		types.EntityTypeEvents,
+		types.EntityTypeInvoices,
+		types.EntityTypeCustomers,
+	}
+	if !lo.Contains(allowedEntityTypes, r.EntityType) {
+		return ierr.NewError("unsupported entity type for export").
+			WithHint("Only events, invoices and customers can be exported").
+			WithReportableDetails(map[string]interface{}{
+				"entity_type": r.EntityType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	allowedFileTypes := []types.FileType{
+		types.FileTypeCSV,
+		types.FileTypeJSONL,
+	}
+	if !lo.Contains(allowedFileTypes, r.FileType) {
+		return ierr.NewError("unsupported file type for export").
+			WithHint("Exports can only be written as CSV or JSONL").
+			WithReportableDetails(map[string]interface{}{
+				"file_type": r.FileType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Filters != nil {
+		if err := r.Filters.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ToTask converts the request to a domain task
 func (r *CreateTaskRequest) ToTask(ctx context.Context) *task.Task {
+	metadata := r.Metadata
+	if r.Filters != nil {
+		metadata = make(map[string]interface{}, len(r.Metadata)+1)
+		maps.Copy(metadata, r.Metadata)
+		metadata[task.MetadataKeyExportFilter] = r.Filters
+	}
+
 	return &task.Task{
 		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_TASK),
 		TaskType:      r.TaskType,
@@ -49,7 +112,7 @@ func (r *CreateTaskRequest) ToTask(ctx context.Context) *task.Task {
 		FileName:      r.FileName,
 		FileType:      r.FileType,
 		TaskStatus:    types.TaskStatusPending,
-		Metadata:      r.Metadata,
+		Metadata:      metadata,
 		EnvironmentID: types.GetEnvironmentID(ctx),
 		BaseModel:     types.GetDefaultBaseModel(ctx),
 	}
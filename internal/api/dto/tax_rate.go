@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/domain/tax"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/shopspring/decimal"
+)
+
+type CreateTaxRateRequest struct {
+	Name        string            `json:"name" validate:"required"`
+	Description string            `json:"description,omitempty"`
+	Percentage  decimal.Decimal   `json:"percentage" swaggertype:"string"`
+	TaxBehavior types.TaxBehavior `json:"tax_behavior" validate:"required"`
+	Country     string            `json:"country" validate:"required,len=2,iso3166_1_alpha2"`
+	State       string            `json:"state,omitempty"`
+	Metadata    types.Metadata    `json:"metadata,omitempty"`
+}
+
+func (r *CreateTaxRateRequest) Validate() error {
+	r.Country = strings.ToUpper(r.Country)
+
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if err := r.TaxBehavior.Validate(); err != nil {
+		return err
+	}
+
+	if r.Percentage.IsNegative() || r.Percentage.GreaterThan(decimal.NewFromInt(100)) {
+		return ierr.NewError("percentage must be between 0 and 100").
+			WithHint("Tax percentage must be between 0 and 100").
+			WithReportableDetails(map[string]any{
+				"percentage": r.Percentage.String(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+func (r *CreateTaxRateRequest) ToTaxRate(ctx context.Context) *tax.TaxRate {
+	return &tax.TaxRate{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_TAX_RATE),
+		Name:          r.Name,
+		Description:   r.Description,
+		Percentage:    r.Percentage,
+		TaxBehavior:   r.TaxBehavior,
+		Country:       r.Country,
+		State:         strings.TrimSpace(r.State),
+		Metadata:      r.Metadata,
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateTaxRateRequest only allows descriptive fields to change, the percentage and
+// jurisdiction of a tax rate are immutable so issued invoices keep matching their rates
+type UpdateTaxRateRequest struct {
+	Name        *string         `json:"name,omitempty"`
+	Description *string         `json:"description,omitempty"`
+	Metadata    *types.Metadata `json:"metadata,omitempty"`
+}
+
+func (r *UpdateTaxRateRequest) Validate() error {
+	if r.Name != nil && *r.Name == "" {
+		return ierr.NewError("name cannot be empty").
+			WithHint("Please provide a name for the tax rate").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type TaxRateResponse struct {
+	*tax.TaxRate
+}
+
+// ListTaxRatesResponse represents a paginated list of tax rates
+type ListTaxRatesResponse = types.ListResponse[*TaxRateResponse]
@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/tenant"
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
 )
 
 type TenantBillingDetails struct {
@@ -47,6 +49,62 @@ func (r *TenantBillingDetails) ToDomain() tenant.TenantBillingDetails {
 	}
 }
 
+// TenantDunningPolicy is the dunning policy applied to the unpaid invoices of the tenant
+type TenantDunningPolicy struct {
+	Enabled     bool                     `json:"enabled"`
+	RetryDays   []int                    `json:"retry_days,omitempty"`
+	GraceDays   int                      `json:"grace_days"`
+	FinalAction types.DunningFinalAction `json:"final_action,omitempty"`
+}
+
+func NewTenantDunningPolicy(p tenant.TenantDunningPolicy) TenantDunningPolicy {
+	return TenantDunningPolicy{
+		Enabled:     p.Enabled,
+		RetryDays:   p.RetryDays,
+		GraceDays:   p.GraceDays,
+		FinalAction: p.FinalAction,
+	}
+}
+
+func (r *TenantDunningPolicy) ToDomain() tenant.TenantDunningPolicy {
+	return tenant.TenantDunningPolicy{
+		Enabled:     r.Enabled,
+		RetryDays:   r.RetryDays,
+		GraceDays:   r.GraceDays,
+		FinalAction: r.FinalAction,
+	}
+}
+
+func (r *TenantDunningPolicy) Validate() error {
+	if r.GraceDays < 0 {
+		return ierr.NewError("grace_days cannot be negative").
+			WithHint("Grace days must be zero or more").
+			WithReportableDetails(map[string]any{
+				"grace_days": r.GraceDays,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	for i, day := range r.RetryDays {
+		if day < 0 || (i > 0 && day <= r.RetryDays[i-1]) {
+			return ierr.NewError("retry_days must be increasing days after the due date").
+				WithHint("Retry days must be zero or more and in increasing order").
+				WithReportableDetails(map[string]any{
+					"retry_days": r.RetryDays,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if r.Enabled || r.FinalAction != "" {
+		if err := r.FinalAction.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type CreateTenantRequest struct {
 	Name           string                `json:"name" validate:"required"`
 	BillingDetails *TenantBillingDetails `json:"billing_details,omitempty"`
@@ -56,6 +114,7 @@ type TenantResponse struct {
 	ID             string                `json:"id"`
 	Name           string                `json:"name"`
 	BillingDetails *TenantBillingDetails `json:"billing_details,omitempty"`
+	DunningPolicy  *TenantDunningPolicy  `json:"dunning_policy,omitempty"`
 	Status         string                `json:"status"`
 	CreatedAt      string                `json:"created_at"`
 	UpdatedAt      string                `json:"updated_at"`
@@ -104,15 +163,27 @@ func NewTenantResponse(t *tenant.Tenant) *TenantResponse {
 		CreatedAt:      t.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:      t.UpdatedAt.Format(time.RFC3339),
 		BillingDetails: &billingDetails,
+		DunningPolicy:  lo.ToPtr(NewTenantDunningPolicy(t.DunningPolicy)),
 	}
 }
 
 type UpdateTenantRequest struct {
 	BillingDetails *TenantBillingDetails `json:"billing_details,omitempty"`
+	DunningPolicy  *TenantDunningPolicy  `json:"dunning_policy,omitempty"`
 }
 
 func (r *UpdateTenantRequest) Validate() error {
-	return validator.ValidateRequest(r)
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.DunningPolicy != nil {
+		if err := r.DunningPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 type TenantBillingUsage struct {
@@ -24,9 +24,14 @@ type CreateWalletRequest struct {
 	AutoTopupTrigger    types.AutoTopupTrigger `json:"auto_topup_trigger,omitempty"`
 	AutoTopupMinBalance decimal.Decimal        `json:"auto_topup_min_balance,omitempty"`
 	AutoTopupAmount     decimal.Decimal        `json:"auto_topup_amount,omitempty"`
+	AutoTopupMethod     types.AutoTopupMethod  `json:"auto_topup_method,omitempty"`
 	WalletType          types.WalletType       `json:"wallet_type"`
 	Config              *types.WalletConfig    `json:"config,omitempty"`
 	ConversionRate      decimal.Decimal        `json:"conversion_rate" default:"1"`
+
+	// AutoTopupPaymentMethodType and AutoTopupPaymentMethodID are required when auto_topup_method is payment
+	AutoTopupPaymentMethodType types.PaymentMethodType `json:"auto_topup_payment_method_type,omitempty"`
+	AutoTopupPaymentMethodID   string                  `json:"auto_topup_payment_method_id,omitempty"`
 }
 
 // UpdateWalletRequest represents the request to update a wallet
@@ -37,7 +42,12 @@ type UpdateWalletRequest struct {
 	AutoTopupTrigger    *types.AutoTopupTrigger `json:"auto_topup_trigger,omitempty"`
 	AutoTopupMinBalance *decimal.Decimal        `json:"auto_topup_min_balance,omitempty"`
 	AutoTopupAmount     *decimal.Decimal        `json:"auto_topup_amount,omitempty"`
+	AutoTopupMethod     *types.AutoTopupMethod  `json:"auto_topup_method,omitempty"`
 	Config              *types.WalletConfig     `json:"config,omitempty"`
+
+	// AutoTopupPaymentMethodType and AutoTopupPaymentMethodID are required when auto_topup_method is payment
+	AutoTopupPaymentMethodType *types.PaymentMethodType `json:"auto_topup_payment_method_type,omitempty"`
+	AutoTopupPaymentMethodID   *string                  `json:"auto_topup_payment_method_id,omitempty"`
 }
 
 func (r *UpdateWalletRequest) Validate() error {
@@ -71,6 +81,13 @@ func (r *UpdateWalletRequest) Validate() error {
 				}).
 				Mark(ierr.ErrValidation)
 		}
+		if err := validateAutoTopupMethod(
+			lo.FromPtr(r.AutoTopupMethod),
+			lo.FromPtr(r.AutoTopupPaymentMethodType),
+			lo.FromPtr(r.AutoTopupPaymentMethodID),
+		); err != nil {
+			return err
+		}
 	}
 
 	if r.Config != nil {
@@ -109,6 +126,10 @@ func (r *CreateWalletRequest) ToWallet(ctx context.Context) *wallet.Wallet {
 		r.AutoTopupTrigger = types.AutoTopupTriggerDisabled
 	}
 
+	if r.AutoTopupMethod == "" {
+		r.AutoTopupMethod = types.AutoTopupMethodInvoice
+	}
+
 	if r.Name == "" {
 		if r.WalletType == types.WalletTypePrePaid {
 			r.Name = fmt.Sprintf("Prepaid Wallet - %s", r.Currency)
@@ -127,6 +148,7 @@ func (r *CreateWalletRequest) ToWallet(ctx context.Context) *wallet.Wallet {
 		AutoTopupTrigger:    r.AutoTopupTrigger,
 		AutoTopupMinBalance: r.AutoTopupMinBalance,
 		AutoTopupAmount:     r.AutoTopupAmount,
+		AutoTopupMethod:     r.AutoTopupMethod,
 		Balance:             decimal.Zero,
 		CreditBalance:       decimal.Zero,
 		WalletStatus:        types.WalletStatusActive,
@@ -135,6 +157,9 @@ func (r *CreateWalletRequest) ToWallet(ctx context.Context) *wallet.Wallet {
 		WalletType:          r.WalletType,
 		Config:              lo.FromPtr(r.Config),
 		ConversionRate:      r.ConversionRate,
+
+		AutoTopupPaymentMethodType: r.AutoTopupPaymentMethodType,
+		AutoTopupPaymentMethodID:   r.AutoTopupPaymentMethodID,
 	}
 }
 
@@ -145,6 +170,9 @@ func (r *CreateWalletRequest) Validate() error {
 	if err := types.AutoTopupTrigger(r.AutoTopupTrigger).Validate(); err != nil {
 		return err
 	}
+	if err := validateAutoTopupMethod(r.AutoTopupMethod, r.AutoTopupPaymentMethodType, r.AutoTopupPaymentMethodID); err != nil {
+		return err
+	}
 	if err := r.WalletType.Validate(); err != nil {
 		return err
 	}
@@ -159,6 +187,57 @@ func (r *CreateWalletRequest) Validate() error {
 	return validator.ValidateRequest(r)
 }
 
+// validateAutoTopupMethod validates the auto top-up method and the payment method it charges
+func validateAutoTopupMethod(method types.AutoTopupMethod, paymentMethodType types.PaymentMethodType, paymentMethodID string) error {
+	if err := method.Validate(); err != nil {
+		return err
+	}
+
+	if method != types.AutoTopupMethodPayment {
+		return nil
+	}
+
+	if paymentMethodType == "" {
+		return ierr.NewError("auto_topup_payment_method_type is required when auto_topup_method is payment").
+			WithHint("Payment method type must be provided for payment based auto-topup").
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := paymentMethodType.Validate(); err != nil {
+		return err
+	}
+
+	// Offline payments carry no payment method, every other method charges the given one
+	if paymentMethodType == types.PaymentMethodTypeOffline && paymentMethodID != "" {
+		return ierr.NewError("auto_topup_payment_method_id is not allowed for offline payment method type").
+			WithHint("Do not provide payment method ID for offline payment based auto-topup").
+			WithReportableDetails(map[string]interface{}{
+				"payment_method_type": paymentMethodType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if paymentMethodType != types.PaymentMethodTypeOffline && paymentMethodID == "" {
+		return ierr.NewError("auto_topup_payment_method_id is required for online payment method type").
+			WithHint("Payment method ID must be provided for payment based auto-topup").
+			WithReportableDetails(map[string]interface{}{
+				"payment_method_type": paymentMethodType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	// Paying for credits with credits would debit a wallet to top up a wallet
+	if paymentMethodType == types.PaymentMethodTypeCredits {
+		return ierr.NewError("credits cannot be used to pay for auto-topup").
+			WithHint("Auto-topup must be paid with a non credits payment method").
+			WithReportableDetails(map[string]interface{}{
+				"payment_method_type": paymentMethodType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
 // WalletResponse represents a wallet in API responses
 type WalletResponse struct {
 	ID                  string                 `json:"id"`
@@ -173,11 +252,15 @@ type WalletResponse struct {
 	AutoTopupTrigger    types.AutoTopupTrigger `json:"auto_topup_trigger"`
 	AutoTopupMinBalance decimal.Decimal        `json:"auto_topup_min_balance"`
 	AutoTopupAmount     decimal.Decimal        `json:"auto_topup_amount"`
+	AutoTopupMethod     types.AutoTopupMethod  `json:"auto_topup_method"`
 	WalletType          types.WalletType       `json:"wallet_type"`
 	Config              types.WalletConfig     `json:"config,omitempty"`
 	ConversionRate      decimal.Decimal        `json:"conversion_rate"`
 	CreatedAt           time.Time              `json:"created_at"`
 	UpdatedAt           time.Time              `json:"updated_at"`
+
+	AutoTopupPaymentMethodType types.PaymentMethodType `json:"auto_topup_payment_method_type,omitempty"`
+	AutoTopupPaymentMethodID   string                  `json:"auto_topup_payment_method_id,omitempty"`
 }
 
 // ToWalletResponse converts domain Wallet to WalletResponse
@@ -198,11 +281,15 @@ func FromWallet(w *wallet.Wallet) *WalletResponse {
 		AutoTopupTrigger:    w.AutoTopupTrigger,
 		AutoTopupMinBalance: w.AutoTopupMinBalance,
 		AutoTopupAmount:     w.AutoTopupAmount,
+		AutoTopupMethod:     w.AutoTopupMethod,
 		WalletType:          w.WalletType,
 		Config:              w.Config,
 		ConversionRate:      w.ConversionRate,
 		CreatedAt:           w.CreatedAt,
 		UpdatedAt:           w.UpdatedAt,
+
+		AutoTopupPaymentMethodType: w.AutoTopupPaymentMethodType,
+		AutoTopupPaymentMethodID:   w.AutoTopupPaymentMethodID,
 	}
 }
 
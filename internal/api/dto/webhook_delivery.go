@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+)
+
+// maxReplayWindow is the longest time range of failed deliveries replayed at once
+const maxReplayWindow = 31 * 24 * time.Hour
+
+// ReplayWebhookDeliveriesRequest replays the failed deliveries of a time range
+type ReplayWebhookDeliveriesRequest struct {
+	StartTime time.Time `json:"start_time" validate:"required"`
+	EndTime   time.Time `json:"end_time" validate:"required"`
+	// WebhookEndpointID restricts the replay to the deliveries of a webhook endpoint
+	WebhookEndpointID string `json:"webhook_endpoint_id,omitempty"`
+}
+
+func (r *ReplayWebhookDeliveriesRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if !r.EndTime.After(r.StartTime) {
+		return ierr.NewError("end_time must be after start_time").
+			WithHint("Please provide a valid time range").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.EndTime.Sub(r.StartTime) > maxReplayWindow {
+		return ierr.NewError("time range is too long").
+			WithHint("The failed deliveries of at most 31 days can be replayed at once").
+			WithReportableDetails(map[string]any{
+				"start_time": r.StartTime,
+				"end_time":   r.EndTime,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type WebhookDeliveryResponse struct {
+	*webhookdelivery.WebhookDelivery
+}
+
+// ListWebhookDeliveriesResponse represents a paginated list of webhook deliveries
+type ListWebhookDeliveriesResponse = types.ListResponse[*WebhookDeliveryResponse]
+
+// ReplayWebhookDeliveriesResponse summarizes a replay of failed deliveries
+type ReplayWebhookDeliveriesResponse struct {
+	TotalReplayed  int `json:"total_replayed"`
+	TotalSucceeded int `json:"total_succeeded"`
+	TotalFailed    int `json:"total_failed"`
+	// TotalRequeued counts the events which failed before any delivery and were published again
+	TotalRequeued int                        `json:"total_requeued"`
+	Items         []*WebhookDeliveryResponse `json:"items"`
+}
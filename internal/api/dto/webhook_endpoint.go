@@ -0,0 +1,133 @@
+package dto
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+)
+
+type CreateWebhookEndpointRequest struct {
+	URL           string            `json:"url" validate:"required"`
+	Description   string            `json:"description,omitempty"`
+	EnabledEvents []string          `json:"enabled_events,omitempty"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	Metadata      types.Metadata    `json:"metadata,omitempty"`
+}
+
+func (r *CreateWebhookEndpointRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if err := validateWebhookEndpointURL(r.URL); err != nil {
+		return err
+	}
+
+	return validateWebhookEndpointEvents(r.EnabledEvents)
+}
+
+func (r *CreateWebhookEndpointRequest) ToWebhookEndpoint(ctx context.Context) *webhookendpoint.WebhookEndpoint {
+	return &webhookendpoint.WebhookEndpoint{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WEBHOOK_ENDPOINT),
+		URL:           r.URL,
+		Description:   r.Description,
+		EnabledEvents: r.EnabledEvents,
+		Headers:       r.Headers,
+		Enabled:       true,
+		Metadata:      r.Metadata,
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+}
+
+type UpdateWebhookEndpointRequest struct {
+	URL           *string            `json:"url,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	EnabledEvents *[]string          `json:"enabled_events,omitempty"`
+	Headers       *map[string]string `json:"headers,omitempty"`
+	Enabled       *bool              `json:"enabled,omitempty"`
+	Metadata      *types.Metadata    `json:"metadata,omitempty"`
+}
+
+func (r *UpdateWebhookEndpointRequest) Validate() error {
+	if r.URL != nil {
+		if err := validateWebhookEndpointURL(*r.URL); err != nil {
+			return err
+		}
+	}
+
+	if r.EnabledEvents != nil {
+		return validateWebhookEndpointEvents(*r.EnabledEvents)
+	}
+
+	return nil
+}
+
+// validateWebhookEndpointURL checks the url is an absolute http(s) url
+func validateWebhookEndpointURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ierr.NewError("invalid webhook endpoint url").
+			WithHint("Please provide an absolute http or https url").
+			WithReportableDetails(map[string]any{
+				"url": rawURL,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// validateWebhookEndpointEvents checks the events are known and distinct
+func validateWebhookEndpointEvents(events []string) error {
+	seen := make(map[string]bool, len(events))
+	for _, event := range events {
+		if err := types.ValidateWebhookEventName(event); err != nil {
+			return err
+		}
+
+		if seen[event] {
+			return ierr.NewError("enabled events must be unique").
+				WithHint("Remove the duplicated event").
+				WithReportableDetails(map[string]any{
+					"event": event,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		seen[event] = true
+	}
+	return nil
+}
+
+// RotateWebhookEndpointSecretRequest replaces the signing secret of a webhook endpoint
+type RotateWebhookEndpointSecretRequest struct {
+	// ExpiresInHours is how long the deliveries are still signed with the replaced secret,
+	// defaults to 24 hours. The replaced secret stops being used immediately when 0.
+	ExpiresInHours *int `json:"expires_in_hours,omitempty" validate:"omitempty,min=0,max=168"`
+}
+
+func (r *RotateWebhookEndpointSecretRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+// GetExpiresIn returns how long the replaced secret remains active
+func (r *RotateWebhookEndpointSecretRequest) GetExpiresIn() time.Duration {
+	if r.ExpiresInHours == nil {
+		return 24 * time.Hour
+	}
+	return time.Duration(*r.ExpiresInHours) * time.Hour
+}
+
+type WebhookEndpointResponse struct {
+	*webhookendpoint.WebhookEndpoint
+
+	// SigningSecret is only returned when the endpoint is created and when its secret is rotated
+	SigningSecret string `json:"signing_secret,omitempty"`
+}
+
+// ListWebhookEndpointsResponse represents a paginated list of webhook endpoints
+type ListWebhookEndpointsResponse = types.ListResponse[*WebhookEndpointResponse]
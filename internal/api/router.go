@@ -33,11 +33,20 @@ type Handlers struct {
 	Payment           *v1.PaymentHandler
 	Task              *v1.TaskHandler
 	Secret            *v1.SecretHandler
+	Coupon            *v1.CouponHandler
+	TaxRate           *v1.TaxRateHandler
+	CreditNote        *v1.CreditNoteHandler
+	Dunning           *v1.DunningHandler
+	Alert             *v1.AlertHandler
+	WebhookEndpoint   *v1.WebhookEndpointHandler
+	WebhookDelivery   *v1.WebhookDeliveryHandler
 	// Portal handlers
 	Onboarding *v1.OnboardingHandler
 	// Cron jobs : TODO: move crons out of API based architecture
 	CronSubscription *cron.SubscriptionHandler
 	CronWallet       *cron.WalletCronHandler
+	CronInvoice      *cron.InvoiceHandler
+	CronAlert        *cron.AlertHandler
 }
 
 func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logger, secretService service.SecretService) *gin.Engine {
@@ -135,6 +144,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			// New endpoints for entitlements and usage
 			customer.GET("/:id/entitlements", handlers.Customer.GetCustomerEntitlements)
 			customer.GET("/:id/usage", handlers.Customer.GetCustomerUsageSummary)
+			customer.POST("/entitlements/check", handlers.Customer.CheckEntitlement)
 
 			// other routes for customer
 			customer.GET("/:id/wallets", handlers.Wallet.GetWalletsByCustomerID)
@@ -159,7 +169,12 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			subscription.GET("", handlers.Subscription.GetSubscriptions)
 			subscription.GET("/:id", handlers.Subscription.GetSubscription)
 			subscription.POST("/:id/cancel", handlers.Subscription.CancelSubscription)
+			subscription.POST("/:id/change_plan", handlers.Subscription.ChangePlan)
+			subscription.POST("/:id/payment_method", handlers.Subscription.UpdatePaymentMethod)
+			subscription.POST("/:id/line_items/:line_item_id/quantity", handlers.Subscription.UpdateLineItemQuantity)
+			subscription.GET("/:id/line_items/:line_item_id/quantity_changes", handlers.Subscription.ListQuantityChanges)
 			subscription.POST("/usage", handlers.Subscription.GetUsageBySubscription)
+			subscription.POST("/plan_version_migrations", handlers.Subscription.MigratePlanVersion)
 
 			subscription.POST("/:id/pause", handlers.SubscriptionPause.PauseSubscription)
 			subscription.POST("/:id/resume", handlers.SubscriptionPause.ResumeSubscription)
@@ -196,6 +211,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			invoices.PUT("/:id/payment", handlers.Invoice.UpdatePaymentStatus)
 			invoices.POST("/:id/payment/attempt", handlers.Invoice.AttemptPayment)
 			invoices.GET("/:id/pdf", handlers.Invoice.GetInvoicePDF)
+			invoices.GET("/:id/dunning_attempts", handlers.Dunning.ListInvoiceAttempts)
 		}
 
 		feature := v1Private.Group("/features")
@@ -207,6 +223,63 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			feature.DELETE("/:id", handlers.Feature.DeleteFeature)
 		}
 
+		coupons := v1Private.Group("/coupons")
+		{
+			coupons.POST("", handlers.Coupon.CreateCoupon)
+			coupons.GET("", handlers.Coupon.ListCoupons)
+			coupons.GET("/applications", handlers.Coupon.ListCouponApplications)
+			coupons.DELETE("/applications/:id", handlers.Coupon.RemoveCouponApplication)
+			coupons.GET("/:id", handlers.Coupon.GetCoupon)
+			coupons.PUT("/:id", handlers.Coupon.UpdateCoupon)
+			coupons.DELETE("/:id", handlers.Coupon.DeleteCoupon)
+			coupons.POST("/:id/apply", handlers.Coupon.ApplyCoupon)
+		}
+
+		taxRates := v1Private.Group("/tax_rates")
+		{
+			taxRates.POST("", handlers.TaxRate.CreateTaxRate)
+			taxRates.GET("", handlers.TaxRate.ListTaxRates)
+			taxRates.GET("/:id", handlers.TaxRate.GetTaxRate)
+			taxRates.PUT("/:id", handlers.TaxRate.UpdateTaxRate)
+			taxRates.DELETE("/:id", handlers.TaxRate.DeleteTaxRate)
+		}
+
+		creditNotes := v1Private.Group("/credit_notes")
+		{
+			creditNotes.POST("", handlers.CreditNote.CreateCreditNote)
+			creditNotes.GET("", handlers.CreditNote.ListCreditNotes)
+			creditNotes.GET("/:id", handlers.CreditNote.GetCreditNote)
+			creditNotes.GET("/:id/pdf", handlers.CreditNote.GetCreditNotePDF)
+		}
+
+		alerts := v1Private.Group("/alerts")
+		{
+			alerts.POST("", handlers.Alert.CreateAlertRule)
+			alerts.GET("", handlers.Alert.ListAlertRules)
+			alerts.GET("/events", handlers.Alert.ListAlertEvents)
+			alerts.GET("/:id", handlers.Alert.GetAlertRule)
+			alerts.PUT("/:id", handlers.Alert.UpdateAlertRule)
+			alerts.DELETE("/:id", handlers.Alert.DeleteAlertRule)
+		}
+
+		webhookEndpoints := v1Private.Group("/webhook_endpoints")
+		{
+			webhookEndpoints.POST("", handlers.WebhookEndpoint.CreateWebhookEndpoint)
+			webhookEndpoints.GET("", handlers.WebhookEndpoint.ListWebhookEndpoints)
+			webhookEndpoints.GET("/:id", handlers.WebhookEndpoint.GetWebhookEndpoint)
+			webhookEndpoints.PUT("/:id", handlers.WebhookEndpoint.UpdateWebhookEndpoint)
+			webhookEndpoints.DELETE("/:id", handlers.WebhookEndpoint.DeleteWebhookEndpoint)
+			webhookEndpoints.POST("/:id/rotate_secret", handlers.WebhookEndpoint.RotateWebhookEndpointSecret)
+		}
+
+		webhookDeliveries := v1Private.Group("/webhooks/deliveries")
+		{
+			webhookDeliveries.GET("", handlers.WebhookDelivery.ListWebhookDeliveries)
+			webhookDeliveries.POST("/replay", handlers.WebhookDelivery.ReplayWebhookDeliveries)
+			webhookDeliveries.GET("/:id", handlers.WebhookDelivery.GetWebhookDelivery)
+			webhookDeliveries.POST("/:id/replay", handlers.WebhookDelivery.ReplayWebhookDelivery)
+		}
+
 		entitlement := v1Private.Group("/entitlements")
 		{
 			entitlement.POST("", handlers.Entitlement.CreateEntitlement)
@@ -289,5 +362,17 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 	{
 		walletGroup.POST("/expire-credits", handlers.CronWallet.ExpireCredits)
 	}
+
+	// Invoice related cron jobs
+	invoiceGroup := cron.Group("/invoices")
+	{
+		invoiceGroup.POST("/dunning", handlers.CronInvoice.ProcessDunning)
+	}
+
+	// Usage alert related cron jobs
+	alertGroup := cron.Group("/alerts")
+	{
+		alertGroup.POST("/evaluate", handlers.CronAlert.EvaluateAlerts)
+	}
 	return router
 }
@@ -0,0 +1,224 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type AlertHandler struct {
+	alertService service.AlertService
+	log          *logger.Logger
+}
+
+func NewAlertHandler(alertService service.AlertService, log *logger.Logger) *AlertHandler {
+	return &AlertHandler{
+		alertService: alertService,
+		log:          log,
+	}
+}
+
+// CreateAlertRule godoc
+// @Summary Create a new alert rule
+// @Description Create an alert rule notifying when the entitlement usage or the spend of subscriptions crosses its thresholds
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param alert_rule body dto.CreateAlertRuleRequest true "Alert rule to create"
+// @Success 201 {object} dto.AlertRuleResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /alerts [post]
+func (h *AlertHandler) CreateAlertRule(c *gin.Context) {
+	var req dto.CreateAlertRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.alertService.CreateAlertRule(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetAlertRule godoc
+// @Summary Get an alert rule by ID
+// @Description Get an alert rule by ID
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Alert rule ID"
+// @Success 200 {object} dto.AlertRuleResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /alerts/{id} [get]
+func (h *AlertHandler) GetAlertRule(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("alert rule ID is required").
+			WithHint("Alert rule ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.alertService.GetAlertRule(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListAlertRules godoc
+// @Summary List alert rules
+// @Description List alert rules with optional filtering
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.AlertRuleFilter true "Filter"
+// @Success 200 {object} dto.ListAlertRulesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /alerts [get]
+func (h *AlertHandler) ListAlertRules(c *gin.Context) {
+	var filter types.AlertRuleFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	resp, err := h.alertService.ListAlertRules(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateAlertRule godoc
+// @Summary Update an alert rule
+// @Description Update the name, thresholds or metadata of an alert rule
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Alert rule ID"
+// @Param alert_rule body dto.UpdateAlertRuleRequest true "Alert rule update data"
+// @Success 200 {object} dto.AlertRuleResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /alerts/{id} [put]
+func (h *AlertHandler) UpdateAlertRule(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("alert rule ID is required").
+			WithHint("Alert rule ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateAlertRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.alertService.UpdateAlertRule(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteAlertRule godoc
+// @Summary Delete an alert rule
+// @Description Archive an alert rule so it is no longer evaluated
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Alert rule ID"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /alerts/{id} [delete]
+func (h *AlertHandler) DeleteAlertRule(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("alert rule ID is required").
+			WithHint("Alert rule ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.alertService.DeleteAlertRule(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "alert rule deleted successfully"})
+}
+
+// ListAlertEvents godoc
+// @Summary List alert events
+// @Description List the thresholds crossed by alert rules with optional filtering
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.AlertEventFilter true "Filter"
+// @Success 200 {object} dto.ListAlertEventsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /alerts/events [get]
+func (h *AlertHandler) ListAlertEvents(c *gin.Context) {
+	var filter types.AlertEventFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	resp, err := h.alertService.ListAlertEvents(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
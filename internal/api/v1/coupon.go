@@ -0,0 +1,295 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type CouponHandler struct {
+	couponService service.CouponService
+	log           *logger.Logger
+}
+
+func NewCouponHandler(couponService service.CouponService, log *logger.Logger) *CouponHandler {
+	return &CouponHandler{
+		couponService: couponService,
+		log:           log,
+	}
+}
+
+// CreateCoupon godoc
+// @Summary Create a new coupon
+// @Description Create a new percentage or fixed amount coupon
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param coupon body dto.CreateCouponRequest true "Coupon to create"
+// @Success 201 {object} dto.CouponResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /coupons [post]
+func (h *CouponHandler) CreateCoupon(c *gin.Context) {
+	var req dto.CreateCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.couponService.CreateCoupon(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetCoupon godoc
+// @Summary Get a coupon by ID
+// @Description Get a coupon by ID
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Coupon ID"
+// @Success 200 {object} dto.CouponResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /coupons/{id} [get]
+func (h *CouponHandler) GetCoupon(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("coupon ID is required").
+			WithHint("Coupon ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.couponService.GetCoupon(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListCoupons godoc
+// @Summary List coupons
+// @Description List coupons with optional filtering
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.CouponFilter true "Filter"
+// @Success 200 {object} dto.ListCouponsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /coupons [get]
+func (h *CouponHandler) ListCoupons(c *gin.Context) {
+	var filter types.CouponFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	resp, err := h.couponService.ListCoupons(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateCoupon godoc
+// @Summary Update a coupon
+// @Description Update the name, redemption limits or metadata of a coupon
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Coupon ID"
+// @Param coupon body dto.UpdateCouponRequest true "Coupon update data"
+// @Success 200 {object} dto.CouponResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /coupons/{id} [put]
+func (h *CouponHandler) UpdateCoupon(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("coupon ID is required").
+			WithHint("Coupon ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.couponService.UpdateCoupon(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteCoupon godoc
+// @Summary Delete a coupon
+// @Description Archive a coupon so it can no longer be applied
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Coupon ID"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /coupons/{id} [delete]
+func (h *CouponHandler) DeleteCoupon(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("coupon ID is required").
+			WithHint("Coupon ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.couponService.DeleteCoupon(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "coupon deleted successfully"})
+}
+
+// ApplyCoupon godoc
+// @Summary Apply a coupon
+// @Description Apply a coupon to a customer, or to a single subscription of the customer
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Coupon ID"
+// @Param request body dto.ApplyCouponRequest true "Coupon application"
+// @Success 201 {object} dto.CouponApplicationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /coupons/{id}/apply [post]
+func (h *CouponHandler) ApplyCoupon(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("coupon ID is required").
+			WithHint("Coupon ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ApplyCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.couponService.ApplyCoupon(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListCouponApplications godoc
+// @Summary List coupon applications
+// @Description List the coupons applied to customers and subscriptions
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.CouponApplicationFilter true "Filter"
+// @Success 200 {object} dto.ListCouponApplicationsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /coupons/applications [get]
+func (h *CouponHandler) ListCouponApplications(c *gin.Context) {
+	var filter types.CouponApplicationFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	resp, err := h.couponService.ListCouponApplications(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// RemoveCouponApplication godoc
+// @Summary Remove a coupon application
+// @Description Stop a coupon from discounting future invoices of the customer or subscription
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Coupon application ID"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /coupons/applications/{id} [delete]
+func (h *CouponHandler) RemoveCouponApplication(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("coupon application ID is required").
+			WithHint("Coupon application ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.couponService.RemoveCouponApplication(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "coupon application removed successfully"})
+}
@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type CreditNoteHandler struct {
+	creditNoteService service.CreditNoteService
+	log               *logger.Logger
+}
+
+func NewCreditNoteHandler(creditNoteService service.CreditNoteService, log *logger.Logger) *CreditNoteHandler {
+	return &CreditNoteHandler{
+		creditNoteService: creditNoteService,
+		log:               log,
+	}
+}
+
+// CreateCreditNote godoc
+// @Summary Create a credit note
+// @Description Issue a credit note against a finalized invoice. The credited amount is refunded to the invoice payment, credited to a customer wallet or deducted from the amount remaining depending on the outcome.
+// @Tags Credit Notes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param credit_note body dto.CreateCreditNoteRequest true "Credit note to create"
+// @Success 201 {object} dto.CreditNoteResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /credit_notes [post]
+func (h *CreditNoteHandler) CreateCreditNote(c *gin.Context) {
+	var req dto.CreateCreditNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.creditNoteService.CreateCreditNote(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetCreditNote godoc
+// @Summary Get a credit note by ID
+// @Description Get a credit note and its line items by ID
+// @Tags Credit Notes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Credit note ID"
+// @Success 200 {object} dto.CreditNoteResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /credit_notes/{id} [get]
+func (h *CreditNoteHandler) GetCreditNote(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("credit note ID is required").
+			WithHint("Credit note ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.creditNoteService.GetCreditNote(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListCreditNotes godoc
+// @Summary List credit notes
+// @Description List credit notes with optional filtering by invoice, customer or outcome
+// @Tags Credit Notes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.CreditNoteFilter true "Filter"
+// @Success 200 {object} dto.ListCreditNotesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /credit_notes [get]
+func (h *CreditNoteHandler) ListCreditNotes(c *gin.Context) {
+	var filter types.CreditNoteFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	resp, err := h.creditNoteService.ListCreditNotes(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetCreditNotePDF godoc
+// @Summary Get PDF for a credit note
+// @Description Retrieve the PDF document for a specific credit note by its ID
+// @Tags Credit Notes
+// @Security ApiKeyAuth
+// @Param id path string true "Credit note ID"
+// @Success 200 {file} application/pdf
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /credit_notes/{id}/pdf [get]
+func (h *CreditNoteHandler) GetCreditNotePDF(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("credit note ID is required").
+			WithHint("Credit note ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	pdf, err := h.creditNoteService.GetCreditNotePDF(c.Request.Context(), id)
+	if err != nil {
+		h.log.Errorw("failed to generate credit note pdf", "error", err, "credit_note_id", id)
+		c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/pdf", pdf)
+}
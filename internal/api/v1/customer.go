@@ -267,3 +267,33 @@ func (h *CustomerHandler) GetCustomerUsageSummary(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+// @Summary Check customer entitlement
+// @Description Check if a customer can use a quantity of a feature. Usage is read from a cached counter refreshed every minute, and allowed quantities are counted until their events are ingested. Counters are kept per instance, so each instance of the API enforces the usage limit on its own.
+// @Tags Customers
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.CheckEntitlementRequest true "Entitlement check"
+// @Success 200 {object} dto.CheckEntitlementResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /customers/entitlements/check [post]
+func (h *CustomerHandler) CheckEntitlement(c *gin.Context) {
+	var req dto.CheckEntitlementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.billing.CheckEntitlement(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
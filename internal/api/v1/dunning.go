@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"net/http"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type DunningHandler struct {
+	dunningService service.DunningService
+	logger         *logger.Logger
+}
+
+func NewDunningHandler(dunningService service.DunningService, logger *logger.Logger) *DunningHandler {
+	return &DunningHandler{
+		dunningService: dunningService,
+		logger:         logger,
+	}
+}
+
+// ListInvoiceAttempts godoc
+// @Summary List dunning attempts of an invoice
+// @Description Get the payment retries made by the dunning process for an unpaid invoice
+// @Tags Invoices
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Invoice ID"
+// @Success 200 {object} dto.ListDunningAttemptsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /invoices/{id}/dunning_attempts [get]
+func (h *DunningHandler) ListInvoiceAttempts(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("invoice ID is required").
+			WithHint("Please provide an invoice ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.dunningService.ListInvoiceAttempts(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Errorw("failed to list dunning attempts", "error", err, "invoice_id", id)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -142,6 +142,186 @@ func (h *SubscriptionHandler) CancelSubscription(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "subscription cancelled successfully"})
 }
 
+// @Summary Change subscription plan
+// @Description Move a subscription to another plan mid-cycle. The usage of the previous plan is settled up to the change, and fixed advance charges are prorated unless proration_behavior is none. The plan change invoice is finalized immediately or at the end of the current period depending on invoice_timing.
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Param request body dto.ChangeSubscriptionPlanRequest true "Change plan request"
+// @Success 200 {object} dto.ChangeSubscriptionPlanResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/change_plan [post]
+func (h *SubscriptionHandler) ChangePlan(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Please provide a valid subscription ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ChangeSubscriptionPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.ChangePlan(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Migrate subscriptions to the current plan version
+// @Description Schedule the subscriptions pinned to a previous version of a plan to move to its current version. Subscriptions can be narrowed down by subscription, customer and plan version. The migration takes effect at the end of the current billing period of each subscription.
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.MigratePlanVersionRequest true "Migrate plan version request"
+// @Success 200 {object} dto.MigratePlanVersionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/plan_version_migrations [post]
+func (h *SubscriptionHandler) MigratePlanVersion(c *gin.Context) {
+	var req dto.MigratePlanVersionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.MigratePlanVersion(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Update subscription payment method
+// @Description Set or remove the payment method on file. Trials requiring a payment method are cancelled at trial end when none is set.
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Param request body dto.UpdateSubscriptionPaymentMethodRequest true "Update payment method request"
+// @Success 200 {object} dto.SubscriptionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/payment_method [post]
+func (h *SubscriptionHandler) UpdatePaymentMethod(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Please provide a valid subscription ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateSubscriptionPaymentMethodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.UpdatePaymentMethod(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Update line item quantity
+// @Description Change the quantity of a fixed price line item from an effective date within the current period. Increases of charges invoiced in advance are prorated on the next invoice, decreases are credited with a credit note.
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Param line_item_id path string true "Line item ID"
+// @Param request body dto.UpdateLineItemQuantityRequest true "Update quantity request"
+// @Success 200 {object} dto.UpdateLineItemQuantityResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/line_items/{line_item_id}/quantity [post]
+func (h *SubscriptionHandler) UpdateLineItemQuantity(c *gin.Context) {
+	id := c.Param("id")
+	lineItemID := c.Param("line_item_id")
+	if id == "" || lineItemID == "" {
+		c.Error(ierr.NewError("subscription ID and line item ID are required").
+			WithHint("Please provide a valid subscription ID and line item ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateLineItemQuantityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.UpdateLineItemQuantity(c.Request.Context(), id, lineItemID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary List quantity changes
+// @Description List the quantity changes of a subscription line item ordered by effective date
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Param line_item_id path string true "Line item ID"
+// @Success 200 {object} dto.ListSubscriptionQuantityChangesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/line_items/{line_item_id}/quantity_changes [get]
+func (h *SubscriptionHandler) ListQuantityChanges(c *gin.Context) {
+	id := c.Param("id")
+	lineItemID := c.Param("line_item_id")
+	if id == "" || lineItemID == "" {
+		c.Error(ierr.NewError("subscription ID and line item ID are required").
+			WithHint("Please provide a valid subscription ID and line item ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.ListQuantityChanges(c.Request.Context(), id, lineItemID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // @Summary Get usage by subscription
 // @Description Get usage for a subscription
 // @Tags Subscriptions
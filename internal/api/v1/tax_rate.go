@@ -0,0 +1,190 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type TaxRateHandler struct {
+	taxRateService service.TaxRateService
+	log            *logger.Logger
+}
+
+func NewTaxRateHandler(taxRateService service.TaxRateService, log *logger.Logger) *TaxRateHandler {
+	return &TaxRateHandler{
+		taxRateService: taxRateService,
+		log:            log,
+	}
+}
+
+// CreateTaxRate godoc
+// @Summary Create a new tax rate
+// @Description Create an inclusive or exclusive tax rate for a country or a state of a country
+// @Tags Tax Rates
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param tax_rate body dto.CreateTaxRateRequest true "Tax rate to create"
+// @Success 201 {object} dto.TaxRateResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /tax_rates [post]
+func (h *TaxRateHandler) CreateTaxRate(c *gin.Context) {
+	var req dto.CreateTaxRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.taxRateService.CreateTaxRate(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetTaxRate godoc
+// @Summary Get a tax rate by ID
+// @Description Get a tax rate by ID
+// @Tags Tax Rates
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Tax rate ID"
+// @Success 200 {object} dto.TaxRateResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /tax_rates/{id} [get]
+func (h *TaxRateHandler) GetTaxRate(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("tax rate ID is required").
+			WithHint("Tax rate ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.taxRateService.GetTaxRate(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListTaxRates godoc
+// @Summary List tax rates
+// @Description List tax rates with optional filtering by jurisdiction
+// @Tags Tax Rates
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.TaxRateFilter true "Filter"
+// @Success 200 {object} dto.ListTaxRatesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /tax_rates [get]
+func (h *TaxRateHandler) ListTaxRates(c *gin.Context) {
+	var filter types.TaxRateFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	resp, err := h.taxRateService.ListTaxRates(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateTaxRate godoc
+// @Summary Update a tax rate
+// @Description Update the name, description or metadata of a tax rate
+// @Tags Tax Rates
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Tax rate ID"
+// @Param tax_rate body dto.UpdateTaxRateRequest true "Tax rate update data"
+// @Success 200 {object} dto.TaxRateResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /tax_rates/{id} [put]
+func (h *TaxRateHandler) UpdateTaxRate(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("tax rate ID is required").
+			WithHint("Tax rate ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateTaxRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.taxRateService.UpdateTaxRate(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteTaxRate godoc
+// @Summary Delete a tax rate
+// @Description Archive a tax rate so it is no longer applied to new invoices
+// @Tags Tax Rates
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Tax rate ID"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /tax_rates/{id} [delete]
+func (h *TaxRateHandler) DeleteTaxRate(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("tax rate ID is required").
+			WithHint("Tax rate ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.taxRateService.DeleteTaxRate(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "tax rate deleted successfully"})
+}
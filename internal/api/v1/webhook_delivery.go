@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type WebhookDeliveryHandler struct {
+	webhookDeliveryService service.WebhookDeliveryService
+	log                    *logger.Logger
+}
+
+func NewWebhookDeliveryHandler(webhookDeliveryService service.WebhookDeliveryService, log *logger.Logger) *WebhookDeliveryHandler {
+	return &WebhookDeliveryHandler{
+		webhookDeliveryService: webhookDeliveryService,
+		log:                    log,
+	}
+}
+
+// ListWebhookDeliveries godoc
+// @Summary List webhook deliveries
+// @Description List the attempts to deliver webhook events with optional filtering
+// @Tags Webhook Deliveries
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.WebhookDeliveryFilter true "Filter"
+// @Success 200 {object} dto.ListWebhookDeliveriesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /webhooks/deliveries [get]
+func (h *WebhookDeliveryHandler) ListWebhookDeliveries(c *gin.Context) {
+	var filter types.WebhookDeliveryFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	resp, err := h.webhookDeliveryService.ListWebhookDeliveries(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetWebhookDelivery godoc
+// @Summary Get a webhook delivery by ID
+// @Description Get a webhook delivery by ID
+// @Tags Webhook Deliveries
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook delivery ID"
+// @Success 200 {object} dto.WebhookDeliveryResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /webhooks/deliveries/{id} [get]
+func (h *WebhookDeliveryHandler) GetWebhookDelivery(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook delivery ID is required").
+			WithHint("Webhook delivery ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.webhookDeliveryService.GetWebhookDelivery(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ReplayWebhookDelivery godoc
+// @Summary Replay a webhook delivery
+// @Description Send the payload of a webhook delivery again to the current configuration of its destination
+// @Tags Webhook Deliveries
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook delivery ID"
+// @Success 200 {object} dto.WebhookDeliveryResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /webhooks/deliveries/{id}/replay [post]
+func (h *WebhookDeliveryHandler) ReplayWebhookDelivery(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook delivery ID is required").
+			WithHint("Webhook delivery ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.webhookDeliveryService.ReplayWebhookDelivery(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ReplayWebhookDeliveries godoc
+// @Summary Replay failed webhook deliveries
+// @Description Replay the failed deliveries of a time range whose event was never received by the destination
+// @Tags Webhook Deliveries
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param replay body dto.ReplayWebhookDeliveriesRequest true "Deliveries to replay"
+// @Success 200 {object} dto.ReplayWebhookDeliveriesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /webhooks/deliveries/replay [post]
+func (h *WebhookDeliveryHandler) ReplayWebhookDeliveries(c *gin.Context) {
+	var req dto.ReplayWebhookDeliveriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.webhookDeliveryService.ReplayWebhookDeliveries(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
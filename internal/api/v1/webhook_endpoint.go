@@ -0,0 +1,232 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type WebhookEndpointHandler struct {
+	webhookEndpointService service.WebhookEndpointService
+	log                    *logger.Logger
+}
+
+func NewWebhookEndpointHandler(webhookEndpointService service.WebhookEndpointService, log *logger.Logger) *WebhookEndpointHandler {
+	return &WebhookEndpointHandler{
+		webhookEndpointService: webhookEndpointService,
+		log:                    log,
+	}
+}
+
+// CreateWebhookEndpoint godoc
+// @Summary Create a new webhook endpoint
+// @Description Register an endpoint the webhook events of the environment are sent to
+// @Tags Webhook Endpoints
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param webhook_endpoint body dto.CreateWebhookEndpointRequest true "Webhook endpoint to create"
+// @Success 201 {object} dto.WebhookEndpointResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /webhook_endpoints [post]
+func (h *WebhookEndpointHandler) CreateWebhookEndpoint(c *gin.Context) {
+	var req dto.CreateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.webhookEndpointService.CreateWebhookEndpoint(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetWebhookEndpoint godoc
+// @Summary Get a webhook endpoint by ID
+// @Description Get a webhook endpoint by ID
+// @Tags Webhook Endpoints
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Success 200 {object} dto.WebhookEndpointResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /webhook_endpoints/{id} [get]
+func (h *WebhookEndpointHandler) GetWebhookEndpoint(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook endpoint ID is required").
+			WithHint("Webhook endpoint ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.webhookEndpointService.GetWebhookEndpoint(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListWebhookEndpoints godoc
+// @Summary List webhook endpoints
+// @Description List webhook endpoints with optional filtering
+// @Tags Webhook Endpoints
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.WebhookEndpointFilter true "Filter"
+// @Success 200 {object} dto.ListWebhookEndpointsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /webhook_endpoints [get]
+func (h *WebhookEndpointHandler) ListWebhookEndpoints(c *gin.Context) {
+	var filter types.WebhookEndpointFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	resp, err := h.webhookEndpointService.ListWebhookEndpoints(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateWebhookEndpoint godoc
+// @Summary Update a webhook endpoint
+// @Description Update the url, events, headers or metadata of a webhook endpoint, or enable and disable it
+// @Tags Webhook Endpoints
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Param webhook_endpoint body dto.UpdateWebhookEndpointRequest true "Webhook endpoint update data"
+// @Success 200 {object} dto.WebhookEndpointResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /webhook_endpoints/{id} [put]
+func (h *WebhookEndpointHandler) UpdateWebhookEndpoint(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook endpoint ID is required").
+			WithHint("Webhook endpoint ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.webhookEndpointService.UpdateWebhookEndpoint(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteWebhookEndpoint godoc
+// @Summary Delete a webhook endpoint
+// @Description Archive a webhook endpoint so no more events are sent to it
+// @Tags Webhook Endpoints
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /webhook_endpoints/{id} [delete]
+func (h *WebhookEndpointHandler) DeleteWebhookEndpoint(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook endpoint ID is required").
+			WithHint("Webhook endpoint ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.webhookEndpointService.DeleteWebhookEndpoint(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "webhook endpoint deleted successfully"})
+}
+
+// RotateWebhookEndpointSecret godoc
+// @Summary Rotate the signing secret of a webhook endpoint
+// @Description Replace the signing secret of a webhook endpoint, the deliveries are signed with both secrets until the replaced one expires
+// @Tags Webhook Endpoints
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Param rotation body dto.RotateWebhookEndpointSecretRequest false "Rotation options"
+// @Success 200 {object} dto.WebhookEndpointResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /webhook_endpoints/{id}/rotate_secret [post]
+func (h *WebhookEndpointHandler) RotateWebhookEndpointSecret(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook endpoint ID is required").
+			WithHint("Webhook endpoint ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.RotateWebhookEndpointSecretRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(ierr.WithError(err).
+				WithHint("Invalid request format").
+				Mark(ierr.ErrValidation))
+			return
+		}
+	}
+
+	resp, err := h.webhookEndpointService.RotateWebhookEndpointSecret(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -41,6 +41,8 @@ const (
 	PrefixInvoice     = "invoice:v1:"
 	PrefixFeature     = "feature:v1:"
 	PrefixEntitlement = "entitlement:v1:"
+
+	PrefixEntitlementUsage = "entitlement_usage:v1:"
 )
 
 // GenerateKey creates a cache key from a prefix and a set of parameters
@@ -4,4 +4,11 @@ import "time"
 
 const (
 	ExpiryDefaultInMemory = 30 * time.Minute
+
+	// ExpiryEntitlementUsage is how often the usage counters of entitlement checks are reconciled with the ingested usage
+	ExpiryEntitlementUsage = 1 * time.Minute
+
+	// ExpiryEntitlementReservation is how long the quantity allowed by an entitlement check is counted
+	// on top of the aggregated usage when its events are not seen by the aggregation
+	ExpiryEntitlementReservation = 5 * time.Minute
 )
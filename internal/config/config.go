@@ -28,8 +28,10 @@ type Configuration struct {
 	DynamoDB   DynamoDBConfig   `validate:"required"`
 	Temporal   TemporalConfig   `validate:"required"`
 	Webhook    Webhook          `validate:"omitempty"`
+	Outbox     OutboxConfig     `validate:"omitempty"`
 	Secrets    SecretsConfig    `validate:"required"`
 	Billing    BillingConfig    `validate:"omitempty"`
+	Storage    StorageConfig    `validate:"omitempty"`
 }
 
 type DeploymentConfig struct {
@@ -125,6 +127,18 @@ type BillingConfig struct {
 	EnvironmentID string `mapstructure:"environment_id" validate:"omitempty"`
 }
 
+type StorageConfig struct {
+	Provider types.StorageProvider `mapstructure:"provider" validate:"omitempty"`
+	Local    LocalStorageConfig    `mapstructure:"local"`
+}
+
+type LocalStorageConfig struct {
+	// BaseDir is the directory the files are written to
+	BaseDir string `mapstructure:"base_dir"`
+	// BaseURL is prefixed to the file keys to build download URLs, file URLs are returned when empty
+	BaseURL string `mapstructure:"base_url"`
+}
+
 func NewConfig() (*Configuration, error) {
 	v := viper.New()
 
@@ -0,0 +1,17 @@
+package config
+
+import (
+	"time"
+)
+
+// OutboxConfig holds configuration for the transactional outbox and its relay. The relay only
+// runs in the local and api modes, which consume the webhook events, so at least one of them must
+// be deployed when the outbox is enabled.
+type OutboxConfig struct {
+	Enabled      bool          `mapstructure:"enabled"`
+	PollInterval time.Duration `mapstructure:"poll_interval" default:"1s"`
+	BatchSize    int           `mapstructure:"batch_size" default:"100"`
+	// LeaseDuration is how long a relay owns an event it is publishing before another relay may retry it
+	LeaseDuration time.Duration `mapstructure:"lease_duration" default:"30s"`
+	MaxBackoff    time.Duration `mapstructure:"max_backoff" default:"5m"`
+}
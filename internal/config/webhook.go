@@ -19,7 +19,10 @@ type Webhook struct {
 	Tenants         map[string]TenantWebhookConfig `mapstructure:"tenants"`
 }
 
-// TenantWebhookConfig represents webhook configuration for a specific tenant
+// TenantWebhookConfig represents webhook configuration for a specific tenant.
+//
+// Deprecated: webhook endpoints are managed through the API, the static configuration is only
+// used for the tenants without any webhook endpoint.
 type TenantWebhookConfig struct {
 	Endpoint       string            `mapstructure:"endpoint"`
 	Headers        map[string]string `mapstructure:"headers"`
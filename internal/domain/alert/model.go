@@ -0,0 +1,138 @@
+package alert
+
+import (
+	"sort"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// Rule notifies when a subscription crosses one of its thresholds in a billing period.
+// Entitlement usage rules measure the usage of a metered feature as a percent of its usage
+// limit, subscription spend rules measure the usage charges in the currency of the subscription.
+type Rule struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	AlertType types.AlertType `json:"alert_type"`
+
+	// FeatureID is the metered feature of entitlement usage rules
+	FeatureID *string `json:"feature_id,omitempty"`
+
+	// CustomerID and SubscriptionID limit the rule to a customer or a subscription, the rule
+	// applies to all subscriptions when neither is set
+	CustomerID     *string `json:"customer_id,omitempty"`
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+
+	// Currency limits subscription spend rules to the subscriptions billed in the currency
+	Currency string `json:"currency,omitempty"`
+
+	// Thresholds are percents for entitlement usage rules and amounts for subscription spend rules
+	Thresholds []decimal.Decimal `json:"thresholds"`
+
+	Metadata      types.Metadata `json:"metadata,omitempty"`
+	EnvironmentID string         `json:"environment_id"`
+	types.BaseModel
+}
+
+// Event is a threshold of a rule crossed by a subscription in a billing period
+type Event struct {
+	ID             string          `json:"id"`
+	AlertRuleID    string          `json:"alert_rule_id"`
+	AlertType      types.AlertType `json:"alert_type"`
+	CustomerID     string          `json:"customer_id"`
+	SubscriptionID string          `json:"subscription_id"`
+	FeatureID      *string         `json:"feature_id,omitempty"`
+	Threshold      decimal.Decimal `json:"threshold"`
+
+	// Value is the usage percent or spend that crossed the threshold
+	Value decimal.Decimal `json:"value"`
+
+	Currency      string    `json:"currency,omitempty"`
+	PeriodStart   time.Time `json:"period_start"`
+	PeriodEnd     time.Time `json:"period_end"`
+	EnvironmentID string    `json:"environment_id"`
+	types.BaseModel
+}
+
+// SortThresholds returns the thresholds in ascending order
+func SortThresholds(thresholds []decimal.Decimal) []decimal.Decimal {
+	sorted := append([]decimal.Decimal(nil), thresholds...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+	return sorted
+}
+
+func FromEnt(e *ent.AlertRule) *Rule {
+	if e == nil {
+		return nil
+	}
+
+	return &Rule{
+		ID:             e.ID,
+		Name:           e.Name,
+		AlertType:      types.AlertType(e.AlertType),
+		FeatureID:      e.FeatureID,
+		CustomerID:     e.CustomerID,
+		SubscriptionID: e.SubscriptionID,
+		Currency:       e.Currency,
+		Thresholds:     e.Thresholds,
+		Metadata:       types.Metadata(e.Metadata),
+		EnvironmentID:  e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+func FromEntList(rules []*ent.AlertRule) []*Rule {
+	result := make([]*Rule, len(rules))
+	for i, r := range rules {
+		result[i] = FromEnt(r)
+	}
+	return result
+}
+
+func EventFromEnt(e *ent.AlertEvent) *Event {
+	if e == nil {
+		return nil
+	}
+
+	return &Event{
+		ID:             e.ID,
+		AlertRuleID:    e.AlertRuleID,
+		AlertType:      types.AlertType(e.AlertType),
+		CustomerID:     e.CustomerID,
+		SubscriptionID: e.SubscriptionID,
+		FeatureID:      e.FeatureID,
+		Threshold:      e.Threshold,
+		Value:          e.Value,
+		Currency:       e.Currency,
+		PeriodStart:    e.PeriodStart,
+		PeriodEnd:      e.PeriodEnd,
+		EnvironmentID:  e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+func EventFromEntList(events []*ent.AlertEvent) []*Event {
+	result := make([]*Event, len(events))
+	for i, e := range events {
+		result[i] = EventFromEnt(e)
+	}
+	return result
+}
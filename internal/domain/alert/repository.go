@@ -0,0 +1,23 @@
+package alert
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for alert rule storage operations
+type Repository interface {
+	Create(ctx context.Context, rule *Rule) error
+	Get(ctx context.Context, id string) (*Rule, error)
+	List(ctx context.Context, filter *types.AlertRuleFilter) ([]*Rule, error)
+	Count(ctx context.Context, filter *types.AlertRuleFilter) (int, error)
+	Update(ctx context.Context, rule *Rule) error
+	Delete(ctx context.Context, id string) error
+
+	// Alert event operations
+	CreateEvent(ctx context.Context, event *Event) error
+	GetEvent(ctx context.Context, id string) (*Event, error)
+	ListEvents(ctx context.Context, filter *types.AlertEventFilter) ([]*Event, error)
+	CountEvents(ctx context.Context, filter *types.AlertEventFilter) (int, error)
+}
@@ -0,0 +1,216 @@
+package coupon
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// Coupon is a reusable discount definition that can be applied to customers and subscriptions
+type Coupon struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	// Code is the optional unique code customers can redeem the coupon with
+	Code string `json:"code,omitempty"`
+
+	DiscountType types.CouponDiscountType `json:"discount_type"`
+
+	// PercentOff is set for percentage coupons ex 25 for 25% off
+	PercentOff *decimal.Decimal `json:"percent_off,omitempty"`
+
+	// AmountOff is set for fixed amount coupons and is expressed in Currency
+	AmountOff *decimal.Decimal `json:"amount_off,omitempty"`
+
+	// Currency is required for fixed amount coupons, which only apply to invoices in the same currency
+	Currency string `json:"currency,omitempty"`
+
+	Duration types.CouponDuration `json:"duration"`
+
+	// DurationInPeriods is the number of billing periods a repeating coupon discounts
+	DurationInPeriods *int `json:"duration_in_periods,omitempty"`
+
+	// MaxRedemptions caps the number of times the coupon can be applied across all customers
+	MaxRedemptions *int `json:"max_redemptions,omitempty"`
+
+	TimesRedeemed int `json:"times_redeemed"`
+
+	// RedeemBy is the last time the coupon can be applied
+	RedeemBy *time.Time `json:"redeem_by,omitempty"`
+
+	Metadata      types.Metadata `json:"metadata,omitempty"`
+	EnvironmentID string         `json:"environment_id"`
+	types.BaseModel
+}
+
+// CouponApplication is a coupon applied to a customer, or to a single subscription of the customer
+type CouponApplication struct {
+	ID         string `json:"id"`
+	CouponID   string `json:"coupon_id"`
+	CustomerID string `json:"customer_id"`
+
+	// SubscriptionID limits the discount to the invoices of one subscription,
+	// when empty the coupon applies to all subscriptions of the customer
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+
+	// PeriodsApplied is the number of invoices the coupon has discounted so far
+	PeriodsApplied int `json:"periods_applied"`
+
+	Metadata      types.Metadata `json:"metadata,omitempty"`
+	EnvironmentID string         `json:"environment_id"`
+	types.BaseModel
+}
+
+// IsRedeemable checks whether the coupon can still be applied at the given time
+func (c *Coupon) IsRedeemable(at time.Time) bool {
+	if c.Status != types.StatusPublished {
+		return false
+	}
+
+	if c.RedeemBy != nil && at.After(*c.RedeemBy) {
+		return false
+	}
+
+	if c.MaxRedemptions != nil && c.TimesRedeemed >= *c.MaxRedemptions {
+		return false
+	}
+
+	return true
+}
+
+// HasRemainingPeriods checks whether an application of the coupon can discount another invoice
+func (c *Coupon) HasRemainingPeriods(periodsApplied int) bool {
+	limit := c.PeriodLimit()
+	return limit == nil || periodsApplied < *limit
+}
+
+// PeriodLimit returns the number of invoices an application of the coupon can discount, or nil
+// when the coupon discounts every invoice
+func (c *Coupon) PeriodLimit() *int {
+	switch c.Duration {
+	case types.CouponDurationOnce:
+		return lo.ToPtr(1)
+	case types.CouponDurationRepeating:
+		return lo.ToPtr(lo.FromPtr(c.DurationInPeriods))
+	case types.CouponDurationForever:
+		return nil
+	default:
+		return lo.ToPtr(0)
+	}
+}
+
+// CalculateDiscount returns the discount of the coupon for the given amount in the given currency.
+// The discount never exceeds the amount and is rounded to the currency precision.
+func (c *Coupon) CalculateDiscount(amount decimal.Decimal, currency string) decimal.Decimal {
+	if !amount.IsPositive() {
+		return decimal.Zero
+	}
+
+	var discount decimal.Decimal
+	switch c.DiscountType {
+	case types.CouponDiscountTypePercentage:
+		discount = amount.Mul(lo.FromPtr(c.PercentOff)).Div(decimal.NewFromInt(100))
+	case types.CouponDiscountTypeFixedAmount:
+		if !strings.EqualFold(c.Currency, currency) {
+			return decimal.Zero
+		}
+		discount = lo.FromPtr(c.AmountOff)
+	default:
+		return decimal.Zero
+	}
+
+	discount = decimal.Min(discount, amount)
+	return discount.Round(types.GetCurrencyPrecision(currency))
+}
+
+// GetDescription returns the description of the coupon shown on invoice discount lines
+func (c *Coupon) GetDescription() string {
+	switch c.DiscountType {
+	case types.CouponDiscountTypePercentage:
+		return fmt.Sprintf("%s (%s%% off)", c.Name, lo.FromPtr(c.PercentOff).String())
+	case types.CouponDiscountTypeFixedAmount:
+		return fmt.Sprintf("%s (%s%s off)", c.Name, types.GetCurrencySymbol(c.Currency), lo.FromPtr(c.AmountOff).String())
+	default:
+		return c.Name
+	}
+}
+
+// FromEnt converts ent.Coupon to domain Coupon
+func FromEnt(e *ent.Coupon) *Coupon {
+	if e == nil {
+		return nil
+	}
+
+	return &Coupon{
+		ID:                e.ID,
+		Name:              e.Name,
+		Code:              e.Code,
+		DiscountType:      types.CouponDiscountType(e.DiscountType),
+		PercentOff:        e.PercentOff,
+		AmountOff:         e.AmountOff,
+		Currency:          e.Currency,
+		Duration:          types.CouponDuration(e.Duration),
+		DurationInPeriods: e.DurationInPeriods,
+		MaxRedemptions:    e.MaxRedemptions,
+		TimesRedeemed:     e.TimesRedeemed,
+		RedeemBy:          e.RedeemBy,
+		Metadata:          types.Metadata(e.Metadata),
+		EnvironmentID:     e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+// FromEntList converts []*ent.Coupon to []*Coupon
+func FromEntList(coupons []*ent.Coupon) []*Coupon {
+	result := make([]*Coupon, len(coupons))
+	for i, c := range coupons {
+		result[i] = FromEnt(c)
+	}
+	return result
+}
+
+// ApplicationFromEnt converts ent.CouponApplication to domain CouponApplication
+func ApplicationFromEnt(e *ent.CouponApplication) *CouponApplication {
+	if e == nil {
+		return nil
+	}
+
+	return &CouponApplication{
+		ID:             e.ID,
+		CouponID:       e.CouponID,
+		CustomerID:     e.CustomerID,
+		SubscriptionID: e.SubscriptionID,
+		PeriodsApplied: e.PeriodsApplied,
+		Metadata:       types.Metadata(e.Metadata),
+		EnvironmentID:  e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+// ApplicationFromEntList converts []*ent.CouponApplication to []*CouponApplication
+func ApplicationFromEntList(applications []*ent.CouponApplication) []*CouponApplication {
+	result := make([]*CouponApplication, len(applications))
+	for i, a := range applications {
+		result[i] = ApplicationFromEnt(a)
+	}
+	return result
+}
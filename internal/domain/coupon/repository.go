@@ -0,0 +1,30 @@
+package coupon
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for coupon storage operations
+type Repository interface {
+	Create(ctx context.Context, coupon *Coupon) error
+	Get(ctx context.Context, id string) (*Coupon, error)
+	List(ctx context.Context, filter *types.CouponFilter) ([]*Coupon, error)
+	Count(ctx context.Context, filter *types.CouponFilter) (int, error)
+	Update(ctx context.Context, coupon *Coupon) error
+	Delete(ctx context.Context, id string) error
+	// IncrementTimesRedeemed atomically counts a redemption unless the coupon reached its
+	// maximum redemptions, in which case it returns false
+	IncrementTimesRedeemed(ctx context.Context, id string) (bool, error)
+
+	// Coupon application operations
+	CreateApplication(ctx context.Context, application *CouponApplication) error
+	GetApplication(ctx context.Context, id string) (*CouponApplication, error)
+	ListApplications(ctx context.Context, filter *types.CouponApplicationFilter) ([]*CouponApplication, error)
+	CountApplications(ctx context.Context, filter *types.CouponApplicationFilter) (int, error)
+	UpdateApplication(ctx context.Context, application *CouponApplication) error
+	// IncrementPeriodsApplied atomically counts a period discounted by the application unless it
+	// reached the given limit, in which case it returns false. A nil limit never stops the count.
+	IncrementPeriodsApplied(ctx context.Context, id string, limit *int) (bool, error)
+}
@@ -0,0 +1,177 @@
+package creditnote
+
+import (
+	"github.com/flexprice/flexprice/ent"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// CreditNote is a partial or full correction of a finalized invoice
+type CreditNote struct {
+	ID               string  `json:"id"`
+	CreditNoteNumber string  `json:"credit_note_number"`
+	InvoiceID        string  `json:"invoice_id"`
+	CustomerID       string  `json:"customer_id"`
+	SubscriptionID   *string `json:"subscription_id,omitempty"`
+
+	Reason  types.CreditNoteReason  `json:"reason"`
+	Outcome types.CreditNoteOutcome `json:"outcome"`
+	Memo    string                  `json:"memo,omitempty"`
+
+	Currency    string          `json:"currency"`
+	TotalAmount decimal.Decimal `json:"total_amount"`
+
+	// WalletID is the wallet credited by the credit note, set for wallet credits and
+	// for refunds of payments made with wallet credits
+	WalletID *string `json:"wallet_id,omitempty"`
+
+	// PaymentID is the payment refunded by the credit note
+	PaymentID *string `json:"payment_id,omitempty"`
+
+	LineItems     []*CreditNoteLineItem `json:"line_items,omitempty"`
+	Metadata      types.Metadata        `json:"metadata,omitempty"`
+	EnvironmentID string                `json:"environment_id"`
+	types.BaseModel
+}
+
+// CreditNoteLineItem is the amount credited for a line item of the invoice
+type CreditNoteLineItem struct {
+	ID           string `json:"id"`
+	CreditNoteID string `json:"credit_note_id"`
+
+	// InvoiceLineItemID is empty for adjustments that are not tied to a single invoice line item
+	InvoiceLineItemID *string `json:"invoice_line_item_id,omitempty"`
+
+	DisplayName   string          `json:"display_name"`
+	Amount        decimal.Decimal `json:"amount"`
+	Currency      string          `json:"currency"`
+	Metadata      types.Metadata  `json:"metadata,omitempty"`
+	EnvironmentID string          `json:"environment_id"`
+	types.BaseModel
+}
+
+// Validate validates the credit note and checks its total matches its line items
+func (c *CreditNote) Validate() error {
+	if err := c.Reason.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Outcome.Validate(); err != nil {
+		return err
+	}
+
+	if len(c.LineItems) == 0 {
+		return ierr.NewError("credit note must have at least one line item").
+			WithHint("Please provide the line items to credit").
+			Mark(ierr.ErrValidation)
+	}
+
+	total := decimal.Zero
+	for _, item := range c.LineItems {
+		if !item.Amount.IsPositive() {
+			return ierr.NewError("credit note line item amount must be positive").
+				WithHint("Credited amounts must be greater than zero").
+				WithReportableDetails(map[string]any{
+					"display_name": item.DisplayName,
+					"amount":       item.Amount.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		total = total.Add(item.Amount)
+	}
+
+	if !total.Equal(c.TotalAmount) {
+		return ierr.NewError("credit note total does not match line items").
+			WithHint("Total amount must be the sum of the line item amounts").
+			WithReportableDetails(map[string]any{
+				"total_amount":     c.TotalAmount.String(),
+				"line_items_total": total.String(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// FromEnt converts ent.CreditNote to domain CreditNote
+func FromEnt(e *ent.CreditNote) *CreditNote {
+	if e == nil {
+		return nil
+	}
+
+	var lineItems []*CreditNoteLineItem
+	if e.Edges.LineItems != nil {
+		lineItems = LineItemFromEntList(e.Edges.LineItems)
+	}
+
+	return &CreditNote{
+		ID:               e.ID,
+		CreditNoteNumber: e.CreditNoteNumber,
+		InvoiceID:        e.InvoiceID,
+		CustomerID:       e.CustomerID,
+		SubscriptionID:   e.SubscriptionID,
+		Reason:           types.CreditNoteReason(e.Reason),
+		Outcome:          types.CreditNoteOutcome(e.Outcome),
+		Memo:             e.Memo,
+		Currency:         e.Currency,
+		TotalAmount:      e.TotalAmount,
+		WalletID:         e.WalletID,
+		PaymentID:        e.PaymentID,
+		LineItems:        lineItems,
+		Metadata:         types.Metadata(e.Metadata),
+		EnvironmentID:    e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+// FromEntList converts []*ent.CreditNote to []*CreditNote
+func FromEntList(notes []*ent.CreditNote) []*CreditNote {
+	result := make([]*CreditNote, len(notes))
+	for i, n := range notes {
+		result[i] = FromEnt(n)
+	}
+	return result
+}
+
+// LineItemFromEnt converts ent.CreditNoteLineItem to domain CreditNoteLineItem
+func LineItemFromEnt(e *ent.CreditNoteLineItem) *CreditNoteLineItem {
+	if e == nil {
+		return nil
+	}
+
+	return &CreditNoteLineItem{
+		ID:                e.ID,
+		CreditNoteID:      e.CreditNoteID,
+		InvoiceLineItemID: e.InvoiceLineItemID,
+		DisplayName:       e.DisplayName,
+		Amount:            e.Amount,
+		Currency:          e.Currency,
+		Metadata:          types.Metadata(e.Metadata),
+		EnvironmentID:     e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+// LineItemFromEntList converts []*ent.CreditNoteLineItem to []*CreditNoteLineItem
+func LineItemFromEntList(items []*ent.CreditNoteLineItem) []*CreditNoteLineItem {
+	result := make([]*CreditNoteLineItem, len(items))
+	for i, item := range items {
+		result[i] = LineItemFromEnt(item)
+	}
+	return result
+}
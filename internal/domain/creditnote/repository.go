@@ -0,0 +1,19 @@
+package creditnote
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for credit note storage operations
+type Repository interface {
+	// CreateWithLineItems creates a credit note with its line items in a single transaction
+	CreateWithLineItems(ctx context.Context, note *CreditNote) error
+	Get(ctx context.Context, id string) (*CreditNote, error)
+	List(ctx context.Context, filter *types.CreditNoteFilter) ([]*CreditNote, error)
+	Count(ctx context.Context, filter *types.CreditNoteFilter) (int, error)
+
+	// GetNextCreditNoteNumber generates the next credit note number of the tenant for the current month
+	GetNextCreditNoteNumber(ctx context.Context) (string, error)
+}
@@ -2,8 +2,10 @@ package customer
 
 import (
 	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/schema"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 // Customer represents a customer in the system
@@ -38,6 +40,15 @@ type Customer struct {
 	// AddressCountry is the country of the customer's address (ISO 3166-1 alpha-2)
 	AddressCountry string `db:"address_country" json:"address_country"`
 
+	// TaxIDs are the tax registration numbers of the customer ex VAT or GST numbers
+	TaxIDs []TaxID `db:"tax_ids" json:"tax_ids,omitempty"`
+
+	// TaxExempt excludes the customer from tax calculation on invoices
+	TaxExempt bool `db:"tax_exempt" json:"tax_exempt"`
+
+	// AutoApplyCredits pays the invoices of the customer from its wallets when they are finalized
+	AutoApplyCredits bool `db:"auto_apply_credits" json:"auto_apply_credits"`
+
 	// Metadata
 	Metadata map[string]string `db:"metadata" json:"metadata"`
 
@@ -47,6 +58,20 @@ type Customer struct {
 	types.BaseModel
 }
 
+// TaxID is a tax registration number of the customer
+type TaxID struct {
+	// Type is the kind of tax ID ex eu_vat, in_gst or us_ein
+	Type  string `json:"type" validate:"required,max=50"`
+	Value string `json:"value" validate:"required,max=100"`
+}
+
+// ToEntTaxIDs converts the tax IDs to the ent schema type
+func (c *Customer) ToEntTaxIDs() []schema.CustomerTaxID {
+	return lo.Map(c.TaxIDs, func(t TaxID, _ int) schema.CustomerTaxID {
+		return schema.CustomerTaxID(t)
+	})
+}
+
 // FromEnt converts an ent customer to a domain customer
 func FromEnt(c *ent.Customer) *Customer {
 	if c == nil {
@@ -63,6 +88,9 @@ func FromEnt(c *ent.Customer) *Customer {
 		AddressState:      c.AddressState,
 		AddressPostalCode: c.AddressPostalCode,
 		AddressCountry:    c.AddressCountry,
+		TaxIDs:            lo.Map(c.TaxIds, func(t schema.CustomerTaxID, _ int) TaxID { return TaxID(t) }),
+		TaxExempt:         c.TaxExempt,
+		AutoApplyCredits:  c.AutoApplyCredits,
 		Metadata:          c.Metadata,
 		EnvironmentID:     c.EnvironmentID,
 		BaseModel: types.BaseModel{
@@ -0,0 +1,64 @@
+package dunning
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// Attempt is a payment retry of an unpaid invoice made by the dunning process
+type Attempt struct {
+	ID             string                     `json:"id"`
+	InvoiceID      string                     `json:"invoice_id"`
+	SubscriptionID *string                    `json:"subscription_id,omitempty"`
+	AttemptNumber  int                        `json:"attempt_number"`
+	AttemptStatus  types.DunningAttemptStatus `json:"attempt_status"`
+
+	// AmountPaid is the amount of the invoice paid by the attempt
+	AmountPaid decimal.Decimal `json:"amount_paid"`
+
+	// AmountRemaining is the amount of the invoice still unpaid after the attempt
+	AmountRemaining decimal.Decimal `json:"amount_remaining"`
+
+	ErrorMessage  *string   `json:"error_message,omitempty"`
+	AttemptedAt   time.Time `json:"attempted_at"`
+	EnvironmentID string    `json:"environment_id"`
+	types.BaseModel
+}
+
+func FromEnt(e *ent.DunningAttempt) *Attempt {
+	if e == nil {
+		return nil
+	}
+
+	return &Attempt{
+		ID:              e.ID,
+		InvoiceID:       e.InvoiceID,
+		SubscriptionID:  e.SubscriptionID,
+		AttemptNumber:   e.AttemptNumber,
+		AttemptStatus:   types.DunningAttemptStatus(e.AttemptStatus),
+		AmountPaid:      e.AmountPaid,
+		AmountRemaining: e.AmountRemaining,
+		ErrorMessage:    e.ErrorMessage,
+		AttemptedAt:     e.AttemptedAt,
+		EnvironmentID:   e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+func FromEntList(attempts []*ent.DunningAttempt) []*Attempt {
+	result := make([]*Attempt, len(attempts))
+	for i, a := range attempts {
+		result[i] = FromEnt(a)
+	}
+	return result
+}
@@ -0,0 +1,13 @@
+package dunning
+
+import (
+	"context"
+)
+
+// Repository defines the interface for dunning attempt storage operations
+type Repository interface {
+	Create(ctx context.Context, attempt *Attempt) error
+
+	// ListByInvoice returns the attempts of the invoice ordered by attempt number
+	ListByInvoice(ctx context.Context, invoiceID string) ([]*Attempt, error)
+}
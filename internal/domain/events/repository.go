@@ -26,6 +26,9 @@ type UsageParams struct {
 	StartTime          time.Time             `json:"start_time" validate:"required"`
 	EndTime            time.Time             `json:"end_time" validate:"required"`
 	Filters            map[string][]string   `json:"filters"`
+	// FilterOperators holds the operator per filter key, applied to both Filters and
+	// the filter groups. Keys without an operator are matched with IN
+	FilterOperators map[string]types.FilterOperator `json:"filter_operators,omitempty"`
 }
 
 type GetEventsParams struct {
@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/schema"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
@@ -27,11 +29,23 @@ type InvoiceLineItem struct {
 	Currency         string          `json:"currency"`
 	PeriodStart      *time.Time      `json:"period_start,omitempty"`
 	PeriodEnd        *time.Time      `json:"period_end,omitempty"`
+	TierBreakdown    []LineItemTier  `json:"tier_breakdown,omitempty"`
 	Metadata         types.Metadata  `json:"metadata,omitempty"`
 	EnvironmentID    string          `json:"environment_id"`
 	types.BaseModel
 }
 
+// LineItemTier is the quantity and amount attributed to a single tier of a tiered price
+type LineItemTier struct {
+	// Tier is the 1 based position of the tier in the price tiers
+	Tier       int              `json:"tier"`
+	UpTo       *uint64          `json:"up_to"`
+	Quantity   decimal.Decimal  `json:"quantity"`
+	UnitAmount decimal.Decimal  `json:"unit_amount"`
+	FlatAmount *decimal.Decimal `json:"flat_amount,omitempty"`
+	Amount     decimal.Decimal  `json:"amount"`
+}
+
 // FromEnt converts an ent.InvoiceLineItem to domain InvoiceLineItem
 func (i *InvoiceLineItem) FromEnt(e *ent.InvoiceLineItem) *InvoiceLineItem {
 	if e == nil {
@@ -55,6 +69,7 @@ func (i *InvoiceLineItem) FromEnt(e *ent.InvoiceLineItem) *InvoiceLineItem {
 		Currency:         e.Currency,
 		PeriodStart:      e.PeriodStart,
 		PeriodEnd:        e.PeriodEnd,
+		TierBreakdown:    lo.Map(e.TierBreakdown, func(t schema.InvoiceLineItemTier, _ int) LineItemTier { return LineItemTier(t) }),
 		Metadata:         e.Metadata,
 		EnvironmentID:    e.EnvironmentID,
 		BaseModel: types.BaseModel{
@@ -86,3 +101,10 @@ func (i *InvoiceLineItem) Validate() error {
 
 	return nil
 }
+
+// ToEntTierBreakdown converts the tier breakdown to the ent schema type
+func (i *InvoiceLineItem) ToEntTierBreakdown() []schema.InvoiceLineItemTier {
+	return lo.Map(i.TierBreakdown, func(t LineItemTier, _ int) schema.InvoiceLineItemTier {
+		return schema.InvoiceLineItemTier(t)
+	})
+}
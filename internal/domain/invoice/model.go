@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/schema"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
@@ -18,9 +20,16 @@ type Invoice struct {
 	InvoiceStatus   types.InvoiceStatus `json:"invoice_status"`
 	PaymentStatus   types.PaymentStatus `json:"payment_status"`
 	Currency        string              `json:"currency"`
+	Subtotal        decimal.Decimal     `json:"subtotal"`
+	TotalDiscount   decimal.Decimal     `json:"total_discount"`
+	Total           decimal.Decimal     `json:"total"`
+	Discounts       []InvoiceDiscount   `json:"discounts,omitempty"`
+	TotalTax        decimal.Decimal     `json:"total_tax"`
+	Taxes           []InvoiceTax        `json:"taxes,omitempty"`
 	AmountDue       decimal.Decimal     `json:"amount_due"`
 	AmountPaid      decimal.Decimal     `json:"amount_paid"`
 	AmountRemaining decimal.Decimal     `json:"amount_remaining"`
+	AmountCredited  decimal.Decimal     `json:"amount_credited"`
 	InvoiceNumber   *string             `json:"invoice_number"`
 	IdempotencyKey  *string             `json:"idempotency_key"`
 	BillingSequence *int                `json:"billing_sequence"`
@@ -41,6 +50,24 @@ type Invoice struct {
 	types.BaseModel
 }
 
+// InvoiceDiscount is a discount line applied to the invoice subtotal
+type InvoiceDiscount struct {
+	CouponID            string          `json:"coupon_id"`
+	CouponApplicationID string          `json:"coupon_application_id"`
+	Description         string          `json:"description"`
+	Amount              decimal.Decimal `json:"amount"`
+}
+
+// InvoiceTax is a tax line computed from a tax rate of the customer's jurisdiction
+type InvoiceTax struct {
+	TaxRateID     string          `json:"tax_rate_id"`
+	Name          string          `json:"name"`
+	Percentage    decimal.Decimal `json:"percentage"`
+	TaxBehavior   string          `json:"tax_behavior"`
+	TaxableAmount decimal.Decimal `json:"taxable_amount"`
+	Amount        decimal.Decimal `json:"amount"`
+}
+
 // FromEnt converts an ent.Invoice to domain Invoice
 func FromEnt(e *ent.Invoice) *Invoice {
 	if e == nil {
@@ -64,9 +91,16 @@ func FromEnt(e *ent.Invoice) *Invoice {
 		InvoiceStatus:   types.InvoiceStatus(e.InvoiceStatus),
 		PaymentStatus:   types.PaymentStatus(e.PaymentStatus),
 		Currency:        e.Currency,
+		Subtotal:        e.Subtotal,
+		TotalDiscount:   e.TotalDiscount,
+		Total:           e.Total,
+		Discounts:       lo.Map(e.Discounts, func(d schema.InvoiceDiscount, _ int) InvoiceDiscount { return InvoiceDiscount(d) }),
+		TotalTax:        e.TotalTax,
+		Taxes:           lo.Map(e.Taxes, func(t schema.InvoiceTax, _ int) InvoiceTax { return InvoiceTax(t) }),
 		AmountDue:       e.AmountDue,
 		AmountPaid:      e.AmountPaid,
 		AmountRemaining: e.AmountRemaining,
+		AmountCredited:  e.AmountCredited,
 		InvoiceNumber:   e.InvoiceNumber,
 		IdempotencyKey:  e.IdempotencyKey,
 		BillingSequence: e.BillingSequence,
@@ -95,12 +129,38 @@ func FromEnt(e *ent.Invoice) *Invoice {
 	}
 }
 
+// ToEntDiscounts converts the discounts to the ent schema type
+func (i *Invoice) ToEntDiscounts() []schema.InvoiceDiscount {
+	return lo.Map(i.Discounts, func(d InvoiceDiscount, _ int) schema.InvoiceDiscount {
+		return schema.InvoiceDiscount(d)
+	})
+}
+
+// ToEntTaxes converts the tax lines to the ent schema type
+func (i *Invoice) ToEntTaxes() []schema.InvoiceTax {
+	return lo.Map(i.Taxes, func(t InvoiceTax, _ int) schema.InvoiceTax {
+		return schema.InvoiceTax(t)
+	})
+}
+
 // Default helper methods
 
 func (i *Invoice) GetRemainingAmount() decimal.Decimal {
 	return i.AmountDue.Sub(i.AmountPaid)
 }
 
+// GetExclusiveTax returns the sum of the tax lines levied on top of the discounted subtotal.
+// Inclusive taxes are already part of the line item amounts.
+func (i *Invoice) GetExclusiveTax() decimal.Decimal {
+	total := decimal.Zero
+	for _, t := range i.Taxes {
+		if t.TaxBehavior == string(types.TaxBehaviorExclusive) {
+			total = total.Add(t.Amount)
+		}
+	}
+	return total
+}
+
 func (i *Invoice) Validate() error {
 	// amount validations
 	if i.AmountDue.IsNegative() {
@@ -127,6 +187,14 @@ func (i *Invoice) Validate() error {
 		return ierr.NewError("invoice validation failed").WithHint("amount_remaining must equal amount_due - amount_paid").Mark(ierr.ErrValidation)
 	}
 
+	if i.TotalDiscount.IsNegative() {
+		return ierr.NewError("invoice validation failed").WithHint("total_discount must be non negative").Mark(ierr.ErrValidation)
+	}
+
+	if i.TotalDiscount.GreaterThan(i.Subtotal) {
+		return ierr.NewError("invoice validation failed").WithHint("total_discount must be less than or equal to subtotal").Mark(ierr.ErrValidation)
+	}
+
 	if i.PeriodStart != nil && i.PeriodEnd != nil {
 		if i.PeriodEnd.Before(*i.PeriodStart) {
 			return ierr.NewError("invoice validation failed").WithHint("period_end must be after period_start").Mark(ierr.ErrValidation)
@@ -138,7 +206,8 @@ func (i *Invoice) Validate() error {
 	}
 
 	// validate line items if present
-	if i.LineItems != nil {
+	if len(i.LineItems) > 0 {
+		lineItemTotal := decimal.Zero
 		for _, item := range i.LineItems {
 			if item.Currency != i.Currency {
 				return ierr.NewError("invoice validation failed").WithHint("line_items currency must match invoice currency").Mark(ierr.ErrValidation)
@@ -146,6 +215,15 @@ func (i *Invoice) Validate() error {
 			if err := item.Validate(); err != nil {
 				return err
 			}
+			lineItemTotal = lineItemTotal.Add(item.Amount)
+		}
+
+		// line items less discounts plus exclusive taxes must reconcile with the amount due
+		expected := lineItemTotal.Sub(i.TotalDiscount).Add(i.GetExclusiveTax())
+		if !expected.Equal(i.AmountDue) {
+			return ierr.NewError("invoice validation failed").
+				WithHintf("line items less discounts plus exclusive taxes %s must equal amount_due %s", expected.String(), i.AmountDue.String()).
+				Mark(ierr.ErrValidation)
 		}
 	}
 
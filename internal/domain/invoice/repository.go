@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // Repository defines the interface for invoice persistence operations
@@ -16,6 +17,9 @@ type Repository interface {
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, filter *types.InvoiceFilter) ([]*Invoice, error)
 	Count(ctx context.Context, filter *types.InvoiceFilter) (int, error)
+	// IncrementAmountCredited atomically adds the amount to the credited amount of the invoice
+	// unless it would exceed the limit, in which case it returns false
+	IncrementAmountCredited(ctx context.Context, id string, amount, limit decimal.Decimal) (bool, error)
 
 	// Edge-specific operations
 	AddLineItems(ctx context.Context, invoiceID string, items []*InvoiceLineItem) error
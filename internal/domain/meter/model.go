@@ -43,9 +43,13 @@ type Meter struct {
 
 type Filter struct {
 	// Key is the key for the filter from $event.properties
-	// Currently we support only first level keys in the properties and not nested keys
+	// Nested keys are addressed with a dot separated path ex "request.model.name"
 	Key string `json:"key"`
 
+	// Operator defines how the values are matched against the property, defaults to IN
+	// The same operator is used when matching the filter_values of the prices on this meter
+	Operator types.FilterOperator `json:"operator,omitempty"`
+
 	// Values are the possible values for the filter to be considered for the meter
 	// For ex "model_name" could have values "o1-mini", "gpt-4o" etc
 	Values []string `json:"values"`
@@ -69,8 +73,9 @@ func FromEnt(e *ent.Meter) *Meter {
 	filters := make([]Filter, len(e.Filters))
 	for i, f := range e.Filters {
 		filters[i] = Filter{
-			Key:    f.Key,
-			Values: f.Values,
+			Key:      f.Key,
+			Operator: f.Operator,
+			Values:   f.Values,
 		}
 	}
 
@@ -116,8 +121,9 @@ func (m *Meter) ToEntFilters() []schema.MeterFilter {
 	filters := make([]schema.MeterFilter, len(m.Filters))
 	for i, f := range m.Filters {
 		filters[i] = schema.MeterFilter{
-			Key:    f.Key,
-			Values: f.Values,
+			Key:      f.Key,
+			Operator: f.Operator,
+			Values:   f.Values,
 		}
 	}
 	return filters
@@ -165,12 +171,40 @@ func (m *Meter) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
-	for _, filter := range m.Filters {
+	return ValidateFilters(m.Filters)
+}
+
+// ValidateFilters validates the keys, operators and values of the meter filters
+func ValidateFilters(filters []Filter) error {
+	seenKeys := make(map[string]bool, len(filters))
+	for _, filter := range filters {
 		if filter.Key == "" {
 			return ierr.NewError("filter key cannot be empty").
 				WithHint("Please provide a key for each filter").
 				Mark(ierr.ErrValidation)
 		}
+		if seenKeys[filter.Key] {
+			return ierr.NewError("duplicate filter key").
+				WithHint("Each filter key can only be used once, use the BETWEEN operator for ranges").
+				WithReportableDetails(map[string]interface{}{
+					"filter_key": filter.Key,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		seenKeys[filter.Key] = true
+		for _, segment := range types.SplitPropertyPath(filter.Key) {
+			if segment == "" {
+				return ierr.NewError("invalid filter key").
+					WithHint("Nested filter keys must not contain empty path segments").
+					WithReportableDetails(map[string]interface{}{
+						"filter_key": filter.Key,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+		}
+		if err := filter.Operator.Validate(); err != nil {
+			return err
+		}
 		if len(filter.Values) == 0 {
 			return ierr.NewError("filter values cannot be empty").
 				WithHint("Please provide at least one value for each filter").
@@ -179,10 +213,22 @@ func (m *Meter) Validate() error {
 				}).
 				Mark(ierr.ErrValidation)
 		}
+		if err := filter.Operator.ValidateValues(filter.Values); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// GetFilterOperators returns the operator per filter key, defaulting to IN
+func (m *Meter) GetFilterOperators() map[string]types.FilterOperator {
+	operators := make(map[string]types.FilterOperator, len(m.Filters))
+	for _, filter := range m.Filters {
+		operators[filter.Key] = filter.Operator.OrDefault()
+	}
+	return operators
+}
+
 // Constructor for creating new meters with defaults
 func NewMeter(name string, tenantID, createdBy string) *Meter {
 	now := time.Now().UTC()
@@ -0,0 +1,65 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Event is a message written in the transaction of the state change it reports, and relayed to
+// the pubsub once the transaction is committed
+type Event struct {
+	ID        string            `json:"id"`
+	Topic     string            `json:"topic"`
+	MessageID string            `json:"message_id"`
+	Payload   string            `json:"payload"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+
+	DeliveryStatus types.OutboxEventStatus `json:"delivery_status"`
+	Attempts       int                     `json:"attempts"`
+	LastError      *string                 `json:"last_error,omitempty"`
+
+	// NextAttemptAt is pushed back while a relay publishes the event and after a failed attempt
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+
+	EnvironmentID string `json:"environment_id"`
+	types.BaseModel
+}
+
+func FromEnt(e *ent.OutboxEvent) *Event {
+	if e == nil {
+		return nil
+	}
+
+	return &Event{
+		ID:             e.ID,
+		Topic:          e.Topic,
+		MessageID:      e.MessageID,
+		Payload:        e.Payload,
+		Metadata:       e.Metadata,
+		DeliveryStatus: types.OutboxEventStatus(e.DeliveryStatus),
+		Attempts:       e.Attempts,
+		LastError:      e.LastError,
+		NextAttemptAt:  e.NextAttemptAt,
+		DeliveredAt:    e.DeliveredAt,
+		EnvironmentID:  e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+func FromEntList(events []*ent.OutboxEvent) []*Event {
+	result := make([]*Event, len(events))
+	for i, e := range events {
+		result[i] = FromEnt(e)
+	}
+	return result
+}
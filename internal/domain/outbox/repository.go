@@ -0,0 +1,20 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for outbox storage operations. The relay operations work
+// across tenants and environments.
+type Repository interface {
+	Create(ctx context.Context, e *Event) error
+	// ListDue lists the pending events due for an attempt at now, oldest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*Event, error)
+	// Claim pushes back the next attempt of a pending event to until, it returns false if another
+	// relay claimed the event since it was listed
+	Claim(ctx context.Context, e *Event, until time.Time) (bool, error)
+	MarkDelivered(ctx context.Context, id string, deliveredAt time.Time) error
+	// MarkFailed records a failed attempt and schedules the next one at nextAttemptAt
+	MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error
+}
@@ -15,6 +15,7 @@ type InvoiceData struct {
 	IssuingDate   CustomTime `json:"issuing_date"`
 	DueDate       CustomTime `json:"due_date"`
 	AmountDue     float64    `json:"amount_due"`
+	Subtotal      float64    `json:"subtotal"`
 	VAT           float64    `json:"vat"` // VAT percentage as decimal (0.18 = 18%)
 	Notes         string     `json:"notes"`
 	BillingReason string     `json:"billing_reason"`
@@ -25,6 +26,28 @@ type InvoiceData struct {
 
 	// Line items
 	LineItems []LineItemData `json:"line_items"`
+
+	// Discounts applied to the subtotal
+	Discounts []DiscountData `json:"discounts,omitempty"`
+
+	// Taxes levied on the discounted subtotal
+	TotalTax float64   `json:"total_tax"`
+	Taxes    []TaxData `json:"taxes,omitempty"`
+}
+
+// DiscountData represents a coupon discount applied to the invoice subtotal
+type DiscountData struct {
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+}
+
+// TaxData represents a tax line of the invoice
+type TaxData struct {
+	Name       string  `json:"name"`
+	Percentage float64 `json:"percentage"`
+	Amount     float64 `json:"amount"`
+	// Inclusive taxes are already part of the subtotal and only shown for reference
+	Inclusive bool `json:"inclusive"`
 }
 
 // BillerInfo contains company information for the invoice issuer
@@ -63,6 +86,44 @@ type LineItemData struct {
 	Amount          float64    `json:"amount"`
 	Quantity        float64    `json:"quantity"`
 	Currency        string     `json:"currency"`
+	// Tiers is the per tier breakdown of tiered prices
+	Tiers []LineItemTierData `json:"tiers,omitempty"`
+}
+
+// LineItemTierData represents the quantity and amount of a single price tier in a line item
+type LineItemTierData struct {
+	Tier       int      `json:"tier"`
+	UpTo       *uint64  `json:"up_to,omitempty"`
+	Quantity   float64  `json:"quantity"`
+	UnitAmount float64  `json:"unit_amount"`
+	FlatAmount *float64 `json:"flat_amount,omitempty"`
+	Amount     float64  `json:"amount"`
+}
+
+// CreditNoteData represents the data model for credit note PDF generation
+type CreditNoteData struct {
+	ID               string     `json:"id"`
+	CreditNoteNumber string     `json:"credit_note_number"`
+	InvoiceNumber    string     `json:"invoice_number"`
+	IssuingDate      CustomTime `json:"issuing_date"`
+	Currency         string     `json:"currency"`
+	TotalAmount      float64    `json:"total_amount"`
+	Reason           string     `json:"reason"`
+	Outcome          string     `json:"outcome"`
+	Memo             string     `json:"memo"`
+
+	// Company information
+	Biller    *BillerInfo    `json:"biller"`
+	Recipient *RecipientInfo `json:"recipient"`
+
+	// Line items
+	LineItems []CreditNoteLineItemData `json:"line_items"`
+}
+
+// CreditNoteLineItemData represents the amount credited for a line item
+type CreditNoteLineItemData struct {
+	DisplayName string  `json:"display_name"`
+	Amount      float64 `json:"amount"`
 }
 
 type CustomTime struct {
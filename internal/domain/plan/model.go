@@ -3,6 +3,7 @@ package plan
 import (
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 type Plan struct {
@@ -10,7 +11,14 @@ type Plan struct {
 	Name          string `db:"name" json:"name"`
 	LookupKey     string `db:"lookup_key" json:"lookup_key"`
 	Description   string `db:"description" json:"description"`
+	Version       int    `db:"version" json:"version"`
 	EnvironmentID string `db:"environment_id" json:"environment_id"`
+
+	// CommitmentAmount is the default minimum usage spend per commitment interval of the
+	// subscriptions on the plan
+	CommitmentAmount   *decimal.Decimal         `db:"commitment_amount" json:"commitment_amount,omitempty" swaggertype:"string"`
+	CommitmentInterval types.CommitmentInterval `db:"commitment_interval" json:"commitment_interval,omitempty"`
+
 	types.BaseModel
 }
 
@@ -24,7 +32,12 @@ func FromEnt(e *ent.Plan) *Plan {
 		Name:          e.Name,
 		LookupKey:     e.LookupKey,
 		Description:   e.Description,
+		Version:       e.Version,
 		EnvironmentID: e.EnvironmentID,
+
+		CommitmentAmount:   e.CommitmentAmount,
+		CommitmentInterval: types.CommitmentInterval(e.CommitmentInterval),
+
 		BaseModel: types.BaseModel{
 			TenantID:  e.TenantID,
 			Status:    types.Status(e.Status),
@@ -46,6 +46,16 @@ type Price struct {
 	// PlanID is the id of the plan for plan based pricing
 	PlanID string `db:"plan_id" json:"plan_id"`
 
+	// PlanVersion is the version of the plan that introduced the price
+	PlanVersion int `db:"plan_version" json:"plan_version"`
+
+	// SubscriptionID is set for the prices negotiated for a single subscription, they override
+	// the plan price they are created from for that subscription only
+	SubscriptionID *string `db:"subscription_id" json:"subscription_id,omitempty"`
+
+	// ParentPriceID is the plan price overridden by the subscription price
+	ParentPriceID *string `db:"parent_price_id" json:"parent_price_id,omitempty"`
+
 	Type types.PriceType `db:"type" json:"type"`
 
 	BillingPeriod types.BillingPeriod `db:"billing_period" json:"billing_period"`
@@ -80,6 +90,10 @@ type Price struct {
 
 	TransformQuantity JSONBTransformQuantity `db:"transform_quantity,jsonb" json:"transform_quantity"`
 
+	// MinimumCharge is the minimum amount billed for this price in a billing period
+	// When the calculated charge is lower, the line item is billed at the minimum instead
+	MinimumCharge *decimal.Decimal `db:"minimum_charge" json:"minimum_charge,omitempty"`
+
 	Metadata JSONBMetadata `db:"metadata,jsonb" json:"metadata"`
 
 	// EnvironmentID is the environment identifier for the price
@@ -143,6 +157,38 @@ func (p *Price) CalculateAmount(quantity decimal.Decimal) decimal.Decimal {
 	return result
 }
 
+// CalculatePackageQuantity returns the number of packages to bill for the quantity
+// after deducting the included quantity, rounding and applying the minimum package count
+func (p *Price) CalculatePackageQuantity(quantity decimal.Decimal) decimal.Decimal {
+	if p.TransformQuantity.DivideBy <= 0 || quantity.IsZero() {
+		return decimal.Zero
+	}
+
+	// usage within the included quantity is free, the minimum packages only apply above it
+	billableQuantity := quantity.Sub(decimal.NewFromInt(p.TransformQuantity.IncludedQuantity))
+	if !billableQuantity.IsPositive() {
+		return decimal.Zero
+	}
+
+	packages := billableQuantity.Div(decimal.NewFromInt(int64(p.TransformQuantity.DivideBy)))
+	if p.TransformQuantity.Round == types.ROUND_UP {
+		packages = packages.Ceil()
+	} else if p.TransformQuantity.Round == types.ROUND_DOWN {
+		packages = packages.Floor()
+	}
+
+	return decimal.Max(packages, decimal.NewFromInt(p.TransformQuantity.MinPackages))
+}
+
+// ApplyMinimumCharge raises the amount to the minimum charge of the price if configured
+// and returns whether the minimum was applied
+func (p *Price) ApplyMinimumCharge(amount decimal.Decimal) (decimal.Decimal, bool) {
+	if p.MinimumCharge == nil || !amount.LessThan(*p.MinimumCharge) {
+		return amount, false
+	}
+	return *p.MinimumCharge, true
+}
+
 // CalculateTierAmount performs calculation for tier price with flat and fixed ampunt
 func (pt *PriceTier) CalculateTierAmount(quantity decimal.Decimal, currency string) decimal.Decimal {
 	// Calculate tier cost with proper rounding
@@ -153,6 +199,18 @@ func (pt *PriceTier) CalculateTierAmount(quantity decimal.Decimal, currency stri
 	return tierCost
 }
 
+// TierBreakdown is the quantity and amount attributed to a single tier
+// when calculating the cost of a tiered price
+type TierBreakdown struct {
+	// TierIndex is the 1 based position of the tier in the sorted price tiers
+	TierIndex  int
+	UpTo       *uint64
+	Quantity   decimal.Decimal
+	UnitAmount decimal.Decimal
+	FlatAmount *decimal.Decimal
+	Amount     decimal.Decimal
+}
+
 // GetDisplayAmount returns the amount in the currency ex $12.00
 func GetDisplayAmountWithPrecision(amount decimal.Decimal, currency string) string {
 	val := FormatAmountToStringWithPrecision(amount, currency)
@@ -178,6 +236,11 @@ func FormatAmountToFloat64WithPrecision(amount decimal.Decimal, currency string)
 type TransformQuantity struct {
 	DivideBy int    `json:"divide_by,omitempty"` // Divide quantity by this number
 	Round    string `json:"round,omitempty"`     // up or down
+	// IncludedQuantity is the number of units free of charge before packages are billed
+	// ex "first 10k emails free, then $1 per 1k" is included_quantity 10000 and divide_by 1000
+	IncludedQuantity int64 `json:"included_quantity,omitempty"`
+	// MinPackages is the minimum number of packages billed once the usage exceeds the included quantity
+	MinPackages int64 `json:"min_packages,omitempty"`
 }
 
 type PriceTier struct {
@@ -312,6 +375,9 @@ func FromEnt(e *ent.Price) *Price {
 		Currency:           e.Currency,
 		DisplayAmount:      e.DisplayAmount,
 		PlanID:             e.PlanID,
+		PlanVersion:        e.PlanVersion,
+		SubscriptionID:     e.SubscriptionID,
+		ParentPriceID:      e.ParentPriceID,
 		Type:               types.PriceType(e.Type),
 		BillingPeriod:      types.BillingPeriod(e.BillingPeriod),
 		BillingPeriodCount: e.BillingPeriodCount,
@@ -326,6 +392,7 @@ func FromEnt(e *ent.Price) *Price {
 		Description:        e.Description,
 		FilterValues:       JSONBFilters(e.FilterValues),
 		TransformQuantity:  JSONBTransformQuantity(e.TransformQuantity),
+		MinimumCharge:      e.MinimumCharge,
 		Metadata:           JSONBMetadata(e.Metadata),
 		EnvironmentID:      e.EnvironmentID,
 		BaseModel: types.BaseModel{
@@ -396,12 +463,29 @@ func (p *Price) ValidateInvoiceCadence() error {
 	return p.InvoiceCadence.Validate()
 }
 
+// ValidateMinimumCharge checks if the minimum charge is valid
+func (p *Price) ValidateMinimumCharge() error {
+	if p.MinimumCharge != nil && p.MinimumCharge.LessThan(decimal.Zero) {
+		return ierr.NewError("minimum charge must be non-negative").
+			WithHint("Please provide a non-negative minimum charge").
+			WithReportableDetails(map[string]interface{}{
+				"minimum_charge": p.MinimumCharge.String(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
 // Validate performs all validations on the price
 func (p *Price) Validate() error {
 	if err := p.ValidateAmount(); err != nil {
 		return err
 	}
 
+	if err := p.ValidateMinimumCharge(); err != nil {
+		return err
+	}
+
 	if err := p.ValidateTrialPeriod(); err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 type Subscription struct {
@@ -20,6 +21,13 @@ type Subscription struct {
 	// PlanID is the identifier for the plan in our system
 	PlanID string `db:"plan_id" json:"plan_id"`
 
+	// PlanVersion is the version of the plan the subscription is billed with
+	PlanVersion int `db:"plan_version" json:"plan_version"`
+
+	// PendingPlanVersion is the plan version the subscription migrates to at the end of the
+	// current period
+	PendingPlanVersion *int `db:"pending_plan_version" json:"pending_plan_version,omitempty"`
+
 	SubscriptionStatus types.SubscriptionStatus `db:"subscription_status" json:"subscription_status"`
 
 	// Currency is the currency of the subscription in lowercase 3 digit ISO codes
@@ -59,6 +67,27 @@ type Subscription struct {
 	// TrialEnd is the end date of the trial period
 	TrialEnd *time.Time `db:"trial_end" json:"trial_end"`
 
+	// TrialRequiresPaymentMethod cancels the subscription at the end of the trial instead of
+	// converting it to active when no payment method is on file
+	TrialRequiresPaymentMethod bool `db:"trial_requires_payment_method" json:"trial_requires_payment_method"`
+
+	// TrialWillEndNotifiedAt is the time the trial will end webhook was sent
+	TrialWillEndNotifiedAt *time.Time `db:"trial_will_end_notified_at" json:"trial_will_end_notified_at,omitempty"`
+
+	// PaymentMethodID is the payment method on file for the subscription
+	PaymentMethodID *string `db:"payment_method_id" json:"payment_method_id,omitempty"`
+
+	// CommitmentAmount is the minimum usage spend of the subscription per commitment interval,
+	// the shortfall is invoiced as a true-up charge
+	CommitmentAmount *decimal.Decimal `db:"commitment_amount" json:"commitment_amount,omitempty" swaggertype:"string"`
+
+	// CommitmentInterval is the window the usage charges are reconciled against the commitment over
+	CommitmentInterval types.CommitmentInterval `db:"commitment_interval" json:"commitment_interval,omitempty"`
+
+	// AutoApplyCredits overrides the setting of the customer for the invoices of the subscription,
+	// the setting of the customer applies when not set
+	AutoApplyCredits *bool `db:"auto_apply_credits" json:"auto_apply_credits,omitempty"`
+
 	BillingCadence types.BillingCadence `db:"billing_cadence" json:"billing_cadence"`
 
 	BillingPeriod types.BillingPeriod `db:"billing_period" json:"billing_period"`
@@ -87,6 +116,11 @@ type Subscription struct {
 	types.BaseModel
 }
 
+// IsTrialPeriod returns true if the billing period falls within the trial of the subscription
+func (s *Subscription) IsTrialPeriod(periodStart, periodEnd time.Time) bool {
+	return s.TrialEnd != nil && !periodEnd.After(*s.TrialEnd)
+}
+
 func GetSubscriptionFromEnt(sub *ent.Subscription) *Subscription {
 	var lineItems []*SubscriptionLineItem
 	if sub.Edges.LineItems != nil {
@@ -106,6 +140,8 @@ func GetSubscriptionFromEnt(sub *ent.Subscription) *Subscription {
 		LookupKey:          sub.LookupKey,
 		CustomerID:         sub.CustomerID,
 		PlanID:             sub.PlanID,
+		PlanVersion:        sub.PlanVersion,
+		PendingPlanVersion: sub.PendingPlanVersion,
 		SubscriptionStatus: types.SubscriptionStatus(sub.SubscriptionStatus),
 		Currency:           sub.Currency,
 		BillingAnchor:      sub.BillingAnchor,
@@ -128,6 +164,14 @@ func GetSubscriptionFromEnt(sub *ent.Subscription) *Subscription {
 		ActivePauseID:      sub.ActivePauseID,
 		LineItems:          lineItems,
 		Pauses:             pauses,
+
+		TrialRequiresPaymentMethod: sub.TrialRequiresPaymentMethod,
+		TrialWillEndNotifiedAt:     sub.TrialWillEndNotifiedAt,
+		PaymentMethodID:            sub.PaymentMethodID,
+		CommitmentAmount:           sub.CommitmentAmount,
+		CommitmentInterval:         types.CommitmentInterval(sub.CommitmentInterval),
+		AutoApplyCredits:           sub.AutoApplyCredits,
+
 		BaseModel: types.BaseModel{
 			TenantID:  sub.TenantID,
 			Status:    types.Status(sub.Status),
@@ -0,0 +1,89 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionQuantityChange records a change of the quantity of a subscription line item
+type SubscriptionQuantityChange struct {
+	// ID is the unique identifier for the quantity change
+	ID string `db:"id" json:"id"`
+
+	// SubscriptionID is the identifier for the subscription
+	SubscriptionID string `db:"subscription_id" json:"subscription_id"`
+
+	// SubscriptionLineItemID is the identifier for the line item whose quantity changed
+	SubscriptionLineItemID string `db:"subscription_line_item_id" json:"subscription_line_item_id"`
+
+	// PriceID is the identifier for the price of the line item
+	PriceID string `db:"price_id" json:"price_id"`
+
+	// PreviousQuantity is the quantity of the line item before the change
+	PreviousQuantity decimal.Decimal `db:"previous_quantity" json:"previous_quantity" swaggertype:"string"`
+
+	// Quantity is the quantity of the line item from the effective date
+	Quantity decimal.Decimal `db:"quantity" json:"quantity" swaggertype:"string"`
+
+	// EffectiveDate is when the new quantity took effect, prorations are computed from it
+	EffectiveDate time.Time `db:"effective_date" json:"effective_date"`
+
+	// CreditNoteID is the credit note issued for the unused time of a decrease of an advance charge
+	CreditNoteID *string `db:"credit_note_id" json:"credit_note_id,omitempty"`
+
+	Metadata types.Metadata `db:"metadata" json:"metadata,omitempty"`
+
+	// EnvironmentID is the environment identifier for the quantity change
+	EnvironmentID string `db:"environment_id" json:"environment_id"`
+
+	types.BaseModel
+}
+
+// IsIncrease returns true if the change increased the quantity of the line item
+func (c *SubscriptionQuantityChange) IsIncrease() bool {
+	return c.Quantity.GreaterThan(c.PreviousQuantity)
+}
+
+// SubscriptionQuantityChangeFromEnt converts an ent.SubscriptionQuantityChange to a domain SubscriptionQuantityChange
+func SubscriptionQuantityChangeFromEnt(c *ent.SubscriptionQuantityChange) *SubscriptionQuantityChange {
+	if c == nil {
+		return nil
+	}
+
+	return &SubscriptionQuantityChange{
+		ID:                     c.ID,
+		SubscriptionID:         c.SubscriptionID,
+		SubscriptionLineItemID: c.SubscriptionLineItemID,
+		PriceID:                c.PriceID,
+		PreviousQuantity:       c.PreviousQuantity,
+		Quantity:               c.Quantity,
+		EffectiveDate:          c.EffectiveDate,
+		CreditNoteID:           c.CreditNoteID,
+		Metadata:               c.Metadata,
+		EnvironmentID:          c.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  c.TenantID,
+			Status:    types.Status(c.Status),
+			CreatedAt: c.CreatedAt,
+			CreatedBy: c.CreatedBy,
+			UpdatedAt: c.UpdatedAt,
+			UpdatedBy: c.UpdatedBy,
+		},
+	}
+}
+
+// SubscriptionQuantityChangeListFromEnt converts a list of ent.SubscriptionQuantityChange to a list of domain SubscriptionQuantityChange
+func SubscriptionQuantityChangeListFromEnt(changes []*ent.SubscriptionQuantityChange) []*SubscriptionQuantityChange {
+	if changes == nil {
+		return nil
+	}
+
+	result := make([]*SubscriptionQuantityChange, len(changes))
+	for i, c := range changes {
+		result[i] = SubscriptionQuantityChangeFromEnt(c)
+	}
+	return result
+}
@@ -2,6 +2,7 @@ package subscription
 
 import (
 	"context"
+	"time"
 
 	"github.com/flexprice/flexprice/internal/types"
 )
@@ -19,6 +20,10 @@ type Repository interface {
 	ListByIDs(ctx context.Context, subscriptionIDs []string) ([]*Subscription, error)
 	CreateWithLineItems(ctx context.Context, subscription *Subscription, items []*SubscriptionLineItem) error
 	GetWithLineItems(ctx context.Context, id string) (*Subscription, []*SubscriptionLineItem, error)
+	// ReplaceLineItems updates the subscription, archives the ended line items and creates the new ones
+	ReplaceLineItems(ctx context.Context, subscription *Subscription, endedItems, newItems []*SubscriptionLineItem) error
+	// MarkTrialWillEndNotified sets the trial will end notified time unless already set and reports whether it did
+	MarkTrialWillEndNotified(ctx context.Context, id string, notifiedAt time.Time) (bool, error)
 
 	// Pause-related methods
 	CreatePause(ctx context.Context, pause *SubscriptionPause) error
@@ -26,4 +31,11 @@ type Repository interface {
 	UpdatePause(ctx context.Context, pause *SubscriptionPause) error
 	ListPauses(ctx context.Context, subscriptionID string) ([]*SubscriptionPause, error)
 	GetWithPauses(ctx context.Context, id string) (*Subscription, []*SubscriptionPause, error)
+
+	// Quantity change methods
+	// UpdateLineItemQuantity sets the quantity of the line item and records the change
+	UpdateLineItemQuantity(ctx context.Context, item *SubscriptionLineItem, change *SubscriptionQuantityChange) error
+	UpdateQuantityChange(ctx context.Context, change *SubscriptionQuantityChange) error
+	// ListQuantityChanges lists the quantity changes of a subscription ordered by effective date
+	ListQuantityChanges(ctx context.Context, subscriptionID string) ([]*SubscriptionQuantityChange, error)
 }
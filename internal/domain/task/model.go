@@ -1,12 +1,19 @@
 package task
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/flexprice/flexprice/ent"
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 )
 
+const (
+	// MetadataKeyExportFilter is the metadata key the filters of an export task are stored under
+	MetadataKeyExportFilter = "filters"
+)
+
 type Task struct {
 	ID                string                 `json:"id"`
 	TaskType          types.TaskType         `json:"task_type"`
@@ -79,3 +86,28 @@ func FromEntList(list []*ent.Task) []*Task {
 func (t *Task) Validate() error {
 	return nil
 }
+
+// GetExportFilter returns the filters of an export task, or an empty filter if none were set
+func (t *Task) GetExportFilter() (*types.TaskExportFilter, error) {
+	filter := &types.TaskExportFilter{}
+	raw, ok := t.Metadata[MetadataKeyExportFilter]
+	if !ok || raw == nil {
+		return filter, nil
+	}
+
+	// The filters are decoded from JSON when the task is read back from the database
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Invalid export filters").
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := json.Unmarshal(data, filter); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Invalid export filters").
+			Mark(ierr.ErrValidation)
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,118 @@
+package tax
+
+import (
+	"strings"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// TaxRate is a tax percentage levied in a jurisdiction identified by country and optionally state
+type TaxRate struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// Percentage is the tax rate ex 18 for 18%
+	Percentage decimal.Decimal `json:"percentage"`
+
+	TaxBehavior types.TaxBehavior `json:"tax_behavior"`
+
+	// Country is the ISO 3166-1 alpha-2 code of the jurisdiction
+	Country string `json:"country"`
+
+	// State limits the tax rate to a state of the country, when empty it applies to the whole country
+	State string `json:"state,omitempty"`
+
+	Metadata      types.Metadata `json:"metadata,omitempty"`
+	EnvironmentID string         `json:"environment_id"`
+	types.BaseModel
+}
+
+// AppliesTo checks whether the tax rate applies to an address in the given country and state
+func (t *TaxRate) AppliesTo(country, state string) bool {
+	if !strings.EqualFold(t.Country, country) {
+		return false
+	}
+
+	return t.State == "" || strings.EqualFold(t.State, state)
+}
+
+// TaxLine is the tax computed for a single tax rate
+type TaxLine struct {
+	TaxRate       *TaxRate
+	TaxableAmount decimal.Decimal
+	Amount        decimal.Decimal
+}
+
+// CalculateTaxes computes the tax lines of the given rates for an amount in the given currency.
+// Inclusive rates are extracted from the amount together so the taxable amount is the same net
+// amount for every rate, and exclusive rates are levied on that net amount.
+func CalculateTaxes(rates []*TaxRate, amount decimal.Decimal, currency string) []TaxLine {
+	if len(rates) == 0 || !amount.IsPositive() {
+		return nil
+	}
+
+	hundred := decimal.NewFromInt(100)
+	precision := types.GetCurrencyPrecision(currency)
+
+	inclusivePercentage := decimal.Zero
+	for _, r := range rates {
+		if r.TaxBehavior == types.TaxBehaviorInclusive {
+			inclusivePercentage = inclusivePercentage.Add(r.Percentage)
+		}
+	}
+
+	net := amount
+	if inclusivePercentage.IsPositive() {
+		net = amount.Div(decimal.NewFromInt(1).Add(inclusivePercentage.Div(hundred)))
+	}
+
+	lines := make([]TaxLine, 0, len(rates))
+	for _, r := range rates {
+		lines = append(lines, TaxLine{
+			TaxRate:       r,
+			TaxableAmount: net.Round(precision),
+			Amount:        net.Mul(r.Percentage).Div(hundred).Round(precision),
+		})
+	}
+
+	return lines
+}
+
+// FromEnt converts ent.TaxRate to domain TaxRate
+func FromEnt(e *ent.TaxRate) *TaxRate {
+	if e == nil {
+		return nil
+	}
+
+	return &TaxRate{
+		ID:            e.ID,
+		Name:          e.Name,
+		Description:   e.Description,
+		Percentage:    e.Percentage,
+		TaxBehavior:   types.TaxBehavior(e.TaxBehavior),
+		Country:       e.Country,
+		State:         e.State,
+		Metadata:      types.Metadata(e.Metadata),
+		EnvironmentID: e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+// FromEntList converts []*ent.TaxRate to []*TaxRate
+func FromEntList(rates []*ent.TaxRate) []*TaxRate {
+	result := make([]*TaxRate, len(rates))
+	for i, r := range rates {
+		result[i] = FromEnt(r)
+	}
+	return result
+}
@@ -0,0 +1,17 @@
+package tax
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for tax rate storage operations
+type Repository interface {
+	Create(ctx context.Context, rate *TaxRate) error
+	Get(ctx context.Context, id string) (*TaxRate, error)
+	List(ctx context.Context, filter *types.TaxRateFilter) ([]*TaxRate, error)
+	Count(ctx context.Context, filter *types.TaxRateFilter) (int, error)
+	Update(ctx context.Context, rate *TaxRate) error
+	Delete(ctx context.Context, id string) error
+}
@@ -25,12 +25,25 @@ type TenantAddress struct {
 	Country    string `json:"address_country,omitempty"`
 }
 
+// TenantDunningPolicy defines how unpaid invoices of the tenant are retried and what happens to
+// their subscriptions once all retries failed
+type TenantDunningPolicy struct {
+	Enabled bool `json:"enabled"`
+	// RetryDays are the days after the due date of an invoice at which its payment is retried
+	RetryDays []int `json:"retry_days,omitempty"`
+	// GraceDays are the days after the due date of an invoice before its subscription becomes past due
+	GraceDays int `json:"grace_days"`
+	// FinalAction is applied to the subscription once the last retry failed
+	FinalAction types.DunningFinalAction `json:"final_action,omitempty"`
+}
+
 // Tenant represents an organization or group within the system.
 type Tenant struct {
 	ID             string               `json:"id"`
 	Name           string               `json:"name"`
 	Status         types.Status         `json:"status"`
 	BillingDetails TenantBillingDetails `json:"billing_details"`
+	DunningPolicy  TenantDunningPolicy  `json:"dunning_policy"`
 	CreatedAt      time.Time            `json:"created_at"`
 	UpdatedAt      time.Time            `json:"updated_at"`
 }
@@ -48,6 +61,7 @@ func FromEnt(e *ent.Tenant) *Tenant {
 		CreatedAt:      e.CreatedAt,
 		UpdatedAt:      e.UpdatedAt,
 		BillingDetails: FromEntBillingDetails(e.BillingDetails),
+		DunningPolicy:  FromEntDunningPolicy(e.DunningPolicy),
 	}
 }
 
@@ -85,6 +99,15 @@ func FromEntTenantAddress(e schema.TenantAddress) TenantAddress {
 	}
 }
 
+func FromEntDunningPolicy(e schema.TenantDunningPolicy) TenantDunningPolicy {
+	return TenantDunningPolicy{
+		Enabled:     e.Enabled,
+		RetryDays:   e.RetryDays,
+		GraceDays:   e.GraceDays,
+		FinalAction: types.DunningFinalAction(e.FinalAction),
+	}
+}
+
 func (t TenantBillingDetails) ToSchema() schema.TenantBillingDetails {
 	return schema.TenantBillingDetails{
 		Email:     t.Email,
@@ -104,3 +127,12 @@ func (t TenantAddress) ToSchema() schema.TenantAddress {
 		Country:    t.Country,
 	}
 }
+
+func (p TenantDunningPolicy) ToSchema() schema.TenantDunningPolicy {
+	return schema.TenantDunningPolicy{
+		Enabled:     p.Enabled,
+		RetryDays:   p.RetryDays,
+		GraceDays:   p.GraceDays,
+		FinalAction: string(p.FinalAction),
+	}
+}
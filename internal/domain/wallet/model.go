@@ -22,10 +22,16 @@ type Wallet struct {
 	AutoTopupTrigger    types.AutoTopupTrigger `db:"auto_topup_trigger" json:"auto_topup_trigger"`
 	AutoTopupMinBalance decimal.Decimal        `db:"auto_topup_min_balance" json:"auto_topup_min_balance"`
 	AutoTopupAmount     decimal.Decimal        `db:"auto_topup_amount" json:"auto_topup_amount"`
+	AutoTopupMethod     types.AutoTopupMethod  `db:"auto_topup_method" json:"auto_topup_method"`
 	WalletType          types.WalletType       `db:"wallet_type" json:"wallet_type"`
 	Config              types.WalletConfig     `db:"config" json:"config"`
 	ConversionRate      decimal.Decimal        `db:"conversion_rate" json:"conversion_rate"`
 	EnvironmentID       string                 `db:"environment_id" json:"environment_id"`
+
+	// AutoTopupPaymentMethodType and AutoTopupPaymentMethodID are charged when AutoTopupMethod is payment
+	AutoTopupPaymentMethodType types.PaymentMethodType `db:"auto_topup_payment_method_type" json:"auto_topup_payment_method_type,omitempty"`
+	AutoTopupPaymentMethodID   string                  `db:"auto_topup_payment_method_id" json:"auto_topup_payment_method_id,omitempty"`
+
 	types.BaseModel
 }
 
@@ -68,6 +74,12 @@ func (w *Wallet) ApplyConversionRate(rate decimal.Decimal) *Wallet {
 	return w
 }
 
+// AutoTopupEnabled returns true if the wallet is configured to top up when its balance drops below the threshold
+func (w *Wallet) AutoTopupEnabled() bool {
+	return w.AutoTopupTrigger == types.AutoTopupTriggerBalanceBelowThreshold &&
+		w.AutoTopupAmount.IsPositive()
+}
+
 // FromEnt converts an ent wallet to a domain wallet
 func FromEnt(e *ent.Wallet) *Wallet {
 	if e == nil {
@@ -95,10 +107,15 @@ func FromEnt(e *ent.Wallet) *Wallet {
 		AutoTopupTrigger:    types.AutoTopupTrigger(lo.FromPtr(e.AutoTopupTrigger)),
 		AutoTopupMinBalance: autoTopupMinBalance,
 		AutoTopupAmount:     autoTopupAmount,
+		AutoTopupMethod:     types.AutoTopupMethod(e.AutoTopupMethod),
 		WalletType:          types.WalletType(e.WalletType),
 		Config:              e.Config,
 		ConversionRate:      e.ConversionRate,
 		EnvironmentID:       e.EnvironmentID,
+
+		AutoTopupPaymentMethodType: types.PaymentMethodType(lo.FromPtr(e.AutoTopupPaymentMethodType)),
+		AutoTopupPaymentMethodID:   lo.FromPtr(e.AutoTopupPaymentMethodID),
+
 		BaseModel: types.BaseModel{
 			TenantID:  e.TenantID,
 			Status:    types.Status(e.Status),
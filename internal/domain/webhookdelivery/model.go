@@ -0,0 +1,91 @@
+package webhookdelivery
+
+import (
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// WebhookDelivery is an attempt to deliver a webhook event to a destination. The events that
+// failed before being delivered to any destination, e.g. because their payload could not be built,
+// are recorded as dead lettered deliveries without destination whose request body is the original
+// event message, so that they can be published again.
+type WebhookDelivery struct {
+	ID string `json:"id"`
+
+	// WebhookEndpointID is nil for the deliveries to the static tenant configuration
+	WebhookEndpointID *string `json:"webhook_endpoint_id,omitempty"`
+	EventID           string  `json:"event_id"`
+	EventName         string  `json:"event_name"`
+	URL               string  `json:"url"`
+
+	// RequestHeaders are the headers sent with the values of the credentials redacted
+	RequestHeaders map[string]string `json:"request_headers,omitempty"`
+	RequestBody    string            `json:"request_body"`
+	ResponseStatus *int              `json:"response_status,omitempty"`
+	LatencyMs      int64             `json:"latency_ms"`
+	// Error is a short description of the failure of the delivery
+	Error *string `json:"error,omitempty"`
+
+	// Attempt is the number of the attempt to deliver the event to the destination, starting at 1
+	Attempt        int                         `json:"attempt"`
+	DeliveryStatus types.WebhookDeliveryStatus `json:"delivery_status"`
+
+	// ReplayOf is the delivery replayed by this delivery
+	ReplayOf *string `json:"replay_of,omitempty"`
+
+	EnvironmentID string `json:"environment_id"`
+	types.BaseModel
+}
+
+// HasTarget returns false for the records of the events that failed before any delivery
+func (d *WebhookDelivery) HasTarget() bool {
+	return d.WebhookEndpointID != nil || d.URL != ""
+}
+
+// TargetKey identifies the destination of the delivery, the webhook endpoint or the url of the
+// static tenant configuration
+func (d *WebhookDelivery) TargetKey() string {
+	if d.WebhookEndpointID != nil {
+		return *d.WebhookEndpointID
+	}
+	return d.URL
+}
+
+func FromEnt(e *ent.WebhookDelivery) *WebhookDelivery {
+	if e == nil {
+		return nil
+	}
+
+	return &WebhookDelivery{
+		ID:                e.ID,
+		WebhookEndpointID: e.WebhookEndpointID,
+		EventID:           e.EventID,
+		EventName:         e.EventName,
+		URL:               e.URL,
+		RequestHeaders:    e.RequestHeaders,
+		RequestBody:       e.RequestBody,
+		ResponseStatus:    e.ResponseStatus,
+		LatencyMs:         e.LatencyMs,
+		Error:             e.Error,
+		Attempt:           e.Attempt,
+		DeliveryStatus:    types.WebhookDeliveryStatus(e.DeliveryStatus),
+		ReplayOf:          e.ReplayOf,
+		EnvironmentID:     e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+func FromEntList(deliveries []*ent.WebhookDelivery) []*WebhookDelivery {
+	result := make([]*WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = FromEnt(d)
+	}
+	return result
+}
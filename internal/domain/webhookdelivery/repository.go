@@ -0,0 +1,17 @@
+package webhookdelivery
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for webhook delivery storage operations
+type Repository interface {
+	Create(ctx context.Context, d *WebhookDelivery) error
+	Get(ctx context.Context, id string) (*WebhookDelivery, error)
+	List(ctx context.Context, filter *types.WebhookDeliveryFilter) ([]*WebhookDelivery, error)
+	Count(ctx context.Context, filter *types.WebhookDeliveryFilter) (int, error)
+	// UpdateDeliveryStatus updates the delivery status of a delivery, the attempt itself is immutable
+	UpdateDeliveryStatus(ctx context.Context, id string, status types.WebhookDeliveryStatus) error
+}
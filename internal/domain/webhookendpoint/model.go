@@ -0,0 +1,95 @@
+package webhookendpoint
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// WebhookEndpoint is a destination the webhook events of a tenant environment are sent to
+type WebhookEndpoint struct {
+	ID          string `json:"id"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+
+	// EnabledEvents are the events sent to the endpoint, all events are sent when empty
+	EnabledEvents []string `json:"enabled_events"`
+
+	// Headers are added to the requests sent to the endpoint
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Enabled pauses the delivery of events to the endpoint when false
+	Enabled bool `json:"enabled"`
+
+	// SigningSecret is the encrypted secret the deliveries to the endpoint are signed with
+	SigningSecret string `json:"-"`
+
+	// PreviousSigningSecret is the encrypted secret replaced by the last rotation, the deliveries
+	// are also signed with it until PreviousSigningSecretExpiresAt
+	PreviousSigningSecret          *string    `json:"-"`
+	PreviousSigningSecretExpiresAt *time.Time `json:"previous_signing_secret_expires_at,omitempty"`
+
+	Metadata      types.Metadata `json:"metadata,omitempty"`
+	EnvironmentID string         `json:"environment_id"`
+	types.BaseModel
+}
+
+// SubscribesTo reports whether the event is sent to the endpoint
+func (e *WebhookEndpoint) SubscribesTo(eventName string) bool {
+	if !e.Enabled {
+		return false
+	}
+	return len(e.EnabledEvents) == 0 || lo.Contains(e.EnabledEvents, eventName)
+}
+
+// ActiveSigningSecrets returns the encrypted secrets the deliveries to the endpoint are signed with
+// at the given time, the current secret first
+func (e *WebhookEndpoint) ActiveSigningSecrets(now time.Time) []string {
+	var secrets []string
+	if e.SigningSecret != "" {
+		secrets = append(secrets, e.SigningSecret)
+	}
+	if lo.FromPtr(e.PreviousSigningSecret) != "" &&
+		e.PreviousSigningSecretExpiresAt != nil && now.Before(*e.PreviousSigningSecretExpiresAt) {
+		secrets = append(secrets, *e.PreviousSigningSecret)
+	}
+	return secrets
+}
+
+func FromEnt(e *ent.WebhookEndpoint) *WebhookEndpoint {
+	if e == nil {
+		return nil
+	}
+
+	return &WebhookEndpoint{
+		ID:                             e.ID,
+		URL:                            e.URL,
+		Description:                    e.Description,
+		EnabledEvents:                  e.EnabledEvents,
+		Headers:                        e.Headers,
+		Enabled:                        e.Enabled,
+		SigningSecret:                  e.SigningSecret,
+		PreviousSigningSecret:          e.PreviousSigningSecret,
+		PreviousSigningSecretExpiresAt: e.PreviousSigningSecretExpiresAt,
+		Metadata:                       types.Metadata(e.Metadata),
+		EnvironmentID:                  e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+func FromEntList(endpoints []*ent.WebhookEndpoint) []*WebhookEndpoint {
+	result := make([]*WebhookEndpoint, len(endpoints))
+	for i, e := range endpoints {
+		result[i] = FromEnt(e)
+	}
+	return result
+}
@@ -0,0 +1,17 @@
+package webhookendpoint
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for webhook endpoint storage operations
+type Repository interface {
+	Create(ctx context.Context, endpoint *WebhookEndpoint) error
+	Get(ctx context.Context, id string) (*WebhookEndpoint, error)
+	List(ctx context.Context, filter *types.WebhookEndpointFilter) ([]*WebhookEndpoint, error)
+	Count(ctx context.Context, filter *types.WebhookEndpointFilter) (int, error)
+	Update(ctx context.Context, endpoint *WebhookEndpoint) error
+	Delete(ctx context.Context, id string) error
+}
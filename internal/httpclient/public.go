@@ -0,0 +1,106 @@
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+// WebhookClient is the client delivering the webhook events, it only connects to public addresses
+// so that the endpoints configured by the tenants cannot reach the internal network
+type WebhookClient Client
+
+// Resolver resolves the host names to their ip addresses
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// NewWebhookClient creates a new client that refuses to connect to non public addresses. The
+// addresses are checked when dialing, after the host is resolved, so that neither a redirect nor
+// a change of the DNS records of the host lets the requests through.
+func NewWebhookClient() WebhookClient {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+				return fmt.Errorf("connecting to non public address %s is not allowed", host)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &DefaultClient{
+		client: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: transport,
+		},
+	}
+}
+
+// IsPublicIP returns false for the private, loopback, link-local and unspecified addresses
+func IsPublicIP(ip net.IP) bool {
+	return !ip.IsPrivate() &&
+		!ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsUnspecified()
+}
+
+// ValidatePublicURL checks that every address the host of the url resolves to is public
+func ValidatePublicURL(ctx context.Context, resolver Resolver, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Please provide a valid url").
+			Mark(ierr.ErrValidation)
+	}
+
+	host := u.Hostname()
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	} else {
+		addrs, err := resolver.LookupIPAddr(ctx, host)
+		if err != nil || len(addrs) == 0 {
+			return ierr.NewError("url host cannot be resolved").
+				WithHint("Please provide a url whose host can be resolved").
+				WithReportableDetails(map[string]any{
+					"host": host,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+
+	for _, ip := range ips {
+		if !IsPublicIP(ip) {
+			return ierr.NewError("url resolves to a non public address").
+				WithHint("Please provide a url that is reachable from the internet").
+				WithReportableDetails(map[string]any{
+					"host": host,
+					"ip":   ip.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
@@ -17,6 +17,9 @@ const (
 
 	// Payment
 	ScopePayment Scope = "payment"
+
+	// Wallet
+	ScopeWalletAutoTopup Scope = "wallet_auto_topup"
 )
 
 // Generator generates idempotency keys
@@ -14,6 +14,7 @@ import (
 // Generator defines the interface for PDF generation operations
 type Generator interface {
 	RenderInvoicePdf(ctx context.Context, data *pdf.InvoiceData) ([]byte, error)
+	RenderCreditNotePdf(ctx context.Context, data *pdf.CreditNoteData) ([]byte, error)
 }
 
 type Config struct {
@@ -58,3 +59,29 @@ func (s *service) RenderInvoicePdf(ctx context.Context, data *pdf.InvoiceData) (
 
 	return pdf, nil
 }
+
+// RenderCreditNotePdf renders the credit note template with the given data
+func (s *service) RenderCreditNotePdf(ctx context.Context, data *pdf.CreditNoteData) ([]byte, error) {
+	templateName := "credit-note.typ"
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("failed to marshal credit note data").
+			Mark(ierr.ErrSystem)
+	}
+
+	pdf, err := s.typst.CompileTemplate(
+		templateName,
+		jsonData,
+		typst.WithOutputFile(fmt.Sprintf("credit-note-%s.pdf", data.ID)),
+	)
+
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("failed to compile credit note template").
+			Mark(ierr.ErrSystem)
+	}
+
+	return pdf, nil
+}
@@ -74,3 +74,23 @@ func TestRenderInvoicePdf_Error(t *testing.T) {
 	assert.ErrorIs(t, err, expectedError)
 	assert.Nil(t, pdf)
 }
+
+func TestRenderCreditNotePdf(t *testing.T) {
+	mockCompiler := new(MockCompiler)
+	service := &service{
+		typst: mockCompiler,
+	}
+
+	data := &pdf.CreditNoteData{ID: "123"}
+	expectedPDF := []byte("mocked PDF content")
+
+	jsonData, err := json.Marshal(data)
+	assert.NoError(t, err)
+
+	mockCompiler.On("CompileTemplate", "credit-note.typ", jsonData, mock.Anything).Return(expectedPDF, nil)
+
+	pdf, err := service.RenderCreditNotePdf(context.Background(), data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedPDF, pdf)
+}
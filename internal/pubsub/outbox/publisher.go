@@ -0,0 +1,64 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	domainOutbox "github.com/flexprice/flexprice/internal/domain/outbox"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/pubsub"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Publisher implements pubsub.Publisher by writing the messages to the outbox. When the context
+// carries a database transaction the message is only relayed if the transaction commits.
+type Publisher struct {
+	repo   domainOutbox.Repository
+	logger *logger.Logger
+}
+
+// NewPublisher creates a new outbox publisher
+func NewPublisher(repo domainOutbox.Repository, logger *logger.Logger) pubsub.Publisher {
+	return &Publisher{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Publish stores the message in the outbox for the relay to publish
+func (p *Publisher) Publish(ctx context.Context, topic string, msg *message.Message) error {
+	baseModel := types.GetDefaultBaseModel(ctx)
+	if tenantID := msg.Metadata.Get("tenant_id"); tenantID != "" {
+		baseModel.TenantID = tenantID
+	}
+
+	now := time.Now().UTC()
+	event := &domainOutbox.Event{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_OUTBOX_EVENT),
+		Topic:          topic,
+		MessageID:      msg.UUID,
+		Payload:        string(msg.Payload),
+		Metadata:       msg.Metadata,
+		DeliveryStatus: types.OutboxEventStatusPending,
+		NextAttemptAt:  now,
+		EnvironmentID:  types.GetEnvironmentID(ctx),
+		BaseModel:      baseModel,
+	}
+
+	if err := p.repo.Create(ctx, event); err != nil {
+		p.logger.Errorw("failed to write message to outbox",
+			"error", err,
+			"message_id", msg.UUID,
+			"topic", topic,
+		)
+		return err
+	}
+
+	return nil
+}
+
+// Close is a no-op since the outbox shares the database client
+func (p *Publisher) Close() error {
+	return nil
+}
@@ -0,0 +1,178 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/flexprice/flexprice/internal/config"
+	domainOutbox "github.com/flexprice/flexprice/internal/domain/outbox"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/pubsub"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+const (
+	defaultPollInterval  = time.Second
+	defaultBatchSize     = 100
+	defaultLeaseDuration = 30 * time.Second
+	defaultMaxBackoff    = 5 * time.Minute
+)
+
+// Relay publishes the outbox events to the pubsub and marks them delivered. An event is
+// published at least once: a relay stopping between the publish and the update makes the
+// event due again once its lease expires.
+type Relay struct {
+	repo   domainOutbox.Repository
+	pubSub pubsub.Publisher
+	config config.OutboxConfig
+	logger *logger.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRelay creates a new outbox relay
+func NewRelay(
+	repo domainOutbox.Repository,
+	pubSub pubsub.PubSub,
+	cfg *config.Configuration,
+	logger *logger.Logger,
+) *Relay {
+	outboxConfig := cfg.Outbox
+	if outboxConfig.PollInterval <= 0 {
+		outboxConfig.PollInterval = defaultPollInterval
+	}
+	if outboxConfig.BatchSize <= 0 {
+		outboxConfig.BatchSize = defaultBatchSize
+	}
+	if outboxConfig.LeaseDuration <= 0 {
+		outboxConfig.LeaseDuration = defaultLeaseDuration
+	}
+	if outboxConfig.MaxBackoff <= 0 {
+		outboxConfig.MaxBackoff = defaultMaxBackoff
+	}
+
+	return &Relay{
+		repo:   repo,
+		pubSub: pubSub,
+		config: outboxConfig,
+		logger: logger,
+	}
+}
+
+// Start polls the outbox in the background until Stop is called
+func (r *Relay) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.config.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			r.RelayDue(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops polling and waits for the batch in progress
+func (r *Relay) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
+
+// RelayDue publishes the due events until none are left
+func (r *Relay) RelayDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		now := time.Now().UTC()
+		events, err := r.repo.ListDue(ctx, now, r.config.BatchSize)
+		if err != nil {
+			r.logger.Errorw("failed to list due outbox events", "error", err)
+			return
+		}
+
+		for _, event := range events {
+			if ctx.Err() != nil {
+				return
+			}
+			r.relay(ctx, event, now)
+		}
+
+		if len(events) < r.config.BatchSize {
+			return
+		}
+	}
+}
+
+func (r *Relay) relay(ctx context.Context, event *domainOutbox.Event, now time.Time) {
+	// Another relay may have listed the same event, only the one holding the lease publishes it
+	claimed, err := r.repo.Claim(ctx, event, now.Add(r.config.LeaseDuration))
+	if err != nil {
+		r.logger.Errorw("failed to claim outbox event", "error", err, "outbox_event_id", event.ID)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	msg := message.NewMessage(event.MessageID, []byte(event.Payload))
+	for key, value := range event.Metadata {
+		msg.Metadata.Set(key, value)
+	}
+
+	publishCtx := context.WithValue(ctx, types.CtxTenantID, event.TenantID)
+	publishCtx = context.WithValue(publishCtx, types.CtxEnvironmentID, event.EnvironmentID)
+
+	if err := r.pubSub.Publish(publishCtx, event.Topic, msg); err != nil {
+		nextAttemptAt := time.Now().UTC().Add(r.backoff(event.Attempts))
+		r.logger.Errorw("failed to relay outbox event",
+			"error", err,
+			"outbox_event_id", event.ID,
+			"message_id", event.MessageID,
+			"topic", event.Topic,
+			"attempts", event.Attempts+1,
+			"next_attempt_at", nextAttemptAt,
+		)
+
+		if err := r.repo.MarkFailed(ctx, event.ID, err.Error(), nextAttemptAt); err != nil {
+			r.logger.Errorw("failed to mark outbox event failed", "error", err, "outbox_event_id", event.ID)
+		}
+		return
+	}
+
+	if err := r.repo.MarkDelivered(ctx, event.ID, time.Now().UTC()); err != nil {
+		// The event is published again once the lease expires
+		r.logger.Errorw("failed to mark outbox event delivered", "error", err, "outbox_event_id", event.ID)
+		return
+	}
+
+	r.logger.Debugw("relayed outbox event",
+		"outbox_event_id", event.ID,
+		"message_id", event.MessageID,
+		"topic", event.Topic,
+	)
+}
+
+// backoff doubles the poll interval for every failed attempt, up to the max backoff
+func (r *Relay) backoff(attempts int) time.Duration {
+	backoff := r.config.PollInterval
+	for i := 0; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= r.config.MaxBackoff {
+			return r.config.MaxBackoff
+		}
+	}
+	return backoff
+}
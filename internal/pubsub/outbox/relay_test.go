@@ -0,0 +1,194 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/flexprice/flexprice/ent/migrate"
+	"github.com/flexprice/flexprice/internal/config"
+	domainOutbox "github.com/flexprice/flexprice/internal/domain/outbox"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/pubsub"
+	entRepo "github.com/flexprice/flexprice/internal/repository/ent"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+const testTopic = "webhooks"
+
+// failingPubSub fails the given number of publishes before publishing to the in-memory pubsub
+type failingPubSub struct {
+	*testutil.InMemoryPubSub
+	failures int
+}
+
+func (p *failingPubSub) Publish(ctx context.Context, topic string, msg *message.Message) error {
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("pubsub unavailable")
+	}
+	return p.InMemoryPubSub.Publish(ctx, topic, msg)
+}
+
+type RelayTestSuite struct {
+	testutil.BaseServiceTestSuite
+	client    postgres.IClient
+	repo      domainOutbox.Repository
+	publisher pubsub.Publisher
+	pubSub    *failingPubSub
+	relay     *Relay
+}
+
+func TestRelay(t *testing.T) {
+	suite.Run(t, new(RelayTestSuite))
+}
+
+func (s *RelayTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.client = testutil.NewSQLiteClient(s.T(), s.GetLogger(), migrate.OutboxEventsTable)
+	s.repo = entRepo.NewOutboxRepository(s.client, s.GetLogger())
+	s.publisher = NewPublisher(s.repo, s.GetLogger())
+	s.pubSub = &failingPubSub{InMemoryPubSub: testutil.NewInMemoryPubSub()}
+	s.relay = NewRelay(s.repo, s.pubSub, &config.Configuration{
+		Outbox: config.OutboxConfig{
+			PollInterval:  10 * time.Millisecond,
+			BatchSize:     2,
+			LeaseDuration: time.Minute,
+			MaxBackoff:    40 * time.Millisecond,
+		},
+	}, s.GetLogger())
+}
+
+func (s *RelayTestSuite) publish(ctx context.Context) *message.Message {
+	msg := message.NewMessage(types.GenerateUUID(), []byte(`{"event_name":"invoice.update.finalized"}`))
+	msg.Metadata.Set("tenant_id", types.GetTenantID(ctx))
+	s.Require().NoError(s.publisher.Publish(ctx, testTopic, msg))
+	return msg
+}
+
+func (s *RelayTestSuite) listEvents() []*domainOutbox.Event {
+	events, err := s.client.Querier(s.GetContext()).OutboxEvent.Query().All(s.GetContext())
+	s.Require().NoError(err)
+	return domainOutbox.FromEntList(events)
+}
+
+func (s *RelayTestSuite) relayedIDs() []string {
+	return lo.Map(s.pubSub.GetMessages(testTopic), func(m *message.Message, _ int) string {
+		return m.UUID
+	})
+}
+
+func (s *RelayTestSuite) TestPublishWritesToOutbox() {
+	msg := s.publish(s.GetContext())
+
+	events := s.listEvents()
+	s.Require().Len(events, 1)
+	s.Equal(testTopic, events[0].Topic)
+	s.Equal(msg.UUID, events[0].MessageID)
+	s.Equal(string(msg.Payload), events[0].Payload)
+	s.Equal(types.GetTenantID(s.GetContext()), events[0].TenantID)
+	s.Equal(types.OutboxEventStatusPending, events[0].DeliveryStatus)
+
+	// Nothing reaches the pubsub before the relay
+	s.Empty(s.pubSub.GetMessages(testTopic))
+}
+
+func (s *RelayTestSuite) TestRelayPublishesCommittedEvents() {
+	var committed, rolledBack *message.Message
+	err := s.client.WithTx(s.GetContext(), func(tx context.Context) error {
+		committed = s.publish(tx)
+		return nil
+	})
+	s.NoError(err)
+
+	err = s.client.WithTx(s.GetContext(), func(tx context.Context) error {
+		rolledBack = s.publish(tx)
+		return errors.New("state change failed")
+	})
+	s.Error(err)
+
+	s.relay.RelayDue(s.GetContext())
+	s.Equal([]string{committed.UUID}, s.relayedIDs())
+	s.NotContains(s.relayedIDs(), rolledBack.UUID)
+
+	relayed := s.pubSub.GetMessages(testTopic)[0]
+	s.Equal(string(committed.Payload), string(relayed.Payload))
+	s.Equal(types.GetTenantID(s.GetContext()), relayed.Metadata.Get("tenant_id"))
+
+	events := s.listEvents()
+	s.Require().Len(events, 1)
+	s.Equal(types.OutboxEventStatusDelivered, events[0].DeliveryStatus)
+
+	// Delivered events are not published again
+	s.relay.RelayDue(s.GetContext())
+	s.Len(s.relayedIDs(), 1)
+}
+
+func (s *RelayTestSuite) TestRelayPublishesAllBatches() {
+	for i := 0; i < 5; i++ {
+		s.publish(s.GetContext())
+	}
+
+	s.relay.RelayDue(s.GetContext())
+	s.Len(s.relayedIDs(), 5)
+}
+
+func (s *RelayTestSuite) TestRelayRetriesWithBackoff() {
+	msg := s.publish(s.GetContext())
+	s.pubSub.failures = 2
+
+	s.relay.RelayDue(s.GetContext())
+	s.Empty(s.relayedIDs())
+
+	events := s.listEvents()
+	s.Require().Len(events, 1)
+	s.Equal(types.OutboxEventStatusPending, events[0].DeliveryStatus)
+	s.Equal(1, events[0].Attempts)
+	s.Equal("pubsub unavailable", lo.FromPtr(events[0].LastError))
+	s.True(events[0].NextAttemptAt.After(time.Now().UTC()))
+
+	// The event is not retried before its backoff
+	s.relay.RelayDue(s.GetContext())
+	s.Equal(1, s.listEvents()[0].Attempts)
+
+	time.Sleep(15 * time.Millisecond)
+	s.relay.RelayDue(s.GetContext())
+	s.Equal(2, s.listEvents()[0].Attempts)
+
+	time.Sleep(25 * time.Millisecond)
+	s.relay.RelayDue(s.GetContext())
+	s.Equal([]string{msg.UUID}, s.relayedIDs())
+	s.Equal(types.OutboxEventStatusDelivered, s.listEvents()[0].DeliveryStatus)
+}
+
+func (s *RelayTestSuite) TestBackoff() {
+	s.Equal(10*time.Millisecond, s.relay.backoff(0))
+	s.Equal(20*time.Millisecond, s.relay.backoff(1))
+	s.Equal(40*time.Millisecond, s.relay.backoff(2))
+	s.Equal(40*time.Millisecond, s.relay.backoff(10))
+}
+
+func (s *RelayTestSuite) TestRelaySkipsLeasedEvents() {
+	msg := s.publish(s.GetContext())
+
+	// Another relay holds the lease of the event
+	events, err := s.repo.ListDue(s.GetContext(), time.Now().UTC(), 10)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+	claimed, err := s.repo.Claim(s.GetContext(), events[0], time.Now().UTC().Add(20*time.Millisecond))
+	s.Require().NoError(err)
+	s.Require().True(claimed)
+
+	s.relay.RelayDue(s.GetContext())
+	s.Empty(s.relayedIDs())
+
+	// The other relay stopped before publishing, the event is relayed once its lease expires
+	time.Sleep(25 * time.Millisecond)
+	s.relay.RelayDue(s.GetContext())
+	s.Equal([]string{msg.UUID}, s.relayedIDs())
+}
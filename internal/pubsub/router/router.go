@@ -7,7 +7,6 @@ import (
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
-	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/sentry"
@@ -21,8 +20,13 @@ type Router struct {
 	config *config.Webhook
 }
 
+// DeadLetterPublisher receives the messages whose handler still failed after all the retries
+type DeadLetterPublisher interface {
+	message.Publisher
+}
+
 // NewRouter creates a new message router
-func NewRouter(cfg *config.Configuration, logger *logger.Logger, sentry *sentry.Service) (*Router, error) {
+func NewRouter(cfg *config.Configuration, logger *logger.Logger, sentry *sentry.Service, deadLetter DeadLetterPublisher) (*Router, error) {
 	router, err := message.NewRouter(
 		message.RouterConfig{},
 		watermill.NewStdLogger(true, false),
@@ -31,7 +35,7 @@ func NewRouter(cfg *config.Configuration, logger *logger.Logger, sentry *sentry.
 		return nil, err
 	}
 
-	poisonQueue, err := middleware.PoisonQueue(getTempDLQ(), "webhooks_dlq")
+	poisonQueue, err := middleware.PoisonQueue(deadLetter, "webhooks_dlq")
 	if err != nil {
 		return nil, err
 	}
@@ -106,13 +110,3 @@ func (r *Router) Close() error {
 	r.logger.Info("closing router")
 	return r.router.Close()
 }
-
-// getTempDLQ returns a temporary DLQ for testing and not actually used
-func getTempDLQ() *gochannel.GoChannel {
-	return gochannel.NewGoChannel(
-		gochannel.Config{
-			Persistent: false,
-		},
-		watermill.NewStdLogger(true, false),
-	)
-}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/repository/clickhouse/builder"
 	"github.com/flexprice/flexprice/internal/types"
 )
 
@@ -20,6 +21,14 @@ func GetAggregator(aggregationType types.AggregationType) events.Aggregator {
 		return &AvgAggregator{}
 	case types.AggregationCountUnique:
 		return &CountUniqueAggregator{}
+	case types.AggregationMax:
+		return &MaxAggregator{}
+	case types.AggregationMin:
+		return &MinAggregator{}
+	case types.AggregationLatest:
+		return &LatestAggregator{}
+	case types.AggregationWeightedSum:
+		return &WeightedSumAggregator{}
 	}
 	return nil
 }
@@ -45,27 +54,16 @@ func formatWindowSize(windowSize types.WindowSize) string {
 	}
 }
 
-func buildFilterConditions(filters map[string][]string) string {
-	if len(filters) == 0 {
+func buildFilterConditions(params *events.UsageParams) string {
+	if len(params.Filters) == 0 {
 		return ""
 	}
 
 	var conditions []string
-	for key, values := range filters {
-		if len(values) == 0 {
-			continue
+	for key, values := range params.Filters {
+		if condition := builder.BuildFilterCondition(key, params.FilterOperators[key], values); condition != "" {
+			conditions = append(conditions, condition)
 		}
-
-		quotedValues := make([]string, len(values))
-		for i, v := range values {
-			quotedValues[i] = fmt.Sprintf("'%s'", v)
-		}
-
-		conditions = append(conditions, fmt.Sprintf(
-			"JSONExtractString(properties, '%s') IN (%s)",
-			key,
-			strings.Join(quotedValues, ","),
-		))
 	}
 
 	if len(conditions) == 0 {
@@ -103,10 +101,24 @@ func parseTimeConditions(params *events.UsageParams) []string {
 	return conditions
 }
 
-// SumAggregator implements sum aggregation
-type SumAggregator struct{}
+func buildCustomerFilters(params *events.UsageParams) string {
+	var conditions []string
 
-func (a *SumAggregator) GetQuery(ctx context.Context, params *events.UsageParams) string {
+	if params.ExternalCustomerID != "" {
+		conditions = append(conditions, fmt.Sprintf("AND external_customer_id = '%s'", params.ExternalCustomerID))
+	}
+
+	if params.CustomerID != "" {
+		conditions = append(conditions, fmt.Sprintf("AND customer_id = '%s'", params.CustomerID))
+	}
+
+	return strings.Join(conditions, " ")
+}
+
+// buildDeduplicatedQuery builds the query shared by the aggregations over the event properties.
+// The events are deduplicated in the inner query, selecting innerColumns per event (and window),
+// then the outer aggregation is applied per window or over the whole period.
+func buildDeduplicatedQuery(ctx context.Context, params *events.UsageParams, outerAggregation, innerColumns, innerGroupBy string) string {
 	windowSize := formatWindowSize(params.WindowSize)
 	selectClause := ""
 	windowClause := ""
@@ -120,50 +132,49 @@ func (a *SumAggregator) GetQuery(ctx context.Context, params *events.UsageParams
 		windowGroupBy = ", window_size"
 	}
 
-	externalCustomerFilter := ""
-	if params.ExternalCustomerID != "" {
-		externalCustomerFilter = fmt.Sprintf("AND external_customer_id = '%s'", params.ExternalCustomerID)
-	}
-
-	customerFilter := ""
-	if params.CustomerID != "" {
-		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
-	}
-
-	filterConditions := buildFilterConditions(params.Filters)
-	timeConditions := buildTimeConditions(params)
-
 	return fmt.Sprintf(`
         SELECT 
-            %s sum(value) as total
+            %s %s as total
         FROM (
             SELECT
-                %s anyLast(JSONExtractFloat(assumeNotNull(properties), '%s')) as value
+                %s %s
             FROM events
             PREWHERE event_name = '%s' 
                 AND tenant_id = '%s'
-				%s
-				%s
                 %s
                 %s
-            GROUP BY %s %s
+                %s
+            GROUP BY %s %s %s
         )
         %s
     `,
 		selectClause,
+		outerAggregation,
 		windowClause,
-		params.PropertyName,
+		innerColumns,
 		params.EventName,
 		types.GetTenantID(ctx),
-		externalCustomerFilter,
-		customerFilter,
-		filterConditions,
-		timeConditions,
+		buildCustomerFilters(params),
+		buildFilterConditions(params),
+		buildTimeConditions(params),
 		getDeduplicationKey(),
+		innerGroupBy,
 		windowGroupBy,
 		groupByClause)
 }
 
+// propertyValueColumn selects the numeric value of the property for a deduplicated event
+func propertyValueColumn(params *events.UsageParams) string {
+	return fmt.Sprintf("anyLast(JSONExtractFloat(assumeNotNull(properties), '%s')) as value", params.PropertyName)
+}
+
+// SumAggregator implements sum aggregation
+type SumAggregator struct{}
+
+func (a *SumAggregator) GetQuery(ctx context.Context, params *events.UsageParams) string {
+	return buildDeduplicatedQuery(ctx, params, "sum(value)", propertyValueColumn(params), "")
+}
+
 func (a *SumAggregator) GetType() types.AggregationType {
 	return types.AggregationSum
 }
@@ -181,27 +192,13 @@ func (a *CountAggregator) GetQuery(ctx context.Context, params *events.UsagePara
 		groupByClause = "GROUP BY window_size ORDER BY window_size"
 	}
 
-	externalCustomerFilter := ""
-	if params.ExternalCustomerID != "" {
-		externalCustomerFilter = fmt.Sprintf("AND external_customer_id = '%s'", params.ExternalCustomerID)
-	}
-
-	customerFilter := ""
-	if params.CustomerID != "" {
-		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
-	}
-
-	filterConditions := buildFilterConditions(params.Filters)
-	timeConditions := buildTimeConditions(params)
-
 	return fmt.Sprintf(`
         SELECT 
             %s count(DISTINCT %s) as total
         FROM events
         PREWHERE event_name = '%s'
             AND tenant_id = '%s'
-			%s
-			%s
+            %s
             %s
             %s
         %s
@@ -210,10 +207,9 @@ func (a *CountAggregator) GetQuery(ctx context.Context, params *events.UsagePara
 		getDeduplicationKey(),
 		params.EventName,
 		types.GetTenantID(ctx),
-		externalCustomerFilter,
-		customerFilter,
-		filterConditions,
-		timeConditions,
+		buildCustomerFilters(params),
+		buildFilterConditions(params),
+		buildTimeConditions(params),
 		groupByClause)
 }
 
@@ -225,61 +221,10 @@ func (a *CountAggregator) GetType() types.AggregationType {
 type CountUniqueAggregator struct{}
 
 func (a *CountUniqueAggregator) GetQuery(ctx context.Context, params *events.UsageParams) string {
-	windowSize := formatWindowSize(params.WindowSize)
-	selectClause := ""
-	windowClause := ""
-	groupByClause := ""
-	windowGroupBy := ""
-
-	if windowSize != "" {
-		selectClause = "window_size,"
-		windowClause = fmt.Sprintf("%s AS window_size,", windowSize)
-		groupByClause = "GROUP BY window_size ORDER BY window_size"
-		windowGroupBy = ", window_size"
-	}
-
-	externalCustomerFilter := ""
-	if params.ExternalCustomerID != "" {
-		externalCustomerFilter = fmt.Sprintf("AND external_customer_id = '%s'", params.ExternalCustomerID)
-	}
-
-	customerFilter := ""
-	if params.CustomerID != "" {
-		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
-	}
-
-	filterConditions := buildFilterConditions(params.Filters)
-	timeConditions := buildTimeConditions(params)
-
-	return fmt.Sprintf(`
-        SELECT 
-            %s count(DISTINCT property_value) as total
-        FROM (
-            SELECT
-                %s JSONExtractString(assumeNotNull(properties), '%s') as property_value
-            FROM events
-            PREWHERE event_name = '%s'
-                AND tenant_id = '%s'
-				%s
-				%s
-                %s
-                %s
-            GROUP BY %s, property_value %s
-        )
-        %s
-    `,
-		selectClause,
-		windowClause,
-		params.PropertyName,
-		params.EventName,
-		types.GetTenantID(ctx),
-		externalCustomerFilter,
-		customerFilter,
-		filterConditions,
-		timeConditions,
-		getDeduplicationKey(),
-		windowGroupBy,
-		groupByClause)
+	return buildDeduplicatedQuery(ctx, params,
+		"count(DISTINCT property_value)",
+		fmt.Sprintf("JSONExtractString(assumeNotNull(properties), '%s') as property_value", params.PropertyName),
+		", property_value")
 }
 
 func (a *CountUniqueAggregator) GetType() types.AggregationType {
@@ -290,65 +235,171 @@ func (a *CountUniqueAggregator) GetType() types.AggregationType {
 type AvgAggregator struct{}
 
 func (a *AvgAggregator) GetQuery(ctx context.Context, params *events.UsageParams) string {
-	windowSize := formatWindowSize(params.WindowSize)
-	selectClause := ""
-	windowClause := ""
-	groupByClause := ""
-	windowGroupBy := ""
+	return buildDeduplicatedQuery(ctx, params, "avg(value)", propertyValueColumn(params), "")
+}
 
-	if windowSize != "" {
-		selectClause = "window_size,"
-		windowClause = fmt.Sprintf("%s AS window_size,", windowSize)
-		groupByClause = "GROUP BY window_size ORDER BY window_size"
-		windowGroupBy = ", window_size"
-	}
+func (a *AvgAggregator) GetType() types.AggregationType {
+	return types.AggregationAvg
+}
 
-	externalCustomerFilter := ""
-	if params.ExternalCustomerID != "" {
-		externalCustomerFilter = fmt.Sprintf("AND external_customer_id = '%s'", params.ExternalCustomerID)
-	}
+// MaxAggregator implements max aggregation
+type MaxAggregator struct{}
 
-	customerFilter := ""
-	if params.CustomerID != "" {
-		customerFilter = fmt.Sprintf("AND customer_id = '%s'", params.CustomerID)
+func (a *MaxAggregator) GetQuery(ctx context.Context, params *events.UsageParams) string {
+	return buildDeduplicatedQuery(ctx, params, "max(value)", propertyValueColumn(params), "")
+}
+
+func (a *MaxAggregator) GetType() types.AggregationType {
+	return types.AggregationMax
+}
+
+// MinAggregator implements min aggregation
+type MinAggregator struct{}
+
+func (a *MinAggregator) GetQuery(ctx context.Context, params *events.UsageParams) string {
+	return buildDeduplicatedQuery(ctx, params, "min(value)", propertyValueColumn(params), "")
+}
+
+func (a *MinAggregator) GetType() types.AggregationType {
+	return types.AggregationMin
+}
+
+// LatestAggregator implements latest value aggregation, i.e. the value of the
+// most recent event in the period or window
+type LatestAggregator struct{}
+
+func (a *LatestAggregator) GetQuery(ctx context.Context, params *events.UsageParams) string {
+	return buildDeduplicatedQuery(ctx, params,
+		"argMax(value, event_timestamp)",
+		propertyValueColumn(params)+", max(timestamp) as event_timestamp",
+		"")
+}
+
+func (a *LatestAggregator) GetType() types.AggregationType {
+	return types.AggregationLatest
+}
+
+// WeightedSumAggregator implements time weighted sum aggregation. Every value holds
+// from its event timestamp until the next event or the end of the period, and the value
+// in effect at the start of the period is carried in from the last event before it.
+// The integral is divided by the period length, or by the window length per window.
+type WeightedSumAggregator struct{}
+
+func (a *WeightedSumAggregator) GetQuery(ctx context.Context, params *events.UsageParams) string {
+	periodStart := fmt.Sprintf("toDateTime64('%s', 3)", formatClickHouseDateTime(params.StartTime))
+	periodEnd := fmt.Sprintf("toDateTime64('%s', 3)", formatClickHouseDateTime(params.EndTime))
+
+	// the segments between consecutive values, the first one starting at the period start
+	// with the last value reported before it
+	series := fmt.Sprintf(`
+            SELECT
+                value,
+                segment_start,
+                leadInFrame(segment_start, 1, %s) OVER (
+                    ORDER BY segment_start
+                    ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING
+                ) as segment_end
+            FROM (
+                SELECT argMax(value, event_timestamp) as value, %s as segment_start
+                FROM (%s)
+                WHERE event_timestamp < %s
+                HAVING count() > 0
+                UNION ALL
+                SELECT value, event_timestamp as segment_start
+                FROM (%s)
+                WHERE event_timestamp >= %s
+            )`,
+		periodEnd,
+		periodStart,
+		a.deduplicatedValues(ctx, params),
+		periodStart,
+		a.deduplicatedValues(ctx, params),
+		periodStart)
+
+	windowMillis := windowSizeMillis(params.WindowSize)
+	if windowMillis == 0 {
+		return fmt.Sprintf(`
+        SELECT 
+            sum(value * (toUnixTimestamp64Milli(segment_end) - toUnixTimestamp64Milli(segment_start))) / %d as total
+        FROM (%s
+        )
+    `,
+			params.EndTime.Sub(params.StartTime).Milliseconds(),
+			series)
 	}
 
-	filterConditions := buildFilterConditions(params.Filters)
-	timeConditions := buildTimeConditions(params)
+	// every segment is split across the windows it overlaps, and each window is normalised by
+	// its own length, clamped to the period for the first and last window
+	periodStartMillis := params.StartTime.UnixMilli()
+	periodEndMillis := params.EndTime.UnixMilli()
 
 	return fmt.Sprintf(`
         SELECT 
-            %s avg(value) as total
+            fromUnixTimestamp64Milli(window_millis) as window_size,
+            sum(value * (least(end_millis, window_millis + toInt64(%d)) - greatest(start_millis, window_millis)))
+                / any(least(window_millis + toInt64(%d), toInt64(%d)) - greatest(window_millis, toInt64(%d))) as total
         FROM (
             SELECT
-                %s anyLast(JSONExtractFloat(assumeNotNull(properties), '%s')) as value
-            FROM events
-            PREWHERE event_name = '%s' 
-                AND tenant_id = '%s'
-				%s
-				%s
-				%s
-                %s
-            GROUP BY %s %s
+                value,
+                toInt64(toUnixTimestamp64Milli(segment_start)) as start_millis,
+                toInt64(toUnixTimestamp64Milli(segment_end)) as end_millis,
+                toInt64(arrayJoin(range(toUInt64(intDiv(start_millis, %d) * %d), toUInt64(end_millis), toUInt64(%d)))) as window_millis
+            FROM (%s
+            )
+            WHERE end_millis > start_millis
         )
-        %s
+        GROUP BY window_size
+        ORDER BY window_size
     `,
-		selectClause,
-		windowClause,
+		windowMillis,
+		windowMillis,
+		periodEndMillis,
+		periodStartMillis,
+		windowMillis,
+		windowMillis,
+		windowMillis,
+		series)
+}
+
+// deduplicatedValues selects the value and timestamp of every event reported before the end of
+// the period, including the ones before its start from which the initial value is carried in
+func (a *WeightedSumAggregator) deduplicatedValues(ctx context.Context, params *events.UsageParams) string {
+	return fmt.Sprintf(`
+                    SELECT
+                        anyLast(JSONExtractFloat(assumeNotNull(properties), '%s')) as value,
+                        max(timestamp) as event_timestamp
+                    FROM events
+                    PREWHERE event_name = '%s' 
+                        AND tenant_id = '%s'
+                        %s
+                        %s
+                        AND timestamp < toDateTime64('%s', 3)
+                    GROUP BY %s`,
 		params.PropertyName,
 		params.EventName,
 		types.GetTenantID(ctx),
-		externalCustomerFilter,
-		customerFilter,
-		filterConditions,
-		timeConditions,
-		getDeduplicationKey(),
-		windowGroupBy,
-		groupByClause)
+		buildCustomerFilters(params),
+		buildFilterConditions(params),
+		formatClickHouseDateTime(params.EndTime),
+		getDeduplicationKey())
 }
 
-func (a *AvgAggregator) GetType() types.AggregationType {
-	return types.AggregationAvg
+// windowSizeMillis returns the length of the window in milliseconds, 0 when not windowed
+func windowSizeMillis(windowSize types.WindowSize) int64 {
+	switch windowSize {
+	case types.WindowSizeMinute:
+		return time.Minute.Milliseconds()
+	case types.WindowSizeHour:
+		return time.Hour.Milliseconds()
+	case types.WindowSizeDay:
+		return (24 * time.Hour).Milliseconds()
+	default:
+		return 0
+	}
+}
+
+func (a *WeightedSumAggregator) GetType() types.AggregationType {
+	return types.AggregationWeightedSum
 }
 
 // // buildFilterGroupsQuery builds a query that matches events to the most specific filter group
@@ -0,0 +1,48 @@
+package clickhouse
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var ctx = context.WithValue(context.Background(), types.CtxTenantID, types.DefaultTenantID)
+
+func TestWeightedSumAggregator_GetQuery(t *testing.T) {
+	params := &events.UsageParams{
+		EventName:          "storage_snapshot",
+		PropertyName:       "storage_gb",
+		ExternalCustomerID: "cust-1",
+		AggregationType:    types.AggregationWeightedSum,
+		StartTime:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:            time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+	}
+
+	t.Run("carries in the value before the period", func(t *testing.T) {
+		sql := (&WeightedSumAggregator{}).GetQuery(ctx, params)
+
+		// the events are read up to the end of the period without a lower bound
+		assert.NotContains(t, sql, "AND timestamp >= toDateTime64")
+		assert.Contains(t, sql, "AND timestamp < toDateTime64('2024-01-01 10:30:00.000', 3)")
+		assert.Contains(t, sql, "SELECT argMax(value, event_timestamp) as value, toDateTime64('2024-01-01 00:00:00.000', 3) as segment_start")
+		assert.Contains(t, sql, "WHERE event_timestamp < toDateTime64('2024-01-01 00:00:00.000', 3)")
+		assert.Contains(t, sql, "WHERE event_timestamp >= toDateTime64('2024-01-01 00:00:00.000', 3)")
+		assert.Contains(t, sql, "/ 37800000 as total")
+	})
+
+	t.Run("normalises each window by its own length", func(t *testing.T) {
+		windowed := *params
+		windowed.WindowSize = types.WindowSizeHour
+		sql := (&WeightedSumAggregator{}).GetQuery(ctx, &windowed)
+
+		// the last window is clamped to the end of the period instead of dividing by the whole period
+		assert.NotContains(t, sql, "/ 37800000")
+		assert.Contains(t, sql, "any(least(window_millis + toInt64(3600000), toInt64(1704105000000)) - greatest(window_millis, toInt64(1704067200000)))")
+		assert.Contains(t, sql, "range(toUInt64(intDiv(start_millis, 3600000) * 3600000), toUInt64(end_millis), toUInt64(3600000))")
+		assert.Contains(t, sql, "GROUP BY window_size")
+	})
+}
@@ -0,0 +1,97 @@
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// PropertyExpression returns the expression extracting a property from the events
+// properties column using the given JSONExtract function. Nested keys like
+// "request.model.name" are resolved as JSON paths, while a first level key that
+// literally contains the separator still takes precedence.
+func PropertyExpression(extractFn, key string) string {
+	if !strings.Contains(key, types.PropertyPathSeparator) {
+		return fmt.Sprintf("%s(properties, '%s')", extractFn, escapeString(key))
+	}
+
+	segments := types.SplitPropertyPath(key)
+	quoted := make([]string, len(segments))
+	for i, segment := range segments {
+		quoted[i] = fmt.Sprintf("'%s'", escapeString(segment))
+	}
+
+	return fmt.Sprintf("if(JSONHas(properties, '%s'), %s(properties, '%s'), %s(properties, %s))",
+		escapeString(key),
+		extractFn,
+		escapeString(key),
+		extractFn,
+		strings.Join(quoted, ", "),
+	)
+}
+
+// BuildFilterCondition builds the condition matching a property against the filter values
+// using the given operator. It returns an empty string when there is nothing to match.
+func BuildFilterCondition(key string, operator types.FilterOperator, values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	stringExpr := PropertyExpression("JSONExtractString", key)
+	quotedValues := make([]string, len(values))
+	for i, v := range values {
+		quotedValues[i] = fmt.Sprintf("'%s'", escapeString(v))
+	}
+
+	switch operator.OrDefault() {
+	case types.FilterOperatorIn:
+		if len(values) == 1 {
+			return fmt.Sprintf("%s = %s", stringExpr, quotedValues[0])
+		}
+		return fmt.Sprintf("%s IN (%s)", stringExpr, strings.Join(quotedValues, ","))
+	case types.FilterOperatorNotIn:
+		return fmt.Sprintf("%s NOT IN (%s)", stringExpr, strings.Join(quotedValues, ","))
+	case types.FilterOperatorPrefix:
+		conditions := make([]string, len(quotedValues))
+		for i, v := range quotedValues {
+			conditions[i] = fmt.Sprintf("startsWith(%s, %s)", stringExpr, v)
+		}
+		if len(conditions) == 1 {
+			return conditions[0]
+		}
+		return fmt.Sprintf("(%s)", strings.Join(conditions, " OR "))
+	}
+
+	// numeric operators compare against the property parsed as a float
+	floatExpr := PropertyExpression("JSONExtractFloat", key)
+	switch operator {
+	case types.FilterOperatorGreaterThan:
+		return fmt.Sprintf("%s > %s", floatExpr, numericLiteral(values[0]))
+	case types.FilterOperatorGreaterThanOrEqual:
+		return fmt.Sprintf("%s >= %s", floatExpr, numericLiteral(values[0]))
+	case types.FilterOperatorLessThan:
+		return fmt.Sprintf("%s < %s", floatExpr, numericLiteral(values[0]))
+	case types.FilterOperatorLessThanOrEqual:
+		return fmt.Sprintf("%s <= %s", floatExpr, numericLiteral(values[0]))
+	case types.FilterOperatorBetween:
+		if len(values) < 2 {
+			return ""
+		}
+		return fmt.Sprintf("(%s >= %s AND %s < %s)",
+			floatExpr, numericLiteral(values[0]), floatExpr, numericLiteral(values[1]))
+	}
+
+	return ""
+}
+
+// numericLiteral casts the value on the ClickHouse side so a malformed value can
+// never be interpolated as raw SQL
+func numericLiteral(value string) string {
+	return fmt.Sprintf("toFloat64OrZero('%s')", escapeString(value))
+}
+
+func escapeString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return strings.ReplaceAll(value, "'", `\'`)
+}
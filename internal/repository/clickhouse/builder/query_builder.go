@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 )
 
@@ -18,6 +19,7 @@ type QueryBuilder struct {
 	args         map[string]interface{}
 	filterGroups []events.FilterGroup
 	params       *events.UsageParams
+	err          error
 }
 
 func NewQueryBuilder() *QueryBuilder {
@@ -41,7 +43,12 @@ func (qb *QueryBuilder) WithBaseFilters(ctx context.Context, params *events.Usag
 		conditions = append(conditions, fmt.Sprintf("tenant_id = '%s'", tenantID))
 	}
 
-	conditions = append(conditions, parseTimeConditions(params)...)
+	// the time weighted sum carries in the value reported before the start of the period
+	timeParams := params
+	if params.AggregationType == types.AggregationWeightedSum {
+		timeParams = &events.UsageParams{EndTime: params.EndTime}
+	}
+	conditions = append(conditions, parseTimeConditions(timeParams)...)
 
 	if params.ExternalCustomerID != "" {
 		conditions = append(conditions, fmt.Sprintf("external_customer_id = '%s'", params.ExternalCustomerID))
@@ -52,21 +59,7 @@ func (qb *QueryBuilder) WithBaseFilters(ctx context.Context, params *events.Usag
 
 	if params.Filters != nil {
 		for property, values := range params.Filters {
-			if len(values) > 0 {
-				var condition string
-				if len(values) == 1 {
-					condition = fmt.Sprintf("JSONExtractString(properties, '%s') = '%s'", property, values[0])
-				} else {
-					quotedValues := make([]string, len(values))
-					for i, v := range values {
-						quotedValues[i] = fmt.Sprintf("'%s'", v)
-					}
-					condition = fmt.Sprintf(
-						"JSONExtractString(properties, '%s') IN (%s)",
-						property,
-						strings.Join(quotedValues, ","),
-					)
-				}
+			if condition := BuildFilterCondition(property, params.FilterOperators[property], values); condition != "" {
 				conditions = append(conditions, condition)
 			}
 		}
@@ -95,24 +88,9 @@ func (qb *QueryBuilder) WithFilterGroups(ctx context.Context, groups []events.Fi
 	for _, group := range groups {
 		var conditions []string
 		for property, values := range group.Filters {
-			if len(values) == 0 {
-				continue
-			}
-			var condition string
-			if len(values) == 1 {
-				condition = fmt.Sprintf("JSONExtractString(properties, '%s') = '%s'", property, values[0])
-			} else {
-				quotedValues := make([]string, len(values))
-				for i, v := range values {
-					quotedValues[i] = fmt.Sprintf("'%s'", v)
-				}
-				condition = fmt.Sprintf(
-					"JSONExtractString(properties, '%s') IN (%s)",
-					property,
-					strings.Join(quotedValues, ","),
-				)
+			if condition := BuildFilterCondition(property, qb.filterOperator(property), values); condition != "" {
+				conditions = append(conditions, condition)
 			}
-			conditions = append(conditions, condition)
 		}
 
 		// Only add the filter group if it has conditions
@@ -160,11 +138,12 @@ func (qb *QueryBuilder) WithFilterGroups(ctx context.Context, groups []events.Fi
 	best_matches AS (
 		SELECT
 			id,
+			timestamp,
 			properties,
 			argMax(group_id, (total_filters, group_id)) as best_match_group
 		FROM matched_events
 		WHERE matches = 1
-		GROUP BY id, properties
+		GROUP BY id, timestamp, properties
 	)`
 
 	qb.filterGroups = groups
@@ -172,17 +151,46 @@ func (qb *QueryBuilder) WithFilterGroups(ctx context.Context, groups []events.Fi
 	return qb
 }
 
+// filterOperator returns the operator configured for the property in the base params
+func (qb *QueryBuilder) filterOperator(property string) types.FilterOperator {
+	if qb.params == nil {
+		return types.FilterOperatorIn
+	}
+	return qb.params.FilterOperators[property].OrDefault()
+}
+
 func (qb *QueryBuilder) WithAggregation(ctx context.Context, aggType types.AggregationType, propertyName string) *QueryBuilder {
+	valueExpr := fmt.Sprintf("CAST(%s AS Float64)", PropertyExpression("JSONExtractString", propertyName))
+
 	var aggClause string
 	switch aggType {
 	case types.AggregationCount:
 		aggClause = "COUNT(*)"
 	case types.AggregationSum:
-		aggClause = fmt.Sprintf("SUM(CAST(JSONExtractString(properties, '%s') AS Float64))", propertyName)
+		aggClause = fmt.Sprintf("SUM(%s)", valueExpr)
 	case types.AggregationAvg:
-		aggClause = fmt.Sprintf("AVG(CAST(JSONExtractString(properties, '%s') AS Float64))", propertyName)
+		aggClause = fmt.Sprintf("AVG(%s)", valueExpr)
 	case types.AggregationCountUnique:
-		aggClause = fmt.Sprintf("COUNT(DISTINCT JSONExtractString(properties, '%s'))", propertyName)
+		aggClause = fmt.Sprintf("COUNT(DISTINCT %s)", PropertyExpression("JSONExtractString", propertyName))
+	case types.AggregationMax:
+		aggClause = fmt.Sprintf("MAX(%s)", valueExpr)
+	case types.AggregationMin:
+		aggClause = fmt.Sprintf("MIN(%s)", valueExpr)
+	case types.AggregationLatest:
+		aggClause = fmt.Sprintf("argMax(%s, timestamp)", valueExpr)
+	case types.AggregationWeightedSum:
+		if qb.params == nil || qb.params.StartTime.IsZero() || qb.params.EndTime.IsZero() ||
+			!qb.params.EndTime.After(qb.params.StartTime) {
+			qb.err = ierr.NewError("start and end time are required for this aggregation type").
+				WithHint("Please provide a valid start and end time for the usage period").
+				WithReportableDetails(map[string]interface{}{
+					"aggregation_type": aggType,
+				}).
+				Mark(ierr.ErrValidation)
+			return qb
+		}
+		qb.finalQuery = qb.weightedSumQuery(valueExpr)
+		return qb
 	}
 
 	qb.finalQuery = fmt.Sprintf("SELECT best_match_group as filter_group_id, %s as value FROM best_matches GROUP BY best_match_group ORDER BY best_match_group", aggClause)
@@ -190,6 +198,53 @@ func (qb *QueryBuilder) WithAggregation(ctx context.Context, aggType types.Aggre
 	return qb
 }
 
+// weightedSumQuery integrates the value over time per filter group. Each value holds until
+// the next matching event of the same group or the end of the period, and the value in effect
+// at the start of the period is carried in from the last event of the group before it. The
+// period of the base filters must be bounded.
+func (qb *QueryBuilder) weightedSumQuery(valueExpr string) string {
+	periodStart := formatClickHouseDateTime(qb.params.StartTime)
+	periodEnd := formatClickHouseDateTime(qb.params.EndTime)
+	periodMillis := qb.params.EndTime.Sub(qb.params.StartTime).Milliseconds()
+
+	return fmt.Sprintf(`SELECT filter_group_id, sum(value * (toUnixTimestamp64Milli(segment_end) - toUnixTimestamp64Milli(segment_start))) / %d as value FROM (
+		SELECT
+			filter_group_id,
+			value,
+			segment_start,
+			leadInFrame(segment_start, 1, toDateTime64('%s', 3)) OVER (PARTITION BY filter_group_id ORDER BY segment_start ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING) as segment_end
+		FROM (
+			SELECT
+				best_match_group as filter_group_id,
+				argMax(%s, timestamp) as value,
+				toDateTime64('%s', 3) as segment_start
+			FROM best_matches
+			WHERE timestamp < toDateTime64('%s', 3)
+			GROUP BY best_match_group
+			UNION ALL
+			SELECT
+				best_match_group as filter_group_id,
+				%s as value,
+				timestamp as segment_start
+			FROM best_matches
+			WHERE timestamp >= toDateTime64('%s', 3)
+		)
+	) GROUP BY filter_group_id ORDER BY filter_group_id`,
+		periodMillis,
+		periodEnd,
+		valueExpr,
+		periodStart,
+		periodStart,
+		valueExpr,
+		periodStart,
+	)
+}
+
+// Err returns the error of the aggregation, when the query cannot be built for its parameters
+func (qb *QueryBuilder) Err() error {
+	return qb.err
+}
+
 func (qb *QueryBuilder) Build() (string, map[string]interface{}) {
 	var ctes []string
 
@@ -152,6 +152,63 @@ func TestQueryBuilder_WithFilterGroups(t *testing.T) {
 	}
 }
 
+func TestBuildFilterCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		operator types.FilterOperator
+		values   []string
+		want     string
+	}{
+		{
+			name:   "default operator with single value",
+			key:    "model",
+			values: []string{"gpt-4o"},
+			want:   "JSONExtractString(properties, 'model') = 'gpt-4o'",
+		},
+		{
+			name:     "nested key with not in",
+			key:      "request.model.name",
+			operator: types.FilterOperatorNotIn,
+			values:   []string{"a", "b"},
+			want:     "if(JSONHas(properties, 'request.model.name'), JSONExtractString(properties, 'request.model.name'), JSONExtractString(properties, 'request', 'model', 'name')) NOT IN ('a','b')",
+		},
+		{
+			name:     "prefix with multiple values",
+			key:      "model",
+			operator: types.FilterOperatorPrefix,
+			values:   []string{"gpt-4", "o1"},
+			want:     "(startsWith(JSONExtractString(properties, 'model'), 'gpt-4') OR startsWith(JSONExtractString(properties, 'model'), 'o1'))",
+		},
+		{
+			name:     "greater than",
+			key:      "tokens",
+			operator: types.FilterOperatorGreaterThan,
+			values:   []string{"1000"},
+			want:     "JSONExtractFloat(properties, 'tokens') > toFloat64OrZero('1000')",
+		},
+		{
+			name:     "between",
+			key:      "tokens",
+			operator: types.FilterOperatorBetween,
+			values:   []string{"10", "20"},
+			want:     "(JSONExtractFloat(properties, 'tokens') >= toFloat64OrZero('10') AND JSONExtractFloat(properties, 'tokens') < toFloat64OrZero('20'))",
+		},
+		{
+			name:   "quotes are escaped",
+			key:    "name",
+			values: []string{"o'brien"},
+			want:   `JSONExtractString(properties, 'name') = 'o\'brien'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, BuildFilterCondition(tt.key, tt.operator, tt.values))
+		})
+	}
+}
+
 func TestQueryBuilder_WithAggregation(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -188,6 +245,24 @@ func TestQueryBuilder_WithAggregation(t *testing.T) {
 			propertyName: "user",
 			wantSQL:      "SELECT best_match_group as filter_group_id, COUNT(DISTINCT JSONExtractString(properties, 'user')) as value FROM best_matches GROUP BY best_match_group ORDER BY best_match_group",
 		},
+		{
+			name:         "max aggregation",
+			aggType:      types.AggregationMax,
+			propertyName: "seats",
+			wantSQL:      "SELECT best_match_group as filter_group_id, MAX(CAST(JSONExtractString(properties, 'seats') AS Float64)) as value FROM best_matches GROUP BY best_match_group ORDER BY best_match_group",
+		},
+		{
+			name:         "min aggregation",
+			aggType:      types.AggregationMin,
+			propertyName: "seats",
+			wantSQL:      "SELECT best_match_group as filter_group_id, MIN(CAST(JSONExtractString(properties, 'seats') AS Float64)) as value FROM best_matches GROUP BY best_match_group ORDER BY best_match_group",
+		},
+		{
+			name:         "latest aggregation",
+			aggType:      types.AggregationLatest,
+			propertyName: "storage_gb",
+			wantSQL:      "SELECT best_match_group as filter_group_id, argMax(CAST(JSONExtractString(properties, 'storage_gb') AS Float64), timestamp) as value FROM best_matches GROUP BY best_match_group ORDER BY best_match_group",
+		},
 	}
 
 	for _, tt := range tests {
@@ -202,6 +277,70 @@ func TestQueryBuilder_WithAggregation(t *testing.T) {
 	}
 }
 
+func TestQueryBuilder_WithWeightedSumAggregation(t *testing.T) {
+	qb := NewQueryBuilder()
+	qb.WithBaseFilters(ctx, &events.UsageParams{
+		EventName:       "storage_snapshot",
+		AggregationType: types.AggregationWeightedSum,
+		StartTime:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:         time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	qb.WithFilterGroups(ctx, []events.FilterGroup{{ID: "1"}})
+	qb.WithAggregation(ctx, types.AggregationWeightedSum, "storage_gb")
+	sql, _ := qb.Build()
+
+	// the events before the period are kept to carry in the value in effect at its start
+	assert.NotContains(t, sql, "timestamp >= toDateTime64('2024-01-01 00:00:00.000', 3) AND")
+	assert.Contains(t, sql, "timestamp < toDateTime64('2024-01-02 00:00:00.000', 3)")
+	assert.Contains(t, sql, "argMax(CAST(JSONExtractString(properties, 'storage_gb') AS Float64), timestamp) as value")
+	assert.Contains(t, sql, "WHERE timestamp < toDateTime64('2024-01-01 00:00:00.000', 3)")
+	assert.Contains(t, sql, "/ 86400000 as value")
+	assert.Contains(t, sql, "leadInFrame(segment_start, 1, toDateTime64('2024-01-02 00:00:00.000', 3)) OVER (PARTITION BY filter_group_id ORDER BY segment_start")
+	assert.Contains(t, sql, "GROUP BY filter_group_id ORDER BY filter_group_id")
+}
+
+func TestQueryBuilder_WithWeightedSumAggregationNestedProperty(t *testing.T) {
+	qb := NewQueryBuilder()
+	qb.WithBaseFilters(ctx, &events.UsageParams{
+		EventName:       "storage_snapshot",
+		AggregationType: types.AggregationWeightedSum,
+		StartTime:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:         time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	qb.WithFilterGroups(ctx, []events.FilterGroup{{ID: "1"}})
+	qb.WithAggregation(ctx, types.AggregationWeightedSum, "disk.size_gb")
+	sql, _ := qb.Build()
+
+	assert.NoError(t, qb.Err())
+	assert.Contains(t, sql, "argMax(CAST(if(JSONHas(properties, 'disk.size_gb'), JSONExtractString(properties, 'disk.size_gb'), JSONExtractString(properties, 'disk', 'size_gb')) AS Float64), timestamp) as value")
+}
+
+func TestQueryBuilder_WithWeightedSumAggregationRequiresPeriod(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		params *events.UsageParams
+	}{
+		{name: "without params"},
+		{name: "without start time", params: &events.UsageParams{EventName: "storage_snapshot", EndTime: start}},
+		{name: "without end time", params: &events.UsageParams{EventName: "storage_snapshot", StartTime: start}},
+		{name: "with an empty period", params: &events.UsageParams{EventName: "storage_snapshot", StartTime: start, EndTime: start}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qb := NewQueryBuilder()
+			if tt.params != nil {
+				qb.WithBaseFilters(ctx, tt.params)
+			}
+			qb.WithFilterGroups(ctx, []events.FilterGroup{{ID: "1"}})
+			qb.WithAggregation(ctx, types.AggregationWeightedSum, "storage_gb")
+
+			assert.Error(t, qb.Err())
+		})
+	}
+}
+
 func TestQueryBuilder_CompleteFlow(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -162,6 +162,10 @@ func (r *EventRepository) GetUsage(ctx context.Context, params *events.UsagePara
 			Mark(ierr.ErrValidation)
 	}
 
+	if err := validateUsageTimeRange(params); err != nil {
+		return nil, err
+	}
+
 	query := aggregator.GetQuery(ctx, params)
 	log.Printf("Executing query: %s", query)
 
@@ -200,7 +204,8 @@ func (r *EventRepository) GetUsage(ctx context.Context, params *events.UsagePara
 						Mark(ierr.ErrDatabase)
 				}
 				value = decimal.NewFromUint64(countValue)
-			case types.AggregationSum, types.AggregationAvg:
+			case types.AggregationSum, types.AggregationAvg,
+				types.AggregationMax, types.AggregationMin, types.AggregationLatest, types.AggregationWeightedSum:
 				var floatValue float64
 				if err := rows.Scan(&windowSize, &floatValue); err != nil {
 					return nil, ierr.WithError(err).
@@ -240,7 +245,8 @@ func (r *EventRepository) GetUsage(ctx context.Context, params *events.UsagePara
 						Mark(ierr.ErrDatabase)
 				}
 				result.Value = decimal.NewFromUint64(value)
-			case types.AggregationSum, types.AggregationAvg:
+			case types.AggregationSum, types.AggregationAvg,
+				types.AggregationMax, types.AggregationMin, types.AggregationLatest, types.AggregationWeightedSum:
 				var value float64
 				if err := rows.Scan(&value); err != nil {
 					return nil, ierr.WithError(err).
@@ -276,11 +282,18 @@ func (r *EventRepository) GetUsageWithFilters(ctx context.Context, params *event
 			Mark(ierr.ErrValidation)
 	}
 
+	if err := validateUsageTimeRange(params.UsageParams); err != nil {
+		return nil, err
+	}
+
 	// Build query using the new builder
 	qb := builder.NewQueryBuilder().
 		WithBaseFilters(ctx, params.UsageParams).
 		WithAggregation(ctx, params.AggregationType, params.PropertyName).
 		WithFilterGroups(ctx, params.FilterGroups)
+	if err := qb.Err(); err != nil {
+		return nil, err
+	}
 
 	query, queryParams := qb.Build()
 
@@ -325,7 +338,8 @@ func (r *EventRepository) GetUsageWithFilters(ctx context.Context, params *event
 					Mark(ierr.ErrDatabase)
 			}
 			result.Value = decimal.NewFromUint64(value)
-		case types.AggregationSum, types.AggregationAvg:
+		case types.AggregationSum, types.AggregationAvg,
+			types.AggregationMax, types.AggregationMin, types.AggregationLatest, types.AggregationWeightedSum:
 			var value float64
 			if err := rows.Scan(&filterGroupID, &value); err != nil {
 				return nil, ierr.WithError(err).
@@ -359,6 +373,26 @@ func (r *EventRepository) GetUsageWithFilters(ctx context.Context, params *event
 	return results, nil
 }
 
+// validateUsageTimeRange ensures aggregations normalised by the period length get a bounded period
+func validateUsageTimeRange(params *events.UsageParams) error {
+	if !params.AggregationType.RequiresTimeRange() {
+		return nil
+	}
+
+	if params.StartTime.IsZero() || params.EndTime.IsZero() || !params.EndTime.After(params.StartTime) {
+		return ierr.NewError("start and end time are required for this aggregation type").
+			WithHint("Please provide a valid start and end time for the usage period").
+			WithReportableDetails(map[string]interface{}{
+				"aggregation_type": params.AggregationType,
+				"start_time":       params.StartTime,
+				"end_time":         params.EndTime,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
 func (r *EventRepository) GetEvents(ctx context.Context, params *events.GetEventsParams) ([]*events.Event, error) {
 	baseQuery := `
 		SELECT 
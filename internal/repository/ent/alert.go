@@ -0,0 +1,458 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/alertevent"
+	"github.com/flexprice/flexprice/ent/alertrule"
+	domainAlert "github.com/flexprice/flexprice/internal/domain/alert"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type alertRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts AlertRuleQueryOptions
+}
+
+func NewAlertRepository(client postgres.IClient, log *logger.Logger) domainAlert.Repository {
+	return &alertRepository{
+		client:    client,
+		log:       log,
+		queryOpts: AlertRuleQueryOptions{},
+	}
+}
+
+func (r *alertRepository) Create(ctx context.Context, a *domainAlert.Rule) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating alert rule",
+		"alert_rule_id", a.ID,
+		"tenant_id", a.TenantID,
+		"alert_type", a.AlertType,
+	)
+
+	// Set environment ID from context if not already set
+	if a.EnvironmentID == "" {
+		a.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	result, err := client.AlertRule.Create().
+		SetID(a.ID).
+		SetName(a.Name).
+		SetAlertType(string(a.AlertType)).
+		SetNillableFeatureID(a.FeatureID).
+		SetNillableCustomerID(a.CustomerID).
+		SetNillableSubscriptionID(a.SubscriptionID).
+		SetCurrency(a.Currency).
+		SetThresholds(a.Thresholds).
+		SetMetadata(map[string]string(a.Metadata)).
+		SetStatus(string(a.Status)).
+		SetTenantID(a.TenantID).
+		SetCreatedAt(a.CreatedAt).
+		SetUpdatedAt(a.UpdatedAt).
+		SetCreatedBy(a.CreatedBy).
+		SetUpdatedBy(a.UpdatedBy).
+		SetEnvironmentID(a.EnvironmentID).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create alert rule").
+			Mark(ierr.ErrDatabase)
+	}
+
+	*a = *domainAlert.FromEnt(result)
+	return nil
+}
+
+func (r *alertRepository) Get(ctx context.Context, id string) (*domainAlert.Rule, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting alert rule",
+		"alert_rule_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	a, err := client.AlertRule.Query().
+		Where(
+			alertrule.ID(id),
+			alertrule.TenantID(types.GetTenantID(ctx)),
+			alertrule.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Alert rule with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"alert_rule_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get alert rule with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainAlert.FromEnt(a), nil
+}
+
+func (r *alertRepository) List(ctx context.Context, filter *types.AlertRuleFilter) ([]*domainAlert.Rule, error) {
+	if filter == nil {
+		filter = types.NewDefaultAlertRuleFilter()
+	}
+
+	client := r.client.Querier(ctx)
+	query := client.AlertRule.Query()
+
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	rules, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list alert rules").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainAlert.FromEntList(rules), nil
+}
+
+func (r *alertRepository) Count(ctx context.Context, filter *types.AlertRuleFilter) (int, error) {
+	client := r.client.Querier(ctx)
+	query := client.AlertRule.Query()
+
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count alert rules").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *alertRepository) Update(ctx context.Context, a *domainAlert.Rule) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating alert rule",
+		"alert_rule_id", a.ID,
+		"tenant_id", a.TenantID,
+	)
+
+	_, err := client.AlertRule.Update().
+		Where(
+			alertrule.ID(a.ID),
+			alertrule.TenantID(a.TenantID),
+			alertrule.EnvironmentID(a.EnvironmentID),
+		).
+		SetName(a.Name).
+		SetThresholds(a.Thresholds).
+		SetMetadata(map[string]string(a.Metadata)).
+		SetStatus(string(a.Status)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to update alert rule with ID %s", a.ID).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *alertRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting alert rule",
+		"alert_rule_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	_, err := client.AlertRule.Update().
+		Where(
+			alertrule.ID(id),
+			alertrule.TenantID(types.GetTenantID(ctx)),
+			alertrule.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to delete alert rule with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *alertRepository) CreateEvent(ctx context.Context, e *domainAlert.Event) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating alert event",
+		"alert_event_id", e.ID,
+		"alert_rule_id", e.AlertRuleID,
+		"subscription_id", e.SubscriptionID,
+		"threshold", e.Threshold,
+	)
+
+	if e.EnvironmentID == "" {
+		e.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	result, err := client.AlertEvent.Create().
+		SetID(e.ID).
+		SetAlertRuleID(e.AlertRuleID).
+		SetAlertType(string(e.AlertType)).
+		SetCustomerID(e.CustomerID).
+		SetSubscriptionID(e.SubscriptionID).
+		SetNillableFeatureID(e.FeatureID).
+		SetThreshold(e.Threshold).
+		SetValue(e.Value).
+		SetCurrency(e.Currency).
+		SetPeriodStart(e.PeriodStart).
+		SetPeriodEnd(e.PeriodEnd).
+		SetStatus(string(e.Status)).
+		SetTenantID(e.TenantID).
+		SetCreatedAt(e.CreatedAt).
+		SetUpdatedAt(e.UpdatedAt).
+		SetCreatedBy(e.CreatedBy).
+		SetUpdatedBy(e.UpdatedBy).
+		SetEnvironmentID(e.EnvironmentID).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("The threshold was already crossed in this period").
+				WithReportableDetails(map[string]any{
+					"alert_rule_id":   e.AlertRuleID,
+					"subscription_id": e.SubscriptionID,
+					"threshold":       e.Threshold,
+					"period_start":    e.PeriodStart,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create alert event").
+			Mark(ierr.ErrDatabase)
+	}
+
+	*e = *domainAlert.EventFromEnt(result)
+	return nil
+}
+
+func (r *alertRepository) GetEvent(ctx context.Context, id string) (*domainAlert.Event, error) {
+	client := r.client.Querier(ctx)
+
+	query := client.AlertEvent.Query().
+		Where(
+			alertevent.ID(id),
+			alertevent.TenantID(types.GetTenantID(ctx)),
+		)
+
+	// webhook payloads are built without an environment
+	if environmentID := types.GetEnvironmentID(ctx); environmentID != "" {
+		query = query.Where(alertevent.EnvironmentID(environmentID))
+	}
+
+	e, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Alert event with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"alert_event_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get alert event with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainAlert.EventFromEnt(e), nil
+}
+
+func (r *alertRepository) ListEvents(ctx context.Context, filter *types.AlertEventFilter) ([]*domainAlert.Event, error) {
+	if filter == nil {
+		filter = types.NewNoLimitAlertEventFilter()
+	}
+
+	client := r.client.Querier(ctx)
+	query := r.eventQuery(ctx, client.AlertEvent.Query(), filter).
+		Order(ent.Desc(alertevent.FieldCreatedAt))
+
+	if !filter.IsUnlimited() {
+		query = query.Limit(filter.GetLimit()).Offset(filter.GetOffset())
+	}
+
+	events, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list alert events").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainAlert.EventFromEntList(events), nil
+}
+
+func (r *alertRepository) CountEvents(ctx context.Context, filter *types.AlertEventFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	count, err := r.eventQuery(ctx, client.AlertEvent.Query(), filter).Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count alert events").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *alertRepository) eventQuery(ctx context.Context, query *ent.AlertEventQuery, filter *types.AlertEventFilter) *ent.AlertEventQuery {
+	query = query.Where(alertevent.TenantID(types.GetTenantID(ctx)))
+	if environmentID := types.GetEnvironmentID(ctx); environmentID != "" {
+		query = query.Where(alertevent.EnvironmentID(environmentID))
+	}
+
+	if filter == nil {
+		return query.Where(alertevent.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	if filter.AlertRuleID != "" {
+		query = query.Where(alertevent.AlertRuleID(filter.AlertRuleID))
+	}
+	if filter.CustomerID != "" {
+		query = query.Where(alertevent.CustomerID(filter.CustomerID))
+	}
+	if filter.SubscriptionID != "" {
+		query = query.Where(alertevent.SubscriptionID(filter.SubscriptionID))
+	}
+
+	if status := filter.GetStatus(); status != "" {
+		query = query.Where(alertevent.Status(status))
+	} else {
+		query = query.Where(alertevent.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	return query
+}
+
+// AlertRuleQuery type alias for better readability
+type AlertRuleQuery = *ent.AlertRuleQuery
+
+// AlertRuleQueryOptions implements BaseQueryOptions for alert rule queries
+type AlertRuleQueryOptions struct{}
+
+func (o AlertRuleQueryOptions) ApplyTenantFilter(ctx context.Context, query AlertRuleQuery) AlertRuleQuery {
+	return query.Where(alertrule.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o AlertRuleQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query AlertRuleQuery) AlertRuleQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(alertrule.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o AlertRuleQueryOptions) ApplyStatusFilter(query AlertRuleQuery, status string) AlertRuleQuery {
+	if status == "" {
+		return query.Where(alertrule.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(alertrule.Status(status))
+}
+
+func (o AlertRuleQueryOptions) ApplySortFilter(query AlertRuleQuery, field string, order string) AlertRuleQuery {
+	orderFunc := ent.Desc
+	if order == "asc" {
+		orderFunc = ent.Asc
+	}
+	return query.Order(orderFunc(o.GetFieldName(field)))
+}
+
+func (o AlertRuleQueryOptions) ApplyPaginationFilter(query AlertRuleQuery, limit int, offset int) AlertRuleQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o AlertRuleQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return alertrule.FieldCreatedAt
+	case "updated_at":
+		return alertrule.FieldUpdatedAt
+	case "name":
+		return alertrule.FieldName
+	default:
+		return field
+	}
+}
+
+func (o AlertRuleQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.AlertRuleFilter, query AlertRuleQuery) AlertRuleQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.AlertRuleIDs) > 0 {
+		query = query.Where(alertrule.IDIn(f.AlertRuleIDs...))
+	}
+
+	if f.AlertType != "" {
+		query = query.Where(alertrule.AlertType(string(f.AlertType)))
+	}
+
+	if f.FeatureID != "" {
+		query = query.Where(alertrule.FeatureID(f.FeatureID))
+	}
+
+	if f.CustomerID != "" {
+		query = query.Where(alertrule.CustomerID(f.CustomerID))
+	}
+
+	if f.SubscriptionID != "" {
+		query = query.Where(alertrule.SubscriptionID(f.SubscriptionID))
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(alertrule.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(alertrule.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
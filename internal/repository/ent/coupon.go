@@ -0,0 +1,556 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/coupon"
+	"github.com/flexprice/flexprice/ent/couponapplication"
+	"github.com/flexprice/flexprice/ent/predicate"
+	domainCoupon "github.com/flexprice/flexprice/internal/domain/coupon"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type couponRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts CouponQueryOptions
+}
+
+func NewCouponRepository(client postgres.IClient, log *logger.Logger) domainCoupon.Repository {
+	return &couponRepository{
+		client:    client,
+		log:       log,
+		queryOpts: CouponQueryOptions{},
+	}
+}
+
+func (r *couponRepository) Create(ctx context.Context, c *domainCoupon.Coupon) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating coupon",
+		"coupon_id", c.ID,
+		"tenant_id", c.TenantID,
+		"code", c.Code,
+	)
+
+	// Set environment ID from context if not already set
+	if c.EnvironmentID == "" {
+		c.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	result, err := client.Coupon.Create().
+		SetID(c.ID).
+		SetName(c.Name).
+		SetCode(c.Code).
+		SetDiscountType(string(c.DiscountType)).
+		SetNillablePercentOff(c.PercentOff).
+		SetNillableAmountOff(c.AmountOff).
+		SetCurrency(c.Currency).
+		SetDuration(string(c.Duration)).
+		SetNillableDurationInPeriods(c.DurationInPeriods).
+		SetNillableMaxRedemptions(c.MaxRedemptions).
+		SetNillableRedeemBy(c.RedeemBy).
+		SetMetadata(map[string]string(c.Metadata)).
+		SetStatus(string(c.Status)).
+		SetTenantID(c.TenantID).
+		SetCreatedAt(c.CreatedAt).
+		SetUpdatedAt(c.UpdatedAt).
+		SetCreatedBy(c.CreatedBy).
+		SetUpdatedBy(c.UpdatedBy).
+		SetEnvironmentID(c.EnvironmentID).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("A coupon with this code already exists").
+				WithReportableDetails(map[string]any{
+					"code": c.Code,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create coupon").
+			Mark(ierr.ErrDatabase)
+	}
+
+	*c = *domainCoupon.FromEnt(result)
+	return nil
+}
+
+func (r *couponRepository) Get(ctx context.Context, id string) (*domainCoupon.Coupon, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting coupon",
+		"coupon_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	c, err := client.Coupon.Query().
+		Where(
+			coupon.ID(id),
+			coupon.TenantID(types.GetTenantID(ctx)),
+			coupon.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Coupon with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"coupon_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get coupon with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainCoupon.FromEnt(c), nil
+}
+
+func (r *couponRepository) List(ctx context.Context, filter *types.CouponFilter) ([]*domainCoupon.Coupon, error) {
+	if filter == nil {
+		filter = types.NewDefaultCouponFilter()
+	}
+
+	client := r.client.Querier(ctx)
+	query := client.Coupon.Query()
+
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	coupons, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list coupons").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainCoupon.FromEntList(coupons), nil
+}
+
+func (r *couponRepository) Count(ctx context.Context, filter *types.CouponFilter) (int, error) {
+	client := r.client.Querier(ctx)
+	query := client.Coupon.Query()
+
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count coupons").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *couponRepository) Update(ctx context.Context, c *domainCoupon.Coupon) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating coupon",
+		"coupon_id", c.ID,
+		"tenant_id", c.TenantID,
+	)
+
+	_, err := client.Coupon.Update().
+		Where(
+			coupon.ID(c.ID),
+			coupon.TenantID(c.TenantID),
+			coupon.EnvironmentID(c.EnvironmentID),
+		).
+		SetName(c.Name).
+		SetNillableMaxRedemptions(c.MaxRedemptions).
+		SetNillableRedeemBy(c.RedeemBy).
+		SetMetadata(map[string]string(c.Metadata)).
+		SetStatus(string(c.Status)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Coupon with ID %s was not found", c.ID).
+				WithReportableDetails(map[string]any{
+					"coupon_id": c.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update coupon with ID %s", c.ID).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// IncrementTimesRedeemed counts a redemption of the coupon in a single conditional update, so
+// concurrent redemptions cannot exceed the maximum. It returns false if the maximum is reached.
+func (r *couponRepository) IncrementTimesRedeemed(ctx context.Context, id string) (bool, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("incrementing coupon redemptions",
+		"coupon_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	n, err := client.Coupon.Update().
+		Where(
+			coupon.ID(id),
+			coupon.TenantID(types.GetTenantID(ctx)),
+			coupon.EnvironmentID(types.GetEnvironmentID(ctx)),
+			coupon.Or(
+				coupon.MaxRedemptionsIsNil(),
+				predicate.Coupon(func(s *entsql.Selector) {
+					s.Where(entsql.ColumnsLT(s.C(coupon.FieldTimesRedeemed), s.C(coupon.FieldMaxRedemptions)))
+				}),
+			),
+		).
+		AddTimesRedeemed(1).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return false, ierr.WithError(err).
+			WithHintf("Failed to redeem coupon with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return n > 0, nil
+}
+
+func (r *couponRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting coupon",
+		"coupon_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	_, err := client.Coupon.Update().
+		Where(
+			coupon.ID(id),
+			coupon.TenantID(types.GetTenantID(ctx)),
+			coupon.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to delete coupon with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *couponRepository) CreateApplication(ctx context.Context, a *domainCoupon.CouponApplication) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating coupon application",
+		"coupon_application_id", a.ID,
+		"coupon_id", a.CouponID,
+		"customer_id", a.CustomerID,
+	)
+
+	if a.EnvironmentID == "" {
+		a.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	result, err := client.CouponApplication.Create().
+		SetID(a.ID).
+		SetCouponID(a.CouponID).
+		SetCustomerID(a.CustomerID).
+		SetNillableSubscriptionID(a.SubscriptionID).
+		SetPeriodsApplied(a.PeriodsApplied).
+		SetMetadata(map[string]string(a.Metadata)).
+		SetStatus(string(a.Status)).
+		SetTenantID(a.TenantID).
+		SetCreatedAt(a.CreatedAt).
+		SetUpdatedAt(a.UpdatedAt).
+		SetCreatedBy(a.CreatedBy).
+		SetUpdatedBy(a.UpdatedBy).
+		SetEnvironmentID(a.EnvironmentID).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to apply coupon").
+			Mark(ierr.ErrDatabase)
+	}
+
+	*a = *domainCoupon.ApplicationFromEnt(result)
+	return nil
+}
+
+func (r *couponRepository) GetApplication(ctx context.Context, id string) (*domainCoupon.CouponApplication, error) {
+	client := r.client.Querier(ctx)
+
+	a, err := client.CouponApplication.Query().
+		Where(
+			couponapplication.ID(id),
+			couponapplication.TenantID(types.GetTenantID(ctx)),
+			couponapplication.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Coupon application with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"coupon_application_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get coupon application with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainCoupon.ApplicationFromEnt(a), nil
+}
+
+func (r *couponRepository) ListApplications(ctx context.Context, filter *types.CouponApplicationFilter) ([]*domainCoupon.CouponApplication, error) {
+	if filter == nil {
+		filter = types.NewNoLimitCouponApplicationFilter()
+	}
+
+	query := r.applicationQuery(ctx, filter)
+
+	query = query.Order(ent.Asc(couponapplication.FieldCreatedAt))
+	if !filter.IsUnlimited() {
+		query = query.Limit(filter.GetLimit()).Offset(filter.GetOffset())
+	}
+
+	applications, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list coupon applications").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainCoupon.ApplicationFromEntList(applications), nil
+}
+
+func (r *couponRepository) CountApplications(ctx context.Context, filter *types.CouponApplicationFilter) (int, error) {
+	if filter == nil {
+		filter = types.NewNoLimitCouponApplicationFilter()
+	}
+
+	count, err := r.applicationQuery(ctx, filter).Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count coupon applications").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+// applicationQuery returns the query for the coupon applications matching the filter, without pagination
+func (r *couponRepository) applicationQuery(ctx context.Context, filter *types.CouponApplicationFilter) *ent.CouponApplicationQuery {
+	client := r.client.Querier(ctx)
+	query := client.CouponApplication.Query().
+		Where(
+			couponapplication.TenantID(types.GetTenantID(ctx)),
+			couponapplication.EnvironmentID(types.GetEnvironmentID(ctx)),
+		)
+
+	if filter.CouponID != "" {
+		query = query.Where(couponapplication.CouponID(filter.CouponID))
+	}
+	if filter.CustomerID != "" {
+		query = query.Where(couponapplication.CustomerID(filter.CustomerID))
+	}
+	if filter.SubscriptionID != "" {
+		query = query.Where(couponapplication.SubscriptionID(filter.SubscriptionID))
+	}
+
+	if status := filter.GetStatus(); status != "" {
+		query = query.Where(couponapplication.Status(status))
+	} else {
+		query = query.Where(couponapplication.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	return query
+}
+
+func (r *couponRepository) UpdateApplication(ctx context.Context, a *domainCoupon.CouponApplication) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating coupon application",
+		"coupon_application_id", a.ID,
+		"periods_applied", a.PeriodsApplied,
+	)
+
+	_, err := client.CouponApplication.Update().
+		Where(
+			couponapplication.ID(a.ID),
+			couponapplication.TenantID(a.TenantID),
+			couponapplication.EnvironmentID(a.EnvironmentID),
+		).
+		SetPeriodsApplied(a.PeriodsApplied).
+		SetMetadata(map[string]string(a.Metadata)).
+		SetStatus(string(a.Status)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to update coupon application with ID %s", a.ID).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// IncrementPeriodsApplied counts a period discounted by the coupon application in a single
+// conditional update, so concurrent invoices cannot use more periods than the limit. It returns
+// false if the limit is reached.
+func (r *couponRepository) IncrementPeriodsApplied(ctx context.Context, id string, limit *int) (bool, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("incrementing coupon application periods",
+		"coupon_application_id", id,
+		"limit", limit,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	query := client.CouponApplication.Update().
+		Where(
+			couponapplication.ID(id),
+			couponapplication.TenantID(types.GetTenantID(ctx)),
+			couponapplication.EnvironmentID(types.GetEnvironmentID(ctx)),
+		)
+	if limit != nil {
+		query = query.Where(couponapplication.PeriodsAppliedLT(*limit))
+	}
+
+	n, err := query.
+		AddPeriodsApplied(1).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return false, ierr.WithError(err).
+			WithHintf("Failed to update coupon application with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return n > 0, nil
+}
+
+// CouponQuery type alias for better readability
+type CouponQuery = *ent.CouponQuery
+
+// CouponQueryOptions implements BaseQueryOptions for coupon queries
+type CouponQueryOptions struct{}
+
+func (o CouponQueryOptions) ApplyTenantFilter(ctx context.Context, query CouponQuery) CouponQuery {
+	return query.Where(coupon.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o CouponQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query CouponQuery) CouponQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(coupon.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o CouponQueryOptions) ApplyStatusFilter(query CouponQuery, status string) CouponQuery {
+	if status == "" {
+		return query.Where(coupon.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(coupon.Status(status))
+}
+
+func (o CouponQueryOptions) ApplySortFilter(query CouponQuery, field string, order string) CouponQuery {
+	orderFunc := ent.Desc
+	if order == "asc" {
+		orderFunc = ent.Asc
+	}
+	return query.Order(orderFunc(o.GetFieldName(field)))
+}
+
+func (o CouponQueryOptions) ApplyPaginationFilter(query CouponQuery, limit int, offset int) CouponQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o CouponQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return coupon.FieldCreatedAt
+	case "updated_at":
+		return coupon.FieldUpdatedAt
+	case "name":
+		return coupon.FieldName
+	case "code":
+		return coupon.FieldCode
+	default:
+		return field
+	}
+}
+
+func (o CouponQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.CouponFilter, query CouponQuery) CouponQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.CouponIDs) > 0 {
+		query = query.Where(coupon.IDIn(f.CouponIDs...))
+	}
+
+	if f.Code != "" {
+		query = query.Where(coupon.Code(f.Code))
+	}
+
+	if f.DiscountType != "" {
+		query = query.Where(coupon.DiscountType(string(f.DiscountType)))
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(coupon.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(coupon.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,314 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/creditnote"
+	domainCreditNote "github.com/flexprice/flexprice/internal/domain/creditnote"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type creditNoteRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts CreditNoteQueryOptions
+}
+
+func NewCreditNoteRepository(client postgres.IClient, log *logger.Logger) domainCreditNote.Repository {
+	return &creditNoteRepository{
+		client:    client,
+		log:       log,
+		queryOpts: CreditNoteQueryOptions{},
+	}
+}
+
+func (r *creditNoteRepository) CreateWithLineItems(ctx context.Context, n *domainCreditNote.CreditNote) error {
+	r.log.Debugw("creating credit note with line items",
+		"credit_note_id", n.ID,
+		"invoice_id", n.InvoiceID,
+		"line_items_count", len(n.LineItems),
+	)
+
+	// Set environment ID from context if not already set
+	if n.EnvironmentID == "" {
+		n.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	return r.client.WithTx(ctx, func(ctx context.Context) error {
+		created, err := r.client.Querier(ctx).CreditNote.Create().
+			SetID(n.ID).
+			SetTenantID(n.TenantID).
+			SetCreditNoteNumber(n.CreditNoteNumber).
+			SetInvoiceID(n.InvoiceID).
+			SetCustomerID(n.CustomerID).
+			SetNillableSubscriptionID(n.SubscriptionID).
+			SetReason(string(n.Reason)).
+			SetOutcome(string(n.Outcome)).
+			SetMemo(n.Memo).
+			SetCurrency(n.Currency).
+			SetTotalAmount(n.TotalAmount).
+			SetNillableWalletID(n.WalletID).
+			SetNillablePaymentID(n.PaymentID).
+			SetMetadata(n.Metadata).
+			SetEnvironmentID(n.EnvironmentID).
+			SetStatus(string(n.Status)).
+			SetCreatedAt(n.CreatedAt).
+			SetUpdatedAt(n.UpdatedAt).
+			SetCreatedBy(n.CreatedBy).
+			SetUpdatedBy(n.UpdatedBy).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return ierr.WithError(err).
+					WithHint("A credit note with this number already exists").
+					WithReportableDetails(map[string]any{
+						"credit_note_number": n.CreditNoteNumber,
+					}).
+					Mark(ierr.ErrAlreadyExists)
+			}
+			return ierr.WithError(err).
+				WithHint("Failed to create credit note").
+				Mark(ierr.ErrDatabase)
+		}
+
+		builders := make([]*ent.CreditNoteLineItemCreate, len(n.LineItems))
+		for i, item := range n.LineItems {
+			builders[i] = r.client.Querier(ctx).CreditNoteLineItem.Create().
+				SetID(item.ID).
+				SetTenantID(item.TenantID).
+				SetCreditNoteID(created.ID).
+				SetNillableInvoiceLineItemID(item.InvoiceLineItemID).
+				SetDisplayName(item.DisplayName).
+				SetAmount(item.Amount).
+				SetCurrency(item.Currency).
+				SetMetadata(item.Metadata).
+				SetEnvironmentID(n.EnvironmentID).
+				SetStatus(string(item.Status)).
+				SetCreatedAt(item.CreatedAt).
+				SetUpdatedAt(item.UpdatedAt).
+				SetCreatedBy(item.CreatedBy).
+				SetUpdatedBy(item.UpdatedBy)
+		}
+
+		items, err := r.client.Querier(ctx).CreditNoteLineItem.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to create credit note line items").
+				Mark(ierr.ErrDatabase)
+		}
+
+		created.Edges.LineItems = items
+		*n = *domainCreditNote.FromEnt(created)
+		return nil
+	})
+}
+
+func (r *creditNoteRepository) Get(ctx context.Context, id string) (*domainCreditNote.CreditNote, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting credit note",
+		"credit_note_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	n, err := client.CreditNote.Query().
+		Where(
+			creditnote.ID(id),
+			creditnote.TenantID(types.GetTenantID(ctx)),
+			creditnote.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		WithLineItems().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Credit note with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"credit_note_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get credit note with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainCreditNote.FromEnt(n), nil
+}
+
+func (r *creditNoteRepository) List(ctx context.Context, filter *types.CreditNoteFilter) ([]*domainCreditNote.CreditNote, error) {
+	if filter == nil {
+		filter = types.NewDefaultCreditNoteFilter()
+	}
+
+	client := r.client.Querier(ctx)
+	query := client.CreditNote.Query().
+		WithLineItems()
+
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	notes, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list credit notes").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainCreditNote.FromEntList(notes), nil
+}
+
+func (r *creditNoteRepository) Count(ctx context.Context, filter *types.CreditNoteFilter) (int, error) {
+	client := r.client.Querier(ctx)
+	query := client.CreditNote.Query()
+
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count credit notes").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *creditNoteRepository) GetNextCreditNoteNumber(ctx context.Context) (string, error) {
+	yearMonth := time.Now().Format("200601") // YYYYMM
+	tenantID := types.GetTenantID(ctx)
+
+	// Use raw SQL for atomic increment since ent doesn't support RETURNING with OnConflict
+	query := `
+		INSERT INTO credit_note_sequences (tenant_id, year_month, last_value, created_at, updated_at)
+		VALUES ($1, $2, 1, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (tenant_id, year_month) DO UPDATE
+		SET last_value = credit_note_sequences.last_value + 1,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING last_value`
+
+	var lastValue int64
+	rows, err := r.client.Querier(ctx).QueryContext(ctx, query, tenantID, yearMonth)
+	if err != nil {
+		return "", ierr.WithError(err).WithHint("credit note number generation failed").Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return "", ierr.WithError(err).WithHint("no sequence value returned").Mark(ierr.ErrDatabase)
+	}
+
+	if err := rows.Scan(&lastValue); err != nil {
+		return "", ierr.WithError(err).WithHint("credit note number generation failed").Mark(ierr.ErrDatabase)
+	}
+
+	r.log.Infow("generated credit note number",
+		"tenant_id", tenantID,
+		"year_month", yearMonth,
+		"sequence", lastValue)
+
+	return fmt.Sprintf("CN-%s-%05d", yearMonth, lastValue), nil
+}
+
+// CreditNoteQuery type alias for better readability
+type CreditNoteQuery = *ent.CreditNoteQuery
+
+// CreditNoteQueryOptions implements BaseQueryOptions for credit note queries
+type CreditNoteQueryOptions struct{}
+
+func (o CreditNoteQueryOptions) ApplyTenantFilter(ctx context.Context, query CreditNoteQuery) CreditNoteQuery {
+	return query.Where(creditnote.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o CreditNoteQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query CreditNoteQuery) CreditNoteQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(creditnote.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o CreditNoteQueryOptions) ApplyStatusFilter(query CreditNoteQuery, status string) CreditNoteQuery {
+	if status == "" {
+		return query.Where(creditnote.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(creditnote.Status(status))
+}
+
+func (o CreditNoteQueryOptions) ApplySortFilter(query CreditNoteQuery, field string, order string) CreditNoteQuery {
+	orderFunc := ent.Desc
+	if order == "asc" {
+		orderFunc = ent.Asc
+	}
+	return query.Order(orderFunc(o.GetFieldName(field)))
+}
+
+func (o CreditNoteQueryOptions) ApplyPaginationFilter(query CreditNoteQuery, limit int, offset int) CreditNoteQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o CreditNoteQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return creditnote.FieldCreatedAt
+	case "updated_at":
+		return creditnote.FieldUpdatedAt
+	case "credit_note_number":
+		return creditnote.FieldCreditNoteNumber
+	case "total_amount":
+		return creditnote.FieldTotalAmount
+	default:
+		return field
+	}
+}
+
+func (o CreditNoteQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.CreditNoteFilter, query CreditNoteQuery) CreditNoteQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.CreditNoteIDs) > 0 {
+		query = query.Where(creditnote.IDIn(f.CreditNoteIDs...))
+	}
+
+	if f.InvoiceID != "" {
+		query = query.Where(creditnote.InvoiceID(f.InvoiceID))
+	}
+
+	if f.CustomerID != "" {
+		query = query.Where(creditnote.CustomerID(f.CustomerID))
+	}
+
+	if f.Outcome != "" {
+		query = query.Where(creditnote.Outcome(string(f.Outcome)))
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(creditnote.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(creditnote.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
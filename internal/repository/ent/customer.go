@@ -53,6 +53,9 @@ func (r *customerRepository) Create(ctx context.Context, c *domainCustomer.Custo
 		SetAddressState(c.AddressState).
 		SetAddressPostalCode(c.AddressPostalCode).
 		SetAddressCountry(c.AddressCountry).
+		SetTaxIds(c.ToEntTaxIDs()).
+		SetTaxExempt(c.TaxExempt).
+		SetAutoApplyCredits(c.AutoApplyCredits).
 		SetMetadata(c.Metadata).
 		SetStatus(string(c.Status)).
 		SetCreatedAt(c.CreatedAt).
@@ -214,6 +217,9 @@ func (r *customerRepository) Update(ctx context.Context, c *domainCustomer.Custo
 		SetAddressState(c.AddressState).
 		SetAddressPostalCode(c.AddressPostalCode).
 		SetAddressCountry(c.AddressCountry).
+		SetTaxIds(c.ToEntTaxIDs()).
+		SetTaxExempt(c.TaxExempt).
+		SetAutoApplyCredits(c.AutoApplyCredits).
 		SetMetadata(c.Metadata).
 		SetUpdatedAt(time.Now().UTC()).
 		SetUpdatedBy(types.GetUserID(ctx)).
@@ -360,5 +366,15 @@ func (o CustomerQueryOptions) applyEntityQueryOptions(_ context.Context, f *type
 		query = query.Where(customer.IDIn(f.CustomerIDs...))
 	}
 
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(customer.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(customer.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
 	return query
 }
@@ -0,0 +1,102 @@
+package ent
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/dunningattempt"
+	domainDunning "github.com/flexprice/flexprice/internal/domain/dunning"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type dunningAttemptRepository struct {
+	client postgres.IClient
+	log    *logger.Logger
+}
+
+func NewDunningAttemptRepository(client postgres.IClient, log *logger.Logger) domainDunning.Repository {
+	return &dunningAttemptRepository{
+		client: client,
+		log:    log,
+	}
+}
+
+func (r *dunningAttemptRepository) Create(ctx context.Context, a *domainDunning.Attempt) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating dunning attempt",
+		"dunning_attempt_id", a.ID,
+		"invoice_id", a.InvoiceID,
+		"attempt_number", a.AttemptNumber,
+	)
+
+	// Set environment ID from context if not already set
+	if a.EnvironmentID == "" {
+		a.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	attempt, err := client.DunningAttempt.Create().
+		SetID(a.ID).
+		SetTenantID(a.TenantID).
+		SetInvoiceID(a.InvoiceID).
+		SetNillableSubscriptionID(a.SubscriptionID).
+		SetAttemptNumber(a.AttemptNumber).
+		SetAttemptStatus(string(a.AttemptStatus)).
+		SetAmountPaid(a.AmountPaid).
+		SetAmountRemaining(a.AmountRemaining).
+		SetNillableErrorMessage(a.ErrorMessage).
+		SetAttemptedAt(a.AttemptedAt).
+		SetEnvironmentID(a.EnvironmentID).
+		SetStatus(string(a.Status)).
+		SetCreatedAt(a.CreatedAt).
+		SetUpdatedAt(a.UpdatedAt).
+		SetCreatedBy(a.CreatedBy).
+		SetUpdatedBy(a.UpdatedBy).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("This dunning attempt was already made").
+				WithReportableDetails(map[string]any{
+					"invoice_id":     a.InvoiceID,
+					"attempt_number": a.AttemptNumber,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create dunning attempt").
+			Mark(ierr.ErrDatabase)
+	}
+
+	*a = *domainDunning.FromEnt(attempt)
+	return nil
+}
+
+func (r *dunningAttemptRepository) ListByInvoice(ctx context.Context, invoiceID string) ([]*domainDunning.Attempt, error) {
+	client := r.client.Querier(ctx)
+
+	query := client.DunningAttempt.Query().
+		Where(
+			dunningattempt.TenantID(types.GetTenantID(ctx)),
+			dunningattempt.InvoiceID(invoiceID),
+			dunningattempt.Status(string(types.StatusPublished)),
+		)
+
+	if environmentID := types.GetEnvironmentID(ctx); environmentID != "" {
+		query = query.Where(dunningattempt.EnvironmentID(environmentID))
+	}
+
+	attempts, err := query.
+		Order(ent.Asc(dunningattempt.FieldAttemptNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list dunning attempts").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainDunning.FromEntList(attempts), nil
+}
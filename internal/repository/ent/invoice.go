@@ -14,6 +14,7 @@ import (
 	"github.com/flexprice/flexprice/internal/postgres"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 type invoiceRepository struct {
@@ -48,9 +49,16 @@ func (r *invoiceRepository) Create(ctx context.Context, inv *domainInvoice.Invoi
 		SetInvoiceStatus(string(inv.InvoiceStatus)).
 		SetPaymentStatus(string(inv.PaymentStatus)).
 		SetCurrency(inv.Currency).
+		SetSubtotal(inv.Subtotal).
+		SetTotalDiscount(inv.TotalDiscount).
+		SetTotal(inv.Total).
+		SetDiscounts(inv.ToEntDiscounts()).
+		SetTotalTax(inv.TotalTax).
+		SetTaxes(inv.ToEntTaxes()).
 		SetAmountDue(inv.AmountDue).
 		SetAmountPaid(inv.AmountPaid).
 		SetAmountRemaining(inv.AmountRemaining).
+		SetAmountCredited(inv.AmountCredited).
 		SetIdempotencyKey(lo.FromPtr(inv.IdempotencyKey)).
 		SetInvoiceNumber(lo.FromPtr(inv.InvoiceNumber)).
 		SetBillingSequence(lo.FromPtr(inv.BillingSequence)).
@@ -105,9 +113,16 @@ func (r *invoiceRepository) CreateWithLineItems(ctx context.Context, inv *domain
 			SetInvoiceStatus(string(inv.InvoiceStatus)).
 			SetPaymentStatus(string(inv.PaymentStatus)).
 			SetCurrency(inv.Currency).
+			SetSubtotal(inv.Subtotal).
+			SetTotalDiscount(inv.TotalDiscount).
+			SetTotal(inv.Total).
+			SetDiscounts(inv.ToEntDiscounts()).
+			SetTotalTax(inv.TotalTax).
+			SetTaxes(inv.ToEntTaxes()).
 			SetAmountDue(inv.AmountDue).
 			SetAmountPaid(inv.AmountPaid).
 			SetAmountRemaining(inv.AmountRemaining).
+			SetAmountCredited(inv.AmountCredited).
 			SetIdempotencyKey(lo.FromPtr(inv.IdempotencyKey)).
 			SetInvoiceNumber(lo.FromPtr(inv.InvoiceNumber)).
 			SetBillingSequence(lo.FromPtr(inv.BillingSequence)).
@@ -157,6 +172,7 @@ func (r *invoiceRepository) CreateWithLineItems(ctx context.Context, inv *domain
 					SetCurrency(item.Currency).
 					SetNillablePeriodStart(item.PeriodStart).
 					SetNillablePeriodEnd(item.PeriodEnd).
+					SetTierBreakdown(item.ToEntTierBreakdown()).
 					SetMetadata(item.Metadata).
 					SetEnvironmentID(item.EnvironmentID).
 					SetStatus(string(item.Status)).
@@ -211,6 +227,7 @@ func (r *invoiceRepository) AddLineItems(ctx context.Context, invoiceID string,
 				SetCurrency(item.Currency).
 				SetNillablePeriodStart(item.PeriodStart).
 				SetNillablePeriodEnd(item.PeriodEnd).
+				SetTierBreakdown(item.ToEntTierBreakdown()).
 				SetMetadata(item.Metadata).
 				SetStatus(string(item.Status)).
 				SetCreatedBy(item.CreatedBy).
@@ -300,6 +317,7 @@ func (r *invoiceRepository) Update(ctx context.Context, inv *domainInvoice.Invoi
 		SetAmountDue(inv.AmountDue).
 		SetAmountPaid(inv.AmountPaid).
 		SetAmountRemaining(inv.AmountRemaining).
+		SetAmountCredited(inv.AmountCredited).
 		SetDescription(inv.Description).
 		SetNillableDueDate(inv.DueDate).
 		SetNillablePaidAt(inv.PaidAt).
@@ -504,6 +522,55 @@ func (r *invoiceRepository) GetNextInvoiceNumber(ctx context.Context) (string, e
 	return fmt.Sprintf("INV-%s-%05d", yearMonth, lastValue), nil
 }
 
+// IncrementAmountCredited adds the amount to the credited amount of the invoice in a single
+// conditional update, so concurrent credit notes cannot credit more than the limit. The row stays
+// locked until the transaction ends. It returns false if the limit would be exceeded.
+func (r *invoiceRepository) IncrementAmountCredited(ctx context.Context, id string, amount, limit decimal.Decimal) (bool, error) {
+	r.logger.Debugw("incrementing invoice amount credited",
+		"invoice_id", id,
+		"amount", amount,
+		"limit", limit,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	// Use raw SQL as ent cannot add to decimal fields
+	query := `
+		UPDATE invoices
+		SET amount_credited = amount_credited + $1,
+			updated_at = $2,
+			updated_by = $3
+		WHERE id = $4
+			AND tenant_id = $5
+			AND environment_id = $6
+			AND status = $7
+			AND amount_credited + $1 <= $8`
+
+	result, err := r.client.Querier(ctx).ExecContext(ctx, query,
+		amount,
+		time.Now().UTC(),
+		types.GetUserID(ctx),
+		id,
+		types.GetTenantID(ctx),
+		types.GetEnvironmentID(ctx),
+		string(types.StatusPublished),
+		limit,
+	)
+	if err != nil {
+		return false, ierr.WithError(err).
+			WithHintf("Failed to credit invoice with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, ierr.WithError(err).
+			WithHintf("Failed to credit invoice with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return n > 0, nil
+}
+
 func (r *invoiceRepository) GetNextBillingSequence(ctx context.Context, subscriptionID string) (int, error) {
 	tenantID := types.GetTenantID(ctx)
 	// Use raw SQL for atomic increment since ent doesn't support RETURNING with OnConflict
@@ -0,0 +1,171 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/outboxevent"
+	domainOutbox "github.com/flexprice/flexprice/internal/domain/outbox"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type outboxRepository struct {
+	client postgres.IClient
+	log    *logger.Logger
+}
+
+func NewOutboxRepository(client postgres.IClient, log *logger.Logger) domainOutbox.Repository {
+	return &outboxRepository{
+		client: client,
+		log:    log,
+	}
+}
+
+// Create writes the event with the transaction in the context, if any, so that the event is only
+// relayed once the state change it reports is committed
+func (r *outboxRepository) Create(ctx context.Context, e *domainOutbox.Event) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating outbox event",
+		"outbox_event_id", e.ID,
+		"topic", e.Topic,
+		"message_id", e.MessageID,
+		"tenant_id", e.TenantID,
+	)
+
+	// Set environment ID from context if not already set
+	if e.EnvironmentID == "" {
+		e.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	result, err := client.OutboxEvent.Create().
+		SetID(e.ID).
+		SetTopic(e.Topic).
+		SetMessageID(e.MessageID).
+		SetPayload(e.Payload).
+		SetMetadata(e.Metadata).
+		SetDeliveryStatus(string(e.DeliveryStatus)).
+		SetAttempts(e.Attempts).
+		SetNillableLastError(e.LastError).
+		SetNextAttemptAt(e.NextAttemptAt).
+		SetNillableDeliveredAt(e.DeliveredAt).
+		SetStatus(string(e.Status)).
+		SetTenantID(e.TenantID).
+		SetCreatedAt(e.CreatedAt).
+		SetUpdatedAt(e.UpdatedAt).
+		SetCreatedBy(e.CreatedBy).
+		SetUpdatedBy(e.UpdatedBy).
+		SetEnvironmentID(e.EnvironmentID).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create outbox event").
+			Mark(ierr.ErrDatabase)
+	}
+
+	*e = *domainOutbox.FromEnt(result)
+	return nil
+}
+
+func (r *outboxRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*domainOutbox.Event, error) {
+	client := r.client.Querier(ctx)
+
+	events, err := client.OutboxEvent.Query().
+		Where(
+			outboxevent.DeliveryStatus(string(types.OutboxEventStatusPending)),
+			outboxevent.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(outboxevent.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list due outbox events").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainOutbox.FromEntList(events), nil
+}
+
+func (r *outboxRepository) Claim(ctx context.Context, e *domainOutbox.Event, until time.Time) (bool, error) {
+	client := r.client.Querier(ctx)
+
+	// The update only matches while the event is as it was listed
+	n, err := client.OutboxEvent.Update().
+		Where(
+			outboxevent.ID(e.ID),
+			outboxevent.DeliveryStatus(string(types.OutboxEventStatusPending)),
+			outboxevent.NextAttemptAt(e.NextAttemptAt),
+		).
+		SetNextAttemptAt(until).
+		SetUpdatedAt(time.Now().UTC()).
+		Save(ctx)
+
+	if err != nil {
+		return false, ierr.WithError(err).
+			WithHintf("Failed to claim outbox event with ID %s", e.ID).
+			Mark(ierr.ErrDatabase)
+	}
+
+	if n == 0 {
+		return false, nil
+	}
+
+	e.NextAttemptAt = until
+	return true, nil
+}
+
+func (r *outboxRepository) MarkDelivered(ctx context.Context, id string, deliveredAt time.Time) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("marking outbox event delivered",
+		"outbox_event_id", id,
+	)
+
+	_, err := client.OutboxEvent.Update().
+		Where(outboxevent.ID(id)).
+		SetDeliveryStatus(string(types.OutboxEventStatusDelivered)).
+		SetDeliveredAt(deliveredAt).
+		AddAttempts(1).
+		SetUpdatedAt(time.Now().UTC()).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to update outbox event with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("marking outbox event failed",
+		"outbox_event_id", id,
+		"next_attempt_at", nextAttemptAt,
+	)
+
+	_, err := client.OutboxEvent.Update().
+		Where(outboxevent.ID(id)).
+		SetLastError(lastError).
+		SetNextAttemptAt(nextAttemptAt).
+		AddAttempts(1).
+		SetUpdatedAt(time.Now().UTC()).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to update outbox event with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/ent/migrate"
+	domainOutbox "github.com/flexprice/flexprice/internal/domain/outbox"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutboxRepositorySuite struct {
+	testutil.BaseServiceTestSuite
+	client postgres.IClient
+	repo   domainOutbox.Repository
+	now    time.Time
+}
+
+func TestOutboxRepository(t *testing.T) {
+	suite.Run(t, new(OutboxRepositorySuite))
+}
+
+func (s *OutboxRepositorySuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.client = testutil.NewSQLiteClient(s.T(), s.GetLogger(), migrate.OutboxEventsTable)
+	s.repo = NewOutboxRepository(s.client, s.GetLogger())
+	s.now = time.Now().UTC().Truncate(time.Second)
+}
+
+func (s *OutboxRepositorySuite) createEvent(ctx context.Context, nextAttemptAt time.Time) *domainOutbox.Event {
+	e := &domainOutbox.Event{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_OUTBOX_EVENT),
+		Topic:          "webhooks",
+		MessageID:      types.GenerateUUID(),
+		Payload:        `{"event_name":"invoice.update.finalized"}`,
+		Metadata:       map[string]string{"tenant_id": types.GetTenantID(ctx)},
+		DeliveryStatus: types.OutboxEventStatusPending,
+		NextAttemptAt:  nextAttemptAt,
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+	s.Require().NoError(s.repo.Create(ctx, e))
+	return e
+}
+
+func (s *OutboxRepositorySuite) get(id string) *domainOutbox.Event {
+	e, err := s.client.Querier(s.GetContext()).OutboxEvent.Get(s.GetContext(), id)
+	s.Require().NoError(err)
+	return domainOutbox.FromEnt(e)
+}
+
+func (s *OutboxRepositorySuite) listDueIDs(now time.Time) []string {
+	events, err := s.repo.ListDue(s.GetContext(), now, 10)
+	s.Require().NoError(err)
+	return lo.Map(events, func(e *domainOutbox.Event, _ int) string { return e.ID })
+}
+
+func (s *OutboxRepositorySuite) TestListDue() {
+	first := s.createEvent(s.GetContext(), s.now.Add(-time.Minute))
+	second := s.createEvent(s.GetContext(), s.now)
+	s.createEvent(s.GetContext(), s.now.Add(time.Minute))
+
+	s.Equal([]string{first.ID, second.ID}, s.listDueIDs(s.now))
+
+	events, err := s.repo.ListDue(s.GetContext(), s.now, 1)
+	s.NoError(err)
+	s.Len(events, 1)
+}
+
+func (s *OutboxRepositorySuite) TestCreateRolledBack() {
+	err := s.client.WithTx(s.GetContext(), func(tx context.Context) error {
+		s.createEvent(tx, s.now)
+		return errors.New("state change failed")
+	})
+	s.Error(err)
+	s.Empty(s.listDueIDs(s.now))
+
+	err = s.client.WithTx(s.GetContext(), func(tx context.Context) error {
+		s.createEvent(tx, s.now)
+		return nil
+	})
+	s.NoError(err)
+	s.Len(s.listDueIDs(s.now), 1)
+}
+
+func (s *OutboxRepositorySuite) TestClaim() {
+	s.createEvent(s.GetContext(), s.now)
+
+	listed, err := s.repo.ListDue(s.GetContext(), s.now, 10)
+	s.Require().NoError(err)
+	s.Require().Len(listed, 1)
+
+	// Another relay listed the event at the same time
+	stale := *listed[0]
+
+	lease := s.now.Add(30 * time.Second)
+	claimed, err := s.repo.Claim(s.GetContext(), listed[0], lease)
+	s.NoError(err)
+	s.True(claimed)
+
+	claimed, err = s.repo.Claim(s.GetContext(), &stale, lease)
+	s.NoError(err)
+	s.False(claimed)
+
+	// The event is only due again once the lease expires
+	s.Empty(s.listDueIDs(s.now))
+	s.Equal([]string{listed[0].ID}, s.listDueIDs(lease))
+
+	claimed, err = s.repo.Claim(s.GetContext(), listed[0], lease.Add(30*time.Second))
+	s.NoError(err)
+	s.True(claimed)
+}
+
+func (s *OutboxRepositorySuite) TestMarkDelivered() {
+	e := s.createEvent(s.GetContext(), s.now)
+
+	s.NoError(s.repo.MarkDelivered(s.GetContext(), e.ID, s.now))
+
+	stored := s.get(e.ID)
+	s.Equal(types.OutboxEventStatusDelivered, stored.DeliveryStatus)
+	s.Equal(1, stored.Attempts)
+	s.NotNil(stored.DeliveredAt)
+	s.Empty(s.listDueIDs(s.now.Add(time.Hour)))
+}
+
+func (s *OutboxRepositorySuite) TestMarkFailed() {
+	e := s.createEvent(s.GetContext(), s.now)
+
+	nextAttemptAt := s.now.Add(2 * time.Second)
+	s.NoError(s.repo.MarkFailed(s.GetContext(), e.ID, "pubsub unavailable", nextAttemptAt))
+
+	stored := s.get(e.ID)
+	s.Equal(types.OutboxEventStatusPending, stored.DeliveryStatus)
+	s.Equal(1, stored.Attempts)
+	s.Equal("pubsub unavailable", lo.FromPtr(stored.LastError))
+	s.Nil(stored.DeliveredAt)
+
+	s.Empty(s.listDueIDs(s.now))
+	s.Equal([]string{e.ID}, s.listDueIDs(nextAttemptAt))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/postgres"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 type planRepository struct {
@@ -47,6 +48,9 @@ func (r *planRepository) Create(ctx context.Context, p *domainPlan.Plan) error {
 		SetName(p.Name).
 		SetDescription(p.Description).
 		SetLookupKey(p.LookupKey).
+		SetNillableVersion(lo.EmptyableToPtr(p.Version)).
+		SetNillableCommitmentAmount(p.CommitmentAmount).
+		SetCommitmentInterval(string(p.CommitmentInterval)).
 		SetTenantID(p.TenantID).
 		SetStatus(string(p.Status)).
 		SetCreatedAt(p.CreatedAt).
@@ -204,7 +208,7 @@ func (r *planRepository) Update(ctx context.Context, p *domainPlan.Plan) error {
 		"tenant_id", p.TenantID,
 	)
 
-	_, err := client.Plan.Update().
+	query := client.Plan.Update().
 		Where(
 			plan.ID(p.ID),
 			plan.TenantID(p.TenantID),
@@ -212,9 +216,18 @@ func (r *planRepository) Update(ctx context.Context, p *domainPlan.Plan) error {
 		SetLookupKey(p.LookupKey).
 		SetName(p.Name).
 		SetDescription(p.Description).
+		SetNillableVersion(lo.EmptyableToPtr(p.Version)).
+		SetCommitmentInterval(string(p.CommitmentInterval)).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if p.CommitmentAmount != nil {
+		query.SetCommitmentAmount(*p.CommitmentAmount)
+	} else {
+		query.ClearCommitmentAmount()
+	}
+
+	_, err := query.Save(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -52,6 +52,9 @@ func (r *priceRepository) Create(ctx context.Context, p *domainPrice.Price) erro
 		SetCurrency(p.Currency).
 		SetDisplayAmount(p.DisplayAmount).
 		SetPlanID(p.PlanID).
+		SetNillablePlanVersion(lo.EmptyableToPtr(p.PlanVersion)).
+		SetNillableSubscriptionID(p.SubscriptionID).
+		SetNillableParentPriceID(p.ParentPriceID).
 		SetType(string(p.Type)).
 		SetBillingPeriod(string(p.BillingPeriod)).
 		SetBillingPeriodCount(p.BillingPeriodCount).
@@ -64,6 +67,7 @@ func (r *priceRepository) Create(ctx context.Context, p *domainPrice.Price) erro
 		SetNillableTierMode(lo.ToPtr(string(p.TierMode))).
 		SetTiers(p.ToEntTiers()).
 		SetTransformQuantity(schema.TransformQuantity(p.TransformQuantity)).
+		SetNillableMinimumCharge(p.MinimumCharge).
 		SetLookupKey(p.LookupKey).
 		SetDescription(p.Description).
 		SetMetadata(map[string]string(p.Metadata)).
@@ -212,6 +216,7 @@ func (r *priceRepository) Update(ctx context.Context, p *domainPrice.Price) erro
 		SetNillableTierMode(lo.ToPtr(string(p.TierMode))).
 		SetTiers(p.ToEntTiers()).
 		SetTransformQuantity(schema.TransformQuantity(p.TransformQuantity)).
+		SetNillableMinimumCharge(p.MinimumCharge).
 		SetLookupKey(p.LookupKey).
 		SetDescription(p.Description).
 		SetMetadata(map[string]string(p.Metadata)).
@@ -293,6 +298,9 @@ func (r *priceRepository) CreateBulk(ctx context.Context, prices []*domainPrice.
 			SetCurrency(p.Currency).
 			SetDisplayAmount(p.DisplayAmount).
 			SetPlanID(p.PlanID).
+			SetNillablePlanVersion(lo.EmptyableToPtr(p.PlanVersion)).
+			SetNillableSubscriptionID(p.SubscriptionID).
+			SetNillableParentPriceID(p.ParentPriceID).
 			SetType(string(p.Type)).
 			SetBillingPeriod(string(p.BillingPeriod)).
 			SetBillingPeriodCount(p.BillingPeriodCount).
@@ -305,6 +313,7 @@ func (r *priceRepository) CreateBulk(ctx context.Context, prices []*domainPrice.
 			SetNillableTierMode(lo.ToPtr(string(p.TierMode))).
 			SetTiers(p.ToEntTiers()).
 			SetTransformQuantity(schema.TransformQuantity(p.TransformQuantity)).
+			SetNillableMinimumCharge(p.MinimumCharge).
 			SetLookupKey(p.LookupKey).
 			SetDescription(p.Description).
 			SetMetadata(map[string]string(p.Metadata)).
@@ -423,6 +432,13 @@ func (o PriceQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.P
 		query = query.Where(price.IDIn(f.PriceIDs...))
 	}
 
+	// Prices scoped to a subscription are only listed by subscription or by ID
+	if len(f.SubscriptionIDs) > 0 {
+		query = query.Where(price.SubscriptionIDIn(f.SubscriptionIDs...))
+	} else if len(f.PriceIDs) == 0 {
+		query = query.Where(price.SubscriptionIDIsNil())
+	}
+
 	// Apply time range filters if specified
 	if f.TimeRangeFilter != nil {
 		if f.StartTime != nil {
@@ -7,12 +7,15 @@ import (
 
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/ent/subscription"
+	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
+	"github.com/flexprice/flexprice/ent/subscriptionquantitychange"
 	domainSub "github.com/flexprice/flexprice/internal/domain/subscription"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/postgres"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 type subscriptionRepository struct {
@@ -43,6 +46,8 @@ func (r *subscriptionRepository) Create(ctx context.Context, sub *domainSub.Subs
 		SetLookupKey(sub.LookupKey).
 		SetCustomerID(sub.CustomerID).
 		SetPlanID(sub.PlanID).
+		SetNillablePlanVersion(lo.EmptyableToPtr(sub.PlanVersion)).
+		SetNillablePendingPlanVersion(sub.PendingPlanVersion).
 		SetSubscriptionStatus(string(sub.SubscriptionStatus)).
 		SetCurrency(sub.Currency).
 		SetBillingAnchor(sub.BillingAnchor).
@@ -55,6 +60,12 @@ func (r *subscriptionRepository) Create(ctx context.Context, sub *domainSub.Subs
 		SetCancelAtPeriodEnd(sub.CancelAtPeriodEnd).
 		SetNillableTrialStart(sub.TrialStart).
 		SetNillableTrialEnd(sub.TrialEnd).
+		SetTrialRequiresPaymentMethod(sub.TrialRequiresPaymentMethod).
+		SetNillableTrialWillEndNotifiedAt(sub.TrialWillEndNotifiedAt).
+		SetNillablePaymentMethodID(sub.PaymentMethodID).
+		SetNillableCommitmentAmount(sub.CommitmentAmount).
+		SetCommitmentInterval(string(sub.CommitmentInterval)).
+		SetNillableAutoApplyCredits(sub.AutoApplyCredits).
 		SetBillingCadence(string(sub.BillingCadence)).
 		SetBillingPeriod(string(sub.BillingPeriod)).
 		SetBillingPeriodCount(sub.BillingPeriodCount).
@@ -114,6 +125,8 @@ func (r *subscriptionRepository) Update(ctx context.Context, sub *domainSub.Subs
 	// Set all fields
 	query.
 		SetLookupKey(sub.LookupKey).
+		SetPlanID(sub.PlanID).
+		SetNillablePlanVersion(lo.EmptyableToPtr(sub.PlanVersion)).
 		SetSubscriptionStatus(string(sub.SubscriptionStatus)).
 		SetCurrentPeriodStart(sub.CurrentPeriodStart).
 		SetCurrentPeriodEnd(sub.CurrentPeriodEnd).
@@ -121,6 +134,8 @@ func (r *subscriptionRepository) Update(ctx context.Context, sub *domainSub.Subs
 		SetNillableCancelAt(sub.CancelAt).
 		SetPauseStatus(string(sub.PauseStatus)).
 		SetCancelAtPeriodEnd(sub.CancelAtPeriodEnd).
+		SetTrialRequiresPaymentMethod(sub.TrialRequiresPaymentMethod).
+		SetNillableTrialWillEndNotifiedAt(sub.TrialWillEndNotifiedAt).
 		SetUpdatedAt(now).
 		SetUpdatedBy(types.GetUserID(ctx)).
 		AddVersion(1) // Increment version atomically
@@ -131,6 +146,31 @@ func (r *subscriptionRepository) Update(ctx context.Context, sub *domainSub.Subs
 		query.ClearActivePauseID()
 	}
 
+	if sub.PendingPlanVersion != nil {
+		query.SetPendingPlanVersion(*sub.PendingPlanVersion)
+	} else {
+		query.ClearPendingPlanVersion()
+	}
+
+	if sub.PaymentMethodID != nil {
+		query.SetPaymentMethodID(*sub.PaymentMethodID)
+	} else {
+		query.ClearPaymentMethodID()
+	}
+
+	if sub.CommitmentAmount != nil {
+		query.SetCommitmentAmount(*sub.CommitmentAmount)
+	} else {
+		query.ClearCommitmentAmount()
+	}
+	query.SetCommitmentInterval(string(sub.CommitmentInterval))
+
+	if sub.AutoApplyCredits != nil {
+		query.SetAutoApplyCredits(*sub.AutoApplyCredits)
+	} else {
+		query.ClearAutoApplyCredits()
+	}
+
 	// Execute update
 	n, err := query.Save(ctx)
 	if err != nil {
@@ -172,6 +212,31 @@ func (r *subscriptionRepository) Update(ctx context.Context, sub *domainSub.Subs
 	return nil
 }
 
+// MarkTrialWillEndNotified records that the trial will end webhook was sent unless it already was.
+// It returns false if the subscription was already marked, so only one caller sends the webhook.
+func (r *subscriptionRepository) MarkTrialWillEndNotified(ctx context.Context, id string, notifiedAt time.Time) (bool, error) {
+	client := r.client.Querier(ctx)
+
+	n, err := client.Subscription.Update().
+		Where(
+			subscription.ID(id),
+			subscription.TenantID(types.GetTenantID(ctx)),
+			subscription.TrialWillEndNotifiedAtIsNil(),
+		).
+		SetTrialWillEndNotifiedAt(notifiedAt).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		return false, ierr.WithError(err).
+			WithHintf("Failed to mark trial will end notified for subscription %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return n > 0, nil
+}
+
 func (r *subscriptionRepository) Delete(ctx context.Context, id string) error {
 	client := r.client.Querier(ctx)
 	err := client.Subscription.UpdateOneID(id).
@@ -215,7 +280,7 @@ func (r *subscriptionRepository) List(ctx context.Context, filter *types.Subscri
 	client := r.client.Querier(ctx)
 	query := client.Subscription.Query()
 	if filter.WithLineItems {
-		query = query.WithLineItems()
+		query = query.WithLineItems(publishedLineItems)
 	}
 
 	// Apply entity-specific filters
@@ -431,6 +496,23 @@ func (o *SubscriptionQueryOptions) applyEntityQueryOptions(_ context.Context, f
 		)
 	}
 
+	// Apply trial end filter
+	if f.TrialEndBefore != nil {
+		query = query.Where(subscription.TrialEndLTE(*f.TrialEndBefore))
+	}
+	if f.TrialEndAfter != nil {
+		query = query.Where(subscription.TrialEndGT(*f.TrialEndAfter))
+	}
+
+	// Apply trial will end notified filter
+	if f.TrialWillEndNotified != nil {
+		if *f.TrialWillEndNotified {
+			query = query.Where(subscription.TrialWillEndNotifiedAtNotNil())
+		} else {
+			query = query.Where(subscription.TrialWillEndNotifiedAtIsNil())
+		}
+	}
+
 	// Apply time range filters
 	if f.TimeRangeFilter != nil {
 		if f.TimeRangeFilter.StartTime != nil {
@@ -452,51 +534,100 @@ func (r *subscriptionRepository) CreateWithLineItems(ctx context.Context, sub *d
 			return fmt.Errorf("failed to create subscription: %w", err)
 		}
 
-		// Create line items
-		client := r.client.Querier(ctx)
-		bulk := make([]*ent.SubscriptionLineItemCreate, len(items))
-		for i, item := range items {
-			// Set environment ID from context if not already set
-			if item.EnvironmentID == "" {
-				item.EnvironmentID = types.GetEnvironmentID(ctx)
-			}
+		return r.createLineItems(ctx, items)
+	})
+}
 
-			bulk[i] = client.SubscriptionLineItem.Create().
-				SetID(item.ID).
-				SetSubscriptionID(item.SubscriptionID).
-				SetCustomerID(item.CustomerID).
-				SetNillablePlanID(types.ToNillableString(item.PlanID)).
-				SetNillablePlanDisplayName(types.ToNillableString(item.PlanDisplayName)).
-				SetPriceID(item.PriceID).
-				SetNillablePriceType(types.ToNillableString(string(item.PriceType))).
-				SetNillableMeterID(types.ToNillableString(item.MeterID)).
-				SetNillableMeterDisplayName(types.ToNillableString(item.MeterDisplayName)).
-				SetNillableDisplayName(types.ToNillableString(item.DisplayName)).
-				SetQuantity(item.Quantity).
-				SetCurrency(item.Currency).
-				SetBillingPeriod(string(item.BillingPeriod)).
-				SetNillableStartDate(types.ToNillableTime(item.StartDate)).
-				SetNillableEndDate(types.ToNillableTime(item.EndDate)).
-				SetInvoiceCadence(string(item.InvoiceCadence)).
-				SetTrialPeriod(item.TrialPeriod).
-				SetMetadata(item.Metadata).
-				SetTenantID(item.TenantID).
-				SetEnvironmentID(item.EnvironmentID).
-				SetStatus(string(item.Status)).
-				SetCreatedBy(item.CreatedBy).
-				SetUpdatedBy(item.UpdatedBy).
-				SetCreatedAt(time.Now()).
-				SetUpdatedAt(time.Now())
+// ReplaceLineItems updates the subscription, ends the given line items and creates the new line items
+func (r *subscriptionRepository) ReplaceLineItems(ctx context.Context, sub *domainSub.Subscription, endedItems, newItems []*domainSub.SubscriptionLineItem) error {
+	return r.client.WithTx(ctx, func(ctx context.Context) error {
+		if err := r.Update(ctx, sub); err != nil {
+			return err
 		}
 
-		if err := client.SubscriptionLineItem.CreateBulk(bulk...).Exec(ctx); err != nil {
-			return fmt.Errorf("failed to create subscription line items: %w", err)
+		client := r.client.Querier(ctx)
+		for _, item := range endedItems {
+			_, err := client.SubscriptionLineItem.Update().
+				Where(
+					subscriptionlineitem.ID(item.ID),
+					subscriptionlineitem.SubscriptionID(sub.ID),
+					subscriptionlineitem.TenantID(types.GetTenantID(ctx)),
+				).
+				SetNillableEndDate(types.ToNillableTime(item.EndDate)).
+				SetStatus(string(types.StatusArchived)).
+				SetUpdatedAt(time.Now().UTC()).
+				SetUpdatedBy(types.GetUserID(ctx)).
+				Save(ctx)
+			if err != nil {
+				return ierr.WithError(err).
+					WithHint("Failed to end subscription line item").
+					WithReportableDetails(map[string]interface{}{
+						"subscription_id": sub.ID,
+						"line_item_id":    item.ID,
+					}).
+					Mark(ierr.ErrDatabase)
+			}
+			item.Status = types.StatusArchived
 		}
 
-		return nil
+		return r.createLineItems(ctx, newItems)
 	})
 }
 
+func (r *subscriptionRepository) createLineItems(ctx context.Context, items []*domainSub.SubscriptionLineItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	client := r.client.Querier(ctx)
+	bulk := make([]*ent.SubscriptionLineItemCreate, len(items))
+	for i, item := range items {
+		// Set environment ID from context if not already set
+		if item.EnvironmentID == "" {
+			item.EnvironmentID = types.GetEnvironmentID(ctx)
+		}
+
+		bulk[i] = client.SubscriptionLineItem.Create().
+			SetID(item.ID).
+			SetSubscriptionID(item.SubscriptionID).
+			SetCustomerID(item.CustomerID).
+			SetNillablePlanID(types.ToNillableString(item.PlanID)).
+			SetNillablePlanDisplayName(types.ToNillableString(item.PlanDisplayName)).
+			SetPriceID(item.PriceID).
+			SetNillablePriceType(types.ToNillableString(string(item.PriceType))).
+			SetNillableMeterID(types.ToNillableString(item.MeterID)).
+			SetNillableMeterDisplayName(types.ToNillableString(item.MeterDisplayName)).
+			SetNillableDisplayName(types.ToNillableString(item.DisplayName)).
+			SetQuantity(item.Quantity).
+			SetCurrency(item.Currency).
+			SetBillingPeriod(string(item.BillingPeriod)).
+			SetNillableStartDate(types.ToNillableTime(item.StartDate)).
+			SetNillableEndDate(types.ToNillableTime(item.EndDate)).
+			SetInvoiceCadence(string(item.InvoiceCadence)).
+			SetTrialPeriod(item.TrialPeriod).
+			SetMetadata(item.Metadata).
+			SetTenantID(item.TenantID).
+			SetEnvironmentID(item.EnvironmentID).
+			SetStatus(string(item.Status)).
+			SetCreatedBy(item.CreatedBy).
+			SetUpdatedBy(item.UpdatedBy).
+			SetCreatedAt(time.Now()).
+			SetUpdatedAt(time.Now())
+	}
+
+	if err := client.SubscriptionLineItem.CreateBulk(bulk...).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create subscription line items: %w", err)
+	}
+
+	return nil
+}
+
+// publishedLineItems loads only the line items that are in effect, line items replaced
+// by a plan change are archived
+func publishedLineItems(q *ent.SubscriptionLineItemQuery) {
+	q.Where(subscriptionlineitem.Status(string(types.StatusPublished)))
+}
+
 func (r *subscriptionRepository) GetWithLineItems(ctx context.Context, id string) (*domainSub.Subscription, []*domainSub.SubscriptionLineItem, error) {
 	client := r.client.Querier(ctx)
 	sub, err := client.Subscription.Query().
@@ -505,7 +636,7 @@ func (r *subscriptionRepository) GetWithLineItems(ctx context.Context, id string
 			subscription.TenantID(types.GetTenantID(ctx)),
 			subscription.Status(string(types.StatusPublished)),
 		).
-		WithLineItems().
+		WithLineItems(publishedLineItems).
 		Only(ctx)
 
 	if err != nil {
@@ -692,6 +823,121 @@ func (r *subscriptionRepository) GetWithPauses(ctx context.Context, id string) (
 	return subscription, pauses, nil
 }
 
+// UpdateLineItemQuantity sets the quantity of a subscription line item and records the change
+func (r *subscriptionRepository) UpdateLineItemQuantity(ctx context.Context, item *domainSub.SubscriptionLineItem, change *domainSub.SubscriptionQuantityChange) error {
+	return r.client.WithTx(ctx, func(ctx context.Context) error {
+		client := r.client.Querier(ctx)
+
+		n, err := client.SubscriptionLineItem.Update().
+			Where(
+				subscriptionlineitem.ID(item.ID),
+				subscriptionlineitem.SubscriptionID(item.SubscriptionID),
+				subscriptionlineitem.TenantID(types.GetTenantID(ctx)),
+				subscriptionlineitem.EnvironmentID(types.GetEnvironmentID(ctx)),
+				subscriptionlineitem.Status(string(types.StatusPublished)),
+			).
+			SetQuantity(item.Quantity).
+			SetUpdatedAt(time.Now().UTC()).
+			SetUpdatedBy(types.GetUserID(ctx)).
+			Save(ctx)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to update subscription line item quantity").
+				WithReportableDetails(map[string]interface{}{
+					"subscription_id": item.SubscriptionID,
+					"line_item_id":    item.ID,
+				}).
+				Mark(ierr.ErrDatabase)
+		}
+		if n == 0 {
+			return ierr.NewError("subscription line item not found").
+				WithHintf("Subscription line item %s not found", item.ID).
+				Mark(ierr.ErrNotFound)
+		}
+
+		// Set environment ID from context if not already set
+		if change.EnvironmentID == "" {
+			change.EnvironmentID = types.GetEnvironmentID(ctx)
+		}
+
+		c, err := client.SubscriptionQuantityChange.Create().
+			SetID(change.ID).
+			SetTenantID(change.TenantID).
+			SetSubscriptionID(change.SubscriptionID).
+			SetSubscriptionLineItemID(change.SubscriptionLineItemID).
+			SetPriceID(change.PriceID).
+			SetPreviousQuantity(change.PreviousQuantity).
+			SetQuantity(change.Quantity).
+			SetEffectiveDate(change.EffectiveDate).
+			SetNillableCreditNoteID(change.CreditNoteID).
+			SetMetadata(change.Metadata).
+			SetStatus(string(change.Status)).
+			SetCreatedBy(change.CreatedBy).
+			SetUpdatedBy(change.UpdatedBy).
+			SetEnvironmentID(change.EnvironmentID).
+			Save(ctx)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to create subscription quantity change").
+				Mark(ierr.ErrDatabase)
+		}
+
+		*change = *domainSub.SubscriptionQuantityChangeFromEnt(c)
+		return nil
+	})
+}
+
+// UpdateQuantityChange updates the mutable fields of a subscription quantity change
+func (r *subscriptionRepository) UpdateQuantityChange(ctx context.Context, change *domainSub.SubscriptionQuantityChange) error {
+	client := r.client.Querier(ctx)
+
+	n, err := client.SubscriptionQuantityChange.Update().
+		Where(
+			subscriptionquantitychange.ID(change.ID),
+			subscriptionquantitychange.TenantID(types.GetTenantID(ctx)),
+			subscriptionquantitychange.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetNillableCreditNoteID(change.CreditNoteID).
+		SetMetadata(change.Metadata).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to update subscription quantity change").
+			Mark(ierr.ErrDatabase)
+	}
+	if n == 0 {
+		return ierr.NewError("subscription quantity change not found").
+			WithHintf("Subscription quantity change %s not found", change.ID).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return nil
+}
+
+// ListQuantityChanges lists the quantity changes of a subscription ordered by effective date
+func (r *subscriptionRepository) ListQuantityChanges(ctx context.Context, subscriptionID string) ([]*domainSub.SubscriptionQuantityChange, error) {
+	client := r.client.Querier(ctx)
+	changes, err := client.SubscriptionQuantityChange.Query().
+		Where(
+			subscriptionquantitychange.SubscriptionID(subscriptionID),
+			subscriptionquantitychange.TenantID(types.GetTenantID(ctx)),
+			subscriptionquantitychange.EnvironmentID(types.GetEnvironmentID(ctx)),
+			subscriptionquantitychange.Status(string(types.StatusPublished)),
+		).
+		Order(ent.Asc(subscriptionquantitychange.FieldEffectiveDate), ent.Asc(subscriptionquantitychange.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list subscription quantity changes").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainSub.SubscriptionQuantityChangeListFromEnt(changes), nil
+}
+
 // ListByCustomerID retrieves all active subscriptions for a customer and includes line items
 func (r *subscriptionRepository) ListByCustomerID(ctx context.Context, customerID string) ([]*domainSub.Subscription, error) {
 	r.logger.Debugw("listing subscriptions by customer ID",
@@ -724,7 +970,7 @@ func (r *subscriptionRepository) ListByIDs(ctx context.Context, subscriptionIDs
 	// we need to use a direct query instead of the List method
 	client := r.client.Querier(ctx)
 	query := client.Subscription.Query().
-		WithLineItems().
+		WithLineItems(publishedLineItems).
 		Where(
 			subscription.IDIn(subscriptionIDs...),
 			subscription.TenantID(types.GetTenantID(ctx)),
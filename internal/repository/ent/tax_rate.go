@@ -0,0 +1,293 @@
+package ent
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/taxrate"
+	domainTax "github.com/flexprice/flexprice/internal/domain/tax"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type taxRateRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts TaxRateQueryOptions
+}
+
+func NewTaxRateRepository(client postgres.IClient, log *logger.Logger) domainTax.Repository {
+	return &taxRateRepository{
+		client:    client,
+		log:       log,
+		queryOpts: TaxRateQueryOptions{},
+	}
+}
+
+func (r *taxRateRepository) Create(ctx context.Context, t *domainTax.TaxRate) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating tax rate",
+		"tax_rate_id", t.ID,
+		"tenant_id", t.TenantID,
+		"country", t.Country,
+		"state", t.State,
+	)
+
+	// Set environment ID from context if not already set
+	if t.EnvironmentID == "" {
+		t.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	result, err := client.TaxRate.Create().
+		SetID(t.ID).
+		SetName(t.Name).
+		SetDescription(t.Description).
+		SetPercentage(t.Percentage).
+		SetTaxBehavior(string(t.TaxBehavior)).
+		SetCountry(t.Country).
+		SetState(t.State).
+		SetMetadata(map[string]string(t.Metadata)).
+		SetStatus(string(t.Status)).
+		SetTenantID(t.TenantID).
+		SetCreatedAt(t.CreatedAt).
+		SetUpdatedAt(t.UpdatedAt).
+		SetCreatedBy(t.CreatedBy).
+		SetUpdatedBy(t.UpdatedBy).
+		SetEnvironmentID(t.EnvironmentID).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create tax rate").
+			Mark(ierr.ErrDatabase)
+	}
+
+	*t = *domainTax.FromEnt(result)
+	return nil
+}
+
+func (r *taxRateRepository) Get(ctx context.Context, id string) (*domainTax.TaxRate, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting tax rate",
+		"tax_rate_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	t, err := client.TaxRate.Query().
+		Where(
+			taxrate.ID(id),
+			taxrate.TenantID(types.GetTenantID(ctx)),
+			taxrate.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Tax rate with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"tax_rate_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get tax rate with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainTax.FromEnt(t), nil
+}
+
+func (r *taxRateRepository) List(ctx context.Context, filter *types.TaxRateFilter) ([]*domainTax.TaxRate, error) {
+	if filter == nil {
+		filter = types.NewDefaultTaxRateFilter()
+	}
+
+	client := r.client.Querier(ctx)
+	query := client.TaxRate.Query()
+
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	rates, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list tax rates").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainTax.FromEntList(rates), nil
+}
+
+func (r *taxRateRepository) Count(ctx context.Context, filter *types.TaxRateFilter) (int, error) {
+	client := r.client.Querier(ctx)
+	query := client.TaxRate.Query()
+
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count tax rates").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *taxRateRepository) Update(ctx context.Context, t *domainTax.TaxRate) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating tax rate",
+		"tax_rate_id", t.ID,
+		"tenant_id", t.TenantID,
+	)
+
+	_, err := client.TaxRate.Update().
+		Where(
+			taxrate.ID(t.ID),
+			taxrate.TenantID(t.TenantID),
+			taxrate.EnvironmentID(t.EnvironmentID),
+		).
+		SetName(t.Name).
+		SetDescription(t.Description).
+		SetMetadata(map[string]string(t.Metadata)).
+		SetStatus(string(t.Status)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to update tax rate with ID %s", t.ID).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *taxRateRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting tax rate",
+		"tax_rate_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	_, err := client.TaxRate.Update().
+		Where(
+			taxrate.ID(id),
+			taxrate.TenantID(types.GetTenantID(ctx)),
+			taxrate.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to delete tax rate with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// TaxRateQuery type alias for better readability
+type TaxRateQuery = *ent.TaxRateQuery
+
+// TaxRateQueryOptions implements BaseQueryOptions for tax rate queries
+type TaxRateQueryOptions struct{}
+
+func (o TaxRateQueryOptions) ApplyTenantFilter(ctx context.Context, query TaxRateQuery) TaxRateQuery {
+	return query.Where(taxrate.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o TaxRateQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query TaxRateQuery) TaxRateQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(taxrate.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o TaxRateQueryOptions) ApplyStatusFilter(query TaxRateQuery, status string) TaxRateQuery {
+	if status == "" {
+		return query.Where(taxrate.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(taxrate.Status(status))
+}
+
+func (o TaxRateQueryOptions) ApplySortFilter(query TaxRateQuery, field string, order string) TaxRateQuery {
+	orderFunc := ent.Desc
+	if order == "asc" {
+		orderFunc = ent.Asc
+	}
+	return query.Order(orderFunc(o.GetFieldName(field)))
+}
+
+func (o TaxRateQueryOptions) ApplyPaginationFilter(query TaxRateQuery, limit int, offset int) TaxRateQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o TaxRateQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return taxrate.FieldCreatedAt
+	case "updated_at":
+		return taxrate.FieldUpdatedAt
+	case "name":
+		return taxrate.FieldName
+	case "country":
+		return taxrate.FieldCountry
+	default:
+		return field
+	}
+}
+
+func (o TaxRateQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.TaxRateFilter, query TaxRateQuery) TaxRateQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.TaxRateIDs) > 0 {
+		query = query.Where(taxrate.IDIn(f.TaxRateIDs...))
+	}
+
+	if f.Country != "" {
+		query = query.Where(taxrate.CountryEqualFold(strings.ToUpper(f.Country)))
+	}
+
+	if f.State != "" {
+		query = query.Where(taxrate.StateEqualFold(f.State))
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(taxrate.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(taxrate.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
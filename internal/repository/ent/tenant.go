@@ -37,6 +37,7 @@ func (r *tenantRepository) Create(ctx context.Context, tenant *domainTenant.Tena
 		SetCreatedAt(tenant.CreatedAt).
 		SetUpdatedAt(tenant.UpdatedAt).
 		SetBillingDetails(tenant.BillingDetails.ToSchema()).
+		SetDunningPolicy(tenant.DunningPolicy.ToSchema()).
 		Save(ctx)
 
 	if err != nil {
@@ -107,6 +108,7 @@ func (r *tenantRepository) Update(ctx context.Context, tenant *domainTenant.Tena
 		UpdateOneID(tenant.ID).
 		SetName(tenant.Name).
 		SetBillingDetails(tenant.BillingDetails.ToSchema()).
+		SetDunningPolicy(tenant.DunningPolicy.ToSchema()).
 		Save(ctx)
 
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/postgres"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
@@ -50,6 +51,9 @@ func (r *walletRepository) CreateWallet(ctx context.Context, w *walletdomain.Wal
 		SetAutoTopupTrigger(string(w.AutoTopupTrigger)).
 		SetAutoTopupMinBalance(w.AutoTopupMinBalance).
 		SetAutoTopupAmount(w.AutoTopupAmount).
+		SetAutoTopupMethod(string(lo.Ternary(w.AutoTopupMethod == "", types.AutoTopupMethodInvoice, w.AutoTopupMethod))).
+		SetNillableAutoTopupPaymentMethodType(lo.EmptyableToPtr(string(w.AutoTopupPaymentMethodType))).
+		SetNillableAutoTopupPaymentMethodID(lo.EmptyableToPtr(w.AutoTopupPaymentMethodID)).
 		SetWalletType(string(w.WalletType)).
 		SetConfig(w.Config).
 		SetConversionRate(w.ConversionRate).
@@ -606,11 +610,25 @@ func (r *walletRepository) UpdateWallet(ctx context.Context, id string, w *walle
 			update.SetAutoTopupTrigger(string(types.AutoTopupTriggerDisabled))
 			update.ClearAutoTopupMinBalance()
 			update.ClearAutoTopupAmount()
+			update.SetAutoTopupMethod(string(types.AutoTopupMethodInvoice))
+			update.ClearAutoTopupPaymentMethodType()
+			update.ClearAutoTopupPaymentMethodID()
 		} else {
 			// When enabling auto top-up, set all required fields
 			update.SetAutoTopupTrigger(string(w.AutoTopupTrigger))
 			update.SetAutoTopupMinBalance(w.AutoTopupMinBalance)
 			update.SetAutoTopupAmount(w.AutoTopupAmount)
+			update.SetAutoTopupMethod(string(lo.Ternary(w.AutoTopupMethod == "", types.AutoTopupMethodInvoice, w.AutoTopupMethod)))
+			if w.AutoTopupMethod == types.AutoTopupMethodPayment {
+				update.SetAutoTopupPaymentMethodType(string(w.AutoTopupPaymentMethodType))
+			} else {
+				update.ClearAutoTopupPaymentMethodType()
+			}
+			if w.AutoTopupMethod == types.AutoTopupMethodPayment && w.AutoTopupPaymentMethodID != "" {
+				update.SetAutoTopupPaymentMethodID(w.AutoTopupPaymentMethodID)
+			} else {
+				update.ClearAutoTopupPaymentMethodID()
+			}
 		}
 	}
 
@@ -0,0 +1,272 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/webhookdelivery"
+	domainWebhookDelivery "github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+type webhookDeliveryRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts WebhookDeliveryQueryOptions
+}
+
+func NewWebhookDeliveryRepository(client postgres.IClient, log *logger.Logger) domainWebhookDelivery.Repository {
+	return &webhookDeliveryRepository{
+		client:    client,
+		log:       log,
+		queryOpts: WebhookDeliveryQueryOptions{},
+	}
+}
+
+func (r *webhookDeliveryRepository) Create(ctx context.Context, d *domainWebhookDelivery.WebhookDelivery) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating webhook delivery",
+		"webhook_delivery_id", d.ID,
+		"event_id", d.EventID,
+		"tenant_id", d.TenantID,
+	)
+
+	// Set environment ID from context if not already set
+	if d.EnvironmentID == "" {
+		d.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	result, err := client.WebhookDelivery.Create().
+		SetID(d.ID).
+		SetNillableWebhookEndpointID(d.WebhookEndpointID).
+		SetEventID(d.EventID).
+		SetEventName(d.EventName).
+		SetURL(d.URL).
+		SetRequestHeaders(d.RequestHeaders).
+		SetRequestBody(d.RequestBody).
+		SetNillableResponseStatus(d.ResponseStatus).
+		SetLatencyMs(d.LatencyMs).
+		SetNillableError(d.Error).
+		SetAttempt(d.Attempt).
+		SetDeliveryStatus(string(d.DeliveryStatus)).
+		SetNillableReplayOf(d.ReplayOf).
+		SetStatus(string(d.Status)).
+		SetTenantID(d.TenantID).
+		SetCreatedAt(d.CreatedAt).
+		SetUpdatedAt(d.UpdatedAt).
+		SetCreatedBy(d.CreatedBy).
+		SetUpdatedBy(d.UpdatedBy).
+		SetEnvironmentID(d.EnvironmentID).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create webhook delivery").
+			Mark(ierr.ErrDatabase)
+	}
+
+	*d = *domainWebhookDelivery.FromEnt(result)
+	return nil
+}
+
+func (r *webhookDeliveryRepository) Get(ctx context.Context, id string) (*domainWebhookDelivery.WebhookDelivery, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting webhook delivery",
+		"webhook_delivery_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	d, err := client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.ID(id),
+			webhookdelivery.TenantID(types.GetTenantID(ctx)),
+			webhookdelivery.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Webhook delivery with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"webhook_delivery_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get webhook delivery with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainWebhookDelivery.FromEnt(d), nil
+}
+
+func (r *webhookDeliveryRepository) List(ctx context.Context, filter *types.WebhookDeliveryFilter) ([]*domainWebhookDelivery.WebhookDelivery, error) {
+	if filter == nil {
+		filter = types.NewDefaultWebhookDeliveryFilter()
+	}
+
+	client := r.client.Querier(ctx)
+	query := client.WebhookDelivery.Query()
+
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	deliveries, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list webhook deliveries").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainWebhookDelivery.FromEntList(deliveries), nil
+}
+
+func (r *webhookDeliveryRepository) Count(ctx context.Context, filter *types.WebhookDeliveryFilter) (int, error) {
+	client := r.client.Querier(ctx)
+	query := client.WebhookDelivery.Query()
+
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count webhook deliveries").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *webhookDeliveryRepository) UpdateDeliveryStatus(ctx context.Context, id string, status types.WebhookDeliveryStatus) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating webhook delivery status",
+		"webhook_delivery_id", id,
+		"delivery_status", status,
+	)
+
+	_, err := client.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.ID(id),
+			webhookdelivery.TenantID(types.GetTenantID(ctx)),
+			webhookdelivery.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetDeliveryStatus(string(status)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to update webhook delivery with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// WebhookDeliveryQuery type alias for better readability
+type WebhookDeliveryQuery = *ent.WebhookDeliveryQuery
+
+// WebhookDeliveryQueryOptions implements BaseQueryOptions for webhook delivery queries
+type WebhookDeliveryQueryOptions struct{}
+
+func (o WebhookDeliveryQueryOptions) ApplyTenantFilter(ctx context.Context, query WebhookDeliveryQuery) WebhookDeliveryQuery {
+	return query.Where(webhookdelivery.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o WebhookDeliveryQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query WebhookDeliveryQuery) WebhookDeliveryQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(webhookdelivery.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o WebhookDeliveryQueryOptions) ApplyStatusFilter(query WebhookDeliveryQuery, status string) WebhookDeliveryQuery {
+	if status == "" {
+		return query.Where(webhookdelivery.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(webhookdelivery.Status(status))
+}
+
+func (o WebhookDeliveryQueryOptions) ApplySortFilter(query WebhookDeliveryQuery, field string, order string) WebhookDeliveryQuery {
+	orderFunc := ent.Desc
+	if order == "asc" {
+		orderFunc = ent.Asc
+	}
+	return query.Order(orderFunc(o.GetFieldName(field)))
+}
+
+func (o WebhookDeliveryQueryOptions) ApplyPaginationFilter(query WebhookDeliveryQuery, limit int, offset int) WebhookDeliveryQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o WebhookDeliveryQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return webhookdelivery.FieldCreatedAt
+	case "updated_at":
+		return webhookdelivery.FieldUpdatedAt
+	case "event_name":
+		return webhookdelivery.FieldEventName
+	case "latency_ms":
+		return webhookdelivery.FieldLatencyMs
+	default:
+		return field
+	}
+}
+
+func (o WebhookDeliveryQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.WebhookDeliveryFilter, query WebhookDeliveryQuery) WebhookDeliveryQuery {
+	if f == nil {
+		return query
+	}
+
+	if f.WebhookEndpointID != "" {
+		query = query.Where(webhookdelivery.WebhookEndpointID(f.WebhookEndpointID))
+	}
+
+	if f.EventID != "" {
+		query = query.Where(webhookdelivery.EventID(f.EventID))
+	}
+
+	if f.EventName != "" {
+		query = query.Where(webhookdelivery.EventName(f.EventName))
+	}
+
+	if len(f.DeliveryStatuses) > 0 {
+		query = query.Where(webhookdelivery.DeliveryStatusIn(lo.Map(f.DeliveryStatuses, func(s types.WebhookDeliveryStatus, _ int) string {
+			return string(s)
+		})...))
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(webhookdelivery.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(webhookdelivery.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
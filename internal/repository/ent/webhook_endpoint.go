@@ -0,0 +1,292 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
+	domainWebhookEndpoint "github.com/flexprice/flexprice/internal/domain/webhookendpoint"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type webhookEndpointRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts WebhookEndpointQueryOptions
+}
+
+func NewWebhookEndpointRepository(client postgres.IClient, log *logger.Logger) domainWebhookEndpoint.Repository {
+	return &webhookEndpointRepository{
+		client:    client,
+		log:       log,
+		queryOpts: WebhookEndpointQueryOptions{},
+	}
+}
+
+func (r *webhookEndpointRepository) Create(ctx context.Context, e *domainWebhookEndpoint.WebhookEndpoint) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating webhook endpoint",
+		"webhook_endpoint_id", e.ID,
+		"tenant_id", e.TenantID,
+	)
+
+	// Set environment ID from context if not already set
+	if e.EnvironmentID == "" {
+		e.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	result, err := client.WebhookEndpoint.Create().
+		SetID(e.ID).
+		SetURL(e.URL).
+		SetDescription(e.Description).
+		SetEnabledEvents(e.EnabledEvents).
+		SetHeaders(e.Headers).
+		SetEnabled(e.Enabled).
+		SetSigningSecret(e.SigningSecret).
+		SetNillablePreviousSigningSecret(e.PreviousSigningSecret).
+		SetNillablePreviousSigningSecretExpiresAt(e.PreviousSigningSecretExpiresAt).
+		SetMetadata(map[string]string(e.Metadata)).
+		SetStatus(string(e.Status)).
+		SetTenantID(e.TenantID).
+		SetCreatedAt(e.CreatedAt).
+		SetUpdatedAt(e.UpdatedAt).
+		SetCreatedBy(e.CreatedBy).
+		SetUpdatedBy(e.UpdatedBy).
+		SetEnvironmentID(e.EnvironmentID).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create webhook endpoint").
+			Mark(ierr.ErrDatabase)
+	}
+
+	*e = *domainWebhookEndpoint.FromEnt(result)
+	return nil
+}
+
+func (r *webhookEndpointRepository) Get(ctx context.Context, id string) (*domainWebhookEndpoint.WebhookEndpoint, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting webhook endpoint",
+		"webhook_endpoint_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	e, err := client.WebhookEndpoint.Query().
+		Where(
+			webhookendpoint.ID(id),
+			webhookendpoint.TenantID(types.GetTenantID(ctx)),
+			webhookendpoint.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Webhook endpoint with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"webhook_endpoint_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get webhook endpoint with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainWebhookEndpoint.FromEnt(e), nil
+}
+
+func (r *webhookEndpointRepository) List(ctx context.Context, filter *types.WebhookEndpointFilter) ([]*domainWebhookEndpoint.WebhookEndpoint, error) {
+	if filter == nil {
+		filter = types.NewDefaultWebhookEndpointFilter()
+	}
+
+	client := r.client.Querier(ctx)
+	query := client.WebhookEndpoint.Query()
+
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	endpoints, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list webhook endpoints").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainWebhookEndpoint.FromEntList(endpoints), nil
+}
+
+func (r *webhookEndpointRepository) Count(ctx context.Context, filter *types.WebhookEndpointFilter) (int, error) {
+	client := r.client.Querier(ctx)
+	query := client.WebhookEndpoint.Query()
+
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count webhook endpoints").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *webhookEndpointRepository) Update(ctx context.Context, e *domainWebhookEndpoint.WebhookEndpoint) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating webhook endpoint",
+		"webhook_endpoint_id", e.ID,
+		"tenant_id", e.TenantID,
+	)
+
+	_, err := client.WebhookEndpoint.Update().
+		Where(
+			webhookendpoint.ID(e.ID),
+			webhookendpoint.TenantID(e.TenantID),
+			webhookendpoint.EnvironmentID(e.EnvironmentID),
+		).
+		SetURL(e.URL).
+		SetDescription(e.Description).
+		SetEnabledEvents(e.EnabledEvents).
+		SetHeaders(e.Headers).
+		SetEnabled(e.Enabled).
+		SetSigningSecret(e.SigningSecret).
+		SetNillablePreviousSigningSecret(e.PreviousSigningSecret).
+		SetNillablePreviousSigningSecretExpiresAt(e.PreviousSigningSecretExpiresAt).
+		SetMetadata(map[string]string(e.Metadata)).
+		SetStatus(string(e.Status)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to update webhook endpoint with ID %s", e.ID).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *webhookEndpointRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting webhook endpoint",
+		"webhook_endpoint_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	_, err := client.WebhookEndpoint.Update().
+		Where(
+			webhookendpoint.ID(id),
+			webhookendpoint.TenantID(types.GetTenantID(ctx)),
+			webhookendpoint.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to delete webhook endpoint with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// WebhookEndpointQuery type alias for better readability
+type WebhookEndpointQuery = *ent.WebhookEndpointQuery
+
+// WebhookEndpointQueryOptions implements BaseQueryOptions for webhook endpoint queries
+type WebhookEndpointQueryOptions struct{}
+
+func (o WebhookEndpointQueryOptions) ApplyTenantFilter(ctx context.Context, query WebhookEndpointQuery) WebhookEndpointQuery {
+	return query.Where(webhookendpoint.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o WebhookEndpointQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query WebhookEndpointQuery) WebhookEndpointQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(webhookendpoint.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o WebhookEndpointQueryOptions) ApplyStatusFilter(query WebhookEndpointQuery, status string) WebhookEndpointQuery {
+	if status == "" {
+		return query.Where(webhookendpoint.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(webhookendpoint.Status(status))
+}
+
+func (o WebhookEndpointQueryOptions) ApplySortFilter(query WebhookEndpointQuery, field string, order string) WebhookEndpointQuery {
+	orderFunc := ent.Desc
+	if order == "asc" {
+		orderFunc = ent.Asc
+	}
+	return query.Order(orderFunc(o.GetFieldName(field)))
+}
+
+func (o WebhookEndpointQueryOptions) ApplyPaginationFilter(query WebhookEndpointQuery, limit int, offset int) WebhookEndpointQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o WebhookEndpointQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return webhookendpoint.FieldCreatedAt
+	case "updated_at":
+		return webhookendpoint.FieldUpdatedAt
+	case "url":
+		return webhookendpoint.FieldURL
+	default:
+		return field
+	}
+}
+
+func (o WebhookEndpointQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.WebhookEndpointFilter, query WebhookEndpointQuery) WebhookEndpointQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.WebhookEndpointIDs) > 0 {
+		query = query.Where(webhookendpoint.IDIn(f.WebhookEndpointIDs...))
+	}
+
+	if f.Enabled != nil {
+		query = query.Where(webhookendpoint.Enabled(*f.Enabled))
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(webhookendpoint.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(webhookendpoint.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
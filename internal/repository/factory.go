@@ -2,23 +2,31 @@ package repository
 
 import (
 	"github.com/flexprice/flexprice/internal/clickhouse"
+	"github.com/flexprice/flexprice/internal/domain/alert"
 	"github.com/flexprice/flexprice/internal/domain/auth"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/creditnote"
 	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/dunning"
 	"github.com/flexprice/flexprice/internal/domain/entitlement"
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
 	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/outbox"
 	"github.com/flexprice/flexprice/internal/domain/payment"
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/domain/task"
+	"github.com/flexprice/flexprice/internal/domain/tax"
 	"github.com/flexprice/flexprice/internal/domain/tenant"
 	"github.com/flexprice/flexprice/internal/domain/user"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
+	"github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/postgres"
 	clickhouseRepo "github.com/flexprice/flexprice/internal/repository/clickhouse"
@@ -102,3 +110,35 @@ func NewTaskRepository(p RepositoryParams) task.Repository {
 func NewSecretRepository(p RepositoryParams) secret.Repository {
 	return entRepo.NewSecretRepository(p.EntClient, p.Logger)
 }
+
+func NewCouponRepository(p RepositoryParams) coupon.Repository {
+	return entRepo.NewCouponRepository(p.EntClient, p.Logger)
+}
+
+func NewTaxRateRepository(p RepositoryParams) tax.Repository {
+	return entRepo.NewTaxRateRepository(p.EntClient, p.Logger)
+}
+
+func NewCreditNoteRepository(p RepositoryParams) creditnote.Repository {
+	return entRepo.NewCreditNoteRepository(p.EntClient, p.Logger)
+}
+
+func NewDunningAttemptRepository(p RepositoryParams) dunning.Repository {
+	return entRepo.NewDunningAttemptRepository(p.EntClient, p.Logger)
+}
+
+func NewAlertRepository(p RepositoryParams) alert.Repository {
+	return entRepo.NewAlertRepository(p.EntClient, p.Logger)
+}
+
+func NewWebhookEndpointRepository(p RepositoryParams) webhookendpoint.Repository {
+	return entRepo.NewWebhookEndpointRepository(p.EntClient, p.Logger)
+}
+
+func NewWebhookDeliveryRepository(p RepositoryParams) webhookdelivery.Repository {
+	return entRepo.NewWebhookDeliveryRepository(p.EntClient, p.Logger)
+}
+
+func NewOutboxRepository(p RepositoryParams) outbox.Repository {
+	return entRepo.NewOutboxRepository(p.EntClient, p.Logger)
+}
@@ -0,0 +1,473 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/alert"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// AlertService defines the interface for usage threshold alerts
+type AlertService interface {
+	CreateAlertRule(ctx context.Context, req dto.CreateAlertRuleRequest) (*dto.AlertRuleResponse, error)
+	GetAlertRule(ctx context.Context, id string) (*dto.AlertRuleResponse, error)
+	ListAlertRules(ctx context.Context, filter *types.AlertRuleFilter) (*dto.ListAlertRulesResponse, error)
+	UpdateAlertRule(ctx context.Context, id string, req dto.UpdateAlertRuleRequest) (*dto.AlertRuleResponse, error)
+	DeleteAlertRule(ctx context.Context, id string) error
+
+	GetAlertEvent(ctx context.Context, id string) (*dto.AlertEventResponse, error)
+	ListAlertEvents(ctx context.Context, filter *types.AlertEventFilter) (*dto.ListAlertEventsResponse, error)
+
+	// EvaluateAlerts evaluates the alert rules of all tenants against the current billing period
+	// of their subscriptions and records the thresholds crossed. It is meant to be called
+	// periodically by a scheduled job.
+	EvaluateAlerts(ctx context.Context) (*dto.EvaluateAlertsResponse, error)
+}
+
+type alertService struct {
+	ServiceParams
+}
+
+// NewAlertService creates a new alert service
+func NewAlertService(params ServiceParams) AlertService {
+	return &alertService{
+		ServiceParams: params,
+	}
+}
+
+func (s *alertService) CreateAlertRule(ctx context.Context, req dto.CreateAlertRuleRequest) (*dto.AlertRuleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if req.FeatureID != nil {
+		f, err := s.FeatureRepo.Get(ctx, *req.FeatureID)
+		if err != nil {
+			return nil, err
+		}
+		if f.Type != types.FeatureTypeMetered {
+			return nil, ierr.NewError("feature is not metered").
+				WithHint("Entitlement usage alerts are only supported for metered features").
+				WithReportableDetails(map[string]any{
+					"feature_id":   f.ID,
+					"feature_type": f.Type,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if req.CustomerID != nil {
+		if _, err := s.CustomerRepo.Get(ctx, *req.CustomerID); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.SubscriptionID != nil {
+		sub, err := s.SubRepo.Get(ctx, *req.SubscriptionID)
+		if err != nil {
+			return nil, err
+		}
+		if req.CustomerID != nil && sub.CustomerID != *req.CustomerID {
+			return nil, ierr.NewError("subscription does not belong to the customer").
+				WithHint("The subscription must belong to the customer of the alert rule").
+				WithReportableDetails(map[string]any{
+					"subscription_id": sub.ID,
+					"customer_id":     *req.CustomerID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	rule := req.ToAlertRule(ctx)
+	if err := s.AlertRepo.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return &dto.AlertRuleResponse{Rule: rule}, nil
+}
+
+func (s *alertService) GetAlertRule(ctx context.Context, id string) (*dto.AlertRuleResponse, error) {
+	if id == "" {
+		return nil, ierr.NewError("alert rule ID is required").
+			WithHint("Alert rule ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	rule, err := s.AlertRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AlertRuleResponse{Rule: rule}, nil
+}
+
+func (s *alertService) ListAlertRules(ctx context.Context, filter *types.AlertRuleFilter) (*dto.ListAlertRulesResponse, error) {
+	if filter == nil {
+		filter = types.NewDefaultAlertRuleFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.AlertRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.AlertRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListAlertRulesResponse{
+		Items: make([]*dto.AlertRuleResponse, len(rules)),
+	}
+
+	for i, rule := range rules {
+		response.Items[i] = &dto.AlertRuleResponse{Rule: rule}
+	}
+
+	response.Pagination = types.NewPaginationResponse(
+		count,
+		filter.GetLimit(),
+		filter.GetOffset(),
+	)
+
+	return response, nil
+}
+
+func (s *alertService) UpdateAlertRule(ctx context.Context, id string, req dto.UpdateAlertRuleRequest) (*dto.AlertRuleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	rule, err := s.AlertRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		rule.Name = *req.Name
+	}
+	if req.Thresholds != nil {
+		rule.Thresholds = alert.SortThresholds(req.Thresholds)
+	}
+	if req.Metadata != nil {
+		rule.Metadata = *req.Metadata
+	}
+
+	if err := s.AlertRepo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return &dto.AlertRuleResponse{Rule: rule}, nil
+}
+
+// DeleteAlertRule archives the alert rule so it is no longer evaluated
+func (s *alertService) DeleteAlertRule(ctx context.Context, id string) error {
+	if _, err := s.AlertRepo.Get(ctx, id); err != nil {
+		return err
+	}
+
+	return s.AlertRepo.Delete(ctx, id)
+}
+
+func (s *alertService) GetAlertEvent(ctx context.Context, id string) (*dto.AlertEventResponse, error) {
+	if id == "" {
+		return nil, ierr.NewError("alert event ID is required").
+			WithHint("Alert event ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	event, err := s.AlertRepo.GetEvent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AlertEventResponse{Event: event}, nil
+}
+
+func (s *alertService) ListAlertEvents(ctx context.Context, filter *types.AlertEventFilter) (*dto.ListAlertEventsResponse, error) {
+	if filter == nil {
+		filter = types.NewDefaultAlertEventFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	events, err := s.AlertRepo.ListEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.AlertRepo.CountEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListAlertEventsResponse{
+		Items: make([]*dto.AlertEventResponse, len(events)),
+	}
+
+	for i, event := range events {
+		response.Items[i] = &dto.AlertEventResponse{Event: event}
+	}
+
+	response.Pagination = types.NewPaginationResponse(
+		count,
+		filter.GetLimit(),
+		filter.GetOffset(),
+	)
+
+	return response, nil
+}
+
+// EvaluateAlerts evaluates the published alert rules of all tenants. The errors of individual
+// tenants are collected in the response, only a failure to list the tenants fails the run.
+func (s *alertService) EvaluateAlerts(ctx context.Context) (*dto.EvaluateAlertsResponse, error) {
+	response := &dto.EvaluateAlertsResponse{
+		Items:   make([]*dto.AlertEventResponse, 0),
+		StartAt: time.Now().UTC(),
+	}
+
+	tenants, err := s.TenantRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tenants {
+		// update context to include the tenant id
+		ctx := context.WithValue(ctx, types.CtxTenantID, t.ID)
+		// clean the environment id to make sure it's not used
+		ctx = context.WithValue(ctx, types.CtxEnvironmentID, "")
+
+		filter := types.NewNoLimitAlertRuleFilter()
+		filter.Status = lo.ToPtr(types.StatusPublished)
+
+		// A failing tenant does not hold back the evaluation of the others
+		rules, err := s.AlertRepo.List(ctx, filter)
+		if err != nil {
+			s.Logger.Errorw("failed to list alert rules for tenant",
+				"tenant_id", t.ID,
+				"error", err)
+			response.TenantErrors = append(response.TenantErrors, &dto.EvaluateAlertsTenantError{
+				TenantID: t.ID,
+				Error:    err.Error(),
+			})
+			continue
+		}
+
+		for _, rule := range rules {
+			// evaluate the rule in its own environment
+			ctx := context.WithValue(ctx, types.CtxEnvironmentID, rule.EnvironmentID)
+
+			events, err := s.evaluateRule(ctx, rule)
+			if err != nil {
+				s.Logger.Errorw("failed to evaluate alert rule",
+					"alert_rule_id", rule.ID,
+					"error", err)
+				response.TotalFailed++
+				continue
+			}
+
+			for _, event := range events {
+				response.Items = append(response.Items, &dto.AlertEventResponse{Event: event})
+			}
+			response.TotalCrossed += len(events)
+		}
+	}
+
+	s.Logger.Infow("completed alert evaluation",
+		"total_crossed", response.TotalCrossed,
+		"total_failed", response.TotalFailed,
+		"tenants_failed", len(response.TenantErrors))
+
+	return response, nil
+}
+
+// evaluateRule measures the subscriptions the rule applies to and records the thresholds they
+// crossed in their current billing period
+func (s *alertService) evaluateRule(ctx context.Context, rule *alert.Rule) ([]*alert.Event, error) {
+	subs, err := s.ruleSubscriptions(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*alert.Event, 0)
+	for _, sub := range subs {
+		value, ok, err := s.measure(ctx, rule, sub)
+		if err != nil {
+			return events, err
+		}
+		if !ok {
+			continue
+		}
+
+		crossed, err := s.crossThresholds(ctx, rule, sub, value)
+		if err != nil {
+			return events, err
+		}
+		events = append(events, crossed...)
+	}
+
+	return events, nil
+}
+
+// ruleSubscriptions returns the billing subscriptions the rule applies to
+func (s *alertService) ruleSubscriptions(ctx context.Context, rule *alert.Rule) ([]*subscription.Subscription, error) {
+	statuses := []types.SubscriptionStatus{
+		types.SubscriptionStatusActive,
+		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
+	}
+
+	var subs []*subscription.Subscription
+	if rule.SubscriptionID != nil {
+		sub, err := s.SubRepo.Get(ctx, *rule.SubscriptionID)
+		if err != nil {
+			return nil, err
+		}
+		subs = []*subscription.Subscription{sub}
+	} else {
+		filter := types.NewNoLimitSubscriptionFilter()
+		filter.CustomerID = lo.FromPtr(rule.CustomerID)
+		filter.SubscriptionStatus = statuses
+
+		var err error
+		subs, err = s.SubRepo.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return lo.Filter(subs, func(sub *subscription.Subscription, _ int) bool {
+		if !lo.Contains(statuses, sub.SubscriptionStatus) {
+			return false
+		}
+		return rule.Currency == "" || types.IsMatchingCurrency(sub.Currency, rule.Currency)
+	}), nil
+}
+
+// measure returns the value of the subscription compared to the thresholds of the rule. It
+// returns false when the rule does not apply to the subscription, e.g. when the subscription
+// has no usage limit for the feature of an entitlement usage rule.
+func (s *alertService) measure(ctx context.Context, rule *alert.Rule, sub *subscription.Subscription) (decimal.Decimal, bool, error) {
+	switch rule.AlertType {
+	case types.AlertTypeEntitlementUsage:
+		summary, err := NewBillingService(s.ServiceParams).GetCustomerUsageSummary(ctx, sub.CustomerID, &dto.GetCustomerUsageSummaryRequest{
+			SubscriptionIDs: []string{sub.ID},
+			FeatureIDs:      []string{lo.FromPtr(rule.FeatureID)},
+		})
+		if err != nil {
+			return decimal.Zero, false, err
+		}
+
+		feature, ok := lo.Find(summary.Features, func(f *dto.FeatureUsageSummary) bool {
+			return f.Feature.ID == lo.FromPtr(rule.FeatureID) && f.TotalLimit != nil
+		})
+		if !ok {
+			return decimal.Zero, false, nil
+		}
+
+		// The thresholds are percents while the usage summary reports the fraction of the limit used
+		if *feature.TotalLimit <= 0 {
+			return decimal.NewFromInt(100), true, nil
+		}
+		return feature.UsagePercent.Mul(decimal.NewFromInt(100)), true, nil
+
+	case types.AlertTypeSubscriptionSpend:
+		// The spend is what the current period would invoice, fixed and usage charges alike
+		_, lineItems, err := s.SubRepo.GetWithLineItems(ctx, sub.ID)
+		if err != nil {
+			return decimal.Zero, false, err
+		}
+
+		result, err := NewBillingService(s.ServiceParams).CalculateCharges(ctx, sub, lineItems, sub.CurrentPeriodStart, sub.CurrentPeriodEnd, true)
+		if err != nil {
+			return decimal.Zero, false, err
+		}
+		return result.TotalAmount, true, nil
+	}
+
+	return decimal.Zero, false, rule.AlertType.Validate()
+}
+
+// crossThresholds records the thresholds reached by the value that were not already crossed in
+// the current billing period of the subscription and sends the threshold crossed webhook
+func (s *alertService) crossThresholds(ctx context.Context, rule *alert.Rule, sub *subscription.Subscription, value decimal.Decimal) ([]*alert.Event, error) {
+	existing, err := s.AlertRepo.ListEvents(ctx, &types.AlertEventFilter{
+		QueryFilter:    types.NewNoLimitQueryFilter(),
+		AlertRuleID:    rule.ID,
+		SubscriptionID: sub.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	existing = lo.Filter(existing, func(e *alert.Event, _ int) bool {
+		return e.PeriodStart.Equal(sub.CurrentPeriodStart)
+	})
+
+	events := make([]*alert.Event, 0)
+	for _, threshold := range alert.SortThresholds(rule.Thresholds) {
+		if value.LessThan(threshold) {
+			break
+		}
+
+		if lo.SomeBy(existing, func(e *alert.Event) bool {
+			return e.Threshold.Equal(threshold)
+		}) {
+			continue
+		}
+
+		event := &alert.Event{
+			ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_ALERT_EVENT),
+			AlertRuleID:    rule.ID,
+			AlertType:      rule.AlertType,
+			CustomerID:     sub.CustomerID,
+			SubscriptionID: sub.ID,
+			FeatureID:      rule.FeatureID,
+			Threshold:      threshold,
+			Value:          value,
+			Currency:       lo.Ternary(rule.AlertType == types.AlertTypeSubscriptionSpend, sub.Currency, ""),
+			PeriodStart:    sub.CurrentPeriodStart,
+			PeriodEnd:      sub.CurrentPeriodEnd,
+			EnvironmentID:  rule.EnvironmentID,
+			BaseModel:      types.GetDefaultBaseModel(ctx),
+		}
+
+		err := s.DB.WithTx(ctx, func(tx context.Context) error {
+			if err := s.AlertRepo.CreateEvent(tx, event); err != nil {
+				return err
+			}
+
+			s.publishWebhookEvent(tx, types.WebhookEventUsageThresholdCrossed, types.WebhookEventPayload{AlertEventID: event.ID})
+			return nil
+		})
+		if err != nil {
+			// crossed concurrently by another evaluation
+			if ierr.IsAlreadyExists(err) {
+				continue
+			}
+			return events, err
+		}
+
+		s.Logger.Infow("usage threshold crossed",
+			"alert_rule_id", rule.ID,
+			"subscription_id", sub.ID,
+			"threshold", threshold,
+			"value", value)
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,462 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/alert"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/feature"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/domain/tenant"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type AlertServiceTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service  AlertService
+	testData struct {
+		customer     *customer.Customer
+		plan         *plan.Plan
+		meter        *meter.Meter
+		feature      *feature.Feature
+		price        *price.Price
+		subscription *subscription.Subscription
+		now          time.Time
+	}
+}
+
+func TestAlertService(t *testing.T) {
+	suite.Run(t, new(AlertServiceTestSuite))
+}
+
+func (s *AlertServiceTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	s.service = NewAlertService(ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		PlanRepo:         s.GetStores().PlanRepo,
+		PriceRepo:        s.GetStores().PriceRepo,
+		EventRepo:        s.GetStores().EventRepo,
+		MeterRepo:        s.GetStores().MeterRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		EntitlementRepo:  s.GetStores().EntitlementRepo,
+		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		FeatureRepo:      s.GetStores().FeatureRepo,
+		TenantRepo:       s.GetStores().TenantRepo,
+		UserRepo:         s.GetStores().UserRepo,
+		AuthRepo:         s.GetStores().AuthRepo,
+		WalletRepo:       s.GetStores().WalletRepo,
+		PaymentRepo:      s.GetStores().PaymentRepo,
+		AlertRepo:        s.GetStores().AlertRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	})
+	s.setupTestData()
+}
+
+func (s *AlertServiceTestSuite) setupTestData() {
+	ctx := s.GetContext()
+
+	s.NoError(s.GetStores().TenantRepo.Create(ctx, &tenant.Tenant{
+		ID:     types.GetTenantID(ctx),
+		Name:   "Test Tenant",
+		Status: types.StatusPublished,
+	}))
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_alert",
+		ExternalID: "ext_cust_alert",
+		Name:       "Test Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	s.testData.plan = &plan.Plan{
+		ID:        "plan_alert",
+		Name:      "Test Plan",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, s.testData.plan))
+
+	s.testData.meter = &meter.Meter{
+		ID:        "meter_api_calls",
+		Name:      "API Calls",
+		EventName: "api_call",
+		Aggregation: meter.Aggregation{
+			Type: types.AggregationCount,
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().MeterRepo.CreateMeter(ctx, s.testData.meter))
+
+	s.testData.feature = &feature.Feature{
+		ID:        "feat_api_calls",
+		Name:      "API Calls",
+		Type:      types.FeatureTypeMetered,
+		MeterID:   s.testData.meter.ID,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().FeatureRepo.Create(ctx, s.testData.feature))
+
+	_, err := s.GetStores().EntitlementRepo.Create(ctx, &entitlement.Entitlement{
+		ID:               "ent_api_calls",
+		PlanID:           s.testData.plan.ID,
+		FeatureID:        s.testData.feature.ID,
+		FeatureType:      types.FeatureTypeMetered,
+		IsEnabled:        true,
+		UsageLimit:       lo.ToPtr(int64(100)),
+		UsageResetPeriod: types.BILLING_PERIOD_MONTHLY,
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	})
+	s.NoError(err)
+
+	s.testData.price = &price.Price{
+		ID:                 "price_api_calls",
+		Amount:             decimal.NewFromInt(1),
+		Currency:           "usd",
+		PlanID:             s.testData.plan.ID,
+		Type:               types.PRICE_TYPE_USAGE,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceArrear,
+		MeterID:            s.testData.meter.ID,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, s.testData.price))
+
+	s.testData.now = time.Now().UTC()
+	s.testData.subscription = &subscription.Subscription{
+		ID:                 "sub_alert",
+		PlanID:             s.testData.plan.ID,
+		CustomerID:         s.testData.customer.ID,
+		StartDate:          s.testData.now.AddDate(0, -1, 0),
+		CurrentPeriodStart: s.testData.now.AddDate(0, 0, -1),
+		CurrentPeriodEnd:   s.testData.now.AddDate(0, 0, 29),
+		Currency:           "usd",
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+
+	lineItems := []*subscription.SubscriptionLineItem{
+		{
+			ID:               types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
+			SubscriptionID:   s.testData.subscription.ID,
+			CustomerID:       s.testData.customer.ID,
+			PlanID:           s.testData.plan.ID,
+			PlanDisplayName:  s.testData.plan.Name,
+			PriceID:          s.testData.price.ID,
+			PriceType:        s.testData.price.Type,
+			MeterID:          s.testData.meter.ID,
+			MeterDisplayName: s.testData.meter.Name,
+			DisplayName:      s.testData.meter.Name,
+			Quantity:         decimal.Zero,
+			Currency:         s.testData.subscription.Currency,
+			BillingPeriod:    s.testData.subscription.BillingPeriod,
+			StartDate:        s.testData.subscription.StartDate,
+			BaseModel:        types.GetDefaultBaseModel(ctx),
+		},
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(ctx, s.testData.subscription, lineItems))
+}
+
+func (s *AlertServiceTestSuite) ingestEvents(count int) {
+	for i := 0; i < count; i++ {
+		s.NoError(s.GetStores().EventRepo.InsertEvent(s.GetContext(), &events.Event{
+			ID:                 s.GetUUID(),
+			TenantID:           types.GetTenantID(s.GetContext()),
+			EventName:          s.testData.meter.EventName,
+			ExternalCustomerID: s.testData.customer.ExternalID,
+			Timestamp:          s.testData.now.Add(-1 * time.Hour),
+			Properties:         map[string]interface{}{},
+		}))
+	}
+}
+
+func (s *AlertServiceTestSuite) createUsageRule(thresholds ...int64) *dto.AlertRuleResponse {
+	rule, err := s.service.CreateAlertRule(s.GetContext(), dto.CreateAlertRuleRequest{
+		Name:       "API calls usage",
+		AlertType:  types.AlertTypeEntitlementUsage,
+		FeatureID:  lo.ToPtr(s.testData.feature.ID),
+		Thresholds: lo.Map(thresholds, func(t int64, _ int) decimal.Decimal { return decimal.NewFromInt(t) }),
+	})
+	s.Require().NoError(err)
+	return rule
+}
+
+func (s *AlertServiceTestSuite) thresholdWebhookCount() int {
+	return lo.CountBy(s.GetWebhookEvents(), func(event *types.WebhookEvent) bool {
+		return event.EventName == types.WebhookEventUsageThresholdCrossed
+	})
+}
+
+func (s *AlertServiceTestSuite) TestCreateAlertRuleValidation() {
+	tests := []struct {
+		name string
+		req  dto.CreateAlertRuleRequest
+	}{
+		{
+			name: "entitlement usage without feature",
+			req: dto.CreateAlertRuleRequest{
+				Name:       "usage",
+				AlertType:  types.AlertTypeEntitlementUsage,
+				Thresholds: []decimal.Decimal{decimal.NewFromInt(80)},
+			},
+		},
+		{
+			name: "subscription spend with feature",
+			req: dto.CreateAlertRuleRequest{
+				Name:       "spend",
+				AlertType:  types.AlertTypeSubscriptionSpend,
+				FeatureID:  lo.ToPtr(s.testData.feature.ID),
+				Thresholds: []decimal.Decimal{decimal.NewFromInt(80)},
+			},
+		},
+		{
+			name: "non positive threshold",
+			req: dto.CreateAlertRuleRequest{
+				Name:       "spend",
+				AlertType:  types.AlertTypeSubscriptionSpend,
+				Thresholds: []decimal.Decimal{decimal.Zero},
+			},
+		},
+		{
+			name: "duplicate thresholds",
+			req: dto.CreateAlertRuleRequest{
+				Name:       "spend",
+				AlertType:  types.AlertTypeSubscriptionSpend,
+				Thresholds: []decimal.Decimal{decimal.NewFromInt(10), decimal.NewFromInt(10)},
+			},
+		},
+		{
+			name: "subscription of another customer",
+			req: dto.CreateAlertRuleRequest{
+				Name:           "spend",
+				AlertType:      types.AlertTypeSubscriptionSpend,
+				CustomerID:     lo.ToPtr("cust_other"),
+				SubscriptionID: lo.ToPtr(s.testData.subscription.ID),
+				Thresholds:     []decimal.Decimal{decimal.NewFromInt(10)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.service.CreateAlertRule(s.GetContext(), tt.req)
+			s.Error(err)
+		})
+	}
+
+	s.Run("thresholds are sorted", func() {
+		rule := s.createUsageRule(80, 50)
+		s.Equal([]string{"50", "80"}, lo.Map(rule.Thresholds, func(t decimal.Decimal, _ int) string { return t.String() }))
+	})
+}
+
+func (s *AlertServiceTestSuite) TestEvaluateEntitlementUsage() {
+	rule := s.createUsageRule(50, 80, 100)
+	s.ingestEvents(85)
+
+	resp, err := s.service.EvaluateAlerts(s.GetContext())
+	s.Require().NoError(err)
+	s.Equal(2, resp.TotalCrossed)
+	s.Zero(resp.TotalFailed)
+	s.Equal(2, s.thresholdWebhookCount())
+
+	events, err := s.service.ListAlertEvents(s.GetContext(), &types.AlertEventFilter{
+		QueryFilter: types.NewDefaultQueryFilter(),
+		AlertRuleID: rule.ID,
+	})
+	s.Require().NoError(err)
+	s.Len(events.Items, 2)
+	for _, event := range events.Items {
+		s.Equal(s.testData.subscription.ID, event.SubscriptionID)
+		s.Equal(s.testData.feature.ID, lo.FromPtr(event.FeatureID))
+		s.True(event.Value.Equal(decimal.NewFromInt(85)))
+		s.True(event.PeriodStart.Equal(s.testData.subscription.CurrentPeriodStart))
+	}
+
+	// The usage summary keeps reporting the fraction of the limit used
+	summary, err := NewBillingService(s.service.(*alertService).ServiceParams).GetCustomerUsageSummary(s.GetContext(), s.testData.customer.ID, &dto.GetCustomerUsageSummaryRequest{
+		FeatureIDs: []string{s.testData.feature.ID},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(summary.Features, 1)
+	s.True(decimal.NewFromFloat(0.85).Equal(summary.Features[0].UsagePercent), "expected 0.85, got %s", summary.Features[0].UsagePercent)
+}
+
+func (s *AlertServiceTestSuite) TestEvaluateIsIdempotentWithinPeriod() {
+	s.createUsageRule(50, 80)
+	s.ingestEvents(60)
+
+	resp, err := s.service.EvaluateAlerts(s.GetContext())
+	s.Require().NoError(err)
+	s.Equal(1, resp.TotalCrossed)
+
+	// no new threshold reached
+	resp, err = s.service.EvaluateAlerts(s.GetContext())
+	s.Require().NoError(err)
+	s.Zero(resp.TotalCrossed)
+
+	// only the next threshold is crossed
+	s.ingestEvents(25)
+	resp, err = s.service.EvaluateAlerts(s.GetContext())
+	s.Require().NoError(err)
+	s.Require().Equal(1, resp.TotalCrossed)
+	s.True(resp.Items[0].Threshold.Equal(decimal.NewFromInt(80)))
+	s.Equal(2, s.thresholdWebhookCount())
+}
+
+func (s *AlertServiceTestSuite) TestEvaluateSubscriptionSpend() {
+	_, err := s.service.CreateAlertRule(s.GetContext(), dto.CreateAlertRuleRequest{
+		Name:           "Spend",
+		AlertType:      types.AlertTypeSubscriptionSpend,
+		CustomerID:     lo.ToPtr(s.testData.customer.ID),
+		SubscriptionID: lo.ToPtr(s.testData.subscription.ID),
+		Currency:       "USD",
+		Thresholds:     []decimal.Decimal{decimal.NewFromInt(50), decimal.NewFromInt(100)},
+	})
+	s.Require().NoError(err)
+
+	// A fixed platform fee of 40 on top of the usage charges
+	ctx := s.GetContext()
+	fixedPrice := &price.Price{
+		ID:                 "price_platform_fee",
+		Amount:             decimal.NewFromInt(40),
+		Currency:           "usd",
+		PlanID:             s.testData.plan.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, fixedPrice))
+	s.NoError(s.GetStores().SubscriptionRepo.ReplaceLineItems(ctx, s.testData.subscription, nil, []*subscription.SubscriptionLineItem{
+		{
+			ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
+			SubscriptionID:  s.testData.subscription.ID,
+			CustomerID:      s.testData.customer.ID,
+			PlanID:          s.testData.plan.ID,
+			PlanDisplayName: s.testData.plan.Name,
+			PriceID:         fixedPrice.ID,
+			PriceType:       fixedPrice.Type,
+			DisplayName:     "Platform fee",
+			Quantity:        decimal.NewFromInt(1),
+			Currency:        s.testData.subscription.Currency,
+			BillingPeriod:   s.testData.subscription.BillingPeriod,
+			InvoiceCadence:  fixedPrice.InvoiceCadence,
+			StartDate:       s.testData.subscription.StartDate,
+			BaseModel:       types.GetDefaultBaseModel(ctx),
+		},
+	}))
+
+	// The first 100 calls are included in the entitlement, the 20 above it are charged
+	s.ingestEvents(120)
+
+	resp, err := s.service.EvaluateAlerts(s.GetContext())
+	s.Require().NoError(err)
+	s.Require().Equal(1, resp.TotalCrossed)
+	s.True(resp.Items[0].Threshold.Equal(decimal.NewFromInt(50)))
+	s.True(resp.Items[0].Value.Equal(decimal.NewFromInt(60)), "expected 60, got %s", resp.Items[0].Value)
+	s.Equal("usd", resp.Items[0].Currency)
+}
+
+func (s *AlertServiceTestSuite) TestEvaluateSkipsDeletedRules() {
+	rule := s.createUsageRule(50)
+	s.NoError(s.service.DeleteAlertRule(s.GetContext(), rule.ID))
+	s.ingestEvents(60)
+
+	resp, err := s.service.EvaluateAlerts(s.GetContext())
+	s.Require().NoError(err)
+	s.Zero(resp.TotalCrossed)
+	s.Zero(s.thresholdWebhookCount())
+}
+
+func (s *AlertServiceTestSuite) TestEvaluateSkipsSubscriptionsWithoutLimit() {
+	_, err := s.GetStores().EntitlementRepo.Update(s.GetContext(), &entitlement.Entitlement{
+		ID:          "ent_api_calls",
+		PlanID:      s.testData.plan.ID,
+		FeatureID:   s.testData.feature.ID,
+		FeatureType: types.FeatureTypeMetered,
+		IsEnabled:   true,
+		BaseModel:   types.GetDefaultBaseModel(s.GetContext()),
+	})
+	s.Require().NoError(err)
+	s.createUsageRule(50)
+	s.ingestEvents(60)
+
+	resp, err := s.service.EvaluateAlerts(s.GetContext())
+	s.Require().NoError(err)
+	s.Zero(resp.TotalCrossed)
+	s.Zero(resp.TotalFailed)
+}
+
+// tenantFailingAlertRepo fails to list the alert rules of a single tenant
+type tenantFailingAlertRepo struct {
+	alert.Repository
+	tenantID string
+}
+
+func (r *tenantFailingAlertRepo) List(ctx context.Context, filter *types.AlertRuleFilter) ([]*alert.Rule, error) {
+	if types.GetTenantID(ctx) == r.tenantID {
+		return nil, ierr.NewError("failed to list alert rules").Mark(ierr.ErrDatabase)
+	}
+	return r.Repository.List(ctx, filter)
+}
+
+func (s *AlertServiceTestSuite) TestFailingTenantDoesNotStopOtherTenants() {
+	broken := &tenant.Tenant{
+		ID:     "tenant_broken",
+		Name:   "Broken Tenant",
+		Status: types.StatusPublished,
+	}
+	s.NoError(s.GetStores().TenantRepo.Create(s.GetContext(), broken))
+
+	svc := s.service.(*alertService)
+	svc.AlertRepo = &tenantFailingAlertRepo{Repository: svc.AlertRepo, tenantID: broken.ID}
+
+	s.createUsageRule(50)
+	s.ingestEvents(60)
+
+	resp, err := s.service.EvaluateAlerts(s.GetContext())
+	s.Require().NoError(err)
+	s.Equal(1, resp.TotalCrossed)
+	s.Require().Len(resp.TenantErrors, 1)
+	s.Equal(broken.ID, resp.TenantErrors[0].TenantID)
+	s.NotEmpty(resp.TenantErrors[0].Error)
+}
+
+func (s *AlertServiceTestSuite) TestDeleteAlertRule() {
+	rule := s.createUsageRule(50)
+	s.NoError(s.service.DeleteAlertRule(s.GetContext(), rule.ID))
+
+	deleted, err := s.service.GetAlertRule(s.GetContext(), rule.ID)
+	s.Require().NoError(err)
+	s.Equal(types.StatusArchived, deleted.Status)
+
+	_, err = s.service.GetAlertRule(s.GetContext(), "alert_missing")
+	s.True(ierr.IsNotFound(err))
+}
@@ -42,6 +42,9 @@ func (s *AuthServiceSuite) setupService() {
 		InvoiceRepo:      s.GetStores().InvoiceRepo,
 		EntitlementRepo:  s.GetStores().EntitlementRepo,
 		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
 		FeatureRepo:      s.GetStores().FeatureRepo,
 		TenantRepo:       s.GetStores().TenantRepo,
 		UserRepo:         s.GetStores().UserRepo,
@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/cache"
 	"github.com/flexprice/flexprice/internal/domain/entitlement"
 	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
@@ -42,6 +45,10 @@ type BillingService interface {
 	// CalculateUsageCharges calculates all usage-based charges
 	CalculateUsageCharges(ctx context.Context, sub *subscription.Subscription, usage *dto.GetUsageBySubscriptionResponse, periodStart, periodEnd time.Time) ([]dto.CreateInvoiceLineItemRequest, decimal.Decimal, error)
 
+	// CalculateQuantityChangeProrations calculates the prorated charges of the quantity increases of fixed
+	// advance charges during a period that was invoiced in advance
+	CalculateQuantityChangeProrations(ctx context.Context, sub *subscription.Subscription, periodStart, periodEnd time.Time) ([]dto.CreateInvoiceLineItemRequest, decimal.Decimal, error)
+
 	// CalculateAllCharges calculates both fixed and usage charges
 	CalculateAllCharges(ctx context.Context, sub *subscription.Subscription, usage *dto.GetUsageBySubscriptionResponse, periodStart, periodEnd time.Time) (*BillingCalculationResult, error)
 
@@ -49,6 +56,12 @@ type BillingService interface {
 	// using the reference point to determine which charges to include
 	PrepareSubscriptionInvoiceRequest(ctx context.Context, sub *subscription.Subscription, periodStart, periodEnd time.Time, referencePoint types.InvoiceReferencePoint) (*dto.CreateInvoiceRequest, error)
 
+	// PreparePlanChangeInvoiceRequest prepares the invoice request for a plan change at changedAt. It settles
+	// the usage and arrear charges of the ended line items up to the change and, when prorating, charges the
+	// fixed advance prices of the new line items for the rest of the current period. It returns nil when
+	// there is nothing to charge.
+	PreparePlanChangeInvoiceRequest(ctx context.Context, sub *subscription.Subscription, endedItems, newItems []*subscription.SubscriptionLineItem, changedAt time.Time, prorate bool) (*dto.CreateInvoiceRequest, error)
+
 	// ClassifyLineItems classifies line items based on cadence and type
 	ClassifyLineItems(sub *subscription.Subscription, currentPeriodStart, currentPeriodEnd time.Time, nextPeriodStart, nextPeriodEnd time.Time) *LineItemClassification
 
@@ -66,6 +79,9 @@ type BillingService interface {
 
 	// GetCustomerUsageSummary returns usage summaries for a customer's features
 	GetCustomerUsageSummary(ctx context.Context, customerID string, req *dto.GetCustomerUsageSummaryRequest) (*dto.CustomerUsageSummaryResponse, error)
+
+	// CheckEntitlement returns whether a customer can use the requested quantity of a feature
+	CheckEntitlement(ctx context.Context, req *dto.CheckEntitlementRequest) (*dto.CheckEntitlementResponse, error)
 }
 
 type billingService struct {
@@ -87,7 +103,27 @@ func (s *billingService) CalculateFixedCharges(
 	fixedCost := decimal.Zero
 	fixedCostLineItems := make([]dto.CreateInvoiceLineItemRequest, 0)
 
-	priceService := NewPriceService(s.PriceRepo, s.MeterRepo, s.Logger)
+	// Fixed charges are not billed during the trial
+	if sub.IsTrialPeriod(periodStart, periodEnd) {
+		return fixedCostLineItems, fixedCost, nil
+	}
+
+	priceService := NewPriceService(s.DB, s.PriceRepo, s.PlanRepo, s.MeterRepo, s.SubRepo, s.Logger)
+
+	// Quantity changes are only needed to weight the arrear charges of the period
+	var quantityChanges map[string][]*subscription.SubscriptionQuantityChange
+	hasFixedArrear := lo.SomeBy(sub.LineItems, func(item *subscription.SubscriptionLineItem) bool {
+		return item.PriceType == types.PRICE_TYPE_FIXED && item.InvoiceCadence == types.InvoiceCadenceArrear
+	})
+	if hasFixedArrear {
+		changes, err := s.SubRepo.ListQuantityChanges(ctx, sub.ID)
+		if err != nil {
+			return nil, fixedCost, err
+		}
+		quantityChanges = lo.GroupBy(changes, func(c *subscription.SubscriptionQuantityChange) string {
+			return c.SubscriptionLineItemID
+		})
+	}
 
 	// Process fixed charges from line items
 	for _, item := range sub.LineItems {
@@ -101,6 +137,37 @@ func (s *billingService) CalculateFixedCharges(
 		}
 
 		amount := priceService.CalculateCost(ctx, price.Price, item.Quantity)
+		tierBreakdown := priceService.CalculateTierBreakdown(ctx, price.Price, item.Quantity)
+		metadata := types.Metadata{
+			"description": fmt.Sprintf("%s (Fixed Charge)", item.DisplayName),
+		}
+
+		// Line items that took effect mid-period, e.g. after a plan change, are only
+		// charged for the part of the period they were in effect
+		itemPeriodStart := periodStart
+		if item.StartDate.After(periodStart) && item.StartDate.Before(periodEnd) {
+			factor := calculateProrationFactor(periodStart, periodEnd, item.StartDate, periodEnd)
+			amount = amount.Mul(factor).Round(types.GetCurrencyPrecision(price.Price.Currency))
+			itemPeriodStart = item.StartDate
+			tierBreakdown = nil
+			metadata["prorated"] = "true"
+		}
+
+		// Arrear charges whose quantity changed during the period are charged for the time
+		// each quantity was in effect
+		if item.InvoiceCadence == types.InvoiceCadenceArrear {
+			changes := quantityChanges[item.ID]
+			if hasQuantityChangesInPeriod(changes, itemPeriodStart, periodEnd) {
+				amount = decimal.Zero
+				for _, segment := range splitByQuantityChanges(item, changes, itemPeriodStart, periodEnd) {
+					factor := calculateProrationFactor(periodStart, periodEnd, segment.start, segment.end)
+					amount = amount.Add(priceService.CalculateCost(ctx, price.Price, segment.quantity).Mul(factor))
+				}
+				amount = amount.Round(types.GetCurrencyPrecision(price.Price.Currency))
+				tierBreakdown = nil
+				metadata["prorated"] = "true"
+			}
+		}
 
 		fixedCostLineItems = append(fixedCostLineItems, dto.CreateInvoiceLineItemRequest{
 			PlanID:          lo.ToPtr(item.PlanID),
@@ -110,11 +177,10 @@ func (s *billingService) CalculateFixedCharges(
 			DisplayName:     lo.ToPtr(item.DisplayName),
 			Amount:          amount,
 			Quantity:        item.Quantity,
-			PeriodStart:     lo.ToPtr(periodStart),
+			PeriodStart:     lo.ToPtr(itemPeriodStart),
 			PeriodEnd:       lo.ToPtr(periodEnd),
-			Metadata: types.Metadata{
-				"description": fmt.Sprintf("%s (Fixed Charge)", item.DisplayName),
-			},
+			TierBreakdown:   toLineItemTiers(tierBreakdown, price.Price.Currency),
+			Metadata:        metadata,
 		})
 
 		fixedCost = fixedCost.Add(amount)
@@ -123,6 +189,133 @@ func (s *billingService) CalculateFixedCharges(
 	return fixedCostLineItems, fixedCost, nil
 }
 
+// quantitySegment is a part of a billing period during which the quantity of a line item didn't change
+type quantitySegment struct {
+	start    time.Time
+	end      time.Time
+	quantity decimal.Decimal
+}
+
+// hasQuantityChangesInPeriod returns true if any of the changes took effect within the period
+func hasQuantityChangesInPeriod(changes []*subscription.SubscriptionQuantityChange, periodStart, periodEnd time.Time) bool {
+	return lo.SomeBy(changes, func(c *subscription.SubscriptionQuantityChange) bool {
+		return c.EffectiveDate.After(periodStart) && c.EffectiveDate.Before(periodEnd)
+	})
+}
+
+// splitByQuantityChanges splits the period into the segments of the quantities of the line item, the
+// changes must be ordered by effective date
+func splitByQuantityChanges(
+	item *subscription.SubscriptionLineItem,
+	changes []*subscription.SubscriptionQuantityChange,
+	periodStart,
+	periodEnd time.Time,
+) []quantitySegment {
+	// The quantity at the start of the period is the one of the last change before it, or the one
+	// replaced by the first change after it
+	quantity := item.Quantity
+	for i, c := range changes {
+		if c.EffectiveDate.After(periodStart) {
+			if i == 0 {
+				quantity = c.PreviousQuantity
+			}
+			break
+		}
+		quantity = c.Quantity
+	}
+
+	segments := make([]quantitySegment, 0, len(changes)+1)
+	segmentStart := periodStart
+	for _, c := range changes {
+		if !c.EffectiveDate.After(periodStart) {
+			continue
+		}
+		if !c.EffectiveDate.Before(periodEnd) {
+			break
+		}
+
+		segments = append(segments, quantitySegment{start: segmentStart, end: c.EffectiveDate, quantity: quantity})
+		segmentStart = c.EffectiveDate
+		quantity = c.Quantity
+	}
+
+	return append(segments, quantitySegment{start: segmentStart, end: periodEnd, quantity: quantity})
+}
+
+// CalculateQuantityChangeProrations charges the increases of quantities of fixed advance charges that took effect
+// during the period, the period itself was invoiced in advance at the previous quantity
+func (s *billingService) CalculateQuantityChangeProrations(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	periodStart,
+	periodEnd time.Time,
+) ([]dto.CreateInvoiceLineItemRequest, decimal.Decimal, error) {
+	total := decimal.Zero
+	lineItems := make([]dto.CreateInvoiceLineItemRequest, 0)
+
+	if sub.IsTrialPeriod(periodStart, periodEnd) {
+		return lineItems, total, nil
+	}
+
+	advanceItems := lo.KeyBy(lo.Filter(sub.LineItems, func(item *subscription.SubscriptionLineItem, _ int) bool {
+		return item.PriceType == types.PRICE_TYPE_FIXED && item.InvoiceCadence == types.InvoiceCadenceAdvance
+	}), func(item *subscription.SubscriptionLineItem) string {
+		return item.ID
+	})
+	if len(advanceItems) == 0 {
+		return lineItems, total, nil
+	}
+
+	changes, err := s.SubRepo.ListQuantityChanges(ctx, sub.ID)
+	if err != nil {
+		return nil, total, err
+	}
+
+	priceService := NewPriceService(s.DB, s.PriceRepo, s.PlanRepo, s.MeterRepo, s.SubRepo, s.Logger)
+	for _, change := range changes {
+		item, ok := advanceItems[change.SubscriptionLineItemID]
+		if !ok || !change.IsIncrease() ||
+			change.EffectiveDate.Before(periodStart) || !change.EffectiveDate.Before(periodEnd) {
+			continue
+		}
+
+		price, err := priceService.GetPrice(ctx, change.PriceID)
+		if err != nil {
+			return nil, total, err
+		}
+
+		factor := calculateProrationFactor(periodStart, periodEnd, change.EffectiveDate, periodEnd)
+		amount := priceService.CalculateCost(ctx, price.Price, change.Quantity).
+			Sub(priceService.CalculateCost(ctx, price.Price, change.PreviousQuantity)).
+			Mul(factor).
+			Round(types.GetCurrencyPrecision(price.Price.Currency))
+		if !amount.IsPositive() {
+			continue
+		}
+
+		displayName := fmt.Sprintf("%s (quantity %s to %s)", item.DisplayName, change.PreviousQuantity.String(), change.Quantity.String())
+		lineItems = append(lineItems, dto.CreateInvoiceLineItemRequest{
+			PlanID:          lo.ToPtr(item.PlanID),
+			PlanDisplayName: lo.ToPtr(item.PlanDisplayName),
+			PriceID:         lo.ToPtr(item.PriceID),
+			PriceType:       lo.ToPtr(string(item.PriceType)),
+			DisplayName:     lo.ToPtr(displayName),
+			Amount:          amount,
+			Quantity:        change.Quantity.Sub(change.PreviousQuantity),
+			PeriodStart:     lo.ToPtr(change.EffectiveDate),
+			PeriodEnd:       lo.ToPtr(periodEnd),
+			Metadata: types.Metadata{
+				"description":        fmt.Sprintf("%s (Quantity Change Proration)", item.DisplayName),
+				"prorated":           "true",
+				"quantity_change_id": change.ID,
+			},
+		})
+		total = total.Add(amount)
+	}
+
+	return lineItems, total, nil
+}
+
 func (s *billingService) CalculateUsageCharges(
 	ctx context.Context,
 	sub *subscription.Subscription,
@@ -130,7 +323,7 @@ func (s *billingService) CalculateUsageCharges(
 	periodStart,
 	periodEnd time.Time,
 ) ([]dto.CreateInvoiceLineItemRequest, decimal.Decimal, error) {
-	priceService := NewPriceService(s.PriceRepo, s.MeterRepo, s.Logger)
+	priceService := NewPriceService(s.DB, s.PriceRepo, s.PlanRepo, s.MeterRepo, s.SubRepo, s.Logger)
 	entitlementService := NewEntitlementService(s.EntitlementRepo, s.PlanRepo, s.FeatureRepo, s.MeterRepo, s.Logger)
 
 	if usage == nil {
@@ -204,9 +397,25 @@ func (s *billingService) CalculateUsageCharges(
 		}
 
 		// Recompute the amount based on the quantity for calculation
-		lineItemAmount := priceService.CalculateCost(ctx, matchingCharge.Price, quantityForCalculation)
+		usageAmount := priceService.CalculateCost(ctx, matchingCharge.Price, quantityForCalculation)
+		lineItemAmount, minimumChargeApplied := matchingCharge.Price.ApplyMinimumCharge(usageAmount)
+		tierBreakdown := priceService.CalculateTierBreakdown(ctx, matchingCharge.Price, quantityForCalculation)
 		totalUsageCost = totalUsageCost.Add(lineItemAmount)
 
+		metadata := types.Metadata{
+			"description": fmt.Sprintf("%s (Usage Charge)", item.DisplayName),
+		}
+		if matchingCharge.Price.BillingModel == types.BILLING_MODEL_PACKAGE {
+			metadata["packages"] = matchingCharge.Price.CalculatePackageQuantity(quantityForCalculation).String()
+			if matchingCharge.Price.TransformQuantity.IncludedQuantity > 0 {
+				metadata["included_quantity"] = fmt.Sprintf("%d", matchingCharge.Price.TransformQuantity.IncludedQuantity)
+			}
+		}
+		if minimumChargeApplied {
+			metadata["minimum_charge_applied"] = "true"
+			metadata["usage_amount"] = usageAmount.String()
+		}
+
 		s.Logger.Debugw("usage charges for line item",
 			"original_amount", matchingCharge.Amount,
 			"calculated_amount", lineItemAmount,
@@ -228,15 +437,36 @@ func (s *billingService) CalculateUsageCharges(
 			Quantity:         quantityForCalculation,
 			PeriodStart:      lo.ToPtr(periodStart),
 			PeriodEnd:        lo.ToPtr(periodEnd),
-			Metadata: types.Metadata{
-				"description": fmt.Sprintf("%s (Usage Charge)", item.DisplayName),
-			},
+			TierBreakdown:    toLineItemTiers(tierBreakdown, matchingCharge.Price.Currency),
+			Metadata:         metadata,
 		})
 	}
 
 	return usageCharges, totalUsageCost, nil
 }
 
+// toLineItemTiers converts the tier breakdown of a price to invoice line item tiers
+// rounding the tier amounts to the currency precision
+func toLineItemTiers(breakdown []price.TierBreakdown, currency string) []invoice.LineItemTier {
+	if len(breakdown) == 0 {
+		return nil
+	}
+
+	precision := types.GetCurrencyPrecision(currency)
+	tiers := make([]invoice.LineItemTier, len(breakdown))
+	for i, tier := range breakdown {
+		tiers[i] = invoice.LineItemTier{
+			Tier:       tier.TierIndex,
+			UpTo:       tier.UpTo,
+			Quantity:   tier.Quantity,
+			UnitAmount: tier.UnitAmount,
+			FlatAmount: tier.FlatAmount,
+			Amount:     tier.Amount.Round(precision),
+		}
+	}
+	return tiers
+}
+
 func (s *billingService) CalculateAllCharges(
 	ctx context.Context,
 	sub *subscription.Subscription,
@@ -387,6 +617,23 @@ func (s *billingService) PrepareSubscriptionInvoiceRequest(
 			Currency:     sub.Currency,
 		}
 
+		// Quantity increases of the period were not part of its advance charges
+		prorations, prorationsTotal, err := s.CalculateQuantityChangeProrations(ctx, sub, periodStart, periodEnd)
+		if err != nil {
+			return nil, err
+		}
+		calculationResult.FixedCharges = append(calculationResult.FixedCharges, prorations...)
+		calculationResult.TotalAmount = calculationResult.TotalAmount.Add(prorationsTotal)
+
+		trueUp, err := s.calculateCommitmentTrueUp(ctx, sub, periodStart, periodEnd, calculationResult.UsageCharges)
+		if err != nil {
+			return nil, err
+		}
+		if trueUp != nil {
+			calculationResult.FixedCharges = append(calculationResult.FixedCharges, *trueUp)
+			calculationResult.TotalAmount = calculationResult.TotalAmount.Add(trueUp.Amount)
+		}
+
 		description = fmt.Sprintf("Invoice for subscription %s", sub.ID)
 
 	case types.ReferencePointPreview:
@@ -427,6 +674,23 @@ func (s *billingService) PrepareSubscriptionInvoiceRequest(
 			Currency:     sub.Currency,
 		}
 
+		// Quantity increases of the period were not part of its advance charges
+		prorations, prorationsTotal, err := s.CalculateQuantityChangeProrations(ctx, sub, periodStart, periodEnd)
+		if err != nil {
+			return nil, err
+		}
+		calculationResult.FixedCharges = append(calculationResult.FixedCharges, prorations...)
+		calculationResult.TotalAmount = calculationResult.TotalAmount.Add(prorationsTotal)
+
+		trueUp, err := s.calculateCommitmentTrueUp(ctx, sub, periodStart, periodEnd, calculationResult.UsageCharges)
+		if err != nil {
+			return nil, err
+		}
+		if trueUp != nil {
+			calculationResult.FixedCharges = append(calculationResult.FixedCharges, *trueUp)
+			calculationResult.TotalAmount = calculationResult.TotalAmount.Add(trueUp.Amount)
+		}
+
 		description = fmt.Sprintf("Preview invoice for subscription %s", sub.ID)
 		metadata["is_preview"] = "true"
 
@@ -447,6 +711,157 @@ func (s *billingService) PrepareSubscriptionInvoiceRequest(
 		metadata,
 	)
 }
+func (s *billingService) PreparePlanChangeInvoiceRequest(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	endedItems,
+	newItems []*subscription.SubscriptionLineItem,
+	changedAt time.Time,
+	prorate bool,
+) (*dto.CreateInvoiceRequest, error) {
+	periodStart := sub.CurrentPeriodStart
+	periodEnd := sub.CurrentPeriodEnd
+
+	s.Logger.Infow("preparing plan change invoice request",
+		"subscription_id", sub.ID,
+		"period_start", periodStart,
+		"period_end", periodEnd,
+		"changed_at", changedAt,
+		"prorate", prorate)
+
+	// Settle the usage of the ended line items up to the change
+	usageItems := lo.Filter(endedItems, func(item *subscription.SubscriptionLineItem, _ int) bool {
+		return item.PriceType == types.PRICE_TYPE_USAGE && item.InvoiceCadence == types.InvoiceCadenceArrear
+	})
+
+	lineItems := make([]dto.CreateInvoiceLineItemRequest, 0)
+	if len(usageItems) > 0 {
+		usageResult, err := s.CalculateCharges(ctx, sub, usageItems, periodStart, changedAt, true)
+		if err != nil {
+			return nil, err
+		}
+		lineItems = append(lineItems, usageResult.UsageCharges...)
+	}
+
+	// Charge the ended fixed arrear prices for the time they were in effect
+	for _, item := range endedItems {
+		if item.PriceType != types.PRICE_TYPE_FIXED || item.InvoiceCadence != types.InvoiceCadenceArrear {
+			continue
+		}
+
+		lineItem, err := s.calculateProratedFixedCharge(ctx, item, periodStart, periodEnd, periodStart, changedAt)
+		if err != nil {
+			return nil, err
+		}
+		if lineItem != nil {
+			lineItems = append(lineItems, *lineItem)
+		}
+	}
+
+	// Charge the new fixed advance prices for the rest of the period, the unused time of the
+	// ended ones is credited against the invoices that charged them
+	if prorate {
+		for _, item := range newItems {
+			if item.PriceType != types.PRICE_TYPE_FIXED || item.InvoiceCadence != types.InvoiceCadenceAdvance {
+				continue
+			}
+
+			lineItem, err := s.calculateProratedFixedCharge(ctx, item, periodStart, periodEnd, changedAt, periodEnd)
+			if err != nil {
+				return nil, err
+			}
+			if lineItem != nil {
+				lineItems = append(lineItems, *lineItem)
+			}
+		}
+	}
+
+	if len(lineItems) == 0 {
+		return nil, nil
+	}
+
+	total := decimal.Zero
+	for _, item := range lineItems {
+		total = total.Add(item.Amount)
+	}
+
+	// Coupons are not applied to plan change invoices so they do not use up a billing period
+	// of the coupon applications
+	return &dto.CreateInvoiceRequest{
+		CustomerID:     sub.CustomerID,
+		SubscriptionID: lo.ToPtr(sub.ID),
+		InvoiceType:    types.InvoiceTypeSubscription,
+		InvoiceStatus:  lo.ToPtr(types.InvoiceStatusDraft),
+		PaymentStatus:  lo.ToPtr(types.PaymentStatusPending),
+		Currency:       sub.Currency,
+		AmountDue:      total,
+		Description:    fmt.Sprintf("Invoice for plan change - subscription %s", sub.ID),
+		DueDate:        lo.ToPtr(changedAt.Add(24 * time.Hour * types.InvoiceDefaultDueDays)),
+		BillingPeriod:  lo.ToPtr(string(sub.BillingPeriod)),
+		PeriodStart:    &periodStart,
+		PeriodEnd:      &periodEnd,
+		BillingReason:  types.InvoiceBillingReasonSubscriptionUpdate,
+		EnvironmentID:  sub.EnvironmentID,
+		Metadata: types.Metadata{
+			"changed_at": changedAt.Format(time.RFC3339),
+		},
+		LineItems: lineItems,
+	}, nil
+}
+
+// calculateProratedFixedCharge returns the invoice line item charging a fixed price line item for
+// the part of the billing period between from and to, or nil when there is nothing to charge
+func (s *billingService) calculateProratedFixedCharge(
+	ctx context.Context,
+	item *subscription.SubscriptionLineItem,
+	periodStart,
+	periodEnd,
+	from,
+	to time.Time,
+) (*dto.CreateInvoiceLineItemRequest, error) {
+	priceService := NewPriceService(s.DB, s.PriceRepo, s.PlanRepo, s.MeterRepo, s.SubRepo, s.Logger)
+	price, err := priceService.GetPrice(ctx, item.PriceID)
+	if err != nil {
+		return nil, err
+	}
+
+	factor := calculateProrationFactor(periodStart, periodEnd, from, to)
+	amount := priceService.CalculateCost(ctx, price.Price, item.Quantity).
+		Mul(factor).
+		Round(types.GetCurrencyPrecision(price.Price.Currency))
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	return &dto.CreateInvoiceLineItemRequest{
+		PlanID:          lo.ToPtr(item.PlanID),
+		PlanDisplayName: lo.ToPtr(item.PlanDisplayName),
+		PriceID:         lo.ToPtr(item.PriceID),
+		PriceType:       lo.ToPtr(string(item.PriceType)),
+		DisplayName:     lo.ToPtr(item.DisplayName),
+		Amount:          amount,
+		Quantity:        item.Quantity,
+		PeriodStart:     lo.ToPtr(from),
+		PeriodEnd:       lo.ToPtr(to),
+		Metadata: types.Metadata{
+			"description":     fmt.Sprintf("%s (Prorated Fixed Charge)", item.DisplayName),
+			"prorated":        "true",
+			"proration_ratio": factor.StringFixed(4),
+		},
+	}, nil
+}
+
+// calculateProrationFactor returns the share of the billing period between from and to
+func calculateProrationFactor(periodStart, periodEnd, from, to time.Time) decimal.Decimal {
+	periodDuration := periodEnd.Sub(periodStart)
+	if periodDuration <= 0 || !to.After(from) {
+		return decimal.Zero
+	}
+
+	factor := decimal.NewFromInt(int64(to.Sub(from))).Div(decimal.NewFromInt(int64(periodDuration)))
+	return decimal.Min(factor, decimal.NewFromInt(1))
+}
+
 func (s *billingService) checkIfChargeInvoiced(
 	invoice *invoice.Invoice,
 	charge *subscription.SubscriptionLineItem,
@@ -592,10 +1007,24 @@ func (s *billingService) CalculateCharges(
 	var err error
 
 	if includeUsage {
+		// Usage from before the line items took effect was settled with the line items they replaced
+		usageStart := periodStart
+		usageItems := lo.Filter(lineItems, func(item *subscription.SubscriptionLineItem, _ int) bool {
+			return item.PriceType == types.PRICE_TYPE_USAGE
+		})
+		if len(usageItems) > 0 {
+			earliest := lo.MinBy(usageItems, func(a, b *subscription.SubscriptionLineItem) bool {
+				return a.StartDate.Before(b.StartDate)
+			})
+			if earliest.StartDate.After(periodStart) && earliest.StartDate.Before(periodEnd) {
+				usageStart = earliest.StartDate
+			}
+		}
+
 		subscriptionService := NewSubscriptionService(s.ServiceParams)
 		usage, err = subscriptionService.GetUsageBySubscription(ctx, &dto.GetUsageBySubscriptionRequest{
 			SubscriptionID: sub.ID,
-			StartTime:      periodStart,
+			StartTime:      usageStart,
 			EndTime:        periodEnd,
 		})
 		if err != nil {
@@ -630,6 +1059,17 @@ func (s *billingService) CreateInvoiceRequestForCharges(
 		}
 	}
 
+	// Apply the discounts of the coupons applied to the subscription and its customer
+	discounts, err := calculateInvoiceDiscounts(ctx, s.CouponRepo, sub, result.TotalAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	totalDiscount := decimal.Zero
+	for _, d := range discounts {
+		totalDiscount = totalDiscount.Add(d.Amount)
+	}
+
 	// Create invoice request
 	req := &dto.CreateInvoiceRequest{
 		CustomerID:     sub.CustomerID,
@@ -638,7 +1078,8 @@ func (s *billingService) CreateInvoiceRequestForCharges(
 		InvoiceStatus:  lo.ToPtr(types.InvoiceStatusDraft),
 		PaymentStatus:  lo.ToPtr(types.PaymentStatusPending),
 		Currency:       sub.Currency,
-		AmountDue:      result.TotalAmount,
+		AmountDue:      result.TotalAmount.Sub(totalDiscount),
+		Discounts:      discounts,
 		Description:    description,
 		DueDate:        lo.ToPtr(invoiceDueDate),
 		BillingPeriod:  lo.ToPtr(string(sub.BillingPeriod)),
@@ -1086,3 +1527,221 @@ func (s *billingService) getUsagePercent(usage decimal.Decimal, limit *int64) de
 
 	return usage.Div(decimal.NewFromInt(*limit))
 }
+
+// entitlementUsageCounter is the usage of a customer feature read by entitlement checks. The
+// aggregated usage of the customer is cached and reconciled in the background once it is older
+// than cache.ExpiryEntitlementUsage, and the quantities of allowed checks are reserved on top of
+// it under the lock of the counter so that concurrent checks cannot exceed the limit together.
+// A nil summary means the customer is not entitled to the feature.
+//
+// The counters live in the memory of each instance, so concurrent checks are only serialised
+// within an instance.
+type entitlementUsageCounter struct {
+	mu         sync.Mutex
+	CustomerID string
+	Summary    *dto.FeatureUsageSummary
+
+	loaded       bool
+	reconciling  bool
+	aggregatedAt time.Time
+	reservations []entitlementReservation
+}
+
+// entitlementReservation is a quantity allowed by a check that is not known to be ingested yet
+type entitlementReservation struct {
+	quantity   decimal.Decimal
+	reservedAt time.Time
+}
+
+// entitlementUsageCountersMu serialises the creation of the entitlement usage counters
+var entitlementUsageCountersMu sync.Mutex
+
+// usage returns the aggregated usage of the counter plus the quantities reserved by allowed checks
+func (c *entitlementUsageCounter) usage() decimal.Decimal {
+	usage := c.Summary.CurrentUsage
+	for _, r := range c.reservations {
+		usage = usage.Add(r.quantity)
+	}
+	return usage
+}
+
+// reserve adds the quantity of an allowed check, merging it with the last reservation of the same second
+func (c *entitlementUsageCounter) reserve(quantity decimal.Decimal, now time.Time) {
+	reservedAt := now.Truncate(time.Second)
+	if n := len(c.reservations); n > 0 && c.reservations[n-1].reservedAt.Equal(reservedAt) {
+		c.reservations[n-1].quantity = c.reservations[n-1].quantity.Add(quantity)
+		return
+	}
+	c.reservations = append(c.reservations, entitlementReservation{quantity: quantity, reservedAt: reservedAt})
+}
+
+// setSummary replaces the aggregated usage of the counter. The growth of the aggregated usage is
+// taken as the ingestion of the oldest reservations, which are dropped up to it, and reservations
+// older than cache.ExpiryEntitlementReservation at the start of the aggregation are dropped as
+// they were either ingested or never used.
+func (c *entitlementUsageCounter) setSummary(summary *dto.FeatureUsageSummary, aggregatedAt time.Time) {
+	ingested := decimal.Zero
+	if c.Summary != nil && summary != nil {
+		ingested = decimal.Max(summary.CurrentUsage.Sub(c.Summary.CurrentUsage), decimal.Zero)
+	}
+
+	reservations := make([]entitlementReservation, 0, len(c.reservations))
+	for _, r := range c.reservations {
+		if !r.reservedAt.Add(cache.ExpiryEntitlementReservation).After(aggregatedAt) {
+			continue
+		}
+
+		covered := decimal.Min(ingested, r.quantity)
+		ingested = ingested.Sub(covered)
+		if r.quantity.Equal(covered) {
+			continue
+		}
+
+		r.quantity = r.quantity.Sub(covered)
+		reservations = append(reservations, r)
+	}
+
+	c.Summary = summary
+	c.aggregatedAt = aggregatedAt
+	c.reservations = reservations
+}
+
+// CheckEntitlement checks the quantity against the usage counter of the customer feature and
+// reserves it when allowed. The aggregated usage is reconciled with the ingested events in the
+// background, so usage that was never checked can lag behind by up to cache.ExpiryEntitlementUsage.
+// Counters are kept per instance, so the limit is enforced by each instance on its own.
+func (s *billingService) CheckEntitlement(ctx context.Context, req *dto.CheckEntitlementRequest) (*dto.CheckEntitlementResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	counter := s.getEntitlementUsageCounter(ctx, req.ExternalCustomerID, req.FeatureID)
+
+	counter.mu.Lock()
+	defer counter.mu.Unlock()
+
+	if !counter.loaded {
+		customer, err := s.CustomerRepo.GetByLookupKey(ctx, req.ExternalCustomerID)
+		if err != nil {
+			return nil, err
+		}
+
+		aggregatedAt := time.Now()
+		summary, err := s.getFeatureUsageSummary(ctx, customer.ID, req.FeatureID)
+		if err != nil {
+			return nil, err
+		}
+
+		counter.CustomerID = customer.ID
+		counter.setSummary(summary, aggregatedAt)
+		counter.loaded = true
+	} else if !counter.reconciling && time.Since(counter.aggregatedAt) > cache.ExpiryEntitlementUsage {
+		counter.reconciling = true
+		go s.reconcileEntitlementUsageCounter(context.WithoutCancel(ctx), counter, req.FeatureID)
+	}
+
+	resp := &dto.CheckEntitlementResponse{
+		CustomerID:   counter.CustomerID,
+		FeatureID:    req.FeatureID,
+		CurrentUsage: decimal.Zero,
+	}
+
+	summary := counter.Summary
+	if summary == nil || !summary.IsEnabled {
+		resp.Reason = types.EntitlementCheckReasonNoEntitlement
+		return resp, nil
+	}
+
+	resp.Allowed = true
+	resp.FeatureType = summary.Feature.Type
+	resp.IsSoftLimit = summary.IsSoftLimit
+	resp.UsageLimit = summary.TotalLimit
+	resp.CurrentUsage = counter.usage()
+
+	if summary.Feature.Type != types.FeatureTypeMetered {
+		return resp, nil
+	}
+
+	// only metered features with a usage limit are limited, a nil limit is unlimited
+	if summary.TotalLimit != nil {
+		remaining := decimal.Max(decimal.NewFromInt(*summary.TotalLimit).Sub(resp.CurrentUsage), decimal.Zero)
+		resp.Remaining = &remaining
+
+		if req.GetQuantity().GreaterThan(remaining) {
+			if summary.IsSoftLimit {
+				resp.Reason = types.EntitlementCheckReasonSoftLimitExceeded
+			} else {
+				resp.Allowed = false
+				resp.Reason = types.EntitlementCheckReasonLimitExceeded
+				return resp, nil
+			}
+		}
+	}
+
+	counter.reserve(req.GetQuantity(), time.Now())
+
+	return resp, nil
+}
+
+// getEntitlementUsageCounter returns the usage counter of the customer feature, creating an
+// unloaded counter when there is none or it expired from the cache
+func (s *billingService) getEntitlementUsageCounter(ctx context.Context, externalCustomerID, featureID string) *entitlementUsageCounter {
+	cacheKey := cache.GenerateKey(cache.PrefixEntitlementUsage,
+		types.GetTenantID(ctx), types.GetEnvironmentID(ctx), externalCustomerID, featureID)
+	memCache := cache.GetInMemoryCache()
+
+	entitlementUsageCountersMu.Lock()
+	defer entitlementUsageCountersMu.Unlock()
+
+	if value, found := memCache.Get(ctx, cacheKey); found {
+		if counter, ok := value.(*entitlementUsageCounter); ok {
+			return counter
+		}
+	}
+
+	counter := &entitlementUsageCounter{}
+	memCache.Set(ctx, cacheKey, counter, cache.ExpiryDefaultInMemory)
+	return counter
+}
+
+// reconcileEntitlementUsageCounter replaces the aggregated usage of the counter with the usage of
+// the customer and drops the reservations it covers. A failed reconciliation keeps the counter as
+// is and is retried by the next check.
+func (s *billingService) reconcileEntitlementUsageCounter(ctx context.Context, counter *entitlementUsageCounter, featureID string) {
+	counter.mu.Lock()
+	customerID := counter.CustomerID
+	counter.mu.Unlock()
+
+	aggregatedAt := time.Now()
+	summary, err := s.getFeatureUsageSummary(ctx, customerID, featureID)
+
+	counter.mu.Lock()
+	defer counter.mu.Unlock()
+
+	counter.reconciling = false
+	if err != nil {
+		s.Logger.Errorw("failed to reconcile entitlement usage counter",
+			"customer_id", customerID,
+			"feature_id", featureID,
+			"error", err)
+		return
+	}
+
+	counter.setSummary(summary, aggregatedAt)
+}
+
+// getFeatureUsageSummary returns the usage summary of the feature for the customer or nil when
+// the customer is not entitled to the feature
+func (s *billingService) getFeatureUsageSummary(ctx context.Context, customerID, featureID string) (*dto.FeatureUsageSummary, error) {
+	usage, err := s.GetCustomerUsageSummary(ctx, customerID, &dto.GetCustomerUsageSummaryRequest{
+		FeatureIDs: []string{featureID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	summary, _ := lo.Find(usage.Features, func(f *dto.FeatureUsageSummary) bool {
+		return f.Feature.ID == featureID
+	})
+	return summary, nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// calculateCommitmentTrueUp returns the line item charging the shortfall of the usage charges of the
+// subscription against its minimum commitment, or nil when the commitment is met or is not due with
+// the invoice of the period. The usage already invoiced in the commitment window, like the earlier
+// periods of an annual commitment or the settlement of a plan change, counts towards the commitment.
+// The commitment is prorated when the subscription ends before the end of the commitment window.
+func (s *billingService) calculateCommitmentTrueUp(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	periodStart,
+	periodEnd time.Time,
+	usageCharges []dto.CreateInvoiceLineItemRequest,
+) (*dto.CreateInvoiceLineItemRequest, error) {
+	if sub.CommitmentAmount == nil || !sub.CommitmentAmount.IsPositive() {
+		return nil, nil
+	}
+
+	// Nothing is committed to during the trial
+	if sub.TrialEnd != nil && !periodEnd.After(*sub.TrialEnd) {
+		return nil, nil
+	}
+
+	// A period cut short, e.g. by a cancellation, is reconciled against the full billing period
+	windowStart := periodStart
+	windowEnd, err := types.NextBillingDate(periodStart, sub.BillingAnchor, sub.BillingPeriodCount, sub.BillingPeriod)
+	if err != nil {
+		return nil, err
+	}
+	if windowEnd.Before(periodEnd) {
+		windowEnd = periodEnd
+	}
+
+	subscriptionEnd := commitmentEndDate(sub)
+	if sub.CommitmentInterval == types.CommitmentIntervalAnnual {
+		// The years of the commitment start on the billing anchor, which is the end of the
+		// trial for subscriptions that started with one
+		windowStart = sub.BillingAnchor
+		for !windowStart.AddDate(1, 0, 0).After(periodStart) {
+			windowStart = windowStart.AddDate(1, 0, 0)
+		}
+		windowEnd = windowStart.AddDate(1, 0, 0)
+
+		// The annual commitment is reconciled with the invoice of the last period of the year,
+		// or of the last period of the subscription when it ends during the year
+		isLastPeriod := subscriptionEnd != nil && !periodEnd.Before(*subscriptionEnd)
+		if periodEnd.Before(windowEnd) && !isLastPeriod {
+			return nil, nil
+		}
+	}
+
+	committedEnd := lo.Ternary(periodEnd.Before(windowEnd), periodEnd, windowEnd)
+	if subscriptionEnd != nil && subscriptionEnd.Before(committedEnd) {
+		committedEnd = *subscriptionEnd
+	}
+
+	commitmentAmount := *sub.CommitmentAmount
+	prorated := committedEnd.Before(windowEnd)
+	if prorated {
+		factor := calculateProrationFactor(windowStart, windowEnd, windowStart, committedEnd)
+		commitmentAmount = commitmentAmount.Mul(factor).Round(types.GetCurrencyPrecision(sub.Currency))
+	}
+
+	invoiceFilter := types.NewNoLimitInvoiceFilter()
+	invoiceFilter.SubscriptionID = sub.ID
+	invoiceFilter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusDraft, types.InvoiceStatusFinalized}
+
+	invoices, err := s.InvoiceRepo.List(ctx, invoiceFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	usageAmount := decimal.Zero
+	for _, inv := range invoices {
+		for _, item := range inv.LineItems {
+			if lo.FromPtr(item.PriceType) != string(types.PRICE_TYPE_USAGE) || item.PeriodStart == nil || item.PeriodEnd == nil {
+				continue
+			}
+			if item.PeriodStart.Before(windowStart) || item.PeriodEnd.After(windowEnd) {
+				continue
+			}
+			usageAmount = usageAmount.Add(item.Amount)
+		}
+	}
+
+	for _, charge := range usageCharges {
+		usageAmount = usageAmount.Add(charge.Amount)
+	}
+
+	shortfall := commitmentAmount.Sub(usageAmount)
+	if !shortfall.IsPositive() {
+		return nil, nil
+	}
+
+	s.Logger.Infow("usage charges below minimum commitment",
+		"subscription_id", sub.ID,
+		"commitment_amount", commitmentAmount.String(),
+		"commitment_interval", sub.CommitmentInterval,
+		"prorated", prorated,
+		"usage_amount", usageAmount.String(),
+		"window_start", windowStart,
+		"window_end", windowEnd)
+
+	metadata := types.Metadata{
+		"commitment_true_up":  "true",
+		"commitment_amount":   commitmentAmount.String(),
+		"commitment_interval": string(sub.CommitmentInterval),
+		"usage_amount":        usageAmount.String(),
+	}
+	if prorated {
+		metadata["prorated"] = "true"
+	}
+
+	return &dto.CreateInvoiceLineItemRequest{
+		PlanID:      lo.ToPtr(sub.PlanID),
+		DisplayName: lo.ToPtr("Minimum commitment true-up"),
+		Amount:      shortfall,
+		Quantity:    decimal.NewFromInt(1),
+		PeriodStart: lo.ToPtr(windowStart),
+		PeriodEnd:   lo.ToPtr(committedEnd),
+		Metadata:    metadata,
+	}, nil
+}
+
+// commitmentEndDate returns the date the subscription stops being billed, if it is cancelled or
+// has an end date
+func commitmentEndDate(sub *subscription.Subscription) *time.Time {
+	var end *time.Time
+	candidates := []*time.Time{sub.EndDate}
+	if sub.SubscriptionStatus == types.SubscriptionStatusCancelled {
+		candidates = append(candidates, sub.CancelledAt)
+	}
+	if sub.CancelAtPeriodEnd {
+		candidates = append(candidates, sub.CancelAt)
+	}
+	for _, candidate := range candidates {
+		if candidate != nil && (end == nil || candidate.Before(*end)) {
+			end = candidate
+		}
+	}
+	return end
+}
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type BillingCommitmentTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service        SubscriptionService
+	billingService BillingService
+	testData       struct {
+		customer   *customer.Customer
+		plan       *plan.Plan
+		meter      *meter.Meter
+		usagePrice *price.Price
+	}
+}
+
+func TestBillingCommitment(t *testing.T) {
+	suite.Run(t, new(BillingCommitmentTestSuite))
+}
+
+func (s *BillingCommitmentTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	params := ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		PlanRepo:         s.GetStores().PlanRepo,
+		PriceRepo:        s.GetStores().PriceRepo,
+		EventRepo:        s.GetStores().EventRepo,
+		MeterRepo:        s.GetStores().MeterRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		EntitlementRepo:  s.GetStores().EntitlementRepo,
+		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
+		FeatureRepo:      s.GetStores().FeatureRepo,
+		TenantRepo:       s.GetStores().TenantRepo,
+		UserRepo:         s.GetStores().UserRepo,
+		AuthRepo:         s.GetStores().AuthRepo,
+		WalletRepo:       s.GetStores().WalletRepo,
+		PaymentRepo:      s.GetStores().PaymentRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	}
+	s.service = NewSubscriptionService(params)
+	s.billingService = NewBillingService(params)
+	s.setupTestData()
+}
+
+func (s *BillingCommitmentTestSuite) setupTestData() {
+	ctx := s.GetContext()
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_committed",
+		ExternalID: "ext_cust_committed",
+		Name:       "Committed Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	s.testData.plan = &plan.Plan{
+		ID:                 "plan_committed",
+		Name:               "Committed",
+		Version:            1,
+		CommitmentAmount:   lo.ToPtr(decimal.NewFromInt(50)),
+		CommitmentInterval: types.CommitmentIntervalBillingPeriod,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, s.testData.plan))
+
+	s.testData.meter = &meter.Meter{
+		ID:        "meter_api_calls",
+		Name:      "API Calls",
+		EventName: "api_call",
+		Aggregation: meter.Aggregation{
+			Type: types.AggregationCount,
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().MeterRepo.CreateMeter(ctx, s.testData.meter))
+
+	s.testData.usagePrice = &price.Price{
+		ID:                 "price_api_calls",
+		Amount:             decimal.NewFromFloat(0.1),
+		Currency:           "usd",
+		PlanID:             s.testData.plan.ID,
+		Type:               types.PRICE_TYPE_USAGE,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceArrear,
+		MeterID:            s.testData.meter.ID,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, s.testData.usagePrice))
+}
+
+// createSubscription creates a subscription with 100 API calls, 10 USD of usage charges
+func (s *BillingCommitmentTestSuite) createSubscription(amount *decimal.Decimal, interval types.CommitmentInterval) *subscription.Subscription {
+	ctx := s.GetContext()
+
+	resp, err := s.service.CreateSubscription(ctx, dto.CreateSubscriptionRequest{
+		CustomerID:         s.testData.customer.ID,
+		PlanID:             s.testData.plan.ID,
+		StartDate:          time.Now().UTC().Add(-time.Hour),
+		Currency:           "usd",
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		CommitmentAmount:   amount,
+		CommitmentInterval: interval,
+	})
+	s.Require().NoError(err)
+
+	for i := 0; i < 100; i++ {
+		s.NoError(s.GetStores().EventRepo.InsertEvent(ctx, &events.Event{
+			ID:                 s.GetUUID(),
+			TenantID:           resp.TenantID,
+			EventName:          s.testData.meter.EventName,
+			ExternalCustomerID: s.testData.customer.ExternalID,
+			Timestamp:          time.Now().UTC().Add(-30 * time.Minute),
+			Properties:         map[string]interface{}{},
+		}))
+	}
+
+	sub, _, err := s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, resp.ID)
+	s.Require().NoError(err)
+	return sub
+}
+
+func (s *BillingCommitmentTestSuite) trueUp(req *dto.CreateInvoiceRequest) (dto.CreateInvoiceLineItemRequest, bool) {
+	return lo.Find(req.LineItems, func(item dto.CreateInvoiceLineItemRequest) bool {
+		return item.Metadata["commitment_true_up"] == "true"
+	})
+}
+
+func (s *BillingCommitmentTestSuite) TestTrueUpForShortfall() {
+	sub := s.createSubscription(nil, "")
+
+	// The subscription inherits the commitment of the plan
+	s.Require().NotNil(sub.CommitmentAmount)
+	s.True(decimal.NewFromInt(50).Equal(*sub.CommitmentAmount))
+	s.Equal(types.CommitmentIntervalBillingPeriod, sub.CommitmentInterval)
+
+	req, err := s.billingService.PrepareSubscriptionInvoiceRequest(s.GetContext(), sub,
+		sub.CurrentPeriodStart, sub.CurrentPeriodEnd, types.ReferencePointPeriodEnd)
+	s.NoError(err)
+
+	trueUp, ok := s.trueUp(req)
+	s.Require().True(ok)
+	s.True(decimal.NewFromInt(40).Equal(trueUp.Amount), "true-up amount %s", trueUp.Amount)
+	s.Equal("10", trueUp.Metadata["usage_amount"])
+	s.True(decimal.NewFromInt(50).Equal(req.AmountDue), "amount due %s", req.AmountDue)
+}
+
+func (s *BillingCommitmentTestSuite) TestNoTrueUpWhenCommitmentMet() {
+	sub := s.createSubscription(lo.ToPtr(decimal.NewFromInt(5)), "")
+
+	req, err := s.billingService.PrepareSubscriptionInvoiceRequest(s.GetContext(), sub,
+		sub.CurrentPeriodStart, sub.CurrentPeriodEnd, types.ReferencePointPeriodEnd)
+	s.NoError(err)
+
+	_, ok := s.trueUp(req)
+	s.False(ok)
+	s.True(decimal.NewFromInt(10).Equal(req.AmountDue), "amount due %s", req.AmountDue)
+}
+
+func (s *BillingCommitmentTestSuite) TestAnnualCommitment() {
+	ctx := s.GetContext()
+	sub := s.createSubscription(lo.ToPtr(decimal.NewFromInt(120)), types.CommitmentIntervalAnnual)
+
+	// Not reconciled before the end of the year of the subscription
+	req, err := s.billingService.PrepareSubscriptionInvoiceRequest(ctx, sub,
+		sub.CurrentPeriodStart, sub.CurrentPeriodEnd, types.ReferencePointPreview)
+	s.NoError(err)
+	_, ok := s.trueUp(req)
+	s.False(ok)
+
+	// Move the billing anchor of the subscription so that the current period is the last of its
+	// year, with the usage of an earlier period of the year already invoiced
+	yearStart := sub.CurrentPeriodEnd.AddDate(-1, 0, 0)
+	sub.StartDate = yearStart
+	sub.BillingAnchor = yearStart
+	s.NoError(s.GetStores().InvoiceRepo.CreateWithLineItems(ctx, &invoice.Invoice{
+		ID:              s.GetUUID(),
+		CustomerID:      sub.CustomerID,
+		SubscriptionID:  lo.ToPtr(sub.ID),
+		InvoiceType:     types.InvoiceTypeSubscription,
+		InvoiceStatus:   types.InvoiceStatusFinalized,
+		Currency:        "usd",
+		AmountDue:       decimal.NewFromInt(30),
+		AmountRemaining: decimal.NewFromInt(30),
+		LineItems: []*invoice.InvoiceLineItem{{
+			ID:             s.GetUUID(),
+			CustomerID:     sub.CustomerID,
+			SubscriptionID: lo.ToPtr(sub.ID),
+			PriceID:        lo.ToPtr(s.testData.usagePrice.ID),
+			PriceType:      lo.ToPtr(string(types.PRICE_TYPE_USAGE)),
+			Amount:         decimal.NewFromInt(30),
+			Quantity:       decimal.NewFromInt(300),
+			Currency:       "usd",
+			PeriodStart:    lo.ToPtr(yearStart),
+			PeriodEnd:      lo.ToPtr(yearStart.AddDate(0, 1, 0)),
+			BaseModel:      types.GetDefaultBaseModel(ctx),
+		}},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}))
+
+	req, err = s.billingService.PrepareSubscriptionInvoiceRequest(ctx, sub,
+		sub.CurrentPeriodStart, sub.CurrentPeriodEnd, types.ReferencePointPreview)
+	s.NoError(err)
+
+	trueUp, ok := s.trueUp(req)
+	s.Require().True(ok)
+	s.True(decimal.NewFromInt(80).Equal(trueUp.Amount), "true-up amount %s", trueUp.Amount)
+	s.Equal(yearStart, *trueUp.PeriodStart)
+	s.Equal(sub.CurrentPeriodEnd, *trueUp.PeriodEnd)
+}
+
+func (s *BillingCommitmentTestSuite) TestAnnualCommitmentAfterTrial() {
+	ctx := s.GetContext()
+	sub := s.createSubscription(lo.ToPtr(decimal.NewFromInt(120)), types.CommitmentIntervalAnnual)
+
+	// The subscription started with a two week trial, its years start at the end of the trial
+	yearStart := sub.CurrentPeriodEnd.AddDate(-1, 0, 0)
+	sub.StartDate = yearStart.AddDate(0, 0, -14)
+	sub.TrialEnd = lo.ToPtr(yearStart)
+	sub.BillingAnchor = yearStart
+
+	req, err := s.billingService.PrepareSubscriptionInvoiceRequest(ctx, sub,
+		sub.CurrentPeriodStart, sub.CurrentPeriodEnd, types.ReferencePointPreview)
+	s.NoError(err)
+
+	trueUp, ok := s.trueUp(req)
+	s.Require().True(ok)
+	s.True(decimal.NewFromInt(110).Equal(trueUp.Amount), "true-up amount %s", trueUp.Amount)
+	s.Equal(yearStart, *trueUp.PeriodStart)
+	s.Equal(sub.CurrentPeriodEnd, *trueUp.PeriodEnd)
+
+	// Nothing is committed to during the trial
+	req, err = s.billingService.PrepareSubscriptionInvoiceRequest(ctx, sub,
+		sub.StartDate, yearStart, types.ReferencePointPreview)
+	s.NoError(err)
+	_, ok = s.trueUp(req)
+	s.False(ok)
+}
+
+func (s *BillingCommitmentTestSuite) TestCommitmentProratedForCancelledPeriod() {
+	sub := s.createSubscription(nil, "")
+
+	// Cancelled halfway through the period, half of the commitment of 50 is due
+	cancelledAt := sub.CurrentPeriodStart.Add(sub.CurrentPeriodEnd.Sub(sub.CurrentPeriodStart) / 2)
+	sub.SubscriptionStatus = types.SubscriptionStatusCancelled
+	sub.CancelledAt = lo.ToPtr(cancelledAt)
+
+	req, err := s.billingService.PrepareSubscriptionInvoiceRequest(s.GetContext(), sub,
+		sub.CurrentPeriodStart, sub.CurrentPeriodEnd, types.ReferencePointPeriodEnd)
+	s.NoError(err)
+
+	trueUp, ok := s.trueUp(req)
+	s.Require().True(ok)
+	s.True(decimal.NewFromInt(15).Equal(trueUp.Amount), "true-up amount %s", trueUp.Amount)
+	s.Equal("25", trueUp.Metadata["commitment_amount"])
+	s.Equal("true", trueUp.Metadata["prorated"])
+	s.Equal(cancelledAt, *trueUp.PeriodEnd)
+}
+
+func (s *BillingCommitmentTestSuite) TestAnnualCommitmentProratedForCancellation() {
+	sub := s.createSubscription(lo.ToPtr(decimal.NewFromInt(1200)), types.CommitmentIntervalAnnual)
+
+	// Cancelled at the end of the first period, the commitment is reconciled for the part of
+	// the year the subscription was active
+	sub.CancelAtPeriodEnd = true
+	sub.CancelAt = lo.ToPtr(sub.CurrentPeriodEnd)
+
+	req, err := s.billingService.PrepareSubscriptionInvoiceRequest(s.GetContext(), sub,
+		sub.CurrentPeriodStart, sub.CurrentPeriodEnd, types.ReferencePointPeriodEnd)
+	s.NoError(err)
+
+	yearEnd := sub.BillingAnchor.AddDate(1, 0, 0)
+	committed := decimal.NewFromInt(1200).
+		Mul(calculateProrationFactor(sub.BillingAnchor, yearEnd, sub.BillingAnchor, sub.CurrentPeriodEnd)).
+		Round(2)
+
+	trueUp, ok := s.trueUp(req)
+	s.Require().True(ok)
+	s.True(committed.Sub(decimal.NewFromInt(10)).Equal(trueUp.Amount), "true-up amount %s", trueUp.Amount)
+	s.Equal("true", trueUp.Metadata["prorated"])
+	s.Equal(sub.BillingAnchor, *trueUp.PeriodStart)
+	s.Equal(sub.CurrentPeriodEnd, *trueUp.PeriodEnd)
+}
+
+func (s *BillingCommitmentTestSuite) TestCommitmentValidation() {
+	tests := []struct {
+		name     string
+		amount   *decimal.Decimal
+		interval types.CommitmentInterval
+	}{
+		{
+			name:   "negative amount",
+			amount: lo.ToPtr(decimal.NewFromInt(-1)),
+		},
+		{
+			name:     "invalid interval",
+			amount:   lo.ToPtr(decimal.NewFromInt(10)),
+			interval: types.CommitmentInterval("weekly"),
+		},
+		{
+			name:     "interval without amount",
+			interval: types.CommitmentIntervalAnnual,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.service.CreateSubscription(s.GetContext(), dto.CreateSubscriptionRequest{
+				CustomerID:         s.testData.customer.ID,
+				PlanID:             s.testData.plan.ID,
+				StartDate:          time.Now().UTC(),
+				Currency:           "usd",
+				BillingCadence:     types.BILLING_CADENCE_RECURRING,
+				BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+				BillingPeriodCount: 1,
+				CommitmentAmount:   tt.amount,
+				CommitmentInterval: tt.interval,
+			})
+			s.Error(err)
+			s.True(ierr.IsValidation(err))
+		})
+	}
+}
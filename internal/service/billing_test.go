@@ -1,17 +1,24 @@
 package service
 
 import (
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/cache"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
 	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
@@ -78,6 +85,9 @@ func (s *BillingServiceSuite) setupService() {
 		InvoiceRepo:      s.GetStores().InvoiceRepo,
 		EntitlementRepo:  s.GetStores().EntitlementRepo,
 		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
 		FeatureRepo:      s.GetStores().FeatureRepo,
 		TenantRepo:       s.GetStores().TenantRepo,
 		UserRepo:         s.GetStores().UserRepo,
@@ -553,6 +563,88 @@ func (s *BillingServiceSuite) TestPrepareSubscriptionInvoiceRequest() {
 	}
 }
 
+func (s *BillingServiceSuite) TestPrepareSubscriptionInvoiceRequestWithCoupons() {
+	ctx := s.GetContext()
+	sub := s.testData.subscription
+
+	percentOff := &coupon.Coupon{
+		ID:           "coupon_percent",
+		Name:         "Ten Percent",
+		DiscountType: types.CouponDiscountTypePercentage,
+		PercentOff:   lo.ToPtr(decimal.NewFromInt(10)),
+		Duration:     types.CouponDurationForever,
+		BaseModel:    types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CouponRepo.Create(ctx, percentOff))
+
+	amountOff := &coupon.Coupon{
+		ID:           "coupon_amount",
+		Name:         "Welcome",
+		DiscountType: types.CouponDiscountTypeFixedAmount,
+		AmountOff:    lo.ToPtr(decimal.NewFromInt(5)),
+		Currency:     "usd",
+		Duration:     types.CouponDurationOnce,
+		BaseModel:    types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CouponRepo.Create(ctx, amountOff))
+
+	// Customer level percentage coupon applied before the subscription level fixed amount coupon
+	customerApplication := &coupon.CouponApplication{
+		ID:         "coupon_app_customer",
+		CouponID:   percentOff.ID,
+		CustomerID: sub.CustomerID,
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	customerApplication.CreatedAt = s.testData.now.Add(-2 * time.Hour)
+	s.NoError(s.GetStores().CouponRepo.CreateApplication(ctx, customerApplication))
+
+	subscriptionApplication := &coupon.CouponApplication{
+		ID:             "coupon_app_subscription",
+		CouponID:       amountOff.ID,
+		CustomerID:     sub.CustomerID,
+		SubscriptionID: lo.ToPtr(sub.ID),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+	subscriptionApplication.CreatedAt = s.testData.now.Add(-time.Hour)
+	s.NoError(s.GetStores().CouponRepo.CreateApplication(ctx, subscriptionApplication))
+
+	// Coupon applied to another subscription of the customer is ignored
+	s.NoError(s.GetStores().CouponRepo.CreateApplication(ctx, &coupon.CouponApplication{
+		ID:             "coupon_app_other",
+		CouponID:       amountOff.ID,
+		CustomerID:     sub.CustomerID,
+		SubscriptionID: lo.ToPtr("sub_other"),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}))
+
+	// Fixed charge of 10: 10% off gives 1 and the fixed 5 off brings the total to 4
+	req, err := s.service.PrepareSubscriptionInvoiceRequest(ctx, sub, sub.CurrentPeriodStart, sub.CurrentPeriodEnd, types.ReferencePointPeriodStart)
+	s.NoError(err)
+	s.NoError(req.Validate())
+	s.Len(req.Discounts, 2)
+	s.Equal(customerApplication.ID, req.Discounts[0].CouponApplicationID)
+	s.True(decimal.NewFromInt(1).Equal(req.Discounts[0].Amount))
+	s.Equal(subscriptionApplication.ID, req.Discounts[1].CouponApplicationID)
+	s.True(decimal.NewFromInt(5).Equal(req.Discounts[1].Amount))
+	s.True(decimal.NewFromInt(4).Equal(req.AmountDue))
+
+	inv, err := req.ToInvoice(ctx)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(10).Equal(inv.Subtotal))
+	s.True(decimal.NewFromInt(6).Equal(inv.TotalDiscount))
+	s.True(decimal.NewFromInt(4).Equal(inv.Total))
+
+	// Once the once coupon discounted an invoice only the forever coupon applies
+	subscriptionApplication.PeriodsApplied = 1
+	s.NoError(s.GetStores().CouponRepo.UpdateApplication(ctx, subscriptionApplication))
+
+	req, err = s.service.PrepareSubscriptionInvoiceRequest(ctx, sub, sub.CurrentPeriodStart, sub.CurrentPeriodEnd, types.ReferencePointPeriodStart)
+	s.NoError(err)
+	s.Len(req.Discounts, 1)
+	s.Equal(percentOff.ID, req.Discounts[0].CouponID)
+	s.True(decimal.NewFromInt(9).Equal(req.AmountDue))
+}
+
 // Helper methods for specific validations
 
 func (s *BillingServiceSuite) validatePeriodStartInvoice(req *dto.CreateInvoiceRequest, sub *subscription.Subscription) {
@@ -939,3 +1031,188 @@ func (s *BillingServiceSuite) TestClassifyLineItems() {
 	// Verify usage charges flag
 	s.True(classification.HasUsageCharges, "Should have usage charges")
 }
+
+func (s *BillingServiceSuite) TestCheckEntitlement() {
+	ctx := s.GetContext()
+	cache.GetInMemoryCache().Flush(ctx)
+	defer cache.GetInMemoryCache().Flush(ctx)
+
+	features := []*feature.Feature{
+		{ID: "feat_api_calls", Name: "API Calls", Type: types.FeatureTypeMetered, MeterID: s.testData.meters.apiCalls.ID},
+		{ID: "feat_api_calls_soft", Name: "API Calls Soft", Type: types.FeatureTypeMetered, MeterID: s.testData.meters.apiCalls.ID},
+		{ID: "feat_sso", Name: "SSO", Type: types.FeatureTypeBoolean},
+		{ID: "feat_not_in_plan", Name: "Not In Plan", Type: types.FeatureTypeBoolean},
+	}
+	for _, f := range features {
+		f.BaseModel = types.GetDefaultBaseModel(ctx)
+		s.NoError(s.GetStores().FeatureRepo.Create(ctx, f))
+	}
+
+	entitlements := []*entitlement.Entitlement{
+		{ID: "ent_api_calls", FeatureID: "feat_api_calls", FeatureType: types.FeatureTypeMetered, UsageLimit: lo.ToPtr(int64(600))},
+		{ID: "ent_api_calls_soft", FeatureID: "feat_api_calls_soft", FeatureType: types.FeatureTypeMetered, UsageLimit: lo.ToPtr(int64(100)), IsSoftLimit: true},
+		{ID: "ent_sso", FeatureID: "feat_sso", FeatureType: types.FeatureTypeBoolean},
+	}
+	for _, e := range entitlements {
+		e.PlanID = s.testData.plan.ID
+		e.IsEnabled = true
+		e.BaseModel = types.GetDefaultBaseModel(ctx)
+		_, err := s.GetStores().EntitlementRepo.Create(ctx, e)
+		s.NoError(err)
+	}
+
+	check := func(featureID string, quantity int64) *dto.CheckEntitlementResponse {
+		resp, err := s.service.CheckEntitlement(ctx, &dto.CheckEntitlementRequest{
+			ExternalCustomerID: s.testData.customer.ExternalID,
+			FeatureID:          featureID,
+			Quantity:           lo.ToPtr(decimal.NewFromInt(quantity)),
+		})
+		s.Require().NoError(err)
+		return resp
+	}
+
+	s.Run("within hard limit", func() {
+		resp := check("feat_api_calls", 50)
+		s.True(resp.Allowed)
+		s.Empty(resp.Reason)
+		s.Equal(s.testData.customer.ID, resp.CustomerID)
+		s.True(resp.CurrentUsage.Equal(decimal.NewFromInt(500)))
+		s.Require().NotNil(resp.Remaining)
+		s.True(resp.Remaining.Equal(decimal.NewFromInt(100)))
+	})
+
+	s.Run("over hard limit", func() {
+		resp := check("feat_api_calls", 101)
+		s.False(resp.Allowed)
+		s.Equal(types.EntitlementCheckReasonLimitExceeded, resp.Reason)
+	})
+
+	s.Run("over soft limit", func() {
+		resp := check("feat_api_calls_soft", 1)
+		s.True(resp.Allowed)
+		s.Equal(types.EntitlementCheckReasonSoftLimitExceeded, resp.Reason)
+		s.True(resp.Remaining.IsZero())
+	})
+
+	s.Run("boolean feature", func() {
+		resp := check("feat_sso", 1)
+		s.True(resp.Allowed)
+		s.Nil(resp.Remaining)
+	})
+
+	s.Run("feature not entitled", func() {
+		resp := check("feat_not_in_plan", 1)
+		s.False(resp.Allowed)
+		s.Equal(types.EntitlementCheckReasonNoEntitlement, resp.Reason)
+	})
+
+	s.Run("allowed checks are counted", func() {
+		// 500 ingested and 50 allowed above
+		resp := check("feat_api_calls", 50)
+		s.True(resp.Allowed)
+		s.True(resp.CurrentUsage.Equal(decimal.NewFromInt(550)), "expected 550, got %s", resp.CurrentUsage)
+
+		resp = check("feat_api_calls", 1)
+		s.False(resp.Allowed)
+		s.True(resp.CurrentUsage.Equal(decimal.NewFromInt(600)), "expected 600, got %s", resp.CurrentUsage)
+	})
+
+	s.Run("concurrent checks do not exceed the limit", func() {
+		cache.GetInMemoryCache().Flush(ctx)
+
+		var allowed atomic.Int32
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := s.service.CheckEntitlement(ctx, &dto.CheckEntitlementRequest{
+					ExternalCustomerID: s.testData.customer.ExternalID,
+					FeatureID:          "feat_api_calls",
+					Quantity:           lo.ToPtr(decimal.NewFromInt(10)),
+				})
+				if err == nil && resp.Allowed {
+					allowed.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		// 100 remain of the limit of 600
+		s.Equal(int32(10), allowed.Load())
+	})
+
+	s.Run("counter is reconciled with the ingested usage", func() {
+		cache.GetInMemoryCache().Flush(ctx)
+		s.True(check("feat_api_calls", 10).Allowed)
+
+		for i := 0; i < 100; i++ {
+			s.NoError(s.eventRepo.InsertEvent(ctx, &events.Event{
+				ID:                 s.GetUUID(),
+				TenantID:           s.testData.subscription.TenantID,
+				EventName:          s.testData.meters.apiCalls.EventName,
+				ExternalCustomerID: s.testData.customer.ExternalID,
+				Timestamp:          s.testData.now.Add(-1 * time.Minute),
+				Properties:         map[string]interface{}{},
+			}))
+		}
+
+		counter := s.service.(*billingService).getEntitlementUsageCounter(ctx, s.testData.customer.ExternalID, "feat_api_calls")
+		s.service.(*billingService).reconcileEntitlementUsageCounter(ctx, counter, "feat_api_calls")
+
+		resp := check("feat_api_calls", 1)
+		s.False(resp.Allowed)
+		s.True(resp.CurrentUsage.Equal(decimal.NewFromInt(600)), "expected 600, got %s", resp.CurrentUsage)
+	})
+
+	s.Run("reservations are dropped once ingested", func() {
+		// 600 ingested, over the soft limit of 100
+		cache.GetInMemoryCache().Flush(ctx)
+		s.True(check("feat_api_calls_soft", 5).Allowed)
+
+		counter := s.service.(*billingService).getEntitlementUsageCounter(ctx, s.testData.customer.ExternalID, "feat_api_calls_soft")
+
+		// not ingested yet, the reservation is still counted after the reconciliation
+		s.service.(*billingService).reconcileEntitlementUsageCounter(ctx, counter, "feat_api_calls_soft")
+		s.True(check("feat_api_calls_soft", 0).CurrentUsage.Equal(decimal.NewFromInt(605)))
+
+		for i := 0; i < 5; i++ {
+			s.NoError(s.eventRepo.InsertEvent(ctx, &events.Event{
+				ID:                 s.GetUUID(),
+				TenantID:           s.testData.subscription.TenantID,
+				EventName:          s.testData.meters.apiCalls.EventName,
+				ExternalCustomerID: s.testData.customer.ExternalID,
+				Timestamp:          s.testData.now.Add(-1 * time.Minute),
+				Properties:         map[string]interface{}{},
+			}))
+		}
+
+		// the ingested usage is not counted twice
+		s.service.(*billingService).reconcileEntitlementUsageCounter(ctx, counter, "feat_api_calls_soft")
+		resp := check("feat_api_calls_soft", 0)
+		s.True(resp.CurrentUsage.Equal(decimal.NewFromInt(605)), "expected 605, got %s", resp.CurrentUsage)
+	})
+
+	s.Run("unused reservations expire", func() {
+		cache.GetInMemoryCache().Flush(ctx)
+		s.True(check("feat_api_calls_soft", 0).Allowed)
+
+		counter := s.service.(*billingService).getEntitlementUsageCounter(ctx, s.testData.customer.ExternalID, "feat_api_calls_soft")
+		counter.mu.Lock()
+		counter.reserve(decimal.NewFromInt(20), time.Now().Add(-cache.ExpiryEntitlementReservation))
+		counter.mu.Unlock()
+		s.True(check("feat_api_calls_soft", 0).CurrentUsage.Equal(decimal.NewFromInt(625)))
+
+		s.service.(*billingService).reconcileEntitlementUsageCounter(ctx, counter, "feat_api_calls_soft")
+		resp := check("feat_api_calls_soft", 0)
+		s.True(resp.CurrentUsage.Equal(decimal.NewFromInt(605)), "expected 605, got %s", resp.CurrentUsage)
+	})
+
+	s.Run("unknown customer", func() {
+		_, err := s.service.CheckEntitlement(ctx, &dto.CheckEntitlementRequest{
+			ExternalCustomerID: "ext_missing",
+			FeatureID:          "feat_api_calls",
+		})
+		s.True(ierr.IsNotFound(err))
+	})
+}
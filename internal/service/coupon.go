@@ -0,0 +1,380 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CouponService defines the interface for coupon operations
+type CouponService interface {
+	CreateCoupon(ctx context.Context, req dto.CreateCouponRequest) (*dto.CouponResponse, error)
+	GetCoupon(ctx context.Context, id string) (*dto.CouponResponse, error)
+	ListCoupons(ctx context.Context, filter *types.CouponFilter) (*dto.ListCouponsResponse, error)
+	UpdateCoupon(ctx context.Context, id string, req dto.UpdateCouponRequest) (*dto.CouponResponse, error)
+	DeleteCoupon(ctx context.Context, id string) error
+
+	// ApplyCoupon applies a coupon to a customer or to one of the customer's subscriptions
+	ApplyCoupon(ctx context.Context, couponID string, req dto.ApplyCouponRequest) (*dto.CouponApplicationResponse, error)
+	ListCouponApplications(ctx context.Context, filter *types.CouponApplicationFilter) (*dto.ListCouponApplicationsResponse, error)
+	RemoveCouponApplication(ctx context.Context, id string) error
+}
+
+type couponService struct {
+	ServiceParams
+}
+
+// NewCouponService creates a new coupon service
+func NewCouponService(params ServiceParams) CouponService {
+	return &couponService{
+		ServiceParams: params,
+	}
+}
+
+func (s *couponService) CreateCoupon(ctx context.Context, req dto.CreateCouponRequest) (*dto.CouponResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	c := req.ToCoupon(ctx)
+	if err := s.CouponRepo.Create(ctx, c); err != nil {
+		return nil, err
+	}
+
+	return &dto.CouponResponse{Coupon: c}, nil
+}
+
+func (s *couponService) GetCoupon(ctx context.Context, id string) (*dto.CouponResponse, error) {
+	if id == "" {
+		return nil, ierr.NewError("coupon ID is required").
+			WithHint("Coupon ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	c, err := s.CouponRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CouponResponse{Coupon: c}, nil
+}
+
+func (s *couponService) ListCoupons(ctx context.Context, filter *types.CouponFilter) (*dto.ListCouponsResponse, error) {
+	if filter == nil {
+		filter = types.NewDefaultCouponFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	coupons, err := s.CouponRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.CouponRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListCouponsResponse{
+		Items: make([]*dto.CouponResponse, len(coupons)),
+	}
+
+	for i, c := range coupons {
+		response.Items[i] = &dto.CouponResponse{Coupon: c}
+	}
+
+	response.Pagination = types.NewPaginationResponse(
+		count,
+		filter.GetLimit(),
+		filter.GetOffset(),
+	)
+
+	return response, nil
+}
+
+func (s *couponService) UpdateCoupon(ctx context.Context, id string, req dto.UpdateCouponRequest) (*dto.CouponResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	c, err := s.CouponRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		c.Name = *req.Name
+	}
+	if req.MaxRedemptions != nil {
+		if *req.MaxRedemptions < c.TimesRedeemed {
+			return nil, ierr.NewError("max_redemptions cannot be less than times_redeemed").
+				WithHint("Max redemptions cannot be lower than the number of times the coupon was already redeemed").
+				WithReportableDetails(map[string]any{
+					"max_redemptions": *req.MaxRedemptions,
+					"times_redeemed":  c.TimesRedeemed,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		c.MaxRedemptions = req.MaxRedemptions
+	}
+	if req.RedeemBy != nil {
+		c.RedeemBy = req.RedeemBy
+	}
+	if req.Metadata != nil {
+		c.Metadata = *req.Metadata
+	}
+
+	if err := s.CouponRepo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+
+	return &dto.CouponResponse{Coupon: c}, nil
+}
+
+// DeleteCoupon archives the coupon so it can no longer be applied.
+// Existing applications keep discounting invoices until they run out or are removed.
+func (s *couponService) DeleteCoupon(ctx context.Context, id string) error {
+	if _, err := s.CouponRepo.Get(ctx, id); err != nil {
+		return err
+	}
+
+	return s.CouponRepo.Delete(ctx, id)
+}
+
+func (s *couponService) ApplyCoupon(ctx context.Context, couponID string, req dto.ApplyCouponRequest) (*dto.CouponApplicationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	c, err := s.CouponRepo.Get(ctx, couponID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.IsRedeemable(time.Now().UTC()) {
+		return nil, ierr.NewError("coupon is not redeemable").
+			WithHint("The coupon has expired, reached its maximum redemptions or was archived").
+			WithReportableDetails(map[string]any{
+				"coupon_id":       c.ID,
+				"status":          c.Status,
+				"redeem_by":       c.RedeemBy,
+				"times_redeemed":  c.TimesRedeemed,
+				"max_redemptions": c.MaxRedemptions,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if _, err := s.CustomerRepo.Get(ctx, req.CustomerID); err != nil {
+		return nil, err
+	}
+
+	if req.SubscriptionID != nil {
+		sub, err := s.SubRepo.Get(ctx, *req.SubscriptionID)
+		if err != nil {
+			return nil, err
+		}
+
+		if sub.CustomerID != req.CustomerID {
+			return nil, ierr.NewError("subscription does not belong to customer").
+				WithHint("The subscription must belong to the customer the coupon is applied to").
+				WithReportableDetails(map[string]any{
+					"subscription_id": sub.ID,
+					"customer_id":     req.CustomerID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if c.DiscountType == types.CouponDiscountTypeFixedAmount && !types.IsMatchingCurrency(c.Currency, sub.Currency) {
+			return nil, ierr.NewError("coupon currency does not match subscription currency").
+				WithHint("Fixed amount coupons can only be applied to subscriptions in the same currency").
+				WithReportableDetails(map[string]any{
+					"coupon_currency":       c.Currency,
+					"subscription_currency": sub.Currency,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	// A coupon can only be active once for the same customer and subscription
+	filter := types.NewNoLimitCouponApplicationFilter()
+	filter.CouponID = c.ID
+	filter.CustomerID = req.CustomerID
+	filter.QueryFilter.Status = lo.ToPtr(types.StatusPublished)
+	existing, err := s.CouponRepo.ListApplications(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range existing {
+		if lo.FromPtr(a.SubscriptionID) == lo.FromPtr(req.SubscriptionID) {
+			return nil, ierr.NewError("coupon already applied").
+				WithHint("The coupon is already applied to this customer or subscription").
+				WithReportableDetails(map[string]any{
+					"coupon_application_id": a.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+	}
+
+	application := req.ToCouponApplication(ctx, c.ID)
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.CouponRepo.CreateApplication(ctx, application); err != nil {
+			return err
+		}
+
+		// the redemption is counted with a conditional update so that concurrent
+		// redemptions cannot exceed the maximum
+		redeemed, err := s.CouponRepo.IncrementTimesRedeemed(ctx, c.ID)
+		if err != nil {
+			return err
+		}
+		if !redeemed {
+			return ierr.NewError("coupon reached its maximum redemptions").
+				WithHint("The coupon has reached its maximum redemptions").
+				WithReportableDetails(map[string]any{
+					"coupon_id":       c.ID,
+					"max_redemptions": c.MaxRedemptions,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c, err = s.CouponRepo.Get(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("applied coupon",
+		"coupon_id", c.ID,
+		"coupon_application_id", application.ID,
+		"customer_id", application.CustomerID,
+		"subscription_id", lo.FromPtr(application.SubscriptionID),
+	)
+
+	return &dto.CouponApplicationResponse{
+		CouponApplication: application,
+		Coupon:            &dto.CouponResponse{Coupon: c},
+	}, nil
+}
+
+func (s *couponService) ListCouponApplications(ctx context.Context, filter *types.CouponApplicationFilter) (*dto.ListCouponApplicationsResponse, error) {
+	if filter == nil {
+		filter = types.NewNoLimitCouponApplicationFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	applications, err := s.CouponRepo.ListApplications(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.CouponRepo.CountApplications(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListCouponApplicationsResponse{
+		Items: make([]*dto.CouponApplicationResponse, len(applications)),
+	}
+
+	for i, a := range applications {
+		response.Items[i] = &dto.CouponApplicationResponse{CouponApplication: a}
+	}
+
+	response.Pagination = types.NewPaginationResponse(
+		count,
+		filter.GetLimit(),
+		filter.GetOffset(),
+	)
+
+	return response, nil
+}
+
+// RemoveCouponApplication stops the coupon from discounting future invoices
+func (s *couponService) RemoveCouponApplication(ctx context.Context, id string) error {
+	application, err := s.CouponRepo.GetApplication(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	application.Status = types.StatusArchived
+	return s.CouponRepo.UpdateApplication(ctx, application)
+}
+
+// calculateInvoiceDiscounts returns the discounts of the coupons applied to the subscription
+// and its customer for an invoice with the given subtotal. Coupons are applied in the order
+// they were applied and each discount is capped at the remaining subtotal. This is read only
+// so it can be used for invoice previews, the coupon applications are only updated once the
+// invoice is created.
+func calculateInvoiceDiscounts(
+	ctx context.Context,
+	couponRepo coupon.Repository,
+	sub *subscription.Subscription,
+	subtotal decimal.Decimal,
+) ([]invoice.InvoiceDiscount, error) {
+	if couponRepo == nil || !subtotal.IsPositive() {
+		return nil, nil
+	}
+
+	filter := types.NewNoLimitCouponApplicationFilter()
+	filter.CustomerID = sub.CustomerID
+	filter.QueryFilter.Status = lo.ToPtr(types.StatusPublished)
+	applications, err := couponRepo.ListApplications(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	discounts := make([]invoice.InvoiceDiscount, 0)
+	remaining := subtotal
+	for _, a := range applications {
+		if a.SubscriptionID != nil && *a.SubscriptionID != sub.ID {
+			continue
+		}
+
+		c, err := couponRepo.Get(ctx, a.CouponID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !c.HasRemainingPeriods(a.PeriodsApplied) {
+			continue
+		}
+
+		amount := c.CalculateDiscount(remaining, sub.Currency)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		discounts = append(discounts, invoice.InvoiceDiscount{
+			CouponID:            c.ID,
+			CouponApplicationID: a.ID,
+			Description:         c.GetDescription(),
+			Amount:              amount,
+		})
+
+		remaining = remaining.Sub(amount)
+		if !remaining.IsPositive() {
+			break
+		}
+	}
+
+	return discounts, nil
+}
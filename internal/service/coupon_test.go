@@ -0,0 +1,448 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type CouponServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service  CouponService
+	testData struct {
+		customer     *customer.Customer
+		subscription *subscription.Subscription
+	}
+}
+
+func TestCouponService(t *testing.T) {
+	suite.Run(t, new(CouponServiceSuite))
+}
+
+func (s *CouponServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.setupService()
+	s.setupTestData()
+}
+
+func (s *CouponServiceSuite) setupService() {
+	s.service = NewCouponService(s.serviceParams())
+}
+
+func (s *CouponServiceSuite) serviceParams() ServiceParams {
+	return ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	}
+}
+
+func (s *CouponServiceSuite) setupTestData() {
+	s.BaseServiceTestSuite.ClearStores()
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_123",
+		ExternalID: "ext_cust_123",
+		Name:       "Test Customer",
+		BaseModel:  types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(s.GetContext(), s.testData.customer))
+
+	s.testData.subscription = &subscription.Subscription{
+		ID:                 "sub_123",
+		CustomerID:         s.testData.customer.ID,
+		Currency:           "usd",
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		BaseModel:          types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.Create(s.GetContext(), s.testData.subscription))
+}
+
+func (s *CouponServiceSuite) TestCreateCoupon() {
+	tests := []struct {
+		name    string
+		req     dto.CreateCouponRequest
+		wantErr bool
+	}{
+		{
+			name: "percentage_coupon",
+			req: dto.CreateCouponRequest{
+				Name:         "Twenty Percent",
+				DiscountType: types.CouponDiscountTypePercentage,
+				PercentOff:   lo.ToPtr(decimal.NewFromInt(20)),
+				Duration:     types.CouponDurationForever,
+			},
+		},
+		{
+			name: "repeating_fixed_amount_coupon",
+			req: dto.CreateCouponRequest{
+				Name:              "Five Off",
+				Code:              "FIVEOFF",
+				DiscountType:      types.CouponDiscountTypeFixedAmount,
+				AmountOff:         lo.ToPtr(decimal.NewFromInt(5)),
+				Currency:          "USD",
+				Duration:          types.CouponDurationRepeating,
+				DurationInPeriods: lo.ToPtr(3),
+			},
+		},
+		{
+			name: "percent_off_above_100",
+			req: dto.CreateCouponRequest{
+				Name:         "Too Much",
+				DiscountType: types.CouponDiscountTypePercentage,
+				PercentOff:   lo.ToPtr(decimal.NewFromInt(120)),
+				Duration:     types.CouponDurationOnce,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fixed_amount_without_currency",
+			req: dto.CreateCouponRequest{
+				Name:         "No Currency",
+				DiscountType: types.CouponDiscountTypeFixedAmount,
+				AmountOff:    lo.ToPtr(decimal.NewFromInt(5)),
+				Duration:     types.CouponDurationOnce,
+			},
+			wantErr: true,
+		},
+		{
+			name: "repeating_without_periods",
+			req: dto.CreateCouponRequest{
+				Name:         "Repeating",
+				DiscountType: types.CouponDiscountTypePercentage,
+				PercentOff:   lo.ToPtr(decimal.NewFromInt(10)),
+				Duration:     types.CouponDurationRepeating,
+			},
+			wantErr: true,
+		},
+		{
+			name: "redeem_by_in_the_past",
+			req: dto.CreateCouponRequest{
+				Name:         "Expired",
+				DiscountType: types.CouponDiscountTypePercentage,
+				PercentOff:   lo.ToPtr(decimal.NewFromInt(10)),
+				Duration:     types.CouponDurationOnce,
+				RedeemBy:     lo.ToPtr(time.Now().UTC().Add(-time.Hour)),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp, err := s.service.CreateCoupon(s.GetContext(), tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.True(ierr.IsValidation(err))
+				return
+			}
+
+			s.NoError(err)
+			s.Equal(tt.req.Name, resp.Name)
+			s.Equal(types.StatusPublished, resp.Status)
+			s.Equal(0, resp.TimesRedeemed)
+		})
+	}
+}
+
+func (s *CouponServiceSuite) createCoupon(req dto.CreateCouponRequest) *dto.CouponResponse {
+	resp, err := s.service.CreateCoupon(s.GetContext(), req)
+	s.NoError(err)
+	return resp
+}
+
+func (s *CouponServiceSuite) TestApplyCoupon() {
+	c := s.createCoupon(dto.CreateCouponRequest{
+		Name:           "Limited",
+		DiscountType:   types.CouponDiscountTypeFixedAmount,
+		AmountOff:      lo.ToPtr(decimal.NewFromInt(5)),
+		Currency:       "usd",
+		Duration:       types.CouponDurationOnce,
+		MaxRedemptions: lo.ToPtr(2),
+	})
+
+	// Apply to the subscription
+	application, err := s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{
+		CustomerID:     s.testData.customer.ID,
+		SubscriptionID: lo.ToPtr(s.testData.subscription.ID),
+	})
+	s.NoError(err)
+	s.Equal(c.ID, application.CouponID)
+	s.Equal(1, application.Coupon.TimesRedeemed)
+
+	// Same coupon cannot be applied twice to the same subscription
+	_, err = s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{
+		CustomerID:     s.testData.customer.ID,
+		SubscriptionID: lo.ToPtr(s.testData.subscription.ID),
+	})
+	s.Error(err)
+	s.True(ierr.IsAlreadyExists(err))
+
+	// Subscription must belong to the customer
+	other := &customer.Customer{
+		ID:        "cust_other",
+		Name:      "Other Customer",
+		BaseModel: types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(s.GetContext(), other))
+	_, err = s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{
+		CustomerID:     other.ID,
+		SubscriptionID: lo.ToPtr(s.testData.subscription.ID),
+	})
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+
+	// Customer level application uses the last redemption
+	_, err = s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{
+		CustomerID: s.testData.customer.ID,
+	})
+	s.NoError(err)
+
+	_, err = s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{
+		CustomerID: other.ID,
+	})
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+}
+
+func (s *CouponServiceSuite) TestApplyCouponConcurrentRedemptions() {
+	c := s.createCoupon(dto.CreateCouponRequest{
+		Name:           "Launch",
+		DiscountType:   types.CouponDiscountTypePercentage,
+		PercentOff:     lo.ToPtr(decimal.NewFromInt(10)),
+		Duration:       types.CouponDurationOnce,
+		MaxRedemptions: lo.ToPtr(3),
+	})
+
+	const customers = 10
+	for i := 0; i < customers; i++ {
+		s.NoError(s.GetStores().CustomerRepo.Create(s.GetContext(), &customer.Customer{
+			ID:        fmt.Sprintf("cust_concurrent_%d", i),
+			Name:      "Concurrent Customer",
+			BaseModel: types.GetDefaultBaseModel(s.GetContext()),
+		}))
+	}
+
+	var (
+		wg       sync.WaitGroup
+		redeemed atomic.Int32
+	)
+	for i := 0; i < customers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{
+				CustomerID: fmt.Sprintf("cust_concurrent_%d", i),
+			})
+			if err == nil {
+				redeemed.Add(1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	s.Equal(int32(3), redeemed.Load())
+
+	stored, err := s.GetStores().CouponRepo.Get(s.GetContext(), c.ID)
+	s.NoError(err)
+	s.Equal(3, stored.TimesRedeemed)
+}
+
+func (s *CouponServiceSuite) TestListCouponApplicationsTotal() {
+	c := s.createCoupon(dto.CreateCouponRequest{
+		Name:         "Welcome",
+		DiscountType: types.CouponDiscountTypePercentage,
+		PercentOff:   lo.ToPtr(decimal.NewFromInt(10)),
+		Duration:     types.CouponDurationOnce,
+	})
+
+	for i := 0; i < 3; i++ {
+		customerID := fmt.Sprintf("cust_page_%d", i)
+		s.NoError(s.GetStores().CustomerRepo.Create(s.GetContext(), &customer.Customer{
+			ID:        customerID,
+			Name:      "Paged Customer",
+			BaseModel: types.GetDefaultBaseModel(s.GetContext()),
+		}))
+		_, err := s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{CustomerID: customerID})
+		s.NoError(err)
+	}
+
+	filter := &types.CouponApplicationFilter{
+		QueryFilter: types.NewDefaultQueryFilter(),
+		CouponID:    c.ID,
+	}
+	filter.QueryFilter.Limit = lo.ToPtr(2)
+
+	resp, err := s.service.ListCouponApplications(s.GetContext(), filter)
+	s.NoError(err)
+	s.Len(resp.Items, 2)
+	s.Equal(3, resp.Pagination.Total)
+}
+
+func (s *CouponServiceSuite) TestApplyCouponCurrencyMismatch() {
+	c := s.createCoupon(dto.CreateCouponRequest{
+		Name:         "Euro Off",
+		DiscountType: types.CouponDiscountTypeFixedAmount,
+		AmountOff:    lo.ToPtr(decimal.NewFromInt(5)),
+		Currency:     "eur",
+		Duration:     types.CouponDurationOnce,
+	})
+
+	_, err := s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{
+		CustomerID:     s.testData.customer.ID,
+		SubscriptionID: lo.ToPtr(s.testData.subscription.ID),
+	})
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+}
+
+func (s *CouponServiceSuite) TestRemoveCouponApplication() {
+	c := s.createCoupon(dto.CreateCouponRequest{
+		Name:         "Forever",
+		DiscountType: types.CouponDiscountTypePercentage,
+		PercentOff:   lo.ToPtr(decimal.NewFromInt(10)),
+		Duration:     types.CouponDurationForever,
+	})
+
+	application, err := s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{
+		CustomerID: s.testData.customer.ID,
+	})
+	s.NoError(err)
+
+	s.NoError(s.service.RemoveCouponApplication(s.GetContext(), application.ID))
+
+	filter := types.NewNoLimitCouponApplicationFilter()
+	filter.CustomerID = s.testData.customer.ID
+	filter.QueryFilter.Status = lo.ToPtr(types.StatusPublished)
+	resp, err := s.service.ListCouponApplications(s.GetContext(), filter)
+	s.NoError(err)
+	s.Empty(resp.Items)
+
+	// The coupon can be applied again once the previous application is removed
+	_, err = s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{
+		CustomerID: s.testData.customer.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *CouponServiceSuite) TestCreateInvoiceMarksDiscountsApplied() {
+	c := s.createCoupon(dto.CreateCouponRequest{
+		Name:              "Two Months",
+		DiscountType:      types.CouponDiscountTypePercentage,
+		PercentOff:        lo.ToPtr(decimal.NewFromInt(50)),
+		Duration:          types.CouponDurationRepeating,
+		DurationInPeriods: lo.ToPtr(2),
+	})
+
+	application, err := s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{
+		CustomerID:     s.testData.customer.ID,
+		SubscriptionID: lo.ToPtr(s.testData.subscription.ID),
+	})
+	s.NoError(err)
+
+	invoiceService := NewInvoiceService(s.serviceParams())
+	resp, err := invoiceService.CreateInvoice(s.GetContext(), s.discountedInvoiceRequest(c.ID, application.ID))
+	s.NoError(err)
+	s.True(decimal.NewFromInt(100).Equal(resp.Subtotal))
+	s.True(decimal.NewFromInt(50).Equal(resp.TotalDiscount))
+	s.True(decimal.NewFromInt(50).Equal(resp.Total))
+	s.True(decimal.NewFromInt(50).Equal(resp.AmountDue))
+
+	updated, err := s.GetStores().CouponRepo.GetApplication(s.GetContext(), application.ID)
+	s.NoError(err)
+	s.Equal(1, updated.PeriodsApplied)
+}
+
+// discountedInvoiceRequest returns a subscription invoice request discounted by half with the coupon application
+func (s *CouponServiceSuite) discountedInvoiceRequest(couponID, applicationID string) dto.CreateInvoiceRequest {
+	return dto.CreateInvoiceRequest{
+		CustomerID:     s.testData.customer.ID,
+		SubscriptionID: lo.ToPtr(s.testData.subscription.ID),
+		InvoiceType:    types.InvoiceTypeSubscription,
+		Currency:       "usd",
+		AmountDue:      decimal.NewFromInt(50),
+		BillingPeriod:  lo.ToPtr(string(types.BILLING_PERIOD_MONTHLY)),
+		PeriodStart:    lo.ToPtr(time.Now().UTC().Add(-30 * 24 * time.Hour)),
+		PeriodEnd:      lo.ToPtr(time.Now().UTC()),
+		BillingReason:  types.InvoiceBillingReasonSubscriptionCycle,
+		LineItems: []dto.CreateInvoiceLineItemRequest{
+			{
+				PriceID:  lo.ToPtr("price_123"),
+				Amount:   decimal.NewFromInt(100),
+				Quantity: decimal.NewFromInt(1),
+			},
+		},
+		Discounts: []invoice.InvoiceDiscount{
+			{
+				CouponID:            couponID,
+				CouponApplicationID: applicationID,
+				Description:         "50% off",
+				Amount:              decimal.NewFromInt(50),
+			},
+		},
+	}
+}
+
+func (s *CouponServiceSuite) TestCreateInvoicesConcurrentlyUseLastPeriod() {
+	c := s.createCoupon(dto.CreateCouponRequest{
+		Name:         "Welcome",
+		DiscountType: types.CouponDiscountTypePercentage,
+		PercentOff:   lo.ToPtr(decimal.NewFromInt(50)),
+		Duration:     types.CouponDurationOnce,
+	})
+
+	application, err := s.service.ApplyCoupon(s.GetContext(), c.ID, dto.ApplyCouponRequest{
+		CustomerID:     s.testData.customer.ID,
+		SubscriptionID: lo.ToPtr(s.testData.subscription.ID),
+	})
+	s.NoError(err)
+
+	invoiceService := NewInvoiceService(s.serviceParams())
+
+	const invoices = 5
+	var (
+		wg      sync.WaitGroup
+		created atomic.Int32
+	)
+	for i := 0; i < invoices; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := invoiceService.CreateInvoice(s.GetContext(), s.discountedInvoiceRequest(c.ID, application.ID))
+			if err == nil {
+				created.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Only one invoice can use the single period of the coupon
+	s.Equal(int32(1), created.Load())
+
+	updated, err := s.GetStores().CouponRepo.GetApplication(s.GetContext(), application.ID)
+	s.NoError(err)
+	s.Equal(1, updated.PeriodsApplied)
+}
@@ -0,0 +1,505 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/creditnote"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/payment"
+	pdf "github.com/flexprice/flexprice/internal/domain/pdf"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CreditNoteService defines the interface for credit note operations
+type CreditNoteService interface {
+	// CreateCreditNote issues a credit note against a finalized invoice and gives the
+	// credited amount back to the customer according to the credit note outcome
+	CreateCreditNote(ctx context.Context, req dto.CreateCreditNoteRequest) (*dto.CreditNoteResponse, error)
+	GetCreditNote(ctx context.Context, id string) (*dto.CreditNoteResponse, error)
+	ListCreditNotes(ctx context.Context, filter *types.CreditNoteFilter) (*dto.ListCreditNotesResponse, error)
+	GetCreditNotePDF(ctx context.Context, id string) ([]byte, error)
+}
+
+type creditNoteService struct {
+	ServiceParams
+}
+
+// NewCreditNoteService creates a new credit note service
+func NewCreditNoteService(params ServiceParams) CreditNoteService {
+	return &creditNoteService{
+		ServiceParams: params,
+	}
+}
+
+func (s *creditNoteService) CreateCreditNote(ctx context.Context, req dto.CreateCreditNoteRequest) (*dto.CreditNoteResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var note *creditnote.CreditNote
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		inv, err := s.InvoiceRepo.Get(ctx, req.InvoiceID)
+		if err != nil {
+			return err
+		}
+
+		if inv.InvoiceStatus != types.InvoiceStatusFinalized {
+			return ierr.NewError("credit notes can only be issued for finalized invoices").
+				WithHint("Finalize the invoice before issuing a credit note, draft invoices can be edited instead").
+				WithReportableDetails(map[string]any{
+					"invoice_id":     inv.ID,
+					"invoice_status": inv.InvoiceStatus,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		existing, err := s.listInvoiceCreditNotes(ctx, inv.ID)
+		if err != nil {
+			return err
+		}
+
+		note = req.ToCreditNote(ctx, inv)
+		if err := s.validateLineItems(inv, existing, note); err != nil {
+			return err
+		}
+
+		if err := note.Validate(); err != nil {
+			return err
+		}
+
+		if err := s.validatePaidAmount(inv, existing, note); err != nil {
+			return err
+		}
+
+		// The credited amount is added with a conditional update, so that concurrent credit notes
+		// cannot credit more than the invoice total. It locks the invoice until the credit note is
+		// created and a credit note created since the invoice was read fails its version check.
+		total := s.getInvoiceTotal(inv)
+		credited, err := s.InvoiceRepo.IncrementAmountCredited(ctx, inv.ID, note.TotalAmount, total)
+		if err != nil {
+			return err
+		}
+		if !credited {
+			return ierr.NewError("credit note amount exceeds the creditable amount of the invoice").
+				WithHint("The total of all credit notes cannot exceed the invoice total").
+				WithReportableDetails(map[string]any{
+					"invoice_id":    inv.ID,
+					"total_amount":  note.TotalAmount.String(),
+					"invoice_total": total.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		switch note.Outcome {
+		case types.CreditNoteOutcomeReduceAmountRemaining:
+			err = s.reduceAmountRemaining(inv, note)
+		case types.CreditNoteOutcomeWalletCredit:
+			err = s.creditCustomerWallet(ctx, inv, note, req.WalletID)
+		case types.CreditNoteOutcomeRefund:
+			err = s.refundPayment(ctx, inv, existing, note)
+		}
+		if err != nil {
+			return err
+		}
+
+		inv.AmountCredited = inv.AmountCredited.Add(note.TotalAmount)
+		if err := s.InvoiceRepo.Update(ctx, inv); err != nil {
+			return err
+		}
+
+		number, err := s.CreditNoteRepo.GetNextCreditNoteNumber(ctx)
+		if err != nil {
+			return err
+		}
+		note.CreditNoteNumber = number
+
+		return s.CreditNoteRepo.CreateWithLineItems(ctx, note)
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to create credit note",
+			"error", err,
+			"invoice_id", req.InvoiceID,
+			"outcome", req.Outcome)
+		return nil, err
+	}
+
+	return &dto.CreditNoteResponse{CreditNote: note}, nil
+}
+
+func (s *creditNoteService) GetCreditNote(ctx context.Context, id string) (*dto.CreditNoteResponse, error) {
+	if id == "" {
+		return nil, ierr.NewError("credit note ID is required").
+			WithHint("Credit note ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	note, err := s.CreditNoteRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CreditNoteResponse{CreditNote: note}, nil
+}
+
+func (s *creditNoteService) ListCreditNotes(ctx context.Context, filter *types.CreditNoteFilter) (*dto.ListCreditNotesResponse, error) {
+	if filter == nil {
+		filter = types.NewDefaultCreditNoteFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	notes, err := s.CreditNoteRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.CreditNoteRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListCreditNotesResponse{
+		Items: make([]*dto.CreditNoteResponse, len(notes)),
+	}
+
+	for i, n := range notes {
+		response.Items[i] = &dto.CreditNoteResponse{CreditNote: n}
+	}
+
+	response.Pagination = types.NewPaginationResponse(
+		count,
+		filter.GetLimit(),
+		filter.GetOffset(),
+	)
+
+	return response, nil
+}
+
+func (s *creditNoteService) GetCreditNotePDF(ctx context.Context, id string) ([]byte, error) {
+	note, err := s.CreditNoteRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := s.InvoiceRepo.Get(ctx, note.InvoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := s.CustomerRepo.Get(ctx, note.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := s.TenantRepo.GetByID(ctx, note.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &pdf.CreditNoteData{
+		ID:               note.ID,
+		CreditNoteNumber: note.CreditNoteNumber,
+		InvoiceNumber:    lo.FromPtr(inv.InvoiceNumber),
+		IssuingDate:      pdf.CustomTime{Time: note.CreatedAt},
+		Currency:         types.GetCurrencySymbol(note.Currency),
+		TotalAmount:      note.TotalAmount.InexactFloat64(),
+		Reason:           string(note.Reason),
+		Outcome:          string(note.Outcome),
+		Memo:             note.Memo,
+		Biller:           getBillerInfo(tenant),
+		Recipient:        getRecipientInfo(customer),
+		LineItems:        make([]pdf.CreditNoteLineItemData, len(note.LineItems)),
+	}
+
+	for i, item := range note.LineItems {
+		data.LineItems[i] = pdf.CreditNoteLineItemData{
+			DisplayName: item.DisplayName,
+			Amount:      item.Amount.InexactFloat64(),
+		}
+	}
+
+	return s.PDFGenerator.RenderCreditNotePdf(ctx, data)
+}
+
+func (s *creditNoteService) listInvoiceCreditNotes(ctx context.Context, invoiceID string) ([]*creditnote.CreditNote, error) {
+	filter := types.NewNoLimitCreditNoteFilter()
+	filter.InvoiceID = invoiceID
+	return s.CreditNoteRepo.List(ctx, filter)
+}
+
+// getInvoiceTotal returns the total the customer was billed on the invoice. Invoices
+// created before invoice totals were tracked fall back to the sum of their line items.
+func (s *creditNoteService) getInvoiceTotal(inv *invoice.Invoice) decimal.Decimal {
+	if inv.Total.IsPositive() {
+		return inv.Total
+	}
+
+	total := decimal.Zero
+	for _, item := range inv.LineItems {
+		total = total.Add(item.Amount)
+	}
+	return total
+}
+
+// validateLineItems checks the credited line items belong to the invoice and are not
+// credited for more than their amount across all credit notes of the invoice
+func (s *creditNoteService) validateLineItems(inv *invoice.Invoice, existing []*creditnote.CreditNote, note *creditnote.CreditNote) error {
+	invoiceLineItems := lo.KeyBy(inv.LineItems, func(item *invoice.InvoiceLineItem) string {
+		return item.ID
+	})
+
+	credited := make(map[string]decimal.Decimal)
+	for _, n := range existing {
+		for _, item := range n.LineItems {
+			if item.InvoiceLineItemID != nil {
+				credited[*item.InvoiceLineItemID] = credited[*item.InvoiceLineItemID].Add(item.Amount)
+			}
+		}
+	}
+
+	for _, item := range note.LineItems {
+		if item.InvoiceLineItemID == nil {
+			continue
+		}
+
+		lineItem, ok := invoiceLineItems[*item.InvoiceLineItemID]
+		if !ok {
+			return ierr.NewError("invoice line item not found").
+				WithHint("Credited line items must belong to the invoice").
+				WithReportableDetails(map[string]any{
+					"invoice_id":           inv.ID,
+					"invoice_line_item_id": *item.InvoiceLineItemID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if item.DisplayName == "" {
+			item.DisplayName = lo.FromPtr(lo.CoalesceOrEmpty(lineItem.DisplayName, lineItem.PlanDisplayName, lo.ToPtr(lineItem.ID)))
+		}
+
+		creditable := lineItem.Amount.Sub(credited[lineItem.ID])
+		if item.Amount.GreaterThan(creditable) {
+			return ierr.NewError("credited amount exceeds the creditable amount of the line item").
+				WithHint("A line item cannot be credited for more than its amount").
+				WithReportableDetails(map[string]any{
+					"invoice_line_item_id": lineItem.ID,
+					"amount":               item.Amount.String(),
+					"creditable_amount":    creditable.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		credited[lineItem.ID] = credited[lineItem.ID].Add(item.Amount)
+	}
+
+	return nil
+}
+
+// validatePaidAmount checks the wallet credit and refund outcomes only give back what the customer
+// paid for the invoice and was not yet given back, the unpaid amount can only be reduced
+func (s *creditNoteService) validatePaidAmount(inv *invoice.Invoice, existing []*creditnote.CreditNote, note *creditnote.CreditNote) error {
+	if note.Outcome != types.CreditNoteOutcomeWalletCredit && note.Outcome != types.CreditNoteOutcomeRefund {
+		return nil
+	}
+
+	givenBack := decimal.Zero
+	for _, n := range existing {
+		if n.Outcome == types.CreditNoteOutcomeWalletCredit || n.Outcome == types.CreditNoteOutcomeRefund {
+			givenBack = givenBack.Add(n.TotalAmount)
+		}
+	}
+
+	available := inv.AmountPaid.Sub(givenBack)
+	if note.TotalAmount.GreaterThan(available) {
+		return ierr.NewError("credit note amount exceeds the paid amount of the invoice").
+			WithHint("Only the paid amount of the invoice can be refunded or credited, reduce the amount remaining for the unpaid amount instead").
+			WithReportableDetails(map[string]any{
+				"invoice_id":       inv.ID,
+				"total_amount":     note.TotalAmount.String(),
+				"amount_paid":      inv.AmountPaid.String(),
+				"available_amount": available.String(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// reduceAmountRemaining lowers the amount the customer still owes on the invoice
+func (s *creditNoteService) reduceAmountRemaining(inv *invoice.Invoice, note *creditnote.CreditNote) error {
+	if note.TotalAmount.GreaterThan(inv.AmountRemaining) {
+		return ierr.NewError("credit note amount exceeds the amount remaining").
+			WithHint("Only the unpaid amount of the invoice can be reduced, refund or credit the paid amount instead").
+			WithReportableDetails(map[string]any{
+				"invoice_id":       inv.ID,
+				"total_amount":     note.TotalAmount.String(),
+				"amount_remaining": inv.AmountRemaining.String(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	inv.AmountDue = inv.AmountDue.Sub(note.TotalAmount)
+	inv.AmountRemaining = inv.AmountRemaining.Sub(note.TotalAmount)
+
+	if inv.AmountRemaining.IsZero() {
+		inv.PaymentStatus = types.PaymentStatusSucceeded
+		if inv.PaidAt == nil {
+			inv.PaidAt = lo.ToPtr(time.Now().UTC())
+		}
+	}
+
+	return nil
+}
+
+// creditCustomerWallet credits the credit note amount to the given wallet, or to an active
+// wallet of the customer in the invoice currency
+func (s *creditNoteService) creditCustomerWallet(ctx context.Context, inv *invoice.Invoice, note *creditnote.CreditNote, walletID *string) error {
+	var w *wallet.Wallet
+	if walletID != nil {
+		found, err := s.WalletRepo.GetWalletByID(ctx, *walletID)
+		if err != nil {
+			return err
+		}
+
+		if found.CustomerID != inv.CustomerID {
+			return ierr.NewError("wallet does not belong to the invoice customer").
+				WithHint("Credit notes can only be credited to a wallet of the invoice customer").
+				WithReportableDetails(map[string]any{
+					"wallet_id":   found.ID,
+					"customer_id": inv.CustomerID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		w = found
+	} else {
+		wallets, err := s.WalletRepo.GetWalletsByCustomerID(ctx, inv.CustomerID)
+		if err != nil {
+			return err
+		}
+
+		w, _ = lo.Find(wallets, func(w *wallet.Wallet) bool {
+			return w.WalletStatus == types.WalletStatusActive && types.IsMatchingCurrency(w.Currency, inv.Currency)
+		})
+		if w == nil {
+			return ierr.NewError("no active wallet found for customer").
+				WithHint("The customer needs an active wallet in the invoice currency to receive the credit").
+				WithReportableDetails(map[string]any{
+					"customer_id": inv.CustomerID,
+					"currency":    inv.Currency,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+	}
+
+	if !types.IsMatchingCurrency(w.Currency, inv.Currency) {
+		return ierr.NewError("wallet currency does not match invoice currency").
+			WithHint("The wallet must be in the invoice currency").
+			WithReportableDetails(map[string]any{
+				"wallet_currency":  w.Currency,
+				"invoice_currency": inv.Currency,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := s.creditWallet(ctx, w.ID, inv, note); err != nil {
+		return err
+	}
+
+	note.WalletID = lo.ToPtr(w.ID)
+	return nil
+}
+
+// refundPayment refunds the credit note amount to a succeeded payment of the invoice.
+// Payments made with wallet credits are refunded to the wallet they were paid from, other
+// payment methods are marked as refunded for the refund to be settled with the gateway.
+func (s *creditNoteService) refundPayment(ctx context.Context, inv *invoice.Invoice, existing []*creditnote.CreditNote, note *creditnote.CreditNote) error {
+	refunded := decimal.Zero
+	refundedByPayment := make(map[string]decimal.Decimal)
+	for _, n := range existing {
+		if n.Outcome == types.CreditNoteOutcomeRefund && n.PaymentID != nil {
+			refunded = refunded.Add(n.TotalAmount)
+			refundedByPayment[*n.PaymentID] = refundedByPayment[*n.PaymentID].Add(n.TotalAmount)
+		}
+	}
+
+	filter := types.NewNoLimitPaymentFilter()
+	filter.DestinationType = lo.ToPtr(string(types.PaymentDestinationTypeInvoice))
+	filter.DestinationID = lo.ToPtr(inv.ID)
+
+	payments, err := s.PaymentRepo.List(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	var p *payment.Payment
+	var refundable decimal.Decimal
+	for _, candidate := range payments {
+		if candidate.PaymentStatus != types.PaymentStatusSucceeded &&
+			candidate.PaymentStatus != types.PaymentStatusPartiallyRefunded {
+			continue
+		}
+
+		refundable = candidate.Amount.Sub(refundedByPayment[candidate.ID])
+		if refundable.GreaterThanOrEqual(note.TotalAmount) {
+			p = candidate
+			break
+		}
+	}
+
+	if p == nil {
+		return ierr.NewError("no payment can be refunded for the credit note amount").
+			WithHint("The credit note amount must not exceed the unrefunded amount of a succeeded payment of the invoice").
+			WithReportableDetails(map[string]any{
+				"invoice_id":   inv.ID,
+				"total_amount": note.TotalAmount.String(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if p.PaymentMethodType == types.PaymentMethodTypeCredits {
+		if err := s.creditWallet(ctx, p.PaymentMethodID, inv, note); err != nil {
+			return err
+		}
+		note.WalletID = lo.ToPtr(p.PaymentMethodID)
+	}
+
+	now := time.Now().UTC()
+	p.RefundedAt = &now
+	p.PaymentStatus = types.PaymentStatusPartiallyRefunded
+	if refundable.Equal(note.TotalAmount) {
+		p.PaymentStatus = types.PaymentStatusRefunded
+	}
+	if err := s.PaymentRepo.Update(ctx, p); err != nil {
+		return err
+	}
+
+	refunded = refunded.Add(note.TotalAmount)
+	inv.PaymentStatus = types.PaymentStatusPartiallyRefunded
+	if refunded.GreaterThanOrEqual(inv.AmountPaid) {
+		inv.PaymentStatus = types.PaymentStatusRefunded
+	}
+
+	note.PaymentID = lo.ToPtr(p.ID)
+	return nil
+}
+
+func (s *creditNoteService) creditWallet(ctx context.Context, walletID string, inv *invoice.Invoice, note *creditnote.CreditNote) error {
+	walletService := NewWalletService(s.ServiceParams)
+	return walletService.CreditWallet(ctx, &wallet.WalletOperation{
+		WalletID:          walletID,
+		Type:              types.TransactionTypeCredit,
+		Amount:            note.TotalAmount,
+		ReferenceType:     types.WalletTxReferenceTypeCreditNote,
+		ReferenceID:       note.ID,
+		Description:       fmt.Sprintf("Credit note for invoice %s", lo.FromPtr(inv.InvoiceNumber)),
+		TransactionReason: types.TransactionReasonInvoiceRefund,
+	})
+}
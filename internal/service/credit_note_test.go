@@ -0,0 +1,500 @@
+package service
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/payment"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreditNoteServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service  CreditNoteService
+	testData struct {
+		customer *customer.Customer
+		invoice  *invoice.Invoice
+		wallet   *wallet.Wallet
+	}
+}
+
+func TestCreditNoteService(t *testing.T) {
+	suite.Run(t, new(CreditNoteServiceSuite))
+}
+
+func (s *CreditNoteServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	s.service = NewCreditNoteService(ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		WalletRepo:       s.GetStores().WalletRepo,
+		PaymentRepo:      s.GetStores().PaymentRepo,
+		TenantRepo:       s.GetStores().TenantRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	})
+	s.setupTestData()
+}
+
+func (s *CreditNoteServiceSuite) setupTestData() {
+	ctx := s.GetContext()
+	now := time.Now().UTC()
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_credit_note",
+		ExternalID: "ext_cust_credit_note",
+		Name:       "Test Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	s.testData.invoice = &invoice.Invoice{
+		ID:              "inv_credit_note",
+		CustomerID:      s.testData.customer.ID,
+		InvoiceType:     types.InvoiceTypeOneOff,
+		InvoiceStatus:   types.InvoiceStatusFinalized,
+		PaymentStatus:   types.PaymentStatusPending,
+		InvoiceNumber:   lo.ToPtr("INV-202501-00001"),
+		Currency:        "usd",
+		AmountDue:       decimal.NewFromInt(100),
+		AmountPaid:      decimal.Zero,
+		AmountRemaining: decimal.NewFromInt(100),
+		Total:           decimal.NewFromInt(100),
+		PeriodStart:     &now,
+		PeriodEnd:       lo.ToPtr(now.Add(30 * 24 * time.Hour)),
+		LineItems: []*invoice.InvoiceLineItem{
+			{
+				ID:          "inv_line_api",
+				InvoiceID:   "inv_credit_note",
+				CustomerID:  s.testData.customer.ID,
+				DisplayName: lo.ToPtr("API Calls"),
+				Amount:      decimal.NewFromInt(60),
+				Quantity:    decimal.NewFromInt(600),
+				Currency:    "usd",
+				BaseModel:   types.GetDefaultBaseModel(ctx),
+			},
+			{
+				ID:          "inv_line_storage",
+				InvoiceID:   "inv_credit_note",
+				CustomerID:  s.testData.customer.ID,
+				DisplayName: lo.ToPtr("Storage"),
+				Amount:      decimal.NewFromInt(40),
+				Quantity:    decimal.NewFromInt(4),
+				Currency:    "usd",
+				BaseModel:   types.GetDefaultBaseModel(ctx),
+			},
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().InvoiceRepo.CreateWithLineItems(ctx, s.testData.invoice))
+
+	s.testData.wallet = &wallet.Wallet{
+		ID:             "wallet_credit_note",
+		CustomerID:     s.testData.customer.ID,
+		Currency:       "usd",
+		Balance:        decimal.Zero,
+		CreditBalance:  decimal.Zero,
+		ConversionRate: decimal.NewFromInt(1),
+		WalletStatus:   types.WalletStatusActive,
+		WalletType:     types.WalletTypePrePaid,
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().WalletRepo.CreateWallet(ctx, s.testData.wallet))
+}
+
+// markInvoicePaid records a succeeded payment for the full invoice amount
+func (s *CreditNoteServiceSuite) markInvoicePaid(methodType types.PaymentMethodType, methodID string) *payment.Payment {
+	ctx := s.GetContext()
+	now := time.Now().UTC()
+
+	p := &payment.Payment{
+		ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PAYMENT),
+		IdempotencyKey:    types.GenerateUUID(),
+		DestinationType:   types.PaymentDestinationTypeInvoice,
+		DestinationID:     s.testData.invoice.ID,
+		PaymentMethodType: methodType,
+		PaymentMethodID:   methodID,
+		Amount:            s.testData.invoice.AmountDue,
+		Currency:          "usd",
+		PaymentStatus:     types.PaymentStatusSucceeded,
+		SucceededAt:       &now,
+		BaseModel:         types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PaymentRepo.Create(ctx, p))
+
+	inv := s.testData.invoice
+	inv.AmountPaid = inv.AmountDue
+	inv.AmountRemaining = decimal.Zero
+	inv.PaymentStatus = types.PaymentStatusSucceeded
+	inv.PaidAt = &now
+	s.NoError(s.GetStores().InvoiceRepo.Update(ctx, inv))
+
+	return p
+}
+
+func (s *CreditNoteServiceSuite) TestCreateCreditNoteReduceAmountRemaining() {
+	resp, err := s.service.CreateCreditNote(s.GetContext(), dto.CreateCreditNoteRequest{
+		InvoiceID: s.testData.invoice.ID,
+		Reason:    types.CreditNoteReasonBillingError,
+		Outcome:   types.CreditNoteOutcomeReduceAmountRemaining,
+		LineItems: []dto.CreateCreditNoteLineItemRequest{
+			{InvoiceLineItemID: lo.ToPtr("inv_line_api"), Amount: decimal.NewFromInt(20)},
+			{DisplayName: "Goodwill", Amount: decimal.NewFromInt(5)},
+		},
+	})
+	s.NoError(err)
+	s.Equal("API Calls", resp.LineItems[0].DisplayName)
+	s.True(decimal.NewFromInt(25).Equal(resp.TotalAmount))
+	s.Contains(resp.CreditNoteNumber, "CN-")
+
+	inv, err := s.GetStores().InvoiceRepo.Get(s.GetContext(), s.testData.invoice.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(75).Equal(inv.AmountDue))
+	s.True(decimal.NewFromInt(75).Equal(inv.AmountRemaining))
+	s.True(decimal.NewFromInt(25).Equal(inv.AmountCredited))
+	s.Equal(types.PaymentStatusPending, inv.PaymentStatus)
+
+	// crediting the rest settles the invoice
+	_, err = s.service.CreateCreditNote(s.GetContext(), dto.CreateCreditNoteRequest{
+		InvoiceID: s.testData.invoice.ID,
+		Reason:    types.CreditNoteReasonOrderChange,
+		Outcome:   types.CreditNoteOutcomeReduceAmountRemaining,
+		LineItems: []dto.CreateCreditNoteLineItemRequest{
+			{DisplayName: "Remaining balance", Amount: decimal.NewFromInt(75)},
+		},
+	})
+	s.NoError(err)
+
+	inv, err = s.GetStores().InvoiceRepo.Get(s.GetContext(), s.testData.invoice.ID)
+	s.NoError(err)
+	s.True(inv.AmountRemaining.IsZero())
+	s.Equal(types.PaymentStatusSucceeded, inv.PaymentStatus)
+	s.NotNil(inv.PaidAt)
+}
+
+func (s *CreditNoteServiceSuite) TestCreateCreditNoteWalletCredit() {
+	s.markInvoicePaid(types.PaymentMethodTypeOffline, "")
+
+	resp, err := s.service.CreateCreditNote(s.GetContext(), dto.CreateCreditNoteRequest{
+		InvoiceID: s.testData.invoice.ID,
+		Reason:    types.CreditNoteReasonProductUnsatisfactory,
+		Outcome:   types.CreditNoteOutcomeWalletCredit,
+		LineItems: []dto.CreateCreditNoteLineItemRequest{
+			{InvoiceLineItemID: lo.ToPtr("inv_line_storage"), Amount: decimal.NewFromInt(40)},
+		},
+	})
+	s.NoError(err)
+	s.Equal(s.testData.wallet.ID, lo.FromPtr(resp.WalletID))
+
+	w, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallet.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(40).Equal(w.Balance))
+
+	inv, err := s.GetStores().InvoiceRepo.Get(s.GetContext(), s.testData.invoice.ID)
+	s.NoError(err)
+	s.True(inv.AmountRemaining.IsZero())
+	s.True(decimal.NewFromInt(40).Equal(inv.AmountCredited))
+}
+
+func (s *CreditNoteServiceSuite) TestCreditNoteLimitedToPaidAmount() {
+	ctx := s.GetContext()
+
+	// The customer paid 30 of the invoice
+	p := s.markInvoicePaid(types.PaymentMethodTypeOffline, "")
+	p.Amount = decimal.NewFromInt(30)
+	s.NoError(s.GetStores().PaymentRepo.Update(ctx, p))
+
+	inv := s.testData.invoice
+	inv.AmountPaid = decimal.NewFromInt(30)
+	inv.AmountRemaining = decimal.NewFromInt(70)
+	inv.PaymentStatus = types.PaymentStatusPending
+	inv.PaidAt = nil
+	s.NoError(s.GetStores().InvoiceRepo.Update(ctx, inv))
+
+	create := func(outcome types.CreditNoteOutcome, amount int64) error {
+		_, err := s.service.CreateCreditNote(ctx, dto.CreateCreditNoteRequest{
+			InvoiceID: inv.ID,
+			Reason:    types.CreditNoteReasonBillingError,
+			Outcome:   outcome,
+			LineItems: []dto.CreateCreditNoteLineItemRequest{
+				{DisplayName: "Adjustment", Amount: decimal.NewFromInt(amount)},
+			},
+		})
+		return err
+	}
+
+	err := create(types.CreditNoteOutcomeWalletCredit, 40)
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+
+	s.NoError(create(types.CreditNoteOutcomeWalletCredit, 20))
+
+	// Only 10 of the paid amount is left to give back
+	err = create(types.CreditNoteOutcomeRefund, 20)
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+
+	s.NoError(create(types.CreditNoteOutcomeRefund, 10))
+
+	err = create(types.CreditNoteOutcomeWalletCredit, 1)
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+
+	// The unpaid amount can still be reduced
+	s.NoError(create(types.CreditNoteOutcomeReduceAmountRemaining, 70))
+
+	w, err := s.GetStores().WalletRepo.GetWalletByID(ctx, s.testData.wallet.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(20).Equal(w.Balance))
+
+	updated, err := s.GetStores().InvoiceRepo.Get(ctx, inv.ID)
+	s.NoError(err)
+	s.True(updated.AmountRemaining.IsZero())
+}
+
+func (s *CreditNoteServiceSuite) TestConcurrentCreditNotesCannotExceedTotal() {
+	req := dto.CreateCreditNoteRequest{
+		InvoiceID: s.testData.invoice.ID,
+		Reason:    types.CreditNoteReasonBillingError,
+		Outcome:   types.CreditNoteOutcomeReduceAmountRemaining,
+		LineItems: []dto.CreateCreditNoteLineItemRequest{
+			{DisplayName: "Adjustment", Amount: decimal.NewFromInt(60)},
+		},
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.service.CreateCreditNote(s.GetContext(), req)
+		}(i)
+	}
+	wg.Wait()
+
+	failed := lo.Filter(errs, func(err error, _ int) bool { return err != nil })
+	s.Require().Len(failed, 1)
+	s.True(ierr.IsValidation(failed[0]))
+
+	inv, err := s.GetStores().InvoiceRepo.Get(s.GetContext(), s.testData.invoice.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(60).Equal(inv.AmountCredited))
+}
+
+func (s *CreditNoteServiceSuite) TestCreateCreditNoteRefund() {
+	s.Run("offline_payment", func() {
+		s.SetupTest()
+		p := s.markInvoicePaid(types.PaymentMethodTypeOffline, "")
+
+		resp, err := s.service.CreateCreditNote(s.GetContext(), dto.CreateCreditNoteRequest{
+			InvoiceID: s.testData.invoice.ID,
+			Reason:    types.CreditNoteReasonDuplicate,
+			Outcome:   types.CreditNoteOutcomeRefund,
+			LineItems: []dto.CreateCreditNoteLineItemRequest{
+				{InvoiceLineItemID: lo.ToPtr("inv_line_api"), Amount: decimal.NewFromInt(30)},
+			},
+		})
+		s.NoError(err)
+		s.Equal(p.ID, lo.FromPtr(resp.PaymentID))
+		s.Nil(resp.WalletID)
+
+		updated, err := s.GetStores().PaymentRepo.Get(s.GetContext(), p.ID)
+		s.NoError(err)
+		s.Equal(types.PaymentStatusPartiallyRefunded, updated.PaymentStatus)
+		s.NotNil(updated.RefundedAt)
+
+		inv, err := s.GetStores().InvoiceRepo.Get(s.GetContext(), s.testData.invoice.ID)
+		s.NoError(err)
+		s.Equal(types.PaymentStatusPartiallyRefunded, inv.PaymentStatus)
+	})
+
+	s.Run("credits_payment", func() {
+		s.SetupTest()
+		p := s.markInvoicePaid(types.PaymentMethodTypeCredits, s.testData.wallet.ID)
+
+		resp, err := s.service.CreateCreditNote(s.GetContext(), dto.CreateCreditNoteRequest{
+			InvoiceID: s.testData.invoice.ID,
+			Reason:    types.CreditNoteReasonFraudulent,
+			Outcome:   types.CreditNoteOutcomeRefund,
+			LineItems: []dto.CreateCreditNoteLineItemRequest{
+				{InvoiceLineItemID: lo.ToPtr("inv_line_api"), Amount: decimal.NewFromInt(60)},
+				{InvoiceLineItemID: lo.ToPtr("inv_line_storage"), Amount: decimal.NewFromInt(40)},
+			},
+		})
+		s.NoError(err)
+		s.Equal(s.testData.wallet.ID, lo.FromPtr(resp.WalletID))
+
+		w, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallet.ID)
+		s.NoError(err)
+		s.True(decimal.NewFromInt(100).Equal(w.Balance))
+
+		updated, err := s.GetStores().PaymentRepo.Get(s.GetContext(), p.ID)
+		s.NoError(err)
+		s.Equal(types.PaymentStatusRefunded, updated.PaymentStatus)
+
+		inv, err := s.GetStores().InvoiceRepo.Get(s.GetContext(), s.testData.invoice.ID)
+		s.NoError(err)
+		s.Equal(types.PaymentStatusRefunded, inv.PaymentStatus)
+	})
+
+	s.Run("no_payment", func() {
+		s.SetupTest()
+		_, err := s.service.CreateCreditNote(s.GetContext(), dto.CreateCreditNoteRequest{
+			InvoiceID: s.testData.invoice.ID,
+			Reason:    types.CreditNoteReasonDuplicate,
+			Outcome:   types.CreditNoteOutcomeRefund,
+			LineItems: []dto.CreateCreditNoteLineItemRequest{
+				{InvoiceLineItemID: lo.ToPtr("inv_line_api"), Amount: decimal.NewFromInt(10)},
+			},
+		})
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+}
+
+func (s *CreditNoteServiceSuite) TestCreateCreditNoteValidation() {
+	tests := []struct {
+		name    string
+		setup   func()
+		req     dto.CreateCreditNoteRequest
+		wantErr bool
+	}{
+		{
+			name: "draft_invoice",
+			setup: func() {
+				s.testData.invoice.InvoiceStatus = types.InvoiceStatusDraft
+				s.NoError(s.GetStores().InvoiceRepo.Update(s.GetContext(), s.testData.invoice))
+			},
+			req: dto.CreateCreditNoteRequest{
+				Reason:  types.CreditNoteReasonOther,
+				Outcome: types.CreditNoteOutcomeReduceAmountRemaining,
+				LineItems: []dto.CreateCreditNoteLineItemRequest{
+					{InvoiceLineItemID: lo.ToPtr("inv_line_api"), Amount: decimal.NewFromInt(10)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "line_item_over_credited",
+			req: dto.CreateCreditNoteRequest{
+				Reason:  types.CreditNoteReasonOther,
+				Outcome: types.CreditNoteOutcomeReduceAmountRemaining,
+				LineItems: []dto.CreateCreditNoteLineItemRequest{
+					{InvoiceLineItemID: lo.ToPtr("inv_line_storage"), Amount: decimal.NewFromInt(41)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "line_item_of_other_invoice",
+			req: dto.CreateCreditNoteRequest{
+				Reason:  types.CreditNoteReasonOther,
+				Outcome: types.CreditNoteOutcomeReduceAmountRemaining,
+				LineItems: []dto.CreateCreditNoteLineItemRequest{
+					{InvoiceLineItemID: lo.ToPtr("inv_line_unknown"), Amount: decimal.NewFromInt(1)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "exceeds_invoice_total",
+			req: dto.CreateCreditNoteRequest{
+				Reason:  types.CreditNoteReasonOther,
+				Outcome: types.CreditNoteOutcomeWalletCredit,
+				LineItems: []dto.CreateCreditNoteLineItemRequest{
+					{DisplayName: "Adjustment", Amount: decimal.NewFromInt(101)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_reason",
+			req: dto.CreateCreditNoteRequest{
+				Reason:  "UNKNOWN",
+				Outcome: types.CreditNoteOutcomeWalletCredit,
+				LineItems: []dto.CreateCreditNoteLineItemRequest{
+					{DisplayName: "Adjustment", Amount: decimal.NewFromInt(1)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wallet_id_without_wallet_credit",
+			req: dto.CreateCreditNoteRequest{
+				Reason:   types.CreditNoteReasonOther,
+				Outcome:  types.CreditNoteOutcomeReduceAmountRemaining,
+				WalletID: lo.ToPtr("wallet_credit_note"),
+				LineItems: []dto.CreateCreditNoteLineItemRequest{
+					{DisplayName: "Adjustment", Amount: decimal.NewFromInt(1)},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			tt.req.InvoiceID = s.testData.invoice.ID
+			_, err := s.service.CreateCreditNote(s.GetContext(), tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.True(ierr.IsValidation(err))
+				return
+			}
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *CreditNoteServiceSuite) TestCreditedAmountsAccumulate() {
+	s.markInvoicePaid(types.PaymentMethodTypeOffline, "")
+
+	req := dto.CreateCreditNoteRequest{
+		InvoiceID: s.testData.invoice.ID,
+		Reason:    types.CreditNoteReasonOrderChange,
+		Outcome:   types.CreditNoteOutcomeWalletCredit,
+		LineItems: []dto.CreateCreditNoteLineItemRequest{
+			{InvoiceLineItemID: lo.ToPtr("inv_line_storage"), Amount: decimal.NewFromInt(30)},
+		},
+	}
+
+	first, err := s.service.CreateCreditNote(s.GetContext(), req)
+	s.NoError(err)
+
+	// only 10 of the storage line item is left to credit
+	_, err = s.service.CreateCreditNote(s.GetContext(), req)
+	s.Error(err)
+
+	req.LineItems[0].Amount = decimal.NewFromInt(10)
+	second, err := s.service.CreateCreditNote(s.GetContext(), req)
+	s.NoError(err)
+	s.NotEqual(first.CreditNoteNumber, second.CreditNoteNumber)
+
+	filter := types.NewDefaultCreditNoteFilter()
+	filter.InvoiceID = s.testData.invoice.ID
+	list, err := s.service.ListCreditNotes(s.GetContext(), filter)
+	s.NoError(err)
+	s.Len(list.Items, 2)
+	s.Equal(2, list.Pagination.Total)
+}
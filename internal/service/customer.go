@@ -20,11 +20,11 @@ type CustomerService interface {
 }
 
 type customerService struct {
-	repo customer.Repository
+	ServiceParams
 }
 
-func NewCustomerService(repo customer.Repository) CustomerService {
-	return &customerService{repo: repo}
+func NewCustomerService(params ServiceParams) CustomerService {
+	return &customerService{ServiceParams: params}
 }
 
 func (s *customerService) CreateCustomer(ctx context.Context, req dto.CreateCustomerRequest) (*dto.CustomerResponse, error) {
@@ -41,8 +41,16 @@ func (s *customerService) CreateCustomer(ctx context.Context, req dto.CreateCust
 			Mark(ierr.ErrValidation)
 	}
 
-	if err := s.repo.Create(ctx, cust); err != nil {
-		// No need to wrap the error as the repository already returns properly formatted errors
+	err := s.DB.WithTx(ctx, func(tx context.Context) error {
+		if err := s.CustomerRepo.Create(tx, cust); err != nil {
+			// No need to wrap the error as the repository already returns properly formatted errors
+			return err
+		}
+
+		s.publishWebhookEvent(tx, types.WebhookEventCustomerCreated, types.WebhookEventPayload{CustomerID: cust.ID})
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -56,7 +64,7 @@ func (s *customerService) GetCustomer(ctx context.Context, id string) (*dto.Cust
 			Mark(ierr.ErrValidation)
 	}
 
-	customer, err := s.repo.Get(ctx, id)
+	customer, err := s.CustomerRepo.Get(ctx, id)
 	if err != nil {
 		// No need to wrap the error as the repository already returns properly formatted errors
 		return nil, err
@@ -78,13 +86,13 @@ func (s *customerService) GetCustomers(ctx context.Context, filter *types.Custom
 			Mark(ierr.ErrValidation)
 	}
 
-	customers, err := s.repo.List(ctx, filter)
+	customers, err := s.CustomerRepo.List(ctx, filter)
 	if err != nil {
 		// No need to wrap the error as the repository already returns properly formatted errors
 		return nil, err
 	}
 
-	total, err := s.repo.Count(ctx, filter)
+	total, err := s.CustomerRepo.Count(ctx, filter)
 	if err != nil {
 		// No need to wrap the error as the repository already returns properly formatted errors
 		return nil, err
@@ -112,7 +120,7 @@ func (s *customerService) UpdateCustomer(ctx context.Context, id string, req dto
 		return nil, err
 	}
 
-	cust, err := s.repo.Get(ctx, id)
+	cust, err := s.CustomerRepo.Get(ctx, id)
 	if err != nil {
 		// No need to wrap the error as the repository already returns properly formatted errors
 		return nil, err
@@ -159,6 +167,17 @@ func (s *customerService) UpdateCustomer(ctx context.Context, id string, req dto
 		cust.AddressCountry = *req.AddressCountry
 	}
 
+	// Update tax fields
+	if req.TaxIDs != nil {
+		cust.TaxIDs = req.TaxIDs
+	}
+	if req.TaxExempt != nil {
+		cust.TaxExempt = *req.TaxExempt
+	}
+	if req.AutoApplyCredits != nil {
+		cust.AutoApplyCredits = *req.AutoApplyCredits
+	}
+
 	// Update metadata if provided
 	if req.Metadata != nil {
 		cust.Metadata = req.Metadata
@@ -174,8 +193,16 @@ func (s *customerService) UpdateCustomer(ctx context.Context, id string, req dto
 	cust.UpdatedAt = time.Now().UTC()
 	cust.UpdatedBy = types.GetUserID(ctx)
 
-	if err := s.repo.Update(ctx, cust); err != nil {
-		// No need to wrap the error as the repository already returns properly formatted errors
+	err = s.DB.WithTx(ctx, func(tx context.Context) error {
+		if err := s.CustomerRepo.Update(tx, cust); err != nil {
+			// No need to wrap the error as the repository already returns properly formatted errors
+			return err
+		}
+
+		s.publishWebhookEvent(tx, types.WebhookEventCustomerUpdated, types.WebhookEventPayload{CustomerID: cust.ID})
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -189,7 +216,7 @@ func (s *customerService) DeleteCustomer(ctx context.Context, id string) error {
 			Mark(ierr.ErrValidation)
 	}
 
-	if err := s.repo.Delete(ctx, id); err != nil {
+	if err := s.CustomerRepo.Delete(ctx, id); err != nil {
 		// No need to wrap the error as the repository already returns properly formatted errors
 		return err
 	}
@@ -204,7 +231,7 @@ func (s *customerService) GetCustomerByLookupKey(ctx context.Context, lookupKey
 			Mark(ierr.ErrValidation)
 	}
 
-	customer, err := s.repo.GetByLookupKey(ctx, lookupKey)
+	customer, err := s.CustomerRepo.GetByLookupKey(ctx, lookupKey)
 	if err != nil {
 		return nil, err
 	}
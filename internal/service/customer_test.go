@@ -3,6 +3,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
@@ -15,7 +16,7 @@ import (
 )
 
 type CustomerServiceSuite struct {
-	suite.Suite
+	testutil.BaseServiceTestSuite
 	ctx             context.Context
 	customerService *customerService
 	repo            *testutil.InMemoryCustomerStore
@@ -26,10 +27,21 @@ func TestCustomerService(t *testing.T) {
 }
 
 func (s *CustomerServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
 	s.ctx = context.Background()
+	s.setupService()
+}
+
+func (s *CustomerServiceSuite) setupService() {
 	s.repo = testutil.NewInMemoryCustomerStore()
 	s.customerService = &customerService{
-		repo: s.repo,
+		ServiceParams: ServiceParams{
+			Logger:           s.GetLogger(),
+			Config:           s.GetConfig(),
+			DB:               s.GetDB(),
+			CustomerRepo:     s.repo,
+			WebhookPublisher: s.GetWebhookPublisher(),
+		},
 	}
 }
 
@@ -189,8 +201,7 @@ func (s *CustomerServiceSuite) TestGetCustomer() {
 
 func (s *CustomerServiceSuite) TestGetCustomers() {
 	// Reset and prepopulate the repository with customers
-	s.repo = testutil.NewInMemoryCustomerStore()
-	s.customerService = &customerService{repo: s.repo}
+	s.setupService()
 	_ = s.repo.Create(s.ctx, &domainCustomer.Customer{
 		ID:             "cust-1",
 		Name:           "Customer One",
@@ -432,3 +443,29 @@ func (s *CustomerServiceSuite) TestGetCustomerByLookupKey() {
 		})
 	}
 }
+
+func (s *CustomerServiceSuite) TestCustomerWebhookEvents() {
+	resp, err := s.customerService.CreateCustomer(s.ctx, dto.CreateCustomerRequest{
+		ExternalID: "ext-webhook",
+		Name:       "Webhook Customer",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.customerService.UpdateCustomer(s.ctx, resp.ID, dto.UpdateCustomerRequest{
+		Name: lo.ToPtr("Renamed Customer"),
+	})
+	s.Require().NoError(err)
+
+	events := s.GetWebhookEvents()
+	s.Require().Len(events, 2)
+	s.Equal(types.WebhookEventCustomerCreated, events[0].EventName)
+	s.Equal(types.WebhookEventCustomerUpdated, events[1].EventName)
+
+	for _, event := range events {
+		var payload struct {
+			CustomerID string `json:"customer_id"`
+		}
+		s.NoError(json.Unmarshal(event.Payload, &payload))
+		s.Equal(resp.ID, payload.CustomerID)
+	}
+}
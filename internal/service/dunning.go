@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/dunning"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/domain/tenant"
+	"github.com/flexprice/flexprice/internal/idempotency"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// DunningService retries the payment of unpaid invoices and moves their subscriptions through
+// the past_due lifecycle according to the dunning policy of the tenant
+type DunningService interface {
+	ProcessDunning(ctx context.Context) (*dto.ProcessDunningResponse, error)
+	ListInvoiceAttempts(ctx context.Context, invoiceID string) (*dto.ListDunningAttemptsResponse, error)
+}
+
+type dunningService struct {
+	ServiceParams
+	invoiceService      *invoiceService
+	subscriptionService *subscriptionService
+}
+
+func NewDunningService(params ServiceParams) DunningService {
+	return &dunningService{
+		ServiceParams: params,
+		invoiceService: &invoiceService{
+			ServiceParams: params,
+			idempGen:      idempotency.NewGenerator(),
+		},
+		subscriptionService: &subscriptionService{
+			ServiceParams: params,
+		},
+	}
+}
+
+const (
+	dunningActionRetry   = "retry"
+	dunningActionPastDue = "past_due"
+)
+
+func (s *dunningService) ListInvoiceAttempts(ctx context.Context, invoiceID string) (*dto.ListDunningAttemptsResponse, error) {
+	// make sure the invoice exists and is visible in the current environment
+	if _, err := s.InvoiceRepo.Get(ctx, invoiceID); err != nil {
+		return nil, err
+	}
+
+	attempts, err := s.DunningRepo.ListByInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewListDunningAttemptsResponse(attempts), nil
+}
+
+// ProcessDunning runs the dunning process of all tenants with an enabled dunning policy. It is
+// meant to be called periodically by a scheduled job. The errors of individual tenants are
+// collected in the response, only a failure to list the tenants fails the run.
+func (s *dunningService) ProcessDunning(ctx context.Context) (*dto.ProcessDunningResponse, error) {
+	now := time.Now().UTC()
+	response := &dto.ProcessDunningResponse{
+		Items:   make([]*dto.ProcessDunningResponseItem, 0),
+		StartAt: now,
+	}
+
+	tenants, err := s.TenantRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tenants {
+		if !t.DunningPolicy.Enabled {
+			continue
+		}
+
+		// update context to include the tenant id
+		ctx := context.WithValue(ctx, types.CtxTenantID, t.ID)
+		// clean the environment id to make sure it's not used
+		ctx = context.WithValue(ctx, types.CtxEnvironmentID, "")
+
+		// A failing tenant does not hold back the dunning of the others
+		if err := s.processTenant(ctx, t, now, response); err != nil {
+			s.Logger.Errorw("failed to process dunning for tenant",
+				"tenant_id", t.ID,
+				"error", err)
+			response.TenantErrors = append(response.TenantErrors, &dto.ProcessDunningTenantError{
+				TenantID: t.ID,
+				Error:    err.Error(),
+			})
+		}
+	}
+
+	s.Logger.Infow("completed dunning process",
+		"total_success", response.TotalSuccess,
+		"total_failed", response.TotalFailed,
+		"tenants_failed", len(response.TenantErrors))
+
+	return response, nil
+}
+
+func (s *dunningService) processTenant(ctx context.Context, t *tenant.Tenant, now time.Time, response *dto.ProcessDunningResponse) error {
+	filter := types.NewNoLimitInvoiceFilter()
+	filter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusFinalized}
+	filter.PaymentStatus = []types.PaymentStatus{types.PaymentStatusPending, types.PaymentStatusFailed}
+	filter.AmountRemainingGt = lo.ToPtr(decimal.Zero)
+
+	invoices, err := s.InvoiceRepo.List(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	for _, inv := range invoices {
+		if now.Before(invoiceDueAt(inv)) {
+			continue
+		}
+
+		// process the invoice in its own environment
+		ctx := context.WithValue(ctx, types.CtxEnvironmentID, inv.EnvironmentID)
+
+		actions, err := s.processInvoice(ctx, t.DunningPolicy, inv, now)
+		if err != nil {
+			s.Logger.Errorw("failed to process dunning for invoice",
+				"invoice_id", inv.ID,
+				"error", err)
+
+			response.TotalFailed++
+			response.Items = append(response.Items, &dto.ProcessDunningResponseItem{
+				InvoiceID:      inv.ID,
+				SubscriptionID: inv.SubscriptionID,
+				Actions:        actions,
+				Success:        false,
+				Error:          err.Error(),
+			})
+			continue
+		}
+
+		if len(actions) == 0 {
+			continue
+		}
+
+		response.TotalSuccess++
+		response.Items = append(response.Items, &dto.ProcessDunningResponseItem{
+			InvoiceID:      inv.ID,
+			SubscriptionID: inv.SubscriptionID,
+			Actions:        actions,
+			Success:        true,
+		})
+	}
+
+	return s.recoverSubscriptions(ctx, t.DunningPolicy, now)
+}
+
+// processInvoice retries the payment of the invoice when the next retry is due and then applies
+// the past due status and the final action to its subscription. It returns the actions taken.
+func (s *dunningService) processInvoice(ctx context.Context, policy tenant.TenantDunningPolicy, inv *invoice.Invoice, now time.Time) ([]string, error) {
+	actions := make([]string, 0)
+	dueAt := invoiceDueAt(inv)
+
+	attempts, err := s.DunningRepo.ListByInvoice(ctx, inv.ID)
+	if err != nil {
+		return actions, err
+	}
+
+	var lastAttempt *dunning.Attempt
+	if len(attempts) > 0 {
+		lastAttempt = attempts[len(attempts)-1]
+	}
+
+	if len(attempts) < len(policy.RetryDays) && !now.Before(dueAt.AddDate(0, 0, policy.RetryDays[len(attempts)])) {
+		lastAttempt, err = s.retryPayment(ctx, inv, len(attempts)+1, now)
+		if err != nil {
+			return actions, err
+		}
+		actions = append(actions, dunningActionRetry)
+
+		if lastAttempt.AttemptStatus == types.DunningAttemptStatusSucceeded {
+			return actions, nil
+		}
+		attempts = append(attempts, lastAttempt)
+	}
+
+	if inv.SubscriptionID == nil || now.Before(dueAt.AddDate(0, 0, policy.GraceDays)) {
+		return actions, nil
+	}
+
+	sub, err := s.SubRepo.Get(ctx, *inv.SubscriptionID)
+	if err != nil {
+		return actions, err
+	}
+
+	if sub.SubscriptionStatus == types.SubscriptionStatusActive {
+		sub.SubscriptionStatus = types.SubscriptionStatusPastDue
+		err := s.DB.WithTx(ctx, func(tx context.Context) error {
+			if err := s.SubRepo.Update(tx, sub); err != nil {
+				return err
+			}
+
+			s.publishWebhookEvent(tx, types.WebhookEventSubscriptionPastDue, types.WebhookEventPayload{SubscriptionID: sub.ID})
+			return nil
+		})
+		if err != nil {
+			return actions, err
+		}
+		actions = append(actions, dunningActionPastDue)
+
+		s.Logger.Infow("subscription is past due",
+			"subscription_id", sub.ID,
+			"invoice_id", inv.ID)
+	}
+
+	retriesExhausted := len(attempts) >= len(policy.RetryDays) &&
+		(lastAttempt == nil || lastAttempt.AttemptStatus == types.DunningAttemptStatusFailed)
+	if sub.SubscriptionStatus != types.SubscriptionStatusPastDue || !retriesExhausted || policy.FinalAction == "" {
+		return actions, nil
+	}
+
+	if err := s.applyFinalAction(ctx, policy.FinalAction, sub, now); err != nil {
+		return actions, err
+	}
+	actions = append(actions, string(policy.FinalAction))
+
+	return actions, nil
+}
+
+// retryPayment attempts the payment of the invoice and records the outcome as a dunning attempt
+func (s *dunningService) retryPayment(ctx context.Context, inv *invoice.Invoice, attemptNumber int, now time.Time) (*dunning.Attempt, error) {
+	amountRemaining := inv.AmountRemaining
+
+	var errorMessage *string
+	if err := s.invoiceService.AttemptPayment(ctx, inv.ID); err != nil {
+		errorMessage = lo.ToPtr(err.Error())
+	}
+
+	updated, err := s.InvoiceRepo.Get(ctx, inv.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := &dunning.Attempt{
+		ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_DUNNING_ATTEMPT),
+		InvoiceID:       inv.ID,
+		SubscriptionID:  inv.SubscriptionID,
+		AttemptNumber:   attemptNumber,
+		AttemptStatus:   types.DunningAttemptStatusFailed,
+		AmountPaid:      amountRemaining.Sub(updated.AmountRemaining),
+		AmountRemaining: updated.AmountRemaining,
+		ErrorMessage:    errorMessage,
+		AttemptedAt:     now,
+		EnvironmentID:   inv.EnvironmentID,
+		BaseModel:       types.GetDefaultBaseModel(ctx),
+	}
+
+	if updated.PaymentStatus == types.PaymentStatusSucceeded || updated.AmountRemaining.LessThanOrEqual(decimal.Zero) {
+		attempt.AttemptStatus = types.DunningAttemptStatusSucceeded
+	} else if attempt.ErrorMessage == nil {
+		attempt.ErrorMessage = lo.ToPtr(fmt.Sprintf("%s of %s %s remains unpaid",
+			updated.AmountRemaining.String(), updated.AmountDue.String(), updated.Currency))
+	}
+
+	err = s.DB.WithTx(ctx, func(tx context.Context) error {
+		if err := s.DunningRepo.Create(tx, attempt); err != nil {
+			return err
+		}
+
+		s.publishWebhookEvent(tx, lo.Ternary(
+			attempt.AttemptStatus == types.DunningAttemptStatusSucceeded,
+			types.WebhookEventInvoiceDunningAttemptSucceeded,
+			types.WebhookEventInvoiceDunningAttemptFailed,
+		), types.WebhookEventPayload{InvoiceID: inv.ID})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("retried invoice payment",
+		"invoice_id", inv.ID,
+		"attempt_number", attemptNumber,
+		"attempt_status", attempt.AttemptStatus,
+		"amount_paid", attempt.AmountPaid,
+		"amount_remaining", attempt.AmountRemaining)
+
+	return attempt, nil
+}
+
+// applyFinalAction applies the final action of the dunning policy to a past due subscription
+// once all payment retries failed
+func (s *dunningService) applyFinalAction(ctx context.Context, action types.DunningFinalAction, sub *subscription.Subscription, now time.Time) error {
+	err := s.DB.WithTx(ctx, func(tx context.Context) error {
+		switch action {
+		case types.DunningFinalActionCancel:
+			sub.SubscriptionStatus = types.SubscriptionStatusCancelled
+			sub.CancelledAt = lo.ToPtr(now)
+			if err := s.SubRepo.Update(tx, sub); err != nil {
+				return err
+			}
+			s.publishWebhookEvent(tx, types.WebhookEventSubscriptionCancelled, types.WebhookEventPayload{SubscriptionID: sub.ID})
+		case types.DunningFinalActionPause:
+			// The pause publishes the subscription paused event
+			req := &dto.PauseSubscriptionRequest{
+				PauseMode: types.PauseModeImmediate,
+				Reason:    "dunning retries exhausted",
+			}
+			if _, _, err := s.subscriptionService.executePause(tx, sub, req, lo.ToPtr(now), nil); err != nil {
+				return err
+			}
+		case types.DunningFinalActionMarkUnpaid:
+			sub.SubscriptionStatus = types.SubscriptionStatusUnpaid
+			if err := s.SubRepo.Update(tx, sub); err != nil {
+				return err
+			}
+		default:
+			return action.Validate()
+		}
+
+		s.publishWebhookEvent(tx, types.WebhookEventSubscriptionDunningExhausted, types.WebhookEventPayload{SubscriptionID: sub.ID})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Infow("applied dunning final action",
+		"subscription_id", sub.ID,
+		"final_action", action)
+	return nil
+}
+
+// recoverSubscriptions moves past due subscriptions back to active once none of their invoices
+// is unpaid beyond the grace period
+func (s *dunningService) recoverSubscriptions(ctx context.Context, policy tenant.TenantDunningPolicy, now time.Time) error {
+	subFilter := types.NewNoLimitSubscriptionFilter()
+	subFilter.SubscriptionStatus = []types.SubscriptionStatus{types.SubscriptionStatusPastDue}
+
+	subs, err := s.SubRepo.List(ctx, subFilter)
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subs {
+		ctx := context.WithValue(ctx, types.CtxEnvironmentID, sub.EnvironmentID)
+
+		filter := types.NewNoLimitInvoiceFilter()
+		filter.SubscriptionID = sub.ID
+		filter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusFinalized}
+		filter.PaymentStatus = []types.PaymentStatus{types.PaymentStatusPending, types.PaymentStatusFailed}
+		filter.AmountRemainingGt = lo.ToPtr(decimal.Zero)
+
+		invoices, err := s.InvoiceRepo.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		overdue := lo.ContainsBy(invoices, func(inv *invoice.Invoice) bool {
+			return !now.Before(invoiceDueAt(inv).AddDate(0, 0, policy.GraceDays))
+		})
+		if overdue {
+			continue
+		}
+
+		sub.SubscriptionStatus = types.SubscriptionStatusActive
+		err = s.DB.WithTx(ctx, func(tx context.Context) error {
+			if err := s.SubRepo.Update(tx, sub); err != nil {
+				return err
+			}
+
+			s.publishWebhookEvent(tx, types.WebhookEventSubscriptionRecovered, types.WebhookEventPayload{SubscriptionID: sub.ID})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		s.Logger.Infow("subscription recovered from past due",
+			"subscription_id", sub.ID)
+	}
+
+	return nil
+}
+
+// invoiceDueAt is when the invoice is due, falling back to when it was finalized
+func invoiceDueAt(inv *invoice.Invoice) time.Time {
+	if inv.DueDate != nil {
+		return *inv.DueDate
+	}
+	if inv.FinalizedAt != nil {
+		return *inv.FinalizedAt
+	}
+	return inv.CreatedAt
+}
@@ -0,0 +1,391 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/domain/tenant"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type DunningServiceTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service  DunningService
+	testData struct {
+		tenant       *tenant.Tenant
+		customer     *customer.Customer
+		subscription *subscription.Subscription
+	}
+}
+
+func TestDunningService(t *testing.T) {
+	suite.Run(t, new(DunningServiceTestSuite))
+}
+
+func (s *DunningServiceTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	s.service = NewDunningService(ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		PlanRepo:         s.GetStores().PlanRepo,
+		PriceRepo:        s.GetStores().PriceRepo,
+		EventRepo:        s.GetStores().EventRepo,
+		MeterRepo:        s.GetStores().MeterRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		EntitlementRepo:  s.GetStores().EntitlementRepo,
+		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		FeatureRepo:      s.GetStores().FeatureRepo,
+		TenantRepo:       s.GetStores().TenantRepo,
+		UserRepo:         s.GetStores().UserRepo,
+		AuthRepo:         s.GetStores().AuthRepo,
+		WalletRepo:       s.GetStores().WalletRepo,
+		PaymentRepo:      s.GetStores().PaymentRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
+		DunningRepo:      s.GetStores().DunningRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	})
+	s.setupTestData()
+}
+
+func (s *DunningServiceTestSuite) setupTestData() {
+	ctx := s.GetContext()
+
+	s.testData.tenant = &tenant.Tenant{
+		ID:     types.GetTenantID(ctx),
+		Name:   "Test Tenant",
+		Status: types.StatusPublished,
+		DunningPolicy: tenant.TenantDunningPolicy{
+			Enabled:     true,
+			RetryDays:   []int{1, 3},
+			GraceDays:   2,
+			FinalAction: types.DunningFinalActionCancel,
+		},
+	}
+	s.NoError(s.GetStores().TenantRepo.Create(ctx, s.testData.tenant))
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_dunning",
+		ExternalID: "ext_cust_dunning",
+		Name:       "Test Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	now := s.GetNow()
+	s.testData.subscription = &subscription.Subscription{
+		ID:                 "sub_dunning",
+		CustomerID:         s.testData.customer.ID,
+		PlanID:             "plan_dunning",
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		Currency:           "usd",
+		StartDate:          now.AddDate(0, -1, 0),
+		CurrentPeriodStart: now.AddDate(0, 0, -10),
+		CurrentPeriodEnd:   now.AddDate(0, 0, 20),
+		BillingAnchor:      now.AddDate(0, -1, 0),
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.Create(ctx, s.testData.subscription))
+}
+
+func (s *DunningServiceTestSuite) createInvoice(id string, dueDate time.Time) *invoice.Invoice {
+	inv := &invoice.Invoice{
+		ID:              id,
+		CustomerID:      s.testData.customer.ID,
+		SubscriptionID:  lo.ToPtr(s.testData.subscription.ID),
+		InvoiceType:     types.InvoiceTypeSubscription,
+		InvoiceStatus:   types.InvoiceStatusFinalized,
+		PaymentStatus:   types.PaymentStatusPending,
+		Currency:        "usd",
+		AmountDue:       decimal.NewFromInt(100),
+		AmountPaid:      decimal.Zero,
+		AmountRemaining: decimal.NewFromInt(100),
+		DueDate:         lo.ToPtr(dueDate),
+		FinalizedAt:     lo.ToPtr(dueDate),
+		BaseModel:       types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().InvoiceRepo.Create(s.GetContext(), inv))
+	return inv
+}
+
+func (s *DunningServiceTestSuite) setPolicy(modify func(policy *tenant.TenantDunningPolicy)) {
+	modify(&s.testData.tenant.DunningPolicy)
+	s.NoError(s.GetStores().TenantRepo.Update(s.GetContext(), s.testData.tenant))
+}
+
+func (s *DunningServiceTestSuite) getSubscription() *subscription.Subscription {
+	sub, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), s.testData.subscription.ID)
+	s.Require().NoError(err)
+	return sub
+}
+
+func (s *DunningServiceTestSuite) webhookEventNames() []string {
+	return lo.Map(s.GetWebhookEvents(), func(event *types.WebhookEvent, _ int) string {
+		return event.EventName
+	})
+}
+
+func (s *DunningServiceTestSuite) TestNoRetryBeforeSchedule() {
+	inv := s.createInvoice("inv_not_due", s.GetNow().Add(-12*time.Hour))
+
+	resp, err := s.service.ProcessDunning(s.GetContext())
+	s.NoError(err)
+	s.Empty(resp.Items)
+
+	attempts, err := s.service.ListInvoiceAttempts(s.GetContext(), inv.ID)
+	s.NoError(err)
+	s.Empty(attempts.Items)
+}
+
+func (s *DunningServiceTestSuite) TestDisabledPolicySkipsTenant() {
+	s.setPolicy(func(policy *tenant.TenantDunningPolicy) {
+		policy.Enabled = false
+	})
+	s.createInvoice("inv_disabled", s.GetNow().AddDate(0, 0, -10))
+
+	resp, err := s.service.ProcessDunning(s.GetContext())
+	s.NoError(err)
+	s.Empty(resp.Items)
+	s.Equal(types.SubscriptionStatusActive, s.getSubscription().SubscriptionStatus)
+}
+
+func (s *DunningServiceTestSuite) TestFailedRetryIsRecorded() {
+	inv := s.createInvoice("inv_retry", s.GetNow().AddDate(0, 0, -1).Add(-time.Hour))
+
+	resp, err := s.service.ProcessDunning(s.GetContext())
+	s.NoError(err)
+	s.Equal(1, resp.TotalSuccess)
+	s.Require().Len(resp.Items, 1)
+	s.Equal([]string{dunningActionRetry}, resp.Items[0].Actions)
+
+	attempts, err := s.service.ListInvoiceAttempts(s.GetContext(), inv.ID)
+	s.NoError(err)
+	s.Require().Len(attempts.Items, 1)
+	s.Equal(1, attempts.Items[0].AttemptNumber)
+	s.Equal(types.DunningAttemptStatusFailed, attempts.Items[0].AttemptStatus)
+	s.True(attempts.Items[0].AmountRemaining.Equal(decimal.NewFromInt(100)))
+	s.NotNil(attempts.Items[0].ErrorMessage)
+
+	// Still within the grace period
+	s.Equal(types.SubscriptionStatusActive, s.getSubscription().SubscriptionStatus)
+	s.Equal([]string{types.WebhookEventInvoiceDunningAttemptFailed}, s.webhookEventNames())
+
+	// The next retry is not due yet
+	resp, err = s.service.ProcessDunning(s.GetContext())
+	s.NoError(err)
+	s.Empty(resp.Items)
+}
+
+// tenantFailingInvoiceRepo fails to list the invoices of a single tenant
+type tenantFailingInvoiceRepo struct {
+	invoice.Repository
+	tenantID string
+}
+
+func (r *tenantFailingInvoiceRepo) List(ctx context.Context, filter *types.InvoiceFilter) ([]*invoice.Invoice, error) {
+	if types.GetTenantID(ctx) == r.tenantID {
+		return nil, ierr.NewError("failed to list invoices").Mark(ierr.ErrDatabase)
+	}
+	return r.Repository.List(ctx, filter)
+}
+
+func (s *DunningServiceTestSuite) TestFailingTenantDoesNotStopOtherTenants() {
+	broken := &tenant.Tenant{
+		ID:            "tenant_broken",
+		Name:          "Broken Tenant",
+		Status:        types.StatusPublished,
+		DunningPolicy: s.testData.tenant.DunningPolicy,
+	}
+	s.NoError(s.GetStores().TenantRepo.Create(s.GetContext(), broken))
+
+	svc := s.service.(*dunningService)
+	svc.InvoiceRepo = &tenantFailingInvoiceRepo{Repository: svc.InvoiceRepo, tenantID: broken.ID}
+
+	s.createInvoice("inv_other_tenant", s.GetNow().AddDate(0, 0, -1).Add(-time.Hour))
+
+	resp, err := s.service.ProcessDunning(s.GetContext())
+	s.NoError(err)
+	s.Equal(1, resp.TotalSuccess)
+	s.Require().Len(resp.Items, 1)
+	s.Equal("inv_other_tenant", resp.Items[0].InvoiceID)
+	s.Require().Len(resp.TenantErrors, 1)
+	s.Equal(broken.ID, resp.TenantErrors[0].TenantID)
+	s.NotEmpty(resp.TenantErrors[0].Error)
+}
+
+func (s *DunningServiceTestSuite) TestSucceededRetryPaysInvoice() {
+	ctx := s.GetContext()
+	w := &wallet.Wallet{
+		ID:             "wallet_dunning",
+		CustomerID:     s.testData.customer.ID,
+		Currency:       "usd",
+		Balance:        decimal.NewFromInt(200),
+		CreditBalance:  decimal.NewFromInt(200),
+		ConversionRate: decimal.NewFromInt(1),
+		WalletStatus:   types.WalletStatusActive,
+		WalletType:     types.WalletTypePrePaid,
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().WalletRepo.CreateWallet(ctx, w))
+	s.NoError(s.GetStores().WalletRepo.CreateTransaction(ctx, &wallet.Transaction{
+		ID:               types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WALLET_TRANSACTION),
+		WalletID:         w.ID,
+		Type:             types.TransactionTypeCredit,
+		Amount:           w.Balance,
+		CreditAmount:     w.CreditBalance,
+		CreditsAvailable: w.CreditBalance,
+		TxStatus:         types.TransactionStatusCompleted,
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	}))
+
+	inv := s.createInvoice("inv_paid", s.GetNow().AddDate(0, 0, -5))
+
+	_, err := s.service.ProcessDunning(ctx)
+	s.NoError(err)
+
+	attempts, err := s.service.ListInvoiceAttempts(ctx, inv.ID)
+	s.NoError(err)
+	s.Require().Len(attempts.Items, 1)
+	s.Equal(types.DunningAttemptStatusSucceeded, attempts.Items[0].AttemptStatus)
+	s.True(attempts.Items[0].AmountPaid.Equal(decimal.NewFromInt(100)))
+	s.True(attempts.Items[0].AmountRemaining.IsZero())
+
+	// A paid invoice does not make the subscription past due
+	s.Equal(types.SubscriptionStatusActive, s.getSubscription().SubscriptionStatus)
+	s.Contains(s.webhookEventNames(), types.WebhookEventInvoiceDunningAttemptSucceeded)
+	s.NotContains(s.webhookEventNames(), types.WebhookEventSubscriptionPastDue)
+}
+
+func (s *DunningServiceTestSuite) TestPastDueAfterGracePeriod() {
+	s.createInvoice("inv_past_due", s.GetNow().AddDate(0, 0, -2).Add(-time.Hour))
+
+	resp, err := s.service.ProcessDunning(s.GetContext())
+	s.NoError(err)
+	s.Require().Len(resp.Items, 1)
+	s.Equal([]string{dunningActionRetry, dunningActionPastDue}, resp.Items[0].Actions)
+
+	s.Equal(types.SubscriptionStatusPastDue, s.getSubscription().SubscriptionStatus)
+	s.Equal([]string{
+		types.WebhookEventInvoiceDunningAttemptFailed,
+		types.WebhookEventSubscriptionPastDue,
+	}, s.webhookEventNames())
+}
+
+func (s *DunningServiceTestSuite) TestFinalActions() {
+	tests := []struct {
+		name           string
+		action         types.DunningFinalAction
+		expectedStatus types.SubscriptionStatus
+	}{
+		{
+			name:           "cancel",
+			action:         types.DunningFinalActionCancel,
+			expectedStatus: types.SubscriptionStatusCancelled,
+		},
+		{
+			name:           "pause",
+			action:         types.DunningFinalActionPause,
+			expectedStatus: types.SubscriptionStatusPaused,
+		},
+		{
+			name:           "mark unpaid",
+			action:         types.DunningFinalActionMarkUnpaid,
+			expectedStatus: types.SubscriptionStatusUnpaid,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			s.setPolicy(func(policy *tenant.TenantDunningPolicy) {
+				policy.FinalAction = tt.action
+			})
+			inv := s.createInvoice("inv_final_"+string(tt.action), s.GetNow().AddDate(0, 0, -4))
+
+			// The first run makes the first retry and the second run the last one
+			_, err := s.service.ProcessDunning(s.GetContext())
+			s.NoError(err)
+			s.Equal(types.SubscriptionStatusPastDue, s.getSubscription().SubscriptionStatus)
+
+			resp, err := s.service.ProcessDunning(s.GetContext())
+			s.NoError(err)
+			s.Require().Len(resp.Items, 1)
+			s.Equal([]string{dunningActionRetry, string(tt.action)}, resp.Items[0].Actions)
+
+			sub := s.getSubscription()
+			s.Equal(tt.expectedStatus, sub.SubscriptionStatus)
+			if tt.action == types.DunningFinalActionCancel {
+				s.NotNil(sub.CancelledAt)
+			}
+			if tt.action == types.DunningFinalActionPause {
+				s.Equal(types.PauseStatusActive, sub.PauseStatus)
+				s.NotNil(sub.ActivePauseID)
+			}
+
+			attempts, err := s.service.ListInvoiceAttempts(s.GetContext(), inv.ID)
+			s.NoError(err)
+			s.Len(attempts.Items, 2)
+			s.Contains(s.webhookEventNames(), types.WebhookEventSubscriptionDunningExhausted)
+
+			// Nothing more happens once the retries are exhausted
+			resp, err = s.service.ProcessDunning(s.GetContext())
+			s.NoError(err)
+			s.Empty(resp.Items)
+		})
+	}
+}
+
+func (s *DunningServiceTestSuite) TestPastDueSubscriptionRecoversWhenPaid() {
+	inv := s.createInvoice("inv_recover", s.GetNow().AddDate(0, 0, -2).Add(-time.Hour))
+
+	_, err := s.service.ProcessDunning(s.GetContext())
+	s.NoError(err)
+	s.Equal(types.SubscriptionStatusPastDue, s.getSubscription().SubscriptionStatus)
+
+	// The customer pays the invoice outside of the dunning process
+	inv, err = s.GetStores().InvoiceRepo.Get(s.GetContext(), inv.ID)
+	s.NoError(err)
+	inv.PaymentStatus = types.PaymentStatusSucceeded
+	inv.AmountPaid = inv.AmountDue
+	inv.AmountRemaining = decimal.Zero
+	s.NoError(s.GetStores().InvoiceRepo.Update(s.GetContext(), inv))
+
+	_, err = s.service.ProcessDunning(s.GetContext())
+	s.NoError(err)
+	s.Equal(types.SubscriptionStatusActive, s.getSubscription().SubscriptionStatus)
+	s.Contains(s.webhookEventNames(), types.WebhookEventSubscriptionRecovered)
+}
+
+func (s *DunningServiceTestSuite) TestWebhookPayloadReferencesInvoice() {
+	inv := s.createInvoice("inv_webhook", s.GetNow().AddDate(0, 0, -1).Add(-time.Hour))
+
+	_, err := s.service.ProcessDunning(s.GetContext())
+	s.NoError(err)
+
+	events := s.GetWebhookEvents()
+	s.Require().Len(events, 1)
+
+	var payload struct {
+		InvoiceID string `json:"invoice_id"`
+	}
+	s.NoError(json.Unmarshal(events[0].Payload, &payload))
+	s.Equal(inv.ID, payload.InvoiceID)
+}
@@ -111,6 +111,7 @@ func (s *eventService) GetUsageByMeter(ctx context.Context, req *dto.GetUsageByM
 		WindowSize:         req.WindowSize,
 		EndTime:            req.EndTime,
 		Filters:            req.Filters,
+		FilterOperators:    m.GetFilterOperators(),
 	}
 
 	usage, err := s.GetUsage(ctx, &getUsageRequest)
@@ -118,12 +119,19 @@ func (s *eventService) GetUsageByMeter(ctx context.Context, req *dto.GetUsageByM
 		return nil, err
 	}
 
-	if m.ResetUsage == types.ResetUsageNever {
+	// time weighted usage is always bounded by the requested period so there is nothing to carry over
+	if m.ResetUsage == types.ResetUsageNever && !m.Aggregation.Type.RequiresTimeRange() {
 		getHistoricUsageRequest := getUsageRequest
 		getHistoricUsageRequest.StartTime = time.Time{}
 		getHistoricUsageRequest.EndTime = req.StartTime
 		getHistoricUsageRequest.WindowSize = ""
 
+		// an empty period cannot be told apart from a zero value, so gauges are aggregated over
+		// the whole history in one query instead of being combined
+		if isGaugeAggregation(m.Aggregation.Type) {
+			getHistoricUsageRequest.EndTime = req.EndTime
+		}
+
 		historicUsage, err := s.GetUsage(ctx, &getHistoricUsageRequest)
 		if err != nil {
 			return nil, err
@@ -173,6 +181,7 @@ func (s *eventService) GetUsageByMeterWithFilters(ctx context.Context, req *dto.
 			StartTime:          req.StartTime,
 			EndTime:            req.EndTime,
 			Filters:            meterFilters,
+			FilterOperators:    m.GetFilterOperators(),
 		},
 		FilterGroups: prioritizedGroups,
 	}
@@ -192,15 +201,23 @@ func (s *eventService) GetUsageByMeterWithFilters(ctx context.Context, req *dto.
 	return results, nil
 }
 
+// combineResults adds the historic usage to the usage of the period. For gauges the historic
+// usage already covers the period and is used as is.
 func (s *eventService) combineResults(historicUsage, currentUsage *events.AggregationResult, m *meter.Meter) *events.AggregationResult {
 	var totalValue decimal.Decimal
 
-	if historicUsage != nil {
-		totalValue = totalValue.Add(historicUsage.Value)
-	}
+	if isGaugeAggregation(m.Aggregation.Type) {
+		if historicUsage != nil {
+			totalValue = historicUsage.Value
+		}
+	} else {
+		if historicUsage != nil {
+			totalValue = totalValue.Add(historicUsage.Value)
+		}
 
-	if currentUsage != nil {
-		totalValue = totalValue.Add(currentUsage.Value)
+		if currentUsage != nil {
+			totalValue = totalValue.Add(currentUsage.Value)
+		}
 	}
 
 	return &events.AggregationResult{
@@ -211,6 +228,16 @@ func (s *eventService) combineResults(historicUsage, currentUsage *events.Aggreg
 	}
 }
 
+// isGaugeAggregation returns true for the aggregations that pick a value instead of
+// accumulating them, so the usage of two periods cannot be added
+func isGaugeAggregation(aggregationType types.AggregationType) bool {
+	switch aggregationType {
+	case types.AggregationMax, types.AggregationMin, types.AggregationLatest:
+		return true
+	}
+	return false
+}
+
 func (s *eventService) GetEvents(ctx context.Context, req *dto.GetEventsRequest) (*dto.GetEventsResponse, error) {
 	if req.PageSize <= 0 || req.PageSize > 50 {
 		req.PageSize = 50
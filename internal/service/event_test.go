@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -241,6 +242,61 @@ func (s *EventServiceSuite) TestGetUsage() {
 			expectedValue: decimal.NewFromFloat(1), // Only one event in us-west-2
 			expectedError: false,
 		},
+		{
+			name: "max_duration",
+			request: &dto.GetUsageRequest{
+				ExternalCustomerID: "cust-1",
+				EventName:          "api_request",
+				PropertyName:       "duration_ms",
+				AggregationType:    types.AggregationMax,
+				StartTime:          time.Now().Add(-2 * time.Hour),
+				EndTime:            time.Now(),
+			},
+			expectedValue: decimal.NewFromFloat(200),
+			expectedError: false,
+		},
+		{
+			name: "min_duration_with_region_filter",
+			request: &dto.GetUsageRequest{
+				ExternalCustomerID: "cust-1",
+				EventName:          "api_request",
+				PropertyName:       "duration_ms",
+				AggregationType:    types.AggregationMin,
+				StartTime:          time.Now().Add(-2 * time.Hour),
+				EndTime:            time.Now(),
+				Filters: map[string][]string{
+					"region": {"us-east-1"},
+				},
+			},
+			expectedValue: decimal.NewFromFloat(100),
+			expectedError: false,
+		},
+		{
+			name: "latest_duration_with_region_filter",
+			request: &dto.GetUsageRequest{
+				ExternalCustomerID: "cust-1",
+				EventName:          "api_request",
+				PropertyName:       "duration_ms",
+				AggregationType:    types.AggregationLatest,
+				StartTime:          time.Now().Add(-2 * time.Hour),
+				EndTime:            time.Now(),
+				Filters: map[string][]string{
+					"region": {"us-east-1"},
+				},
+			},
+			expectedValue: decimal.NewFromFloat(150), // evt-2 is the most recent us-east-1 event
+			expectedError: false,
+		},
+		{
+			name: "weighted_sum_without_time_range",
+			request: &dto.GetUsageRequest{
+				ExternalCustomerID: "cust-1",
+				EventName:          "api_request",
+				PropertyName:       "duration_ms",
+				AggregationType:    types.AggregationWeightedSum,
+			},
+			expectedError: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -367,6 +423,295 @@ func (s *EventServiceSuite) TestGetUsageByMeter() {
 	s.Equal(types.AggregationSum, result.Type)
 }
 
+// TestGetUsageByMeterGaugeWithHistoricUsage checks that zero and negative values are carried
+// over from the history of the meters that never reset
+func (s *EventServiceSuite) TestGetUsageByMeterGaugeWithHistoricUsage() {
+	periodStart := time.Now().Add(-1 * time.Hour)
+
+	testCases := []struct {
+		name            string
+		aggregationType types.AggregationType
+		historic        []float64
+		period          []float64
+		expectedValue   float64
+	}{
+		{
+			name:            "max_of_negative_history_without_usage_in_period",
+			aggregationType: types.AggregationMax,
+			historic:        []float64{-20, -12},
+			expectedValue:   -12,
+		},
+		{
+			name:            "max_of_negative_values",
+			aggregationType: types.AggregationMax,
+			historic:        []float64{-20},
+			period:          []float64{-8, -5},
+			expectedValue:   -5,
+		},
+		{
+			name:            "min_keeps_the_historic_zero",
+			aggregationType: types.AggregationMin,
+			historic:        []float64{3, 0},
+			period:          []float64{5},
+			expectedValue:   0,
+		},
+		{
+			name:            "min_of_negative_values",
+			aggregationType: types.AggregationMin,
+			historic:        []float64{-3},
+			period:          []float64{0, -7},
+			expectedValue:   -7,
+		},
+		{
+			name:            "latest_zero_in_period",
+			aggregationType: types.AggregationLatest,
+			historic:        []float64{4},
+			period:          []float64{-2, 0},
+			expectedValue:   0,
+		},
+		{
+			name:            "latest_negative_history_without_usage_in_period",
+			aggregationType: types.AggregationLatest,
+			historic:        []float64{4, -6},
+			expectedValue:   -6,
+		},
+	}
+
+	for i, tc := range testCases {
+		s.Run(tc.name, func() {
+			eventName := fmt.Sprintf("gauge_%d", i)
+
+			// historic readings are an hour apart before the period, the period readings ten minutes apart
+			insert := func(prefix string, values []float64, timestampAt func(j int) time.Time) {
+				for j, value := range values {
+					event := events.NewEvent(
+						eventName,
+						types.GetTenantID(s.ctx),
+						"cust-1",
+						map[string]interface{}{"reading": value},
+						timestampAt(j),
+						fmt.Sprintf("%s-%s-%d", eventName, prefix, j),
+						"",
+						"",
+					)
+					s.NoError(s.eventRepo.InsertEvent(s.ctx, event))
+				}
+			}
+			insert("historic", tc.historic, func(j int) time.Time {
+				return periodStart.Add(-time.Duration(len(tc.historic)-j) * time.Hour)
+			})
+			insert("period", tc.period, func(j int) time.Time {
+				return periodStart.Add(time.Duration(j+1) * 10 * time.Minute)
+			})
+
+			testMeter := &meter.Meter{
+				ID:        "meter-" + eventName,
+				Name:      "Gauge",
+				EventName: eventName,
+				Aggregation: meter.Aggregation{
+					Type:  tc.aggregationType,
+					Field: "reading",
+				},
+				ResetUsage: types.ResetUsageNever,
+				BaseModel: types.BaseModel{
+					TenantID: types.GetTenantID(s.ctx),
+				},
+			}
+
+			mockedMeterRepo := testutil.NewInMemoryMeterStore()
+			s.NoError(mockedMeterRepo.CreateMeter(s.ctx, testMeter))
+			s.service = NewEventService(s.eventRepo, mockedMeterRepo, s.publisher, s.logger)
+
+			result, err := s.service.GetUsageByMeter(s.ctx, &dto.GetUsageByMeterRequest{
+				MeterID:            testMeter.ID,
+				ExternalCustomerID: "cust-1",
+				StartTime:          periodStart,
+				EndTime:            time.Now(),
+			})
+			s.NoError(err)
+			s.Equal(tc.expectedValue, result.Value.InexactFloat64())
+		})
+	}
+}
+
+func (s *EventServiceSuite) TestGetUsageByMeterWithNestedFilters() {
+	testMeter := &meter.Meter{
+		ID:        "meter-nested",
+		Name:      "Tokens",
+		EventName: "llm_request",
+		Aggregation: meter.Aggregation{
+			Type:  types.AggregationSum,
+			Field: "tokens",
+		},
+		Filters: []meter.Filter{
+			{
+				Key:      "request.model.name",
+				Operator: types.FilterOperatorPrefix,
+				Values:   []string{"gpt-4"},
+			},
+			{
+				Key:      "tokens",
+				Operator: types.FilterOperatorGreaterThanOrEqual,
+				Values:   []string{"0"},
+			},
+		},
+		ResetUsage: types.ResetUsageBillingPeriod,
+		BaseModel: types.BaseModel{
+			TenantID: types.GetTenantID(s.ctx),
+		},
+	}
+	s.NoError(testMeter.Validate())
+
+	mockedMeterRepo := testutil.NewInMemoryMeterStore()
+	s.NoError(mockedMeterRepo.CreateMeter(s.ctx, testMeter))
+	s.service = NewEventService(s.eventRepo, mockedMeterRepo, s.publisher, s.logger)
+
+	requests := []struct {
+		id     string
+		model  string
+		tokens float64
+	}{
+		{id: "llm-1", model: "gpt-4o", tokens: 500},
+		{id: "llm-2", model: "gpt-4o-mini", tokens: 2000},
+		{id: "llm-3", model: "claude", tokens: 700}, // excluded by the prefix filter
+	}
+	for _, r := range requests {
+		event := events.NewEvent(
+			"llm_request",
+			types.GetTenantID(s.ctx),
+			"cust-1",
+			map[string]interface{}{
+				"tokens": r.tokens,
+				"request": map[string]interface{}{
+					"model": map[string]interface{}{"name": r.model},
+				},
+			},
+			time.Now().Add(-30*time.Minute),
+			r.id,
+			"",
+			"",
+		)
+		s.NoError(s.eventRepo.InsertEvent(s.ctx, event))
+	}
+
+	// the price filter values on "tokens" reuse the meter operator (>=)
+	results, err := s.service.GetUsageByMeterWithFilters(s.ctx, &dto.GetUsageByMeterRequest{
+		MeterID:            testMeter.ID,
+		ExternalCustomerID: "cust-1",
+		StartTime:          time.Now().Add(-1 * time.Hour),
+		EndTime:            time.Now(),
+	}, map[string]map[string][]string{
+		"price-large": {"tokens": {"1000"}},
+	})
+	s.NoError(err)
+	s.Len(results, 1)
+	s.Equal(decimal.NewFromFloat(2000).InexactFloat64(), results[0].Value.InexactFloat64())
+}
+
+func (s *EventServiceSuite) TestGetUsageWeightedSum() {
+	periodStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.Add(10 * time.Hour)
+
+	// 10 GB for the first 5 hours, then 20 GB for the remaining 5 hours
+	snapshots := []struct {
+		id        string
+		timestamp time.Time
+		value     float64
+	}{
+		{id: "snap-1", timestamp: periodStart, value: 10},
+		{id: "snap-2", timestamp: periodStart.Add(5 * time.Hour), value: 20},
+	}
+
+	for _, snap := range snapshots {
+		event := events.NewEvent(
+			"storage_snapshot",
+			types.GetTenantID(s.ctx),
+			"cust-1",
+			map[string]interface{}{"storage_gb": snap.value},
+			snap.timestamp,
+			snap.id,
+			"",
+			"",
+		)
+		s.NoError(s.eventRepo.InsertEvent(s.ctx, event))
+	}
+
+	result, err := s.service.GetUsage(s.ctx, &dto.GetUsageRequest{
+		ExternalCustomerID: "cust-1",
+		EventName:          "storage_snapshot",
+		PropertyName:       "storage_gb",
+		AggregationType:    types.AggregationWeightedSum,
+		StartTime:          periodStart,
+		EndTime:            periodEnd,
+	})
+	s.NoError(err)
+	s.Equal(decimal.NewFromFloat(15).InexactFloat64(), result.Value.InexactFloat64())
+}
+
+func (s *EventServiceSuite) TestGetUsageWeightedSumCarriesInPriorValue() {
+	periodStart := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.Add(10 * time.Hour)
+
+	testCases := []struct {
+		name          string
+		customerID    string
+		snapshots     map[time.Time]float64
+		expectedValue float64
+	}{
+		{
+			// storage unchanged through the whole period is billed at the prior value
+			name:       "no_events_in_period",
+			customerID: "cust-unchanged",
+			snapshots: map[time.Time]float64{
+				periodStart.Add(-48 * time.Hour): 5,
+				periodStart.Add(-24 * time.Hour): 8,
+			},
+			expectedValue: 8,
+		},
+		{
+			// 8 GB carried in for the first 2 hours, then 18 GB for the remaining 8 hours
+			name:       "prior_value_until_the_first_event",
+			customerID: "cust-changed",
+			snapshots: map[time.Time]float64{
+				periodStart.Add(-24 * time.Hour): 8,
+				periodStart.Add(2 * time.Hour):   18,
+			},
+			expectedValue: 16,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			i := 0
+			for timestamp, value := range tc.snapshots {
+				event := events.NewEvent(
+					"storage_snapshot",
+					types.GetTenantID(s.ctx),
+					tc.customerID,
+					map[string]interface{}{"storage_gb": value},
+					timestamp,
+					fmt.Sprintf("%s-%d", tc.customerID, i),
+					"",
+					"",
+				)
+				s.NoError(s.eventRepo.InsertEvent(s.ctx, event))
+				i++
+			}
+
+			result, err := s.service.GetUsage(s.ctx, &dto.GetUsageRequest{
+				ExternalCustomerID: tc.customerID,
+				EventName:          "storage_snapshot",
+				PropertyName:       "storage_gb",
+				AggregationType:    types.AggregationWeightedSum,
+				StartTime:          periodStart,
+				EndTime:            periodEnd,
+			})
+			s.NoError(err)
+			s.Equal(tc.expectedValue, result.Value.InexactFloat64())
+		})
+	}
+}
+
 func (s *EventServiceSuite) TestGetEvents() {
 	now := time.Now()
 	// Setup test data
@@ -1,9 +1,18 @@
 package service
 
 import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/alert"
 	"github.com/flexprice/flexprice/internal/domain/auth"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/creditnote"
 	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/dunning"
 	"github.com/flexprice/flexprice/internal/domain/entitlement"
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
@@ -15,14 +24,19 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/domain/tax"
 	"github.com/flexprice/flexprice/internal/domain/tenant"
 	"github.com/flexprice/flexprice/internal/domain/user"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
+	"github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/pdf"
 	"github.com/flexprice/flexprice/internal/postgres"
 	"github.com/flexprice/flexprice/internal/publisher"
+	"github.com/flexprice/flexprice/internal/types"
 	webhookPublisher "github.com/flexprice/flexprice/internal/webhook/publisher"
+	"github.com/google/uuid"
 )
 
 // ServiceParams holds common dependencies for services
@@ -34,22 +48,29 @@ type ServiceParams struct {
 	PDFGenerator pdf.Generator
 
 	// Repositories
-	AuthRepo        auth.Repository
-	UserRepo        user.Repository
-	EventRepo       events.Repository
-	MeterRepo       meter.Repository
-	PriceRepo       price.Repository
-	CustomerRepo    customer.Repository
-	PlanRepo        plan.Repository
-	SubRepo         subscription.Repository
-	WalletRepo      wallet.Repository
-	TenantRepo      tenant.Repository
-	InvoiceRepo     invoice.Repository
-	FeatureRepo     feature.Repository
-	EntitlementRepo entitlement.Repository
-	PaymentRepo     payment.Repository
-	SecretRepo      secret.Repository
-	EnvironmentRepo environment.Repository
+	AuthRepo            auth.Repository
+	UserRepo            user.Repository
+	EventRepo           events.Repository
+	MeterRepo           meter.Repository
+	PriceRepo           price.Repository
+	CustomerRepo        customer.Repository
+	PlanRepo            plan.Repository
+	SubRepo             subscription.Repository
+	WalletRepo          wallet.Repository
+	TenantRepo          tenant.Repository
+	InvoiceRepo         invoice.Repository
+	FeatureRepo         feature.Repository
+	EntitlementRepo     entitlement.Repository
+	PaymentRepo         payment.Repository
+	SecretRepo          secret.Repository
+	EnvironmentRepo     environment.Repository
+	CouponRepo          coupon.Repository
+	TaxRateRepo         tax.Repository
+	CreditNoteRepo      creditnote.Repository
+	DunningRepo         dunning.Repository
+	AlertRepo           alert.Repository
+	WebhookEndpointRepo webhookendpoint.Repository
+	WebhookDeliveryRepo webhookdelivery.Repository
 
 	// Publishers
 	EventPublisher   publisher.EventPublisher
@@ -78,31 +99,94 @@ func NewServiceParams(
 	paymentRepo payment.Repository,
 	secretRepo secret.Repository,
 	environmentRepo environment.Repository,
+	couponRepo coupon.Repository,
+	taxRateRepo tax.Repository,
+	creditNoteRepo creditnote.Repository,
+	dunningRepo dunning.Repository,
+	alertRepo alert.Repository,
+	webhookEndpointRepo webhookendpoint.Repository,
+	webhookDeliveryRepo webhookdelivery.Repository,
 	eventPublisher publisher.EventPublisher,
 	webhookPublisher webhookPublisher.WebhookPublisher,
 ) ServiceParams {
 	return ServiceParams{
-		Logger:           logger,
-		Config:           config,
-		DB:               db,
-		PDFGenerator:     pdfGenerator,
-		AuthRepo:         authRepo,
-		UserRepo:         userRepo,
-		EventRepo:        eventRepo,
-		MeterRepo:        meterRepo,
-		PriceRepo:        priceRepo,
-		CustomerRepo:     customerRepo,
-		PlanRepo:         planRepo,
-		SubRepo:          subRepo,
-		WalletRepo:       walletRepo,
-		TenantRepo:       tenantRepo,
-		InvoiceRepo:      invoiceRepo,
-		FeatureRepo:      featureRepo,
-		EntitlementRepo:  entitlementRepo,
-		PaymentRepo:      paymentRepo,
-		SecretRepo:       secretRepo,
-		EnvironmentRepo:  environmentRepo,
-		EventPublisher:   eventPublisher,
-		WebhookPublisher: webhookPublisher,
+		Logger:              logger,
+		Config:              config,
+		DB:                  db,
+		PDFGenerator:        pdfGenerator,
+		AuthRepo:            authRepo,
+		UserRepo:            userRepo,
+		EventRepo:           eventRepo,
+		MeterRepo:           meterRepo,
+		PriceRepo:           priceRepo,
+		CustomerRepo:        customerRepo,
+		PlanRepo:            planRepo,
+		SubRepo:             subRepo,
+		WalletRepo:          walletRepo,
+		TenantRepo:          tenantRepo,
+		InvoiceRepo:         invoiceRepo,
+		FeatureRepo:         featureRepo,
+		EntitlementRepo:     entitlementRepo,
+		PaymentRepo:         paymentRepo,
+		SecretRepo:          secretRepo,
+		EnvironmentRepo:     environmentRepo,
+		CouponRepo:          couponRepo,
+		TaxRateRepo:         taxRateRepo,
+		CreditNoteRepo:      creditNoteRepo,
+		DunningRepo:         dunningRepo,
+		AlertRepo:           alertRepo,
+		WebhookEndpointRepo: webhookEndpointRepo,
+		WebhookDeliveryRepo: webhookDeliveryRepo,
+		EventPublisher:      eventPublisher,
+		WebhookPublisher:    webhookPublisher,
+	}
+}
+
+// publishWebhookEvent publishes the webhook event of the tenant and environment of ctx along with
+// the transaction of ctx, so that the event is only delivered if the state change it reports is
+// committed. With the outbox the event is written in the transaction and relayed once it commits,
+// without it the event is published after the commit. A failure to publish is logged and never
+// fails the operation reporting the event.
+func (p ServiceParams) publishWebhookEvent(ctx context.Context, eventName string, payload types.WebhookEventPayload) {
+	payload.TenantID = types.GetTenantID(ctx)
+	webhookPayload, err := json.Marshal(payload)
+	if err != nil {
+		p.Logger.Errorw("failed to marshal webhook payload", "error", err)
+		return
+	}
+
+	webhookEvent := &types.WebhookEvent{
+		ID:            uuid.New().String(),
+		EventName:     eventName,
+		TenantID:      types.GetTenantID(ctx),
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		Timestamp:     time.Now().UTC(),
+		Payload:       json.RawMessage(webhookPayload),
+	}
+
+	tx := p.DB.TxFromContext(ctx)
+	if tx == nil || p.Config.Outbox.Enabled {
+		p.publish(ctx, webhookEvent)
+		return
+	}
+
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(txCtx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(txCtx, tx); err != nil {
+				return err
+			}
+			p.publish(ctx, webhookEvent)
+			return nil
+		})
+	})
+}
+
+func (p ServiceParams) publish(ctx context.Context, webhookEvent *types.WebhookEvent) {
+	if err := p.WebhookPublisher.PublishWebhook(ctx, webhookEvent); err != nil {
+		p.Logger.Errorw("failed to publish webhook event",
+			"error", err,
+			"event_id", webhookEvent.ID,
+			"event", webhookEvent.EventName,
+		)
 	}
 }
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/flexprice/flexprice/ent/migrate"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/pubsub/outbox"
+	entRepo "github.com/flexprice/flexprice/internal/repository/ent"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	webhookPublisher "github.com/flexprice/flexprice/internal/webhook/publisher"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceParamsSuite struct {
+	testutil.BaseServiceTestSuite
+	db     postgres.IClient
+	params ServiceParams
+}
+
+func TestServiceParams(t *testing.T) {
+	suite.Run(t, new(ServiceParamsSuite))
+}
+
+func (s *ServiceParamsSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.db = testutil.NewSQLiteClient(s.T(), s.GetLogger(), migrate.OutboxEventsTable)
+	s.params = ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           &config.Configuration{},
+		DB:               s.db,
+		WebhookPublisher: s.GetWebhookPublisher(),
+	}
+}
+
+func (s *ServiceParamsSuite) TestPublishWebhookEventWithoutTransaction() {
+	s.params.publishWebhookEvent(s.GetContext(), types.WebhookEventInvoiceUpdateFinalized, types.WebhookEventPayload{
+		InvoiceID: "inv_1",
+	})
+
+	events := s.GetWebhookEvents()
+	s.Require().Len(events, 1)
+	s.Equal(types.WebhookEventInvoiceUpdateFinalized, events[0].EventName)
+	s.Equal(types.GetTenantID(s.GetContext()), events[0].TenantID)
+
+	var payload types.WebhookEventPayload
+	s.NoError(json.Unmarshal(events[0].Payload, &payload))
+	s.Equal("inv_1", payload.InvoiceID)
+	s.Equal(types.GetTenantID(s.GetContext()), payload.TenantID)
+}
+
+func (s *ServiceParamsSuite) TestPublishWebhookEventAfterCommit() {
+	err := s.db.WithTx(s.GetContext(), func(tx context.Context) error {
+		s.params.publishWebhookEvent(tx, types.WebhookEventInvoiceUpdateFinalized, types.WebhookEventPayload{
+			InvoiceID: "inv_1",
+		})
+		s.Empty(s.GetWebhookEvents())
+		return nil
+	})
+	s.NoError(err)
+	s.Len(s.GetWebhookEvents(), 1)
+}
+
+func (s *ServiceParamsSuite) TestPublishWebhookEventRolledBack() {
+	err := s.db.WithTx(s.GetContext(), func(tx context.Context) error {
+		s.params.publishWebhookEvent(tx, types.WebhookEventInvoiceUpdateFinalized, types.WebhookEventPayload{
+			InvoiceID: "inv_1",
+		})
+		return errors.New("state change failed")
+	})
+	s.Error(err)
+	s.Empty(s.GetWebhookEvents())
+}
+
+func (s *ServiceParamsSuite) TestPublishWebhookEventToOutbox() {
+	repo := entRepo.NewOutboxRepository(s.db, s.GetLogger())
+	cfg := &config.Configuration{Webhook: config.Webhook{Topic: "webhooks"}}
+	publisher, err := webhookPublisher.NewPublisher(outbox.NewPublisher(repo, s.GetLogger()), cfg, s.GetLogger())
+	s.Require().NoError(err)
+	s.params.WebhookPublisher = publisher
+	s.params.Config.Outbox.Enabled = true
+
+	publishInTx := func(invoiceID string, txErr error) {
+		_ = s.db.WithTx(s.GetContext(), func(tx context.Context) error {
+			s.params.publishWebhookEvent(tx, types.WebhookEventInvoiceUpdateFinalized, types.WebhookEventPayload{
+				InvoiceID: invoiceID,
+			})
+			return txErr
+		})
+	}
+	publishInTx("inv_1", nil)
+	publishInTx("inv_2", errors.New("state change failed"))
+
+	events, err := s.db.Querier(s.GetContext()).OutboxEvent.Query().All(s.GetContext())
+	s.NoError(err)
+	s.Require().Len(events, 1)
+
+	var event types.WebhookEvent
+	s.NoError(json.Unmarshal([]byte(events[0].Payload), &event))
+	var payload types.WebhookEventPayload
+	s.NoError(json.Unmarshal(event.Payload, &payload))
+	s.Equal("inv_1", payload.InvoiceID)
+
+	// The relay publishes the event, not the service
+	s.Empty(s.GetWebhookEvents())
+}
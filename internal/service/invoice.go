@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,11 +11,11 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/customer"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	pdf "github.com/flexprice/flexprice/internal/domain/pdf"
+	"github.com/flexprice/flexprice/internal/domain/tax"
 	"github.com/flexprice/flexprice/internal/domain/tenant"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/idempotency"
 	"github.com/flexprice/flexprice/internal/types"
-	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
@@ -129,6 +128,11 @@ func (s *invoiceService) CreateInvoice(ctx context.Context, req dto.CreateInvoic
 			return err
 		}
 
+		// Levy the taxes of the customer's jurisdiction on the discounted subtotal
+		if err := s.applyTaxes(ctx, inv); err != nil {
+			return err
+		}
+
 		inv.InvoiceNumber = &invoiceNumber
 		inv.IdempotencyKey = &idempKey
 		inv.BillingSequence = billingSeq
@@ -171,8 +175,21 @@ func (s *invoiceService) CreateInvoice(ctx context.Context, req dto.CreateInvoic
 			return err
 		}
 
+		// Count the billing period against the coupon applications that discounted the invoice
+		if err := s.markDiscountsApplied(tx, inv); err != nil {
+			return err
+		}
+
 		// Convert to response
 		resp = dto.NewInvoiceResponse(inv)
+
+		eventName := types.WebhookEventInvoiceCreateDraft
+		if resp.InvoiceStatus == types.InvoiceStatusFinalized {
+			eventName = types.WebhookEventInvoiceUpdateFinalized
+		}
+
+		// Published in the transaction so that the event is only delivered if the invoice is created
+		s.publishWebhookEvent(tx, eventName, types.WebhookEventPayload{InvoiceID: resp.ID})
 		return nil
 	})
 
@@ -184,13 +201,112 @@ func (s *invoiceService) CreateInvoice(ctx context.Context, req dto.CreateInvoic
 		return nil, err
 	}
 
-	eventName := types.WebhookEventInvoiceCreateDraft
-	if resp.InvoiceStatus == types.InvoiceStatusFinalized {
-		eventName = types.WebhookEventInvoiceUpdateFinalized
+	return resp, nil
+}
+
+// applyTaxes computes the tax lines of the invoice from the tax rates of the customer's
+// jurisdiction. Exclusive taxes are added to the amount due while inclusive taxes are
+// extracted from it, so only exclusive taxes change the invoice total.
+func (s *invoiceService) applyTaxes(ctx context.Context, inv *invoice.Invoice) error {
+	if s.TaxRateRepo == nil || len(inv.Taxes) > 0 || !isTaxableInvoice(inv) {
+		return nil
 	}
 
-	s.publishWebhookEvent(ctx, eventName, resp.ID)
-	return resp, nil
+	cust, err := s.CustomerRepo.Get(ctx, inv.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	rates, err := getApplicableTaxRates(ctx, s.TaxRateRepo, cust)
+	if err != nil {
+		return err
+	}
+
+	lines := tax.CalculateTaxes(rates, inv.AmountDue, inv.Currency)
+	if len(lines) == 0 {
+		return nil
+	}
+
+	totalTax := decimal.Zero
+	exclusiveTax := decimal.Zero
+	inv.Taxes = make([]invoice.InvoiceTax, 0, len(lines))
+	for _, line := range lines {
+		inv.Taxes = append(inv.Taxes, invoice.InvoiceTax{
+			TaxRateID:     line.TaxRate.ID,
+			Name:          line.TaxRate.Name,
+			Percentage:    line.TaxRate.Percentage,
+			TaxBehavior:   string(line.TaxRate.TaxBehavior),
+			TaxableAmount: line.TaxableAmount,
+			Amount:        line.Amount,
+		})
+
+		totalTax = totalTax.Add(line.Amount)
+		if line.TaxRate.TaxBehavior == types.TaxBehaviorExclusive {
+			exclusiveTax = exclusiveTax.Add(line.Amount)
+		}
+	}
+
+	inv.TotalTax = totalTax
+	inv.AmountDue = inv.AmountDue.Add(exclusiveTax)
+	inv.Total = inv.AmountDue
+
+	return nil
+}
+
+// isTaxableInvoice returns false for invoices that do not sell anything taxable: credit
+// invoices, wallet auto top-ups which buy prepaid credits, and plan change invoices whose
+// prorated charges were taxed on the invoices of the periods they adjust
+func isTaxableInvoice(inv *invoice.Invoice) bool {
+	if inv.InvoiceType == types.InvoiceTypeCredit {
+		return false
+	}
+	if inv.BillingReason == string(types.InvoiceBillingReasonSubscriptionUpdate) {
+		return false
+	}
+	return !isAutoTopup(inv.Metadata)
+}
+
+// markDiscountsApplied increments the periods applied of the coupon applications behind
+// the discounts of the invoice, so once and repeating coupons stop after their last period
+func (s *invoiceService) markDiscountsApplied(ctx context.Context, inv *invoice.Invoice) error {
+	if s.CouponRepo == nil {
+		return nil
+	}
+
+	for _, d := range inv.Discounts {
+		if d.CouponApplicationID == "" {
+			continue
+		}
+
+		application, err := s.CouponRepo.GetApplication(ctx, d.CouponApplicationID)
+		if err != nil {
+			return err
+		}
+
+		c, err := s.CouponRepo.Get(ctx, application.CouponID)
+		if err != nil {
+			return err
+		}
+
+		// the period is counted with a conditional update so that concurrent invoices cannot
+		// both use the last period of the coupon
+		applied, err := s.CouponRepo.IncrementPeriodsApplied(ctx, application.ID, c.PeriodLimit())
+		if err != nil {
+			return err
+		}
+		if !applied {
+			return ierr.NewError("coupon application has no remaining periods").
+				WithHint("The coupon was used by another invoice of the period, please retry").
+				WithReportableDetails(map[string]any{
+					"invoice_id":            inv.ID,
+					"coupon_id":             c.ID,
+					"coupon_application_id": application.ID,
+				}).
+				Mark(ierr.ErrVersionConflict)
+		}
+	}
+
+	return nil
 }
 
 func (s *invoiceService) GetInvoice(ctx context.Context, id string) (*dto.InvoiceResponse, error) {
@@ -293,18 +409,94 @@ func (s *invoiceService) performFinalizeInvoiceActions(ctx context.Context, inv
 		return ierr.NewError("invoice is not in draft status").WithHint("invoice must be in draft status to be finalized").Mark(ierr.ErrValidation)
 	}
 
+	// Resolve the setting before finalizing so that the invoice is not finalized when it fails
+	autoApply := false
+	if inv.AmountRemaining.IsPositive() {
+		var err error
+		autoApply, err = s.shouldAutoApplyCredits(ctx, inv)
+		if err != nil {
+			return err
+		}
+	}
+
 	now := time.Now().UTC()
 	inv.InvoiceStatus = types.InvoiceStatusFinalized
 	inv.FinalizedAt = &now
 
-	if err := s.InvoiceRepo.Update(ctx, inv); err != nil {
+	if err := s.updateAndPublish(ctx, inv, types.WebhookEventInvoiceUpdateFinalized); err != nil {
 		return err
 	}
 
-	s.publishWebhookEvent(ctx, types.WebhookEventInvoiceUpdateFinalized, inv.ID)
+	if !autoApply {
+		return nil
+	}
+
+	// The invoice stays finalized when the credits cannot be applied, it is paid otherwise
+	if err := s.performAutoApplyCreditsActions(ctx, inv); err != nil {
+		s.Logger.Errorw("failed to apply credits to invoice",
+			"error", err.Error(),
+			"invoice_id", inv.ID)
+	}
+
 	return nil
 }
 
+// performAutoApplyCreditsActions pays the finalized invoice from the wallets of the customer, it
+// is used when auto apply credits is enabled for the subscription of the invoice or else for the
+// customer. Promotional credits are used before prepaid ones and the credits expiring first before
+// the others.
+func (s *invoiceService) performAutoApplyCreditsActions(ctx context.Context, inv *invoice.Invoice) error {
+	walletPaymentService := NewWalletPaymentService(s.ServiceParams)
+
+	options := DefaultWalletPaymentOptions()
+	options.ExpiringCreditsFirst = true
+	options.AdditionalMetadata = types.Metadata{
+		"payment_source": "auto_apply_credits",
+	}
+
+	amountPaid, err := walletPaymentService.ProcessInvoicePaymentWithWallets(ctx, inv, options)
+	if err != nil {
+		return err
+	}
+
+	if amountPaid.IsZero() {
+		return nil
+	}
+
+	s.Logger.Infow("applied credits to invoice",
+		"invoice_id", inv.ID,
+		"amount_applied", amountPaid,
+		"amount_remaining", inv.AmountRemaining.Sub(amountPaid))
+
+	// The payments update the invoice, keep the caller in sync with them
+	updated, err := s.InvoiceRepo.Get(ctx, inv.ID)
+	if err != nil {
+		return err
+	}
+	*inv = *updated
+
+	return nil
+}
+
+func (s *invoiceService) shouldAutoApplyCredits(ctx context.Context, inv *invoice.Invoice) (bool, error) {
+	if inv.SubscriptionID != nil {
+		sub, err := s.SubRepo.Get(ctx, *inv.SubscriptionID)
+		if err != nil {
+			return false, err
+		}
+		if sub.AutoApplyCredits != nil {
+			return *sub.AutoApplyCredits, nil
+		}
+	}
+
+	cust, err := s.CustomerRepo.Get(ctx, inv.CustomerID)
+	if err != nil {
+		return false, err
+	}
+
+	return cust.AutoApplyCredits, nil
+}
+
 func (s *invoiceService) VoidInvoice(ctx context.Context, id string) error {
 	inv, err := s.InvoiceRepo.Get(ctx, id)
 	if err != nil {
@@ -341,12 +533,7 @@ func (s *invoiceService) VoidInvoice(ctx context.Context, id string) error {
 	inv.InvoiceStatus = types.InvoiceStatusVoided
 	inv.VoidedAt = &now
 
-	if err := s.InvoiceRepo.Update(ctx, inv); err != nil {
-		return err
-	}
-
-	s.publishWebhookEvent(ctx, types.WebhookEventInvoiceUpdateVoided, inv.ID)
-	return nil
+	return s.updateAndPublish(ctx, inv, types.WebhookEventInvoiceUpdateVoided)
 }
 
 func (s *invoiceService) ProcessDraftInvoice(ctx context.Context, id string) error {
@@ -364,6 +551,11 @@ func (s *invoiceService) ProcessDraftInvoice(ctx context.Context, id string) err
 		return err
 	}
 
+	// nothing is left to pay once the credits are applied
+	if !inv.AmountRemaining.IsPositive() {
+		return nil
+	}
+
 	// try to process payment for the invoice and log any errors
 	// this is not a blocker for the invoice to be processed
 	if err := s.performPaymentAttemptActions(ctx, inv); err != nil {
@@ -431,12 +623,7 @@ func (s *invoiceService) UpdatePaymentStatus(ctx context.Context, id string, sta
 		return err
 	}
 
-	if err := s.InvoiceRepo.Update(ctx, inv); err != nil {
-		return err
-	}
-
-	s.publishWebhookEvent(ctx, types.WebhookEventInvoiceUpdatePayment, inv.ID)
-	return nil
+	return s.updateAndPublish(ctx, inv, types.WebhookEventInvoiceUpdatePayment)
 }
 
 func (s *invoiceService) CreateSubscriptionInvoice(ctx context.Context, req *dto.CreateSubscriptionInvoiceRequest) (*dto.InvoiceResponse, error) {
@@ -512,6 +699,10 @@ func (s *invoiceService) GetPreviewInvoice(ctx context.Context, req dto.GetPrevi
 		return nil, err
 	}
 
+	if err := s.applyTaxes(ctx, inv); err != nil {
+		return nil, err
+	}
+
 	// Create preview response
 	response := dto.NewInvoiceResponse(inv)
 
@@ -583,7 +774,7 @@ func (s *invoiceService) GetCustomerInvoiceSummary(ctx context.Context, customer
 
 		// Split charges by type
 		for _, item := range inv.LineItems {
-			if *item.PriceType == string(types.PRICE_TYPE_USAGE) {
+			if lo.FromPtr(item.PriceType) == string(types.PRICE_TYPE_USAGE) {
 				summary.UnpaidUsageCharges = summary.UnpaidUsageCharges.Add(item.Amount)
 			} else {
 				summary.UnpaidFixedCharges = summary.UnpaidFixedCharges.Add(item.Amount)
@@ -694,29 +885,17 @@ func (s *invoiceService) validatePaymentStatusTransition(from, to types.PaymentS
 		Mark(ierr.ErrValidation)
 }
 
-func (s *invoiceService) publishWebhookEvent(ctx context.Context, eventName string, invoiceID string) {
-	webhookPayload, err := json.Marshal(struct {
-		InvoiceID string `json:"invoice_id"`
-		TenantID  string `json:"tenant_id"`
-	}{
-		InvoiceID: invoiceID,
-		TenantID:  types.GetTenantID(ctx),
-	})
-	if err != nil {
-		s.Logger.Errorw("failed to marshal webhook payload", "error", err)
-		return
-	}
+// updateAndPublish updates the invoice and publishes the event in a single transaction, so that
+// the event is delivered if and only if the update is committed
+func (s *invoiceService) updateAndPublish(ctx context.Context, inv *invoice.Invoice, eventName string) error {
+	return s.DB.WithTx(ctx, func(tx context.Context) error {
+		if err := s.InvoiceRepo.Update(tx, inv); err != nil {
+			return err
+		}
 
-	webhookEvent := &types.WebhookEvent{
-		ID:        uuid.New().String(),
-		EventName: eventName,
-		TenantID:  types.GetTenantID(ctx),
-		Timestamp: time.Now().UTC(),
-		Payload:   json.RawMessage(webhookPayload),
-	}
-	if err := s.WebhookPublisher.PublishWebhook(ctx, webhookEvent); err != nil {
-		s.Logger.Errorf("failed to publish %s event: %v", webhookEvent.EventName, err)
-	}
+		s.publishWebhookEvent(tx, eventName, types.WebhookEventPayload{InvoiceID: inv.ID})
+		return nil
+	})
 }
 
 // AttemptPayment attempts to pay an invoice using available wallets
@@ -834,6 +1013,7 @@ func (s *invoiceService) getInvoiceDataForPDFGen(
 	}
 
 	amountDue, _ := inv.AmountDue.Float64()
+	subtotal, _ := inv.Subtotal.Float64()
 	// Convert to InvoiceData
 	data := &pdf.InvoiceData{
 		ID:            inv.ID,
@@ -841,11 +1021,12 @@ func (s *invoiceService) getInvoiceDataForPDFGen(
 		InvoiceStatus: string(inv.InvoiceStatus),
 		Currency:      types.GetCurrencySymbol(inv.Currency),
 		AmountDue:     amountDue,
+		Subtotal:      subtotal,
 		BillingReason: inv.BillingReason,
 		Notes:         "",  // resolved from invoice metadata
 		VAT:           0.0, // resolved from invoice metadata
-		Biller:        s.getBillerInfo(tenant),
-		Recipient:     s.getRecipientInfo(customer),
+		Biller:        getBillerInfo(tenant),
+		Recipient:     getRecipientInfo(customer),
 	}
 
 	// Convert dates
@@ -874,6 +1055,31 @@ func (s *invoiceService) getInvoiceDataForPDFGen(
 		}
 	}
 
+	// Invoices created before discounts were introduced have no subtotal
+	if inv.Subtotal.IsZero() {
+		data.Subtotal = amountDue
+	}
+
+	data.Discounts = make([]pdf.DiscountData, len(inv.Discounts))
+	for i, d := range inv.Discounts {
+		amount, _ := d.Amount.Float64()
+		data.Discounts[i] = pdf.DiscountData{
+			Description: d.Description,
+			Amount:      amount,
+		}
+	}
+
+	data.TotalTax = inv.TotalTax.InexactFloat64()
+	data.Taxes = make([]pdf.TaxData, len(inv.Taxes))
+	for i, t := range inv.Taxes {
+		data.Taxes[i] = pdf.TaxData{
+			Name:       t.Name,
+			Percentage: t.Percentage.InexactFloat64(),
+			Amount:     t.Amount.InexactFloat64(),
+			Inclusive:  t.TaxBehavior == string(types.TaxBehaviorInclusive),
+		}
+	}
+
 	data.LineItems = make([]pdf.LineItemData, len(inv.LineItems))
 
 	for i, item := range inv.LineItems {
@@ -912,13 +1118,27 @@ func (s *invoiceService) getInvoiceDataForPDFGen(
 			lineItem.PeriodEnd = pdf.CustomTime{Time: *item.PeriodEnd}
 		}
 
+		for _, tier := range item.TierBreakdown {
+			tierData := pdf.LineItemTierData{
+				Tier:       tier.Tier,
+				UpTo:       tier.UpTo,
+				Quantity:   tier.Quantity.InexactFloat64(),
+				UnitAmount: tier.UnitAmount.InexactFloat64(),
+				Amount:     tier.Amount.InexactFloat64(),
+			}
+			if tier.FlatAmount != nil {
+				tierData.FlatAmount = lo.ToPtr(tier.FlatAmount.InexactFloat64())
+			}
+			lineItem.Tiers = append(lineItem.Tiers, tierData)
+		}
+
 		data.LineItems[i] = lineItem
 	}
 
 	return data, nil
 }
 
-func (s *invoiceService) getRecipientInfo(c *customer.Customer) *pdf.RecipientInfo {
+func getRecipientInfo(c *customer.Customer) *pdf.RecipientInfo {
 	if c == nil {
 		return nil
 	}
@@ -963,7 +1183,7 @@ func (s *invoiceService) getRecipientInfo(c *customer.Customer) *pdf.RecipientIn
 	return result
 }
 
-func (s *invoiceService) getBillerInfo(t *tenant.Tenant) *pdf.BillerInfo {
+func getBillerInfo(t *tenant.Tenant) *pdf.BillerInfo {
 	if t == nil {
 		return nil
 	}
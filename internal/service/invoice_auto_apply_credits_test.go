@@ -0,0 +1,253 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type InvoiceAutoApplyCreditsTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service  InvoiceService
+	testData struct {
+		customer *customer.Customer
+		wallets  struct {
+			promotional     *wallet.Wallet
+			prepaidExpiring *wallet.Wallet
+			prepaid         *wallet.Wallet
+		}
+	}
+}
+
+func TestInvoiceAutoApplyCredits(t *testing.T) {
+	suite.Run(t, new(InvoiceAutoApplyCreditsTestSuite))
+}
+
+func (s *InvoiceAutoApplyCreditsTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	s.service = NewInvoiceService(ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		PlanRepo:         s.GetStores().PlanRepo,
+		PriceRepo:        s.GetStores().PriceRepo,
+		EventRepo:        s.GetStores().EventRepo,
+		MeterRepo:        s.GetStores().MeterRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		EntitlementRepo:  s.GetStores().EntitlementRepo,
+		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
+		FeatureRepo:      s.GetStores().FeatureRepo,
+		TenantRepo:       s.GetStores().TenantRepo,
+		UserRepo:         s.GetStores().UserRepo,
+		AuthRepo:         s.GetStores().AuthRepo,
+		WalletRepo:       s.GetStores().WalletRepo,
+		PaymentRepo:      s.GetStores().PaymentRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	})
+	s.setupTestData()
+}
+
+func (s *InvoiceAutoApplyCreditsTestSuite) setupTestData() {
+	ctx := s.GetContext()
+
+	s.testData.customer = &customer.Customer{
+		ID:               "cust_auto_apply",
+		ExternalID:       "ext_cust_auto_apply",
+		Name:             "Auto Apply Customer",
+		AutoApplyCredits: true,
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	// The prepaid wallet with the smaller balance holds credits that never expire, the one with
+	// the expiring credits is used first regardless
+	s.testData.wallets.promotional = s.createWallet("wallet_promotional", types.WalletTypePromotional, 30, nil)
+	s.testData.wallets.prepaidExpiring = s.createWallet("wallet_prepaid_expiring", types.WalletTypePrePaid, 50,
+		lo.ToPtr(time.Now().UTC().AddDate(0, 1, 0)))
+	s.testData.wallets.prepaid = s.createWallet("wallet_prepaid", types.WalletTypePrePaid, 40, nil)
+}
+
+func (s *InvoiceAutoApplyCreditsTestSuite) createWallet(id string, walletType types.WalletType, balance int64, expiry *time.Time) *wallet.Wallet {
+	ctx := s.GetContext()
+
+	w := &wallet.Wallet{
+		ID:             id,
+		CustomerID:     s.testData.customer.ID,
+		Currency:       "usd",
+		Balance:        decimal.NewFromInt(balance),
+		CreditBalance:  decimal.NewFromInt(balance),
+		ConversionRate: decimal.NewFromInt(1),
+		WalletStatus:   types.WalletStatusActive,
+		WalletType:     walletType,
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().WalletRepo.CreateWallet(ctx, w))
+	s.NoError(s.GetStores().WalletRepo.CreateTransaction(ctx, &wallet.Transaction{
+		ID:               s.GetUUID(),
+		WalletID:         w.ID,
+		Type:             types.TransactionTypeCredit,
+		Amount:           w.Balance,
+		CreditAmount:     w.CreditBalance,
+		CreditsAvailable: w.CreditBalance,
+		ExpiryDate:       expiry,
+		TxStatus:         types.TransactionStatusCompleted,
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	}))
+	return w
+}
+
+func (s *InvoiceAutoApplyCreditsTestSuite) createDraftInvoice(amount int64, subscriptionID *string) *invoice.Invoice {
+	ctx := s.GetContext()
+
+	inv := &invoice.Invoice{
+		ID:              s.GetUUID(),
+		CustomerID:      s.testData.customer.ID,
+		SubscriptionID:  subscriptionID,
+		InvoiceType:     types.InvoiceTypeOneOff,
+		InvoiceStatus:   types.InvoiceStatusDraft,
+		PaymentStatus:   types.PaymentStatusPending,
+		Currency:        "usd",
+		AmountDue:       decimal.NewFromInt(amount),
+		AmountPaid:      decimal.Zero,
+		AmountRemaining: decimal.NewFromInt(amount),
+		BaseModel:       types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().InvoiceRepo.Create(ctx, inv))
+	return inv
+}
+
+func (s *InvoiceAutoApplyCreditsTestSuite) walletBalance(w *wallet.Wallet) decimal.Decimal {
+	updated, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), w.ID)
+	s.NoError(err)
+	return updated.Balance
+}
+
+func (s *InvoiceAutoApplyCreditsTestSuite) TestCreditsAppliedOnFinalize() {
+	ctx := s.GetContext()
+	inv := s.createDraftInvoice(100, nil)
+
+	s.NoError(s.service.FinalizeInvoice(ctx, inv.ID))
+
+	updated, err := s.GetStores().InvoiceRepo.Get(ctx, inv.ID)
+	s.NoError(err)
+	s.Equal(types.InvoiceStatusFinalized, updated.InvoiceStatus)
+	s.Equal(types.PaymentStatusSucceeded, updated.PaymentStatus)
+	s.True(updated.AmountRemaining.IsZero(), "amount remaining %s", updated.AmountRemaining)
+
+	// Promotional credits first, then the prepaid credits expiring first
+	s.True(s.walletBalance(s.testData.wallets.promotional).IsZero())
+	s.True(s.walletBalance(s.testData.wallets.prepaidExpiring).IsZero())
+	s.True(decimal.NewFromInt(20).Equal(s.walletBalance(s.testData.wallets.prepaid)))
+
+	// The debits are linked to the invoice
+	filter := types.NewNoLimitWalletTransactionFilter()
+	filter.WalletID = lo.ToPtr(s.testData.wallets.prepaid.ID)
+	filter.Type = lo.ToPtr(types.TransactionTypeDebit)
+	debits, err := s.GetStores().WalletRepo.ListAllWalletTransactions(ctx, filter)
+	s.NoError(err)
+	s.Require().Len(debits, 1)
+	s.Equal(inv.ID, debits[0].Metadata["invoice_id"])
+	s.Equal(types.TransactionReasonInvoicePayment, debits[0].TransactionReason)
+}
+
+func (s *InvoiceAutoApplyCreditsTestSuite) TestCreditsNotAppliedWhenDisabled() {
+	ctx := s.GetContext()
+
+	s.testData.customer.AutoApplyCredits = false
+	s.NoError(s.GetStores().CustomerRepo.Update(ctx, s.testData.customer))
+
+	inv := s.createDraftInvoice(100, nil)
+	s.NoError(s.service.FinalizeInvoice(ctx, inv.ID))
+
+	updated, err := s.GetStores().InvoiceRepo.Get(ctx, inv.ID)
+	s.NoError(err)
+	s.Equal(types.PaymentStatusPending, updated.PaymentStatus)
+	s.True(decimal.NewFromInt(100).Equal(updated.AmountRemaining))
+	s.True(decimal.NewFromInt(30).Equal(s.walletBalance(s.testData.wallets.promotional)))
+}
+
+func (s *InvoiceAutoApplyCreditsTestSuite) TestSubscriptionOverridesCustomer() {
+	ctx := s.GetContext()
+
+	sub := &subscription.Subscription{
+		ID:               "sub_auto_apply",
+		CustomerID:       s.testData.customer.ID,
+		AutoApplyCredits: lo.ToPtr(false),
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.Create(ctx, sub))
+
+	inv := s.createDraftInvoice(100, lo.ToPtr(sub.ID))
+	s.NoError(s.service.FinalizeInvoice(ctx, inv.ID))
+
+	updated, err := s.GetStores().InvoiceRepo.Get(ctx, inv.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(100).Equal(updated.AmountRemaining))
+
+	payments, err := s.GetStores().PaymentRepo.List(ctx, &types.PaymentFilter{
+		DestinationID:   lo.ToPtr(inv.ID),
+		DestinationType: lo.ToPtr(string(types.PaymentDestinationTypeInvoice)),
+	})
+	s.NoError(err)
+	s.Empty(payments)
+}
+
+func (s *InvoiceAutoApplyCreditsTestSuite) TestProcessDraftInvoicePaysFromWalletsWhenDisabled() {
+	ctx := s.GetContext()
+
+	s.testData.customer.AutoApplyCredits = false
+	s.NoError(s.GetStores().CustomerRepo.Update(ctx, s.testData.customer))
+
+	inv := s.createDraftInvoice(100, nil)
+	s.NoError(s.service.ProcessDraftInvoice(ctx, inv.ID))
+
+	updated, err := s.GetStores().InvoiceRepo.Get(ctx, inv.ID)
+	s.NoError(err)
+	s.Equal(types.InvoiceStatusFinalized, updated.InvoiceStatus)
+	s.Equal(types.PaymentStatusSucceeded, updated.PaymentStatus)
+	s.True(updated.AmountRemaining.IsZero(), "amount remaining %s", updated.AmountRemaining)
+
+	// The payment attempt made after processing the invoice uses the wallets
+	payments, err := s.GetStores().PaymentRepo.List(ctx, &types.PaymentFilter{
+		DestinationID:   lo.ToPtr(inv.ID),
+		DestinationType: lo.ToPtr(string(types.PaymentDestinationTypeInvoice)),
+	})
+	s.NoError(err)
+	s.NotEmpty(payments)
+	for _, p := range payments {
+		s.Equal("automatic_attempt", p.Metadata["payment_source"])
+	}
+
+	total := s.walletBalance(s.testData.wallets.promotional).
+		Add(s.walletBalance(s.testData.wallets.prepaidExpiring)).
+		Add(s.walletBalance(s.testData.wallets.prepaid))
+	s.True(decimal.NewFromInt(20).Equal(total), "wallet balances %s", total)
+}
+
+func (s *InvoiceAutoApplyCreditsTestSuite) TestProcessDraftInvoiceFailsWhenSettingUnresolved() {
+	ctx := s.GetContext()
+
+	inv := s.createDraftInvoice(100, lo.ToPtr("sub_missing"))
+	s.Error(s.service.ProcessDraftInvoice(ctx, inv.ID))
+
+	updated, err := s.GetStores().InvoiceRepo.Get(ctx, inv.ID)
+	s.NoError(err)
+	s.Equal(types.InvoiceStatusDraft, updated.InvoiceStatus)
+	s.True(decimal.NewFromInt(30).Equal(s.walletBalance(s.testData.wallets.promotional)))
+}
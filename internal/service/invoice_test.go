@@ -80,6 +80,9 @@ func (s *InvoiceServiceSuite) setupService() {
 		InvoiceRepo:      s.invoiceRepo,
 		EntitlementRepo:  s.GetStores().EntitlementRepo,
 		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
 		FeatureRepo:      s.GetStores().FeatureRepo,
 		TenantRepo:       s.GetStores().TenantRepo,
 		UserRepo:         s.GetStores().UserRepo,
@@ -866,6 +869,9 @@ func (s *InvoiceServiceSuite) setupWallets() {
 		InvoiceRepo:      s.invoiceRepo,
 		EntitlementRepo:  s.GetStores().EntitlementRepo,
 		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
 		FeatureRepo:      s.GetStores().FeatureRepo,
 		TenantRepo:       s.GetStores().TenantRepo,
 		UserRepo:         s.GetStores().UserRepo,
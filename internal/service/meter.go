@@ -158,6 +158,11 @@ func (s *meterService) UpdateMeter(ctx context.Context, id string, filters []met
 	// Merge filters
 	mergedFilters := mergeFilters(existingMeter.Filters, filters)
 
+	// Validate the merged operators and values before persisting
+	if err := meter.ValidateFilters(mergedFilters); err != nil {
+		return nil, err
+	}
+
 	// Update only the filters field in the database
 	if err := s.meterRepo.UpdateMeter(ctx, id, mergedFilters); err != nil {
 		return nil, err
@@ -169,17 +174,24 @@ func (s *meterService) UpdateMeter(ctx context.Context, id string, filters []met
 }
 
 // mergeFilters combines existing filters with new filters, ensuring no duplicates
+// An operator on a new filter replaces the existing one and numeric operators replace
+// the values instead of merging them since they define a bound rather than a set
 func mergeFilters(existingFilters, newFilters []meter.Filter) []meter.Filter {
 	filterMap := make(map[string][]string)
+	operatorMap := make(map[string]types.FilterOperator)
 
 	// Add existing filters to the map
 	for _, f := range existingFilters {
 		filterMap[f.Key] = f.Values
+		operatorMap[f.Key] = f.Operator
 	}
 
 	// Merge new filters into the map
 	for _, newFilter := range newFilters {
-		if _, exists := filterMap[newFilter.Key]; !exists {
+		if newFilter.Operator != "" {
+			operatorMap[newFilter.Key] = newFilter.Operator
+		}
+		if _, exists := filterMap[newFilter.Key]; !exists || operatorMap[newFilter.Key].IsNumeric() {
 			filterMap[newFilter.Key] = []string{}
 		}
 		for _, value := range newFilter.Values {
@@ -193,8 +205,9 @@ func mergeFilters(existingFilters, newFilters []meter.Filter) []meter.Filter {
 	mergedFilters := make([]meter.Filter, 0, len(filterMap))
 	for key, values := range filterMap {
 		mergedFilters = append(mergedFilters, meter.Filter{
-			Key:    key,
-			Values: values,
+			Key:      key,
+			Operator: operatorMap[key],
+			Values:   values,
 		})
 	}
 
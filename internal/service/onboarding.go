@@ -307,10 +307,8 @@ func (s *onboardingService) createEventRequest(eventMsg *types.OnboardingEventsM
 	properties := make(map[string]interface{})
 
 	// Handle properties based on meter aggregation and filters
-	if meter.Aggregation.Type == types.AggregationSum ||
-		meter.Aggregation.Type == types.AggregationCountUnique ||
-		meter.Aggregation.Type == types.AggregationAvg {
-		// For sum/avg aggregation, we need to generate a value for the aggregation field
+	if meter.Aggregation.Type.RequiresField() {
+		// For field based aggregations, we need to generate a value for the aggregation field
 		if meter.Aggregation.Field != "" {
 			// Generate a random value between 1 and 100
 			properties[meter.Aggregation.Field] = rand.Int63n(100) + 1
@@ -651,6 +649,7 @@ func (s *onboardingService) createDefaultPlans(ctx context.Context, features []*
 		s.MeterRepo,
 		s.EntitlementRepo,
 		s.FeatureRepo,
+		s.SubRepo,
 		s.Logger,
 	)
 
@@ -903,7 +902,7 @@ func (s *onboardingService) createDefaultCustomers(ctx context.Context) ([]*dto.
 	s.Logger.Infow("creating default customers for Cursor pricing model")
 
 	// Create a customer service instance
-	customerService := NewCustomerService(s.CustomerRepo)
+	customerService := NewCustomerService(s.ServiceParams)
 
 	// Create a default customer
 	customer := dto.CreateCustomerRequest{
@@ -122,7 +122,19 @@ func (p *paymentProcessor) ProcessPayment(ctx context.Context, id string) (*paym
 	}
 
 	paymentObj.UpdatedAt = time.Now().UTC()
-	if err := p.PaymentRepo.Update(ctx, paymentObj); err != nil {
+	err = p.DB.WithTx(ctx, func(tx context.Context) error {
+		if err := p.PaymentRepo.Update(tx, paymentObj); err != nil {
+			return err
+		}
+
+		p.publishWebhookEvent(tx, lo.Ternary(
+			paymentObj.PaymentStatus == types.PaymentStatusSucceeded,
+			types.WebhookEventPaymentSucceeded,
+			types.WebhookEventPaymentFailed,
+		), types.WebhookEventPayload{PaymentID: paymentObj.ID})
+		return nil
+	})
+	if err != nil {
 		return paymentObj, err
 	}
 
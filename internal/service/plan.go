@@ -9,6 +9,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/postgres"
@@ -30,6 +31,7 @@ type planService struct {
 	meterRepo       meter.Repository
 	entitlementRepo entitlement.Repository
 	featureRepo     feature.Repository
+	subRepo         subscription.Repository
 	client          postgres.IClient
 	logger          *logger.Logger
 }
@@ -41,6 +43,7 @@ func NewPlanService(
 	meterRepo meter.Repository,
 	entitlementRepo entitlement.Repository,
 	featureRepo feature.Repository,
+	subRepo subscription.Repository,
 	logger *logger.Logger,
 ) PlanService {
 	return &planService{
@@ -50,6 +53,7 @@ func NewPlanService(
 		meterRepo:       meterRepo,
 		entitlementRepo: entitlementRepo,
 		featureRepo:     featureRepo,
+		subRepo:         subRepo,
 		logger:          logger,
 	}
 }
@@ -82,6 +86,7 @@ func (s *planService) CreatePlan(ctx context.Context, req dto.CreatePlanRequest)
 						Mark(ierr.ErrValidation)
 				}
 				price.PlanID = plan.ID
+				price.PlanVersion = plan.Version
 				prices[i] = price
 			}
 
@@ -131,7 +136,7 @@ func (s *planService) GetPlan(ctx context.Context, id string) (*dto.PlanResponse
 		return nil, err
 	}
 
-	priceService := NewPriceService(s.priceRepo, s.meterRepo, s.logger)
+	priceService := NewPriceService(s.client, s.priceRepo, s.planRepo, s.meterRepo, s.subRepo, s.logger)
 	entitlementService := NewEntitlementService(s.entitlementRepo, s.planRepo, s.featureRepo, s.meterRepo, s.logger)
 
 	pricesResponse, err := priceService.GetPricesByPlanID(ctx, plan.ID)
@@ -204,7 +209,7 @@ func (s *planService) GetPlans(ctx context.Context, filter *types.PlanFilter) (*
 	pricesByPlanID := make(map[string][]*dto.PriceResponse)
 	entitlementsByPlanID := make(map[string][]*dto.EntitlementResponse)
 
-	priceService := NewPriceService(s.priceRepo, s.meterRepo, s.logger)
+	priceService := NewPriceService(s.client, s.priceRepo, s.planRepo, s.meterRepo, s.subRepo, s.logger)
 	entitlementService := NewEntitlementService(s.entitlementRepo, s.planRepo, s.featureRepo, s.meterRepo, s.logger)
 
 	// If prices or entitlements expansion is requested, fetch them in bulk
@@ -274,6 +279,10 @@ func (s *planService) UpdatePlan(ctx context.Context, id string, req dto.UpdateP
 			Mark(ierr.ErrValidation)
 	}
 
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Get the existing plan
 	planResponse, err := s.GetPlan(ctx, id)
 	if err != nil {
@@ -292,9 +301,40 @@ func (s *planService) UpdatePlan(ctx context.Context, id string, req dto.UpdateP
 	if req.LookupKey != nil {
 		plan.LookupKey = *req.LookupKey
 	}
+	if req.CommitmentAmount != nil {
+		plan.CommitmentAmount = req.CommitmentAmount
+		// The interval of an existing commitment is kept unless the request changes it
+		if plan.CommitmentInterval == "" {
+			plan.CommitmentInterval = types.CommitmentIntervalBillingPeriod
+		}
+	}
+	if req.CommitmentInterval != "" {
+		if plan.CommitmentAmount == nil {
+			return nil, ierr.NewError("plan has no commitment").
+				WithHint("Please provide the commitment amount for the commitment interval").
+				WithReportableDetails(map[string]interface{}{
+					"plan_id":             plan.ID,
+					"commitment_interval": req.CommitmentInterval,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		plan.CommitmentInterval = req.CommitmentInterval
+	}
 
 	// Start a transaction for updating plan, prices, and entitlements
 	err = s.client.WithTx(ctx, func(ctx context.Context) error {
+		// Adding or removing prices of a plan in use creates a new version of it, the
+		// subscriptions on the plan stay on the version they are pinned to until they are migrated
+		if planPricesChanged(planResponse.Prices, req.Prices) {
+			inUse, err := planHasSubscriptions(ctx, s.subRepo, plan.ID)
+			if err != nil {
+				return err
+			}
+			if inUse {
+				plan.Version++
+			}
+		}
+
 		// 1. Update the plan
 		if err := s.planRepo.Update(ctx, plan); err != nil {
 			return err
@@ -347,6 +387,7 @@ func (s *planService) UpdatePlan(ctx context.Context, id string, req dto.UpdateP
 							Mark(ierr.ErrValidation)
 					}
 					newPrice.PlanID = plan.ID
+					newPrice.PlanVersion = plan.Version
 					newPrices = append(newPrices, newPrice)
 				}
 			}
@@ -422,6 +463,41 @@ func (s *planService) UpdatePlan(ctx context.Context, id string, req dto.UpdateP
 	return s.GetPlan(ctx, id)
 }
 
+// planHasSubscriptions returns true if any subscription, in any status, was created on the plan
+func planHasSubscriptions(ctx context.Context, subRepo subscription.Repository, planID string) (bool, error) {
+	filter := types.NewNoLimitSubscriptionFilter()
+	filter.PlanID = planID
+
+	count, err := subRepo.Count(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// planPricesChanged returns true if the update adds prices to the plan or removes existing ones
+func planPricesChanged(existing []*dto.PriceResponse, reqPrices []dto.UpdatePlanPriceRequest) bool {
+	if len(reqPrices) == 0 {
+		return false
+	}
+
+	reqPriceIDs := make(map[string]bool, len(reqPrices))
+	for _, reqPrice := range reqPrices {
+		if reqPrice.ID == "" {
+			return true
+		}
+		reqPriceIDs[reqPrice.ID] = true
+	}
+
+	for _, p := range existing {
+		if !reqPriceIDs[p.ID] {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (s *planService) DeletePlan(ctx context.Context, id string) error {
 	err := s.planRepo.Delete(ctx, id)
 	if err != nil {
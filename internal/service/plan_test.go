@@ -38,6 +38,7 @@ func (s *PlanServiceSuite) setupService() {
 		stores.MeterRepo,
 		stores.EntitlementRepo,
 		stores.FeatureRepo,
+		stores.SubscriptionRepo,
 		s.GetLogger(),
 	)
 }
@@ -516,6 +517,45 @@ func (s *PlanServiceSuite) TestUpdatePlan() {
 	s.Equal(*req.Name, resp.Plan.Name)
 }
 
+func (s *PlanServiceSuite) TestUpdatePlanCommitment() {
+	ctx := s.GetContext()
+	_ = s.GetStores().PlanRepo.Create(ctx, &plan.Plan{
+		ID:                 "plan-committed",
+		Name:               "Committed",
+		CommitmentAmount:   lo.ToPtr(decimal.NewFromInt(1200)),
+		CommitmentInterval: types.CommitmentIntervalAnnual,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	})
+	_ = s.GetStores().PlanRepo.Create(ctx, &plan.Plan{
+		ID:        "plan-uncommitted",
+		Name:      "Uncommitted",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	})
+
+	// Changing the amount keeps the interval of the existing commitment
+	resp, err := s.service.UpdatePlan(ctx, "plan-committed", dto.UpdatePlanRequest{
+		CommitmentAmount: lo.ToPtr(decimal.NewFromInt(2400)),
+	})
+	s.NoError(err)
+	s.True(decimal.NewFromInt(2400).Equal(*resp.Plan.CommitmentAmount))
+	s.Equal(types.CommitmentIntervalAnnual, resp.Plan.CommitmentInterval)
+
+	// A new commitment defaults to the billing period
+	resp, err = s.service.UpdatePlan(ctx, "plan-uncommitted", dto.UpdatePlanRequest{
+		CommitmentAmount: lo.ToPtr(decimal.NewFromInt(100)),
+	})
+	s.NoError(err)
+	s.Equal(types.CommitmentIntervalBillingPeriod, resp.Plan.CommitmentInterval)
+
+	// The interval is changed when the request sets it
+	resp, err = s.service.UpdatePlan(ctx, "plan-committed", dto.UpdatePlanRequest{
+		CommitmentAmount:   lo.ToPtr(decimal.NewFromInt(200)),
+		CommitmentInterval: types.CommitmentIntervalBillingPeriod,
+	})
+	s.NoError(err)
+	s.Equal(types.CommitmentIntervalBillingPeriod, resp.Plan.CommitmentInterval)
+}
+
 func (s *PlanServiceSuite) TestDeletePlan() {
 	// Create a plan
 	plan := &plan.Plan{ID: "plan-1", Name: "Plan to Delete"}
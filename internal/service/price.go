@@ -6,9 +6,12 @@ import (
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
@@ -21,16 +24,34 @@ type PriceService interface {
 	UpdatePrice(ctx context.Context, id string, req dto.UpdatePriceRequest) (*dto.PriceResponse, error)
 	DeletePrice(ctx context.Context, id string) error
 	CalculateCost(ctx context.Context, price *price.Price, quantity decimal.Decimal) decimal.Decimal
+	CalculateTierBreakdown(ctx context.Context, price *price.Price, quantity decimal.Decimal) []price.TierBreakdown
 }
 
 type priceService struct {
+	client    postgres.IClient
 	repo      price.Repository
+	planRepo  plan.Repository
 	meterRepo meter.Repository
+	subRepo   subscription.Repository
 	logger    *logger.Logger
 }
 
-func NewPriceService(repo price.Repository, meterRepo meter.Repository, logger *logger.Logger) PriceService {
-	return &priceService{repo: repo, logger: logger, meterRepo: meterRepo}
+func NewPriceService(
+	client postgres.IClient,
+	repo price.Repository,
+	planRepo plan.Repository,
+	meterRepo meter.Repository,
+	subRepo subscription.Repository,
+	logger *logger.Logger,
+) PriceService {
+	return &priceService{
+		client:    client,
+		repo:      repo,
+		planRepo:  planRepo,
+		meterRepo: meterRepo,
+		subRepo:   subRepo,
+		logger:    logger,
+	}
 }
 
 func (s *priceService) CreatePrice(ctx context.Context, req dto.CreatePriceRequest) (*dto.PriceResponse, error) {
@@ -51,7 +72,17 @@ func (s *priceService) CreatePrice(ctx context.Context, req dto.CreatePriceReque
 			Mark(ierr.ErrValidation)
 	}
 
-	if err := s.repo.Create(ctx, price); err != nil {
+	err = s.client.WithTx(ctx, func(ctx context.Context) error {
+		// Adding a price to a plan in use creates a new version of it
+		version, err := s.bumpPlanVersion(ctx, price.PlanID)
+		if err != nil {
+			return err
+		}
+		price.PlanVersion = version
+
+		return s.repo.Create(ctx, price)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -170,10 +201,46 @@ func (s *priceService) UpdatePrice(ctx context.Context, id string, req dto.Updat
 }
 
 func (s *priceService) DeletePrice(ctx context.Context, id string) error {
-	if err := s.repo.Delete(ctx, id); err != nil {
+	price, err := s.repo.Get(ctx, id)
+	if err != nil {
 		return err
 	}
-	return nil
+
+	return s.client.WithTx(ctx, func(ctx context.Context) error {
+		// Removing a price from a plan in use creates a new version of it, the subscriptions
+		// pinned to previous versions keep being billed for the price until they are migrated
+		if price.PlanID != "" {
+			if _, err := s.bumpPlanVersion(ctx, price.PlanID); err != nil {
+				return err
+			}
+		}
+
+		return s.repo.Delete(ctx, id)
+	})
+}
+
+// bumpPlanVersion increments the version of the plan if it already has subscriptions and
+// returns the version the plan's prices belong to
+func (s *priceService) bumpPlanVersion(ctx context.Context, planID string) (int, error) {
+	p, err := s.planRepo.Get(ctx, planID)
+	if err != nil {
+		return 0, err
+	}
+
+	inUse, err := planHasSubscriptions(ctx, s.subRepo, planID)
+	if err != nil {
+		return 0, err
+	}
+	if !inUse {
+		return p.Version, nil
+	}
+
+	p.Version++
+	if err := s.planRepo.Update(ctx, p); err != nil {
+		return 0, err
+	}
+
+	return p.Version, nil
 }
 
 // CalculateCost calculates the cost for a given price and usage
@@ -193,15 +260,7 @@ func (s *priceService) CalculateCost(ctx context.Context, price *price.Price, qu
 			return decimal.Zero
 		}
 
-		transformedQuantity := quantity.Div(decimal.NewFromInt(int64(price.TransformQuantity.DivideBy)))
-
-		if price.TransformQuantity.Round == types.ROUND_UP {
-			transformedQuantity = transformedQuantity.Ceil()
-		} else if price.TransformQuantity.Round == types.ROUND_DOWN {
-			transformedQuantity = transformedQuantity.Floor()
-		}
-
-		cost = price.CalculateAmount(transformedQuantity)
+		cost = price.CalculateAmount(price.CalculatePackageQuantity(quantity))
 
 	case types.BILLING_MODEL_TIERED:
 		cost = s.calculateTieredCost(ctx, price, quantity)
@@ -214,21 +273,37 @@ func (s *priceService) CalculateCost(ctx context.Context, price *price.Price, qu
 // calculateTieredCost calculates cost for tiered pricing
 func (s *priceService) calculateTieredCost(ctx context.Context, price *price.Price, quantity decimal.Decimal) decimal.Decimal {
 	cost := decimal.Zero
-	if len(price.Tiers) == 0 {
-		s.logger.WithContext(ctx).Errorf("no tiers found for price %s", price.ID)
-		return cost
+	for _, tier := range s.CalculateTierBreakdown(ctx, price, quantity) {
+		cost = cost.Add(tier.Amount)
+	}
+	return cost
+}
+
+// CalculateTierBreakdown returns the quantity and amount attributed to each tier of a tiered price.
+// In VOLUME mode the whole quantity is billed in the tier it falls into, in SLAB mode the
+// quantity is graduated across the tiers and the flat amount of every tier reached is charged.
+// A zero quantity reaches no tier, so like CalculateCost it bills nothing, flat amounts included.
+func (s *priceService) CalculateTierBreakdown(ctx context.Context, p *price.Price, quantity decimal.Decimal) []price.TierBreakdown {
+	if p.BillingModel != types.BILLING_MODEL_TIERED || quantity.IsZero() {
+		return nil
+	}
+
+	if len(p.Tiers) == 0 {
+		s.logger.WithContext(ctx).Errorf("no tiers found for price %s", p.ID)
+		return nil
 	}
 
 	// Sort price tiers by up_to value
-	sort.Slice(price.Tiers, func(i, j int) bool {
-		return price.Tiers[i].GetTierUpTo() < price.Tiers[j].GetTierUpTo()
+	sort.Slice(p.Tiers, func(i, j int) bool {
+		return p.Tiers[i].GetTierUpTo() < p.Tiers[j].GetTierUpTo()
 	})
 
-	switch price.TierMode {
+	var breakdown []price.TierBreakdown
+	switch p.TierMode {
 	case types.BILLING_TIER_VOLUME:
-		selectedTierIndex := len(price.Tiers) - 1
+		selectedTierIndex := len(p.Tiers) - 1
 		// Find the tier that the quantity falls into
-		for i, tier := range price.Tiers {
+		for i, tier := range p.Tiers {
 			if tier.UpTo == nil {
 				selectedTierIndex = i
 				break
@@ -239,24 +314,31 @@ func (s *priceService) calculateTieredCost(ctx context.Context, price *price.Pri
 			}
 		}
 
-		selectedTier := price.Tiers[selectedTierIndex]
+		selectedTier := p.Tiers[selectedTierIndex]
 
 		// Calculate tier cost with proper rounding and handling of flat amount
-		tierCost := selectedTier.CalculateTierAmount(quantity, price.Currency)
+		tierCost := selectedTier.CalculateTierAmount(quantity, p.Currency)
 
 		s.logger.WithContext(ctx).Debugf(
 			"volume tier total cost for quantity %s: %s price: %s tier : %+v",
 			quantity.String(),
 			tierCost.String(),
-			price.ID,
+			p.ID,
 			selectedTier,
 		)
 
-		cost = cost.Add(tierCost)
+		breakdown = append(breakdown, price.TierBreakdown{
+			TierIndex:  selectedTierIndex + 1,
+			UpTo:       selectedTier.UpTo,
+			Quantity:   quantity,
+			UnitAmount: selectedTier.UnitAmount,
+			FlatAmount: selectedTier.FlatAmount,
+			Amount:     tierCost,
+		})
 
 	case types.BILLING_TIER_SLAB:
 		remainingQuantity := quantity
-		for _, tier := range price.Tiers {
+		for i, tier := range p.Tiers {
 			var tierQuantity = remainingQuantity
 			if tier.UpTo != nil {
 				upTo := decimal.NewFromUint64(*tier.UpTo)
@@ -266,26 +348,34 @@ func (s *priceService) calculateTieredCost(ctx context.Context, price *price.Pri
 			}
 
 			// Calculate tier cost with proper rounding and handling of flat amount
-			tierCost := tier.CalculateTierAmount(tierQuantity, price.Currency)
-			cost = cost.Add(tierCost)
+			tierCost := tier.CalculateTierAmount(tierQuantity, p.Currency)
 			remainingQuantity = remainingQuantity.Sub(tierQuantity)
 
 			s.logger.WithContext(ctx).Debugf(
 				"slab tier total cost for quantity %s: %s price: %s tier : %+v",
 				quantity.String(),
 				tierCost.String(),
-				price.ID,
+				p.ID,
 				tier,
 			)
 
+			breakdown = append(breakdown, price.TierBreakdown{
+				TierIndex:  i + 1,
+				UpTo:       tier.UpTo,
+				Quantity:   tierQuantity,
+				UnitAmount: tier.UnitAmount,
+				FlatAmount: tier.FlatAmount,
+				Amount:     tierCost,
+			})
+
 			if remainingQuantity.LessThanOrEqual(decimal.Zero) {
 				break
 			}
 		}
 	default:
-		s.logger.WithContext(ctx).Errorf("invalid tier mode: %s", price.TierMode)
-		return decimal.Zero
+		s.logger.WithContext(ctx).Errorf("invalid tier mode: %s", p.TierMode)
+		return nil
 	}
 
-	return cost
+	return breakdown
 }
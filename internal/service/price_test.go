@@ -7,7 +7,10 @@ import (
 	"testing"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
@@ -20,6 +23,8 @@ type PriceServiceSuite struct {
 	ctx          context.Context
 	priceService *priceService
 	priceRepo    *testutil.InMemoryPriceStore
+	planRepo     *testutil.InMemoryPlanStore
+	subRepo      *testutil.InMemorySubscriptionStore
 }
 
 func TestPriceService(t *testing.T) {
@@ -30,12 +35,21 @@ func (s *PriceServiceSuite) SetupTest() {
 	s.ctx = testutil.SetupContext()
 	s.priceRepo = testutil.NewInMemoryPriceStore()
 
+	s.planRepo = testutil.NewInMemoryPlanStore()
+	s.subRepo = testutil.NewInMemorySubscriptionStore()
+
 	s.priceService = &priceService{
-		repo: s.priceRepo,
+		client:   testutil.NewMockPostgresClient(logger.GetLogger()),
+		repo:     s.priceRepo,
+		planRepo: s.planRepo,
+		subRepo:  s.subRepo,
+		logger:   logger.GetLogger(),
 	}
 }
 
 func (s *PriceServiceSuite) TestCreatePrice() {
+	_ = s.planRepo.Create(s.ctx, &plan.Plan{ID: "plan-1", Name: "Test Plan", Version: 1, BaseModel: types.GetDefaultBaseModel(s.ctx)})
+
 	req := dto.CreatePriceRequest{
 		Amount:             "100",
 		Currency:           "usd",
@@ -74,6 +88,48 @@ func (s *PriceServiceSuite) TestCreatePrice() {
 
 	// Normalize currency to lowercase for comparison
 	s.Equal(strings.ToLower(req.Currency), resp.Price.Currency)
+
+	// The plan has no subscriptions yet, so the price is added to its current version
+	s.Equal(1, resp.Price.PlanVersion)
+	p, err := s.planRepo.Get(s.ctx, "plan-1")
+	s.NoError(err)
+	s.Equal(1, p.Version)
+}
+
+func (s *PriceServiceSuite) TestCreatePriceOnPlanInUseCreatesVersion() {
+	_ = s.planRepo.Create(s.ctx, &plan.Plan{ID: "plan-1", Name: "Test Plan", Version: 1, BaseModel: types.GetDefaultBaseModel(s.ctx)})
+	_ = s.subRepo.Create(s.ctx, &subscription.Subscription{
+		ID:          "sub-1",
+		PlanID:      "plan-1",
+		PlanVersion: 1,
+		BaseModel:   types.GetDefaultBaseModel(s.ctx),
+	})
+
+	req := dto.CreatePriceRequest{
+		Amount:             "100",
+		Currency:           "usd",
+		PlanID:             "plan-1",
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+	}
+
+	// Adding a price to a plan in use creates a new version of it
+	resp, err := s.priceService.CreatePrice(s.ctx, req)
+	s.NoError(err)
+	s.Equal(2, resp.Price.PlanVersion)
+	p, err := s.planRepo.Get(s.ctx, "plan-1")
+	s.NoError(err)
+	s.Equal(2, p.Version)
+
+	// Removing it creates another one
+	s.NoError(s.priceService.DeletePrice(s.ctx, resp.Price.ID))
+	p, err = s.planRepo.Get(s.ctx, "plan-1")
+	s.NoError(err)
+	s.Equal(3, p.Version)
 }
 
 func (s *PriceServiceSuite) TestGetPrice() {
@@ -176,3 +232,162 @@ func (s *PriceServiceSuite) TestDeletePrice() {
 	_, err = s.priceRepo.Get(s.ctx, "price-1")
 	s.Error(err)
 }
+
+func (s *PriceServiceSuite) TestCalculateCostPackageWithIncludedQuantity() {
+	p := &price.Price{
+		ID:           "price-1",
+		Amount:       decimal.NewFromInt(1),
+		Currency:     "usd",
+		BillingModel: types.BILLING_MODEL_PACKAGE,
+		TransformQuantity: price.JSONBTransformQuantity{
+			DivideBy:         1000,
+			Round:            types.ROUND_UP,
+			IncludedQuantity: 10000,
+			MinPackages:      2,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		quantity decimal.Decimal
+		expected decimal.Decimal
+	}{
+		{
+			name:     "no usage is not billed",
+			quantity: decimal.Zero,
+			expected: decimal.Zero,
+		},
+		{
+			name:     "usage within included quantity is not billed",
+			quantity: decimal.NewFromInt(9000),
+			expected: decimal.Zero,
+		},
+		{
+			name:     "usage equal to included quantity is not billed",
+			quantity: decimal.NewFromInt(10000),
+			expected: decimal.Zero,
+		},
+		{
+			name:     "usage just above included quantity bills the minimum packages",
+			quantity: decimal.NewFromInt(10001),
+			expected: decimal.NewFromInt(2),
+		},
+		{
+			name:     "usage above included quantity is rounded up",
+			quantity: decimal.NewFromInt(15001),
+			expected: decimal.NewFromInt(6),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			cost := s.priceService.CalculateCost(s.ctx, p, tt.quantity)
+			s.True(tt.expected.Equal(cost), "expected %s got %s", tt.expected, cost)
+		})
+	}
+}
+
+func (s *PriceServiceSuite) TestApplyMinimumCharge() {
+	p := &price.Price{
+		ID:            "price-1",
+		Amount:        decimal.NewFromInt(1),
+		Currency:      "usd",
+		MinimumCharge: lo.ToPtr(decimal.NewFromInt(10)),
+	}
+
+	amount, applied := p.ApplyMinimumCharge(decimal.NewFromInt(4))
+	s.True(applied)
+	s.True(decimal.NewFromInt(10).Equal(amount))
+
+	amount, applied = p.ApplyMinimumCharge(decimal.NewFromInt(25))
+	s.False(applied)
+	s.True(decimal.NewFromInt(25).Equal(amount))
+
+	_, err := s.priceService.CreatePrice(s.ctx, dto.CreatePriceRequest{
+		Amount:             "1",
+		Currency:           "usd",
+		PlanID:             "plan-1",
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		MinimumCharge:      lo.ToPtr("-5"),
+	})
+	s.Error(err)
+}
+
+func (s *PriceServiceSuite) TestCalculateTierBreakdown() {
+	tiers := price.JSONBTiers{
+		{UpTo: lo.ToPtr(uint64(10)), UnitAmount: decimal.NewFromInt(5), FlatAmount: lo.ToPtr(decimal.NewFromInt(20))},
+		{UpTo: lo.ToPtr(uint64(30)), UnitAmount: decimal.NewFromInt(3), FlatAmount: lo.ToPtr(decimal.NewFromInt(10))},
+		{UpTo: nil, UnitAmount: decimal.NewFromInt(1)},
+	}
+
+	s.Run("slab mode graduates the quantity across tiers", func() {
+		p := &price.Price{
+			ID:           "price-slab",
+			Currency:     "usd",
+			BillingModel: types.BILLING_MODEL_TIERED,
+			TierMode:     types.BILLING_TIER_SLAB,
+			Tiers:        tiers,
+		}
+
+		breakdown := s.priceService.CalculateTierBreakdown(s.ctx, p, decimal.NewFromInt(45))
+		s.Require().Len(breakdown, 3)
+
+		// up_to is the size of each tier: 10 units in the first tier, 30 in the second and the remaining 5 above
+		s.True(decimal.NewFromInt(10).Equal(breakdown[0].Quantity))
+		s.True(decimal.NewFromInt(70).Equal(breakdown[0].Amount))
+		s.True(decimal.NewFromInt(30).Equal(breakdown[1].Quantity))
+		s.True(decimal.NewFromInt(100).Equal(breakdown[1].Amount))
+		s.True(decimal.NewFromInt(5).Equal(breakdown[2].Quantity))
+		s.True(decimal.NewFromInt(5).Equal(breakdown[2].Amount))
+		s.Equal(3, breakdown[2].TierIndex)
+
+		cost := s.priceService.CalculateCost(s.ctx, p, decimal.NewFromInt(45))
+		s.True(decimal.NewFromInt(175).Equal(cost), "expected 175 got %s", cost)
+	})
+
+	s.Run("zero quantity bills no flat amount", func() {
+		for _, tierMode := range []types.BillingTier{types.BILLING_TIER_SLAB, types.BILLING_TIER_VOLUME} {
+			p := &price.Price{
+				ID:           "price-zero",
+				Currency:     "usd",
+				BillingModel: types.BILLING_MODEL_TIERED,
+				TierMode:     tierMode,
+				Tiers:        tiers,
+			}
+
+			s.Nil(s.priceService.CalculateTierBreakdown(s.ctx, p, decimal.Zero))
+			s.True(s.priceService.CalculateCost(s.ctx, p, decimal.Zero).IsZero())
+		}
+	})
+
+	s.Run("volume mode bills the whole quantity in a single tier", func() {
+		p := &price.Price{
+			ID:           "price-volume",
+			Currency:     "usd",
+			BillingModel: types.BILLING_MODEL_TIERED,
+			TierMode:     types.BILLING_TIER_VOLUME,
+			Tiers:        tiers,
+		}
+
+		breakdown := s.priceService.CalculateTierBreakdown(s.ctx, p, decimal.NewFromInt(15))
+		s.Require().Len(breakdown, 1)
+		s.Equal(2, breakdown[0].TierIndex)
+		s.True(decimal.NewFromInt(15).Equal(breakdown[0].Quantity))
+		s.True(decimal.NewFromInt(55).Equal(breakdown[0].Amount))
+	})
+
+	s.Run("non tiered prices have no breakdown", func() {
+		p := &price.Price{
+			ID:           "price-flat",
+			Currency:     "usd",
+			Amount:       decimal.NewFromInt(1),
+			BillingModel: types.BILLING_MODEL_FLAT_FEE,
+		}
+		s.Nil(s.priceService.CalculateTierBreakdown(s.ctx, p, decimal.NewFromInt(15)))
+	})
+}
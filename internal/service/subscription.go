@@ -2,14 +2,18 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
@@ -24,6 +28,22 @@ type SubscriptionService interface {
 	GetUsageBySubscription(ctx context.Context, req *dto.GetUsageBySubscriptionRequest) (*dto.GetUsageBySubscriptionResponse, error)
 	UpdateBillingPeriods(ctx context.Context) (*dto.SubscriptionUpdatePeriodResponse, error)
 
+	// ChangePlan moves the subscription to another plan mid-cycle, settling the previous plan up to the
+	// change and prorating the fixed advance charges of both plans
+	ChangePlan(ctx context.Context, id string, req dto.ChangeSubscriptionPlanRequest) (*dto.ChangeSubscriptionPlanResponse, error)
+
+	// MigratePlanVersion schedules subscriptions pinned to a previous version of a plan to move to
+	// its current version at the end of their current billing period
+	MigratePlanVersion(ctx context.Context, req dto.MigratePlanVersionRequest) (*dto.MigratePlanVersionResponse, error)
+
+	// UpdatePaymentMethod sets the payment method on file, trials requiring one convert to active
+	// at trial end only when it is set
+	UpdatePaymentMethod(ctx context.Context, id string, req dto.UpdateSubscriptionPaymentMethodRequest) (*dto.SubscriptionResponse, error)
+
+	// Quantity-related methods
+	UpdateLineItemQuantity(ctx context.Context, subscriptionID, lineItemID string, req dto.UpdateLineItemQuantityRequest) (*dto.UpdateLineItemQuantityResponse, error)
+	ListQuantityChanges(ctx context.Context, subscriptionID, lineItemID string) (*dto.ListSubscriptionQuantityChangesResponse, error)
+
 	// Pause-related methods
 	PauseSubscription(ctx context.Context, subscriptionID string, req *dto.PauseSubscriptionRequest) (*dto.PauseSubscriptionResponse, error)
 	ResumeSubscription(ctx context.Context, subscriptionID string, req *dto.ResumeSubscriptionRequest) (*dto.ResumeSubscriptionResponse, error)
@@ -79,9 +99,10 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 			Mark(ierr.ErrValidation)
 	}
 
-	priceService := NewPriceService(s.PriceRepo, s.MeterRepo, s.Logger)
+	priceService := NewPriceService(s.DB, s.PriceRepo, s.PlanRepo, s.MeterRepo, s.SubRepo, s.Logger)
 	priceFilter := types.NewNoLimitPriceFilter().
 		WithPlanIDs([]string{plan.ID}).
+		WithStatus(types.StatusPublished).
 		WithExpand(string(types.ExpandMeters))
 	pricesResponse, err := priceService.GetPrices(ctx, priceFilter)
 	if err != nil {
@@ -102,12 +123,17 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 		prices[i] = *p.Price
 	}
 
-	priceMap := make(map[string]*dto.PriceResponse, len(prices))
-	for _, p := range pricesResponse.Items {
-		priceMap[p.Price.ID] = p
-	}
-
 	sub := req.ToSubscription(ctx)
+	sub.PlanVersion = plan.Version
+
+	// The subscription inherits the commitment of the plan unless one is negotiated for it
+	if sub.CommitmentAmount == nil {
+		sub.CommitmentAmount = plan.CommitmentAmount
+		sub.CommitmentInterval = plan.CommitmentInterval
+	}
+	if sub.CommitmentAmount != nil && sub.CommitmentInterval == "" {
+		sub.CommitmentInterval = types.CommitmentIntervalBillingPeriod
+	}
 
 	// Filter prices for subscription that are valid for the plan
 	validPrices := filterValidPricesForSubscription(pricesResponse.Items, sub)
@@ -122,6 +148,12 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 			Mark(ierr.ErrValidation)
 	}
 
+	// Negotiated rates replace the plan prices they override for this subscription only
+	validPrices, overridePrices, err := applyPriceOverrides(ctx, sub, validPrices, req.PriceOverrides)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now().UTC()
 
 	// Set start date and ensure it's in UTC
@@ -162,59 +194,10 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 	sub.CurrentPeriodEnd = nextBillingDate
 	sub.SubscriptionStatus = types.SubscriptionStatusActive
 
-	// Convert line items
-	lineItems := make([]*subscription.SubscriptionLineItem, 0, len(validPrices))
-	for _, price := range validPrices {
-		lineItems = append(lineItems, &subscription.SubscriptionLineItem{
-			PriceID:       price.ID,
-			EnvironmentID: types.GetEnvironmentID(ctx),
-			BaseModel:     types.GetDefaultBaseModel(ctx),
-		})
-	}
-
-	// Convert line items
-	for _, item := range lineItems {
-		price, ok := priceMap[item.PriceID]
-		if !ok {
-			return nil, ierr.NewError("failed to get price %s: price not found").
-				WithHint("Ensure all prices are valid and available").
-				WithReportableDetails(map[string]interface{}{
-					"price_id": item.PriceID,
-				}).
-				Mark(ierr.ErrDatabase)
-		}
-
-		if price.Type == types.PRICE_TYPE_USAGE && price.Meter != nil {
-			item.MeterID = price.Meter.ID
-			item.MeterDisplayName = price.Meter.Name
-			item.DisplayName = price.Meter.Name
-			item.Quantity = decimal.Zero
-		} else {
-			item.DisplayName = plan.Name
-			if item.Quantity.IsZero() {
-				item.Quantity = decimal.NewFromInt(1)
-			}
-		}
-
-		if item.ID == "" {
-			item.ID = types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM)
-		}
+	// The trial, if any, replaces the first billing period
+	trialing := applyTrial(sub, validPrices)
 
-		item.SubscriptionID = sub.ID
-		item.PriceType = price.Type
-		item.PlanID = plan.ID
-		item.PlanDisplayName = plan.Name
-		item.CustomerID = sub.CustomerID
-		item.Currency = sub.Currency
-		item.BillingPeriod = sub.BillingPeriod
-		item.InvoiceCadence = price.InvoiceCadence
-		item.TrialPeriod = price.TrialPeriod
-		item.StartDate = sub.StartDate
-		if sub.EndDate != nil {
-			item.EndDate = *sub.EndDate
-		}
-	}
-	sub.LineItems = lineItems
+	sub.LineItems = buildSubscriptionLineItems(ctx, sub, plan, validPrices, sub.StartDate)
 
 	s.Logger.Infow("creating subscription",
 		"customer_id", sub.CustomerID,
@@ -224,15 +207,29 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 		"current_period_start", sub.CurrentPeriodStart,
 		"current_period_end", sub.CurrentPeriodEnd,
 		"valid_prices", len(validPrices),
-		"num_line_items", len(sub.LineItems))
+		"num_line_items", len(sub.LineItems),
+		"trial_end", sub.TrialEnd)
 
 	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if len(overridePrices) > 0 {
+			if err := s.PriceRepo.CreateBulk(ctx, overridePrices); err != nil {
+				return err
+			}
+		}
+
 		// Create subscription with line items
 		err = s.SubRepo.CreateWithLineItems(ctx, sub, sub.LineItems)
 		if err != nil {
 			return err
 		}
 
+		s.publishWebhookEvent(ctx, types.WebhookEventSubscriptionCreated, types.WebhookEventPayload{SubscriptionID: sub.ID})
+
+		// Nothing is charged in advance for the trial
+		if trialing {
+			return nil
+		}
+
 		// Create invoice for the subscription (in case it has advance charges)
 		_, err = invoiceService.CreateSubscriptionInvoice(ctx, &dto.CreateSubscriptionInvoiceRequest{
 			SubscriptionID: sub.ID,
@@ -269,7 +266,7 @@ func (s *subscriptionService) GetSubscription(ctx context.Context, id string) (*
 	}
 
 	// expand plan
-	planService := NewPlanService(s.DB, s.PlanRepo, s.PriceRepo, s.MeterRepo, s.EntitlementRepo, s.FeatureRepo, s.Logger)
+	planService := NewPlanService(s.DB, s.PlanRepo, s.PriceRepo, s.MeterRepo, s.EntitlementRepo, s.FeatureRepo, s.SubRepo, s.Logger)
 
 	plan, err := planService.GetPlan(ctx, subscription.PlanID)
 	if err != nil {
@@ -278,7 +275,7 @@ func (s *subscriptionService) GetSubscription(ctx context.Context, id string) (*
 	response.Plan = plan
 
 	// expand customer
-	customerService := NewCustomerService(s.CustomerRepo)
+	customerService := NewCustomerService(s.ServiceParams)
 	customer, err := customerService.GetCustomer(ctx, subscription.CustomerID)
 	if err != nil {
 		return nil, err
@@ -312,15 +309,571 @@ func (s *subscriptionService) CancelSubscription(ctx context.Context, id string,
 		subscription.CancelAt = nil
 	}
 
-	if err := s.SubRepo.Update(ctx, subscription); err != nil {
-		return err
+	return s.DB.WithTx(ctx, func(tx context.Context) error {
+		if err := s.SubRepo.Update(tx, subscription); err != nil {
+			return err
+		}
+
+		// Cancellations at period end only take effect when the period is processed
+		if cancelAtPeriodEnd {
+			s.publishWebhookEvent(tx, types.WebhookEventSubscriptionUpdated, types.WebhookEventPayload{SubscriptionID: subscription.ID})
+		} else {
+			s.publishWebhookEvent(tx, types.WebhookEventSubscriptionCancelled, types.WebhookEventPayload{SubscriptionID: subscription.ID})
+		}
+		return nil
+	})
+}
+
+func (s *subscriptionService) ChangePlan(ctx context.Context, id string, req dto.ChangeSubscriptionPlanRequest) (*dto.ChangeSubscriptionPlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
 	}
 
-	return nil
+	sub, _, err := s.SubRepo.GetWithLineItems(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.SubscriptionStatus != types.SubscriptionStatusActive {
+		return nil, ierr.NewError("subscription is not active").
+			WithHint("Only active subscriptions can change plan").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id":     id,
+				"subscription_status": sub.SubscriptionStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if sub.PlanID == req.PlanID {
+		return nil, ierr.NewError("subscription is already on the plan").
+			WithHint("Please choose a different plan").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": id,
+				"plan_id":         req.PlanID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	now := time.Now().UTC()
+	if now.Before(sub.CurrentPeriodStart) || !now.Before(sub.CurrentPeriodEnd) {
+		return nil, ierr.NewError("subscription billing period is not current").
+			WithHint("The plan can be changed once the billing period of the subscription has been updated").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id":      id,
+				"current_period_start": sub.CurrentPeriodStart,
+				"current_period_end":   sub.CurrentPeriodEnd,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	newPlan, err := s.PlanRepo.Get(ctx, req.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if newPlan.Status != types.StatusPublished {
+		return nil, ierr.NewError("plan is not active").
+			WithHint("The plan must be active to change to it").
+			WithReportableDetails(map[string]interface{}{
+				"plan_id": req.PlanID,
+				"status":  newPlan.Status,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	priceService := NewPriceService(s.DB, s.PriceRepo, s.PlanRepo, s.MeterRepo, s.SubRepo, s.Logger)
+	priceFilter := types.NewNoLimitPriceFilter().
+		WithPlanIDs([]string{newPlan.ID}).
+		WithStatus(types.StatusPublished).
+		WithExpand(string(types.ExpandMeters))
+	pricesResponse, err := priceService.GetPrices(ctx, priceFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	validPrices := filterValidPricesForSubscription(pricesResponse.Items, sub)
+	if len(validPrices) == 0 {
+		return nil, ierr.NewError("no valid prices found for subscription").
+			WithHint("The plan has no prices matching the currency and billing period of the subscription").
+			WithReportableDetails(map[string]interface{}{
+				"plan_id":         req.PlanID,
+				"currency":        sub.Currency,
+				"billing_period":  sub.BillingPeriod,
+				"billing_cadence": sub.BillingCadence,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	endedItems := sub.LineItems
+	newItems := buildSubscriptionLineItems(ctx, sub, newPlan, validPrices, now)
+	carryOverQuantities(endedItems, newItems)
+	prorate := req.ProrationBehavior == types.ProrationBehaviorCreateProrations
+	previousPlanID := sub.PlanID
+
+	response := &dto.ChangeSubscriptionPlanResponse{
+		ChangedAt:      now,
+		ProratedCredit: decimal.Zero,
+	}
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		billingService := NewBillingService(s.ServiceParams)
+		invoiceService := NewInvoiceService(s.ServiceParams)
+
+		// The settlement is computed before the previous line items are ended so their
+		// usage up to the change is still attributed to them
+		invoiceReq, err := billingService.PreparePlanChangeInvoiceRequest(ctx, sub, endedItems, newItems, now, prorate)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range endedItems {
+			item.EndDate = now
+		}
+		sub.PlanID = newPlan.ID
+		sub.PlanVersion = newPlan.Version
+		sub.PendingPlanVersion = nil
+
+		if err := s.SubRepo.ReplaceLineItems(ctx, sub, endedItems, newItems); err != nil {
+			return err
+		}
+		sub.LineItems = newItems
+
+		if invoiceReq != nil {
+			invoiceReq.Metadata["previous_plan_id"] = previousPlanID
+			invoiceReq.Metadata["plan_id"] = newPlan.ID
+
+			inv, err := invoiceService.CreateInvoice(ctx, *invoiceReq)
+			if err != nil {
+				return err
+			}
+
+			// Deferred plan change invoices are finalized with the invoice of the period end
+			if req.InvoiceTiming == types.PlanChangeInvoiceTimingImmediate {
+				if err := invoiceService.ProcessDraftInvoice(ctx, inv.ID); err != nil {
+					return err
+				}
+
+				inv, err = invoiceService.GetInvoice(ctx, inv.ID)
+				if err != nil {
+					return err
+				}
+			}
+			response.Invoice = inv
+		}
+
+		if prorate {
+			notes, err := s.creditUnusedTime(ctx, sub, endedItems, now)
+			if err != nil {
+				return err
+			}
+
+			for _, note := range notes {
+				response.ProratedCredit = response.ProratedCredit.Add(note.TotalAmount)
+			}
+			response.CreditNotes = notes
+		}
+
+		s.publishWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, types.WebhookEventPayload{SubscriptionID: sub.ID})
+		return nil
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to change subscription plan",
+			"error", err,
+			"subscription_id", id,
+			"plan_id", req.PlanID)
+		return nil, err
+	}
+
+	s.Logger.Infow("changed subscription plan",
+		"subscription_id", id,
+		"previous_plan_id", previousPlanID,
+		"plan_id", newPlan.ID,
+		"changed_at", now,
+		"prorated_credit", response.ProratedCredit)
+
+	response.Subscription = &dto.SubscriptionResponse{Subscription: sub}
+	return response, nil
+}
+
+// creditUnusedTime issues credit notes for the unused part of the current period of the fixed advance
+// prices of the ended line items, against the invoices that charged them
+func (s *subscriptionService) creditUnusedTime(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	endedItems []*subscription.SubscriptionLineItem,
+	changedAt time.Time,
+) ([]*dto.CreditNoteResponse, error) {
+	advancePriceIDs := make(map[string]bool)
+	for _, item := range endedItems {
+		if item.PriceType == types.PRICE_TYPE_FIXED && item.InvoiceCadence == types.InvoiceCadenceAdvance {
+			advancePriceIDs[item.PriceID] = true
+		}
+	}
+
+	if len(advancePriceIDs) == 0 {
+		return nil, nil
+	}
+
+	filter := types.NewNoLimitInvoiceFilter()
+	filter.SubscriptionID = sub.ID
+	filter.InvoiceType = types.InvoiceTypeSubscription
+	filter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusFinalized}
+
+	invoices, err := s.InvoiceRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	factor := calculateProrationFactor(sub.CurrentPeriodStart, sub.CurrentPeriodEnd, changedAt, sub.CurrentPeriodEnd)
+	precision := types.GetCurrencyPrecision(sub.Currency)
+	creditNoteService := NewCreditNoteService(s.ServiceParams)
+
+	notes := make([]*dto.CreditNoteResponse, 0)
+	for _, inv := range invoices {
+		lineItems := make([]dto.CreateCreditNoteLineItemRequest, 0)
+		total := decimal.Zero
+
+		for _, item := range inv.LineItems {
+			if !advancePriceIDs[lo.FromPtr(item.PriceID)] ||
+				item.PeriodStart == nil || !item.PeriodStart.Equal(sub.CurrentPeriodStart) ||
+				item.PeriodEnd == nil || !item.PeriodEnd.Equal(sub.CurrentPeriodEnd) {
+				continue
+			}
+
+			amount := item.Amount.Mul(factor).Round(precision)
+			if !amount.IsPositive() {
+				continue
+			}
+
+			lineItems = append(lineItems, dto.CreateCreditNoteLineItemRequest{
+				InvoiceLineItemID: lo.ToPtr(item.ID),
+				DisplayName:       fmt.Sprintf("Unused time on %s", lo.FromPtr(item.DisplayName)),
+				Amount:            amount,
+			})
+			total = total.Add(amount)
+		}
+
+		if len(lineItems) == 0 {
+			continue
+		}
+
+		outcome, err := s.getUnusedTimeCreditOutcome(ctx, inv, total)
+		if err != nil {
+			return nil, err
+		}
+
+		note, err := creditNoteService.CreateCreditNote(ctx, dto.CreateCreditNoteRequest{
+			InvoiceID: inv.ID,
+			Reason:    types.CreditNoteReasonOrderChange,
+			Outcome:   outcome,
+			Memo:      fmt.Sprintf("Plan change on %s", changedAt.Format(time.RFC3339)),
+			LineItems: lineItems,
+			Metadata: types.Metadata{
+				"subscription_id": sub.ID,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, note)
+	}
+
+	return notes, nil
+}
+
+// getUnusedTimeCreditOutcome reduces the amount remaining of an unpaid invoice, otherwise the credit
+// goes to a wallet of the customer or is refunded to the invoice payment when the customer has none
+func (s *subscriptionService) getUnusedTimeCreditOutcome(ctx context.Context, inv *invoice.Invoice, amount decimal.Decimal) (types.CreditNoteOutcome, error) {
+	if inv.AmountRemaining.GreaterThanOrEqual(amount) {
+		return types.CreditNoteOutcomeReduceAmountRemaining, nil
+	}
+
+	wallets, err := s.WalletRepo.GetWalletsByCustomerID(ctx, inv.CustomerID)
+	if err != nil {
+		return "", err
+	}
+
+	_, hasWallet := lo.Find(wallets, func(w *wallet.Wallet) bool {
+		return w.WalletStatus == types.WalletStatusActive && types.IsMatchingCurrency(w.Currency, inv.Currency)
+	})
+	if hasWallet {
+		return types.CreditNoteOutcomeWalletCredit, nil
+	}
+
+	return types.CreditNoteOutcomeRefund, nil
+}
+
+// UpdateLineItemQuantity changes the quantity of a fixed price line item from the effective date. Increases of
+// charges invoiced in advance are prorated on the next invoice, decreases are credited right away as the
+// invoice of the current period is already issued
+func (s *subscriptionService) UpdateLineItemQuantity(ctx context.Context, subscriptionID, lineItemID string, req dto.UpdateLineItemQuantityRequest) (*dto.UpdateLineItemQuantityResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	sub, lineItems, err := s.SubRepo.GetWithLineItems(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.SubscriptionStatus != types.SubscriptionStatusActive {
+		return nil, ierr.NewError("subscription is not active").
+			WithHint("Only the quantities of active subscriptions can be changed").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id":     subscriptionID,
+				"subscription_status": sub.SubscriptionStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	item, ok := lo.Find(lineItems, func(li *subscription.SubscriptionLineItem) bool {
+		return li.ID == lineItemID
+	})
+	if !ok {
+		return nil, ierr.NewError("subscription line item not found").
+			WithHintf("Subscription line item %s not found", lineItemID).
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": subscriptionID,
+				"line_item_id":    lineItemID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	if item.PriceType != types.PRICE_TYPE_FIXED {
+		return nil, ierr.NewError("only fixed price line items have a quantity").
+			WithHint("The quantity of usage based line items is derived from the usage").
+			WithReportableDetails(map[string]interface{}{
+				"line_item_id": lineItemID,
+				"price_type":   item.PriceType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if item.Quantity.Equal(req.Quantity) {
+		return nil, ierr.NewError("line item already has the quantity").
+			WithHint("Please choose a different quantity").
+			WithReportableDetails(map[string]interface{}{
+				"line_item_id": lineItemID,
+				"quantity":     req.Quantity,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	effectiveDate := time.Now().UTC()
+	if req.EffectiveDate != nil {
+		effectiveDate = req.EffectiveDate.UTC()
+	}
+
+	if effectiveDate.Before(sub.CurrentPeriodStart) || !effectiveDate.Before(sub.CurrentPeriodEnd) ||
+		effectiveDate.Before(item.StartDate) {
+		return nil, ierr.NewError("effective date is outside the current billing period").
+			WithHint("The quantity can only be changed within the current billing period").
+			WithReportableDetails(map[string]interface{}{
+				"effective_date":       effectiveDate,
+				"current_period_start": sub.CurrentPeriodStart,
+				"current_period_end":   sub.CurrentPeriodEnd,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	// Changes apply on top of each other, a change can't take effect before an earlier one
+	changes, err := s.SubRepo.ListQuantityChanges(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range changes {
+		if c.SubscriptionLineItemID == item.ID && effectiveDate.Before(c.EffectiveDate) {
+			return nil, ierr.NewError("effective date is before the last quantity change").
+				WithHint("The quantity can't be changed before an earlier change took effect").
+				WithReportableDetails(map[string]interface{}{
+					"effective_date":      effectiveDate,
+					"last_effective_date": c.EffectiveDate,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	change := &subscription.SubscriptionQuantityChange{
+		ID:                     types.GenerateUUIDWithPrefix(types.UUID_PREFIX_QUANTITY_CHANGE),
+		SubscriptionID:         sub.ID,
+		SubscriptionLineItemID: item.ID,
+		PriceID:                item.PriceID,
+		PreviousQuantity:       item.Quantity,
+		Quantity:               req.Quantity,
+		EffectiveDate:          effectiveDate,
+		Metadata:               req.Metadata,
+		EnvironmentID:          types.GetEnvironmentID(ctx),
+		BaseModel:              types.GetDefaultBaseModel(ctx),
+	}
+
+	response := &dto.UpdateLineItemQuantityResponse{}
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		item.Quantity = req.Quantity
+		if err := s.SubRepo.UpdateLineItemQuantity(ctx, item, change); err != nil {
+			return err
+		}
+
+		s.publishWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, types.WebhookEventPayload{SubscriptionID: subscriptionID})
+
+		if change.IsIncrease() || item.InvoiceCadence != types.InvoiceCadenceAdvance {
+			return nil
+		}
+
+		note, err := s.creditQuantityDecrease(ctx, sub, change)
+		if err != nil {
+			return err
+		}
+
+		if note != nil {
+			change.CreditNoteID = lo.ToPtr(note.ID)
+			if err := s.SubRepo.UpdateQuantityChange(ctx, change); err != nil {
+				return err
+			}
+		}
+		response.CreditNote = note
+		return nil
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to update line item quantity",
+			"error", err,
+			"subscription_id", subscriptionID,
+			"line_item_id", lineItemID)
+		return nil, err
+	}
+
+	s.Logger.Infow("updated line item quantity",
+		"subscription_id", subscriptionID,
+		"line_item_id", lineItemID,
+		"previous_quantity", change.PreviousQuantity,
+		"quantity", change.Quantity,
+		"effective_date", effectiveDate)
+
+	response.LineItem = item
+	response.Change = &dto.SubscriptionQuantityChangeResponse{SubscriptionQuantityChange: change}
+	return response, nil
+}
+
+// creditQuantityDecrease credits the unused time of the removed quantity against the invoice that charged
+// the line item in advance for the current period
+func (s *subscriptionService) creditQuantityDecrease(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	change *subscription.SubscriptionQuantityChange,
+) (*dto.CreditNoteResponse, error) {
+	filter := types.NewNoLimitInvoiceFilter()
+	filter.SubscriptionID = sub.ID
+	filter.InvoiceType = types.InvoiceTypeSubscription
+	filter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusFinalized}
+
+	invoices, err := s.InvoiceRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var inv *invoice.Invoice
+	var lineItem *invoice.InvoiceLineItem
+	for _, i := range invoices {
+		for _, li := range i.LineItems {
+			if lo.FromPtr(li.PriceID) == change.PriceID &&
+				li.PeriodEnd != nil && li.PeriodEnd.Equal(sub.CurrentPeriodEnd) {
+				inv, lineItem = i, li
+				break
+			}
+		}
+		if inv != nil {
+			break
+		}
+	}
+
+	// Nothing was charged in advance for the period, e.g. the first invoice is still pending
+	if inv == nil {
+		return nil, nil
+	}
+
+	priceService := NewPriceService(s.DB, s.PriceRepo, s.PlanRepo, s.MeterRepo, s.SubRepo, s.Logger)
+	price, err := priceService.GetPrice(ctx, change.PriceID)
+	if err != nil {
+		return nil, err
+	}
+
+	factor := calculateProrationFactor(sub.CurrentPeriodStart, sub.CurrentPeriodEnd, change.EffectiveDate, sub.CurrentPeriodEnd)
+	amount := priceService.CalculateCost(ctx, price.Price, change.PreviousQuantity).
+		Sub(priceService.CalculateCost(ctx, price.Price, change.Quantity)).
+		Mul(factor).
+		Round(types.GetCurrencyPrecision(sub.Currency))
+
+	// The removed quantity may include increases that are not invoiced yet, only what was charged is credited
+	creditNoteFilter := types.NewNoLimitCreditNoteFilter()
+	creditNoteFilter.InvoiceID = inv.ID
+	existing, err := s.CreditNoteRepo.List(ctx, creditNoteFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	creditable := lineItem.Amount
+	for _, n := range existing {
+		for _, li := range n.LineItems {
+			if lo.FromPtr(li.InvoiceLineItemID) == lineItem.ID {
+				creditable = creditable.Sub(li.Amount)
+			}
+		}
+	}
+	amount = decimal.Min(amount, creditable)
+
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	outcome, err := s.getUnusedTimeCreditOutcome(ctx, inv, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	creditNoteService := NewCreditNoteService(s.ServiceParams)
+	return creditNoteService.CreateCreditNote(ctx, dto.CreateCreditNoteRequest{
+		InvoiceID: inv.ID,
+		Reason:    types.CreditNoteReasonOrderChange,
+		Outcome:   outcome,
+		Memo: fmt.Sprintf("Quantity change from %s to %s on %s",
+			change.PreviousQuantity.String(), change.Quantity.String(), change.EffectiveDate.Format(time.RFC3339)),
+		LineItems: []dto.CreateCreditNoteLineItemRequest{
+			{
+				InvoiceLineItemID: lo.ToPtr(lineItem.ID),
+				DisplayName:       fmt.Sprintf("Unused time on %s", lo.FromPtr(lineItem.DisplayName)),
+				Amount:            amount,
+			},
+		},
+		Metadata: types.Metadata{
+			"subscription_id":    sub.ID,
+			"quantity_change_id": change.ID,
+		},
+	})
+}
+
+// ListQuantityChanges lists the quantity changes of a subscription, optionally of a single line item
+func (s *subscriptionService) ListQuantityChanges(ctx context.Context, subscriptionID, lineItemID string) (*dto.ListSubscriptionQuantityChangesResponse, error) {
+	// Validate the subscription exists
+	if _, err := s.SubRepo.Get(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	changes, err := s.SubRepo.ListQuantityChanges(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if lineItemID != "" {
+		changes = lo.Filter(changes, func(c *subscription.SubscriptionQuantityChange, _ int) bool {
+			return c.SubscriptionLineItemID == lineItemID
+		})
+	}
+
+	return dto.NewListSubscriptionQuantityChangesResponse(changes), nil
 }
 
 func (s *subscriptionService) ListSubscriptions(ctx context.Context, filter *types.SubscriptionFilter) (*dto.ListSubscriptionsResponse, error) {
-	planService := NewPlanService(s.DB, s.PlanRepo, s.PriceRepo, s.MeterRepo, s.EntitlementRepo, s.FeatureRepo, s.Logger)
+	planService := NewPlanService(s.DB, s.PlanRepo, s.PriceRepo, s.MeterRepo, s.EntitlementRepo, s.FeatureRepo, s.SubRepo, s.Logger)
 
 	subscriptions, err := s.SubRepo.List(ctx, filter)
 	if err != nil {
@@ -375,7 +928,7 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 	response := &dto.GetUsageBySubscriptionResponse{}
 
 	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventPublisher, s.Logger)
-	priceService := NewPriceService(s.PriceRepo, s.MeterRepo, s.Logger)
+	priceService := NewPriceService(s.DB, s.PriceRepo, s.PlanRepo, s.MeterRepo, s.SubRepo, s.Logger)
 
 	// Get subscription with line items
 	subscription, lineItems, err := s.SubRepo.GetWithLineItems(ctx, req.SubscriptionID)
@@ -597,6 +1150,11 @@ func (s *subscriptionService) UpdateBillingPeriods(ctx context.Context) (*dto.Su
 	s.Logger.Infow("starting billing period updates",
 		"current_time", now)
 
+	if err := s.notifyTrialsEnding(ctx, now); err != nil {
+		s.Logger.Errorw("failed to notify trials ending",
+			"error", err)
+	}
+
 	response := &dto.SubscriptionUpdatePeriodResponse{
 		Items:        make([]*dto.SubscriptionUpdatePeriodResponseItem, 0),
 		TotalFailed:  0,
@@ -612,7 +1170,11 @@ func (s *subscriptionService) UpdateBillingPeriods(ctx context.Context) (*dto.Su
 				Offset: lo.ToPtr(offset),
 				Status: lo.ToPtr(types.StatusPublished),
 			},
-			SubscriptionStatus: []types.SubscriptionStatus{types.SubscriptionStatusActive},
+			SubscriptionStatus: []types.SubscriptionStatus{
+				types.SubscriptionStatusActive,
+				types.SubscriptionStatusTrialing,
+				types.SubscriptionStatusPastDue,
+			},
 			TimeRangeFilter: &types.TimeRangeFilter{
 				EndTime: &now,
 			},
@@ -678,6 +1240,19 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 		return nil
 	}
 
+	// Trials either convert to active or get cancelled once they are over
+	var trialConverted bool
+	if sub.SubscriptionStatus == types.SubscriptionStatusTrialing {
+		converted, err := s.endTrial(ctx, sub, now)
+		if err != nil {
+			return err
+		}
+		if !converted {
+			return nil
+		}
+		trialConverted = true
+	}
+
 	// Check for scheduled pauses that should be activated
 	if sub.PauseStatus == types.PauseStatusScheduled && sub.ActivePauseID != nil {
 		pause, err := s.SubRepo.GetPause(ctx, *sub.ActivePauseID)
@@ -690,12 +1265,7 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 			sub.SubscriptionStatus = types.SubscriptionStatusPaused
 			pause.PauseStatus = types.PauseStatusActive
 
-			// Update the subscription and pause
-			if err := s.SubRepo.Update(ctx, sub); err != nil {
-				return err
-			}
-
-			if err := s.SubRepo.UpdatePause(ctx, pause); err != nil {
+			if err := s.activatePause(ctx, sub, pause); err != nil {
 				return err
 			}
 
@@ -712,12 +1282,7 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 			sub.SubscriptionStatus = types.SubscriptionStatusPaused
 			pause.PauseStatus = types.PauseStatusActive
 
-			// Update the subscription and pause
-			if err := s.SubRepo.Update(ctx, sub); err != nil {
-				return err
-			}
-
-			if err := s.SubRepo.UpdatePause(ctx, pause); err != nil {
+			if err := s.activatePause(ctx, sub, pause); err != nil {
 				return err
 			}
 
@@ -755,11 +1320,19 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 			sub.CurrentPeriodEnd = sub.CurrentPeriodEnd.Add(pauseDuration)
 
 			// Update the subscription and pause
-			if err := s.SubRepo.Update(ctx, sub); err != nil {
-				return err
-			}
+			err := s.DB.WithTx(ctx, func(tx context.Context) error {
+				if err := s.SubRepo.Update(tx, sub); err != nil {
+					return err
+				}
 
-			if err := s.SubRepo.UpdatePause(ctx, pause); err != nil {
+				if err := s.SubRepo.UpdatePause(tx, pause); err != nil {
+					return err
+				}
+
+				s.publishWebhookEvent(tx, types.WebhookEventSubscriptionResumed, types.WebhookEventPayload{SubscriptionID: sub.ID})
+				return nil
+			})
+			if err != nil {
 				return err
 			}
 
@@ -833,6 +1406,16 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 		// Process all periods except the last one (which becomes the new current period)
 		for i := 0; i < len(periods)-1; i++ {
 			period := periods[i]
+			cancelAtPeriodEnd := sub.CancelAtPeriodEnd && sub.CancelAt != nil && !sub.CancelAt.After(period.end)
+
+			// Subscriptions scheduled to move to a new plan version are migrated at the end of
+			// the current period, the migration creates the invoices of the period
+			if i == 0 && sub.PendingPlanVersion != nil && !cancelAtPeriodEnd {
+				if err := s.migratePlanVersion(ctx, sub, period.end); err != nil {
+					return err
+				}
+				continue
+			}
 
 			// Create a single invoice for both arrear and advance charges at period end
 			inv, err := invoiceService.CreateSubscriptionInvoice(ctx, &dto.CreateSubscriptionInvoiceRequest{
@@ -853,13 +1436,18 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 				"period_index", i)
 
 			// Check for cancellation at this period end
-			if sub.CancelAtPeriodEnd && sub.CancelAt != nil && !sub.CancelAt.After(period.end) {
+			if cancelAtPeriodEnd {
 				sub.SubscriptionStatus = types.SubscriptionStatusCancelled
 				sub.CancelledAt = sub.CancelAt
 				break
 			}
 		}
 
+		// Finalize the plan change invoices deferred to the end of the processed periods
+		if err := s.finalizeDeferredPlanChangeInvoices(ctx, sub, periods[len(periods)-2].end); err != nil {
+			return err
+		}
+
 		// Update to the new current period (last period)
 		newPeriod := periods[len(periods)-1]
 		sub.CurrentPeriodStart = newPeriod.start
@@ -876,6 +1464,13 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 			return err
 		}
 
+		if sub.SubscriptionStatus == types.SubscriptionStatusCancelled {
+			s.publishWebhookEvent(ctx, types.WebhookEventSubscriptionCancelled, types.WebhookEventPayload{SubscriptionID: sub.ID})
+		}
+		if trialConverted {
+			s.publishWebhookEvent(ctx, types.WebhookEventSubscriptionTrialEnded, types.WebhookEventPayload{SubscriptionID: sub.ID})
+		}
+
 		s.Logger.Infow("completed subscription period processing",
 			"subscription_id", sub.ID,
 			"original_period_start", periods[0].start,
@@ -895,9 +1490,31 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 		return err
 	}
 
+	if trialConverted {
+		s.Logger.Infow("converted subscription at trial end",
+			"subscription_id", sub.ID,
+			"trial_end", sub.TrialEnd)
+	}
+
 	return nil
 }
 
+// activatePause pauses the subscription as its pause starts
+func (s *subscriptionService) activatePause(ctx context.Context, sub *subscription.Subscription, pause *subscription.SubscriptionPause) error {
+	return s.DB.WithTx(ctx, func(tx context.Context) error {
+		if err := s.SubRepo.Update(tx, sub); err != nil {
+			return err
+		}
+
+		if err := s.SubRepo.UpdatePause(tx, pause); err != nil {
+			return err
+		}
+
+		s.publishWebhookEvent(tx, types.WebhookEventSubscriptionPaused, types.WebhookEventPayload{SubscriptionID: sub.ID})
+		return nil
+	})
+}
+
 func createChargeResponse(priceObj *price.Price, quantity decimal.Decimal, cost decimal.Decimal, meterDisplayName string) *dto.SubscriptionUsageByMetersResponse {
 	finalAmount := price.FormatAmountToFloat64WithPrecision(cost, priceObj.Currency)
 
@@ -943,6 +1560,179 @@ func filterValidPricesForSubscription(prices []*dto.PriceResponse, subscriptionO
 	return validPrices
 }
 
+// applyPriceOverrides creates the subscription scoped prices of the overrides and returns the prices
+// of the subscription with the plan prices they override replaced
+func applyPriceOverrides(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	prices []*dto.PriceResponse,
+	overrides []dto.SubscriptionPriceOverrideRequest,
+) ([]*dto.PriceResponse, []*price.Price, error) {
+	if len(overrides) == 0 {
+		return prices, nil, nil
+	}
+
+	pricesByID := lo.KeyBy(prices, func(p *dto.PriceResponse) string {
+		return p.ID
+	})
+
+	overridePrices := make([]*price.Price, 0, len(overrides))
+	overridesByPriceID := make(map[string]*dto.PriceResponse, len(overrides))
+	for _, override := range overrides {
+		planPrice, ok := pricesByID[override.PriceID]
+		if !ok {
+			return nil, nil, ierr.NewError("price to override not found").
+				WithHint("Only the prices of the plan matching the subscription can be overridden").
+				WithReportableDetails(map[string]interface{}{
+					"price_id": override.PriceID,
+					"plan_id":  sub.PlanID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		p, err := override.ToPrice(ctx, sub, planPrice.Price)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		overridePrices = append(overridePrices, p)
+		overridesByPriceID[planPrice.ID] = &dto.PriceResponse{Price: p, Meter: planPrice.Meter}
+	}
+
+	result := lo.Map(prices, func(p *dto.PriceResponse, _ int) *dto.PriceResponse {
+		if override, ok := overridesByPriceID[p.ID]; ok {
+			return override
+		}
+		return p
+	})
+
+	return result, overridePrices, nil
+}
+
+// finalizeDeferredPlanChangeInvoices finalizes the draft plan change invoices of the subscription for
+// periods ending by periodEnd
+func (s *subscriptionService) finalizeDeferredPlanChangeInvoices(ctx context.Context, sub *subscription.Subscription, periodEnd time.Time) error {
+	filter := types.NewNoLimitInvoiceFilter()
+	filter.SubscriptionID = sub.ID
+	filter.InvoiceType = types.InvoiceTypeSubscription
+	filter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusDraft}
+
+	invoices, err := s.InvoiceRepo.List(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	invoiceService := NewInvoiceService(s.ServiceParams)
+	for _, inv := range invoices {
+		if inv.BillingReason != string(types.InvoiceBillingReasonSubscriptionUpdate) ||
+			inv.PeriodEnd == nil || inv.PeriodEnd.After(periodEnd) {
+			continue
+		}
+
+		if err := invoiceService.ProcessDraftInvoice(ctx, inv.ID); err != nil {
+			return err
+		}
+
+		s.Logger.Infow("finalized deferred plan change invoice",
+			"subscription_id", sub.ID,
+			"invoice_id", inv.ID)
+	}
+
+	return nil
+}
+
+// buildSubscriptionLineItems creates the line items of the subscription for the prices of the plan
+// taking effect from startDate
+func buildSubscriptionLineItems(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	plan *plan.Plan,
+	prices []*dto.PriceResponse,
+	startDate time.Time,
+) []*subscription.SubscriptionLineItem {
+	lineItems := make([]*subscription.SubscriptionLineItem, 0, len(prices))
+	for _, price := range prices {
+		item := &subscription.SubscriptionLineItem{
+			ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
+			SubscriptionID:  sub.ID,
+			CustomerID:      sub.CustomerID,
+			PlanID:          plan.ID,
+			PlanDisplayName: plan.Name,
+			PriceID:         price.ID,
+			PriceType:       price.Type,
+			Currency:        sub.Currency,
+			BillingPeriod:   sub.BillingPeriod,
+			InvoiceCadence:  price.InvoiceCadence,
+			TrialPeriod:     price.TrialPeriod,
+			StartDate:       startDate,
+			EnvironmentID:   types.GetEnvironmentID(ctx),
+			BaseModel:       types.GetDefaultBaseModel(ctx),
+		}
+
+		if price.Type == types.PRICE_TYPE_USAGE && price.Meter != nil {
+			item.MeterID = price.Meter.ID
+			item.MeterDisplayName = price.Meter.Name
+			item.DisplayName = price.Meter.Name
+			item.Quantity = decimal.Zero
+		} else {
+			item.DisplayName = plan.Name
+			item.Quantity = decimal.NewFromInt(1)
+		}
+
+		if sub.EndDate != nil {
+			item.EndDate = *sub.EndDate
+		}
+
+		lineItems = append(lineItems, item)
+	}
+
+	return lineItems
+}
+
+// carryOverQuantities copies the quantities of the previous line items onto the line items
+// replacing them, so a plan change keeps the seats of the customer. A new line item takes the
+// quantity of the previous line item of the same price or else of the same meter. The fixed
+// line items of different prices are matched only when each side has a single one, as
+// otherwise it is ambiguous which seats move to which price.
+func carryOverQuantities(previous, next []*subscription.SubscriptionLineItem) {
+	used := make(map[string]bool, len(previous))
+	match := func(pred func(item *subscription.SubscriptionLineItem) bool) *subscription.SubscriptionLineItem {
+		for _, item := range previous {
+			if !used[item.ID] && pred(item) {
+				used[item.ID] = true
+				return item
+			}
+		}
+		return nil
+	}
+
+	var unmatched []*subscription.SubscriptionLineItem
+	for _, item := range next {
+		prev := match(func(p *subscription.SubscriptionLineItem) bool { return p.PriceID == item.PriceID })
+		if prev == nil && item.MeterID != "" {
+			prev = match(func(p *subscription.SubscriptionLineItem) bool { return p.MeterID == item.MeterID })
+		}
+		if prev == nil {
+			unmatched = append(unmatched, item)
+			continue
+		}
+		item.Quantity = prev.Quantity
+	}
+
+	isFixed := func(item *subscription.SubscriptionLineItem) bool {
+		return item.PriceType == types.PRICE_TYPE_FIXED
+	}
+	remaining := lo.Filter(previous, func(item *subscription.SubscriptionLineItem, _ int) bool {
+		return !used[item.ID] && isFixed(item)
+	})
+	unmatchedFixed := lo.Filter(unmatched, func(item *subscription.SubscriptionLineItem, _ int) bool {
+		return isFixed(item)
+	})
+	if len(remaining) == 1 && len(unmatchedFixed) == 1 {
+		unmatchedFixed[0].Quantity = remaining[0].Quantity
+	}
+}
+
 func calculatePriority(filterValues map[string][]string) int {
 	priority := 0
 	for _, values := range filterValues {
@@ -1069,6 +1859,12 @@ func (s *subscriptionService) executePause(
 			return err
 		}
 
+		// Scheduled pauses only pause the subscription once they are activated
+		if sub.SubscriptionStatus == types.SubscriptionStatusPaused {
+			s.publishWebhookEvent(txCtx, types.WebhookEventSubscriptionPaused, types.WebhookEventPayload{SubscriptionID: sub.ID})
+		} else {
+			s.publishWebhookEvent(txCtx, types.WebhookEventSubscriptionUpdated, types.WebhookEventPayload{SubscriptionID: sub.ID})
+		}
 		return nil
 	})
 
@@ -1150,7 +1946,7 @@ func (s *subscriptionService) ResumeSubscription(
 		}, nil
 	}
 
-	// Resume the subscription
+	// Resume the subscription, resuming a scheduled pause only cancels the pause
 	sub, activePause, err = s.executeResume(ctx, sub, activePause, req)
 	if err != nil {
 		return nil, err
@@ -1192,7 +1988,8 @@ func (s *subscriptionService) executeResume(
 	sub.ActivePauseID = nil
 
 	// Only change subscription status if it was paused
-	if sub.SubscriptionStatus == types.SubscriptionStatusPaused {
+	wasPaused := sub.SubscriptionStatus == types.SubscriptionStatusPaused
+	if wasPaused {
 		sub.SubscriptionStatus = types.SubscriptionStatusActive
 	}
 
@@ -1211,6 +2008,11 @@ func (s *subscriptionService) executeResume(
 			return err
 		}
 
+		if wasPaused {
+			s.publishWebhookEvent(txCtx, types.WebhookEventSubscriptionResumed, types.WebhookEventPayload{SubscriptionID: sub.ID})
+		} else {
+			s.publishWebhookEvent(txCtx, types.WebhookEventSubscriptionUpdated, types.WebhookEventPayload{SubscriptionID: sub.ID})
+		}
 		return nil
 	})
 
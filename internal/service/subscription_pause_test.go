@@ -53,6 +53,9 @@ func (s *SubscriptionPauseTestSuite) SetupTest() {
 		InvoiceRepo:      s.GetStores().InvoiceRepo,
 		EntitlementRepo:  s.GetStores().EntitlementRepo,
 		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
 		FeatureRepo:      s.GetStores().FeatureRepo,
 		TenantRepo:       s.GetStores().TenantRepo,
 		UserRepo:         s.GetStores().UserRepo,
@@ -351,6 +354,44 @@ func (s *SubscriptionPauseTestSuite) TestResumeSubscription() {
 	}
 }
 
+func (s *SubscriptionPauseTestSuite) TestPauseAndResumeWebhookEvents() {
+	ctx := s.GetContext()
+	subID := s.pauseTestData.activeSubscription.ID
+
+	// Scheduled pauses only update the subscription until they are activated
+	_, err := s.service.PauseSubscription(ctx, subID, &dto.PauseSubscriptionRequest{
+		PauseMode: types.PauseModePeriodEnd,
+		PauseDays: lo.ToPtr(10),
+	})
+	s.NoError(err)
+	_, err = s.service.ResumeSubscription(ctx, subID, &dto.ResumeSubscriptionRequest{
+		ResumeMode: types.ResumeModeImmediate,
+	})
+	s.NoError(err)
+
+	_, err = s.service.PauseSubscription(ctx, subID, &dto.PauseSubscriptionRequest{
+		PauseMode: types.PauseModeImmediate,
+		PauseDays: lo.ToPtr(10),
+	})
+	s.NoError(err)
+	_, err = s.service.ResumeSubscription(ctx, subID, &dto.ResumeSubscriptionRequest{
+		ResumeMode: types.ResumeModeImmediate,
+	})
+	s.NoError(err)
+
+	s.NoError(s.service.CancelSubscription(ctx, subID, false))
+
+	s.Equal([]string{
+		types.WebhookEventSubscriptionUpdated,
+		types.WebhookEventSubscriptionUpdated,
+		types.WebhookEventSubscriptionPaused,
+		types.WebhookEventSubscriptionResumed,
+		types.WebhookEventSubscriptionCancelled,
+	}, lo.Map(s.GetWebhookEvents(), func(event *types.WebhookEvent, _ int) string {
+		return event.EventName
+	}))
+}
+
 func (s *SubscriptionPauseTestSuite) TestGetPause() {
 	// Ensure we have a valid context
 	ctx := s.GetContext()
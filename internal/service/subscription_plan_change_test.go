@@ -0,0 +1,340 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubscriptionPlanChangeTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service  SubscriptionService
+	testData struct {
+		customer     *customer.Customer
+		basicPlan    *plan.Plan
+		proPlan      *plan.Plan
+		basicPrice   *price.Price
+		proPrice     *price.Price
+		subscription *subscription.Subscription
+		invoice      *invoice.Invoice
+	}
+}
+
+func TestSubscriptionPlanChange(t *testing.T) {
+	suite.Run(t, new(SubscriptionPlanChangeTestSuite))
+}
+
+func (s *SubscriptionPlanChangeTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	s.service = NewSubscriptionService(ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		PlanRepo:         s.GetStores().PlanRepo,
+		PriceRepo:        s.GetStores().PriceRepo,
+		EventRepo:        s.GetStores().EventRepo,
+		MeterRepo:        s.GetStores().MeterRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		EntitlementRepo:  s.GetStores().EntitlementRepo,
+		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
+		FeatureRepo:      s.GetStores().FeatureRepo,
+		TenantRepo:       s.GetStores().TenantRepo,
+		UserRepo:         s.GetStores().UserRepo,
+		AuthRepo:         s.GetStores().AuthRepo,
+		WalletRepo:       s.GetStores().WalletRepo,
+		PaymentRepo:      s.GetStores().PaymentRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	})
+	s.setupTestData()
+}
+
+func (s *SubscriptionPlanChangeTestSuite) setupTestData() {
+	ctx := s.GetContext()
+	now := time.Now().UTC()
+	periodStart := now.Add(-10 * 24 * time.Hour)
+	periodEnd := now.Add(20 * 24 * time.Hour)
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_plan_change",
+		ExternalID: "ext_cust_plan_change",
+		Name:       "Test Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	s.testData.basicPlan = &plan.Plan{
+		ID:        "plan_basic",
+		Name:      "Basic",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, s.testData.basicPlan))
+
+	s.testData.proPlan = &plan.Plan{
+		ID:        "plan_pro",
+		Name:      "Pro",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, s.testData.proPlan))
+
+	s.testData.basicPrice = s.createFixedPrice("price_basic", s.testData.basicPlan.ID, decimal.NewFromInt(30))
+	s.testData.proPrice = s.createFixedPrice("price_pro", s.testData.proPlan.ID, decimal.NewFromInt(60))
+
+	s.testData.subscription = &subscription.Subscription{
+		ID:                 "sub_plan_change",
+		PlanID:             s.testData.basicPlan.ID,
+		CustomerID:         s.testData.customer.ID,
+		StartDate:          periodStart,
+		BillingAnchor:      periodStart,
+		CurrentPeriodStart: periodStart,
+		CurrentPeriodEnd:   periodEnd,
+		Currency:           "usd",
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	lineItems := []*subscription.SubscriptionLineItem{
+		{
+			ID:              "subs_line_basic",
+			SubscriptionID:  s.testData.subscription.ID,
+			CustomerID:      s.testData.customer.ID,
+			PlanID:          s.testData.basicPlan.ID,
+			PlanDisplayName: s.testData.basicPlan.Name,
+			PriceID:         s.testData.basicPrice.ID,
+			PriceType:       types.PRICE_TYPE_FIXED,
+			DisplayName:     s.testData.basicPlan.Name,
+			Quantity:        decimal.NewFromInt(1),
+			Currency:        "usd",
+			BillingPeriod:   types.BILLING_PERIOD_MONTHLY,
+			InvoiceCadence:  types.InvoiceCadenceAdvance,
+			StartDate:       periodStart,
+			BaseModel:       types.GetDefaultBaseModel(ctx),
+		},
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(ctx, s.testData.subscription, lineItems))
+
+	// The advance charge of the basic plan for the current period
+	s.testData.invoice = &invoice.Invoice{
+		ID:              "inv_plan_change",
+		CustomerID:      s.testData.customer.ID,
+		SubscriptionID:  lo.ToPtr(s.testData.subscription.ID),
+		InvoiceType:     types.InvoiceTypeSubscription,
+		InvoiceStatus:   types.InvoiceStatusFinalized,
+		PaymentStatus:   types.PaymentStatusPending,
+		InvoiceNumber:   lo.ToPtr("INV-202501-00001"),
+		Currency:        "usd",
+		AmountDue:       decimal.NewFromInt(30),
+		AmountPaid:      decimal.Zero,
+		AmountRemaining: decimal.NewFromInt(30),
+		Subtotal:        decimal.NewFromInt(30),
+		Total:           decimal.NewFromInt(30),
+		PeriodStart:     &periodStart,
+		PeriodEnd:       &periodEnd,
+		LineItems: []*invoice.InvoiceLineItem{
+			{
+				ID:             "inv_line_basic",
+				InvoiceID:      "inv_plan_change",
+				CustomerID:     s.testData.customer.ID,
+				SubscriptionID: lo.ToPtr(s.testData.subscription.ID),
+				PriceID:        lo.ToPtr(s.testData.basicPrice.ID),
+				DisplayName:    lo.ToPtr(s.testData.basicPlan.Name),
+				Amount:         decimal.NewFromInt(30),
+				Quantity:       decimal.NewFromInt(1),
+				Currency:       "usd",
+				PeriodStart:    &periodStart,
+				PeriodEnd:      &periodEnd,
+				BaseModel:      types.GetDefaultBaseModel(ctx),
+			},
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().InvoiceRepo.CreateWithLineItems(ctx, s.testData.invoice))
+}
+
+func (s *SubscriptionPlanChangeTestSuite) createFixedPrice(id, planID string, amount decimal.Decimal) *price.Price {
+	p := &price.Price{
+		ID:                 id,
+		Amount:             amount,
+		Currency:           "usd",
+		PlanID:             planID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		BaseModel:          types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(s.GetContext(), p))
+	return p
+}
+
+func (s *SubscriptionPlanChangeTestSuite) TestChangePlanWithProrations() {
+	ctx := s.GetContext()
+
+	resp, err := s.service.ChangePlan(ctx, s.testData.subscription.ID, dto.ChangeSubscriptionPlanRequest{
+		PlanID: s.testData.proPlan.ID,
+	})
+	s.NoError(err)
+	s.Equal(s.testData.proPlan.ID, resp.Subscription.PlanID)
+
+	// Two thirds of the period remain, the pro plan is charged for them
+	s.NotNil(resp.Invoice)
+	s.Equal(types.InvoiceStatusFinalized, resp.Invoice.InvoiceStatus)
+	s.Equal(types.InvoiceBillingReasonSubscriptionUpdate, types.InvoiceBillingReason(resp.Invoice.BillingReason))
+	s.True(decimal.NewFromInt(40).Equal(resp.Invoice.Total), "expected 40, got %s", resp.Invoice.Total)
+
+	// and the unused time of the basic plan is deducted from its unpaid invoice
+	s.Len(resp.CreditNotes, 1)
+	s.Equal(types.CreditNoteReasonOrderChange, resp.CreditNotes[0].Reason)
+	s.Equal(types.CreditNoteOutcomeReduceAmountRemaining, resp.CreditNotes[0].Outcome)
+	s.True(decimal.NewFromInt(20).Equal(resp.ProratedCredit), "expected 20, got %s", resp.ProratedCredit)
+
+	inv, err := s.GetStores().InvoiceRepo.Get(ctx, s.testData.invoice.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(10).Equal(inv.AmountRemaining), "expected 10, got %s", inv.AmountRemaining)
+
+	sub, lineItems, err := s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, s.testData.subscription.ID)
+	s.NoError(err)
+	s.Equal(s.testData.proPlan.ID, sub.PlanID)
+	s.Len(lineItems, 1)
+	s.Equal(s.testData.proPrice.ID, lineItems[0].PriceID)
+	s.Equal(resp.ChangedAt, lineItems[0].StartDate)
+}
+
+func (s *SubscriptionPlanChangeTestSuite) TestChangePlanCarriesOverQuantity() {
+	ctx := s.GetContext()
+
+	_, lineItems, err := s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, s.testData.subscription.ID)
+	s.NoError(err)
+	s.Len(lineItems, 1)
+	item := lineItems[0]
+	item.Quantity = decimal.NewFromInt(3)
+	s.NoError(s.GetStores().SubscriptionRepo.UpdateLineItemQuantity(ctx, item, &subscription.SubscriptionQuantityChange{
+		ID:                     "subs_qty_basic",
+		SubscriptionID:         s.testData.subscription.ID,
+		SubscriptionLineItemID: item.ID,
+		PriceID:                item.PriceID,
+		PreviousQuantity:       decimal.NewFromInt(1),
+		Quantity:               decimal.NewFromInt(3),
+		EffectiveDate:          s.testData.subscription.CurrentPeriodStart,
+		BaseModel:              types.GetDefaultBaseModel(ctx),
+	}))
+
+	resp, err := s.service.ChangePlan(ctx, s.testData.subscription.ID, dto.ChangeSubscriptionPlanRequest{
+		PlanID: s.testData.proPlan.ID,
+	})
+	s.NoError(err)
+
+	// Three seats of the pro plan are charged for the remaining two thirds of the period
+	s.NotNil(resp.Invoice)
+	s.True(decimal.NewFromInt(120).Equal(resp.Invoice.Total), "expected 120, got %s", resp.Invoice.Total)
+
+	_, lineItems, err = s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, s.testData.subscription.ID)
+	s.NoError(err)
+	s.Len(lineItems, 1)
+	s.Equal(s.testData.proPrice.ID, lineItems[0].PriceID)
+	s.True(decimal.NewFromInt(3).Equal(lineItems[0].Quantity), "expected 3, got %s", lineItems[0].Quantity)
+}
+
+func (s *SubscriptionPlanChangeTestSuite) TestChangePlanWithoutProrations() {
+	ctx := s.GetContext()
+
+	resp, err := s.service.ChangePlan(ctx, s.testData.subscription.ID, dto.ChangeSubscriptionPlanRequest{
+		PlanID:            s.testData.proPlan.ID,
+		ProrationBehavior: types.ProrationBehaviorNone,
+	})
+	s.NoError(err)
+	s.Nil(resp.Invoice)
+	s.Empty(resp.CreditNotes)
+	s.True(resp.ProratedCredit.IsZero())
+
+	inv, err := s.GetStores().InvoiceRepo.Get(ctx, s.testData.invoice.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(30).Equal(inv.AmountRemaining))
+}
+
+func (s *SubscriptionPlanChangeTestSuite) TestChangePlanInvoicedAtPeriodEnd() {
+	ctx := s.GetContext()
+
+	resp, err := s.service.ChangePlan(ctx, s.testData.subscription.ID, dto.ChangeSubscriptionPlanRequest{
+		PlanID:        s.testData.proPlan.ID,
+		InvoiceTiming: types.PlanChangeInvoiceTimingPeriodEnd,
+	})
+	s.NoError(err)
+	s.NotNil(resp.Invoice)
+	s.Equal(types.InvoiceStatusDraft, resp.Invoice.InvoiceStatus)
+	s.Len(resp.CreditNotes, 1)
+}
+
+func (s *SubscriptionPlanChangeTestSuite) TestChangePlanValidation() {
+	ctx := s.GetContext()
+
+	cancelled := &subscription.Subscription{
+		ID:                 "sub_plan_change_cancelled",
+		PlanID:             s.testData.basicPlan.ID,
+		CustomerID:         s.testData.customer.ID,
+		StartDate:          s.testData.subscription.StartDate,
+		CurrentPeriodStart: s.testData.subscription.CurrentPeriodStart,
+		CurrentPeriodEnd:   s.testData.subscription.CurrentPeriodEnd,
+		Currency:           "usd",
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		SubscriptionStatus: types.SubscriptionStatusCancelled,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(ctx, cancelled, nil))
+
+	testCases := []struct {
+		name           string
+		subscriptionID string
+		req            dto.ChangeSubscriptionPlanRequest
+	}{
+		{
+			name:           "same_plan",
+			subscriptionID: s.testData.subscription.ID,
+			req:            dto.ChangeSubscriptionPlanRequest{PlanID: s.testData.basicPlan.ID},
+		},
+		{
+			name:           "inactive_subscription",
+			subscriptionID: cancelled.ID,
+			req:            dto.ChangeSubscriptionPlanRequest{PlanID: s.testData.proPlan.ID},
+		},
+		{
+			name:           "invalid_proration_behavior",
+			subscriptionID: s.testData.subscription.ID,
+			req: dto.ChangeSubscriptionPlanRequest{
+				PlanID:            s.testData.proPlan.ID,
+				ProrationBehavior: "always",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.service.ChangePlan(ctx, tc.subscriptionID, tc.req)
+			s.Error(err)
+			s.True(ierr.IsValidation(err))
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// MigratePlanVersion schedules the subscriptions matching the request that are pinned to a previous
+// version of the plan to move to its current version at the end of their current billing period
+func (s *subscriptionService) MigratePlanVersion(ctx context.Context, req dto.MigratePlanVersionRequest) (*dto.MigratePlanVersionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.PlanRepo.Get(ctx, req.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.Status != types.StatusPublished {
+		return nil, ierr.NewError("plan is not active").
+			WithHint("Subscriptions can only be migrated to an active plan").
+			WithReportableDetails(map[string]interface{}{
+				"plan_id": req.PlanID,
+				"status":  plan.Status,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	filter := types.NewNoLimitSubscriptionFilter()
+	filter.PlanID = plan.ID
+	filter.SubscriptionStatus = []types.SubscriptionStatus{
+		types.SubscriptionStatusActive,
+		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
+	}
+
+	subs, err := s.SubRepo.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	subs = lo.Filter(subs, func(sub *subscription.Subscription, _ int) bool {
+		if sub.PlanVersion >= plan.Version {
+			return false
+		}
+		if len(req.SubscriptionIDs) > 0 && !lo.Contains(req.SubscriptionIDs, sub.ID) {
+			return false
+		}
+		if len(req.CustomerIDs) > 0 && !lo.Contains(req.CustomerIDs, sub.CustomerID) {
+			return false
+		}
+		if len(req.PlanVersions) > 0 && !lo.Contains(req.PlanVersions, sub.PlanVersion) {
+			return false
+		}
+		return true
+	})
+
+	response := &dto.MigratePlanVersionResponse{
+		PlanID:      plan.ID,
+		PlanVersion: plan.Version,
+		Items:       make([]*dto.MigratePlanVersionResponseItem, 0, len(subs)),
+	}
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		for _, sub := range subs {
+			sub.PendingPlanVersion = lo.ToPtr(plan.Version)
+			if err := s.SubRepo.Update(ctx, sub); err != nil {
+				return err
+			}
+			s.publishWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, types.WebhookEventPayload{SubscriptionID: sub.ID})
+
+			response.Items = append(response.Items, &dto.MigratePlanVersionResponseItem{
+				SubscriptionID: sub.ID,
+				CustomerID:     sub.CustomerID,
+				FromVersion:    sub.PlanVersion,
+				EffectiveAt:    sub.CurrentPeriodEnd,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("scheduled plan version migration",
+		"plan_id", plan.ID,
+		"plan_version", plan.Version,
+		"num_subscriptions", len(response.Items))
+
+	return response, nil
+}
+
+// migratePlanVersion moves the subscription to its pending plan version at the end of the period.
+// The line items of the prices that are no longer on the plan are settled for the period and ended,
+// the remaining ones are invoiced as usual and the prices added to the plan since the version of the
+// subscription take effect from the period end, with their advance charges for the next period.
+func (s *subscriptionService) migratePlanVersion(ctx context.Context, sub *subscription.Subscription, periodEnd time.Time) error {
+	billingService := NewBillingService(s.ServiceParams)
+	invoiceService := NewInvoiceService(s.ServiceParams)
+	priceService := NewPriceService(s.DB, s.PriceRepo, s.PlanRepo, s.MeterRepo, s.SubRepo, s.Logger)
+
+	plan, err := s.PlanRepo.Get(ctx, sub.PlanID)
+	if err != nil {
+		return err
+	}
+
+	_, lineItems, err := s.SubRepo.GetWithLineItems(ctx, sub.ID)
+	if err != nil {
+		return err
+	}
+
+	priceFilter := types.NewNoLimitPriceFilter().
+		WithPlanIDs([]string{plan.ID}).
+		WithStatus(types.StatusPublished).
+		WithExpand(string(types.ExpandMeters))
+	pricesResponse, err := priceService.GetPrices(ctx, priceFilter)
+	if err != nil {
+		return err
+	}
+
+	// The line items of the prices negotiated for the subscription stand for the plan prices
+	// they override
+	overridePrices, err := s.PriceRepo.List(ctx, types.NewNoLimitPriceFilter().WithSubscriptionIDs([]string{sub.ID}))
+	if err != nil {
+		return err
+	}
+	planPriceIDs := lo.SliceToMap(overridePrices, func(p *price.Price) (string, string) {
+		return p.ID, lo.FromPtr(p.ParentPriceID)
+	})
+	planPriceID := func(item *subscription.SubscriptionLineItem) string {
+		if id, ok := planPriceIDs[item.PriceID]; ok {
+			return id
+		}
+		return item.PriceID
+	}
+
+	validPrices := filterValidPricesForSubscription(pricesResponse.Items, sub)
+	validPriceIDs := lo.SliceToMap(validPrices, func(p *dto.PriceResponse) (string, bool) {
+		return p.ID, true
+	})
+	keptItems, removedItems := lo.FilterReject(lineItems, func(item *subscription.SubscriptionLineItem, _ int) bool {
+		return validPriceIDs[planPriceID(item)]
+	})
+	subscribedPriceIDs := lo.SliceToMap(lineItems, func(item *subscription.SubscriptionLineItem) (string, bool) {
+		return planPriceID(item), true
+	})
+	addedPrices := lo.Filter(validPrices, func(p *dto.PriceResponse, _ int) bool {
+		return !subscribedPriceIDs[p.ID]
+	})
+
+	previousVersion := sub.PlanVersion
+	versionMetadata := func(metadata types.Metadata) types.Metadata {
+		if metadata == nil {
+			metadata = make(types.Metadata)
+		}
+		metadata["previous_plan_version"] = strconv.Itoa(previousVersion)
+		metadata["plan_version"] = strconv.Itoa(*sub.PendingPlanVersion)
+		return metadata
+	}
+
+	// Settle the removed line items for the whole period, none of their advance charges are
+	// carried over to the next period
+	if len(removedItems) > 0 {
+		invoiceReq, err := billingService.PreparePlanChangeInvoiceRequest(ctx, sub, removedItems, nil, periodEnd, false)
+		if err != nil {
+			return err
+		}
+
+		if invoiceReq != nil {
+			invoiceReq.Metadata = versionMetadata(invoiceReq.Metadata)
+			inv, err := invoiceService.CreateInvoice(ctx, *invoiceReq)
+			if err != nil {
+				return err
+			}
+
+			if err := invoiceService.ProcessDraftInvoice(ctx, inv.ID); err != nil {
+				return err
+			}
+		}
+
+		for _, item := range removedItems {
+			item.EndDate = periodEnd
+		}
+
+		if err := s.SubRepo.ReplaceLineItems(ctx, sub, removedItems, nil); err != nil {
+			return err
+		}
+	}
+
+	if len(keptItems) > 0 {
+		if _, err := invoiceService.CreateSubscriptionInvoice(ctx, &dto.CreateSubscriptionInvoiceRequest{
+			SubscriptionID: sub.ID,
+			PeriodStart:    sub.CurrentPeriodStart,
+			PeriodEnd:      periodEnd,
+			ReferencePoint: types.ReferencePointPeriodEnd,
+		}); err != nil {
+			return err
+		}
+	}
+
+	addedItems := buildSubscriptionLineItems(ctx, sub, plan, addedPrices, periodEnd)
+	if len(addedItems) > 0 {
+		if err := s.SubRepo.ReplaceLineItems(ctx, sub, nil, addedItems); err != nil {
+			return err
+		}
+
+		// The kept line items were charged in advance for the next period with the period end
+		// invoice, only the added ones are left to charge
+		advanceItems := lo.Filter(addedItems, func(item *subscription.SubscriptionLineItem, _ int) bool {
+			return item.PriceType == types.PRICE_TYPE_FIXED && item.InvoiceCadence == types.InvoiceCadenceAdvance
+		})
+		if len(advanceItems) > 0 {
+			nextPeriodEnd, err := types.NextBillingDate(periodEnd, sub.BillingAnchor, sub.BillingPeriodCount, sub.BillingPeriod)
+			if err != nil {
+				return err
+			}
+
+			result, err := billingService.CalculateCharges(ctx, sub, advanceItems, periodEnd, nextPeriodEnd, false)
+			if err != nil {
+				return err
+			}
+
+			invoiceReq, err := billingService.CreateInvoiceRequestForCharges(ctx, sub, result, periodEnd, nextPeriodEnd,
+				fmt.Sprintf("Invoice for plan version migration - subscription %s", sub.ID),
+				versionMetadata(nil))
+			if err != nil {
+				return err
+			}
+
+			inv, err := invoiceService.CreateInvoice(ctx, *invoiceReq)
+			if err != nil {
+				return err
+			}
+
+			if err := invoiceService.ProcessDraftInvoice(ctx, inv.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.Logger.Infow("migrated subscription plan version",
+		"subscription_id", sub.ID,
+		"plan_id", plan.ID,
+		"previous_plan_version", previousVersion,
+		"plan_version", *sub.PendingPlanVersion,
+		"removed_line_items", len(removedItems),
+		"added_line_items", len(addedItems),
+		"migrated_at", periodEnd)
+
+	sub.PlanVersion = *sub.PendingPlanVersion
+	sub.PendingPlanVersion = nil
+	return nil
+}
@@ -0,0 +1,348 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubscriptionPlanVersionTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service     SubscriptionService
+	planService PlanService
+	testData    struct {
+		customer     *customer.Customer
+		plan         *plan.Plan
+		basePrice    *price.Price
+		seatPrice    *price.Price
+		subscription *subscription.Subscription
+		periodEnd    time.Time
+	}
+}
+
+func TestSubscriptionPlanVersion(t *testing.T) {
+	suite.Run(t, new(SubscriptionPlanVersionTestSuite))
+}
+
+func (s *SubscriptionPlanVersionTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	s.service = NewSubscriptionService(ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		PlanRepo:         s.GetStores().PlanRepo,
+		PriceRepo:        s.GetStores().PriceRepo,
+		EventRepo:        s.GetStores().EventRepo,
+		MeterRepo:        s.GetStores().MeterRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		EntitlementRepo:  s.GetStores().EntitlementRepo,
+		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
+		FeatureRepo:      s.GetStores().FeatureRepo,
+		TenantRepo:       s.GetStores().TenantRepo,
+		UserRepo:         s.GetStores().UserRepo,
+		AuthRepo:         s.GetStores().AuthRepo,
+		WalletRepo:       s.GetStores().WalletRepo,
+		PaymentRepo:      s.GetStores().PaymentRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	})
+	s.planService = NewPlanService(
+		s.GetDB(),
+		s.GetStores().PlanRepo,
+		s.GetStores().PriceRepo,
+		s.GetStores().MeterRepo,
+		s.GetStores().EntitlementRepo,
+		s.GetStores().FeatureRepo,
+		s.GetStores().SubscriptionRepo,
+		s.GetLogger(),
+	)
+	s.setupTestData()
+}
+
+func (s *SubscriptionPlanVersionTestSuite) setupTestData() {
+	ctx := s.GetContext()
+	now := time.Now().UTC()
+	periodStart := now.AddDate(0, -1, -5)
+	s.testData.periodEnd = now.AddDate(0, 0, -5)
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_plan_version",
+		ExternalID: "ext_cust_plan_version",
+		Name:       "Test Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	s.testData.plan = &plan.Plan{
+		ID:        "plan_versioned",
+		Name:      "Versioned",
+		Version:   1,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, s.testData.plan))
+
+	s.testData.basePrice = s.createFixedPrice("price_base", decimal.NewFromInt(30), 1)
+	s.testData.seatPrice = s.createFixedPrice("price_seat", decimal.NewFromInt(10), 1)
+
+	s.testData.subscription = &subscription.Subscription{
+		ID:                 "sub_plan_version",
+		PlanID:             s.testData.plan.ID,
+		PlanVersion:        1,
+		CustomerID:         s.testData.customer.ID,
+		StartDate:          periodStart,
+		BillingAnchor:      periodStart,
+		CurrentPeriodStart: periodStart,
+		CurrentPeriodEnd:   s.testData.periodEnd,
+		Currency:           "usd",
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	lineItems := lo.Map([]*price.Price{s.testData.basePrice, s.testData.seatPrice}, func(p *price.Price, _ int) *subscription.SubscriptionLineItem {
+		return &subscription.SubscriptionLineItem{
+			ID:             "subs_line_" + p.ID,
+			SubscriptionID: s.testData.subscription.ID,
+			CustomerID:     s.testData.customer.ID,
+			PlanID:         s.testData.plan.ID,
+			PriceID:        p.ID,
+			PriceType:      types.PRICE_TYPE_FIXED,
+			DisplayName:    s.testData.plan.Name,
+			Quantity:       decimal.NewFromInt(1),
+			Currency:       "usd",
+			BillingPeriod:  types.BILLING_PERIOD_MONTHLY,
+			InvoiceCadence: types.InvoiceCadenceAdvance,
+			StartDate:      periodStart,
+			BaseModel:      types.GetDefaultBaseModel(ctx),
+		}
+	})
+	s.NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(ctx, s.testData.subscription, lineItems))
+}
+
+func (s *SubscriptionPlanVersionTestSuite) createFixedPrice(id string, amount decimal.Decimal, planVersion int) *price.Price {
+	p := &price.Price{
+		ID:                 id,
+		Amount:             amount,
+		Currency:           "usd",
+		PlanID:             s.testData.plan.ID,
+		PlanVersion:        planVersion,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		BaseModel:          types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(s.GetContext(), p))
+	return p
+}
+
+func fixedPriceRequest(amount string) *dto.CreatePriceRequest {
+	return &dto.CreatePriceRequest{
+		Amount:             amount,
+		Currency:           "usd",
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+	}
+}
+
+// releaseVersion2 replaces the base price of the plan with a pro price
+func (s *SubscriptionPlanVersionTestSuite) releaseVersion2() *dto.PlanResponse {
+	resp, err := s.planService.UpdatePlan(s.GetContext(), s.testData.plan.ID, dto.UpdatePlanRequest{
+		Prices: []dto.UpdatePlanPriceRequest{
+			{ID: s.testData.seatPrice.ID, CreatePriceRequest: fixedPriceRequest("10")},
+			{CreatePriceRequest: fixedPriceRequest("50")},
+		},
+	})
+	s.NoError(err)
+	return resp
+}
+
+func (s *SubscriptionPlanVersionTestSuite) TestUpdatePlanPricesCreatesVersion() {
+	ctx := s.GetContext()
+
+	// Metadata only changes are made in place
+	resp, err := s.planService.UpdatePlan(ctx, s.testData.plan.ID, dto.UpdatePlanRequest{
+		Name: lo.ToPtr("Renamed"),
+	})
+	s.NoError(err)
+	s.Equal(1, resp.Plan.Version)
+
+	resp = s.releaseVersion2()
+	s.Equal(2, resp.Plan.Version)
+	s.Len(resp.Prices, 2)
+
+	newPrice, ok := lo.Find(resp.Prices, func(p *dto.PriceResponse) bool {
+		return p.ID != s.testData.seatPrice.ID
+	})
+	s.True(ok)
+	s.Equal(2, newPrice.PlanVersion)
+
+	// The removed price is archived, the subscriptions billed for it keep it
+	removed, err := s.GetStores().PriceRepo.Get(ctx, s.testData.basePrice.ID)
+	s.NoError(err)
+	s.Equal(types.StatusArchived, removed.Status)
+}
+
+func (s *SubscriptionPlanVersionTestSuite) TestUpdatePlanPricesWithoutSubscriptionsKeepsVersion() {
+	ctx := s.GetContext()
+
+	created, err := s.planService.CreatePlan(ctx, dto.CreatePlanRequest{
+		Name:   "Unused",
+		Prices: []dto.CreatePlanPriceRequest{{CreatePriceRequest: fixedPriceRequest("10")}},
+	})
+	s.NoError(err)
+
+	// Nobody is subscribed to the plan yet, so its prices are changed in place
+	resp, err := s.planService.UpdatePlan(ctx, created.ID, dto.UpdatePlanRequest{
+		Prices: []dto.UpdatePlanPriceRequest{
+			{CreatePriceRequest: fixedPriceRequest("20")},
+			{CreatePriceRequest: fixedPriceRequest("30")},
+		},
+	})
+	s.NoError(err)
+	s.Equal(1, resp.Plan.Version)
+	for _, p := range resp.Prices {
+		s.Equal(1, p.PlanVersion)
+	}
+}
+
+func (s *SubscriptionPlanVersionTestSuite) TestNewSubscriptionsUseCurrentVersion() {
+	ctx := s.GetContext()
+	s.releaseVersion2()
+
+	cust := &customer.Customer{
+		ID:         "cust_plan_version_new",
+		ExternalID: "ext_cust_plan_version_new",
+		Name:       "New Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, cust))
+
+	resp, err := s.service.CreateSubscription(ctx, dto.CreateSubscriptionRequest{
+		CustomerID:         cust.ID,
+		PlanID:             s.testData.plan.ID,
+		StartDate:          time.Now().UTC(),
+		Currency:           "usd",
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+	})
+	s.NoError(err)
+	s.Equal(2, resp.PlanVersion)
+	s.Len(resp.LineItems, 2)
+	s.False(lo.ContainsBy(resp.LineItems, func(item *subscription.SubscriptionLineItem) bool {
+		return item.PriceID == s.testData.basePrice.ID
+	}))
+
+	// The existing subscription stays pinned to its version
+	sub, lineItems, err := s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, s.testData.subscription.ID)
+	s.NoError(err)
+	s.Equal(1, sub.PlanVersion)
+	s.Nil(sub.PendingPlanVersion)
+	s.True(lo.ContainsBy(lineItems, func(item *subscription.SubscriptionLineItem) bool {
+		return item.PriceID == s.testData.basePrice.ID
+	}))
+}
+
+func (s *SubscriptionPlanVersionTestSuite) TestMigratePlanVersion() {
+	ctx := s.GetContext()
+
+	// Nothing to migrate while the subscription is on the current version
+	resp, err := s.service.MigratePlanVersion(ctx, dto.MigratePlanVersionRequest{PlanID: s.testData.plan.ID})
+	s.NoError(err)
+	s.Empty(resp.Items)
+
+	s.releaseVersion2()
+
+	// Filters that do not match the subscription leave it pinned
+	resp, err = s.service.MigratePlanVersion(ctx, dto.MigratePlanVersionRequest{
+		PlanID:      s.testData.plan.ID,
+		CustomerIDs: []string{"cust_other"},
+	})
+	s.NoError(err)
+	s.Empty(resp.Items)
+
+	resp, err = s.service.MigratePlanVersion(ctx, dto.MigratePlanVersionRequest{
+		PlanID:       s.testData.plan.ID,
+		CustomerIDs:  []string{s.testData.customer.ID},
+		PlanVersions: []int{1},
+	})
+	s.NoError(err)
+	s.Equal(2, resp.PlanVersion)
+	s.Require().Len(resp.Items, 1)
+	s.Equal(s.testData.subscription.ID, resp.Items[0].SubscriptionID)
+	s.Equal(1, resp.Items[0].FromVersion)
+	s.True(s.testData.periodEnd.Equal(resp.Items[0].EffectiveAt))
+
+	sub, err := s.GetStores().SubscriptionRepo.Get(ctx, s.testData.subscription.ID)
+	s.NoError(err)
+	s.Equal(1, sub.PlanVersion)
+	s.Equal(lo.ToPtr(2), sub.PendingPlanVersion)
+
+	_, err = s.service.MigratePlanVersion(ctx, dto.MigratePlanVersionRequest{
+		PlanID:       s.testData.plan.ID,
+		PlanVersions: []int{0},
+	})
+	s.Error(err)
+}
+
+func (s *SubscriptionPlanVersionTestSuite) TestMigrationAppliedAtPeriodEnd() {
+	ctx := s.GetContext()
+	s.releaseVersion2()
+
+	_, err := s.service.MigratePlanVersion(ctx, dto.MigratePlanVersionRequest{PlanID: s.testData.plan.ID})
+	s.NoError(err)
+
+	_, err = s.service.UpdateBillingPeriods(ctx)
+	s.NoError(err)
+
+	sub, lineItems, err := s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, s.testData.subscription.ID)
+	s.NoError(err)
+	s.Equal(2, sub.PlanVersion)
+	s.Nil(sub.PendingPlanVersion)
+	s.True(s.testData.periodEnd.Equal(sub.CurrentPeriodStart))
+
+	// The base price is replaced with the pro price from the period end
+	s.Len(lineItems, 2)
+	s.False(lo.ContainsBy(lineItems, func(item *subscription.SubscriptionLineItem) bool {
+		return item.PriceID == s.testData.basePrice.ID
+	}))
+	added, ok := lo.Find(lineItems, func(item *subscription.SubscriptionLineItem) bool {
+		return item.PriceID != s.testData.seatPrice.ID
+	})
+	s.True(ok)
+	s.True(s.testData.periodEnd.Equal(added.StartDate))
+
+	// The kept seat price and the added pro price are charged in advance for the next period
+	invoices, err := s.GetStores().InvoiceRepo.List(ctx, types.NewNoLimitInvoiceFilter())
+	s.NoError(err)
+	total := decimal.Zero
+	for _, inv := range invoices {
+		s.Equal(types.InvoiceStatusFinalized, inv.InvoiceStatus)
+		total = total.Add(inv.AmountDue)
+	}
+	s.True(decimal.NewFromInt(60).Equal(total), "total invoiced %s", total)
+}
@@ -0,0 +1,337 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubscriptionPriceOverrideTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service        SubscriptionService
+	billingService BillingService
+	testData       struct {
+		customer   *customer.Customer
+		plan       *plan.Plan
+		meter      *meter.Meter
+		fixedPrice *price.Price
+		usagePrice *price.Price
+	}
+}
+
+func TestSubscriptionPriceOverride(t *testing.T) {
+	suite.Run(t, new(SubscriptionPriceOverrideTestSuite))
+}
+
+func (s *SubscriptionPriceOverrideTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	params := ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		PlanRepo:         s.GetStores().PlanRepo,
+		PriceRepo:        s.GetStores().PriceRepo,
+		EventRepo:        s.GetStores().EventRepo,
+		MeterRepo:        s.GetStores().MeterRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		EntitlementRepo:  s.GetStores().EntitlementRepo,
+		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
+		FeatureRepo:      s.GetStores().FeatureRepo,
+		TenantRepo:       s.GetStores().TenantRepo,
+		UserRepo:         s.GetStores().UserRepo,
+		AuthRepo:         s.GetStores().AuthRepo,
+		WalletRepo:       s.GetStores().WalletRepo,
+		PaymentRepo:      s.GetStores().PaymentRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	}
+	s.service = NewSubscriptionService(params)
+	s.billingService = NewBillingService(params)
+	s.setupTestData()
+}
+
+func (s *SubscriptionPriceOverrideTestSuite) setupTestData() {
+	ctx := s.GetContext()
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_enterprise",
+		ExternalID: "ext_cust_enterprise",
+		Name:       "Enterprise Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	s.testData.plan = &plan.Plan{
+		ID:        "plan_enterprise",
+		Name:      "Enterprise",
+		Version:   1,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, s.testData.plan))
+
+	s.testData.meter = &meter.Meter{
+		ID:        "meter_api_calls",
+		Name:      "API Calls",
+		EventName: "api_call",
+		Aggregation: meter.Aggregation{
+			Type: types.AggregationCount,
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().MeterRepo.CreateMeter(ctx, s.testData.meter))
+
+	s.testData.fixedPrice = &price.Price{
+		ID:                 "price_platform_fee",
+		Amount:             decimal.NewFromInt(100),
+		Currency:           "usd",
+		PlanID:             s.testData.plan.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, s.testData.fixedPrice))
+
+	s.testData.usagePrice = &price.Price{
+		ID:                 "price_api_calls",
+		Amount:             decimal.NewFromFloat(0.1),
+		Currency:           "usd",
+		PlanID:             s.testData.plan.ID,
+		Type:               types.PRICE_TYPE_USAGE,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceArrear,
+		MeterID:            s.testData.meter.ID,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, s.testData.usagePrice))
+}
+
+func (s *SubscriptionPriceOverrideTestSuite) createSubscription(overrides ...dto.SubscriptionPriceOverrideRequest) (*dto.SubscriptionResponse, error) {
+	return s.service.CreateSubscription(s.GetContext(), dto.CreateSubscriptionRequest{
+		CustomerID:         s.testData.customer.ID,
+		PlanID:             s.testData.plan.ID,
+		StartDate:          time.Now().UTC().Add(-time.Hour),
+		Currency:           "usd",
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		PriceOverrides:     overrides,
+	})
+}
+
+func (s *SubscriptionPriceOverrideTestSuite) TestCreateSubscriptionWithPriceOverrides() {
+	ctx := s.GetContext()
+
+	resp, err := s.createSubscription(dto.SubscriptionPriceOverrideRequest{
+		PriceID: s.testData.fixedPrice.ID,
+		Amount:  lo.ToPtr("80"),
+	})
+	s.NoError(err)
+	s.Len(resp.LineItems, 2)
+
+	// The fixed price is replaced with the negotiated one, the usage price is inherited
+	fixedItem, ok := lo.Find(resp.LineItems, func(item *subscription.SubscriptionLineItem) bool {
+		return item.PriceType == types.PRICE_TYPE_FIXED
+	})
+	s.Require().True(ok)
+	s.NotEqual(s.testData.fixedPrice.ID, fixedItem.PriceID)
+	s.True(lo.ContainsBy(resp.LineItems, func(item *subscription.SubscriptionLineItem) bool {
+		return item.PriceID == s.testData.usagePrice.ID
+	}))
+
+	override, err := s.GetStores().PriceRepo.Get(ctx, fixedItem.PriceID)
+	s.NoError(err)
+	s.Equal(lo.ToPtr(resp.ID), override.SubscriptionID)
+	s.Equal(lo.ToPtr(s.testData.fixedPrice.ID), override.ParentPriceID)
+	s.True(decimal.NewFromInt(80).Equal(override.Amount))
+
+	// The plan keeps its prices
+	planPrices, err := NewPriceService(s.GetDB(), s.GetStores().PriceRepo, s.GetStores().PlanRepo, s.GetStores().MeterRepo, s.GetStores().SubscriptionRepo, s.GetLogger()).
+		GetPricesByPlanID(ctx, s.testData.plan.ID)
+	s.NoError(err)
+	s.Len(planPrices.Items, 2)
+
+	// The advance charge is billed at the negotiated rate
+	invoices, err := s.GetStores().InvoiceRepo.List(ctx, types.NewNoLimitInvoiceFilter())
+	s.NoError(err)
+	s.Require().Len(invoices, 1)
+	s.True(decimal.NewFromInt(80).Equal(invoices[0].AmountDue), "amount due %s", invoices[0].AmountDue)
+}
+
+func (s *SubscriptionPriceOverrideTestSuite) TestUsageChargesUseOverride() {
+	ctx := s.GetContext()
+
+	resp, err := s.createSubscription(dto.SubscriptionPriceOverrideRequest{
+		PriceID: s.testData.usagePrice.ID,
+		Amount:  lo.ToPtr("0.05"),
+	})
+	s.NoError(err)
+
+	for i := 0; i < 100; i++ {
+		s.NoError(s.GetStores().EventRepo.InsertEvent(ctx, &events.Event{
+			ID:                 s.GetUUID(),
+			TenantID:           resp.TenantID,
+			EventName:          s.testData.meter.EventName,
+			ExternalCustomerID: s.testData.customer.ExternalID,
+			Timestamp:          time.Now().UTC().Add(-30 * time.Minute),
+			Properties:         map[string]interface{}{},
+		}))
+	}
+
+	sub, _, err := s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, resp.ID)
+	s.NoError(err)
+
+	result, err := s.billingService.CalculateCharges(ctx, sub, sub.LineItems, sub.CurrentPeriodStart, sub.CurrentPeriodEnd, true)
+	s.NoError(err)
+	s.Require().Len(result.UsageCharges, 1)
+	s.True(decimal.NewFromInt(5).Equal(result.UsageCharges[0].Amount), "usage amount %s", result.UsageCharges[0].Amount)
+	s.True(decimal.NewFromInt(100).Equal(result.FixedCharges[0].Amount))
+}
+
+func (s *SubscriptionPriceOverrideTestSuite) TestPriceOverrideValidation() {
+	tests := []struct {
+		name      string
+		overrides []dto.SubscriptionPriceOverrideRequest
+	}{
+		{
+			name:      "price not on the plan",
+			overrides: []dto.SubscriptionPriceOverrideRequest{{PriceID: "price_unknown", Amount: lo.ToPtr("10")}},
+		},
+		{
+			name:      "empty override",
+			overrides: []dto.SubscriptionPriceOverrideRequest{{PriceID: s.testData.fixedPrice.ID}},
+		},
+		{
+			name:      "negative amount",
+			overrides: []dto.SubscriptionPriceOverrideRequest{{PriceID: s.testData.fixedPrice.ID, Amount: lo.ToPtr("-1")}},
+		},
+		{
+			name: "tiers on a flat fee price",
+			overrides: []dto.SubscriptionPriceOverrideRequest{{
+				PriceID: s.testData.usagePrice.ID,
+				Tiers:   []dto.CreatePriceTier{{UnitAmount: "0.01"}},
+			}},
+		},
+		{
+			name: "filter values on a fixed price",
+			overrides: []dto.SubscriptionPriceOverrideRequest{{
+				PriceID:      s.testData.fixedPrice.ID,
+				FilterValues: map[string][]string{"region": {"us"}},
+			}},
+		},
+		{
+			name: "price overridden twice",
+			overrides: []dto.SubscriptionPriceOverrideRequest{
+				{PriceID: s.testData.fixedPrice.ID, Amount: lo.ToPtr("80")},
+				{PriceID: s.testData.fixedPrice.ID, Amount: lo.ToPtr("70")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.createSubscription(tt.overrides...)
+			s.Error(err)
+			s.True(ierr.IsValidation(err))
+		})
+	}
+
+	// Nothing is created for the rejected subscriptions
+	subs, err := s.GetStores().SubscriptionRepo.List(s.GetContext(), types.NewNoLimitSubscriptionFilter())
+	s.NoError(err)
+	s.Empty(subs)
+}
+
+func (s *SubscriptionPriceOverrideTestSuite) TestPriceOverrideTierValidation() {
+	tests := []struct {
+		name    string
+		tiers   []dto.CreatePriceTier
+		wantErr bool
+	}{
+		{
+			name: "valid tiers",
+			tiers: []dto.CreatePriceTier{
+				{UpTo: lo.ToPtr(uint64(100)), UnitAmount: "0.02", FlatAmount: lo.ToPtr("5")},
+				{UnitAmount: "0.01"},
+			},
+		},
+		{
+			name:    "empty tiers",
+			tiers:   []dto.CreatePriceTier{},
+			wantErr: true,
+		},
+		{
+			name: "tiers out of order",
+			tiers: []dto.CreatePriceTier{
+				{UpTo: lo.ToPtr(uint64(100)), UnitAmount: "0.02"},
+				{UpTo: lo.ToPtr(uint64(50)), UnitAmount: "0.015"},
+				{UnitAmount: "0.01"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "last tier bounded",
+			tiers: []dto.CreatePriceTier{
+				{UpTo: lo.ToPtr(uint64(100)), UnitAmount: "0.02"},
+				{UpTo: lo.ToPtr(uint64(200)), UnitAmount: "0.01"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unbounded tier before the last",
+			tiers: []dto.CreatePriceTier{
+				{UnitAmount: "0.02"},
+				{UnitAmount: "0.01"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "negative unit amount",
+			tiers:   []dto.CreatePriceTier{{UnitAmount: "-0.01"}},
+			wantErr: true,
+		},
+		{
+			name:    "negative flat amount",
+			tiers:   []dto.CreatePriceTier{{UnitAmount: "0.01", FlatAmount: lo.ToPtr("-5")}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			req := dto.SubscriptionPriceOverrideRequest{PriceID: s.testData.usagePrice.ID, Tiers: tt.tiers}
+			err := req.Validate()
+			if !tt.wantErr {
+				s.NoError(err)
+				return
+			}
+			s.Error(err)
+			s.True(ierr.IsValidation(err))
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubscriptionQuantityTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service        SubscriptionService
+	billingService BillingService
+	testData       struct {
+		customer     *customer.Customer
+		plan         *plan.Plan
+		seatPrice    *price.Price
+		subscription *subscription.Subscription
+		seatItem     *subscription.SubscriptionLineItem
+		invoice      *invoice.Invoice
+	}
+}
+
+func TestSubscriptionQuantity(t *testing.T) {
+	suite.Run(t, new(SubscriptionQuantityTestSuite))
+}
+
+func (s *SubscriptionQuantityTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	params := ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		PlanRepo:         s.GetStores().PlanRepo,
+		PriceRepo:        s.GetStores().PriceRepo,
+		EventRepo:        s.GetStores().EventRepo,
+		MeterRepo:        s.GetStores().MeterRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		EntitlementRepo:  s.GetStores().EntitlementRepo,
+		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
+		FeatureRepo:      s.GetStores().FeatureRepo,
+		TenantRepo:       s.GetStores().TenantRepo,
+		UserRepo:         s.GetStores().UserRepo,
+		AuthRepo:         s.GetStores().AuthRepo,
+		WalletRepo:       s.GetStores().WalletRepo,
+		PaymentRepo:      s.GetStores().PaymentRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	}
+	s.service = NewSubscriptionService(params)
+	s.billingService = NewBillingService(params)
+	s.setupTestData()
+}
+
+func (s *SubscriptionQuantityTestSuite) setupTestData() {
+	ctx := s.GetContext()
+	now := time.Now().UTC()
+	periodStart := now.Add(-10 * 24 * time.Hour)
+	periodEnd := now.Add(20 * 24 * time.Hour)
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_quantity",
+		ExternalID: "ext_cust_quantity",
+		Name:       "Test Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	s.testData.plan = &plan.Plan{
+		ID:        "plan_seats",
+		Name:      "Seats",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, s.testData.plan))
+
+	s.testData.seatPrice = &price.Price{
+		ID:                 "price_seat",
+		Amount:             decimal.NewFromInt(10),
+		Currency:           "usd",
+		PlanID:             s.testData.plan.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, s.testData.seatPrice))
+
+	s.testData.subscription = &subscription.Subscription{
+		ID:                 "sub_quantity",
+		PlanID:             s.testData.plan.ID,
+		CustomerID:         s.testData.customer.ID,
+		StartDate:          periodStart,
+		BillingAnchor:      periodStart,
+		CurrentPeriodStart: periodStart,
+		CurrentPeriodEnd:   periodEnd,
+		Currency:           "usd",
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.testData.seatItem = &subscription.SubscriptionLineItem{
+		ID:              "subs_line_seats",
+		SubscriptionID:  s.testData.subscription.ID,
+		CustomerID:      s.testData.customer.ID,
+		PlanID:          s.testData.plan.ID,
+		PlanDisplayName: s.testData.plan.Name,
+		PriceID:         s.testData.seatPrice.ID,
+		PriceType:       types.PRICE_TYPE_FIXED,
+		DisplayName:     "Seats",
+		Quantity:        decimal.NewFromInt(5),
+		Currency:        "usd",
+		BillingPeriod:   types.BILLING_PERIOD_MONTHLY,
+		InvoiceCadence:  types.InvoiceCadenceAdvance,
+		StartDate:       periodStart,
+		BaseModel:       types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(ctx, s.testData.subscription,
+		[]*subscription.SubscriptionLineItem{s.testData.seatItem}))
+
+	// The advance charge of the 5 seats for the current period
+	s.testData.invoice = &invoice.Invoice{
+		ID:              "inv_quantity",
+		CustomerID:      s.testData.customer.ID,
+		SubscriptionID:  lo.ToPtr(s.testData.subscription.ID),
+		InvoiceType:     types.InvoiceTypeSubscription,
+		InvoiceStatus:   types.InvoiceStatusFinalized,
+		PaymentStatus:   types.PaymentStatusPending,
+		InvoiceNumber:   lo.ToPtr("INV-202501-00001"),
+		Currency:        "usd",
+		AmountDue:       decimal.NewFromInt(50),
+		AmountPaid:      decimal.Zero,
+		AmountRemaining: decimal.NewFromInt(50),
+		Subtotal:        decimal.NewFromInt(50),
+		Total:           decimal.NewFromInt(50),
+		PeriodStart:     &periodStart,
+		PeriodEnd:       &periodEnd,
+		LineItems: []*invoice.InvoiceLineItem{
+			{
+				ID:             "inv_line_seats",
+				InvoiceID:      "inv_quantity",
+				CustomerID:     s.testData.customer.ID,
+				SubscriptionID: lo.ToPtr(s.testData.subscription.ID),
+				PriceID:        lo.ToPtr(s.testData.seatPrice.ID),
+				DisplayName:    lo.ToPtr("Seats"),
+				Amount:         decimal.NewFromInt(50),
+				Quantity:       decimal.NewFromInt(5),
+				Currency:       "usd",
+				PeriodStart:    &periodStart,
+				PeriodEnd:      &periodEnd,
+				BaseModel:      types.GetDefaultBaseModel(ctx),
+			},
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().InvoiceRepo.CreateWithLineItems(ctx, s.testData.invoice))
+}
+
+func (s *SubscriptionQuantityTestSuite) TestIncreaseIsProratedOnNextInvoice() {
+	ctx := s.GetContext()
+	sub := s.testData.subscription
+
+	resp, err := s.service.UpdateLineItemQuantity(ctx, sub.ID, s.testData.seatItem.ID, dto.UpdateLineItemQuantityRequest{
+		Quantity: decimal.NewFromInt(8),
+	})
+	s.NoError(err)
+	s.Nil(resp.CreditNote)
+	s.True(decimal.NewFromInt(8).Equal(resp.LineItem.Quantity))
+	s.True(decimal.NewFromInt(5).Equal(resp.Change.PreviousQuantity))
+
+	changes, err := s.service.ListQuantityChanges(ctx, sub.ID, s.testData.seatItem.ID)
+	s.NoError(err)
+	s.Len(changes.Items, 1)
+
+	// 3 seats for the two thirds of the period left
+	updated, lineItems, err := s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, sub.ID)
+	s.NoError(err)
+	updated.LineItems = lineItems
+	prorations, total, err := s.billingService.CalculateQuantityChangeProrations(ctx, updated, sub.CurrentPeriodStart, sub.CurrentPeriodEnd)
+	s.NoError(err)
+	s.Len(prorations, 1)
+	s.True(decimal.NewFromInt(20).Equal(total), "expected 20, got %s", total)
+	s.True(decimal.NewFromInt(3).Equal(prorations[0].Quantity))
+	s.Equal(resp.Change.ID, prorations[0].Metadata["quantity_change_id"])
+}
+
+func (s *SubscriptionQuantityTestSuite) TestDecreaseIsCredited() {
+	ctx := s.GetContext()
+	sub := s.testData.subscription
+
+	resp, err := s.service.UpdateLineItemQuantity(ctx, sub.ID, s.testData.seatItem.ID, dto.UpdateLineItemQuantityRequest{
+		Quantity: decimal.NewFromInt(2),
+	})
+	s.NoError(err)
+	s.NotNil(resp.CreditNote)
+	s.Equal(types.CreditNoteOutcomeReduceAmountRemaining, resp.CreditNote.Outcome)
+	s.True(decimal.NewFromInt(20).Equal(resp.CreditNote.TotalAmount), "expected 20, got %s", resp.CreditNote.TotalAmount)
+	s.Equal(resp.CreditNote.ID, lo.FromPtr(resp.Change.CreditNoteID))
+
+	inv, err := s.GetStores().InvoiceRepo.Get(ctx, s.testData.invoice.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(30).Equal(inv.AmountRemaining), "expected 30, got %s", inv.AmountRemaining)
+
+	// Decreases are not charged again on the next invoice
+	prorations, _, err := s.billingService.CalculateQuantityChangeProrations(ctx, sub, sub.CurrentPeriodStart, sub.CurrentPeriodEnd)
+	s.NoError(err)
+	s.Empty(prorations)
+}
+
+func (s *SubscriptionQuantityTestSuite) TestArrearChargeIsWeightedByQuantity() {
+	ctx := s.GetContext()
+	sub := s.testData.subscription
+	s.testData.seatItem.InvoiceCadence = types.InvoiceCadenceArrear
+
+	// 5 seats for the first third of the period and 8 for the rest
+	_, err := s.service.UpdateLineItemQuantity(ctx, sub.ID, s.testData.seatItem.ID, dto.UpdateLineItemQuantityRequest{
+		Quantity: decimal.NewFromInt(8),
+	})
+	s.NoError(err)
+
+	updated, lineItems, err := s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, sub.ID)
+	s.NoError(err)
+	updated.LineItems = lineItems
+	charges, total, err := s.billingService.CalculateFixedCharges(ctx, updated, sub.CurrentPeriodStart, sub.CurrentPeriodEnd)
+	s.NoError(err)
+	s.Len(charges, 1)
+	s.True(decimal.NewFromInt(70).Equal(total), "expected 70, got %s", total)
+}
+
+func (s *SubscriptionQuantityTestSuite) TestUpdateLineItemQuantityValidation() {
+	ctx := s.GetContext()
+	sub := s.testData.subscription
+
+	testCases := []struct {
+		name string
+		req  dto.UpdateLineItemQuantityRequest
+	}{
+		{
+			name: "negative_quantity",
+			req:  dto.UpdateLineItemQuantityRequest{Quantity: decimal.NewFromInt(-1)},
+		},
+		{
+			name: "same_quantity",
+			req:  dto.UpdateLineItemQuantityRequest{Quantity: decimal.NewFromInt(5)},
+		},
+		{
+			name: "effective_date_before_period",
+			req: dto.UpdateLineItemQuantityRequest{
+				Quantity:      decimal.NewFromInt(6),
+				EffectiveDate: lo.ToPtr(sub.CurrentPeriodStart.Add(-time.Hour)),
+			},
+		},
+		{
+			name: "effective_date_after_period",
+			req: dto.UpdateLineItemQuantityRequest{
+				Quantity:      decimal.NewFromInt(6),
+				EffectiveDate: lo.ToPtr(sub.CurrentPeriodEnd),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.service.UpdateLineItemQuantity(ctx, sub.ID, s.testData.seatItem.ID, tc.req)
+			s.Error(err)
+			s.True(ierr.IsValidation(err))
+		})
+	}
+
+	_, err := s.service.UpdateLineItemQuantity(ctx, sub.ID, "subs_line_missing", dto.UpdateLineItemQuantityRequest{
+		Quantity: decimal.NewFromInt(6),
+	})
+	s.True(ierr.IsNotFound(err))
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+const (
+	// trialWillEndNotice is how long before the end of a trial the trial will end webhook is sent
+	trialWillEndNotice = 3 * 24 * time.Hour
+
+	// trialWillEndBatchSize is the number of trials listed per batch when sending the trial will end webhook
+	trialWillEndBatchSize = 100
+)
+
+// applyTrial starts the trial of a new subscription. The trial ends at the requested trial end or,
+// when none is requested, after the longest trial period of the prices. The trial is the first
+// billing period of the subscription and the billing cycle is anchored to its end. It returns true
+// if the subscription is trialing.
+func applyTrial(sub *subscription.Subscription, prices []*dto.PriceResponse) bool {
+	trialEnd := sub.TrialEnd
+	if trialEnd == nil {
+		trialDays := lo.Max(lo.Map(prices, func(p *dto.PriceResponse, _ int) int {
+			return p.TrialPeriod
+		}))
+		if trialDays > 0 {
+			trialEnd = lo.ToPtr(sub.StartDate.AddDate(0, 0, trialDays))
+		}
+	}
+
+	if trialEnd == nil || !trialEnd.After(sub.StartDate) {
+		sub.TrialStart = nil
+		sub.TrialEnd = nil
+		return false
+	}
+
+	sub.TrialStart = lo.ToPtr(lo.FromPtrOr(sub.TrialStart, sub.StartDate).UTC())
+	sub.TrialEnd = lo.ToPtr(trialEnd.UTC())
+	sub.SubscriptionStatus = types.SubscriptionStatusTrialing
+	sub.BillingAnchor = *sub.TrialEnd
+	sub.CurrentPeriodStart = sub.StartDate
+	sub.CurrentPeriodEnd = *sub.TrialEnd
+	return true
+}
+
+// endTrial ends the trial of the subscription once it is over. Trials cancelled at period end or
+// requiring a payment method when none is on file are cancelled, others convert to active. It
+// returns true if the subscription converted and its billing periods should be processed.
+func (s *subscriptionService) endTrial(ctx context.Context, sub *subscription.Subscription, now time.Time) (bool, error) {
+	if sub.TrialEnd != nil && !now.After(*sub.TrialEnd) {
+		return false, nil
+	}
+
+	// Subscriptions cancelled at the end of the trial are not converted either
+	cancelAtTrialEnd := sub.CancelAtPeriodEnd && sub.CancelAt != nil &&
+		(sub.TrialEnd == nil || !sub.CancelAt.After(*sub.TrialEnd))
+	missingPaymentMethod := sub.TrialRequiresPaymentMethod && sub.PaymentMethodID == nil
+
+	if cancelAtTrialEnd || missingPaymentMethod {
+		sub.SubscriptionStatus = types.SubscriptionStatusCancelled
+		sub.CancelledAt = lo.Ternary(cancelAtTrialEnd, sub.CancelAt, sub.TrialEnd)
+		err := s.DB.WithTx(ctx, func(tx context.Context) error {
+			if err := s.SubRepo.Update(tx, sub); err != nil {
+				return err
+			}
+
+			s.publishWebhookEvent(tx, types.WebhookEventSubscriptionTrialEnded, types.WebhookEventPayload{SubscriptionID: sub.ID})
+			s.publishWebhookEvent(tx, types.WebhookEventSubscriptionCancelled, types.WebhookEventPayload{SubscriptionID: sub.ID})
+			return nil
+		})
+		if err != nil {
+			return false, err
+		}
+
+		s.Logger.Infow("cancelled subscription at trial end",
+			"subscription_id", sub.ID,
+			"trial_end", sub.TrialEnd,
+			"missing_payment_method", missingPaymentMethod)
+		return false, nil
+	}
+
+	sub.SubscriptionStatus = types.SubscriptionStatusActive
+	return true, nil
+}
+
+func (s *subscriptionService) UpdatePaymentMethod(ctx context.Context, id string, req dto.UpdateSubscriptionPaymentMethodRequest) (*dto.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.SubRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.SubscriptionStatus == types.SubscriptionStatusCancelled {
+		return nil, ierr.NewError("subscription is cancelled").
+			WithHint("The payment method of a cancelled subscription cannot be updated").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": id,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	sub.PaymentMethodID = req.PaymentMethodID
+	err = s.DB.WithTx(ctx, func(tx context.Context) error {
+		if err := s.SubRepo.Update(tx, sub); err != nil {
+			return err
+		}
+
+		s.publishWebhookEvent(tx, types.WebhookEventSubscriptionUpdated, types.WebhookEventPayload{SubscriptionID: sub.ID})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SubscriptionResponse{Subscription: sub}, nil
+}
+
+// notifyTrialsEnding sends the trial will end webhook for the trials ending within the notice. The
+// trials are paged in batches and only those not notified yet are listed, the notified marker is set
+// with a conditional update so that concurrent runs notify each trial once.
+func (s *subscriptionService) notifyTrialsEnding(ctx context.Context, now time.Time) error {
+	offset := 0
+	for {
+		filter := &types.SubscriptionFilter{
+			QueryFilter: &types.QueryFilter{
+				Limit:  lo.ToPtr(trialWillEndBatchSize),
+				Offset: lo.ToPtr(offset),
+				Status: lo.ToPtr(types.StatusPublished),
+			},
+			SubscriptionStatus:   []types.SubscriptionStatus{types.SubscriptionStatusTrialing},
+			TrialEndAfter:        lo.ToPtr(now),
+			TrialEndBefore:       lo.ToPtr(now.Add(trialWillEndNotice)),
+			TrialWillEndNotified: lo.ToPtr(false),
+		}
+
+		subs, err := s.SubRepo.ListAllTenant(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, sub := range subs {
+			// update context to include the tenant id
+			ctx := context.WithValue(ctx, types.CtxTenantID, sub.TenantID)
+			// clean the environment id to make sure it's not used
+			ctx = context.WithValue(ctx, types.CtxEnvironmentID, "")
+
+			// Marked subscriptions drop out of the listing, only those left unmarked move the offset
+			err := s.DB.WithTx(ctx, func(tx context.Context) error {
+				marked, err := s.SubRepo.MarkTrialWillEndNotified(tx, sub.ID, now)
+				if err != nil || !marked {
+					return err
+				}
+
+				s.publishWebhookEvent(tx, types.WebhookEventSubscriptionTrialWillEnd, types.WebhookEventPayload{SubscriptionID: sub.ID})
+				return nil
+			})
+			if err != nil {
+				s.Logger.Errorw("failed to mark trial will end as notified",
+					"subscription_id", sub.ID,
+					"error", err)
+				offset++
+			}
+		}
+
+		if len(subs) < trialWillEndBatchSize {
+			return nil
+		}
+	}
+}
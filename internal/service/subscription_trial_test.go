@@ -0,0 +1,319 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubscriptionTrialTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service  SubscriptionService
+	testData struct {
+		customer *customer.Customer
+		plan     *plan.Plan
+		price    *price.Price
+	}
+}
+
+func TestSubscriptionTrial(t *testing.T) {
+	suite.Run(t, new(SubscriptionTrialTestSuite))
+}
+
+func (s *SubscriptionTrialTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	s.service = NewSubscriptionService(ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		PlanRepo:         s.GetStores().PlanRepo,
+		PriceRepo:        s.GetStores().PriceRepo,
+		EventRepo:        s.GetStores().EventRepo,
+		MeterRepo:        s.GetStores().MeterRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		EntitlementRepo:  s.GetStores().EntitlementRepo,
+		EnvironmentRepo:  s.GetStores().EnvironmentRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
+		FeatureRepo:      s.GetStores().FeatureRepo,
+		TenantRepo:       s.GetStores().TenantRepo,
+		UserRepo:         s.GetStores().UserRepo,
+		AuthRepo:         s.GetStores().AuthRepo,
+		WalletRepo:       s.GetStores().WalletRepo,
+		PaymentRepo:      s.GetStores().PaymentRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	})
+	s.setupTestData()
+}
+
+func (s *SubscriptionTrialTestSuite) setupTestData() {
+	ctx := s.GetContext()
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_trial",
+		ExternalID: "ext_cust_trial",
+		Name:       "Test Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	s.testData.plan = &plan.Plan{
+		ID:        "plan_trial",
+		Name:      "Pro",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, s.testData.plan))
+
+	s.testData.price = &price.Price{
+		ID:                 "price_trial",
+		Amount:             decimal.NewFromInt(30),
+		Currency:           "usd",
+		PlanID:             s.testData.plan.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		TrialPeriod:        14,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, s.testData.price))
+}
+
+func (s *SubscriptionTrialTestSuite) createSubscription(startDate time.Time, modify func(req *dto.CreateSubscriptionRequest)) *dto.SubscriptionResponse {
+	req := dto.CreateSubscriptionRequest{
+		CustomerID:         s.testData.customer.ID,
+		PlanID:             s.testData.plan.ID,
+		StartDate:          startDate,
+		Currency:           "usd",
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+	}
+	if modify != nil {
+		modify(&req)
+	}
+
+	resp, err := s.service.CreateSubscription(s.GetContext(), req)
+	s.Require().NoError(err)
+	return resp
+}
+
+func (s *SubscriptionTrialTestSuite) listInvoices(subscriptionID string) []*invoice.Invoice {
+	invoices, err := s.GetStores().InvoiceRepo.List(s.GetContext(), &types.InvoiceFilter{
+		QueryFilter:    types.NewNoLimitQueryFilter(),
+		SubscriptionID: subscriptionID,
+	})
+	s.Require().NoError(err)
+	return invoices
+}
+
+func (s *SubscriptionTrialTestSuite) webhookEventNames(subscriptionID string) []string {
+	var names []string
+	for _, event := range s.GetWebhookEvents() {
+		var payload struct {
+			SubscriptionID string `json:"subscription_id"`
+		}
+		s.NoError(json.Unmarshal(event.Payload, &payload))
+		if payload.SubscriptionID == subscriptionID {
+			names = append(names, event.EventName)
+		}
+	}
+	return names
+}
+
+func (s *SubscriptionTrialTestSuite) TestCreateSubscriptionStartsTrialFromPrice() {
+	startDate := time.Now().UTC().Add(-time.Hour)
+	resp := s.createSubscription(startDate, nil)
+
+	trialEnd := startDate.AddDate(0, 0, 14)
+	s.Equal(types.SubscriptionStatusTrialing, resp.SubscriptionStatus)
+	s.Require().NotNil(resp.TrialStart)
+	s.Require().NotNil(resp.TrialEnd)
+	s.True(startDate.Equal(*resp.TrialStart))
+	s.True(trialEnd.Equal(*resp.TrialEnd))
+	s.True(trialEnd.Equal(resp.CurrentPeriodEnd))
+	s.True(trialEnd.Equal(resp.BillingAnchor))
+
+	// Nothing is charged in advance for the trial
+	s.Empty(s.listInvoices(resp.ID))
+}
+
+func (s *SubscriptionTrialTestSuite) TestCreateSubscriptionWithExplicitTrialEnd() {
+	startDate := time.Now().UTC().Add(-time.Hour)
+	trialEnd := startDate.AddDate(0, 0, 7)
+	resp := s.createSubscription(startDate, func(req *dto.CreateSubscriptionRequest) {
+		req.TrialEnd = lo.ToPtr(trialEnd)
+	})
+
+	s.Equal(types.SubscriptionStatusTrialing, resp.SubscriptionStatus)
+	s.Require().NotNil(resp.TrialEnd)
+	s.True(trialEnd.Equal(*resp.TrialEnd))
+	s.True(trialEnd.Equal(resp.CurrentPeriodEnd))
+}
+
+func (s *SubscriptionTrialTestSuite) TestCreateSubscriptionWithTrialEndBeforeStartFails() {
+	startDate := time.Now().UTC().Add(-time.Hour)
+	_, err := s.service.CreateSubscription(s.GetContext(), dto.CreateSubscriptionRequest{
+		CustomerID:         s.testData.customer.ID,
+		PlanID:             s.testData.plan.ID,
+		StartDate:          startDate,
+		TrialEnd:           lo.ToPtr(startDate.Add(-time.Hour)),
+		Currency:           "usd",
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+	})
+	s.Error(err)
+}
+
+func (s *SubscriptionTrialTestSuite) TestCreateSubscriptionWithoutTrialIsActive() {
+	s.testData.price.TrialPeriod = 0
+	s.NoError(s.GetStores().PriceRepo.Update(s.GetContext(), s.testData.price))
+
+	resp := s.createSubscription(time.Now().UTC().Add(-time.Hour), nil)
+	s.Equal(types.SubscriptionStatusActive, resp.SubscriptionStatus)
+	s.Nil(resp.TrialEnd)
+	s.Len(s.listInvoices(resp.ID), 1)
+}
+
+func (s *SubscriptionTrialTestSuite) TestTrialConvertsToActiveAtTrialEnd() {
+	startDate := time.Now().UTC().AddDate(0, 0, -15)
+	resp := s.createSubscription(startDate, nil)
+	trialEnd := *resp.TrialEnd
+
+	_, err := s.service.UpdateBillingPeriods(s.GetContext())
+	s.NoError(err)
+
+	sub, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), resp.ID)
+	s.NoError(err)
+	s.Equal(types.SubscriptionStatusActive, sub.SubscriptionStatus)
+	s.True(trialEnd.Equal(sub.CurrentPeriodStart))
+
+	// Only the first paid period is charged, the fixed charge of the trial is not
+	invoices := s.listInvoices(resp.ID)
+	s.Require().Len(invoices, 1)
+	s.True(decimal.NewFromInt(30).Equal(invoices[0].AmountDue))
+	s.Require().Len(invoices[0].LineItems, 1)
+	s.True(trialEnd.Equal(*invoices[0].LineItems[0].PeriodStart))
+
+	s.Contains(s.webhookEventNames(resp.ID), types.WebhookEventSubscriptionTrialEnded)
+}
+
+func (s *SubscriptionTrialTestSuite) TestTrialRequiringPaymentMethodIsCancelledWithoutOne() {
+	startDate := time.Now().UTC().AddDate(0, 0, -15)
+	resp := s.createSubscription(startDate, func(req *dto.CreateSubscriptionRequest) {
+		req.TrialRequiresPaymentMethod = true
+	})
+
+	_, err := s.service.UpdateBillingPeriods(s.GetContext())
+	s.NoError(err)
+
+	sub, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), resp.ID)
+	s.NoError(err)
+	s.Equal(types.SubscriptionStatusCancelled, sub.SubscriptionStatus)
+	s.Require().NotNil(sub.CancelledAt)
+	s.True(resp.TrialEnd.Equal(*sub.CancelledAt))
+	s.Empty(s.listInvoices(resp.ID))
+
+	s.Equal([]string{
+		types.WebhookEventSubscriptionCreated,
+		types.WebhookEventSubscriptionTrialEnded,
+		types.WebhookEventSubscriptionCancelled,
+	}, s.webhookEventNames(resp.ID))
+}
+
+func (s *SubscriptionTrialTestSuite) TestTrialRequiringPaymentMethodConvertsWithOne() {
+	startDate := time.Now().UTC().AddDate(0, 0, -15)
+	resp := s.createSubscription(startDate, func(req *dto.CreateSubscriptionRequest) {
+		req.TrialRequiresPaymentMethod = true
+	})
+
+	_, err := s.service.UpdatePaymentMethod(s.GetContext(), resp.ID, dto.UpdateSubscriptionPaymentMethodRequest{
+		PaymentMethodID: lo.ToPtr("pm_card"),
+	})
+	s.NoError(err)
+
+	_, err = s.service.UpdateBillingPeriods(s.GetContext())
+	s.NoError(err)
+
+	sub, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), resp.ID)
+	s.NoError(err)
+	s.Equal(types.SubscriptionStatusActive, sub.SubscriptionStatus)
+	s.Len(s.listInvoices(resp.ID), 1)
+}
+
+func (s *SubscriptionTrialTestSuite) TestTrialIsNotProcessedBeforeTrialEnd() {
+	resp := s.createSubscription(time.Now().UTC().AddDate(0, 0, -5), nil)
+
+	_, err := s.service.UpdateBillingPeriods(s.GetContext())
+	s.NoError(err)
+
+	sub, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), resp.ID)
+	s.NoError(err)
+	s.Equal(types.SubscriptionStatusTrialing, sub.SubscriptionStatus)
+	s.Empty(s.listInvoices(resp.ID))
+	s.Equal([]string{types.WebhookEventSubscriptionCreated}, s.webhookEventNames(resp.ID))
+}
+
+func (s *SubscriptionTrialTestSuite) TestTrialWillEndIsNotifiedOnce() {
+	// The trial ends in two days
+	resp := s.createSubscription(time.Now().UTC().AddDate(0, 0, -12), nil)
+
+	_, err := s.service.UpdateBillingPeriods(s.GetContext())
+	s.NoError(err)
+	_, err = s.service.UpdateBillingPeriods(s.GetContext())
+	s.NoError(err)
+
+	s.Equal([]string{
+		types.WebhookEventSubscriptionCreated,
+		types.WebhookEventSubscriptionTrialWillEnd,
+	}, s.webhookEventNames(resp.ID))
+
+	sub, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), resp.ID)
+	s.NoError(err)
+	s.NotNil(sub.TrialWillEndNotifiedAt)
+	s.Equal(types.SubscriptionStatusTrialing, sub.SubscriptionStatus)
+}
+
+func (s *SubscriptionTrialTestSuite) TestTrialWillEndIsNotifiedAcrossBatches() {
+	countTrialWillEnd := func() int {
+		return lo.CountBy(s.GetWebhookEvents(), func(event *types.WebhookEvent) bool {
+			return event.EventName == types.WebhookEventSubscriptionTrialWillEnd
+		})
+	}
+
+	// More trials ending in two days than fit in a batch
+	startDate := time.Now().UTC().AddDate(0, 0, -12)
+	for i := 0; i < trialWillEndBatchSize+5; i++ {
+		s.createSubscription(startDate, nil)
+	}
+
+	_, err := s.service.UpdateBillingPeriods(s.GetContext())
+	s.NoError(err)
+	s.Equal(trialWillEndBatchSize+5, countTrialWillEnd())
+
+	// The notified trials are not listed again, a new trial is still notified
+	resp := s.createSubscription(startDate, nil)
+	_, err = s.service.UpdateBillingPeriods(s.GetContext())
+	s.NoError(err)
+	s.Equal(trialWillEndBatchSize+6, countTrialWillEnd())
+	s.Contains(s.webhookEventNames(resp.ID), types.WebhookEventSubscriptionTrialWillEnd)
+}
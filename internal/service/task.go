@@ -14,8 +14,10 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/domain/customer"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/domain/task"
 	ierr "github.com/flexprice/flexprice/internal/errors"
@@ -23,7 +25,9 @@ import (
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/postgres"
 	"github.com/flexprice/flexprice/internal/publisher"
+	"github.com/flexprice/flexprice/internal/storage"
 	"github.com/flexprice/flexprice/internal/types"
+	webhookPublisher "github.com/flexprice/flexprice/internal/webhook/publisher"
 )
 
 type TaskService interface {
@@ -35,14 +39,18 @@ type TaskService interface {
 }
 
 type taskService struct {
-	taskRepo     task.Repository
-	eventRepo    events.Repository
-	meterRepo    meter.Repository
-	customerRepo customer.Repository
-	publisher    publisher.EventPublisher
-	logger       *logger.Logger
-	db           postgres.IClient
-	client       httpclient.Client
+	taskRepo         task.Repository
+	eventRepo        events.Repository
+	meterRepo        meter.Repository
+	customerRepo     customer.Repository
+	invoiceRepo      invoice.Repository
+	publisher        publisher.EventPublisher
+	webhookPublisher webhookPublisher.WebhookPublisher
+	logger           *logger.Logger
+	db               postgres.IClient
+	config           *config.Configuration
+	client           httpclient.Client
+	storage          storage.Storage
 }
 
 func NewTaskService(
@@ -50,20 +58,28 @@ func NewTaskService(
 	eventRepo events.Repository,
 	meterRepo meter.Repository,
 	customerRepo customer.Repository,
+	invoiceRepo invoice.Repository,
 	publisher publisher.EventPublisher,
+	webhookPublisher webhookPublisher.WebhookPublisher,
 	db postgres.IClient,
+	config *config.Configuration,
 	logger *logger.Logger,
 	client httpclient.Client,
+	storage storage.Storage,
 ) TaskService {
 	return &taskService{
-		taskRepo:     taskRepo,
-		eventRepo:    eventRepo,
-		meterRepo:    meterRepo,
-		customerRepo: customerRepo,
-		publisher:    publisher,
-		logger:       logger,
-		db:           db,
-		client:       client,
+		taskRepo:         taskRepo,
+		eventRepo:        eventRepo,
+		meterRepo:        meterRepo,
+		customerRepo:     customerRepo,
+		invoiceRepo:      invoiceRepo,
+		publisher:        publisher,
+		webhookPublisher: webhookPublisher,
+		logger:           logger,
+		db:               db,
+		config:           config,
+		client:           client,
+		storage:          storage,
 	}
 }
 
@@ -185,12 +201,14 @@ func (s *taskService) ProcessTask(ctx context.Context, id string) error {
 	// Create progress tracker with the task object
 	tracker := newProgressTracker(ctx, t, 100, 30*time.Second, s.taskRepo, s.logger)
 
-	// Process based on entity type
+	// Process based on task and entity type
 	var processErr error
-	switch t.EntityType {
-	case types.EntityTypeEvents:
+	switch {
+	case t.TaskType == types.TaskTypeExport:
+		processErr = s.processExport(ctx, t, tracker)
+	case t.EntityType == types.EntityTypeEvents:
 		processErr = s.processEvents(ctx, t, tracker)
-	case types.EntityTypeCustomers:
+	case t.EntityType == types.EntityTypeCustomers:
 		processErr = s.processCustomers(ctx, t, tracker)
 	default:
 		processErr = ierr.NewError("unsupported entity type").
@@ -558,7 +576,13 @@ func (s *taskService) processCustomers(ctx context.Context, t *task.Task, tracke
 		customerID := ""
 
 		// Process customer
-		customerSvc := NewCustomerService(s.customerRepo)
+		customerSvc := NewCustomerService(ServiceParams{
+			CustomerRepo:     s.customerRepo,
+			WebhookPublisher: s.webhookPublisher,
+			Logger:           s.logger,
+			Config:           s.config,
+			DB:               s.db,
+		})
 
 		// Map standard fields
 		for i, header := range standardHeaders {
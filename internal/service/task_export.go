@@ -0,0 +1,459 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/task"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+const (
+	// exportPageSize is the number of records read from the repositories at a time while exporting
+	exportPageSize = 500
+)
+
+var (
+	// invoiceExportColumns are the invoice columns of CSV invoice exports, repeated on every line item row
+	invoiceExportColumns = []string{
+		"invoice_id", "invoice_number", "customer_id", "subscription_id", "invoice_type", "invoice_status",
+		"payment_status", "currency", "subtotal", "total_discount", "total_tax", "total", "amount_due",
+		"amount_paid", "amount_remaining", "period_start", "period_end", "due_date", "created_at",
+	}
+
+	// lineItemExportColumns are the line item columns of CSV invoice exports
+	lineItemExportColumns = []string{
+		"line_item_id", "line_item_display_name", "line_item_price_id", "line_item_meter_id",
+		"line_item_quantity", "line_item_amount", "line_item_period_start", "line_item_period_end",
+	}
+)
+
+// processExport streams the records matching the filters of the task into a CSV or JSON Lines file
+// in the storage backend and sets the download URL of the file on the task
+func (s *taskService) processExport(ctx context.Context, t *task.Task, tracker task.ProgressTracker) error {
+	filter, err := t.GetExportFilter()
+	if err != nil {
+		return err
+	}
+
+	var extension, contentType string
+	switch t.FileType {
+	case types.FileTypeCSV:
+		extension, contentType = "csv", "text/csv"
+	case types.FileTypeJSONL:
+		extension, contentType = "jsonl", "application/x-ndjson"
+	default:
+		return ierr.NewError("unsupported file type for export").
+			WithHint("Exports can only be written as CSV or JSONL").
+			WithReportableDetails(map[string]interface{}{
+				"file_type": t.FileType,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	var writeRecords func(ctx context.Context, filter *types.TaskExportFilter, w *exportWriter, tracker task.ProgressTracker) error
+	switch t.EntityType {
+	case types.EntityTypeEvents:
+		writeRecords = s.exportEvents
+	case types.EntityTypeInvoices:
+		writeRecords = s.exportInvoices
+	case types.EntityTypeCustomers:
+		writeRecords = s.exportCustomers
+	default:
+		return ierr.NewError("unsupported entity type for export").
+			WithHint("Only events, invoices and customers can be exported").
+			WithReportableDetails(map[string]interface{}{
+				"entity_type": t.EntityType,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	key := fmt.Sprintf("exports/%s/%s.%s", t.TenantID, t.ID, extension)
+
+	// Stream the records into the storage backend through a pipe so that the export is never held
+	// in memory as a whole
+	pr, pw := io.Pipe()
+	writeErr := make(chan error, 1)
+	go func() {
+		w := newExportWriter(t.FileType, pw)
+		err := writeRecords(ctx, filter, w, tracker)
+		if err == nil {
+			err = w.flush()
+		}
+		pw.CloseWithError(err)
+		writeErr <- err
+	}()
+
+	uploadErr := s.storage.Upload(ctx, key, contentType, pr)
+	// Unblock the writer if the upload stopped reading early
+	pr.CloseWithError(io.ErrClosedPipe)
+	if err := <-writeErr; err != nil {
+		s.logger.Errorw("failed to write export", "task_id", t.ID, "error", err)
+		errorSummary := fmt.Sprintf("Failed to write export: %v", err)
+		t.ErrorSummary = &errorSummary
+		return err
+	}
+	if uploadErr != nil {
+		s.logger.Errorw("failed to upload export", "task_id", t.ID, "key", key, "error", uploadErr)
+		errorSummary := fmt.Sprintf("Failed to upload export: %v", uploadErr)
+		t.ErrorSummary = &errorSummary
+		return uploadErr
+	}
+
+	fileURL, err := s.storage.GetURL(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	t.FileURL = fileURL
+	if t.FileName == nil {
+		t.FileName = lo.ToPtr(fmt.Sprintf("%s_%s.%s", strings.ToLower(string(t.EntityType)), t.ID, extension))
+	}
+	t.TotalRecords = lo.ToPtr(t.SuccessfulRecords)
+
+	if err := s.taskRepo.Update(ctx, t); err != nil {
+		return err
+	}
+
+	s.logger.Infow("exported records",
+		"task_id", t.ID,
+		"entity_type", t.EntityType,
+		"records", t.SuccessfulRecords,
+		"file_url", fileURL,
+	)
+
+	return nil
+}
+
+// exportEvents writes the events matching the filters, newest first
+func (s *taskService) exportEvents(ctx context.Context, filter *types.TaskExportFilter, w *exportWriter, tracker task.ProgressTracker) error {
+	params := &events.GetEventsParams{
+		ExternalCustomerID: filter.ExternalCustomerID,
+		EventName:          filter.EventName,
+		StartTime:          lo.FromPtr(filter.StartTime),
+		EndTime:            lo.FromPtr(filter.EndTime),
+		PageSize:           exportPageSize,
+	}
+
+	// Events are keyed on the external customer ID
+	if filter.CustomerID != "" && filter.ExternalCustomerID == "" {
+		c, err := s.customerRepo.Get(ctx, filter.CustomerID)
+		if err != nil {
+			return err
+		}
+		params.ExternalCustomerID = c.ExternalID
+	}
+
+	if err := w.writeHeader([]string{
+		"id", "event_name", "external_customer_id", "customer_id", "timestamp", "source", "properties",
+	}); err != nil {
+		return err
+	}
+
+	for {
+		page, err := s.eventRepo.GetEvents(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		// The repository may return one extra event to signal that there are more
+		for _, e := range lo.Slice(page, 0, exportPageSize) {
+			if err := s.writeEvent(w, e); err != nil {
+				return err
+			}
+			tracker.Increment(true, nil)
+		}
+
+		if len(page) < exportPageSize {
+			return nil
+		}
+
+		last := page[exportPageSize-1]
+		params.IterLast = &events.EventIterator{
+			Timestamp: last.Timestamp,
+			ID:        last.ID,
+		}
+	}
+}
+
+func (s *taskService) writeEvent(w *exportWriter, e *events.Event) error {
+	if w.isJSONL() {
+		return w.writeObject(dto.Event{
+			ID:                 e.ID,
+			ExternalCustomerID: e.ExternalCustomerID,
+			CustomerID:         e.CustomerID,
+			EventName:          e.EventName,
+			Timestamp:          e.Timestamp,
+			Properties:         e.Properties,
+			Source:             e.Source,
+		})
+	}
+
+	properties, err := marshalExportJSON(e.Properties)
+	if err != nil {
+		return err
+	}
+
+	return w.writeRow([]string{
+		e.ID,
+		e.EventName,
+		e.ExternalCustomerID,
+		e.CustomerID,
+		e.Timestamp.Format(time.RFC3339Nano),
+		e.Source,
+		properties,
+	})
+}
+
+// exportInvoices writes the invoices matching the filters along with their line items. CSV files
+// have a row per line item with the invoice columns repeated.
+func (s *taskService) exportInvoices(ctx context.Context, filter *types.TaskExportFilter, w *exportWriter, tracker task.ProgressTracker) error {
+	invoiceFilter := types.NewInvoiceFilter()
+	invoiceFilter.QueryFilter.Limit = lo.ToPtr(exportPageSize)
+	invoiceFilter.CustomerID = filter.CustomerID
+	if filter.StartTime != nil || filter.EndTime != nil {
+		invoiceFilter.TimeRangeFilter = &types.TimeRangeFilter{
+			StartTime: filter.StartTime,
+			EndTime:   filter.EndTime,
+		}
+	}
+
+	if filter.ExternalCustomerID != "" && filter.CustomerID == "" {
+		c, err := s.customerRepo.GetByLookupKey(ctx, filter.ExternalCustomerID)
+		if err != nil {
+			return err
+		}
+		invoiceFilter.CustomerID = c.ID
+	}
+
+	if err := w.writeHeader(lo.Flatten([][]string{invoiceExportColumns, lineItemExportColumns})); err != nil {
+		return err
+	}
+
+	for offset := 0; ; offset += exportPageSize {
+		invoiceFilter.QueryFilter.Offset = lo.ToPtr(offset)
+		page, err := s.invoiceRepo.List(ctx, invoiceFilter)
+		if err != nil {
+			return err
+		}
+
+		for _, inv := range page {
+			if err := s.writeInvoice(w, inv); err != nil {
+				return err
+			}
+			tracker.Increment(true, nil)
+		}
+
+		if len(page) < exportPageSize {
+			return nil
+		}
+	}
+}
+
+func (s *taskService) writeInvoice(w *exportWriter, inv *invoice.Invoice) error {
+	if w.isJSONL() {
+		return w.writeObject(dto.NewInvoiceResponse(inv))
+	}
+
+	invoiceColumns := []string{
+		inv.ID,
+		lo.FromPtr(inv.InvoiceNumber),
+		inv.CustomerID,
+		lo.FromPtr(inv.SubscriptionID),
+		string(inv.InvoiceType),
+		string(inv.InvoiceStatus),
+		string(inv.PaymentStatus),
+		inv.Currency,
+		inv.Subtotal.String(),
+		inv.TotalDiscount.String(),
+		inv.TotalTax.String(),
+		inv.Total.String(),
+		inv.AmountDue.String(),
+		inv.AmountPaid.String(),
+		inv.AmountRemaining.String(),
+		formatExportTime(inv.PeriodStart),
+		formatExportTime(inv.PeriodEnd),
+		formatExportTime(inv.DueDate),
+		formatExportTime(&inv.CreatedAt),
+	}
+
+	if len(inv.LineItems) == 0 {
+		return w.writeRow(append(invoiceColumns, make([]string, len(lineItemExportColumns))...))
+	}
+
+	for _, item := range inv.LineItems {
+		row := make([]string, 0, len(invoiceColumns)+len(lineItemExportColumns))
+		row = append(row, invoiceColumns...)
+		row = append(row,
+			item.ID,
+			lo.FromPtr(item.DisplayName),
+			lo.FromPtr(item.PriceID),
+			lo.FromPtr(item.MeterID),
+			item.Quantity.String(),
+			item.Amount.String(),
+			formatExportTime(item.PeriodStart),
+			formatExportTime(item.PeriodEnd),
+		)
+		if err := w.writeRow(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportCustomers writes the customers matching the filters
+func (s *taskService) exportCustomers(ctx context.Context, filter *types.TaskExportFilter, w *exportWriter, tracker task.ProgressTracker) error {
+	customerFilter := types.NewCustomerFilter()
+	customerFilter.QueryFilter.Limit = lo.ToPtr(exportPageSize)
+	customerFilter.ExternalID = filter.ExternalCustomerID
+	if filter.CustomerID != "" {
+		customerFilter.CustomerIDs = []string{filter.CustomerID}
+	}
+	if filter.StartTime != nil || filter.EndTime != nil {
+		customerFilter.TimeRangeFilter = &types.TimeRangeFilter{
+			StartTime: filter.StartTime,
+			EndTime:   filter.EndTime,
+		}
+	}
+
+	// The columns match the ones accepted by customer imports
+	if err := w.writeHeader([]string{
+		"id", "external_id", "name", "email", "address_line1", "address_line2", "address_city",
+		"address_state", "address_postal_code", "address_country", "created_at", "metadata",
+	}); err != nil {
+		return err
+	}
+
+	for offset := 0; ; offset += exportPageSize {
+		customerFilter.QueryFilter.Offset = lo.ToPtr(offset)
+		page, err := s.customerRepo.List(ctx, customerFilter)
+		if err != nil {
+			return err
+		}
+
+		for _, c := range page {
+			var err error
+			if w.isJSONL() {
+				err = w.writeObject(&dto.CustomerResponse{Customer: c})
+			} else {
+				var metadata string
+				metadata, err = marshalExportJSON(c.Metadata)
+				if err == nil {
+					err = w.writeRow([]string{
+						c.ID,
+						c.ExternalID,
+						c.Name,
+						c.Email,
+						c.AddressLine1,
+						c.AddressLine2,
+						c.AddressCity,
+						c.AddressState,
+						c.AddressPostalCode,
+						c.AddressCountry,
+						formatExportTime(&c.CreatedAt),
+						metadata,
+					})
+				}
+			}
+			if err != nil {
+				return err
+			}
+			tracker.Increment(true, nil)
+		}
+
+		if len(page) < exportPageSize {
+			return nil
+		}
+	}
+}
+
+// exportWriter writes export records as CSV rows or JSON Lines
+type exportWriter struct {
+	fileType types.FileType
+	csv      *csv.Writer
+	jsonl    *json.Encoder
+}
+
+func newExportWriter(fileType types.FileType, w io.Writer) *exportWriter {
+	if fileType == types.FileTypeJSONL {
+		return &exportWriter{fileType: fileType, jsonl: json.NewEncoder(w)}
+	}
+	return &exportWriter{fileType: fileType, csv: csv.NewWriter(w)}
+}
+
+func (w *exportWriter) isJSONL() bool {
+	return w.fileType == types.FileTypeJSONL
+}
+
+// writeHeader writes the header row of CSV files, JSON Lines files have no header
+func (w *exportWriter) writeHeader(header []string) error {
+	if w.isJSONL() {
+		return nil
+	}
+	return w.writeRow(header)
+}
+
+func (w *exportWriter) writeRow(row []string) error {
+	if err := w.csv.Write(row); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to write export").
+			Mark(ierr.ErrSystem)
+	}
+	return nil
+}
+
+func (w *exportWriter) writeObject(v interface{}) error {
+	if err := w.jsonl.Encode(v); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to write export").
+			Mark(ierr.ErrSystem)
+	}
+	return nil
+}
+
+func (w *exportWriter) flush() error {
+	if w.isJSONL() {
+		return nil
+	}
+
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to write export").
+			Mark(ierr.ErrSystem)
+	}
+	return nil
+}
+
+// marshalExportJSON encodes a map into a single CSV column, empty maps are left blank
+func marshalExportJSON[K comparable, V any](m map[K]V) (string, error) {
+	if len(m) == 0 {
+		return "", nil
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to encode export column").
+			Mark(ierr.ErrSystem)
+	}
+	return string(data), nil
+}
+
+func formatExportTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
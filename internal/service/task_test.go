@@ -1,8 +1,12 @@
 package service
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -10,9 +14,13 @@ import (
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/customer"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/task"
+	"github.com/flexprice/flexprice/internal/storage"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -20,6 +28,7 @@ type TaskServiceSuite struct {
 	testutil.BaseServiceTestSuite
 	service  TaskService
 	client   *testutil.MockHTTPClient
+	storage  *storage.LocalStorage
 	testData struct {
 		task   *task.Task
 		events struct {
@@ -37,6 +46,7 @@ func TestTaskService(t *testing.T) {
 func (s *TaskServiceSuite) SetupTest() {
 	s.BaseServiceTestSuite.SetupTest()
 	s.client = testutil.NewMockHTTPClient()
+	s.storage = storage.NewLocalStorage(s.T().TempDir(), "")
 	s.setupService()
 	s.setupTestData()
 }
@@ -52,10 +62,14 @@ func (s *TaskServiceSuite) setupService() {
 		s.GetStores().EventRepo,
 		s.GetStores().MeterRepo,
 		s.GetStores().CustomerRepo,
+		s.GetStores().InvoiceRepo,
 		s.GetPublisher(),
+		s.GetWebhookPublisher(),
 		s.GetDB(),
+		s.GetConfig(),
 		s.GetLogger(),
 		s.client,
+		s.storage,
 	)
 }
 
@@ -657,3 +671,293 @@ func (s *TaskServiceSuite) TestProcessTaskWithCustomers() {
 		})
 	}
 }
+
+func (s *TaskServiceSuite) TestCreateExportTaskValidation() {
+	tests := []struct {
+		name string
+		req  dto.CreateTaskRequest
+	}{
+		{
+			name: "file_url_set",
+			req: dto.CreateTaskRequest{
+				TaskType:   types.TaskTypeExport,
+				EntityType: types.EntityTypeEvents,
+				FileURL:    "https://example.com/events.csv",
+				FileType:   types.FileTypeCSV,
+			},
+		},
+		{
+			name: "unsupported_entity_type",
+			req: dto.CreateTaskRequest{
+				TaskType:   types.TaskTypeExport,
+				EntityType: types.EntityTypePrices,
+				FileType:   types.FileTypeCSV,
+			},
+		},
+		{
+			name: "unsupported_file_type",
+			req: dto.CreateTaskRequest{
+				TaskType:   types.TaskTypeExport,
+				EntityType: types.EntityTypeEvents,
+				FileType:   types.FileTypeJSON,
+			},
+		},
+		{
+			name: "end_time_before_start_time",
+			req: dto.CreateTaskRequest{
+				TaskType:   types.TaskTypeExport,
+				EntityType: types.EntityTypeEvents,
+				FileType:   types.FileTypeCSV,
+				Filters: &types.TaskExportFilter{
+					StartTime: lo.ToPtr(s.testData.now),
+					EndTime:   lo.ToPtr(s.testData.now.Add(-time.Hour)),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.service.CreateTask(s.GetContext(), tt.req)
+			s.Error(err)
+		})
+	}
+}
+
+func (s *TaskServiceSuite) TestExportEvents() {
+	s.Run("csv_with_event_name_filter", func() {
+		t := s.createExportTask(types.EntityTypeEvents, types.FileTypeCSV, &types.TaskExportFilter{
+			EventName: "storage_usage",
+		})
+
+		rows := s.readExportCSV(t)
+		s.Len(rows, 3)
+		s.Equal([]string{"id", "event_name", "external_customer_id", "customer_id", "timestamp", "source", "properties"}, rows[0])
+		for _, row := range rows[1:] {
+			s.Equal("storage_usage", row[1])
+			s.Equal("cust_ext_123", row[2])
+
+			var props map[string]interface{}
+			s.NoError(json.Unmarshal([]byte(row[6]), &props))
+			s.Equal("us-east-1", props["region"])
+		}
+
+		s.Equal(2, lo.FromPtr(t.TotalRecords))
+		s.Equal(2, t.SuccessfulRecords)
+		s.Equal(types.TaskStatusCompleted, t.TaskStatus)
+		s.NotEmpty(t.FileURL)
+		s.NotNil(t.FileName)
+	})
+
+	s.Run("jsonl_across_pages", func() {
+		// Exactly two pages so that the last page is full
+		for i := 0; i < 2*exportPageSize; i++ {
+			s.NoError(s.GetStores().EventRepo.InsertEvent(s.GetContext(), &events.Event{
+				ID:                 fmt.Sprintf("event_bulk_%04d", i),
+				TenantID:           s.testData.task.TenantID,
+				EventName:          "bulk",
+				ExternalCustomerID: "cust_ext_123",
+				Timestamp:          s.testData.now.Add(-time.Duration(i%7) * time.Minute),
+				Properties:         map[string]interface{}{},
+			}))
+		}
+
+		t := s.createExportTask(types.EntityTypeEvents, types.FileTypeJSONL, &types.TaskExportFilter{
+			EventName: "bulk",
+		})
+
+		lines := s.readExportJSONL(t)
+		s.Len(lines, 2*exportPageSize)
+
+		ids := lo.Map(lines, func(line map[string]interface{}, _ int) string {
+			s.Equal("bulk", line["event_name"])
+			return line["id"].(string)
+		})
+		s.Len(lo.Uniq(ids), 2*exportPageSize)
+		s.Equal(2*exportPageSize, lo.FromPtr(t.TotalRecords))
+	})
+}
+
+func (s *TaskServiceSuite) TestExportInvoices() {
+	ctx := s.GetContext()
+	inv := &invoice.Invoice{
+		ID:              "inv_export_1",
+		CustomerID:      "cust_export_1",
+		InvoiceType:     types.InvoiceTypeOneOff,
+		InvoiceStatus:   types.InvoiceStatusFinalized,
+		PaymentStatus:   types.PaymentStatusPending,
+		Currency:        "usd",
+		Subtotal:        decimal.NewFromInt(30),
+		Total:           decimal.NewFromInt(30),
+		AmountDue:       decimal.NewFromInt(30),
+		AmountRemaining: decimal.NewFromInt(30),
+		LineItems: []*invoice.InvoiceLineItem{
+			{
+				ID:          "inli_export_1",
+				InvoiceID:   "inv_export_1",
+				CustomerID:  "cust_export_1",
+				DisplayName: lo.ToPtr("Seats"),
+				Amount:      decimal.NewFromInt(10),
+				Quantity:    decimal.NewFromInt(1),
+				Currency:    "usd",
+				BaseModel:   types.GetDefaultBaseModel(ctx),
+			},
+			{
+				ID:          "inli_export_2",
+				InvoiceID:   "inv_export_1",
+				CustomerID:  "cust_export_1",
+				DisplayName: lo.ToPtr("API calls"),
+				Amount:      decimal.NewFromInt(20),
+				Quantity:    decimal.NewFromInt(200),
+				Currency:    "usd",
+				BaseModel:   types.GetDefaultBaseModel(ctx),
+			},
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().InvoiceRepo.Create(ctx, inv))
+
+	other := &invoice.Invoice{
+		ID:            "inv_export_2",
+		CustomerID:    "cust_export_2",
+		InvoiceType:   types.InvoiceTypeOneOff,
+		InvoiceStatus: types.InvoiceStatusDraft,
+		PaymentStatus: types.PaymentStatusPending,
+		Currency:      "usd",
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().InvoiceRepo.Create(ctx, other))
+
+	s.Run("csv_row_per_line_item", func() {
+		t := s.createExportTask(types.EntityTypeInvoices, types.FileTypeCSV, &types.TaskExportFilter{
+			CustomerID: inv.CustomerID,
+		})
+
+		rows := s.readExportCSV(t)
+		s.Len(rows, 3)
+		header := rows[0]
+		col := func(row []string, name string) string {
+			return row[lo.IndexOf(header, name)]
+		}
+		for _, row := range rows[1:] {
+			s.Equal(inv.ID, col(row, "invoice_id"))
+			s.Equal("30", col(row, "total"))
+		}
+		s.Equal("inli_export_1", col(rows[1], "line_item_id"))
+		s.Equal("Seats", col(rows[1], "line_item_display_name"))
+		s.Equal("200", col(rows[2], "line_item_quantity"))
+		s.Equal(1, lo.FromPtr(t.TotalRecords))
+	})
+
+	s.Run("csv_invoice_without_line_items", func() {
+		t := s.createExportTask(types.EntityTypeInvoices, types.FileTypeCSV, &types.TaskExportFilter{
+			CustomerID: other.CustomerID,
+		})
+
+		rows := s.readExportCSV(t)
+		s.Len(rows, 2)
+		s.Equal(other.ID, rows[1][0])
+		s.Len(rows[1], len(rows[0]))
+	})
+
+	s.Run("jsonl_with_line_items", func() {
+		t := s.createExportTask(types.EntityTypeInvoices, types.FileTypeJSONL, nil)
+
+		lines := s.readExportJSONL(t)
+		s.Len(lines, 2)
+		exported, ok := lo.Find(lines, func(line map[string]interface{}) bool {
+			return line["id"] == inv.ID
+		})
+		s.True(ok)
+		s.Len(exported["line_items"], 2)
+	})
+}
+
+func (s *TaskServiceSuite) TestExportCustomers() {
+	ctx := s.GetContext()
+	for i := 1; i <= 3; i++ {
+		s.NoError(s.GetStores().CustomerRepo.Create(ctx, &customer.Customer{
+			ID:         fmt.Sprintf("cust_export_%d", i),
+			ExternalID: fmt.Sprintf("cust_ext_export_%d", i),
+			Name:       fmt.Sprintf("Customer %d", i),
+			Email:      fmt.Sprintf("customer%d@example.com", i),
+			Metadata:   map[string]string{"industry": "Software"},
+			BaseModel:  types.GetDefaultBaseModel(ctx),
+		}))
+	}
+
+	s.Run("csv_with_external_id_filter", func() {
+		t := s.createExportTask(types.EntityTypeCustomers, types.FileTypeCSV, &types.TaskExportFilter{
+			ExternalCustomerID: "cust_ext_export_2",
+		})
+
+		rows := s.readExportCSV(t)
+		s.Len(rows, 2)
+		s.Equal("cust_export_2", rows[1][0])
+		s.Equal("cust_ext_export_2", rows[1][1])
+		s.Equal("customer2@example.com", rows[1][3])
+		s.JSONEq(`{"industry":"Software"}`, rows[1][len(rows[1])-1])
+	})
+
+	s.Run("jsonl_all_customers", func() {
+		t := s.createExportTask(types.EntityTypeCustomers, types.FileTypeJSONL, nil)
+
+		lines := s.readExportJSONL(t)
+		s.Len(lines, 3)
+		s.Equal(3, t.SuccessfulRecords)
+	})
+}
+
+// createExportTask creates and processes an export task and returns it as stored after processing
+func (s *TaskServiceSuite) createExportTask(entityType types.EntityType, fileType types.FileType, filters *types.TaskExportFilter) *task.Task {
+	resp, err := s.service.CreateTask(s.GetContext(), dto.CreateTaskRequest{
+		TaskType:   types.TaskTypeExport,
+		EntityType: entityType,
+		FileType:   fileType,
+		Filters:    filters,
+	})
+	s.Require().NoError(err)
+
+	t, err := s.GetStores().TaskRepo.Get(s.GetContext(), resp.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.TaskStatusCompleted, t.TaskStatus)
+	return t
+}
+
+func (s *TaskServiceSuite) readExport(t *task.Task) []byte {
+	extension := "csv"
+	if t.FileType == types.FileTypeJSONL {
+		extension = "jsonl"
+	}
+
+	key := fmt.Sprintf("exports/%s/%s.%s", t.TenantID, t.ID, extension)
+	url, err := s.storage.GetURL(s.GetContext(), key)
+	s.Require().NoError(err)
+	s.Equal(url, t.FileURL)
+
+	r, err := s.storage.Download(s.GetContext(), key)
+	s.Require().NoError(err)
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	s.Require().NoError(err)
+	return data
+}
+
+func (s *TaskServiceSuite) readExportCSV(t *task.Task) [][]string {
+	rows, err := csv.NewReader(bytes.NewReader(s.readExport(t))).ReadAll()
+	s.Require().NoError(err)
+	return rows
+}
+
+func (s *TaskServiceSuite) readExportJSONL(t *task.Task) []map[string]interface{} {
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(bytes.NewReader(s.readExport(t)))
+	for scanner.Scan() {
+		var line map[string]interface{}
+		s.Require().NoError(json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	s.Require().NoError(scanner.Err())
+	return lines
+}
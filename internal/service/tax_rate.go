@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/tax"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// TaxRateService defines the interface for tax rate operations
+type TaxRateService interface {
+	CreateTaxRate(ctx context.Context, req dto.CreateTaxRateRequest) (*dto.TaxRateResponse, error)
+	GetTaxRate(ctx context.Context, id string) (*dto.TaxRateResponse, error)
+	ListTaxRates(ctx context.Context, filter *types.TaxRateFilter) (*dto.ListTaxRatesResponse, error)
+	UpdateTaxRate(ctx context.Context, id string, req dto.UpdateTaxRateRequest) (*dto.TaxRateResponse, error)
+	DeleteTaxRate(ctx context.Context, id string) error
+}
+
+type taxRateService struct {
+	ServiceParams
+}
+
+// NewTaxRateService creates a new tax rate service
+func NewTaxRateService(params ServiceParams) TaxRateService {
+	return &taxRateService{
+		ServiceParams: params,
+	}
+}
+
+func (s *taxRateService) CreateTaxRate(ctx context.Context, req dto.CreateTaxRateRequest) (*dto.TaxRateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	r := req.ToTaxRate(ctx)
+	if err := s.TaxRateRepo.Create(ctx, r); err != nil {
+		return nil, err
+	}
+
+	return &dto.TaxRateResponse{TaxRate: r}, nil
+}
+
+func (s *taxRateService) GetTaxRate(ctx context.Context, id string) (*dto.TaxRateResponse, error) {
+	if id == "" {
+		return nil, ierr.NewError("tax rate ID is required").
+			WithHint("Tax rate ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	r, err := s.TaxRateRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.TaxRateResponse{TaxRate: r}, nil
+}
+
+func (s *taxRateService) ListTaxRates(ctx context.Context, filter *types.TaxRateFilter) (*dto.ListTaxRatesResponse, error) {
+	if filter == nil {
+		filter = types.NewDefaultTaxRateFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	rates, err := s.TaxRateRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.TaxRateRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListTaxRatesResponse{
+		Items: make([]*dto.TaxRateResponse, len(rates)),
+	}
+
+	for i, r := range rates {
+		response.Items[i] = &dto.TaxRateResponse{TaxRate: r}
+	}
+
+	response.Pagination = types.NewPaginationResponse(
+		count,
+		filter.GetLimit(),
+		filter.GetOffset(),
+	)
+
+	return response, nil
+}
+
+func (s *taxRateService) UpdateTaxRate(ctx context.Context, id string, req dto.UpdateTaxRateRequest) (*dto.TaxRateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	r, err := s.TaxRateRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		r.Name = *req.Name
+	}
+	if req.Description != nil {
+		r.Description = *req.Description
+	}
+	if req.Metadata != nil {
+		r.Metadata = *req.Metadata
+	}
+
+	if err := s.TaxRateRepo.Update(ctx, r); err != nil {
+		return nil, err
+	}
+
+	return &dto.TaxRateResponse{TaxRate: r}, nil
+}
+
+// DeleteTaxRate archives the tax rate so it is no longer applied to new invoices.
+// Invoices already issued keep a snapshot of the rate in their tax lines.
+func (s *taxRateService) DeleteTaxRate(ctx context.Context, id string) error {
+	if _, err := s.TaxRateRepo.Get(ctx, id); err != nil {
+		return err
+	}
+
+	return s.TaxRateRepo.Delete(ctx, id)
+}
+
+// getApplicableTaxRates returns the published tax rates of the customer's jurisdiction.
+// Tax exempt customers and customers without a billing country are not taxed.
+func getApplicableTaxRates(ctx context.Context, taxRateRepo tax.Repository, cust *customer.Customer) ([]*tax.TaxRate, error) {
+	if taxRateRepo == nil || cust == nil || cust.TaxExempt || cust.AddressCountry == "" {
+		return nil, nil
+	}
+
+	filter := types.NewNoLimitTaxRateFilter()
+	filter.Country = cust.AddressCountry
+	filter.Status = lo.ToPtr(types.StatusPublished)
+
+	rates, err := taxRateRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(rates, func(r *tax.TaxRate, _ int) bool {
+		return r.AppliesTo(cust.AddressCountry, cust.AddressState)
+	}), nil
+}
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type TaxRateServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service TaxRateService
+}
+
+func TestTaxRateService(t *testing.T) {
+	suite.Run(t, new(TaxRateServiceSuite))
+}
+
+func (s *TaxRateServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	s.service = NewTaxRateService(s.serviceParams())
+}
+
+func (s *TaxRateServiceSuite) serviceParams() ServiceParams {
+	return ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CreditNoteRepo:   s.GetStores().CreditNoteRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	}
+}
+
+func (s *TaxRateServiceSuite) createTaxRate(req dto.CreateTaxRateRequest) *dto.TaxRateResponse {
+	resp, err := s.service.CreateTaxRate(s.GetContext(), req)
+	s.NoError(err)
+	return resp
+}
+
+func (s *TaxRateServiceSuite) createCustomer(id, country, state string, exempt bool) *customer.Customer {
+	c := &customer.Customer{
+		ID:             id,
+		ExternalID:     "ext_" + id,
+		Name:           "Test Customer",
+		AddressCountry: country,
+		AddressState:   state,
+		TaxExempt:      exempt,
+		BaseModel:      types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(s.GetContext(), c))
+	return c
+}
+
+func (s *TaxRateServiceSuite) TestCreateTaxRate() {
+	tests := []struct {
+		name    string
+		req     dto.CreateTaxRateRequest
+		wantErr bool
+	}{
+		{
+			name: "country_rate",
+			req: dto.CreateTaxRateRequest{
+				Name:        "VAT",
+				Percentage:  decimal.NewFromInt(20),
+				TaxBehavior: types.TaxBehaviorExclusive,
+				Country:     "gb",
+			},
+		},
+		{
+			name: "state_rate",
+			req: dto.CreateTaxRateRequest{
+				Name:        "CA Sales Tax",
+				Percentage:  decimal.NewFromFloat(7.25),
+				TaxBehavior: types.TaxBehaviorExclusive,
+				Country:     "US",
+				State:       "CA",
+			},
+		},
+		{
+			name: "percentage_above_100",
+			req: dto.CreateTaxRateRequest{
+				Name:        "Invalid",
+				Percentage:  decimal.NewFromInt(101),
+				TaxBehavior: types.TaxBehaviorExclusive,
+				Country:     "US",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_tax_behavior",
+			req: dto.CreateTaxRateRequest{
+				Name:        "Invalid",
+				Percentage:  decimal.NewFromInt(10),
+				TaxBehavior: "both",
+				Country:     "US",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_country",
+			req: dto.CreateTaxRateRequest{
+				Name:        "Invalid",
+				Percentage:  decimal.NewFromInt(10),
+				TaxBehavior: types.TaxBehaviorInclusive,
+				Country:     "XX",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp, err := s.service.CreateTaxRate(s.GetContext(), tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.True(ierr.IsValidation(err))
+				return
+			}
+			s.NoError(err)
+			s.NotEmpty(resp.ID)
+			s.Equal(types.StatusPublished, resp.Status)
+		})
+	}
+}
+
+func (s *TaxRateServiceSuite) TestListTaxRatesByJurisdiction() {
+	s.createTaxRate(dto.CreateTaxRateRequest{
+		Name:        "VAT",
+		Percentage:  decimal.NewFromInt(20),
+		TaxBehavior: types.TaxBehaviorInclusive,
+		Country:     "GB",
+	})
+	s.createTaxRate(dto.CreateTaxRateRequest{
+		Name:        "CA Sales Tax",
+		Percentage:  decimal.NewFromFloat(7.25),
+		TaxBehavior: types.TaxBehaviorExclusive,
+		Country:     "US",
+		State:       "CA",
+	})
+
+	filter := types.NewDefaultTaxRateFilter()
+	filter.Country = "us"
+	resp, err := s.service.ListTaxRates(s.GetContext(), filter)
+	s.NoError(err)
+	s.Len(resp.Items, 1)
+	s.Equal("CA Sales Tax", resp.Items[0].Name)
+}
+
+func (s *TaxRateServiceSuite) TestDeleteTaxRate() {
+	r := s.createTaxRate(dto.CreateTaxRateRequest{
+		Name:        "VAT",
+		Percentage:  decimal.NewFromInt(20),
+		TaxBehavior: types.TaxBehaviorExclusive,
+		Country:     "GB",
+	})
+
+	s.NoError(s.service.DeleteTaxRate(s.GetContext(), r.ID))
+
+	resp, err := s.service.ListTaxRates(s.GetContext(), types.NewDefaultTaxRateFilter())
+	s.NoError(err)
+	s.Empty(resp.Items)
+}
+
+func (s *TaxRateServiceSuite) TestCreateInvoiceWithTaxes() {
+	s.createTaxRate(dto.CreateTaxRateRequest{
+		Name:        "US Federal",
+		Percentage:  decimal.NewFromInt(5),
+		TaxBehavior: types.TaxBehaviorExclusive,
+		Country:     "US",
+	})
+	s.createTaxRate(dto.CreateTaxRateRequest{
+		Name:        "CA Sales Tax",
+		Percentage:  decimal.NewFromInt(10),
+		TaxBehavior: types.TaxBehaviorExclusive,
+		Country:     "US",
+		State:       "CA",
+	})
+	s.createTaxRate(dto.CreateTaxRateRequest{
+		Name:        "VAT",
+		Percentage:  decimal.NewFromInt(25),
+		TaxBehavior: types.TaxBehaviorInclusive,
+		Country:     "SE",
+	})
+
+	tests := []struct {
+		name          string
+		customer      *customer.Customer
+		wantTaxLines  int
+		wantTotalTax  decimal.Decimal
+		wantAmountDue decimal.Decimal
+	}{
+		{
+			name:          "exclusive_country_and_state_rates",
+			customer:      s.createCustomer("cust_ca", "US", "CA", false),
+			wantTaxLines:  2,
+			wantTotalTax:  decimal.NewFromInt(15),
+			wantAmountDue: decimal.NewFromInt(115),
+		},
+		{
+			name:          "state_rate_does_not_apply_to_other_states",
+			customer:      s.createCustomer("cust_ny", "US", "NY", false),
+			wantTaxLines:  1,
+			wantTotalTax:  decimal.NewFromInt(5),
+			wantAmountDue: decimal.NewFromInt(105),
+		},
+		{
+			name:          "inclusive_rate_keeps_amount_due",
+			customer:      s.createCustomer("cust_se", "SE", "", false),
+			wantTaxLines:  1,
+			wantTotalTax:  decimal.NewFromInt(20),
+			wantAmountDue: decimal.NewFromInt(100),
+		},
+		{
+			name:          "tax_exempt_customer",
+			customer:      s.createCustomer("cust_exempt", "US", "CA", true),
+			wantTaxLines:  0,
+			wantTotalTax:  decimal.Zero,
+			wantAmountDue: decimal.NewFromInt(100),
+		},
+		{
+			name:          "customer_without_address",
+			customer:      s.createCustomer("cust_no_address", "", "", false),
+			wantTaxLines:  0,
+			wantTotalTax:  decimal.Zero,
+			wantAmountDue: decimal.NewFromInt(100),
+		},
+	}
+
+	invoiceService := NewInvoiceService(s.serviceParams())
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp, err := invoiceService.CreateInvoice(s.GetContext(), dto.CreateInvoiceRequest{
+				CustomerID:    tt.customer.ID,
+				InvoiceType:   types.InvoiceTypeOneOff,
+				Currency:      "usd",
+				AmountDue:     decimal.NewFromInt(100),
+				BillingReason: types.InvoiceBillingReasonManual,
+				PaymentStatus: lo.ToPtr(types.PaymentStatusPending),
+				LineItems: []dto.CreateInvoiceLineItemRequest{
+					{
+						DisplayName: lo.ToPtr("Setup fee"),
+						Amount:      decimal.NewFromInt(100),
+						Quantity:    decimal.NewFromInt(1),
+					},
+				},
+			})
+			s.NoError(err)
+			s.Len(resp.Taxes, tt.wantTaxLines)
+			s.True(tt.wantTotalTax.Equal(resp.TotalTax), "total tax %s", resp.TotalTax)
+			s.True(tt.wantAmountDue.Equal(resp.AmountDue), "amount due %s", resp.AmountDue)
+			s.True(tt.wantAmountDue.Equal(resp.Total), "total %s", resp.Total)
+			s.True(tt.wantAmountDue.Equal(resp.AmountRemaining), "amount remaining %s", resp.AmountRemaining)
+			s.assertLineItemsReconcile(resp)
+		})
+	}
+}
+
+func (s *TaxRateServiceSuite) TestCreateInvoiceSkipsTaxForNonTaxableInvoices() {
+	s.createTaxRate(dto.CreateTaxRateRequest{
+		Name:        "US Federal",
+		Percentage:  decimal.NewFromInt(5),
+		TaxBehavior: types.TaxBehaviorExclusive,
+		Country:     "US",
+	})
+	cust := s.createCustomer("cust_non_taxable", "US", "CA", false)
+
+	now := time.Now().UTC()
+	tests := []struct {
+		name   string
+		modify func(req *dto.CreateInvoiceRequest)
+	}{
+		{
+			name: "wallet_auto_topup",
+			modify: func(req *dto.CreateInvoiceRequest) {
+				req.Metadata = types.Metadata{autoTopupMetadataKey: "true"}
+			},
+		},
+		{
+			name: "plan_change_proration",
+			modify: func(req *dto.CreateInvoiceRequest) {
+				req.InvoiceType = types.InvoiceTypeSubscription
+				req.SubscriptionID = lo.ToPtr("sub_plan_change")
+				req.BillingReason = types.InvoiceBillingReasonSubscriptionUpdate
+				req.BillingPeriod = lo.ToPtr(string(types.BILLING_PERIOD_MONTHLY))
+				req.PeriodStart = lo.ToPtr(now.AddDate(0, -1, 0))
+				req.PeriodEnd = lo.ToPtr(now)
+				req.LineItems[0].PriceID = lo.ToPtr("price_plan_change")
+			},
+		},
+	}
+
+	invoiceService := NewInvoiceService(s.serviceParams())
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			req := dto.CreateInvoiceRequest{
+				CustomerID:    cust.ID,
+				InvoiceType:   types.InvoiceTypeOneOff,
+				Currency:      "usd",
+				AmountDue:     decimal.NewFromInt(100),
+				BillingReason: types.InvoiceBillingReasonManual,
+				PaymentStatus: lo.ToPtr(types.PaymentStatusPending),
+				LineItems: []dto.CreateInvoiceLineItemRequest{
+					{
+						DisplayName: lo.ToPtr("Charge"),
+						Amount:      decimal.NewFromInt(100),
+						Quantity:    decimal.NewFromInt(1),
+					},
+				},
+			}
+			tt.modify(&req)
+
+			resp, err := invoiceService.CreateInvoice(s.GetContext(), req)
+			s.NoError(err)
+			s.Empty(resp.Taxes)
+			s.True(resp.TotalTax.IsZero(), "total tax %s", resp.TotalTax)
+			s.True(decimal.NewFromInt(100).Equal(resp.AmountDue), "amount due %s", resp.AmountDue)
+			s.assertLineItemsReconcile(resp)
+		})
+	}
+}
+
+// assertLineItemsReconcile checks that the line items less discounts plus exclusive taxes sum to the amount due
+func (s *TaxRateServiceSuite) assertLineItemsReconcile(resp *dto.InvoiceResponse) {
+	total := decimal.Zero
+	for _, item := range resp.LineItems {
+		total = total.Add(item.Amount)
+	}
+	total = total.Sub(resp.TotalDiscount)
+	for _, t := range resp.Taxes {
+		if t.TaxBehavior == string(types.TaxBehaviorExclusive) {
+			total = total.Add(t.Amount)
+		}
+	}
+	s.True(total.Equal(resp.AmountDue), "line items reconcile to %s, amount due %s", total, resp.AmountDue)
+}
@@ -81,7 +81,7 @@ func (s *tenantService) createTenantAsBillingCustomer(ctx context.Context, t *te
 	}
 
 	// Create customer in billing tenant
-	customerService := NewCustomerService(s.CustomerRepo)
+	customerService := NewCustomerService(s.ServiceParams)
 	_, err := customerService.CreateCustomer(billingCtx, createCustomerReq)
 	return err
 }
@@ -159,6 +159,10 @@ func (s *tenantService) UpdateTenant(ctx context.Context, id string, req dto.Upd
 	}
 	existingTenant.BillingDetails = billingDetails
 
+	if req.DunningPolicy != nil {
+		existingTenant.DunningPolicy = req.DunningPolicy.ToDomain()
+	}
+
 	// Update the timestamp
 	existingTenant.UpdatedAt = time.Now()
 
@@ -172,7 +176,7 @@ func (s *tenantService) UpdateTenant(ctx context.Context, id string, req dto.Upd
 
 func (s *tenantService) GetBillingUsage(ctx context.Context) (*dto.TenantBillingUsage, error) {
 	billingService := NewBillingService(s.ServiceParams)
-	customerService := NewCustomerService(s.CustomerRepo)
+	customerService := NewCustomerService(s.ServiceParams)
 	subscriptionService := NewSubscriptionService(s.ServiceParams)
 
 	response := &dto.TenantBillingUsage{}
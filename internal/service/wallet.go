@@ -26,6 +26,9 @@ type WalletService interface {
 	// GetWalletTransactions retrieves transactions for a wallet with pagination
 	GetWalletTransactions(ctx context.Context, walletID string, filter *types.WalletTransactionFilter) (*dto.ListWalletTransactionsResponse, error)
 
+	// GetWalletTransactionByID retrieves a wallet transaction by its ID
+	GetWalletTransactionByID(ctx context.Context, id string) (*dto.WalletTransactionResponse, error)
+
 	// TopUpWallet adds credits to a wallet
 	TopUpWallet(ctx context.Context, walletID string, req *dto.TopUpWalletRequest) (*dto.WalletResponse, error)
 
@@ -191,6 +194,21 @@ func (s *walletService) GetWalletTransactions(ctx context.Context, walletID stri
 	return response, nil
 }
 
+func (s *walletService) GetWalletTransactionByID(ctx context.Context, id string) (*dto.WalletTransactionResponse, error) {
+	if id == "" {
+		return nil, ierr.NewError("transaction_id is required").
+			WithHint("Transaction ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	txn, err := s.WalletRepo.GetTransactionByID(ctx, id)
+	if err != nil {
+		return nil, err // Repository already using ierr
+	}
+
+	return dto.FromWalletTransaction(txn), nil
+}
+
 // Update the TopUpWallet method to use the new processWalletOperation
 func (s *walletService) TopUpWallet(ctx context.Context, walletID string, req *dto.TopUpWalletRequest) (*dto.WalletResponse, error) {
 	// Create a credit operation
@@ -312,6 +330,18 @@ func (s *walletService) GetWalletBalance(ctx context.Context, walletID string) (
 		"currency", w.Currency,
 	)
 
+	// Top up the wallet when the real-time balance has crossed the threshold, the unpaid invoice of an
+	// invoiced top-up offsets its credit so the response is computed from the balance before the top-up
+	if s.shouldAutoTopup(w, realTimeBalance.Div(w.ConversionRate)) {
+		if _, err := s.autoTopupWallet(ctx, w); err != nil {
+			s.Logger.Errorw("failed to auto top-up wallet",
+				"wallet_id", walletID,
+				"real_time_balance", realTimeBalance,
+				"error", err,
+			)
+		}
+	}
+
 	return &dto.WalletBalanceResponse{
 		Wallet:                w,
 		RealTimeBalance:       realTimeBalance,
@@ -400,6 +430,15 @@ func (s *walletService) UpdateWallet(ctx context.Context, id string, req *dto.Up
 	if req.AutoTopupAmount != nil {
 		existing.AutoTopupAmount = *req.AutoTopupAmount
 	}
+	if req.AutoTopupMethod != nil {
+		existing.AutoTopupMethod = *req.AutoTopupMethod
+	}
+	if req.AutoTopupPaymentMethodType != nil {
+		existing.AutoTopupPaymentMethodType = *req.AutoTopupPaymentMethodType
+	}
+	if req.AutoTopupPaymentMethodID != nil {
+		existing.AutoTopupPaymentMethodID = *req.AutoTopupPaymentMethodID
+	}
 	if req.Config != nil {
 		existing.Config = *req.Config
 	}
@@ -516,9 +555,15 @@ func (s *walletService) processDebitOperation(ctx context.Context, req *wallet.W
 func (s *walletService) processWalletOperation(ctx context.Context, req *wallet.WalletOperation) error {
 	s.Logger.Debugw("Processing wallet operation", "req", req)
 
-	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+	var (
+		w                *wallet.Wallet
+		tx               *wallet.Transaction
+		newCreditBalance decimal.Decimal
+	)
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
 		// Get wallet
-		w, err := s.WalletRepo.GetWalletByID(ctx, req.WalletID)
+		var err error
+		w, err = s.WalletRepo.GetWalletByID(ctx, req.WalletID)
 		if err != nil {
 			return err
 		}
@@ -528,7 +573,6 @@ func (s *walletService) processWalletOperation(ctx context.Context, req *wallet.
 			return err
 		}
 
-		var newCreditBalance decimal.Decimal
 		// For debit operations, find and consume available credits
 		if req.Type == types.TransactionTypeDebit {
 			newCreditBalance = w.CreditBalance.Sub(req.CreditAmount)
@@ -545,7 +589,7 @@ func (s *walletService) processWalletOperation(ctx context.Context, req *wallet.
 		finalBalance := newCreditBalance.Mul(w.ConversionRate)
 
 		// Create transaction record
-		tx := &wallet.Transaction{
+		tx = &wallet.Transaction{
 			ID:                  types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WALLET_TRANSACTION),
 			WalletID:            req.WalletID,
 			Type:                req.Type,
@@ -584,9 +628,45 @@ func (s *walletService) processWalletOperation(ctx context.Context, req *wallet.
 			return err
 		}
 
+		s.publishWebhookEvent(ctx, types.WebhookEventWalletTransactionCreated, types.WebhookEventPayload{
+			TransactionID: tx.ID,
+			WalletID:      w.ID,
+		})
+
+		if req.Type == types.TransactionTypeDebit && s.crossedLowBalanceThreshold(w, tx.CreditBalanceBefore, tx.CreditBalanceAfter) {
+			s.publishWebhookEvent(ctx, types.WebhookEventWalletBalanceLow, types.WebhookEventPayload{WalletID: w.ID})
+		}
+
 		s.Logger.Debugw("Wallet operation completed")
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// Top up the wallet once the debit has been committed, a failed top-up does not fail the debit
+	if req.Type == types.TransactionTypeDebit &&
+		req.TransactionReason != types.TransactionReasonWalletTermination &&
+		s.shouldAutoTopup(w, newCreditBalance) {
+		if _, err := s.autoTopupWallet(ctx, w); err != nil {
+			s.Logger.Errorw("failed to auto top-up wallet",
+				"wallet_id", w.ID,
+				"credit_balance", newCreditBalance,
+				"error", err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// crossedLowBalanceThreshold returns true if an operation moving the credit balance of the wallet
+// from before to after dropped it below the balance threshold of the wallet
+func (s *walletService) crossedLowBalanceThreshold(w *wallet.Wallet, before, after decimal.Decimal) bool {
+	return w.WalletStatus == types.WalletStatusActive &&
+		w.AutoTopupTrigger == types.AutoTopupTriggerBalanceBelowThreshold &&
+		before.GreaterThanOrEqual(w.AutoTopupMinBalance) &&
+		after.LessThan(w.AutoTopupMinBalance)
 }
 
 // ExpireCredits expires credits for a given transaction
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/payment"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/idempotency"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// autoTopupMetadataKey marks the invoices, payments and wallet credits issued by an auto top-up
+	autoTopupMetadataKey = "auto_topup"
+
+	// autoTopupMaxPaymentAttempts bounds the payments charged for a single auto top-up invoice
+	autoTopupMaxPaymentAttempts = 3
+)
+
+// isAutoTopup returns true if the metadata was stamped by an auto top-up
+func isAutoTopup(metadata types.Metadata) bool {
+	return metadata != nil && metadata[autoTopupMetadataKey] == "true"
+}
+
+// shouldAutoTopup returns true if the credit balance of the wallet is below its auto top-up threshold
+func (s *walletService) shouldAutoTopup(w *wallet.Wallet, creditBalance decimal.Decimal) bool {
+	return w.WalletStatus == types.WalletStatusActive &&
+		w.AutoTopupEnabled() &&
+		creditBalance.LessThan(w.AutoTopupMinBalance)
+}
+
+// autoTopupWallet tops up the wallet by its auto top-up amount. The top-up is keyed on the latest
+// credit of the wallet so that concurrent debits crossing the threshold issue a single top-up, and
+// no new top-up is issued while the invoice of the previous one is unpaid. It returns true if the
+// wallet was credited.
+func (s *walletService) autoTopupWallet(ctx context.Context, w *wallet.Wallet) (bool, error) {
+	if w.WalletStatus != types.WalletStatusActive || !w.AutoTopupEnabled() {
+		return false, nil
+	}
+
+	txFilter := types.NewWalletTransactionFilter()
+	txFilter.WalletID = lo.ToPtr(w.ID)
+	txFilter.Type = lo.ToPtr(types.TransactionTypeCredit)
+	txFilter.QueryFilter.Limit = lo.ToPtr(1)
+
+	credits, err := s.WalletRepo.ListWalletTransactions(ctx, txFilter)
+	if err != nil {
+		return false, err
+	}
+
+	var lastCreditID string
+	if len(credits) > 0 {
+		lastCredit := credits[0]
+		lastCreditID = lastCredit.ID
+
+		// An invoiced top-up credits the wallet before it is paid, wait for the payment before
+		// extending more credit
+		if isAutoTopup(lastCredit.Metadata) && lastCredit.ReferenceType == types.WalletTxReferenceTypeInvoice {
+			inv, err := s.InvoiceRepo.Get(ctx, lastCredit.ReferenceID)
+			if err != nil {
+				return false, err
+			}
+			if inv.PaymentStatus != types.PaymentStatusSucceeded {
+				s.Logger.Infow("skipping auto top-up, previous auto top-up invoice is unpaid",
+					"wallet_id", w.ID,
+					"invoice_id", inv.ID,
+				)
+				return false, nil
+			}
+		}
+	}
+
+	idempKey := idempotency.NewGenerator().GenerateKey(idempotency.ScopeWalletAutoTopup, map[string]interface{}{
+		"wallet_id":      w.ID,
+		"last_credit_id": lastCreditID,
+	})
+
+	existing, err := s.InvoiceRepo.GetByIdempotencyKey(ctx, idempKey)
+	if err != nil && !ierr.IsNotFound(err) {
+		return false, err
+	}
+	if existing != nil {
+		// A top-up whose payment failed is retried with a new payment against the same invoice
+		if w.AutoTopupMethod == types.AutoTopupMethodPayment && existing.PaymentStatus != types.PaymentStatusSucceeded {
+			return s.autoTopupWithPayment(ctx, w, idempKey)
+		}
+
+		s.Logger.Infow("skipping auto top-up, already issued for the latest credit",
+			"wallet_id", w.ID,
+			"invoice_id", existing.ID,
+		)
+		return false, nil
+	}
+
+	if w.AutoTopupMethod == types.AutoTopupMethodPayment {
+		return s.autoTopupWithPayment(ctx, w, idempKey)
+	}
+
+	return s.autoTopupWithInvoice(ctx, w, idempKey)
+}
+
+// autoTopupWithInvoice credits the wallet against a finalized invoice of the top-up amount
+func (s *walletService) autoTopupWithInvoice(ctx context.Context, w *wallet.Wallet, idempKey string) (bool, error) {
+	credited := false
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		inv, err := s.createAutoTopupInvoice(ctx, w, idempKey)
+		if err != nil {
+			return err
+		}
+
+		// A concurrent top-up that committed first has already credited the wallet
+		exists, err := s.hasCreditForReference(ctx, w.ID, types.WalletTxReferenceTypeInvoice, inv.ID)
+		if err != nil || exists {
+			return err
+		}
+
+		if err := s.CreditWallet(ctx, &wallet.WalletOperation{
+			WalletID:          w.ID,
+			Type:              types.TransactionTypeCredit,
+			CreditAmount:      w.AutoTopupAmount,
+			Description:       "Auto top-up",
+			Metadata:          types.Metadata{autoTopupMetadataKey: "true"},
+			TransactionReason: types.TransactionReasonPurchasedCreditInvoiced,
+			ReferenceType:     types.WalletTxReferenceTypeInvoice,
+			ReferenceID:       inv.ID,
+		}); err != nil {
+			return err
+		}
+
+		credited = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return credited, nil
+}
+
+// autoTopupWithPayment charges the configured payment method for a finalized invoice of the top-up
+// amount and credits the wallet once the payment succeeds. A failed payment leaves the invoice
+// unpaid and the wallet is not credited, the next top-up retries with a new payment against the
+// same invoice until autoTopupMaxPaymentAttempts payments have failed.
+func (s *walletService) autoTopupWithPayment(ctx context.Context, w *wallet.Wallet, idempKey string) (bool, error) {
+	var p *payment.Payment
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		inv, err := s.createAutoTopupInvoice(ctx, w, idempKey)
+		if err != nil {
+			return err
+		}
+
+		payments, err := s.PaymentRepo.List(ctx, &types.PaymentFilter{
+			QueryFilter:     types.NewNoLimitQueryFilter(),
+			DestinationType: lo.ToPtr(string(types.PaymentDestinationTypeInvoice)),
+			DestinationID:   lo.ToPtr(inv.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		// A concurrent top-up that committed first has already created a payment that is not failed
+		if lo.SomeBy(payments, func(p *payment.Payment) bool { return p.PaymentStatus != types.PaymentStatusFailed }) {
+			return nil
+		}
+
+		attempt := len(payments)
+		if attempt >= autoTopupMaxPaymentAttempts {
+			s.Logger.Warnw("skipping auto top-up, payment attempts exhausted",
+				"wallet_id", w.ID,
+				"invoice_id", inv.ID,
+				"attempts", attempt,
+			)
+			return nil
+		}
+
+		// The first payment keeps the key of the top-up, retries are keyed on their attempt
+		paymentKey := idempKey
+		if attempt > 0 {
+			paymentKey = idempotency.NewGenerator().GenerateKey(idempotency.ScopeWalletAutoTopup, map[string]interface{}{
+				"invoice_id": inv.ID,
+				"attempt":    attempt,
+			})
+		}
+
+		paymentResp, err := NewPaymentService(s.ServiceParams).CreatePayment(ctx, &dto.CreatePaymentRequest{
+			IdempotencyKey:    paymentKey,
+			DestinationType:   types.PaymentDestinationTypeInvoice,
+			DestinationID:     inv.ID,
+			PaymentMethodType: w.AutoTopupPaymentMethodType,
+			PaymentMethodID:   w.AutoTopupPaymentMethodID,
+			Amount:            inv.AmountDue,
+			Currency:          inv.Currency,
+			Metadata: types.Metadata{
+				"wallet_id":          w.ID,
+				autoTopupMetadataKey: "true",
+			},
+			ProcessPayment: false,
+		})
+		if err != nil {
+			return err
+		}
+
+		p, err = s.PaymentRepo.Get(ctx, paymentResp.ID)
+		return err
+	})
+	if err != nil || p == nil {
+		return false, err
+	}
+
+	// Charge the payment method outside of the transaction so that the payment and its attempts are
+	// recorded even if the charge fails
+	p, err = NewPaymentProcessorService(s.ServiceParams).ProcessPayment(ctx, p.ID)
+	if err != nil {
+		return false, err
+	}
+	if p.PaymentStatus != types.PaymentStatusSucceeded {
+		return false, nil
+	}
+
+	if err := s.CreditWallet(ctx, &wallet.WalletOperation{
+		WalletID:          w.ID,
+		Type:              types.TransactionTypeCredit,
+		CreditAmount:      w.AutoTopupAmount,
+		Description:       "Auto top-up",
+		Metadata:          types.Metadata{autoTopupMetadataKey: "true"},
+		TransactionReason: types.TransactionReasonPurchasedCreditDirect,
+		ReferenceType:     types.WalletTxReferenceTypePayment,
+		ReferenceID:       p.ID,
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// createAutoTopupInvoice creates the finalized one-off invoice of the auto top-up amount
+func (s *walletService) createAutoTopupInvoice(ctx context.Context, w *wallet.Wallet, idempKey string) (*dto.InvoiceResponse, error) {
+	amount := w.AutoTopupAmount.Mul(w.ConversionRate)
+	metadata := types.Metadata{
+		"wallet_id":          w.ID,
+		autoTopupMetadataKey: "true",
+	}
+
+	return NewInvoiceService(s.ServiceParams).CreateInvoice(ctx, dto.CreateInvoiceRequest{
+		CustomerID:     w.CustomerID,
+		IdempotencyKey: lo.ToPtr(idempKey),
+		InvoiceType:    types.InvoiceTypeOneOff,
+		Currency:       w.Currency,
+		AmountDue:      amount,
+		Description:    fmt.Sprintf("Auto top-up of %s credits", w.AutoTopupAmount.String()),
+		BillingReason:  types.InvoiceBillingReasonManual,
+		InvoiceStatus:  lo.ToPtr(types.InvoiceStatusFinalized),
+		PaymentStatus:  lo.ToPtr(types.PaymentStatusPending),
+		AmountPaid:     lo.ToPtr(decimal.Zero),
+		LineItems: []dto.CreateInvoiceLineItemRequest{
+			{
+				DisplayName: lo.ToPtr("Wallet auto top-up"),
+				Amount:      amount,
+				Quantity:    decimal.NewFromInt(1),
+				Metadata:    metadata,
+			},
+		},
+		Metadata: metadata,
+	})
+}
+
+// hasCreditForReference returns true if the wallet has been credited for the reference
+func (s *walletService) hasCreditForReference(ctx context.Context, walletID string, referenceType types.WalletTxReferenceType, referenceID string) (bool, error) {
+	txFilter := types.NewNoLimitWalletTransactionFilter()
+	txFilter.WalletID = lo.ToPtr(walletID)
+	txFilter.Type = lo.ToPtr(types.TransactionTypeCredit)
+	txFilter.ReferenceType = lo.ToPtr(string(referenceType))
+	txFilter.ReferenceID = lo.ToPtr(referenceID)
+
+	count, err := s.WalletRepo.CountWalletTransactions(ctx, txFilter)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
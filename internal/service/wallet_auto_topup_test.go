@@ -0,0 +1,381 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/payment"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type WalletAutoTopupTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service  WalletService
+	testData struct {
+		customer *customer.Customer
+		wallet   *wallet.Wallet
+	}
+}
+
+func TestWalletAutoTopup(t *testing.T) {
+	suite.Run(t, new(WalletAutoTopupTestSuite))
+}
+
+func (s *WalletAutoTopupTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	s.service = NewWalletService(ServiceParams{
+		Logger:           s.GetLogger(),
+		Config:           s.GetConfig(),
+		DB:               s.GetDB(),
+		WalletRepo:       s.GetStores().WalletRepo,
+		SubRepo:          s.GetStores().SubscriptionRepo,
+		PlanRepo:         s.GetStores().PlanRepo,
+		PriceRepo:        s.GetStores().PriceRepo,
+		EventRepo:        s.GetStores().EventRepo,
+		MeterRepo:        s.GetStores().MeterRepo,
+		CustomerRepo:     s.GetStores().CustomerRepo,
+		InvoiceRepo:      s.GetStores().InvoiceRepo,
+		EntitlementRepo:  s.GetStores().EntitlementRepo,
+		FeatureRepo:      s.GetStores().FeatureRepo,
+		TaxRateRepo:      s.GetStores().TaxRateRepo,
+		CouponRepo:       s.GetStores().CouponRepo,
+		PaymentRepo:      s.GetStores().PaymentRepo,
+		EventPublisher:   s.GetPublisher(),
+		WebhookPublisher: s.GetWebhookPublisher(),
+	})
+	s.setupTestData()
+}
+
+func (s *WalletAutoTopupTestSuite) setupTestData() {
+	ctx := s.GetContext()
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_auto_topup",
+		ExternalID: "ext_cust_auto_topup",
+		Name:       "Auto Topup Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	// 100 credits at 2 usd per credit, topped up by 50 credits when below 20 credits
+	s.testData.wallet = &wallet.Wallet{
+		ID:                  "wallet_auto_topup",
+		CustomerID:          s.testData.customer.ID,
+		Currency:            "usd",
+		WalletType:          types.WalletTypePrePaid,
+		Balance:             decimal.NewFromInt(200),
+		CreditBalance:       decimal.NewFromInt(100),
+		ConversionRate:      decimal.NewFromInt(2),
+		WalletStatus:        types.WalletStatusActive,
+		AutoTopupTrigger:    types.AutoTopupTriggerBalanceBelowThreshold,
+		AutoTopupMinBalance: decimal.NewFromInt(20),
+		AutoTopupAmount:     decimal.NewFromInt(50),
+		AutoTopupMethod:     types.AutoTopupMethodInvoice,
+		BaseModel:           types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().WalletRepo.CreateWallet(ctx, s.testData.wallet))
+
+	// Seed the wallet with the credit backing its balance
+	s.NoError(s.GetStores().WalletRepo.CreateTransaction(ctx, &wallet.Transaction{
+		ID:                  "wtx_seed",
+		WalletID:            s.testData.wallet.ID,
+		Type:                types.TransactionTypeCredit,
+		Amount:              decimal.NewFromInt(200),
+		CreditAmount:        decimal.NewFromInt(100),
+		CreditsAvailable:    decimal.NewFromInt(100),
+		ReferenceType:       types.WalletTxReferenceTypeRequest,
+		ReferenceID:         "req_seed",
+		TxStatus:            types.TransactionStatusCompleted,
+		TransactionReason:   types.TransactionReasonFreeCredit,
+		CreditBalanceBefore: decimal.Zero,
+		CreditBalanceAfter:  decimal.NewFromInt(100),
+		BaseModel:           types.GetDefaultBaseModel(ctx),
+	}))
+}
+
+func (s *WalletAutoTopupTestSuite) debit(credits int64) {
+	s.NoError(s.service.DebitWallet(s.GetContext(), &wallet.WalletOperation{
+		WalletID:          s.testData.wallet.ID,
+		Type:              types.TransactionTypeDebit,
+		CreditAmount:      decimal.NewFromInt(credits),
+		TransactionReason: types.TransactionReasonInvoicePayment,
+	}))
+}
+
+func (s *WalletAutoTopupTestSuite) webhookEventNames() []string {
+	return lo.Map(s.GetWebhookEvents(), func(event *types.WebhookEvent, _ int) string {
+		return event.EventName
+	})
+}
+
+func (s *WalletAutoTopupTestSuite) listTopupInvoices() []*invoice.Invoice {
+	invoices, err := s.GetStores().InvoiceRepo.List(s.GetContext(), types.NewNoLimitInvoiceFilter())
+	s.NoError(err)
+	return lo.Filter(invoices, func(inv *invoice.Invoice, _ int) bool {
+		return isAutoTopup(inv.Metadata)
+	})
+}
+
+func (s *WalletAutoTopupTestSuite) setPaymentMethod(paymentMethodType types.PaymentMethodType, paymentMethodID string) {
+	_, err := s.service.UpdateWallet(s.GetContext(), s.testData.wallet.ID, &dto.UpdateWalletRequest{
+		AutoTopupTrigger:           lo.ToPtr(types.AutoTopupTriggerBalanceBelowThreshold),
+		AutoTopupMinBalance:        lo.ToPtr(decimal.NewFromInt(20)),
+		AutoTopupAmount:            lo.ToPtr(decimal.NewFromInt(50)),
+		AutoTopupMethod:            lo.ToPtr(types.AutoTopupMethodPayment),
+		AutoTopupPaymentMethodType: lo.ToPtr(paymentMethodType),
+		AutoTopupPaymentMethodID:   lo.ToPtr(paymentMethodID),
+	})
+	s.NoError(err)
+}
+
+func (s *WalletAutoTopupTestSuite) TestDebitAboveThresholdDoesNotTopUp() {
+	s.debit(70)
+
+	w, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallet.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(30).Equal(w.CreditBalance))
+	s.Empty(s.listTopupInvoices())
+}
+
+func (s *WalletAutoTopupTestSuite) TestDebitCrossingThresholdTopsUpWithInvoice() {
+	s.debit(90)
+
+	w, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallet.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(60).Equal(w.CreditBalance), "expected 60 credits, got %s", w.CreditBalance)
+	s.True(decimal.NewFromInt(120).Equal(w.Balance), "expected balance 120, got %s", w.Balance)
+
+	invoices := s.listTopupInvoices()
+	s.Require().Len(invoices, 1)
+	s.Equal(types.InvoiceTypeOneOff, invoices[0].InvoiceType)
+	s.Equal(types.InvoiceStatusFinalized, invoices[0].InvoiceStatus)
+	s.Equal(types.PaymentStatusPending, invoices[0].PaymentStatus)
+	s.True(decimal.NewFromInt(100).Equal(invoices[0].AmountDue), "expected amount due 100, got %s", invoices[0].AmountDue)
+
+	txFilter := types.NewNoLimitWalletTransactionFilter()
+	txFilter.WalletID = lo.ToPtr(s.testData.wallet.ID)
+	txFilter.ReferenceType = lo.ToPtr(string(types.WalletTxReferenceTypeInvoice))
+	txFilter.ReferenceID = lo.ToPtr(invoices[0].ID)
+	txs, err := s.GetStores().WalletRepo.ListWalletTransactions(s.GetContext(), txFilter)
+	s.NoError(err)
+	s.Require().Len(txs, 1)
+	s.Equal(types.TransactionReasonPurchasedCreditInvoiced, txs[0].TransactionReason)
+	s.True(decimal.NewFromInt(50).Equal(txs[0].CreditAmount))
+}
+
+func (s *WalletAutoTopupTestSuite) TestNoTopUpWhilePreviousTopUpInvoiceIsUnpaid() {
+	s.debit(90)
+	s.debit(45)
+
+	w, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallet.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(15).Equal(w.CreditBalance), "expected 15 credits, got %s", w.CreditBalance)
+	s.Len(s.listTopupInvoices(), 1)
+
+	// Once the top-up invoice is paid the next debit tops up again
+	inv := s.listTopupInvoices()[0]
+	inv.PaymentStatus = types.PaymentStatusSucceeded
+	inv.AmountPaid = inv.AmountDue
+	inv.AmountRemaining = decimal.Zero
+	s.NoError(s.GetStores().InvoiceRepo.Update(s.GetContext(), inv))
+
+	s.debit(5)
+
+	w, err = s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallet.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(60).Equal(w.CreditBalance), "expected 60 credits, got %s", w.CreditBalance)
+	s.Len(s.listTopupInvoices(), 2)
+}
+
+func (s *WalletAutoTopupTestSuite) TestTopUpIsIdempotentForTheSameCredit() {
+	s.debit(90)
+
+	// A concurrent debit that read the wallet before the top-up resolves to the same top-up
+	stale := *s.testData.wallet
+	topped, err := s.service.(*walletService).autoTopupWallet(s.GetContext(), &stale)
+	s.NoError(err)
+	s.False(topped)
+	s.Len(s.listTopupInvoices(), 1)
+}
+
+func (s *WalletAutoTopupTestSuite) TestDebitCrossingThresholdTopsUpWithPayment() {
+	s.setPaymentMethod(types.PaymentMethodTypeOffline, "")
+
+	s.debit(90)
+
+	w, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallet.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(60).Equal(w.CreditBalance), "expected 60 credits, got %s", w.CreditBalance)
+
+	invoices := s.listTopupInvoices()
+	s.Require().Len(invoices, 1)
+	s.Equal(types.PaymentStatusSucceeded, invoices[0].PaymentStatus)
+	s.True(invoices[0].AmountRemaining.IsZero())
+
+	payments, err := s.GetStores().PaymentRepo.List(s.GetContext(), &types.PaymentFilter{
+		QueryFilter:   types.NewNoLimitQueryFilter(),
+		DestinationID: lo.ToPtr(invoices[0].ID),
+	})
+	s.NoError(err)
+	s.Require().Len(payments, 1)
+	s.Equal(types.PaymentStatusSucceeded, payments[0].PaymentStatus)
+	s.Equal(types.PaymentMethodTypeOffline, payments[0].PaymentMethodType)
+
+	txFilter := types.NewNoLimitWalletTransactionFilter()
+	txFilter.WalletID = lo.ToPtr(s.testData.wallet.ID)
+	txFilter.ReferenceType = lo.ToPtr(string(types.WalletTxReferenceTypePayment))
+	txFilter.ReferenceID = lo.ToPtr(payments[0].ID)
+	txs, err := s.GetStores().WalletRepo.ListWalletTransactions(s.GetContext(), txFilter)
+	s.NoError(err)
+	s.Require().Len(txs, 1)
+	s.Equal(types.TransactionReasonPurchasedCreditDirect, txs[0].TransactionReason)
+}
+
+func (s *WalletAutoTopupTestSuite) TestFailedPaymentIsRetriedUpToMaxAttempts() {
+	s.setPaymentMethod(types.PaymentMethodTypeCard, "pm_auto_topup")
+
+	s.debit(90)
+	s.debit(1)
+	s.debit(1)
+	s.debit(1)
+
+	w, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallet.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(7).Equal(w.CreditBalance), "expected 7 credits, got %s", w.CreditBalance)
+
+	// Every retry charges the same invoice with a new payment
+	invoices := s.listTopupInvoices()
+	s.Require().Len(invoices, 1)
+	s.Equal(types.PaymentStatusPending, invoices[0].PaymentStatus)
+
+	payments, err := s.GetStores().PaymentRepo.List(s.GetContext(), &types.PaymentFilter{
+		QueryFilter:   types.NewNoLimitQueryFilter(),
+		DestinationID: lo.ToPtr(invoices[0].ID),
+	})
+	s.NoError(err)
+	s.Require().Len(payments, autoTopupMaxPaymentAttempts)
+	for _, p := range payments {
+		s.Equal(types.PaymentStatusFailed, p.PaymentStatus)
+	}
+}
+
+func (s *WalletAutoTopupTestSuite) TestFailedPaymentRetrySucceeds() {
+	s.setPaymentMethod(types.PaymentMethodTypeCard, "pm_auto_topup")
+	s.debit(90)
+
+	s.setPaymentMethod(types.PaymentMethodTypeOffline, "")
+	s.debit(5)
+
+	w, err := s.GetStores().WalletRepo.GetWalletByID(s.GetContext(), s.testData.wallet.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(55).Equal(w.CreditBalance), "expected 55 credits, got %s", w.CreditBalance)
+
+	invoices := s.listTopupInvoices()
+	s.Require().Len(invoices, 1)
+
+	payments, err := s.GetStores().PaymentRepo.List(s.GetContext(), &types.PaymentFilter{
+		QueryFilter:   types.NewNoLimitQueryFilter(),
+		DestinationID: lo.ToPtr(invoices[0].ID),
+	})
+	s.NoError(err)
+	s.Require().Len(payments, 2)
+	s.Equal(1, lo.CountBy(payments, func(p *payment.Payment) bool { return p.PaymentStatus == types.PaymentStatusSucceeded }))
+}
+
+func (s *WalletAutoTopupTestSuite) TestDebitCrossingThresholdPublishesBalanceLow() {
+	s.debit(70)
+	s.NotContains(s.webhookEventNames(), types.WebhookEventWalletBalanceLow)
+
+	s.debit(15)
+	s.Equal(1, lo.Count(s.webhookEventNames(), types.WebhookEventWalletBalanceLow))
+
+	// The debit and the auto top-up credit are both published as transactions
+	s.Equal(3, lo.Count(s.webhookEventNames(), types.WebhookEventWalletTransactionCreated))
+}
+
+func (s *WalletAutoTopupTestSuite) TestTopUpPaymentPublishesPaymentEvents() {
+	s.setPaymentMethod(types.PaymentMethodTypeOffline, "")
+	s.debit(90)
+	s.Contains(s.webhookEventNames(), types.WebhookEventPaymentSucceeded)
+
+	s.setPaymentMethod(types.PaymentMethodTypeCard, "pm_auto_topup")
+	s.debit(50)
+	s.Contains(s.webhookEventNames(), types.WebhookEventPaymentFailed)
+}
+
+func (s *WalletAutoTopupTestSuite) TestGetWalletBalanceTopsUpBelowThreshold() {
+	ctx := s.GetContext()
+	s.NoError(s.GetStores().WalletRepo.UpdateWalletBalance(ctx, s.testData.wallet.ID, decimal.NewFromInt(20), decimal.NewFromInt(10)))
+
+	_, err := s.service.GetWalletBalance(ctx, s.testData.wallet.ID)
+	s.NoError(err)
+
+	w, err := s.GetStores().WalletRepo.GetWalletByID(ctx, s.testData.wallet.ID)
+	s.NoError(err)
+	s.True(decimal.NewFromInt(60).Equal(w.CreditBalance), "expected 60 credits, got %s", w.CreditBalance)
+	s.Len(s.listTopupInvoices(), 1)
+
+	// The unpaid top-up invoice keeps the real-time balance below the threshold without topping up again
+	_, err = s.service.GetWalletBalance(ctx, s.testData.wallet.ID)
+	s.NoError(err)
+	s.Len(s.listTopupInvoices(), 1)
+}
+
+func (s *WalletAutoTopupTestSuite) TestWalletTerminationDoesNotTopUp() {
+	s.NoError(s.service.TerminateWallet(s.GetContext(), s.testData.wallet.ID))
+	s.Empty(s.listTopupInvoices())
+}
+
+func (s *WalletAutoTopupTestSuite) TestUpdateWalletAutoTopupValidation() {
+	tests := []struct {
+		name string
+		req  *dto.UpdateWalletRequest
+	}{
+		{
+			name: "invalid_method",
+			req: &dto.UpdateWalletRequest{
+				AutoTopupTrigger:    lo.ToPtr(types.AutoTopupTriggerBalanceBelowThreshold),
+				AutoTopupMinBalance: lo.ToPtr(decimal.NewFromInt(20)),
+				AutoTopupAmount:     lo.ToPtr(decimal.NewFromInt(50)),
+				AutoTopupMethod:     lo.ToPtr(types.AutoTopupMethod("wire")),
+			},
+		},
+		{
+			name: "payment_without_payment_method",
+			req: &dto.UpdateWalletRequest{
+				AutoTopupTrigger:    lo.ToPtr(types.AutoTopupTriggerBalanceBelowThreshold),
+				AutoTopupMinBalance: lo.ToPtr(decimal.NewFromInt(20)),
+				AutoTopupAmount:     lo.ToPtr(decimal.NewFromInt(50)),
+				AutoTopupMethod:     lo.ToPtr(types.AutoTopupMethodPayment),
+			},
+		},
+		{
+			name: "payment_with_credits",
+			req: &dto.UpdateWalletRequest{
+				AutoTopupTrigger:           lo.ToPtr(types.AutoTopupTriggerBalanceBelowThreshold),
+				AutoTopupMinBalance:        lo.ToPtr(decimal.NewFromInt(20)),
+				AutoTopupAmount:            lo.ToPtr(decimal.NewFromInt(50)),
+				AutoTopupMethod:            lo.ToPtr(types.AutoTopupMethodPayment),
+				AutoTopupPaymentMethodType: lo.ToPtr(types.PaymentMethodTypeCredits),
+				AutoTopupPaymentMethodID:   lo.ToPtr("wallet_other"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.service.UpdateWallet(s.GetContext(), s.testData.wallet.ID, tt.req)
+			s.Error(err)
+			s.True(ierr.IsValidation(err))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"sort"
+	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
@@ -30,6 +31,9 @@ type WalletPaymentOptions struct {
 	Strategy WalletPaymentStrategy
 	// MaxWalletsToUse limits the number of wallets to use (0 means no limit)
 	MaxWalletsToUse int
+	// ExpiringCreditsFirst uses the wallets holding the credits that expire first before the
+	// other wallets of the same type
+	ExpiringCreditsFirst bool
 	// AdditionalMetadata to include in payment requests
 	AdditionalMetadata types.Metadata
 }
@@ -191,9 +195,64 @@ func (s *walletPaymentService) GetWalletsForPayment(
 	// Sort wallets based on the selected strategy
 	sortedWallets := s.sortWalletsByStrategy(activeWallets, options.Strategy)
 
+	if options.ExpiringCreditsFirst {
+		return s.sortWalletsByCreditExpiry(ctx, sortedWallets, options.Strategy)
+	}
+
 	return sortedWallets, nil
 }
 
+// sortWalletsByCreditExpiry moves the wallets holding the credits that expire first to the front of
+// their wallet type, the wallet types keep the priority of the strategy
+func (s *walletPaymentService) sortWalletsByCreditExpiry(
+	ctx context.Context,
+	wallets []*wallet.Wallet,
+	strategy WalletPaymentStrategy,
+) ([]*wallet.Wallet, error) {
+	if strategy == "" {
+		strategy = PromotionalFirstStrategy
+	}
+
+	// The eligible credits of a wallet are ordered by expiry, the first one expires first
+	expiries := make(map[string]*time.Time, len(wallets))
+	for _, w := range wallets {
+		credits, err := s.WalletRepo.FindEligibleCredits(ctx, w.ID, decimal.Zero, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(credits) > 0 {
+			expiries[w.ID] = credits[0].ExpiryDate
+		}
+	}
+
+	priority := func(w *wallet.Wallet) int {
+		switch {
+		case strategy == PromotionalFirstStrategy && w.WalletType != types.WalletTypePromotional:
+			return 1
+		case strategy == PrepaidFirstStrategy && w.WalletType != types.WalletTypePrePaid:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	result := append([]*wallet.Wallet{}, wallets...)
+	sort.SliceStable(result, func(i, j int) bool {
+		if pi, pj := priority(result[i]), priority(result[j]); pi != pj {
+			return pi < pj
+		}
+
+		// Credits without an expiry are used last
+		ei, ej := expiries[result[i].ID], expiries[result[j].ID]
+		if ei == nil || ej == nil {
+			return ei != nil && ej == nil
+		}
+		return ei.Before(*ej)
+	})
+
+	return result, nil
+}
+
 // sortWalletsByStrategy sorts wallets based on the specified strategy
 func (s *walletPaymentService) sortWalletsByStrategy(
 	wallets []*wallet.Wallet,
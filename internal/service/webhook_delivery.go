@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/httpclient"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/webhook/delivery"
+	"github.com/samber/lo"
+)
+
+// WebhookDeliveryService defines the interface for inspecting and replaying webhook deliveries
+type WebhookDeliveryService interface {
+	GetWebhookDelivery(ctx context.Context, id string) (*dto.WebhookDeliveryResponse, error)
+	ListWebhookDeliveries(ctx context.Context, filter *types.WebhookDeliveryFilter) (*dto.ListWebhookDeliveriesResponse, error)
+
+	// ReplayWebhookDelivery sends the payload of the delivery again to its destination. The new
+	// delivery is returned whether it succeeded or not. The events which failed before any
+	// delivery are published again instead.
+	ReplayWebhookDelivery(ctx context.Context, id string) (*dto.WebhookDeliveryResponse, error)
+
+	// ReplayWebhookDeliveries replays the deliveries of the time range whose event was never
+	// received by the destination
+	ReplayWebhookDeliveries(ctx context.Context, req dto.ReplayWebhookDeliveriesRequest) (*dto.ReplayWebhookDeliveriesResponse, error)
+}
+
+type webhookDeliveryService struct {
+	ServiceParams
+	sender            *delivery.Sender
+	encryptionService security.EncryptionService
+}
+
+// NewWebhookDeliveryService creates a new webhook delivery service
+func NewWebhookDeliveryService(params ServiceParams, client httpclient.WebhookClient) WebhookDeliveryService {
+	encryptionService, err := security.NewEncryptionService(params.Config, params.Logger)
+	if err != nil {
+		params.Logger.Fatalw("failed to create encryption service", "error", err)
+	}
+
+	return &webhookDeliveryService{
+		ServiceParams:     params,
+		sender:            delivery.NewSender(client, params.WebhookDeliveryRepo, params.Logger),
+		encryptionService: encryptionService,
+	}
+}
+
+func (s *webhookDeliveryService) GetWebhookDelivery(ctx context.Context, id string) (*dto.WebhookDeliveryResponse, error) {
+	if id == "" {
+		return nil, ierr.NewError("webhook delivery ID is required").
+			WithHint("Webhook delivery ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	d, err := s.WebhookDeliveryRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.WebhookDeliveryResponse{WebhookDelivery: d}, nil
+}
+
+func (s *webhookDeliveryService) ListWebhookDeliveries(ctx context.Context, filter *types.WebhookDeliveryFilter) (*dto.ListWebhookDeliveriesResponse, error) {
+	if filter == nil {
+		filter = types.NewDefaultWebhookDeliveryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.WebhookDeliveryRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.WebhookDeliveryRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListWebhookDeliveriesResponse{
+		Items: make([]*dto.WebhookDeliveryResponse, len(deliveries)),
+	}
+
+	for i, d := range deliveries {
+		response.Items[i] = &dto.WebhookDeliveryResponse{WebhookDelivery: d}
+	}
+
+	response.Pagination = types.NewPaginationResponse(
+		count,
+		filter.GetLimit(),
+		filter.GetOffset(),
+	)
+
+	return response, nil
+}
+
+func (s *webhookDeliveryService) ReplayWebhookDelivery(ctx context.Context, id string) (*dto.WebhookDeliveryResponse, error) {
+	d, err := s.WebhookDeliveryRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !d.HasTarget() {
+		return s.requeue(ctx, d)
+	}
+
+	eventDeliveries, err := s.listEventDeliveries(ctx, d.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.replay(ctx, d, eventDeliveries)
+}
+
+func (s *webhookDeliveryService) ReplayWebhookDeliveries(ctx context.Context, req dto.ReplayWebhookDeliveriesRequest) (*dto.ReplayWebhookDeliveriesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	failed, err := s.WebhookDeliveryRepo.List(ctx, &types.WebhookDeliveryFilter{
+		QueryFilter: types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: &types.TimeRangeFilter{
+			StartTime: lo.ToPtr(req.StartTime),
+			EndTime:   lo.ToPtr(req.EndTime),
+		},
+		WebhookEndpointID: req.WebhookEndpointID,
+		DeliveryStatuses: []types.WebhookDeliveryStatus{
+			types.WebhookDeliveryStatusFailed,
+			types.WebhookDeliveryStatusDeadLettered,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Replay the oldest events first
+	sort.SliceStable(failed, func(i, j int) bool {
+		return failed[i].CreatedAt.Before(failed[j].CreatedAt)
+	})
+
+	response := &dto.ReplayWebhookDeliveriesResponse{
+		Items: make([]*dto.WebhookDeliveryResponse, 0),
+	}
+
+	// An event is replayed once per destination, and only if the destination never received it
+	replayed := make(map[string]bool)
+	for _, d := range failed {
+		if !d.HasTarget() {
+			resp, err := s.requeue(ctx, d)
+			if err != nil {
+				s.Logger.Warnw("skipping webhook event requeue",
+					"webhook_delivery_id", d.ID,
+					"error", err,
+				)
+				continue
+			}
+			response.TotalRequeued++
+			response.Items = append(response.Items, resp)
+			continue
+		}
+
+		key := d.EventID + "/" + d.TargetKey()
+		if replayed[key] {
+			continue
+		}
+		replayed[key] = true
+
+		eventDeliveries, err := s.listEventDeliveries(ctx, d.EventID)
+		if err != nil {
+			return nil, err
+		}
+
+		if lo.SomeBy(eventDeliveries, func(x *webhookdelivery.WebhookDelivery) bool {
+			return x.TargetKey() == d.TargetKey() && x.DeliveryStatus == types.WebhookDeliveryStatusSucceeded
+		}) {
+			continue
+		}
+
+		resp, err := s.replay(ctx, d, eventDeliveries)
+		if err != nil {
+			// The destination can no longer be replayed to, e.g. the endpoint was deleted
+			s.Logger.Warnw("skipping webhook delivery replay",
+				"webhook_delivery_id", d.ID,
+				"error", err,
+			)
+			continue
+		}
+
+		response.TotalReplayed++
+		if resp.DeliveryStatus == types.WebhookDeliveryStatusSucceeded {
+			response.TotalSucceeded++
+		} else {
+			response.TotalFailed++
+		}
+		response.Items = append(response.Items, resp)
+	}
+
+	return response, nil
+}
+
+// replay sends the payload of the delivery to the current configuration of its destination
+func (s *webhookDeliveryService) replay(ctx context.Context, d *webhookdelivery.WebhookDelivery, eventDeliveries []*webhookdelivery.WebhookDelivery) (*dto.WebhookDeliveryResponse, error) {
+	target, err := s.getTarget(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := lo.CountBy(eventDeliveries, func(x *webhookdelivery.WebhookDelivery) bool {
+		return x.TargetKey() == d.TargetKey()
+	})
+
+	replayed, err := s.sender.Send(ctx, delivery.Request{
+		Target:    target,
+		EventID:   d.EventID,
+		EventName: d.EventName,
+		Payload:   []byte(d.RequestBody),
+		Attempt:   attempts + 1,
+		ReplayOf:  lo.ToPtr(d.ID),
+	})
+	if err != nil {
+		s.Logger.Warnw("webhook delivery replay failed",
+			"webhook_delivery_id", d.ID,
+			"replay_webhook_delivery_id", replayed.ID,
+			"error", err,
+		)
+	}
+
+	return &dto.WebhookDeliveryResponse{WebhookDelivery: replayed}, nil
+}
+
+// requeue publishes again an event which failed before any delivery. The event keeps its id so
+// that it is not delivered again to the destinations which already received it.
+func (s *webhookDeliveryService) requeue(ctx context.Context, d *webhookdelivery.WebhookDelivery) (*dto.WebhookDeliveryResponse, error) {
+	if d.DeliveryStatus == types.WebhookDeliveryStatusRequeued {
+		return nil, ierr.NewError("webhook event is already requeued").
+			WithHint("The webhook event has already been published again").
+			WithReportableDetails(map[string]any{
+				"webhook_delivery_id": d.ID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	var event types.WebhookEvent
+	if err := json.Unmarshal([]byte(d.RequestBody), &event); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("The webhook event cannot be requeued").
+			WithReportableDetails(map[string]any{
+				"webhook_delivery_id": d.ID,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	// The record is only marked requeued along with the event being published
+	err := s.DB.WithTx(ctx, func(tx context.Context) error {
+		if err := s.WebhookDeliveryRepo.UpdateDeliveryStatus(tx, d.ID, types.WebhookDeliveryStatusRequeued); err != nil {
+			return err
+		}
+
+		return s.WebhookPublisher.PublishWebhook(tx, &event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	d.DeliveryStatus = types.WebhookDeliveryStatusRequeued
+
+	return &dto.WebhookDeliveryResponse{WebhookDelivery: d}, nil
+}
+
+// getTarget returns the current destination of the delivery
+func (s *webhookDeliveryService) getTarget(ctx context.Context, d *webhookdelivery.WebhookDelivery) (delivery.Target, error) {
+	if d.WebhookEndpointID == nil {
+		tenantCfg, ok := s.Config.Webhook.Tenants[d.TenantID]
+		if !ok || !tenantCfg.Enabled {
+			return delivery.Target{}, ierr.NewError("webhooks are disabled for the tenant").
+				WithHint("The delivery cannot be replayed as webhooks are disabled").
+				WithReportableDetails(map[string]any{
+					"webhook_delivery_id": d.ID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		return delivery.Target{
+			URL:     tenantCfg.Endpoint,
+			Headers: tenantCfg.Headers,
+		}, nil
+	}
+
+	endpoint, err := s.WebhookEndpointRepo.Get(ctx, *d.WebhookEndpointID)
+	if err != nil {
+		return delivery.Target{}, err
+	}
+
+	if endpoint.Status != types.StatusPublished || !endpoint.Enabled {
+		return delivery.Target{}, ierr.NewError("webhook endpoint is not enabled").
+			WithHint("Enable the webhook endpoint to replay its deliveries").
+			WithReportableDetails(map[string]any{
+				"webhook_delivery_id": d.ID,
+				"webhook_endpoint_id": endpoint.ID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return delivery.NewEndpointTarget(endpoint, s.encryptionService, time.Now().UTC())
+}
+
+// listEventDeliveries returns all the delivery attempts of the event
+func (s *webhookDeliveryService) listEventDeliveries(ctx context.Context, eventID string) ([]*webhookdelivery.WebhookDelivery, error) {
+	return s.WebhookDeliveryRepo.List(ctx, &types.WebhookDeliveryFilter{
+		QueryFilter: types.NewNoLimitQueryFilter(),
+		EventID:     eventID,
+	})
+}
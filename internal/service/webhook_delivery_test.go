@@ -0,0 +1,309 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	"github.com/flexprice/flexprice/internal/httpclient"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/webhook/delivery"
+	"github.com/flexprice/flexprice/internal/webhook/signature"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookDeliveryServiceTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service         WebhookDeliveryService
+	endpointService WebhookEndpointService
+	client          *testutil.MockHTTPClient
+	sender          *delivery.Sender
+	testData        struct {
+		endpoint *dto.WebhookEndpointResponse
+		payload  []byte
+	}
+}
+
+func TestWebhookDeliveryService(t *testing.T) {
+	suite.Run(t, new(WebhookDeliveryServiceTestSuite))
+}
+
+func (s *WebhookDeliveryServiceTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+
+	params := ServiceParams{
+		Logger:              s.GetLogger(),
+		Config:              s.GetConfig(),
+		DB:                  s.GetDB(),
+		WebhookEndpointRepo: s.GetStores().WebhookEndpointRepo,
+		WebhookDeliveryRepo: s.GetStores().WebhookDeliveryRepo,
+		EventPublisher:      s.GetPublisher(),
+		WebhookPublisher:    s.GetWebhookPublisher(),
+	}
+
+	s.client = testutil.NewMockHTTPClient()
+	s.service = NewWebhookDeliveryService(params, s.client)
+	s.endpointService = NewWebhookEndpointService(params)
+	resolver := testutil.NewMockResolver()
+	resolver.RegisterHost("example.com", "93.184.215.14")
+	s.endpointService.(*webhookEndpointService).resolver = resolver
+	s.sender = delivery.NewSender(s.client, s.GetStores().WebhookDeliveryRepo, s.GetLogger())
+	s.setupTestData()
+}
+
+func (s *WebhookDeliveryServiceTestSuite) setupTestData() {
+	var err error
+	s.testData.endpoint, err = s.endpointService.CreateWebhookEndpoint(s.GetContext(), dto.CreateWebhookEndpointRequest{
+		URL: "https://example.com/webhooks",
+	})
+	s.NoError(err)
+	s.testData.payload = []byte(`{"event_type":"invoice.update.finalized"}`)
+}
+
+// deliver records a delivery of the event to the test endpoint as the webhook handler does
+func (s *WebhookDeliveryServiceTestSuite) deliver(eventID string, attempt int) *webhookdelivery.WebhookDelivery {
+	d, _ := s.sender.Send(s.GetContext(), delivery.Request{
+		Target: delivery.Target{
+			EndpointID: s.testData.endpoint.ID,
+			URL:        s.testData.endpoint.URL,
+		},
+		EventID:   eventID,
+		EventName: types.WebhookEventInvoiceUpdateFinalized,
+		Payload:   s.testData.payload,
+		Attempt:   attempt,
+	})
+	return d
+}
+
+func (s *WebhookDeliveryServiceTestSuite) respond(statusCode int) {
+	s.client.Clear()
+	s.client.RegisterResponse("/webhooks", testutil.MockResponse{
+		StatusCode: statusCode,
+		Body:       []byte(http.StatusText(statusCode)),
+	})
+}
+
+func (s *WebhookDeliveryServiceTestSuite) TestDeliveryLog() {
+	s.respond(http.StatusInternalServerError)
+	failed := s.deliver("evt_1", 1)
+	s.Equal(types.WebhookDeliveryStatusFailed, failed.DeliveryStatus)
+	s.Equal(http.StatusInternalServerError, lo.FromPtr(failed.ResponseStatus))
+	s.NotNil(failed.Error)
+
+	s.respond(http.StatusOK)
+	succeeded := s.deliver("evt_1", 2)
+	s.Equal(types.WebhookDeliveryStatusSucceeded, succeeded.DeliveryStatus)
+	s.Nil(succeeded.Error)
+
+	list, err := s.service.ListWebhookDeliveries(s.GetContext(), types.NewDefaultWebhookDeliveryFilter())
+	s.NoError(err)
+	s.Len(list.Items, 2)
+
+	filter := types.NewDefaultWebhookDeliveryFilter()
+	filter.DeliveryStatuses = []types.WebhookDeliveryStatus{types.WebhookDeliveryStatusFailed}
+	list, err = s.service.ListWebhookDeliveries(s.GetContext(), filter)
+	s.NoError(err)
+	s.Len(list.Items, 1)
+	s.Equal(failed.ID, list.Items[0].ID)
+
+	filter.DeliveryStatuses = []types.WebhookDeliveryStatus{"unknown"}
+	_, err = s.service.ListWebhookDeliveries(s.GetContext(), filter)
+	s.Error(err)
+}
+
+func (s *WebhookDeliveryServiceTestSuite) TestDeliveryLogKeepsNoSecrets() {
+	s.client.Clear()
+	s.client.RegisterResponse("/webhooks", testutil.MockResponse{
+		StatusCode: http.StatusBadRequest,
+		Body:       []byte(`{"error":"invalid token sk_live_123"}`),
+	})
+
+	d, err := s.sender.Send(s.GetContext(), delivery.Request{
+		Target: delivery.Target{
+			EndpointID: s.testData.endpoint.ID,
+			URL:        s.testData.endpoint.URL,
+			Headers:    map[string]string{"Authorization": "Bearer sk_live_123"},
+			Secrets:    []string{s.testData.endpoint.SigningSecret},
+		},
+		EventID:   "evt_1",
+		EventName: types.WebhookEventInvoiceUpdateFinalized,
+		Payload:   s.testData.payload,
+		Attempt:   1,
+	})
+	s.Error(err)
+
+	got, err := s.service.GetWebhookDelivery(s.GetContext(), d.ID)
+	s.NoError(err)
+	s.Equal(http.StatusBadRequest, lo.FromPtr(got.ResponseStatus))
+	s.Equal("webhook endpoint responded with status code 400", lo.FromPtr(got.Error))
+
+	// The credentials are redacted, the signature is kept to be checked against the request body
+	s.Equal("[REDACTED]", got.RequestHeaders["Authorization"])
+	s.NotEmpty(got.RequestHeaders[signature.Header])
+
+	body, err := json.Marshal(got)
+	s.NoError(err)
+	s.NotContains(string(body), "sk_live_123")
+}
+
+func (s *WebhookDeliveryServiceTestSuite) TestReplayWebhookDelivery() {
+	s.respond(http.StatusServiceUnavailable)
+	failed := s.deliver("evt_1", 1)
+
+	s.respond(http.StatusOK)
+	resp, err := s.service.ReplayWebhookDelivery(s.GetContext(), failed.ID)
+	s.NoError(err)
+	s.Equal(types.WebhookDeliveryStatusSucceeded, resp.DeliveryStatus)
+	s.Equal(2, resp.Attempt)
+	s.Equal(failed.ID, lo.FromPtr(resp.ReplayOf))
+	s.Equal(failed.RequestBody, resp.RequestBody)
+
+	// The replay is signed with the current secret of the endpoint
+	header := resp.RequestHeaders[signature.Header]
+	s.NoError(signature.Verify([]byte(resp.RequestBody), header, s.testData.endpoint.SigningSecret, signature.DefaultTolerance))
+
+	// A disabled endpoint cannot be replayed to
+	_, err = s.endpointService.UpdateWebhookEndpoint(s.GetContext(), s.testData.endpoint.ID, dto.UpdateWebhookEndpointRequest{
+		Enabled: lo.ToPtr(false),
+	})
+	s.NoError(err)
+	_, err = s.service.ReplayWebhookDelivery(s.GetContext(), failed.ID)
+	s.Error(err)
+}
+
+func (s *WebhookDeliveryServiceTestSuite) TestReplayWebhookDeliveries() {
+	start := time.Now().UTC().Add(-time.Minute)
+
+	s.respond(http.StatusInternalServerError)
+	s.deliver("evt_1", 1)
+	s.deliver("evt_1", 2)
+	s.deliver("evt_2", 1)
+	s.deliver("evt_3", 1)
+
+	// evt_3 was eventually delivered
+	s.respond(http.StatusOK)
+	s.deliver("evt_3", 2)
+
+	resp, err := s.service.ReplayWebhookDeliveries(s.GetContext(), dto.ReplayWebhookDeliveriesRequest{
+		StartTime: start,
+		EndTime:   time.Now().UTC().Add(time.Minute),
+	})
+	s.NoError(err)
+	s.Equal(2, resp.TotalReplayed)
+	s.Equal(2, resp.TotalSucceeded)
+	s.Equal(0, resp.TotalFailed)
+
+	replayedEvents := lo.Map(resp.Items, func(d *dto.WebhookDeliveryResponse, _ int) string {
+		return d.EventID
+	})
+	s.ElementsMatch([]string{"evt_1", "evt_2"}, replayedEvents)
+
+	// Nothing is left to replay
+	resp, err = s.service.ReplayWebhookDeliveries(s.GetContext(), dto.ReplayWebhookDeliveriesRequest{
+		StartTime: start,
+		EndTime:   time.Now().UTC().Add(time.Minute),
+	})
+	s.NoError(err)
+	s.Equal(0, resp.TotalReplayed)
+
+	_, err = s.service.ReplayWebhookDeliveries(s.GetContext(), dto.ReplayWebhookDeliveriesRequest{
+		StartTime: start,
+		EndTime:   start.Add(-time.Hour),
+	})
+	s.Error(err)
+}
+
+func (s *WebhookDeliveryServiceTestSuite) TestReplayUndeliveredWebhookEvent() {
+	start := time.Now().UTC().Add(-time.Minute)
+
+	// The event failed before any delivery, e.g. its payload could not be built
+	event := &types.WebhookEvent{
+		ID:            "evt_1",
+		EventName:     types.WebhookEventInvoiceUpdateFinalized,
+		TenantID:      types.GetTenantID(s.GetContext()),
+		EnvironmentID: types.GetEnvironmentID(s.GetContext()),
+		Timestamp:     time.Now().UTC(),
+		Payload:       json.RawMessage(`{"invoice_id":"inv_1"}`),
+	}
+	body, err := json.Marshal(event)
+	s.NoError(err)
+
+	undelivered := &webhookdelivery.WebhookDelivery{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WEBHOOK_DELIVERY),
+		EventID:        event.ID,
+		EventName:      event.EventName,
+		RequestBody:    string(body),
+		Error:          lo.ToPtr("failed to build payload"),
+		DeliveryStatus: types.WebhookDeliveryStatusDeadLettered,
+		EnvironmentID:  event.EnvironmentID,
+		BaseModel:      types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().WebhookDeliveryRepo.Create(s.GetContext(), undelivered))
+
+	resp, err := s.service.ReplayWebhookDeliveries(s.GetContext(), dto.ReplayWebhookDeliveriesRequest{
+		StartTime: start,
+		EndTime:   time.Now().UTC().Add(time.Minute),
+	})
+	s.NoError(err)
+	s.Equal(1, resp.TotalRequeued)
+	s.Equal(0, resp.TotalReplayed)
+	s.Require().Len(resp.Items, 1)
+	s.Equal(types.WebhookDeliveryStatusRequeued, resp.Items[0].DeliveryStatus)
+
+	// The event is published again with its id
+	events := s.GetWebhookEvents()
+	s.Require().Len(events, 1)
+	s.Equal(event.ID, events[0].ID)
+	s.JSONEq(string(event.Payload), string(events[0].Payload))
+
+	stored, err := s.GetStores().WebhookDeliveryRepo.Get(s.GetContext(), undelivered.ID)
+	s.NoError(err)
+	s.Equal(types.WebhookDeliveryStatusRequeued, stored.DeliveryStatus)
+
+	// A requeued event is not published twice
+	_, err = s.service.ReplayWebhookDelivery(s.GetContext(), undelivered.ID)
+	s.Error(err)
+	s.Len(s.GetWebhookEvents(), 1)
+}
+
+func (s *WebhookDeliveryServiceTestSuite) TestWebhookClientRefusesNonPublicAddresses() {
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		received.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{name: "loopback ip", url: server.URL},
+		{name: "host resolving to a loopback ip", url: "http://localhost:" + serverURL.Port()},
+	}
+
+	client := httpclient.NewWebhookClient()
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := client.Send(s.GetContext(), &httpclient.Request{
+				Method: http.MethodPost,
+				URL:    tt.url,
+				Body:   s.testData.payload,
+			})
+			s.Error(err)
+		})
+	}
+	s.Zero(received.Load())
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/httpclient"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/webhook/signature"
+	"github.com/samber/lo"
+)
+
+// WebhookEndpointService defines the interface for managing the webhook endpoints of a tenant
+type WebhookEndpointService interface {
+	CreateWebhookEndpoint(ctx context.Context, req dto.CreateWebhookEndpointRequest) (*dto.WebhookEndpointResponse, error)
+	GetWebhookEndpoint(ctx context.Context, id string) (*dto.WebhookEndpointResponse, error)
+	ListWebhookEndpoints(ctx context.Context, filter *types.WebhookEndpointFilter) (*dto.ListWebhookEndpointsResponse, error)
+	UpdateWebhookEndpoint(ctx context.Context, id string, req dto.UpdateWebhookEndpointRequest) (*dto.WebhookEndpointResponse, error)
+	DeleteWebhookEndpoint(ctx context.Context, id string) error
+
+	// RotateWebhookEndpointSecret replaces the signing secret of the endpoint. The deliveries are
+	// signed with both secrets until the replaced one expires so receivers can switch without downtime.
+	RotateWebhookEndpointSecret(ctx context.Context, id string, req dto.RotateWebhookEndpointSecretRequest) (*dto.WebhookEndpointResponse, error)
+}
+
+type webhookEndpointService struct {
+	ServiceParams
+	encryptionService security.EncryptionService
+	resolver          httpclient.Resolver
+}
+
+// NewWebhookEndpointService creates a new webhook endpoint service
+func NewWebhookEndpointService(params ServiceParams) WebhookEndpointService {
+	encryptionService, err := security.NewEncryptionService(params.Config, params.Logger)
+	if err != nil {
+		params.Logger.Fatalw("failed to create encryption service", "error", err)
+	}
+
+	return &webhookEndpointService{
+		ServiceParams:     params,
+		encryptionService: encryptionService,
+		resolver:          net.DefaultResolver,
+	}
+}
+
+func (s *webhookEndpointService) CreateWebhookEndpoint(ctx context.Context, req dto.CreateWebhookEndpointRequest) (*dto.WebhookEndpointResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	// The deliveries are checked again when connecting, in case the host resolves differently by then
+	if err := httpclient.ValidatePublicURL(ctx, s.resolver, req.URL); err != nil {
+		return nil, err
+	}
+
+	endpoint := req.ToWebhookEndpoint(ctx)
+
+	secret, encryptedSecret, err := s.generateSigningSecret()
+	if err != nil {
+		return nil, err
+	}
+	endpoint.SigningSecret = encryptedSecret
+
+	if err := s.WebhookEndpointRepo.Create(ctx, endpoint); err != nil {
+		return nil, err
+	}
+
+	return &dto.WebhookEndpointResponse{
+		WebhookEndpoint: endpoint,
+		SigningSecret:   secret,
+	}, nil
+}
+
+func (s *webhookEndpointService) GetWebhookEndpoint(ctx context.Context, id string) (*dto.WebhookEndpointResponse, error) {
+	if id == "" {
+		return nil, ierr.NewError("webhook endpoint ID is required").
+			WithHint("Webhook endpoint ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	endpoint, err := s.WebhookEndpointRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.WebhookEndpointResponse{WebhookEndpoint: endpoint}, nil
+}
+
+func (s *webhookEndpointService) ListWebhookEndpoints(ctx context.Context, filter *types.WebhookEndpointFilter) (*dto.ListWebhookEndpointsResponse, error) {
+	if filter == nil {
+		filter = types.NewDefaultWebhookEndpointFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoints, err := s.WebhookEndpointRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.WebhookEndpointRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListWebhookEndpointsResponse{
+		Items: make([]*dto.WebhookEndpointResponse, len(endpoints)),
+	}
+
+	for i, endpoint := range endpoints {
+		response.Items[i] = &dto.WebhookEndpointResponse{WebhookEndpoint: endpoint}
+	}
+
+	response.Pagination = types.NewPaginationResponse(
+		count,
+		filter.GetLimit(),
+		filter.GetOffset(),
+	)
+
+	return response, nil
+}
+
+func (s *webhookEndpointService) UpdateWebhookEndpoint(ctx context.Context, id string, req dto.UpdateWebhookEndpointRequest) (*dto.WebhookEndpointResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.WebhookEndpointRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := httpclient.ValidatePublicURL(ctx, s.resolver, *req.URL); err != nil {
+			return nil, err
+		}
+		endpoint.URL = *req.URL
+	}
+	if req.Description != nil {
+		endpoint.Description = *req.Description
+	}
+	if req.EnabledEvents != nil {
+		endpoint.EnabledEvents = *req.EnabledEvents
+	}
+	if req.Headers != nil {
+		endpoint.Headers = *req.Headers
+	}
+	if req.Enabled != nil {
+		endpoint.Enabled = *req.Enabled
+	}
+	if req.Metadata != nil {
+		endpoint.Metadata = *req.Metadata
+	}
+
+	if err := s.WebhookEndpointRepo.Update(ctx, endpoint); err != nil {
+		return nil, err
+	}
+
+	return &dto.WebhookEndpointResponse{WebhookEndpoint: endpoint}, nil
+}
+
+// DeleteWebhookEndpoint archives the endpoint so no more events are sent to it
+func (s *webhookEndpointService) DeleteWebhookEndpoint(ctx context.Context, id string) error {
+	if _, err := s.WebhookEndpointRepo.Get(ctx, id); err != nil {
+		return err
+	}
+
+	return s.WebhookEndpointRepo.Delete(ctx, id)
+}
+
+func (s *webhookEndpointService) RotateWebhookEndpointSecret(ctx context.Context, id string, req dto.RotateWebhookEndpointSecretRequest) (*dto.WebhookEndpointResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.WebhookEndpointRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, encryptedSecret, err := s.generateSigningSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint.SigningSecret != "" {
+		endpoint.PreviousSigningSecret = lo.ToPtr(endpoint.SigningSecret)
+		endpoint.PreviousSigningSecretExpiresAt = lo.ToPtr(time.Now().UTC().Add(req.GetExpiresIn()))
+	}
+	endpoint.SigningSecret = encryptedSecret
+
+	if err := s.WebhookEndpointRepo.Update(ctx, endpoint); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("rotated webhook endpoint signing secret",
+		"webhook_endpoint_id", endpoint.ID,
+		"previous_signing_secret_expires_at", endpoint.PreviousSigningSecretExpiresAt,
+	)
+
+	return &dto.WebhookEndpointResponse{
+		WebhookEndpoint: endpoint,
+		SigningSecret:   secret,
+	}, nil
+}
+
+// generateSigningSecret generates a new signing secret and returns it with its encrypted value
+func (s *webhookEndpointService) generateSigningSecret() (string, string, error) {
+	secret, err := signature.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+
+	encryptedSecret, err := s.encryptionService.Encrypt(secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	return secret, encryptedSecret, nil
+}
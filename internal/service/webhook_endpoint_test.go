@@ -0,0 +1,333 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/webhook/signature"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookEndpointServiceTestSuite struct {
+	testutil.BaseServiceTestSuite
+	service  WebhookEndpointService
+	resolver *testutil.MockResolver
+}
+
+func TestWebhookEndpointService(t *testing.T) {
+	suite.Run(t, new(WebhookEndpointServiceTestSuite))
+}
+
+func (s *WebhookEndpointServiceTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+	s.service = NewWebhookEndpointService(ServiceParams{
+		Logger:              s.GetLogger(),
+		Config:              s.GetConfig(),
+		DB:                  s.GetDB(),
+		WebhookEndpointRepo: s.GetStores().WebhookEndpointRepo,
+		EventPublisher:      s.GetPublisher(),
+		WebhookPublisher:    s.GetWebhookPublisher(),
+	})
+
+	s.resolver = testutil.NewMockResolver()
+	s.resolver.RegisterHost("example.com", "93.184.215.14")
+	s.resolver.RegisterHost("internal.example.com", "10.0.0.5")
+	s.resolver.RegisterHost("mixed.example.com", "93.184.215.14", "127.0.0.1")
+	s.service.(*webhookEndpointService).resolver = s.resolver
+}
+
+func (s *WebhookEndpointServiceTestSuite) TestCreateWebhookEndpoint() {
+	tests := []struct {
+		name    string
+		req     dto.CreateWebhookEndpointRequest
+		wantErr bool
+	}{
+		{
+			name: "all events",
+			req: dto.CreateWebhookEndpointRequest{
+				URL: "https://example.com/webhooks",
+			},
+		},
+		{
+			name: "selected events",
+			req: dto.CreateWebhookEndpointRequest{
+				URL:           "https://example.com/webhooks",
+				EnabledEvents: []string{types.WebhookEventInvoiceUpdateFinalized, types.WebhookEventSubscriptionTrialEnded},
+				Headers:       map[string]string{"Authorization": "Bearer token"},
+			},
+		},
+		{
+			name:    "missing url",
+			req:     dto.CreateWebhookEndpointRequest{},
+			wantErr: true,
+		},
+		{
+			name: "relative url",
+			req: dto.CreateWebhookEndpointRequest{
+				URL: "/webhooks",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown event",
+			req: dto.CreateWebhookEndpointRequest{
+				URL:           "https://example.com/webhooks",
+				EnabledEvents: []string{"invoice.unknown"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated event",
+			req: dto.CreateWebhookEndpointRequest{
+				URL:           "https://example.com/webhooks",
+				EnabledEvents: []string{types.WebhookEventInvoiceUpdateFinalized, types.WebhookEventInvoiceUpdateFinalized},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp, err := s.service.CreateWebhookEndpoint(s.GetContext(), tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.True(ierr.IsValidation(err))
+				return
+			}
+
+			s.NoError(err)
+			s.True(resp.Enabled)
+			s.Equal(tt.req.URL, resp.URL)
+			s.Equal(tt.req.EnabledEvents, resp.EnabledEvents)
+			s.Equal(types.GetEnvironmentID(s.GetContext()), resp.EnvironmentID)
+
+			got, err := s.service.GetWebhookEndpoint(s.GetContext(), resp.ID)
+			s.NoError(err)
+			s.Equal(resp.ID, got.ID)
+		})
+	}
+}
+
+func (s *WebhookEndpointServiceTestSuite) TestUpdateWebhookEndpoint() {
+	ctx := s.GetContext()
+
+	created, err := s.service.CreateWebhookEndpoint(ctx, dto.CreateWebhookEndpointRequest{
+		URL: "https://example.com/webhooks",
+	})
+	s.NoError(err)
+	s.True(created.SubscribesTo(types.WebhookEventInvoiceCreateDraft))
+
+	updated, err := s.service.UpdateWebhookEndpoint(ctx, created.ID, dto.UpdateWebhookEndpointRequest{
+		EnabledEvents: &[]string{types.WebhookEventInvoiceUpdateFinalized},
+	})
+	s.NoError(err)
+	s.False(updated.SubscribesTo(types.WebhookEventInvoiceCreateDraft))
+	s.True(updated.SubscribesTo(types.WebhookEventInvoiceUpdateFinalized))
+
+	updated, err = s.service.UpdateWebhookEndpoint(ctx, created.ID, dto.UpdateWebhookEndpointRequest{
+		Enabled: lo.ToPtr(false),
+	})
+	s.NoError(err)
+	s.False(updated.Enabled)
+	s.False(updated.SubscribesTo(types.WebhookEventInvoiceUpdateFinalized))
+
+	_, err = s.service.UpdateWebhookEndpoint(ctx, created.ID, dto.UpdateWebhookEndpointRequest{
+		URL: lo.ToPtr("ftp://example.com"),
+	})
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+}
+
+func (s *WebhookEndpointServiceTestSuite) TestWebhookEndpointURLMustBePublic() {
+	ctx := s.GetContext()
+
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "public host", url: "https://example.com/webhooks"},
+		{name: "public ip", url: "https://93.184.215.14/webhooks"},
+		{name: "private ip", url: "http://10.1.2.3/webhooks", wantErr: true},
+		{name: "private ip range 192.168", url: "http://192.168.1.10:8080/webhooks", wantErr: true},
+		{name: "loopback ip", url: "http://127.0.0.1/webhooks", wantErr: true},
+		{name: "loopback ipv6", url: "http://[::1]/webhooks", wantErr: true},
+		{name: "ipv4 mapped loopback", url: "http://[::ffff:127.0.0.1]/webhooks", wantErr: true},
+		{name: "link-local metadata ip", url: "http://169.254.169.254/latest/meta-data", wantErr: true},
+		{name: "unspecified ip", url: "http://0.0.0.0/webhooks", wantErr: true},
+		{name: "host resolving to a private ip", url: "https://internal.example.com/webhooks", wantErr: true},
+		{name: "host resolving to a loopback ip among others", url: "https://mixed.example.com/webhooks", wantErr: true},
+		{name: "unresolvable host", url: "https://unknown.example.com/webhooks", wantErr: true},
+	}
+
+	existing, err := s.service.CreateWebhookEndpoint(ctx, dto.CreateWebhookEndpointRequest{
+		URL: "https://example.com/webhooks",
+	})
+	s.Require().NoError(err)
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.service.CreateWebhookEndpoint(ctx, dto.CreateWebhookEndpointRequest{URL: tt.url})
+			_, updateErr := s.service.UpdateWebhookEndpoint(ctx, existing.ID, dto.UpdateWebhookEndpointRequest{
+				URL: lo.ToPtr(tt.url),
+			})
+			if !tt.wantErr {
+				s.NoError(err)
+				s.NoError(updateErr)
+				return
+			}
+			s.True(ierr.IsValidation(err), "create error %v", err)
+			s.True(ierr.IsValidation(updateErr), "update error %v", updateErr)
+		})
+	}
+}
+
+func (s *WebhookEndpointServiceTestSuite) TestListAndDeleteWebhookEndpoints() {
+	ctx := s.GetContext()
+
+	first, err := s.service.CreateWebhookEndpoint(ctx, dto.CreateWebhookEndpointRequest{
+		URL: "https://example.com/first",
+	})
+	s.NoError(err)
+
+	second, err := s.service.CreateWebhookEndpoint(ctx, dto.CreateWebhookEndpointRequest{
+		URL: "https://example.com/second",
+	})
+	s.NoError(err)
+
+	_, err = s.service.UpdateWebhookEndpoint(ctx, second.ID, dto.UpdateWebhookEndpointRequest{
+		Enabled: lo.ToPtr(false),
+	})
+	s.NoError(err)
+
+	list, err := s.service.ListWebhookEndpoints(ctx, types.NewDefaultWebhookEndpointFilter())
+	s.NoError(err)
+	s.Len(list.Items, 2)
+	s.Equal(2, list.Pagination.Total)
+
+	filter := types.NewDefaultWebhookEndpointFilter()
+	filter.Enabled = lo.ToPtr(true)
+	list, err = s.service.ListWebhookEndpoints(ctx, filter)
+	s.NoError(err)
+	s.Len(list.Items, 1)
+	s.Equal(first.ID, list.Items[0].ID)
+
+	s.NoError(s.service.DeleteWebhookEndpoint(ctx, first.ID))
+
+	list, err = s.service.ListWebhookEndpoints(ctx, types.NewDefaultWebhookEndpointFilter())
+	s.NoError(err)
+	s.Len(list.Items, 1)
+	s.Equal(second.ID, list.Items[0].ID)
+}
+
+func (s *WebhookEndpointServiceTestSuite) TestSigningSecretRotation() {
+	ctx := s.GetContext()
+	payload := []byte(`{"event_type":"invoice.update.finalized"}`)
+
+	created, err := s.service.CreateWebhookEndpoint(ctx, dto.CreateWebhookEndpointRequest{
+		URL: "https://example.com/webhooks",
+	})
+	s.NoError(err)
+	s.NotEmpty(created.SigningSecret)
+	s.NotEqual(created.SigningSecret, created.WebhookEndpoint.SigningSecret, "secret must be stored encrypted")
+
+	got, err := s.service.GetWebhookEndpoint(ctx, created.ID)
+	s.NoError(err)
+	s.Empty(got.SigningSecret, "secret must only be returned on creation and rotation")
+
+	rotated, err := s.service.RotateWebhookEndpointSecret(ctx, created.ID, dto.RotateWebhookEndpointSecretRequest{})
+	s.NoError(err)
+	s.NotEqual(created.SigningSecret, rotated.SigningSecret)
+	s.Require().NotNil(rotated.PreviousSigningSecretExpiresAt)
+	s.WithinDuration(time.Now().Add(24*time.Hour), *rotated.PreviousSigningSecretExpiresAt, time.Minute)
+
+	// Both secrets are active during the rotation
+	now := time.Now().UTC()
+	s.Len(rotated.ActiveSigningSecrets(now), 2)
+	s.Len(rotated.ActiveSigningSecrets(now.Add(25*time.Hour)), 1)
+
+	header := signature.BuildHeader(now, payload, rotated.SigningSecret, created.SigningSecret)
+	s.NoError(signature.Verify(payload, header, rotated.SigningSecret, signature.DefaultTolerance))
+	s.NoError(signature.Verify(payload, header, created.SigningSecret, signature.DefaultTolerance))
+
+	// The replaced secret stops being used immediately without a grace period
+	rotated, err = s.service.RotateWebhookEndpointSecret(ctx, created.ID, dto.RotateWebhookEndpointSecretRequest{
+		ExpiresInHours: lo.ToPtr(0),
+	})
+	s.NoError(err)
+	s.Len(rotated.ActiveSigningSecrets(time.Now().UTC()), 1)
+
+	_, err = s.service.RotateWebhookEndpointSecret(ctx, created.ID, dto.RotateWebhookEndpointSecretRequest{
+		ExpiresInHours: lo.ToPtr(-1),
+	})
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+}
+
+func (s *WebhookEndpointServiceTestSuite) TestVerifySignature() {
+	secret, err := signature.GenerateSecret()
+	s.NoError(err)
+
+	payload := []byte(`{"event_type":"invoice.update.finalized"}`)
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		payload []byte
+		header  string
+		secret  string
+		wantErr bool
+	}{
+		{
+			name:    "valid signature",
+			payload: payload,
+			header:  signature.BuildHeader(now, payload, secret),
+			secret:  secret,
+		},
+		{
+			name:    "tampered payload",
+			payload: []byte(`{"event_type":"invoice.update.voided"}`),
+			header:  signature.BuildHeader(now, payload, secret),
+			secret:  secret,
+			wantErr: true,
+		},
+		{
+			name:    "wrong secret",
+			payload: payload,
+			header:  signature.BuildHeader(now, payload, secret),
+			secret:  "whsec_other",
+			wantErr: true,
+		},
+		{
+			name:    "expired timestamp",
+			payload: payload,
+			header:  signature.BuildHeader(now.Add(-time.Hour), payload, secret),
+			secret:  secret,
+			wantErr: true,
+		},
+		{
+			name:    "malformed header",
+			payload: payload,
+			header:  "v1=abc",
+			secret:  secret,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			err := signature.Verify(tt.payload, tt.header, tt.secret, signature.DefaultTolerance)
+			if tt.wantErr {
+				s.Error(err)
+				return
+			}
+			s.NoError(err)
+		})
+	}
+}
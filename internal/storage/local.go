@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+// LocalStorage stores files on the local filesystem, meant for development
+type LocalStorage struct {
+	baseDir string
+	baseURL string
+}
+
+// NewLocalStorage creates a local storage rooted at baseDir. Download URLs are built by
+// prefixing the keys with baseURL, or are file URLs when baseURL is empty.
+func NewLocalStorage(baseDir, baseURL string) *LocalStorage {
+	if baseDir == "" {
+		baseDir = filepath.Join(os.TempDir(), "flexprice")
+	}
+
+	return &LocalStorage{
+		baseDir: baseDir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (s *LocalStorage) Upload(ctx context.Context, key string, _ string, body io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create storage directory").
+			WithReportableDetails(map[string]interface{}{
+				"key": key,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	// Write to a temporary file first so that a failed upload does not leave a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create file").
+			WithReportableDetails(map[string]interface{}{
+				"key": key,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return ierr.WithError(err).
+			WithHint("Failed to write file").
+			WithReportableDetails(map[string]interface{}{
+				"key": key,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to write file").
+			WithReportableDetails(map[string]interface{}{
+				"key": key,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to write file").
+			WithReportableDetails(map[string]interface{}{
+				"key": key,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	return nil
+}
+
+func (s *LocalStorage) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ierr.WithError(err).
+				WithHint("File not found").
+				WithReportableDetails(map[string]interface{}{
+					"key": key,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to open file").
+			WithReportableDetails(map[string]interface{}{
+				"key": key,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	return f, nil
+}
+
+func (s *LocalStorage) GetURL(ctx context.Context, key string) (string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	if s.baseURL != "" {
+		return s.baseURL + "/" + strings.TrimPrefix(filepath.ToSlash(filepath.Clean(key)), "/"), nil
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to resolve file path").
+			Mark(ierr.ErrSystem)
+	}
+
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(abs)}).String(), nil
+}
+
+// path resolves the key to a path inside the base directory
+func (s *LocalStorage) path(key string) (string, error) {
+	cleaned := filepath.Clean("/" + key)
+	if key == "" || cleaned == "/" {
+		return "", ierr.NewError("invalid storage key").
+			WithHint("Storage key cannot be empty").
+			Mark(ierr.ErrValidation)
+	}
+
+	return filepath.Join(s.baseDir, cleaned), nil
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"context"
+	"io"
+
+	"github.com/flexprice/flexprice/internal/config"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Storage stores files under keys and serves them back through download URLs
+type Storage interface {
+	// Upload streams the body to the file stored under the key, replacing any existing file
+	Upload(ctx context.Context, key string, contentType string, body io.Reader) error
+
+	// Download opens the file stored under the key, the caller must close the reader
+	Download(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// GetURL returns the URL the file stored under the key can be downloaded from
+	GetURL(ctx context.Context, key string) (string, error)
+}
+
+// NewStorage creates the storage backend of the configured provider
+func NewStorage(cfg *config.Configuration) (Storage, error) {
+	switch cfg.Storage.Provider {
+	case "", types.StorageProviderLocal:
+		return NewLocalStorage(cfg.Storage.Local.BaseDir, cfg.Storage.Local.BaseURL), nil
+	default:
+		return nil, ierr.NewError("unsupported storage provider").
+			WithHint("Unsupported storage provider").
+			WithReportableDetails(map[string]interface{}{
+				"provider": cfg.Storage.Provider,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+}
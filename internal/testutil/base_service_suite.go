@@ -2,12 +2,17 @@ package testutil
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/cache"
 	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/alert"
 	"github.com/flexprice/flexprice/internal/domain/auth"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/creditnote"
 	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/dunning"
 	"github.com/flexprice/flexprice/internal/domain/entitlement"
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
@@ -20,9 +25,12 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/domain/task"
+	"github.com/flexprice/flexprice/internal/domain/tax"
 	"github.com/flexprice/flexprice/internal/domain/tenant"
 	"github.com/flexprice/flexprice/internal/domain/user"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
+	"github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/pdf"
 	"github.com/flexprice/flexprice/internal/postgres"
@@ -35,23 +43,30 @@ import (
 
 // Stores holds all the repository interfaces for testing
 type Stores struct {
-	SubscriptionRepo subscription.Repository
-	EventRepo        events.Repository
-	PlanRepo         plan.Repository
-	PriceRepo        price.Repository
-	MeterRepo        meter.Repository
-	CustomerRepo     customer.Repository
-	InvoiceRepo      invoice.Repository
-	WalletRepo       wallet.Repository
-	PaymentRepo      payment.Repository
-	AuthRepo         auth.Repository
-	UserRepo         user.Repository
-	TenantRepo       tenant.Repository
-	EnvironmentRepo  environment.Repository
-	EntitlementRepo  entitlement.Repository
-	FeatureRepo      feature.Repository
-	TaskRepo         task.Repository
-	SecretRepo       secret.Repository
+	SubscriptionRepo    subscription.Repository
+	EventRepo           events.Repository
+	PlanRepo            plan.Repository
+	PriceRepo           price.Repository
+	MeterRepo           meter.Repository
+	CustomerRepo        customer.Repository
+	InvoiceRepo         invoice.Repository
+	WalletRepo          wallet.Repository
+	PaymentRepo         payment.Repository
+	AuthRepo            auth.Repository
+	UserRepo            user.Repository
+	TenantRepo          tenant.Repository
+	EnvironmentRepo     environment.Repository
+	EntitlementRepo     entitlement.Repository
+	FeatureRepo         feature.Repository
+	TaskRepo            task.Repository
+	SecretRepo          secret.Repository
+	CouponRepo          coupon.Repository
+	TaxRateRepo         tax.Repository
+	CreditNoteRepo      creditnote.Repository
+	DunningRepo         dunning.Repository
+	AlertRepo           alert.Repository
+	WebhookEndpointRepo webhookendpoint.Repository
+	WebhookDeliveryRepo webhookdelivery.Repository
 }
 
 // BaseServiceTestSuite provides common functionality for all service test suites
@@ -66,6 +81,7 @@ type BaseServiceTestSuite struct {
 	config           *config.Configuration
 	now              time.Time
 	pdfGenerator     pdf.Generator
+	webhookPubSub    *InMemoryPubSub
 }
 
 // SetupSuite is called once before running the tests in the suite
@@ -78,6 +94,9 @@ func (s *BaseServiceTestSuite) SetupSuite() {
 		Logging: config.LoggingConfig{
 			Level: types.LogLevelDebug,
 		},
+		Secrets: config.SecretsConfig{
+			EncryptionKey: "test-encryption-key-for-unit-tests-only",
+		},
 	}
 	var err error
 	s.config = cfg
@@ -111,31 +130,38 @@ func (s *BaseServiceTestSuite) setupContext() {
 
 func (s *BaseServiceTestSuite) setupStores() {
 	s.stores = Stores{
-		SubscriptionRepo: NewInMemorySubscriptionStore(),
-		EventRepo:        NewInMemoryEventStore(),
-		PlanRepo:         NewInMemoryPlanStore(),
-		PriceRepo:        NewInMemoryPriceStore(),
-		MeterRepo:        NewInMemoryMeterStore(),
-		CustomerRepo:     NewInMemoryCustomerStore(),
-		InvoiceRepo:      NewInMemoryInvoiceStore(),
-		WalletRepo:       NewInMemoryWalletStore(),
-		PaymentRepo:      NewInMemoryPaymentStore(),
-		AuthRepo:         NewInMemoryAuthRepository(),
-		UserRepo:         NewInMemoryUserStore(),
-		TenantRepo:       NewInMemoryTenantStore(),
-		EnvironmentRepo:  NewInMemoryEnvironmentStore(),
-		EntitlementRepo:  NewInMemoryEntitlementStore(),
-		FeatureRepo:      NewInMemoryFeatureStore(),
-		TaskRepo:         NewInMemoryTaskStore(),
-		SecretRepo:       NewInMemorySecretStore(),
+		SubscriptionRepo:    NewInMemorySubscriptionStore(),
+		EventRepo:           NewInMemoryEventStore(),
+		PlanRepo:            NewInMemoryPlanStore(),
+		PriceRepo:           NewInMemoryPriceStore(),
+		MeterRepo:           NewInMemoryMeterStore(),
+		CustomerRepo:        NewInMemoryCustomerStore(),
+		InvoiceRepo:         NewInMemoryInvoiceStore(),
+		WalletRepo:          NewInMemoryWalletStore(),
+		PaymentRepo:         NewInMemoryPaymentStore(),
+		AuthRepo:            NewInMemoryAuthRepository(),
+		UserRepo:            NewInMemoryUserStore(),
+		TenantRepo:          NewInMemoryTenantStore(),
+		EnvironmentRepo:     NewInMemoryEnvironmentStore(),
+		EntitlementRepo:     NewInMemoryEntitlementStore(),
+		FeatureRepo:         NewInMemoryFeatureStore(),
+		TaskRepo:            NewInMemoryTaskStore(),
+		SecretRepo:          NewInMemorySecretStore(),
+		CouponRepo:          NewInMemoryCouponStore(),
+		TaxRateRepo:         NewInMemoryTaxRateStore(),
+		CreditNoteRepo:      NewInMemoryCreditNoteStore(),
+		DunningRepo:         NewInMemoryDunningStore(),
+		AlertRepo:           NewInMemoryAlertStore(),
+		WebhookEndpointRepo: NewInMemoryWebhookEndpointStore(),
+		WebhookDeliveryRepo: NewInMemoryWebhookDeliveryStore(),
 	}
 
 	s.db = NewMockPostgresClient(s.logger)
 	s.pdfGenerator = NewMockPDFGenerator(s.logger)
 	eventStore := s.stores.EventRepo.(*InMemoryEventStore)
 	s.publisher = NewInMemoryEventPublisher(eventStore)
-	pubsub := NewInMemoryPubSub()
-	webhookPublisher, err := webhookPublisher.NewPublisher(pubsub, s.config, s.logger)
+	s.webhookPubSub = NewInMemoryPubSub()
+	webhookPublisher, err := webhookPublisher.NewPublisher(s.webhookPubSub, s.config, s.logger)
 	if err != nil {
 		s.T().Fatalf("failed to create webhook publisher: %v", err)
 	}
@@ -160,6 +186,13 @@ func (s *BaseServiceTestSuite) clearStores() {
 	s.stores.FeatureRepo.(*InMemoryFeatureStore).Clear()
 	s.stores.TaskRepo.(*InMemoryTaskStore).Clear()
 	s.stores.SecretRepo.(*InMemorySecretStore).Clear()
+	s.stores.CouponRepo.(*InMemoryCouponStore).Clear()
+	s.stores.TaxRateRepo.(*InMemoryTaxRateStore).Clear()
+	s.stores.CreditNoteRepo.(*InMemoryCreditNoteStore).Clear()
+	s.stores.DunningRepo.(*InMemoryDunningStore).Clear()
+	s.stores.AlertRepo.(*InMemoryAlertStore).Clear()
+	s.stores.WebhookEndpointRepo.(*InMemoryWebhookEndpointStore).Clear()
+	s.stores.WebhookDeliveryRepo.(*InMemoryWebhookDeliveryStore).Clear()
 }
 
 func (s *BaseServiceTestSuite) ClearStores() {
@@ -186,6 +219,19 @@ func (s *BaseServiceTestSuite) GetPublisher() publisher.EventPublisher {
 	return s.publisher
 }
 
+// GetWebhookEvents returns the webhook events published by the test webhook publisher
+func (s *BaseServiceTestSuite) GetWebhookEvents() []*types.WebhookEvent {
+	var events []*types.WebhookEvent
+	for _, msg := range s.webhookPubSub.GetMessages(s.config.Webhook.Topic) {
+		var event types.WebhookEvent
+		if err := json.Unmarshal(msg.Payload, &event); err != nil {
+			s.T().Fatalf("failed to unmarshal webhook event: %v", err)
+		}
+		events = append(events, &event)
+	}
+	return events
+}
+
 // GetWebhookPublisher returns the test webhook publisher
 func (s *BaseServiceTestSuite) GetWebhookPublisher() webhookPublisher.WebhookPublisher {
 	return s.webhookPublisher
@@ -0,0 +1,266 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/alert"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryAlertStore implements alert.Repository
+type InMemoryAlertStore struct {
+	*InMemoryStore[*alert.Rule]
+	events *InMemoryStore[*alert.Event]
+}
+
+// NewInMemoryAlertStore creates a new in-memory alert store
+func NewInMemoryAlertStore() *InMemoryAlertStore {
+	return &InMemoryAlertStore{
+		InMemoryStore: NewInMemoryStore[*alert.Rule](),
+		events:        NewInMemoryStore[*alert.Event](),
+	}
+}
+
+// alertRuleFilterFn implements filtering logic for alert rules
+func alertRuleFilterFn(ctx context.Context, r *alert.Rule, filter interface{}) bool {
+	if r == nil {
+		return false
+	}
+
+	f, ok := filter.(*types.AlertRuleFilter)
+	if !ok {
+		return true
+	}
+
+	if tenantID, ok := ctx.Value(types.CtxTenantID).(string); ok {
+		if r.TenantID != tenantID {
+			return false
+		}
+	}
+
+	if !CheckEnvironmentFilter(ctx, r.EnvironmentID) {
+		return false
+	}
+
+	if len(f.AlertRuleIDs) > 0 && !lo.Contains(f.AlertRuleIDs, r.ID) {
+		return false
+	}
+
+	if f.AlertType != "" && r.AlertType != f.AlertType {
+		return false
+	}
+
+	if f.FeatureID != "" && lo.FromPtr(r.FeatureID) != f.FeatureID {
+		return false
+	}
+
+	if f.CustomerID != "" && lo.FromPtr(r.CustomerID) != f.CustomerID {
+		return false
+	}
+
+	if f.SubscriptionID != "" && lo.FromPtr(r.SubscriptionID) != f.SubscriptionID {
+		return false
+	}
+
+	if f.QueryFilter != nil && f.Status != nil {
+		if r.Status != *f.Status {
+			return false
+		}
+	} else if r.Status == types.StatusArchived {
+		return false
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil && r.CreatedAt.Before(*f.StartTime) {
+			return false
+		}
+		if f.EndTime != nil && r.CreatedAt.After(*f.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// alertRuleSortFn implements sorting logic for alert rules
+func alertRuleSortFn(i, j *alert.Rule) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+// alertEventFilterFn implements filtering logic for alert events
+func alertEventFilterFn(ctx context.Context, e *alert.Event, filter interface{}) bool {
+	if e == nil {
+		return false
+	}
+
+	f, ok := filter.(*types.AlertEventFilter)
+	if !ok {
+		return true
+	}
+
+	if tenantID, ok := ctx.Value(types.CtxTenantID).(string); ok {
+		if e.TenantID != tenantID {
+			return false
+		}
+	}
+
+	if !CheckEnvironmentFilter(ctx, e.EnvironmentID) {
+		return false
+	}
+
+	if f.AlertRuleID != "" && e.AlertRuleID != f.AlertRuleID {
+		return false
+	}
+
+	if f.CustomerID != "" && e.CustomerID != f.CustomerID {
+		return false
+	}
+
+	if f.SubscriptionID != "" && e.SubscriptionID != f.SubscriptionID {
+		return false
+	}
+
+	if f.QueryFilter != nil && f.Status != nil {
+		if e.Status != *f.Status {
+			return false
+		}
+	} else if e.Status == types.StatusDeleted {
+		return false
+	}
+
+	return true
+}
+
+// alertEventSortFn sorts alert events with the most recent first
+func alertEventSortFn(i, j *alert.Event) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryAlertStore) Create(ctx context.Context, r *alert.Rule) error {
+	if r == nil {
+		return ierr.NewError("alert rule cannot be nil").
+			WithHint("Alert rule data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.EnvironmentID == "" {
+		r.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	if err := s.InMemoryStore.Create(ctx, r.ID, r); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create alert rule").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryAlertStore) Get(ctx context.Context, id string) (*alert.Rule, error) {
+	r, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Alert rule with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"alert_rule_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return r, nil
+}
+
+func (s *InMemoryAlertStore) List(ctx context.Context, filter *types.AlertRuleFilter) ([]*alert.Rule, error) {
+	return s.InMemoryStore.List(ctx, filter, alertRuleFilterFn, alertRuleSortFn)
+}
+
+func (s *InMemoryAlertStore) Count(ctx context.Context, filter *types.AlertRuleFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, alertRuleFilterFn)
+}
+
+func (s *InMemoryAlertStore) Update(ctx context.Context, r *alert.Rule) error {
+	if r == nil {
+		return ierr.NewError("alert rule cannot be nil").
+			WithHint("Alert rule data is required").
+			Mark(ierr.ErrValidation)
+	}
+	return s.InMemoryStore.Update(ctx, r.ID, r)
+}
+
+func (s *InMemoryAlertStore) Delete(ctx context.Context, id string) error {
+	r, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	r.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, id, r)
+}
+
+func (s *InMemoryAlertStore) CreateEvent(ctx context.Context, e *alert.Event) error {
+	if e == nil {
+		return ierr.NewError("alert event cannot be nil").
+			WithHint("Alert event data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if e.EnvironmentID == "" {
+		e.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	// A threshold is crossed at most once per subscription and billing period
+	existing, err := s.ListEvents(ctx, &types.AlertEventFilter{
+		QueryFilter:    types.NewNoLimitQueryFilter(),
+		AlertRuleID:    e.AlertRuleID,
+		SubscriptionID: e.SubscriptionID,
+	})
+	if err != nil {
+		return err
+	}
+	if lo.SomeBy(existing, func(x *alert.Event) bool {
+		return x.PeriodStart.Equal(e.PeriodStart) && x.Threshold.Equal(e.Threshold)
+	}) {
+		return ierr.NewError("alert event already exists").
+			WithHint("The threshold was already crossed in this period").
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	if err := s.events.Create(ctx, e.ID, e); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create alert event").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryAlertStore) GetEvent(ctx context.Context, id string) (*alert.Event, error) {
+	e, err := s.events.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Alert event with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"alert_event_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return e, nil
+}
+
+func (s *InMemoryAlertStore) ListEvents(ctx context.Context, filter *types.AlertEventFilter) ([]*alert.Event, error) {
+	return s.events.List(ctx, filter, alertEventFilterFn, alertEventSortFn)
+}
+
+func (s *InMemoryAlertStore) CountEvents(ctx context.Context, filter *types.AlertEventFilter) (int, error) {
+	return s.events.Count(ctx, filter, alertEventFilterFn)
+}
+
+// Clear removes all alert rules and alert events from the store
+func (s *InMemoryAlertStore) Clear() {
+	s.InMemoryStore.Clear()
+	s.events.Clear()
+}
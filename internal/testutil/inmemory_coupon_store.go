@@ -0,0 +1,304 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryCouponStore implements coupon.Repository
+type InMemoryCouponStore struct {
+	*InMemoryStore[*coupon.Coupon]
+	applications *InMemoryStore[*coupon.CouponApplication]
+	// redeemMu serialises the redemption and period counter updates like the conditional updates in postgres
+	redeemMu sync.Mutex
+}
+
+// NewInMemoryCouponStore creates a new in-memory coupon store
+func NewInMemoryCouponStore() *InMemoryCouponStore {
+	return &InMemoryCouponStore{
+		InMemoryStore: NewInMemoryStore[*coupon.Coupon](),
+		applications:  NewInMemoryStore[*coupon.CouponApplication](),
+	}
+}
+
+// couponFilterFn implements filtering logic for coupons
+func couponFilterFn(ctx context.Context, c *coupon.Coupon, filter interface{}) bool {
+	if c == nil {
+		return false
+	}
+
+	f, ok := filter.(*types.CouponFilter)
+	if !ok {
+		return true
+	}
+
+	if tenantID, ok := ctx.Value(types.CtxTenantID).(string); ok {
+		if c.TenantID != tenantID {
+			return false
+		}
+	}
+
+	if !CheckEnvironmentFilter(ctx, c.EnvironmentID) {
+		return false
+	}
+
+	if len(f.CouponIDs) > 0 && !lo.Contains(f.CouponIDs, c.ID) {
+		return false
+	}
+
+	if f.Code != "" && c.Code != f.Code {
+		return false
+	}
+
+	if f.DiscountType != "" && c.DiscountType != f.DiscountType {
+		return false
+	}
+
+	if f.QueryFilter != nil && f.Status != nil {
+		if c.Status != *f.Status {
+			return false
+		}
+	} else if c.Status == types.StatusArchived {
+		return false
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil && c.CreatedAt.Before(*f.StartTime) {
+			return false
+		}
+		if f.EndTime != nil && c.CreatedAt.After(*f.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// couponSortFn implements sorting logic for coupons
+func couponSortFn(i, j *coupon.Coupon) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+// couponApplicationFilterFn implements filtering logic for coupon applications
+func couponApplicationFilterFn(ctx context.Context, a *coupon.CouponApplication, filter interface{}) bool {
+	if a == nil {
+		return false
+	}
+
+	f, ok := filter.(*types.CouponApplicationFilter)
+	if !ok {
+		return true
+	}
+
+	if tenantID, ok := ctx.Value(types.CtxTenantID).(string); ok {
+		if a.TenantID != tenantID {
+			return false
+		}
+	}
+
+	if !CheckEnvironmentFilter(ctx, a.EnvironmentID) {
+		return false
+	}
+
+	if f.CouponID != "" && a.CouponID != f.CouponID {
+		return false
+	}
+
+	if f.CustomerID != "" && a.CustomerID != f.CustomerID {
+		return false
+	}
+
+	if f.SubscriptionID != "" && lo.FromPtr(a.SubscriptionID) != f.SubscriptionID {
+		return false
+	}
+
+	if f.QueryFilter != nil && f.Status != nil {
+		if a.Status != *f.Status {
+			return false
+		}
+	} else if a.Status == types.StatusDeleted {
+		return false
+	}
+
+	return true
+}
+
+// couponApplicationSortFn sorts coupon applications in the order they were applied
+func couponApplicationSortFn(i, j *coupon.CouponApplication) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.Before(j.CreatedAt)
+}
+
+func (s *InMemoryCouponStore) Create(ctx context.Context, c *coupon.Coupon) error {
+	if c == nil {
+		return ierr.NewError("coupon cannot be nil").
+			WithHint("Coupon data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if c.EnvironmentID == "" {
+		c.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	// Codes are unique among published coupons
+	if c.Code != "" {
+		existing, _ := s.InMemoryStore.List(ctx, nil, nil, nil)
+		for _, e := range existing {
+			if e.Code == c.Code && e.TenantID == c.TenantID && e.EnvironmentID == c.EnvironmentID && e.Status == types.StatusPublished {
+				return ierr.NewError("coupon code already exists").
+					WithHint("A coupon with this code already exists").
+					WithReportableDetails(map[string]any{
+						"code": c.Code,
+					}).
+					Mark(ierr.ErrAlreadyExists)
+			}
+		}
+	}
+
+	if err := s.InMemoryStore.Create(ctx, c.ID, c); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create coupon").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryCouponStore) Get(ctx context.Context, id string) (*coupon.Coupon, error) {
+	c, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Coupon with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"coupon_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return c, nil
+}
+
+func (s *InMemoryCouponStore) List(ctx context.Context, filter *types.CouponFilter) ([]*coupon.Coupon, error) {
+	return s.InMemoryStore.List(ctx, filter, couponFilterFn, couponSortFn)
+}
+
+func (s *InMemoryCouponStore) Count(ctx context.Context, filter *types.CouponFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, couponFilterFn)
+}
+
+func (s *InMemoryCouponStore) Update(ctx context.Context, c *coupon.Coupon) error {
+	if c == nil {
+		return ierr.NewError("coupon cannot be nil").
+			WithHint("Coupon data is required").
+			Mark(ierr.ErrValidation)
+	}
+	return s.InMemoryStore.Update(ctx, c.ID, c)
+}
+
+func (s *InMemoryCouponStore) Delete(ctx context.Context, id string) error {
+	c, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, id, c)
+}
+
+func (s *InMemoryCouponStore) IncrementTimesRedeemed(ctx context.Context, id string) (bool, error) {
+	s.redeemMu.Lock()
+	defer s.redeemMu.Unlock()
+
+	c, err := s.Get(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if c.MaxRedemptions != nil && c.TimesRedeemed >= *c.MaxRedemptions {
+		return false, nil
+	}
+
+	updated := *c
+	updated.TimesRedeemed++
+	return true, s.InMemoryStore.Update(ctx, id, &updated)
+}
+
+func (s *InMemoryCouponStore) CreateApplication(ctx context.Context, a *coupon.CouponApplication) error {
+	if a == nil {
+		return ierr.NewError("coupon application cannot be nil").
+			WithHint("Coupon application data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if a.EnvironmentID == "" {
+		a.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	if err := s.applications.Create(ctx, a.ID, a); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to apply coupon").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryCouponStore) GetApplication(ctx context.Context, id string) (*coupon.CouponApplication, error) {
+	a, err := s.applications.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Coupon application with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"coupon_application_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return a, nil
+}
+
+func (s *InMemoryCouponStore) ListApplications(ctx context.Context, filter *types.CouponApplicationFilter) ([]*coupon.CouponApplication, error) {
+	return s.applications.List(ctx, filter, couponApplicationFilterFn, couponApplicationSortFn)
+}
+
+func (s *InMemoryCouponStore) CountApplications(ctx context.Context, filter *types.CouponApplicationFilter) (int, error) {
+	return s.applications.Count(ctx, filter, couponApplicationFilterFn)
+}
+
+func (s *InMemoryCouponStore) UpdateApplication(ctx context.Context, a *coupon.CouponApplication) error {
+	if a == nil {
+		return ierr.NewError("coupon application cannot be nil").
+			WithHint("Coupon application data is required").
+			Mark(ierr.ErrValidation)
+	}
+	return s.applications.Update(ctx, a.ID, a)
+}
+
+func (s *InMemoryCouponStore) IncrementPeriodsApplied(ctx context.Context, id string, limit *int) (bool, error) {
+	s.redeemMu.Lock()
+	defer s.redeemMu.Unlock()
+
+	a, err := s.GetApplication(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if limit != nil && a.PeriodsApplied >= *limit {
+		return false, nil
+	}
+
+	updated := *a
+	updated.PeriodsApplied++
+	return true, s.applications.Update(ctx, id, &updated)
+}
+
+// Clear removes all coupons and coupon applications from the store
+func (s *InMemoryCouponStore) Clear() {
+	s.InMemoryStore.Clear()
+	s.applications.Clear()
+}
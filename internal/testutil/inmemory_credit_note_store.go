@@ -0,0 +1,154 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/creditnote"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryCreditNoteStore implements creditnote.Repository
+type InMemoryCreditNoteStore struct {
+	*InMemoryStore[*creditnote.CreditNote]
+	mu       sync.Mutex
+	sequence int
+}
+
+// NewInMemoryCreditNoteStore creates a new in-memory credit note store
+func NewInMemoryCreditNoteStore() *InMemoryCreditNoteStore {
+	return &InMemoryCreditNoteStore{
+		InMemoryStore: NewInMemoryStore[*creditnote.CreditNote](),
+	}
+}
+
+// creditNoteFilterFn implements filtering logic for credit notes
+func creditNoteFilterFn(ctx context.Context, n *creditnote.CreditNote, filter interface{}) bool {
+	if n == nil {
+		return false
+	}
+
+	f, ok := filter.(*types.CreditNoteFilter)
+	if !ok {
+		return true
+	}
+
+	if tenantID, ok := ctx.Value(types.CtxTenantID).(string); ok {
+		if n.TenantID != tenantID {
+			return false
+		}
+	}
+
+	if !CheckEnvironmentFilter(ctx, n.EnvironmentID) {
+		return false
+	}
+
+	if len(f.CreditNoteIDs) > 0 && !lo.Contains(f.CreditNoteIDs, n.ID) {
+		return false
+	}
+
+	if f.InvoiceID != "" && n.InvoiceID != f.InvoiceID {
+		return false
+	}
+
+	if f.CustomerID != "" && n.CustomerID != f.CustomerID {
+		return false
+	}
+
+	if f.Outcome != "" && n.Outcome != f.Outcome {
+		return false
+	}
+
+	if f.QueryFilter != nil && f.Status != nil {
+		if n.Status != *f.Status {
+			return false
+		}
+	} else if n.Status == types.StatusDeleted {
+		return false
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil && n.CreatedAt.Before(*f.StartTime) {
+			return false
+		}
+		if f.EndTime != nil && n.CreatedAt.After(*f.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// creditNoteSortFn implements sorting logic for credit notes
+func creditNoteSortFn(i, j *creditnote.CreditNote) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryCreditNoteStore) CreateWithLineItems(ctx context.Context, n *creditnote.CreditNote) error {
+	if n == nil {
+		return ierr.NewError("credit note cannot be nil").
+			WithHint("Credit note data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if n.EnvironmentID == "" {
+		n.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	for _, item := range n.LineItems {
+		item.CreditNoteID = n.ID
+		item.EnvironmentID = n.EnvironmentID
+	}
+
+	if err := s.InMemoryStore.Create(ctx, n.ID, n); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create credit note").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryCreditNoteStore) Get(ctx context.Context, id string) (*creditnote.CreditNote, error) {
+	n, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Credit note with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"credit_note_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return n, nil
+}
+
+func (s *InMemoryCreditNoteStore) List(ctx context.Context, filter *types.CreditNoteFilter) ([]*creditnote.CreditNote, error) {
+	return s.InMemoryStore.List(ctx, filter, creditNoteFilterFn, creditNoteSortFn)
+}
+
+func (s *InMemoryCreditNoteStore) Count(ctx context.Context, filter *types.CreditNoteFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, creditNoteFilterFn)
+}
+
+func (s *InMemoryCreditNoteStore) GetNextCreditNoteNumber(_ context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sequence++
+	return fmt.Sprintf("CN-%s-%05d", time.Now().Format("200601"), s.sequence), nil
+}
+
+// Clear removes all credit notes from the store and resets the sequence
+func (s *InMemoryCreditNoteStore) Clear() {
+	s.InMemoryStore.Clear()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sequence = 0
+}
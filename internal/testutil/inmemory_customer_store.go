@@ -40,6 +40,9 @@ func copyCustomer(c *customer.Customer) *customer.Customer {
 		AddressState:      c.AddressState,
 		AddressPostalCode: c.AddressPostalCode,
 		AddressCountry:    c.AddressCountry,
+		TaxIDs:            c.TaxIDs,
+		TaxExempt:         c.TaxExempt,
+		AutoApplyCredits:  c.AutoApplyCredits,
 		Metadata:          lo.Assign(map[string]string{}, c.Metadata),
 		EnvironmentID:     c.EnvironmentID,
 		BaseModel: types.BaseModel{
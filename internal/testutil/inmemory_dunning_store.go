@@ -0,0 +1,87 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/dunning"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryDunningStore implements dunning.Repository
+type InMemoryDunningStore struct {
+	*InMemoryStore[*dunning.Attempt]
+}
+
+// NewInMemoryDunningStore creates a new in-memory dunning attempt store
+func NewInMemoryDunningStore() *InMemoryDunningStore {
+	return &InMemoryDunningStore{
+		InMemoryStore: NewInMemoryStore[*dunning.Attempt](),
+	}
+}
+
+func (s *InMemoryDunningStore) Create(ctx context.Context, a *dunning.Attempt) error {
+	if a == nil {
+		return ierr.NewError("dunning attempt cannot be nil").
+			WithHint("Dunning attempt cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set environment ID from context if not already set
+	if a.EnvironmentID == "" {
+		a.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	// Attempts are unique per invoice and attempt number
+	existing, err := s.ListByInvoice(ctx, a.InvoiceID)
+	if err != nil {
+		return err
+	}
+	if lo.SomeBy(existing, func(e *dunning.Attempt) bool {
+		return e.AttemptNumber == a.AttemptNumber
+	}) {
+		return ierr.NewError("dunning attempt already exists").
+			WithHint("This dunning attempt was already made").
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	attempt := *a
+	return s.InMemoryStore.Create(ctx, a.ID, &attempt)
+}
+
+func (s *InMemoryDunningStore) ListByInvoice(ctx context.Context, invoiceID string) ([]*dunning.Attempt, error) {
+	return s.InMemoryStore.List(ctx, invoiceID, dunningAttemptFilterFn, dunningAttemptSortFn)
+}
+
+// dunningAttemptFilterFn filters the attempts of the invoice passed as filter
+func dunningAttemptFilterFn(ctx context.Context, a *dunning.Attempt, filter interface{}) bool {
+	if a == nil {
+		return false
+	}
+
+	invoiceID, ok := filter.(string)
+	if !ok {
+		return true
+	}
+
+	if tenantID, ok := ctx.Value(types.CtxTenantID).(string); ok {
+		if a.TenantID != tenantID {
+			return false
+		}
+	}
+
+	if !CheckEnvironmentFilter(ctx, a.EnvironmentID) {
+		return false
+	}
+
+	return a.InvoiceID == invoiceID
+}
+
+// dunningAttemptSortFn sorts attempts by attempt number
+func dunningAttemptSortFn(i, j *dunning.Attempt) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.AttemptNumber < j.AttemptNumber
+}
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/events"
 	ierr "github.com/flexprice/flexprice/internal/errors"
@@ -63,33 +64,16 @@ func (s *InMemoryEventStore) GetUsage(ctx context.Context, params *events.UsageP
 			continue
 		}
 
-		if event.Timestamp.Before(params.StartTime) || event.Timestamp.After(params.EndTime) {
+		// the time weighted sum carries in the value reported before the start of the period
+		if event.Timestamp.Before(params.StartTime) && params.AggregationType != types.AggregationWeightedSum {
 			continue
 		}
-
-		// Apply property filters
-		matchesFilters := true
-		for key, expectedValues := range params.Filters {
-			if propertyValue, exists := event.Properties[key]; exists {
-				valueStr := fmt.Sprintf("%v", propertyValue)
-				valueMatches := false
-				for _, expectedValue := range expectedValues {
-					if valueStr == expectedValue {
-						valueMatches = true
-						break
-					}
-				}
-				if !valueMatches {
-					matchesFilters = false
-					break
-				}
-			} else {
-				matchesFilters = false
-				break
-			}
+		if event.Timestamp.After(params.EndTime) {
+			continue
 		}
 
-		if matchesFilters {
+		// Apply property filters
+		if matchesPropertyFilters(event, params.Filters, params.FilterOperators) {
 			filteredEvents = append(filteredEvents, event)
 		}
 	}
@@ -113,6 +97,10 @@ func (s *InMemoryEventStore) GetUsage(ctx context.Context, params *events.UsageP
 			}
 		}
 		result.Value = sum
+	case types.AggregationMax, types.AggregationMin, types.AggregationLatest:
+		result.Value = aggregateGauge(filteredEvents, params.AggregationType, params.PropertyName)
+	case types.AggregationWeightedSum:
+		result.Value = aggregateWeightedSum(filteredEvents, params)
 	}
 
 	return result, nil
@@ -196,12 +184,19 @@ func (s *InMemoryEventStore) GetUsageWithFilters(ctx context.Context, params *ev
 	for _, group := range params.FilterGroups {
 		// Filter events based on base filters and group filters
 		var filteredEvents []*events.Event
+		baseParams := params.UsageParams
+		if params.AggregationType == types.AggregationWeightedSum {
+			withoutStart := *params.UsageParams
+			withoutStart.StartTime = time.Time{}
+			baseParams = &withoutStart
+		}
+
 		for _, event := range s.events {
-			if !s.matchesBaseFilters(ctx, event, params.UsageParams) {
+			if !s.matchesBaseFilters(ctx, event, baseParams) {
 				continue
 			}
 
-			if !s.matchesFilterGroup(event, group) {
+			if !s.matchesFilterGroup(event, group, params.FilterOperators) {
 				continue
 			}
 
@@ -219,21 +214,8 @@ func (s *InMemoryEventStore) GetUsageWithFilters(ctx context.Context, params *ev
 			for _, event := range filteredEvents {
 				if val, ok := event.Properties[params.PropertyName]; ok {
 					// Try to convert the value to float64
-					var floatVal float64
-					switch v := val.(type) {
-					case float64:
-						floatVal = v
-					case int64:
-						floatVal = float64(v)
-					case int:
-						floatVal = float64(v)
-					case string:
-						var err error
-						floatVal, err = strconv.ParseFloat(v, 64)
-						if err != nil {
-							continue
-						}
-					default:
+					floatVal, ok := toFloat64(val)
+					if !ok {
 						continue
 					}
 					sum = sum.Add(decimal.NewFromFloat(floatVal))
@@ -249,6 +231,10 @@ func (s *InMemoryEventStore) GetUsageWithFilters(ctx context.Context, params *ev
 			}
 			log.Printf("Calculated %s: sum=%v, count=%d, value=%v",
 				params.AggregationType, sum, count, value)
+		case types.AggregationMax, types.AggregationMin, types.AggregationLatest:
+			value = aggregateGauge(filteredEvents, params.AggregationType, params.PropertyName)
+		case types.AggregationWeightedSum:
+			value = aggregateWeightedSum(filteredEvents, params.UsageParams)
 		}
 		result := &events.AggregationResult{
 			EventName: params.EventName,
@@ -264,6 +250,118 @@ func (s *InMemoryEventStore) GetUsageWithFilters(ctx context.Context, params *ev
 	return results, nil
 }
 
+// aggregateGauge computes MAX, MIN and LATEST over the numeric values of the property
+func aggregateGauge(filteredEvents []*events.Event, aggType types.AggregationType, propertyName string) decimal.Decimal {
+	var (
+		value    decimal.Decimal
+		latestAt time.Time
+		found    bool
+	)
+
+	for _, event := range filteredEvents {
+		val, ok := event.Properties[propertyName]
+		if !ok {
+			continue
+		}
+		floatVal, ok := toFloat64(val)
+		if !ok {
+			continue
+		}
+		current := decimal.NewFromFloat(floatVal)
+
+		switch aggType {
+		case types.AggregationMax:
+			if !found || current.GreaterThan(value) {
+				value = current
+			}
+		case types.AggregationMin:
+			if !found || current.LessThan(value) {
+				value = current
+			}
+		case types.AggregationLatest:
+			if !found || event.Timestamp.After(latestAt) {
+				value = current
+				latestAt = event.Timestamp
+			}
+		}
+		found = true
+	}
+
+	return value
+}
+
+// aggregateWeightedSum integrates the property over the period where each value holds
+// until the next event, normalised by the period length. The last value reported before
+// the period holds from its start.
+func aggregateWeightedSum(filteredEvents []*events.Event, params *events.UsageParams) decimal.Decimal {
+	period := params.EndTime.Sub(params.StartTime)
+	if period <= 0 {
+		return decimal.Zero
+	}
+
+	type segment struct {
+		start time.Time
+		value float64
+	}
+
+	sorted := make([]*events.Event, len(filteredEvents))
+	copy(sorted, filteredEvents)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	var segments []segment
+	for _, event := range sorted {
+		val, ok := event.Properties[params.PropertyName]
+		if !ok {
+			continue
+		}
+		floatVal, ok := toFloat64(val)
+		if !ok {
+			continue
+		}
+
+		if event.Timestamp.Before(params.StartTime) {
+			segments = []segment{{start: params.StartTime, value: floatVal}}
+			continue
+		}
+		segments = append(segments, segment{start: event.Timestamp, value: floatVal})
+	}
+
+	total := decimal.Zero
+	for i, seg := range segments {
+		next := params.EndTime
+		if i+1 < len(segments) {
+			next = segments[i+1].start
+		}
+
+		duration := decimal.NewFromInt(next.Sub(seg.start).Milliseconds())
+		total = total.Add(decimal.NewFromFloat(seg.value).Mul(duration))
+	}
+
+	return total.Div(decimal.NewFromInt(period.Milliseconds()))
+}
+
+// toFloat64 converts a numeric event property to float64
+func toFloat64(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case string:
+		floatVal, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, false
+		}
+		return floatVal, true
+	default:
+		return 0, false
+	}
+}
+
 func (s *InMemoryEventStore) matchesBaseFilters(ctx context.Context, event *events.Event, params *events.UsageParams) bool {
 	// check tenant ID
 	tenantID := types.GetTenantID(ctx)
@@ -292,50 +390,37 @@ func (s *InMemoryEventStore) matchesBaseFilters(ctx context.Context, event *even
 	}
 
 	// Check base filters
-	if params.Filters != nil {
-		for key, values := range params.Filters {
-			if propValue, ok := event.Properties[key]; !ok {
-				log.Printf("Event %s missing property %s", event.ID, key)
-				return false
-			} else {
-				found := false
-				for _, value := range values {
-					if fmt.Sprintf("%v", propValue) == value {
-						found = true
-						break
-					}
-				}
-				if !found {
-					log.Printf("Event %s property %s=%v not in values %v",
-						event.ID, key, propValue, values)
-					return false
-				}
-			}
-		}
+	if !matchesPropertyFilters(event, params.Filters, params.FilterOperators) {
+		log.Printf("Event %s does not match filters %v", event.ID, params.Filters)
+		return false
 	}
 
 	return true
 }
 
-func (s *InMemoryEventStore) matchesFilterGroup(event *events.Event, group events.FilterGroup) bool {
-	if len(group.Filters) == 0 {
-		return true
-	}
+func (s *InMemoryEventStore) matchesFilterGroup(event *events.Event, group events.FilterGroup, operators map[string]types.FilterOperator) bool {
+	return matchesPropertyFilters(event, group.Filters, operators)
+}
 
-	for key, values := range group.Filters {
-		if propValue, ok := event.Properties[key]; !ok {
-			return false
-		} else {
-			found := false
-			for _, value := range values {
-				if fmt.Sprintf("%v", propValue) == value {
-					found = true
-					break
-				}
-			}
-			if !found {
+// matchesPropertyFilters mirrors the clickhouse filter conditions including nested keys and operators
+func matchesPropertyFilters(event *events.Event, filters map[string][]string, operators map[string]types.FilterOperator) bool {
+	for key, values := range filters {
+		if len(values) == 0 {
+			continue
+		}
+
+		operator := operators[key].OrDefault()
+		propValue, ok := types.LookupProperty(event.Properties, key)
+		if !ok {
+			// a missing property can only satisfy a negative match
+			if operator != types.FilterOperatorNotIn {
 				return false
 			}
+			continue
+		}
+
+		if !operator.Match(fmt.Sprintf("%v", propValue), values) {
+			return false
 		}
 	}
 
@@ -3,17 +3,21 @@ package testutil
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 // InMemoryInvoiceStore implements invoice.Repository
 type InMemoryInvoiceStore struct {
 	*InMemoryStore[*invoice.Invoice]
+	// creditMu serialises the credited amount updates like the conditional update in postgres
+	creditMu sync.Mutex
 }
 
 // NewInMemoryInvoiceStore creates a new in-memory invoice store
@@ -52,6 +56,7 @@ func copyInvoice(inv *invoice.Invoice) *invoice.Invoice {
 			Currency:         item.Currency,
 			PeriodStart:      item.PeriodStart,
 			PeriodEnd:        item.PeriodEnd,
+			TierBreakdown:    item.TierBreakdown,
 			Metadata:         item.Metadata,
 			BaseModel:        item.BaseModel,
 		})
@@ -65,18 +70,27 @@ func copyInvoice(inv *invoice.Invoice) *invoice.Invoice {
 		InvoiceStatus:   inv.InvoiceStatus,
 		PaymentStatus:   inv.PaymentStatus,
 		Currency:        inv.Currency,
+		Subtotal:        inv.Subtotal,
+		TotalDiscount:   inv.TotalDiscount,
+		Total:           inv.Total,
+		Discounts:       inv.Discounts,
+		TotalTax:        inv.TotalTax,
+		Taxes:           inv.Taxes,
 		AmountDue:       inv.AmountDue,
 		AmountPaid:      inv.AmountPaid,
 		AmountRemaining: inv.AmountRemaining,
+		AmountCredited:  inv.AmountCredited,
 		Description:     inv.Description,
 		DueDate:         inv.DueDate,
 		PaidAt:          inv.PaidAt,
 		VoidedAt:        inv.VoidedAt,
 		FinalizedAt:     inv.FinalizedAt,
 		BillingPeriod:   inv.BillingPeriod,
+		BillingReason:   inv.BillingReason,
 		PeriodStart:     inv.PeriodStart,
 		PeriodEnd:       inv.PeriodEnd,
 		InvoicePDFURL:   inv.InvoicePDFURL,
+		IdempotencyKey:  inv.IdempotencyKey,
 		LineItems:       lineItems,
 		Metadata:        inv.Metadata,
 		EnvironmentID:   inv.EnvironmentID,
@@ -145,6 +159,23 @@ func (s *InMemoryInvoiceStore) Update(ctx context.Context, inv *invoice.Invoice)
 	return s.InMemoryStore.Update(ctx, inv.ID, copyInvoice(inv))
 }
 
+func (s *InMemoryInvoiceStore) IncrementAmountCredited(ctx context.Context, id string, amount, limit decimal.Decimal) (bool, error) {
+	s.creditMu.Lock()
+	defer s.creditMu.Unlock()
+
+	inv, err := s.Get(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if inv.AmountCredited.Add(amount).GreaterThan(limit) {
+		return false, nil
+	}
+
+	inv.AmountCredited = inv.AmountCredited.Add(amount)
+	return true, s.InMemoryStore.Update(ctx, id, inv)
+}
+
 func (s *InMemoryInvoiceStore) Delete(ctx context.Context, id string) error {
 	return s.InMemoryStore.Delete(ctx, id)
 }
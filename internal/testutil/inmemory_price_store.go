@@ -57,6 +57,15 @@ func priceFilterFn(ctx context.Context, p *price.Price, filter interface{}) bool
 		}
 	}
 
+	// Prices scoped to a subscription are only listed by subscription or by ID
+	if len(f.SubscriptionIDs) > 0 {
+		if p.SubscriptionID == nil || !lo.Contains(f.SubscriptionIDs, *p.SubscriptionID) {
+			return false
+		}
+	} else if len(f.PriceIDs) == 0 && p.SubscriptionID != nil {
+		return false
+	}
+
 	// Filter by status
 	if f.Status != nil && p.Status != *f.Status {
 		return false
@@ -216,10 +225,19 @@ func (s *InMemoryPriceStore) CreateBulk(ctx context.Context, prices []*price.Pri
 	return nil
 }
 
-// DeleteBulk deletes multiple prices in bulk
+// DeleteBulk archives multiple prices in bulk, like the postgres repository the prices can still be
+// fetched by the line items of the subscriptions billed for them
 func (s *InMemoryPriceStore) DeleteBulk(ctx context.Context, ids []string) error {
 	for _, id := range ids {
-		if err := s.Delete(ctx, id); err != nil {
+		p, err := s.Get(ctx, id)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to delete prices in bulk").
+				Mark(ierr.ErrDatabase)
+		}
+
+		p.Status = types.StatusArchived
+		if err := s.Update(ctx, p); err != nil {
 			return ierr.WithError(err).
 				WithHint("Failed to delete prices in bulk").
 				Mark(ierr.ErrDatabase)
@@ -2,6 +2,9 @@ package testutil
 
 import (
 	"context"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	ierr "github.com/flexprice/flexprice/internal/errors"
@@ -15,6 +18,11 @@ type InMemorySubscriptionStore struct {
 	lineItems map[string][]*subscription.SubscriptionLineItem // map[subscriptionID][]lineItems
 	pauses    map[string][]*subscription.SubscriptionPause    // map[subscriptionID][]pauses
 	pauseByID map[string]*subscription.SubscriptionPause      // map[pauseID]pause
+
+	quantityChanges map[string][]*subscription.SubscriptionQuantityChange // map[subscriptionID][]changes
+
+	// notifyMu serialises the trial will end markers like the conditional update in postgres
+	notifyMu sync.Mutex
 }
 
 func NewInMemorySubscriptionStore() *InMemorySubscriptionStore {
@@ -23,6 +31,8 @@ func NewInMemorySubscriptionStore() *InMemorySubscriptionStore {
 		lineItems:     make(map[string][]*subscription.SubscriptionLineItem),
 		pauses:        make(map[string][]*subscription.SubscriptionPause),
 		pauseByID:     make(map[string]*subscription.SubscriptionPause),
+
+		quantityChanges: make(map[string][]*subscription.SubscriptionQuantityChange),
 	}
 }
 
@@ -74,6 +84,19 @@ func subscriptionFilterFn(ctx context.Context, sub *subscription.Subscription, f
 		return false
 	}
 
+	// Filter by trial end
+	if f.TrialEndBefore != nil && (sub.TrialEnd == nil || sub.TrialEnd.After(*f.TrialEndBefore)) {
+		return false
+	}
+	if f.TrialEndAfter != nil && (sub.TrialEnd == nil || !sub.TrialEnd.After(*f.TrialEndAfter)) {
+		return false
+	}
+
+	// Filter by trial will end notified
+	if f.TrialWillEndNotified != nil && *f.TrialWillEndNotified != (sub.TrialWillEndNotifiedAt != nil) {
+		return false
+	}
+
 	// Filter by time range
 	if f.TimeRangeFilter != nil {
 		if f.StartTime != nil && sub.CreatedAt.Before(*f.StartTime) {
@@ -158,9 +181,11 @@ func (s *InMemorySubscriptionStore) Get(ctx context.Context, id string) (*subscr
 			}).
 			Mark(ierr.ErrDatabase)
 	}
-	// Attach line items if they exist
+	// Attach the line items in effect if they exist
 	if items, ok := s.lineItems[id]; ok {
-		sub.LineItems = items
+		sub.LineItems = lo.Filter(items, func(item *subscription.SubscriptionLineItem, _ int) bool {
+			return item.Status != types.StatusArchived
+		})
 	}
 	return sub, nil
 }
@@ -274,24 +299,45 @@ func (s *InMemorySubscriptionStore) Delete(ctx context.Context, id string) error
 func (s *InMemorySubscriptionStore) ListAll(ctx context.Context, filter *types.SubscriptionFilter) ([]*subscription.Subscription, error) {
 	// Create an unlimited filter
 	unlimitedFilter := &types.SubscriptionFilter{
-		QueryFilter:        types.NewNoLimitQueryFilter(),
-		TimeRangeFilter:    filter.TimeRangeFilter,
-		CustomerID:         filter.CustomerID,
-		PlanID:             filter.PlanID,
-		SubscriptionStatus: filter.SubscriptionStatus,
-		BillingCadence:     filter.BillingCadence,
-		BillingPeriod:      filter.BillingPeriod,
-		IncludeCanceled:    filter.IncludeCanceled,
-		ActiveAt:           filter.ActiveAt,
+		QueryFilter:          types.NewNoLimitQueryFilter(),
+		TimeRangeFilter:      filter.TimeRangeFilter,
+		CustomerID:           filter.CustomerID,
+		PlanID:               filter.PlanID,
+		SubscriptionStatus:   filter.SubscriptionStatus,
+		BillingCadence:       filter.BillingCadence,
+		BillingPeriod:        filter.BillingPeriod,
+		IncludeCanceled:      filter.IncludeCanceled,
+		ActiveAt:             filter.ActiveAt,
+		TrialEndBefore:       filter.TrialEndBefore,
+		TrialEndAfter:        filter.TrialEndAfter,
+		TrialWillEndNotified: filter.TrialWillEndNotified,
 	}
 
 	return s.List(ctx, unlimitedFilter)
 }
 
-// ListAllTenant returns all subscriptions across all tenants
+// ListAllTenant returns the subscriptions across all tenants, paginated by the filter
 // NOTE: This is a potentially expensive operation and to be used only for CRONs
 func (s *InMemorySubscriptionStore) ListAllTenant(ctx context.Context, filter *types.SubscriptionFilter) ([]*subscription.Subscription, error) {
-	return s.ListAll(ctx, filter)
+	return s.List(ctx, filter)
+}
+
+// MarkTrialWillEndNotified sets the trial will end notified time unless already set
+func (s *InMemorySubscriptionStore) MarkTrialWillEndNotified(ctx context.Context, id string, notifiedAt time.Time) (bool, error) {
+	s.notifyMu.Lock()
+	defer s.notifyMu.Unlock()
+
+	sub, err := s.Get(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if sub.TrialWillEndNotifiedAt != nil {
+		return false, nil
+	}
+
+	updated := *sub
+	updated.TrialWillEndNotifiedAt = &notifiedAt
+	return true, s.InMemoryStore.Update(ctx, id, &updated)
 }
 
 // CreateWithLineItems creates a subscription with its line items
@@ -310,9 +356,24 @@ func (s *InMemorySubscriptionStore) GetWithLineItems(ctx context.Context, id str
 	if err != nil {
 		return nil, nil, err
 	}
-	items := s.lineItems[id]
-	sub.LineItems = items
-	return sub, items, nil
+	return sub, sub.LineItems, nil
+}
+
+// ReplaceLineItems updates the subscription, archives the ended line items and creates the new ones
+func (s *InMemorySubscriptionStore) ReplaceLineItems(ctx context.Context, sub *subscription.Subscription, endedItems, newItems []*subscription.SubscriptionLineItem) error {
+	if err := s.Update(ctx, sub); err != nil {
+		return err
+	}
+
+	for _, item := range endedItems {
+		item.Status = types.StatusArchived
+	}
+
+	s.lineItems[sub.ID] = append(s.lineItems[sub.ID], newItems...)
+	sub.LineItems = lo.Filter(s.lineItems[sub.ID], func(item *subscription.SubscriptionLineItem, _ int) bool {
+		return item.Status != types.StatusArchived
+	})
+	return nil
 }
 
 // CreatePause creates a new subscription pause
@@ -400,3 +461,53 @@ func (s *InMemorySubscriptionStore) GetWithPauses(ctx context.Context, id string
 	sub.Pauses = pauses
 	return sub, pauses, nil
 }
+
+// UpdateLineItemQuantity sets the quantity of a subscription line item and records the change
+func (s *InMemorySubscriptionStore) UpdateLineItemQuantity(ctx context.Context, item *subscription.SubscriptionLineItem, change *subscription.SubscriptionQuantityChange) error {
+	existing, ok := lo.Find(s.lineItems[item.SubscriptionID], func(li *subscription.SubscriptionLineItem) bool {
+		return li.ID == item.ID && li.Status != types.StatusArchived
+	})
+	if !ok {
+		return ierr.NewError("subscription line item not found").
+			WithHint("Subscription line item not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": item.ID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	existing.Quantity = item.Quantity
+
+	// Set environment ID from context if not already set
+	if change.EnvironmentID == "" {
+		change.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	s.quantityChanges[change.SubscriptionID] = append(s.quantityChanges[change.SubscriptionID], change)
+	return nil
+}
+
+// UpdateQuantityChange updates a subscription quantity change
+func (s *InMemorySubscriptionStore) UpdateQuantityChange(ctx context.Context, change *subscription.SubscriptionQuantityChange) error {
+	for i, c := range s.quantityChanges[change.SubscriptionID] {
+		if c.ID == change.ID {
+			s.quantityChanges[change.SubscriptionID][i] = change
+			return nil
+		}
+	}
+
+	return ierr.NewError("quantity change not found").
+		WithHint("Quantity change not found").
+		WithReportableDetails(map[string]interface{}{
+			"id": change.ID,
+		}).
+		Mark(ierr.ErrNotFound)
+}
+
+// ListQuantityChanges lists the quantity changes of a subscription ordered by effective date
+func (s *InMemorySubscriptionStore) ListQuantityChanges(ctx context.Context, subscriptionID string) ([]*subscription.SubscriptionQuantityChange, error) {
+	changes := append([]*subscription.SubscriptionQuantityChange{}, s.quantityChanges[subscriptionID]...)
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].EffectiveDate.Before(changes[j].EffectiveDate)
+	})
+	return changes, nil
+}
@@ -31,6 +31,7 @@ func copyTask(t *task.Task) *task.Task {
 		TaskType:          t.TaskType,
 		EntityType:        t.EntityType,
 		FileURL:           t.FileURL,
+		FileName:          t.FileName,
 		FileType:          t.FileType,
 		TaskStatus:        t.TaskStatus,
 		TotalRecords:      t.TotalRecords,
@@ -0,0 +1,147 @@
+package testutil
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/domain/tax"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryTaxRateStore implements tax.Repository
+type InMemoryTaxRateStore struct {
+	*InMemoryStore[*tax.TaxRate]
+}
+
+// NewInMemoryTaxRateStore creates a new in-memory tax rate store
+func NewInMemoryTaxRateStore() *InMemoryTaxRateStore {
+	return &InMemoryTaxRateStore{
+		InMemoryStore: NewInMemoryStore[*tax.TaxRate](),
+	}
+}
+
+// taxRateFilterFn implements filtering logic for tax rates
+func taxRateFilterFn(ctx context.Context, r *tax.TaxRate, filter interface{}) bool {
+	if r == nil {
+		return false
+	}
+
+	f, ok := filter.(*types.TaxRateFilter)
+	if !ok {
+		return true
+	}
+
+	if tenantID, ok := ctx.Value(types.CtxTenantID).(string); ok {
+		if r.TenantID != tenantID {
+			return false
+		}
+	}
+
+	if !CheckEnvironmentFilter(ctx, r.EnvironmentID) {
+		return false
+	}
+
+	if len(f.TaxRateIDs) > 0 && !lo.Contains(f.TaxRateIDs, r.ID) {
+		return false
+	}
+
+	if f.Country != "" && !strings.EqualFold(r.Country, f.Country) {
+		return false
+	}
+
+	if f.State != "" && !strings.EqualFold(r.State, f.State) {
+		return false
+	}
+
+	if f.QueryFilter != nil && f.Status != nil {
+		if r.Status != *f.Status {
+			return false
+		}
+	} else if r.Status == types.StatusArchived {
+		return false
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil && r.CreatedAt.Before(*f.StartTime) {
+			return false
+		}
+		if f.EndTime != nil && r.CreatedAt.After(*f.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// taxRateSortFn implements sorting logic for tax rates
+func taxRateSortFn(i, j *tax.TaxRate) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryTaxRateStore) Create(ctx context.Context, r *tax.TaxRate) error {
+	if r == nil {
+		return ierr.NewError("tax rate cannot be nil").
+			WithHint("Tax rate data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.EnvironmentID == "" {
+		r.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	if err := s.InMemoryStore.Create(ctx, r.ID, r); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create tax rate").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryTaxRateStore) Get(ctx context.Context, id string) (*tax.TaxRate, error) {
+	r, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Tax rate with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"tax_rate_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return r, nil
+}
+
+func (s *InMemoryTaxRateStore) List(ctx context.Context, filter *types.TaxRateFilter) ([]*tax.TaxRate, error) {
+	return s.InMemoryStore.List(ctx, filter, taxRateFilterFn, taxRateSortFn)
+}
+
+func (s *InMemoryTaxRateStore) Count(ctx context.Context, filter *types.TaxRateFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, taxRateFilterFn)
+}
+
+func (s *InMemoryTaxRateStore) Update(ctx context.Context, r *tax.TaxRate) error {
+	if r == nil {
+		return ierr.NewError("tax rate cannot be nil").
+			WithHint("Tax rate data is required").
+			Mark(ierr.ErrValidation)
+	}
+	return s.InMemoryStore.Update(ctx, r.ID, r)
+}
+
+func (s *InMemoryTaxRateStore) Delete(ctx context.Context, id string) error {
+	r, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	r.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, id, r)
+}
+
+// Clear removes all tax rates from the store
+func (s *InMemoryTaxRateStore) Clear() {
+	s.InMemoryStore.Clear()
+}
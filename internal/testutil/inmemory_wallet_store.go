@@ -494,6 +494,11 @@ func (s *InMemoryWalletStore) UpdateWallet(ctx context.Context, id string, w *wa
 	if !w.AutoTopupAmount.IsZero() {
 		existing.AutoTopupAmount = w.AutoTopupAmount
 	}
+	if w.AutoTopupMethod != "" {
+		existing.AutoTopupMethod = w.AutoTopupMethod
+		existing.AutoTopupPaymentMethodType = w.AutoTopupPaymentMethodType
+		existing.AutoTopupPaymentMethodID = w.AutoTopupPaymentMethodID
+	}
 	// Update config if provided (WalletConfig is a struct type, so we always update it)
 	existing.Config = w.Config
 
@@ -0,0 +1,143 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryWebhookDeliveryStore implements webhookdelivery.Repository
+type InMemoryWebhookDeliveryStore struct {
+	*InMemoryStore[*webhookdelivery.WebhookDelivery]
+}
+
+// NewInMemoryWebhookDeliveryStore creates a new in-memory webhook delivery store
+func NewInMemoryWebhookDeliveryStore() *InMemoryWebhookDeliveryStore {
+	return &InMemoryWebhookDeliveryStore{
+		InMemoryStore: NewInMemoryStore[*webhookdelivery.WebhookDelivery](),
+	}
+}
+
+// webhookDeliveryFilterFn implements filtering logic for webhook deliveries
+func webhookDeliveryFilterFn(ctx context.Context, d *webhookdelivery.WebhookDelivery, filter interface{}) bool {
+	if d == nil {
+		return false
+	}
+
+	f, ok := filter.(*types.WebhookDeliveryFilter)
+	if !ok {
+		return true
+	}
+
+	if tenantID, ok := ctx.Value(types.CtxTenantID).(string); ok {
+		if d.TenantID != tenantID {
+			return false
+		}
+	}
+
+	if !CheckEnvironmentFilter(ctx, d.EnvironmentID) {
+		return false
+	}
+
+	if f.WebhookEndpointID != "" && lo.FromPtr(d.WebhookEndpointID) != f.WebhookEndpointID {
+		return false
+	}
+
+	if f.EventID != "" && d.EventID != f.EventID {
+		return false
+	}
+
+	if f.EventName != "" && d.EventName != f.EventName {
+		return false
+	}
+
+	if len(f.DeliveryStatuses) > 0 && !lo.Contains(f.DeliveryStatuses, d.DeliveryStatus) {
+		return false
+	}
+
+	if f.QueryFilter != nil && f.Status != nil {
+		if d.Status != *f.Status {
+			return false
+		}
+	} else if d.Status == types.StatusDeleted {
+		return false
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil && d.CreatedAt.Before(*f.StartTime) {
+			return false
+		}
+		if f.EndTime != nil && d.CreatedAt.After(*f.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// webhookDeliverySortFn sorts webhook deliveries with the most recent first
+func webhookDeliverySortFn(i, j *webhookdelivery.WebhookDelivery) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryWebhookDeliveryStore) Create(ctx context.Context, d *webhookdelivery.WebhookDelivery) error {
+	if d == nil {
+		return ierr.NewError("webhook delivery cannot be nil").
+			WithHint("Webhook delivery data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if d.EnvironmentID == "" {
+		d.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	if err := s.InMemoryStore.Create(ctx, d.ID, d); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create webhook delivery").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryWebhookDeliveryStore) Get(ctx context.Context, id string) (*webhookdelivery.WebhookDelivery, error) {
+	d, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Webhook delivery with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"webhook_delivery_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return d, nil
+}
+
+func (s *InMemoryWebhookDeliveryStore) List(ctx context.Context, filter *types.WebhookDeliveryFilter) ([]*webhookdelivery.WebhookDelivery, error) {
+	return s.InMemoryStore.List(ctx, filter, webhookDeliveryFilterFn, webhookDeliverySortFn)
+}
+
+func (s *InMemoryWebhookDeliveryStore) Count(ctx context.Context, filter *types.WebhookDeliveryFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, webhookDeliveryFilterFn)
+}
+
+func (s *InMemoryWebhookDeliveryStore) UpdateDeliveryStatus(ctx context.Context, id string, status types.WebhookDeliveryStatus) error {
+	d, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	d.DeliveryStatus = status
+	d.UpdatedAt = time.Now().UTC()
+	return s.InMemoryStore.Update(ctx, id, d)
+}
+
+// Clear removes all webhook deliveries from the store
+func (s *InMemoryWebhookDeliveryStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -0,0 +1,142 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryWebhookEndpointStore implements webhookendpoint.Repository
+type InMemoryWebhookEndpointStore struct {
+	*InMemoryStore[*webhookendpoint.WebhookEndpoint]
+}
+
+// NewInMemoryWebhookEndpointStore creates a new in-memory webhook endpoint store
+func NewInMemoryWebhookEndpointStore() *InMemoryWebhookEndpointStore {
+	return &InMemoryWebhookEndpointStore{
+		InMemoryStore: NewInMemoryStore[*webhookendpoint.WebhookEndpoint](),
+	}
+}
+
+// webhookEndpointFilterFn implements filtering logic for webhook endpoints
+func webhookEndpointFilterFn(ctx context.Context, e *webhookendpoint.WebhookEndpoint, filter interface{}) bool {
+	if e == nil {
+		return false
+	}
+
+	f, ok := filter.(*types.WebhookEndpointFilter)
+	if !ok {
+		return true
+	}
+
+	if tenantID, ok := ctx.Value(types.CtxTenantID).(string); ok {
+		if e.TenantID != tenantID {
+			return false
+		}
+	}
+
+	if !CheckEnvironmentFilter(ctx, e.EnvironmentID) {
+		return false
+	}
+
+	if len(f.WebhookEndpointIDs) > 0 && !lo.Contains(f.WebhookEndpointIDs, e.ID) {
+		return false
+	}
+
+	if f.Enabled != nil && e.Enabled != *f.Enabled {
+		return false
+	}
+
+	if f.QueryFilter != nil && f.Status != nil {
+		if e.Status != *f.Status {
+			return false
+		}
+	} else if e.Status == types.StatusArchived {
+		return false
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil && e.CreatedAt.Before(*f.StartTime) {
+			return false
+		}
+		if f.EndTime != nil && e.CreatedAt.After(*f.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// webhookEndpointSortFn implements sorting logic for webhook endpoints
+func webhookEndpointSortFn(i, j *webhookendpoint.WebhookEndpoint) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryWebhookEndpointStore) Create(ctx context.Context, e *webhookendpoint.WebhookEndpoint) error {
+	if e == nil {
+		return ierr.NewError("webhook endpoint cannot be nil").
+			WithHint("Webhook endpoint data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if e.EnvironmentID == "" {
+		e.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	if err := s.InMemoryStore.Create(ctx, e.ID, e); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create webhook endpoint").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryWebhookEndpointStore) Get(ctx context.Context, id string) (*webhookendpoint.WebhookEndpoint, error) {
+	e, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Webhook endpoint with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"webhook_endpoint_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return e, nil
+}
+
+func (s *InMemoryWebhookEndpointStore) List(ctx context.Context, filter *types.WebhookEndpointFilter) ([]*webhookendpoint.WebhookEndpoint, error) {
+	return s.InMemoryStore.List(ctx, filter, webhookEndpointFilterFn, webhookEndpointSortFn)
+}
+
+func (s *InMemoryWebhookEndpointStore) Count(ctx context.Context, filter *types.WebhookEndpointFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, webhookEndpointFilterFn)
+}
+
+func (s *InMemoryWebhookEndpointStore) Update(ctx context.Context, e *webhookendpoint.WebhookEndpoint) error {
+	if e == nil {
+		return ierr.NewError("webhook endpoint cannot be nil").
+			WithHint("Webhook endpoint data is required").
+			Mark(ierr.ErrValidation)
+	}
+	return s.InMemoryStore.Update(ctx, e.ID, e)
+}
+
+func (s *InMemoryWebhookEndpointStore) Delete(ctx context.Context, id string) error {
+	e, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	e.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, id, e)
+}
+
+// Clear removes all webhook endpoints from the store
+func (s *InMemoryWebhookEndpointStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -0,0 +1,49 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/flexprice/flexprice/internal/httpclient"
+)
+
+var _ httpclient.Resolver = (*MockResolver)(nil) // Ensure MockResolver implements Resolver
+
+// MockResolver resolves the registered host names without any DNS lookup
+type MockResolver struct {
+	mu    sync.RWMutex
+	hosts map[string][]string
+}
+
+// NewMockResolver creates a new mock resolver
+func NewMockResolver() *MockResolver {
+	return &MockResolver{
+		hosts: make(map[string][]string),
+	}
+}
+
+// RegisterHost registers the addresses a host name resolves to
+func (r *MockResolver) RegisterHost(host string, ips ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hosts[host] = ips
+}
+
+// LookupIPAddr returns the addresses registered for the host
+func (r *MockResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ips, ok := r.hosts[host]
+	if !ok {
+		return nil, fmt.Errorf("no such host: %s", host)
+	}
+
+	addrs := make([]net.IPAddr, 0, len(ips))
+	for _, ip := range ips {
+		addrs = append(addrs, net.IPAddr{IP: net.ParseIP(ip)})
+	}
+	return addrs, nil
+}
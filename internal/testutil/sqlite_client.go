@@ -0,0 +1,44 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// NewSQLiteClient creates a postgres client over an in-memory sqlite database holding the given
+// tables, for the tests which need real transactions. Only the tables whose columns have no
+// postgres specific types can be created.
+func NewSQLiteClient(t *testing.T, logger *logger.Logger, tables ...*schema.Table) postgres.IClient {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", types.GenerateUUID())
+	drv, err := entsql.Open(dialect.SQLite, dsn)
+	if err != nil {
+		t.Fatalf("failed to open sqlite database: %v", err)
+	}
+
+	migrate, err := schema.NewMigrate(drv)
+	if err != nil {
+		t.Fatalf("failed to create sqlite migration: %v", err)
+	}
+	if err := migrate.Create(context.Background(), tables...); err != nil {
+		t.Fatalf("failed to create sqlite tables: %v", err)
+	}
+
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return postgres.NewClient(client, logger)
+}
@@ -9,11 +9,21 @@ const (
 	AggregationSum         AggregationType = "SUM"
 	AggregationAvg         AggregationType = "AVG"
 	AggregationCountUnique AggregationType = "COUNT_UNIQUE"
+	AggregationMax         AggregationType = "MAX"
+	AggregationMin         AggregationType = "MIN"
+	// AggregationLatest picks the value of the most recent event in the window
+	// and is meant for gauges like "storage used in GB"
+	AggregationLatest AggregationType = "LATEST"
+	// AggregationWeightedSum integrates a gauge over time. Each reported value holds
+	// until the next event (or the end of the period) and the result is normalised
+	// by the period length, so a storage meter billed monthly yields GB-months
+	AggregationWeightedSum AggregationType = "WEIGHTED_SUM"
 )
 
 func (t AggregationType) Validate() bool {
 	switch t {
-	case AggregationCount, AggregationSum, AggregationAvg, AggregationCountUnique:
+	case AggregationCount, AggregationSum, AggregationAvg, AggregationCountUnique,
+		AggregationMax, AggregationMin, AggregationLatest, AggregationWeightedSum:
 		return true
 	default:
 		return false
@@ -29,3 +39,8 @@ func (t AggregationType) RequiresField() bool {
 		return true
 	}
 }
+
+// RequiresTimeRange returns true if the aggregation can only be computed over a bounded period
+func (t AggregationType) RequiresTimeRange() bool {
+	return t == AggregationWeightedSum
+}
@@ -0,0 +1,201 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// AlertType is what an alert rule measures against its thresholds
+type AlertType string
+
+const (
+	// AlertTypeEntitlementUsage measures the usage of a metered feature as a percent of its
+	// entitlement usage limit
+	AlertTypeEntitlementUsage AlertType = "entitlement_usage"
+	// AlertTypeSubscriptionSpend measures the fixed and usage charges of the current billing period
+	// of a subscription in its currency
+	AlertTypeSubscriptionSpend AlertType = "subscription_spend"
+)
+
+func (t AlertType) String() string {
+	return string(t)
+}
+
+func (t AlertType) Validate() error {
+	allowed := []AlertType{
+		AlertTypeEntitlementUsage,
+		AlertTypeSubscriptionSpend,
+	}
+	if !lo.Contains(allowed, t) {
+		return ierr.NewError("invalid alert type").
+			WithHint("Alert type must be one of entitlement_usage or subscription_spend").
+			WithReportableDetails(map[string]any{
+				"allowed_values": allowed,
+				"provided_value": t,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// AlertRuleFilter defines the filter parameters for listing alert rules
+type AlertRuleFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	AlertRuleIDs   []string  `form:"alert_rule_ids" json:"alert_rule_ids,omitempty"`
+	AlertType      AlertType `form:"alert_type" json:"alert_type,omitempty"`
+	FeatureID      string    `form:"feature_id" json:"feature_id,omitempty"`
+	CustomerID     string    `form:"customer_id" json:"customer_id,omitempty"`
+	SubscriptionID string    `form:"subscription_id" json:"subscription_id,omitempty"`
+}
+
+func NewDefaultAlertRuleFilter() *AlertRuleFilter {
+	return &AlertRuleFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+func NewNoLimitAlertRuleFilter() *AlertRuleFilter {
+	return &AlertRuleFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+func (f *AlertRuleFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+
+	if f.QueryFilter == nil {
+		f.QueryFilter = NewDefaultQueryFilter()
+	}
+
+	if err := f.QueryFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.AlertType != "" {
+		if err := f.AlertType.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *AlertRuleFilter) GetLimit() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+func (f *AlertRuleFilter) GetOffset() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+func (f *AlertRuleFilter) GetSort() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+func (f *AlertRuleFilter) GetStatus() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+func (f *AlertRuleFilter) GetOrder() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+func (f *AlertRuleFilter) GetExpand() Expand {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *AlertRuleFilter) IsUnlimited() bool {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// AlertEventFilter defines the filter parameters for listing the thresholds crossed by alert rules
+type AlertEventFilter struct {
+	*QueryFilter
+
+	AlertRuleID    string `form:"alert_rule_id" json:"alert_rule_id,omitempty"`
+	CustomerID     string `form:"customer_id" json:"customer_id,omitempty"`
+	SubscriptionID string `form:"subscription_id" json:"subscription_id,omitempty"`
+}
+
+func NewDefaultAlertEventFilter() *AlertEventFilter {
+	return &AlertEventFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+func NewNoLimitAlertEventFilter() *AlertEventFilter {
+	return &AlertEventFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+func (f *AlertEventFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+
+	if f.QueryFilter == nil {
+		f.QueryFilter = NewDefaultQueryFilter()
+	}
+
+	return f.QueryFilter.Validate()
+}
+
+func (f *AlertEventFilter) GetLimit() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+func (f *AlertEventFilter) GetOffset() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+func (f *AlertEventFilter) GetStatus() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+func (f *AlertEventFilter) IsUnlimited() bool {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// CommitmentInterval is the window over which the usage charges of a subscription are reconciled
+// against its minimum commitment
+type CommitmentInterval string
+
+const (
+	// CommitmentIntervalBillingPeriod reconciles the commitment at the end of every billing period
+	CommitmentIntervalBillingPeriod CommitmentInterval = "billing_period"
+
+	// CommitmentIntervalAnnual reconciles the commitment at the end of every year of the
+	// subscription, counted from its start date
+	CommitmentIntervalAnnual CommitmentInterval = "annual"
+)
+
+// Validate validates the commitment interval
+func (i CommitmentInterval) Validate() error {
+	allowed := []CommitmentInterval{
+		CommitmentIntervalBillingPeriod,
+		CommitmentIntervalAnnual,
+	}
+
+	if !lo.Contains(allowed, i) {
+		return ierr.NewError("invalid commitment_interval").
+			WithHint("Invalid commitment interval").
+			WithReportableDetails(map[string]any{
+				"type":          i,
+				"allowed_types": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
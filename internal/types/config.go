@@ -23,3 +23,10 @@ const (
 	LogLevelDebug LogLevel = "debug"
 	LogLevelInfo  LogLevel = "info"
 )
+
+type StorageProvider string
+
+const (
+	// StorageProviderLocal stores files on the local filesystem
+	StorageProviderLocal StorageProvider = "local"
+)
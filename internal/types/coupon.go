@@ -0,0 +1,246 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// CouponDiscountType is the way a coupon reduces the invoice amount
+type CouponDiscountType string
+
+const (
+	// CouponDiscountTypePercentage reduces the invoice subtotal by a percentage
+	CouponDiscountTypePercentage CouponDiscountType = "percentage"
+	// CouponDiscountTypeFixedAmount reduces the invoice subtotal by a fixed amount in the coupon currency
+	CouponDiscountTypeFixedAmount CouponDiscountType = "fixed_amount"
+)
+
+func (t CouponDiscountType) String() string {
+	return string(t)
+}
+
+func (t CouponDiscountType) Validate() error {
+	allowed := []CouponDiscountType{
+		CouponDiscountTypePercentage,
+		CouponDiscountTypeFixedAmount,
+	}
+	if !lo.Contains(allowed, t) {
+		return ierr.NewError("invalid coupon discount type").
+			WithHint("Discount type must be one of percentage or fixed_amount").
+			WithReportableDetails(map[string]any{
+				"allowed_values": allowed,
+				"provided_value": t,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// CouponDuration defines for how many billing periods an applied coupon keeps discounting invoices
+type CouponDuration string
+
+const (
+	// CouponDurationOnce discounts only the first invoice after the coupon is applied
+	CouponDurationOnce CouponDuration = "once"
+	// CouponDurationRepeating discounts the invoices of a fixed number of billing periods
+	CouponDurationRepeating CouponDuration = "repeating"
+	// CouponDurationForever discounts every invoice until the coupon application is removed
+	CouponDurationForever CouponDuration = "forever"
+)
+
+func (d CouponDuration) String() string {
+	return string(d)
+}
+
+func (d CouponDuration) Validate() error {
+	allowed := []CouponDuration{
+		CouponDurationOnce,
+		CouponDurationRepeating,
+		CouponDurationForever,
+	}
+	if !lo.Contains(allowed, d) {
+		return ierr.NewError("invalid coupon duration").
+			WithHint("Duration must be one of once, repeating or forever").
+			WithReportableDetails(map[string]any{
+				"allowed_values": allowed,
+				"provided_value": d,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// CouponFilter defines the filter parameters for listing coupons
+type CouponFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	CouponIDs    []string           `form:"coupon_ids" json:"coupon_ids,omitempty"`
+	Code         string             `form:"code" json:"code,omitempty"`
+	DiscountType CouponDiscountType `form:"discount_type" json:"discount_type,omitempty"`
+}
+
+func NewDefaultCouponFilter() *CouponFilter {
+	return &CouponFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+func NewNoLimitCouponFilter() *CouponFilter {
+	return &CouponFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+func (f *CouponFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+
+	if f.QueryFilter == nil {
+		f.QueryFilter = NewDefaultQueryFilter()
+	}
+
+	if err := f.QueryFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.DiscountType != "" {
+		if err := f.DiscountType.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *CouponFilter) GetLimit() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+func (f *CouponFilter) GetOffset() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+func (f *CouponFilter) GetSort() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+func (f *CouponFilter) GetStatus() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+func (f *CouponFilter) GetOrder() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+func (f *CouponFilter) GetExpand() Expand {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *CouponFilter) IsUnlimited() bool {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// CouponApplicationFilter defines the filter parameters for listing coupon applications
+type CouponApplicationFilter struct {
+	*QueryFilter
+
+	CouponID       string `form:"coupon_id" json:"coupon_id,omitempty"`
+	CustomerID     string `form:"customer_id" json:"customer_id,omitempty"`
+	SubscriptionID string `form:"subscription_id" json:"subscription_id,omitempty"`
+}
+
+func NewNoLimitCouponApplicationFilter() *CouponApplicationFilter {
+	return &CouponApplicationFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+func (f *CouponApplicationFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+
+	if f.QueryFilter == nil {
+		f.QueryFilter = NewDefaultQueryFilter()
+	}
+
+	return f.QueryFilter.Validate()
+}
+
+func (f *CouponApplicationFilter) GetLimit() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+func (f *CouponApplicationFilter) GetOffset() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+func (f *CouponApplicationFilter) GetSort() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+func (f *CouponApplicationFilter) GetStatus() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+func (f *CouponApplicationFilter) GetOrder() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+func (f *CouponApplicationFilter) GetExpand() Expand {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *CouponApplicationFilter) IsUnlimited() bool {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
@@ -0,0 +1,179 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// CreditNoteReason is the reason a credit note was issued
+type CreditNoteReason string
+
+const (
+	CreditNoteReasonDuplicate             CreditNoteReason = "DUPLICATE"
+	CreditNoteReasonFraudulent            CreditNoteReason = "FRAUDULENT"
+	CreditNoteReasonOrderChange           CreditNoteReason = "ORDER_CHANGE"
+	CreditNoteReasonProductUnsatisfactory CreditNoteReason = "PRODUCT_UNSATISFACTORY"
+	CreditNoteReasonBillingError          CreditNoteReason = "BILLING_ERROR"
+	CreditNoteReasonOther                 CreditNoteReason = "OTHER"
+)
+
+func (r CreditNoteReason) String() string {
+	return string(r)
+}
+
+func (r CreditNoteReason) Validate() error {
+	allowed := []CreditNoteReason{
+		CreditNoteReasonDuplicate,
+		CreditNoteReasonFraudulent,
+		CreditNoteReasonOrderChange,
+		CreditNoteReasonProductUnsatisfactory,
+		CreditNoteReasonBillingError,
+		CreditNoteReasonOther,
+	}
+	if !lo.Contains(allowed, r) {
+		return ierr.NewError("invalid credit note reason").
+			WithHint("Please provide a valid credit note reason").
+			WithReportableDetails(map[string]any{
+				"allowed_values": allowed,
+				"provided_value": r,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// CreditNoteOutcome is how the credited amount is given back to the customer
+type CreditNoteOutcome string
+
+const (
+	// CreditNoteOutcomeRefund refunds the credited amount to a succeeded payment of the invoice, up to
+	// the paid amount not yet refunded or credited
+	CreditNoteOutcomeRefund CreditNoteOutcome = "REFUND"
+	// CreditNoteOutcomeWalletCredit credits the credited amount to a wallet of the customer, up to the
+	// paid amount not yet refunded or credited
+	CreditNoteOutcomeWalletCredit CreditNoteOutcome = "WALLET_CREDIT"
+	// CreditNoteOutcomeReduceAmountRemaining reduces the amount the customer still owes on the invoice
+	CreditNoteOutcomeReduceAmountRemaining CreditNoteOutcome = "REDUCE_AMOUNT_REMAINING"
+)
+
+func (o CreditNoteOutcome) String() string {
+	return string(o)
+}
+
+func (o CreditNoteOutcome) Validate() error {
+	allowed := []CreditNoteOutcome{
+		CreditNoteOutcomeRefund,
+		CreditNoteOutcomeWalletCredit,
+		CreditNoteOutcomeReduceAmountRemaining,
+	}
+	if !lo.Contains(allowed, o) {
+		return ierr.NewError("invalid credit note outcome").
+			WithHint("Outcome must be one of REFUND, WALLET_CREDIT or REDUCE_AMOUNT_REMAINING").
+			WithReportableDetails(map[string]any{
+				"allowed_values": allowed,
+				"provided_value": o,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// CreditNoteFilter defines the filter parameters for listing credit notes
+type CreditNoteFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	CreditNoteIDs []string          `form:"credit_note_ids" json:"credit_note_ids,omitempty"`
+	InvoiceID     string            `form:"invoice_id" json:"invoice_id,omitempty"`
+	CustomerID    string            `form:"customer_id" json:"customer_id,omitempty"`
+	Outcome       CreditNoteOutcome `form:"outcome" json:"outcome,omitempty"`
+}
+
+func NewDefaultCreditNoteFilter() *CreditNoteFilter {
+	return &CreditNoteFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+func NewNoLimitCreditNoteFilter() *CreditNoteFilter {
+	return &CreditNoteFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+func (f *CreditNoteFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+
+	if f.QueryFilter == nil {
+		f.QueryFilter = NewDefaultQueryFilter()
+	}
+
+	if err := f.QueryFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.Outcome != "" {
+		if err := f.Outcome.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *CreditNoteFilter) GetLimit() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+func (f *CreditNoteFilter) GetOffset() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+func (f *CreditNoteFilter) GetSort() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+func (f *CreditNoteFilter) GetStatus() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+func (f *CreditNoteFilter) GetOrder() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+func (f *CreditNoteFilter) GetExpand() Expand {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *CreditNoteFilter) IsUnlimited() bool {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// DunningFinalAction is what happens to the subscription of an invoice once all payment retries failed
+type DunningFinalAction string
+
+const (
+	// DunningFinalActionCancel cancels the subscription
+	DunningFinalActionCancel DunningFinalAction = "cancel"
+	// DunningFinalActionPause pauses the subscription until it is resumed
+	DunningFinalActionPause DunningFinalAction = "pause"
+	// DunningFinalActionMarkUnpaid keeps the subscription billing but marks it as unpaid
+	DunningFinalActionMarkUnpaid DunningFinalAction = "mark_unpaid"
+)
+
+func (a DunningFinalAction) String() string {
+	return string(a)
+}
+
+func (a DunningFinalAction) Validate() error {
+	allowed := []DunningFinalAction{
+		DunningFinalActionCancel,
+		DunningFinalActionPause,
+		DunningFinalActionMarkUnpaid,
+	}
+	if !lo.Contains(allowed, a) {
+		return ierr.NewError("invalid dunning final action").
+			WithHint("Final action must be one of cancel, pause or mark_unpaid").
+			WithReportableDetails(map[string]any{
+				"allowed_values": allowed,
+				"provided_value": a,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// DunningAttemptStatus is the outcome of a payment retry of an unpaid invoice
+type DunningAttemptStatus string
+
+const (
+	DunningAttemptStatusSucceeded DunningAttemptStatus = "succeeded"
+	DunningAttemptStatusFailed    DunningAttemptStatus = "failed"
+)
+
+func (s DunningAttemptStatus) String() string {
+	return string(s)
+}
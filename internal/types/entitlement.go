@@ -137,3 +137,18 @@ func (f *EntitlementFilter) IsUnlimited() bool {
 	}
 	return f.QueryFilter.IsUnlimited()
 }
+
+// EntitlementCheckReason explains why an entitlement check was denied or allowed over the limit
+type EntitlementCheckReason string
+
+const (
+	// EntitlementCheckReasonNoEntitlement is returned when no active subscription of the customer
+	// grants the feature
+	EntitlementCheckReasonNoEntitlement EntitlementCheckReason = "no_entitlement"
+	// EntitlementCheckReasonLimitExceeded is returned when the requested quantity exceeds the hard
+	// usage limit
+	EntitlementCheckReasonLimitExceeded EntitlementCheckReason = "limit_exceeded"
+	// EntitlementCheckReasonSoftLimitExceeded is returned when the requested quantity exceeds a soft
+	// usage limit, the usage is still allowed
+	EntitlementCheckReasonSoftLimitExceeded EntitlementCheckReason = "soft_limit_exceeded"
+)
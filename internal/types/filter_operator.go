@@ -0,0 +1,183 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// FilterOperator defines how the values of a meter filter are matched against an event property
+type FilterOperator string
+
+const (
+	// FilterOperatorIn matches when the property equals one of the values (default)
+	FilterOperatorIn FilterOperator = "IN"
+	// FilterOperatorNotIn matches when the property equals none of the values
+	FilterOperatorNotIn FilterOperator = "NOT_IN"
+	// FilterOperatorPrefix matches when the property starts with one of the values
+	FilterOperatorPrefix FilterOperator = "PREFIX"
+	// FilterOperatorGreaterThan matches numeric properties strictly greater than the single value
+	FilterOperatorGreaterThan FilterOperator = "GT"
+	// FilterOperatorGreaterThanOrEqual matches numeric properties greater than or equal to the single value
+	FilterOperatorGreaterThanOrEqual FilterOperator = "GTE"
+	// FilterOperatorLessThan matches numeric properties strictly less than the single value
+	FilterOperatorLessThan FilterOperator = "LT"
+	// FilterOperatorLessThanOrEqual matches numeric properties less than or equal to the single value
+	FilterOperatorLessThanOrEqual FilterOperator = "LTE"
+	// FilterOperatorBetween matches numeric properties in [values[0], values[1])
+	FilterOperatorBetween FilterOperator = "BETWEEN"
+)
+
+// PropertyPathSeparator separates the segments of a nested property key ex "request.model.name"
+const PropertyPathSeparator = "."
+
+// Validate ensures the FilterOperator value is valid
+func (o FilterOperator) Validate() error {
+	if o == "" {
+		return nil
+	}
+
+	allowedValues := []FilterOperator{
+		FilterOperatorIn,
+		FilterOperatorNotIn,
+		FilterOperatorPrefix,
+		FilterOperatorGreaterThan,
+		FilterOperatorGreaterThanOrEqual,
+		FilterOperatorLessThan,
+		FilterOperatorLessThanOrEqual,
+		FilterOperatorBetween,
+	}
+
+	if !lo.Contains(allowedValues, o) {
+		return ierr.NewError("invalid filter operator").
+			WithHint("Invalid filter operator").
+			WithReportableDetails(map[string]any{
+				"allowed_values": allowedValues,
+				"provided_value": o,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// OrDefault returns FilterOperatorIn when the operator is not set
+func (o FilterOperator) OrDefault() FilterOperator {
+	if o == "" {
+		return FilterOperatorIn
+	}
+	return o
+}
+
+// IsNumeric returns true if the operator compares the property as a number
+func (o FilterOperator) IsNumeric() bool {
+	switch o {
+	case FilterOperatorGreaterThan, FilterOperatorGreaterThanOrEqual,
+		FilterOperatorLessThan, FilterOperatorLessThanOrEqual, FilterOperatorBetween:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateValues checks that the values are usable with the operator
+func (o FilterOperator) ValidateValues(values []string) error {
+	op := o.OrDefault()
+	if !op.IsNumeric() {
+		return nil
+	}
+
+	expected := 1
+	if op == FilterOperatorBetween {
+		expected = 2
+	}
+
+	if len(values) != expected {
+		return ierr.NewError("invalid number of filter values for operator").
+			WithHintf("Operator %s expects exactly %d value(s)", op, expected).
+			WithReportableDetails(map[string]any{
+				"operator": op,
+				"values":   values,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, v := range values {
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return ierr.NewError("filter value must be numeric").
+				WithHintf("Operator %s expects numeric values", op).
+				WithReportableDetails(map[string]any{
+					"operator": op,
+					"value":    v,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+// Match evaluates the operator against the string form of a property value
+func (o FilterOperator) Match(actual string, values []string) bool {
+	switch o.OrDefault() {
+	case FilterOperatorIn:
+		return lo.Contains(values, actual)
+	case FilterOperatorNotIn:
+		return !lo.Contains(values, actual)
+	case FilterOperatorPrefix:
+		return lo.SomeBy(values, func(v string) bool { return strings.HasPrefix(actual, v) })
+	}
+
+	number, err := strconv.ParseFloat(actual, 64)
+	if err != nil || len(values) == 0 {
+		return false
+	}
+	bounds := make([]float64, len(values))
+	for i, v := range values {
+		if bounds[i], err = strconv.ParseFloat(v, 64); err != nil {
+			return false
+		}
+	}
+
+	switch o {
+	case FilterOperatorGreaterThan:
+		return number > bounds[0]
+	case FilterOperatorGreaterThanOrEqual:
+		return number >= bounds[0]
+	case FilterOperatorLessThan:
+		return number < bounds[0]
+	case FilterOperatorLessThanOrEqual:
+		return number <= bounds[0]
+	case FilterOperatorBetween:
+		return len(bounds) == 2 && number >= bounds[0] && number < bounds[1]
+	default:
+		return false
+	}
+}
+
+// SplitPropertyPath splits a nested property key into its path segments
+func SplitPropertyPath(key string) []string {
+	return strings.Split(key, PropertyPathSeparator)
+}
+
+// LookupProperty resolves a possibly nested property key in the event properties
+func LookupProperty(properties map[string]interface{}, key string) (interface{}, bool) {
+	// exact first level keys win so existing keys containing dots keep working
+	if value, ok := properties[key]; ok {
+		return value, true
+	}
+
+	var current interface{} = properties
+	for _, segment := range SplitPropertyPath(key) {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[segment]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterOperator_Match(t *testing.T) {
+	tests := []struct {
+		name     string
+		operator FilterOperator
+		actual   string
+		values   []string
+		want     bool
+	}{
+		{name: "default is in", operator: "", actual: "gpt-4o", values: []string{"gpt-4o", "o1"}, want: true},
+		{name: "in miss", operator: FilterOperatorIn, actual: "gpt-3", values: []string{"gpt-4o"}, want: false},
+		{name: "not in", operator: FilterOperatorNotIn, actual: "gpt-3", values: []string{"gpt-4o"}, want: true},
+		{name: "not in miss", operator: FilterOperatorNotIn, actual: "gpt-4o", values: []string{"gpt-4o"}, want: false},
+		{name: "prefix", operator: FilterOperatorPrefix, actual: "gpt-4o-mini", values: []string{"o1", "gpt-4"}, want: true},
+		{name: "prefix miss", operator: FilterOperatorPrefix, actual: "claude", values: []string{"gpt"}, want: false},
+		{name: "gt", operator: FilterOperatorGreaterThan, actual: "1001", values: []string{"1000"}, want: true},
+		{name: "gt equal", operator: FilterOperatorGreaterThan, actual: "1000", values: []string{"1000"}, want: false},
+		{name: "gte equal", operator: FilterOperatorGreaterThanOrEqual, actual: "1000", values: []string{"1000"}, want: true},
+		{name: "lt", operator: FilterOperatorLessThan, actual: "10.5", values: []string{"11"}, want: true},
+		{name: "lte", operator: FilterOperatorLessThanOrEqual, actual: "11", values: []string{"11"}, want: true},
+		{name: "between lower bound inclusive", operator: FilterOperatorBetween, actual: "100", values: []string{"100", "200"}, want: true},
+		{name: "between upper bound exclusive", operator: FilterOperatorBetween, actual: "200", values: []string{"100", "200"}, want: false},
+		{name: "numeric on non numeric value", operator: FilterOperatorGreaterThan, actual: "abc", values: []string{"1"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.operator.Match(tt.actual, tt.values))
+		})
+	}
+}
+
+func TestFilterOperator_ValidateValues(t *testing.T) {
+	assert.NoError(t, FilterOperatorIn.ValidateValues([]string{"a", "b"}))
+	assert.NoError(t, FilterOperatorGreaterThan.ValidateValues([]string{"10"}))
+	assert.NoError(t, FilterOperatorBetween.ValidateValues([]string{"10", "20"}))
+	assert.Error(t, FilterOperatorGreaterThan.ValidateValues([]string{"10", "20"}))
+	assert.Error(t, FilterOperatorBetween.ValidateValues([]string{"10"}))
+	assert.Error(t, FilterOperatorLessThan.ValidateValues([]string{"ten"}))
+	assert.Error(t, FilterOperator("LIKE").Validate())
+}
+
+func TestLookupProperty(t *testing.T) {
+	properties := map[string]interface{}{
+		"request": map[string]interface{}{
+			"model": map[string]interface{}{
+				"name": "gpt-4o",
+			},
+		},
+		"legacy.key": "flat",
+	}
+
+	value, ok := LookupProperty(properties, "request.model.name")
+	assert.True(t, ok)
+	assert.Equal(t, "gpt-4o", value)
+
+	value, ok = LookupProperty(properties, "legacy.key")
+	assert.True(t, ok)
+	assert.Equal(t, "flat", value)
+
+	_, ok = LookupProperty(properties, "request.model.version")
+	assert.False(t, ok)
+
+	_, ok = LookupProperty(properties, "request.model.name.first")
+	assert.False(t, ok)
+}
@@ -0,0 +1,15 @@
+package types
+
+// OutboxEventStatus is the delivery status of an outbox event
+type OutboxEventStatus string
+
+const (
+	// OutboxEventStatusPending is an event not relayed to the pubsub yet
+	OutboxEventStatusPending OutboxEventStatus = "pending"
+	// OutboxEventStatusDelivered is an event published to the pubsub
+	OutboxEventStatusDelivered OutboxEventStatus = "delivered"
+)
+
+func (s OutboxEventStatus) String() string {
+	return string(s)
+}
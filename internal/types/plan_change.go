@@ -0,0 +1,71 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// ProrationBehavior determines how fixed advance charges are adjusted when a subscription changes plan mid-cycle
+type ProrationBehavior string
+
+const (
+	// ProrationBehaviorCreateProrations credits the unused time of the previous plan and charges
+	// the remaining time of the new plan
+	ProrationBehaviorCreateProrations ProrationBehavior = "create_prorations"
+
+	// ProrationBehaviorNone keeps the charges of the previous plan for the current period and
+	// starts charging the new plan from the next period
+	ProrationBehaviorNone ProrationBehavior = "none"
+)
+
+// Validate validates the proration behavior
+func (b ProrationBehavior) Validate() error {
+	allowed := []ProrationBehavior{
+		ProrationBehaviorCreateProrations,
+		ProrationBehaviorNone,
+	}
+
+	if !lo.Contains(allowed, b) {
+		return ierr.NewError("invalid proration_behavior").
+			WithHint("Invalid proration behavior").
+			WithReportableDetails(map[string]any{
+				"type":          b,
+				"allowed_types": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// PlanChangeInvoiceTiming determines when the charges of a plan change are invoiced
+type PlanChangeInvoiceTiming string
+
+const (
+	// PlanChangeInvoiceTimingImmediate finalizes the plan change invoice right away
+	PlanChangeInvoiceTimingImmediate PlanChangeInvoiceTiming = "immediate"
+
+	// PlanChangeInvoiceTimingPeriodEnd keeps the plan change invoice in draft until the end
+	// of the current billing period
+	PlanChangeInvoiceTimingPeriodEnd PlanChangeInvoiceTiming = "period_end"
+)
+
+// Validate validates the plan change invoice timing
+func (t PlanChangeInvoiceTiming) Validate() error {
+	allowed := []PlanChangeInvoiceTiming{
+		PlanChangeInvoiceTimingImmediate,
+		PlanChangeInvoiceTimingPeriodEnd,
+	}
+
+	if !lo.Contains(allowed, t) {
+		return ierr.NewError("invalid invoice_timing").
+			WithHint("Invalid invoice timing").
+			WithReportableDetails(map[string]any{
+				"type":          t,
+				"allowed_types": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
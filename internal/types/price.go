@@ -165,6 +165,10 @@ type PriceFilter struct {
 	*TimeRangeFilter
 	PlanIDs  []string `json:"plan_ids,omitempty" form:"plan_ids"`
 	PriceIDs []string `json:"price_ids,omitempty" form:"price_ids"`
+
+	// SubscriptionIDs lists the prices overridden for the subscriptions, the prices scoped to a
+	// subscription are otherwise only listed by ID
+	SubscriptionIDs []string `json:"subscription_ids,omitempty" form:"subscription_ids"`
 }
 
 // NewPriceFilter creates a new PriceFilter with default values
@@ -225,6 +229,12 @@ func (f *PriceFilter) WithPriceIDs(priceIDs []string) *PriceFilter {
 	return f
 }
 
+// WithSubscriptionIDs adds subscription IDs to the filter
+func (f *PriceFilter) WithSubscriptionIDs(subscriptionIDs []string) *PriceFilter {
+	f.SubscriptionIDs = subscriptionIDs
+	return f
+}
+
 // WithStatus sets the status on the filter
 func (f *PriceFilter) WithStatus(status Status) *PriceFilter {
 	f.Status = &status
@@ -115,6 +115,12 @@ type SubscriptionFilter struct {
 	IncludeCanceled bool `json:"include_canceled,omitempty" form:"include_canceled"`
 	// ActiveAt filters subscriptions that are active at the given time
 	ActiveAt *time.Time `json:"active_at,omitempty" form:"active_at"`
+	// TrialEndBefore filters subscriptions whose trial ends at or before the given time
+	TrialEndBefore *time.Time `json:"trial_end_before,omitempty" form:"trial_end_before"`
+	// TrialEndAfter filters subscriptions whose trial ends after the given time
+	TrialEndAfter *time.Time `json:"trial_end_after,omitempty" form:"trial_end_after"`
+	// TrialWillEndNotified filters subscriptions by whether the trial will end webhook was sent
+	TrialWillEndNotified *bool `json:"trial_will_end_notified,omitempty" form:"trial_will_end_notified"`
 
 	// WithLineItems includes line items in the response
 	WithLineItems bool `json:"with_line_items,omitempty" form:"with_line_items"`
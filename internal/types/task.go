@@ -37,6 +37,7 @@ const (
 	EntityTypeEvents    EntityType = "EVENTS"
 	EntityTypePrices    EntityType = "PRICES"
 	EntityTypeCustomers EntityType = "CUSTOMERS"
+	EntityTypeInvoices  EntityType = "INVOICES"
 )
 
 func (e EntityType) String() string {
@@ -48,6 +49,7 @@ func (e EntityType) Validate() error {
 		EntityTypeEvents,
 		EntityTypePrices,
 		EntityTypeCustomers,
+		EntityTypeInvoices,
 	}
 	if !lo.Contains(allowed, e) {
 		return ierr.NewError("invalid entity type").
@@ -60,8 +62,9 @@ func (e EntityType) Validate() error {
 type FileType string
 
 const (
-	FileTypeCSV  FileType = "CSV"
-	FileTypeJSON FileType = "JSON"
+	FileTypeCSV   FileType = "CSV"
+	FileTypeJSON  FileType = "JSON"
+	FileTypeJSONL FileType = "JSONL"
 )
 
 func (f FileType) String() string {
@@ -72,6 +75,7 @@ func (f FileType) Validate() error {
 	allowed := []FileType{
 		FileTypeCSV,
 		FileTypeJSON,
+		FileTypeJSONL,
 	}
 	if !lo.Contains(allowed, f) {
 		return ierr.NewError("invalid file type").
@@ -109,6 +113,31 @@ func (s TaskStatus) Validate() error {
 	return nil
 }
 
+// TaskExportFilter narrows down the records written by an export task. The time range applies
+// to the event timestamp for events, the billing period for invoices and the creation time for
+// customers.
+type TaskExportFilter struct {
+	StartTime          *time.Time `json:"start_time,omitempty"`
+	EndTime            *time.Time `json:"end_time,omitempty"`
+	EventName          string     `json:"event_name,omitempty"`
+	ExternalCustomerID string     `json:"external_customer_id,omitempty"`
+	CustomerID         string     `json:"customer_id,omitempty"`
+}
+
+// Validate validates the export filter
+func (f *TaskExportFilter) Validate() error {
+	if f.StartTime != nil && f.EndTime != nil && f.EndTime.Before(*f.StartTime) {
+		return ierr.NewError("end_time must be after start_time").
+			WithHint("End time must be after start time").
+			WithReportableDetails(map[string]interface{}{
+				"start_time": f.StartTime,
+				"end_time":   f.EndTime,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
 // TaskFilter defines the filter parameters for listing tasks
 type TaskFilter struct {
 	*QueryFilter
@@ -0,0 +1,131 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// TaxBehavior defines whether the invoice amounts already include the tax
+type TaxBehavior string
+
+const (
+	// TaxBehaviorExclusive adds the tax on top of the invoice amount
+	TaxBehaviorExclusive TaxBehavior = "exclusive"
+	// TaxBehaviorInclusive treats the invoice amount as already including the tax
+	TaxBehaviorInclusive TaxBehavior = "inclusive"
+)
+
+func (b TaxBehavior) String() string {
+	return string(b)
+}
+
+func (b TaxBehavior) Validate() error {
+	allowed := []TaxBehavior{
+		TaxBehaviorExclusive,
+		TaxBehaviorInclusive,
+	}
+	if !lo.Contains(allowed, b) {
+		return ierr.NewError("invalid tax behavior").
+			WithHint("Tax behavior must be one of exclusive or inclusive").
+			WithReportableDetails(map[string]any{
+				"allowed_values": allowed,
+				"provided_value": b,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// TaxRateFilter defines the filter parameters for listing tax rates
+type TaxRateFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	TaxRateIDs []string `form:"tax_rate_ids" json:"tax_rate_ids,omitempty"`
+	// Country is the ISO 3166-1 alpha-2 code of the jurisdiction
+	Country string `form:"country" json:"country,omitempty"`
+	State   string `form:"state" json:"state,omitempty"`
+}
+
+func NewDefaultTaxRateFilter() *TaxRateFilter {
+	return &TaxRateFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+func NewNoLimitTaxRateFilter() *TaxRateFilter {
+	return &TaxRateFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+func (f *TaxRateFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+
+	if f.QueryFilter == nil {
+		f.QueryFilter = NewDefaultQueryFilter()
+	}
+
+	if err := f.QueryFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *TaxRateFilter) GetLimit() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+func (f *TaxRateFilter) GetOffset() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+func (f *TaxRateFilter) GetSort() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+func (f *TaxRateFilter) GetStatus() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+func (f *TaxRateFilter) GetOrder() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+func (f *TaxRateFilter) GetExpand() Expand {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *TaxRateFilter) IsUnlimited() bool {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
@@ -32,6 +32,7 @@ const (
 	UUID_PREFIX_SUBSCRIPTION           = "subs"
 	UUID_PREFIX_SUBSCRIPTION_LINE_ITEM = "subs_line"
 	UUID_PREFIX_SUBSCRIPTION_PAUSE     = "pause"
+	UUID_PREFIX_QUANTITY_CHANGE        = "subs_qty"
 	UUID_PREFIX_CUSTOMER               = "cust"
 	UUID_PREFIX_WALLET                 = "wallet"
 	UUID_PREFIX_WALLET_TRANSACTION     = "wtxn"
@@ -44,4 +45,15 @@ const (
 	UUID_PREFIX_PAYMENT_ATTEMPT        = "attempt"
 	UUID_PREFIX_TASK                   = "task"
 	UUID_PREFIX_SECRET                 = "secret"
+	UUID_PREFIX_COUPON                 = "coupon"
+	UUID_PREFIX_COUPON_APPLICATION     = "coupon_app"
+	UUID_PREFIX_TAX_RATE               = "taxrate"
+	UUID_PREFIX_CREDIT_NOTE            = "cn"
+	UUID_PREFIX_CREDIT_NOTE_LINE_ITEM  = "cn_line"
+	UUID_PREFIX_DUNNING_ATTEMPT        = "dunning"
+	UUID_PREFIX_ALERT_RULE             = "alert"
+	UUID_PREFIX_ALERT_EVENT            = "alert_event"
+	UUID_PREFIX_WEBHOOK_ENDPOINT       = "we"
+	UUID_PREFIX_WEBHOOK_DELIVERY       = "whd"
+	UUID_PREFIX_OUTBOX_EVENT           = "obx"
 )
@@ -99,6 +99,8 @@ const (
 	WalletTxReferenceTypeExternal WalletTxReferenceType = "EXTERNAL"
 	// WalletTxReferenceTypeRequest is used for auto generated reference IDs
 	WalletTxReferenceTypeRequest WalletTxReferenceType = "REQUEST"
+	// WalletTxReferenceTypeCreditNote is used for flexprice system credit note IDs
+	WalletTxReferenceTypeCreditNote WalletTxReferenceType = "CREDIT_NOTE"
 )
 
 func (t WalletTxReferenceType) Validate() error {
@@ -107,6 +109,7 @@ func (t WalletTxReferenceType) Validate() error {
 		string(WalletTxReferenceTypePayment),
 		string(WalletTxReferenceTypeExternal),
 		string(WalletTxReferenceTypeRequest),
+		string(WalletTxReferenceTypeCreditNote),
 	}
 	if !lo.Contains(allowedValues, string(t)) {
 		return ierr.NewError("invalid wallet transaction reference type").
@@ -156,6 +159,42 @@ func (t AutoTopupTrigger) String() string {
 	return string(t)
 }
 
+// AutoTopupMethod represents how an auto top-up of a wallet is funded
+type AutoTopupMethod string
+
+const (
+	// AutoTopupMethodInvoice credits the wallet against a finalized invoice that is paid later
+	AutoTopupMethodInvoice AutoTopupMethod = "invoice"
+	// AutoTopupMethodPayment charges the configured payment method and credits the wallet once the payment succeeds
+	AutoTopupMethodPayment AutoTopupMethod = "payment"
+)
+
+func (m AutoTopupMethod) Validate() error {
+	allowedValues := []string{
+		string(AutoTopupMethodInvoice),
+		string(AutoTopupMethodPayment),
+	}
+	if m == "" {
+		return nil
+	}
+
+	if !lo.Contains(allowedValues, string(m)) {
+		return ierr.NewError("invalid auto top-up method").
+			WithHint("Invalid auto top-up method").
+			WithReportableDetails(map[string]any{
+				"allowed": allowedValues,
+				"method":  m,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// String returns the string representation of AutoTopupMethod
+func (m AutoTopupMethod) String() string {
+	return string(m)
+}
+
 // WalletTransactionFilter represents the filter options for wallet transactions
 type WalletTransactionFilter struct {
 	*QueryFilter
@@ -3,15 +3,32 @@ package types
 import (
 	"encoding/json"
 	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
 )
 
 // WebhookEvent represents a webhook event to be delivered
 type WebhookEvent struct {
-	ID        string          `json:"id"`
-	EventName string          `json:"event_name"`
-	TenantID  string          `json:"tenant_id"`
-	Timestamp time.Time       `json:"timestamp"`
-	Payload   json.RawMessage `json:"payload"`
+	ID            string          `json:"id"`
+	EventName     string          `json:"event_name"`
+	TenantID      string          `json:"tenant_id"`
+	EnvironmentID string          `json:"environment_id,omitempty"`
+	Timestamp     time.Time       `json:"timestamp"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// WebhookEventPayload references the resources of a webhook event published by the services, the
+// payload builders load them when the event is delivered
+type WebhookEventPayload struct {
+	TenantID       string `json:"tenant_id"`
+	InvoiceID      string `json:"invoice_id,omitempty"`
+	SubscriptionID string `json:"subscription_id,omitempty"`
+	CustomerID     string `json:"customer_id,omitempty"`
+	PaymentID      string `json:"payment_id,omitempty"`
+	AlertEventID   string `json:"alert_event_id,omitempty"`
+	WalletID       string `json:"wallet_id,omitempty"`
+	TransactionID  string `json:"transaction_id,omitempty"`
 }
 
 // Common webhook event names
@@ -21,3 +38,87 @@ const (
 	WebhookEventInvoiceUpdatePayment   = "invoice.updated.payment"
 	WebhookEventInvoiceUpdateVoided    = "invoice.update.voided"
 )
+
+// Subscription webhook event names
+const (
+	WebhookEventSubscriptionCreated      = "subscription.created"
+	WebhookEventSubscriptionUpdated      = "subscription.updated"
+	WebhookEventSubscriptionCancelled    = "subscription.cancelled"
+	WebhookEventSubscriptionPaused       = "subscription.paused"
+	WebhookEventSubscriptionResumed      = "subscription.resumed"
+	WebhookEventSubscriptionTrialWillEnd = "subscription.trial.will_end"
+	WebhookEventSubscriptionTrialEnded   = "subscription.trial.ended"
+)
+
+// Dunning webhook event names
+const (
+	WebhookEventInvoiceDunningAttemptFailed    = "invoice.dunning.attempt_failed"
+	WebhookEventInvoiceDunningAttemptSucceeded = "invoice.dunning.attempt_succeeded"
+	WebhookEventSubscriptionPastDue            = "subscription.dunning.past_due"
+	WebhookEventSubscriptionRecovered          = "subscription.dunning.recovered"
+	WebhookEventSubscriptionDunningExhausted   = "subscription.dunning.exhausted"
+)
+
+// Payment webhook event names
+const (
+	WebhookEventPaymentSucceeded = "payment.succeeded"
+	WebhookEventPaymentFailed    = "payment.failed"
+)
+
+// Wallet webhook event names
+const (
+	WebhookEventWalletTransactionCreated = "wallet.transaction.created"
+	WebhookEventWalletBalanceLow         = "wallet.balance.low"
+)
+
+// Customer webhook event names
+const (
+	WebhookEventCustomerCreated = "customer.created"
+	WebhookEventCustomerUpdated = "customer.updated"
+)
+
+// Alert webhook event names
+const (
+	WebhookEventUsageThresholdCrossed = "usage.threshold.crossed"
+)
+
+// WebhookEventNames are the events webhook endpoints can subscribe to
+var WebhookEventNames = []string{
+	WebhookEventInvoiceCreateDraft,
+	WebhookEventInvoiceUpdateFinalized,
+	WebhookEventInvoiceUpdatePayment,
+	WebhookEventInvoiceUpdateVoided,
+	WebhookEventSubscriptionCreated,
+	WebhookEventSubscriptionUpdated,
+	WebhookEventSubscriptionCancelled,
+	WebhookEventSubscriptionPaused,
+	WebhookEventSubscriptionResumed,
+	WebhookEventSubscriptionTrialWillEnd,
+	WebhookEventSubscriptionTrialEnded,
+	WebhookEventInvoiceDunningAttemptFailed,
+	WebhookEventInvoiceDunningAttemptSucceeded,
+	WebhookEventSubscriptionPastDue,
+	WebhookEventSubscriptionRecovered,
+	WebhookEventSubscriptionDunningExhausted,
+	WebhookEventPaymentSucceeded,
+	WebhookEventPaymentFailed,
+	WebhookEventWalletTransactionCreated,
+	WebhookEventWalletBalanceLow,
+	WebhookEventCustomerCreated,
+	WebhookEventCustomerUpdated,
+	WebhookEventUsageThresholdCrossed,
+}
+
+// ValidateWebhookEventName validates the name of an event a webhook endpoint subscribes to
+func ValidateWebhookEventName(eventName string) error {
+	if !lo.Contains(WebhookEventNames, eventName) {
+		return ierr.NewError("invalid webhook event").
+			WithHint("Invalid webhook event name").
+			WithReportableDetails(map[string]any{
+				"event_name":     eventName,
+				"allowed_events": WebhookEventNames,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
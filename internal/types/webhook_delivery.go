@@ -0,0 +1,142 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// WebhookDeliveryStatus is the outcome of an attempt to deliver a webhook event
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	// WebhookDeliveryStatusDeadLettered is a failed delivery which is no longer retried
+	WebhookDeliveryStatusDeadLettered WebhookDeliveryStatus = "dead_lettered"
+	// WebhookDeliveryStatusRequeued is a dead lettered event that failed before being delivered to
+	// any destination and was published again to be delivered
+	WebhookDeliveryStatusRequeued WebhookDeliveryStatus = "requeued"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+func (s WebhookDeliveryStatus) Validate() error {
+	allowed := []WebhookDeliveryStatus{
+		WebhookDeliveryStatusSucceeded,
+		WebhookDeliveryStatusFailed,
+		WebhookDeliveryStatusDeadLettered,
+		WebhookDeliveryStatusRequeued,
+	}
+	if !lo.Contains(allowed, s) {
+		return ierr.NewError("invalid webhook delivery status").
+			WithHint("Webhook delivery status must be one of succeeded, failed, dead_lettered or requeued").
+			WithReportableDetails(map[string]any{
+				"allowed_values": allowed,
+				"provided_value": s,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// WebhookDeliveryFilter defines the filter parameters for listing webhook deliveries
+type WebhookDeliveryFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	WebhookEndpointID string                  `form:"webhook_endpoint_id" json:"webhook_endpoint_id,omitempty"`
+	EventID           string                  `form:"event_id" json:"event_id,omitempty"`
+	EventName         string                  `form:"event_name" json:"event_name,omitempty"`
+	DeliveryStatuses  []WebhookDeliveryStatus `form:"delivery_statuses" json:"delivery_statuses,omitempty"`
+}
+
+func NewDefaultWebhookDeliveryFilter() *WebhookDeliveryFilter {
+	return &WebhookDeliveryFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+func NewNoLimitWebhookDeliveryFilter() *WebhookDeliveryFilter {
+	return &WebhookDeliveryFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+func (f *WebhookDeliveryFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+
+	if f.QueryFilter == nil {
+		f.QueryFilter = NewDefaultQueryFilter()
+	}
+
+	if err := f.QueryFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, status := range f.DeliveryStatuses {
+		if err := status.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *WebhookDeliveryFilter) GetLimit() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+func (f *WebhookDeliveryFilter) GetOffset() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+func (f *WebhookDeliveryFilter) GetSort() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+func (f *WebhookDeliveryFilter) GetStatus() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+func (f *WebhookDeliveryFilter) GetOrder() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+func (f *WebhookDeliveryFilter) GetExpand() Expand {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *WebhookDeliveryFilter) IsUnlimited() bool {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
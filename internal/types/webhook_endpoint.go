@@ -0,0 +1,93 @@
+package types
+
+// WebhookEndpointFilter defines the filter parameters for listing webhook endpoints
+type WebhookEndpointFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	WebhookEndpointIDs []string `form:"webhook_endpoint_ids" json:"webhook_endpoint_ids,omitempty"`
+	Enabled            *bool    `form:"enabled" json:"enabled,omitempty"`
+}
+
+func NewDefaultWebhookEndpointFilter() *WebhookEndpointFilter {
+	return &WebhookEndpointFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+func NewNoLimitWebhookEndpointFilter() *WebhookEndpointFilter {
+	return &WebhookEndpointFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+func (f *WebhookEndpointFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+
+	if f.QueryFilter == nil {
+		f.QueryFilter = NewDefaultQueryFilter()
+	}
+
+	if err := f.QueryFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *WebhookEndpointFilter) GetLimit() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+func (f *WebhookEndpointFilter) GetOffset() int {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+func (f *WebhookEndpointFilter) GetSort() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+func (f *WebhookEndpointFilter) GetStatus() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+func (f *WebhookEndpointFilter) GetOrder() string {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+func (f *WebhookEndpointFilter) GetExpand() Expand {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *WebhookEndpointFilter) IsUnlimited() bool {
+	if f == nil || f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
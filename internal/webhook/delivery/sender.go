@@ -0,0 +1,212 @@
+// Package delivery sends the webhook events to their destinations and records every attempt.
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
+	"github.com/flexprice/flexprice/internal/httpclient"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/webhook/signature"
+	"github.com/samber/lo"
+)
+
+// maxErrorLength is the number of characters of the failure kept in the delivery log
+const maxErrorLength = 256
+
+// redactedHeaderValue replaces the values of the headers that can carry credentials in the delivery log
+const redactedHeaderValue = "[REDACTED]"
+
+// loggedHeaders are the headers whose values are kept in the delivery log, they carry no credentials
+var loggedHeaders = map[string]bool{
+	http.CanonicalHeaderKey("Content-Type"):   true,
+	http.CanonicalHeaderKey("User-Agent"):     true,
+	http.CanonicalHeaderKey(signature.Header): true,
+}
+
+// Target is a destination a webhook event is delivered to
+type Target struct {
+	// EndpointID is empty for the static tenant configuration
+	EndpointID string
+	URL        string
+	Headers    map[string]string
+	// Secrets are the decrypted signing secrets, the deliveries are not signed when empty
+	Secrets []string
+}
+
+// NewEndpointTarget returns the target of a webhook endpoint with its signing secrets active at now
+func NewEndpointTarget(e *webhookendpoint.WebhookEndpoint, encryptionService security.EncryptionService, now time.Time) (Target, error) {
+	encrypted := e.ActiveSigningSecrets(now)
+	secrets := make([]string, 0, len(encrypted))
+	for _, s := range encrypted {
+		secret, err := encryptionService.Decrypt(s)
+		if err != nil {
+			return Target{}, err
+		}
+		secrets = append(secrets, secret)
+	}
+
+	return Target{
+		EndpointID: e.ID,
+		URL:        e.URL,
+		Headers:    e.Headers,
+		Secrets:    secrets,
+	}, nil
+}
+
+// Key identifies the target the same way as webhookdelivery.WebhookDelivery.TargetKey
+func (t Target) Key() string {
+	if t.EndpointID != "" {
+		return t.EndpointID
+	}
+	return t.URL
+}
+
+// BuildHeaders returns the headers of a delivery of the payload to the target, including its signature
+func (t Target) BuildHeaders(now time.Time, payload []byte) map[string]string {
+	if len(t.Secrets) == 0 {
+		return t.Headers
+	}
+
+	headers := make(map[string]string, len(t.Headers)+1)
+	for k, v := range t.Headers {
+		headers[k] = v
+	}
+	headers[signature.Header] = signature.BuildHeader(now, payload, t.Secrets...)
+	return headers
+}
+
+// Request is the delivery of a webhook event to a target
+type Request struct {
+	Target    Target
+	EventID   string
+	EventName string
+	Payload   []byte
+	// Attempt is the number of the attempt to deliver the event to the target, starting at 1
+	Attempt int
+	// ReplayOf is the delivery replayed by the request
+	ReplayOf *string
+}
+
+// Sender sends the webhook deliveries and records them in the delivery log
+type Sender struct {
+	client httpclient.WebhookClient
+	repo   webhookdelivery.Repository
+	logger *logger.Logger
+}
+
+// NewSender creates a new webhook delivery sender
+func NewSender(client httpclient.WebhookClient, repo webhookdelivery.Repository, logger *logger.Logger) *Sender {
+	return &Sender{
+		client: client,
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Send delivers the payload to the target and records the attempt. The recorded attempt is
+// returned in all cases, along with an error when the delivery failed.
+func (s *Sender) Send(ctx context.Context, req Request) (*webhookdelivery.WebhookDelivery, error) {
+	now := time.Now()
+	headers := req.Target.BuildHeaders(now, req.Payload)
+
+	d := &webhookdelivery.WebhookDelivery{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WEBHOOK_DELIVERY),
+		EventID:        req.EventID,
+		EventName:      req.EventName,
+		URL:            req.Target.URL,
+		RequestHeaders: redactHeaders(headers),
+		RequestBody:    string(req.Payload),
+		Attempt:        lo.Max([]int{req.Attempt, 1}),
+		ReplayOf:       req.ReplayOf,
+		EnvironmentID:  types.GetEnvironmentID(ctx),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+	if req.Target.EndpointID != "" {
+		d.WebhookEndpointID = lo.ToPtr(req.Target.EndpointID)
+	}
+
+	resp, sendErr := s.client.Send(ctx, &httpclient.Request{
+		Method:  "POST",
+		URL:     req.Target.URL,
+		Headers: headers,
+		Body:    req.Payload,
+	})
+	d.LatencyMs = time.Since(now).Milliseconds()
+
+	switch {
+	case sendErr != nil:
+		if httpErr, ok := httpclient.IsHTTPError(sendErr); ok {
+			d.ResponseStatus = lo.ToPtr(httpErr.StatusCode)
+			sendErr = statusCodeError(httpErr.StatusCode)
+		}
+	case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
+		d.ResponseStatus = lo.ToPtr(resp.StatusCode)
+		sendErr = statusCodeError(resp.StatusCode)
+	default:
+		d.ResponseStatus = lo.ToPtr(resp.StatusCode)
+	}
+
+	if sendErr != nil {
+		d.DeliveryStatus = types.WebhookDeliveryStatusFailed
+		d.Error = lo.ToPtr(sanitizeError(sendErr))
+	} else {
+		d.DeliveryStatus = types.WebhookDeliveryStatusSucceeded
+	}
+
+	// The outcome of the delivery does not depend on the delivery log
+	if err := s.repo.Create(ctx, d); err != nil {
+		s.logger.Errorw("failed to record webhook delivery",
+			"error", err,
+			"webhook_delivery_id", d.ID,
+			"event_id", d.EventID,
+		)
+	}
+
+	return d, sendErr
+}
+
+func statusCodeError(statusCode int) error {
+	return fmt.Errorf("webhook endpoint responded with status code %d", statusCode)
+}
+
+// sanitizeError returns a short single line description of the failure of the delivery. The
+// url of the request is left out as it can carry credentials in its query.
+func sanitizeError(err error) string {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+
+	msg := strings.Join(strings.Fields(err.Error()), " ")
+	if len(msg) > maxErrorLength {
+		msg = msg[:maxErrorLength]
+	}
+	return msg
+}
+
+// redactHeaders returns the headers with the values of the ones that can carry credentials redacted
+func redactHeaders(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+
+	redacted := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if loggedHeaders[http.CanonicalHeaderKey(k)] {
+			redacted[k] = v
+			continue
+		}
+		redacted[k] = redactedHeaderValue
+	}
+	return redacted
+}
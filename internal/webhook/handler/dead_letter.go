@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	"github.com/flexprice/flexprice/internal/logger"
+	pubsubRouter "github.com/flexprice/flexprice/internal/pubsub/router"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// maxDeadLetterReasonLength is the number of characters of the failure kept for the events that
+// failed before any delivery
+const maxDeadLetterReasonLength = 256
+
+// deadLetterPublisher records the webhook events which could not be delivered after all the
+// retries in the delivery log, where they can be inspected and replayed
+type deadLetterPublisher struct {
+	deliveryRepo webhookdelivery.Repository
+	logger       *logger.Logger
+}
+
+// NewDeadLetterPublisher creates the dead letter queue of the message router
+func NewDeadLetterPublisher(deliveryRepo webhookdelivery.Repository, logger *logger.Logger) pubsubRouter.DeadLetterPublisher {
+	return &deadLetterPublisher{
+		deliveryRepo: deliveryRepo,
+		logger:       logger,
+	}
+}
+
+func (p *deadLetterPublisher) Publish(_ string, messages ...*message.Message) error {
+	for _, msg := range messages {
+		if msg.Metadata.Get(middleware.PoisonedHandlerKey) != HandlerName {
+			p.logger.Errorw("dropping message failed after all retries",
+				"message_uuid", msg.UUID,
+				"handler", msg.Metadata.Get(middleware.PoisonedHandlerKey),
+				"reason", msg.Metadata.Get(middleware.ReasonForPoisonedKey),
+			)
+			continue
+		}
+
+		var event types.WebhookEvent
+		if err := json.Unmarshal(msg.Payload, &event); err != nil {
+			p.logger.Errorw("failed to unmarshal dead lettered webhook event",
+				"error", err,
+				"message_uuid", msg.UUID,
+			)
+			continue
+		}
+
+		if err := p.deadLetter(&event, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deadLetter marks the last failed delivery of the event to each target which never received it.
+// The event is recorded with its original message when it failed before any delivery, e.g. when
+// its payload could not be built or its targets could not be looked up.
+func (p *deadLetterPublisher) deadLetter(event *types.WebhookEvent, msg *message.Message) error {
+	ctx := context.WithValue(context.Background(), types.CtxTenantID, event.TenantID)
+	ctx = context.WithValue(ctx, types.CtxEnvironmentID, event.EnvironmentID)
+
+	// deliveries are sorted with the most recent first
+	deliveries, err := p.deliveryRepo.List(ctx, &types.WebhookDeliveryFilter{
+		QueryFilter: types.NewNoLimitQueryFilter(),
+		EventID:     event.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	deliveries = lo.Filter(deliveries, func(d *webhookdelivery.WebhookDelivery, _ int) bool {
+		return d.HasTarget()
+	})
+	if len(deliveries) == 0 {
+		return p.recordUndelivered(ctx, event, msg)
+	}
+
+	delivered := make(map[string]bool)
+	for _, d := range deliveries {
+		if d.DeliveryStatus == types.WebhookDeliveryStatusSucceeded {
+			delivered[d.TargetKey()] = true
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, d := range deliveries {
+		key := d.TargetKey()
+		if delivered[key] || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if err := p.deliveryRepo.UpdateDeliveryStatus(ctx, d.ID, types.WebhookDeliveryStatusDeadLettered); err != nil {
+			return err
+		}
+
+		p.logger.Warnw("webhook delivery dead lettered",
+			"webhook_delivery_id", d.ID,
+			"webhook_endpoint_id", d.WebhookEndpointID,
+			"event_id", event.ID,
+			"event", event.EventName,
+			"attempt", d.Attempt,
+		)
+	}
+
+	return nil
+}
+
+// recordUndelivered records the original message of an event that failed before any delivery
+func (p *deadLetterPublisher) recordUndelivered(ctx context.Context, event *types.WebhookEvent, msg *message.Message) error {
+	reason := strings.Join(strings.Fields(msg.Metadata.Get(middleware.ReasonForPoisonedKey)), " ")
+	if len(reason) > maxDeadLetterReasonLength {
+		reason = reason[:maxDeadLetterReasonLength]
+	}
+
+	d := &webhookdelivery.WebhookDelivery{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WEBHOOK_DELIVERY),
+		EventID:        event.ID,
+		EventName:      event.EventName,
+		RequestBody:    string(msg.Payload),
+		Error:          lo.ToPtr(reason),
+		DeliveryStatus: types.WebhookDeliveryStatusDeadLettered,
+		EnvironmentID:  event.EnvironmentID,
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+	if err := p.deliveryRepo.Create(ctx, d); err != nil {
+		return err
+	}
+
+	p.logger.Warnw("webhook event dead lettered before any delivery",
+		"webhook_delivery_id", d.ID,
+		"event_id", event.ID,
+		"event", event.EventName,
+		"reason", reason,
+	)
+	return nil
+}
+
+func (p *deadLetterPublisher) Close() error {
+	return nil
+}
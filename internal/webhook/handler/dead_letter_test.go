@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeadLetterPublisherTestSuite struct {
+	testutil.BaseServiceTestSuite
+	publisher *deadLetterPublisher
+	event     *types.WebhookEvent
+}
+
+func TestDeadLetterPublisher(t *testing.T) {
+	suite.Run(t, new(DeadLetterPublisherTestSuite))
+}
+
+func (s *DeadLetterPublisherTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.BaseServiceTestSuite.ClearStores()
+
+	s.publisher = NewDeadLetterPublisher(s.GetStores().WebhookDeliveryRepo, s.GetLogger()).(*deadLetterPublisher)
+	s.event = &types.WebhookEvent{
+		ID:            "evt_1",
+		EventName:     types.WebhookEventInvoiceUpdateFinalized,
+		TenantID:      types.GetTenantID(s.GetContext()),
+		EnvironmentID: types.GetEnvironmentID(s.GetContext()),
+		Timestamp:     time.Now().UTC(),
+		Payload:       json.RawMessage(`{"invoice_id":"inv_1"}`),
+	}
+}
+
+// poisoned returns the message of the event as the poison queue middleware hands it over
+func (s *DeadLetterPublisherTestSuite) poisoned(reason string) *message.Message {
+	payload, err := json.Marshal(s.event)
+	s.NoError(err)
+
+	msg := message.NewMessage(s.event.ID, payload)
+	msg.Metadata.Set(middleware.PoisonedHandlerKey, HandlerName)
+	msg.Metadata.Set(middleware.ReasonForPoisonedKey, reason)
+	return msg
+}
+
+func (s *DeadLetterPublisherTestSuite) createDelivery(endpointID string, status types.WebhookDeliveryStatus) *webhookdelivery.WebhookDelivery {
+	d := &webhookdelivery.WebhookDelivery{
+		ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WEBHOOK_DELIVERY),
+		EventID:           s.event.ID,
+		EventName:         s.event.EventName,
+		WebhookEndpointID: lo.ToPtr(endpointID),
+		URL:               "https://example.com/" + endpointID,
+		RequestBody:       string(s.event.Payload),
+		Attempt:           1,
+		DeliveryStatus:    status,
+		EnvironmentID:     s.event.EnvironmentID,
+		BaseModel:         types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().WebhookDeliveryRepo.Create(s.GetContext(), d))
+	return d
+}
+
+func (s *DeadLetterPublisherTestSuite) listDeliveries() []*webhookdelivery.WebhookDelivery {
+	deliveries, err := s.GetStores().WebhookDeliveryRepo.List(s.GetContext(), &types.WebhookDeliveryFilter{
+		QueryFilter: types.NewNoLimitQueryFilter(),
+		EventID:     s.event.ID,
+	})
+	s.NoError(err)
+	return deliveries
+}
+
+func (s *DeadLetterPublisherTestSuite) TestDeadLetterFailedDeliveries() {
+	failed := s.createDelivery("we_1", types.WebhookDeliveryStatusFailed)
+	delivered := s.createDelivery("we_2", types.WebhookDeliveryStatusSucceeded)
+
+	s.NoError(s.publisher.Publish("", s.poisoned("endpoint returned 500")))
+
+	stored, err := s.GetStores().WebhookDeliveryRepo.Get(s.GetContext(), failed.ID)
+	s.NoError(err)
+	s.Equal(types.WebhookDeliveryStatusDeadLettered, stored.DeliveryStatus)
+
+	stored, err = s.GetStores().WebhookDeliveryRepo.Get(s.GetContext(), delivered.ID)
+	s.NoError(err)
+	s.Equal(types.WebhookDeliveryStatusSucceeded, stored.DeliveryStatus)
+
+	// No record is added for the event as it reached its destinations
+	s.Len(s.listDeliveries(), 2)
+}
+
+func (s *DeadLetterPublisherTestSuite) TestDeadLetterEventFailedBeforeDelivery() {
+	msg := s.poisoned("failed to build payload:\n invoice not found")
+	s.NoError(s.publisher.Publish("", msg))
+
+	deliveries := s.listDeliveries()
+	s.Require().Len(deliveries, 1)
+
+	d := deliveries[0]
+	s.False(d.HasTarget())
+	s.Equal(types.WebhookDeliveryStatusDeadLettered, d.DeliveryStatus)
+	s.Equal(string(msg.Payload), d.RequestBody)
+	s.Equal("failed to build payload: invoice not found", lo.FromPtr(d.Error))
+	s.Equal(s.event.EventName, d.EventName)
+}
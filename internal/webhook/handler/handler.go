@@ -3,18 +3,28 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/webhookdelivery"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
 	"github.com/flexprice/flexprice/internal/httpclient"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/pubsub"
 	pubsubRouter "github.com/flexprice/flexprice/internal/pubsub/router"
+	"github.com/flexprice/flexprice/internal/security"
 	"github.com/flexprice/flexprice/internal/sentry"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/webhook/delivery"
 	"github.com/flexprice/flexprice/internal/webhook/payload"
+	"github.com/samber/lo"
 )
 
+// HandlerName is the name of the webhook handler in the message router
+const HandlerName = "webhook_handler"
+
 // Handler interface for processing webhook events
 type Handler interface {
 	RegisterHandler(router *pubsubRouter.Router)
@@ -25,9 +35,13 @@ type handler struct {
 	pubSub  pubsub.PubSub
 	config  *config.Webhook
 	factory payload.PayloadBuilderFactory
-	client  httpclient.Client
+	sender  *delivery.Sender
 	logger  *logger.Logger
 	sentry  *sentry.Service
+
+	endpointRepo      webhookendpoint.Repository
+	deliveryRepo      webhookdelivery.Repository
+	encryptionService security.EncryptionService
 }
 
 // NewHandler creates a new memory-based handler
@@ -35,23 +49,34 @@ func NewHandler(
 	pubSub pubsub.PubSub,
 	cfg *config.Configuration,
 	factory payload.PayloadBuilderFactory,
-	client httpclient.Client,
+	client httpclient.WebhookClient,
+	endpointRepo webhookendpoint.Repository,
+	deliveryRepo webhookdelivery.Repository,
 	logger *logger.Logger,
 	sentry *sentry.Service,
 ) (Handler, error) {
+	encryptionService, err := security.NewEncryptionService(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &handler{
 		pubSub:  pubSub,
 		config:  &cfg.Webhook,
 		factory: factory,
-		client:  client,
+		sender:  delivery.NewSender(client, deliveryRepo, logger),
 		logger:  logger,
 		sentry:  sentry,
+
+		endpointRepo:      endpointRepo,
+		deliveryRepo:      deliveryRepo,
+		encryptionService: encryptionService,
 	}, nil
 }
 
 func (h *handler) RegisterHandler(router *pubsubRouter.Router) {
 	router.AddNoPublishHandler(
-		"webhook_handler",
+		HandlerName,
 		h.config.Topic,
 		h.pubSub,
 		h.processMessage,
@@ -77,37 +102,50 @@ func (h *handler) processMessage(msg *message.Message) error {
 		return nil // Don't retry on unmarshal errors
 	}
 
-	// Get tenant config
-	tenantCfg, ok := h.config.Tenants[event.TenantID]
-	if !ok {
-		h.logger.Warnw("tenant config not found",
-			"tenant_id", event.TenantID,
-			"message_uuid", msg.UUID,
-		)
-		// Don't retry if tenant not found
-		return nil
+	// set tenant_id and environment_id in context
+	ctx = context.WithValue(ctx, types.CtxTenantID, event.TenantID)
+	ctx = context.WithValue(ctx, types.CtxEnvironmentID, event.EnvironmentID)
+
+	targets, err := h.getTargets(ctx, &event)
+	if err != nil {
+		return err
+	}
+
+	// The message is retried until all the targets received it, skip those which already did
+	previous, err := h.deliveryRepo.List(ctx, &types.WebhookDeliveryFilter{
+		QueryFilter: types.NewNoLimitQueryFilter(),
+		EventID:     event.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	attempts := make(map[string]int)
+	delivered := make(map[string]bool)
+	for _, d := range previous {
+		if !d.HasTarget() {
+			continue
+		}
+		attempts[d.TargetKey()]++
+		if d.DeliveryStatus == types.WebhookDeliveryStatusSucceeded {
+			delivered[d.TargetKey()] = true
+		}
 	}
 
-	// Check if tenant webhooks are enabled
-	if !tenantCfg.Enabled {
-		h.logger.Debugw("webhooks disabled for tenant",
+	targets = lo.Filter(targets, func(t delivery.Target, _ int) bool {
+		return !delivered[t.Key()]
+	})
+
+	if len(targets) == 0 {
+		h.logger.Debugw("no webhook endpoint to deliver event to",
 			"tenant_id", event.TenantID,
+			"environment_id", event.EnvironmentID,
+			"event", event.EventName,
 			"message_uuid", msg.UUID,
 		)
 		return nil
 	}
 
-	// Check if event is excluded
-	for _, excludedEvent := range tenantCfg.ExcludedEvents {
-		if excludedEvent == event.EventName {
-			h.logger.Debugw("event excluded for tenant",
-				"tenant_id", event.TenantID,
-				"event", event.EventName,
-			)
-			return nil
-		}
-	}
-
 	// Build event payload
 	builder, err := h.factory.GetBuilder(event.EventName)
 	if err != nil {
@@ -119,8 +157,6 @@ func (h *handler) processMessage(msg *message.Message) error {
 		"builder", builder,
 	)
 
-	// set tenant_id in context
-	ctx = context.WithValue(ctx, types.CtxTenantID, event.TenantID)
 	webHookPayload, err := builder.BuildPayload(ctx, event.EventName, event.Payload)
 	if err != nil {
 		return err
@@ -131,31 +167,79 @@ func (h *handler) processMessage(msg *message.Message) error {
 		"payload", string(webHookPayload),
 	)
 
-	// Send webhook
-	req := &httpclient.Request{
-		Method:  "POST",
-		URL:     tenantCfg.Endpoint,
-		Headers: tenantCfg.Headers,
-		Body:    webHookPayload,
-	}
+	// Send the webhook to every target, the message is retried when any delivery fails
+	var errs []error
+	for _, target := range targets {
+		d, err := h.sender.Send(ctx, delivery.Request{
+			Target:    target,
+			EventID:   event.ID,
+			EventName: event.EventName,
+			Payload:   webHookPayload,
+			Attempt:   attempts[target.Key()] + 1,
+		})
+		if err != nil {
+			h.logger.Errorw("failed to send webhook",
+				"error", err,
+				"message_uuid", msg.UUID,
+				"tenant_id", event.TenantID,
+				"webhook_endpoint_id", target.EndpointID,
+				"webhook_delivery_id", d.ID,
+				"event", event.EventName,
+			)
+			errs = append(errs, err)
+			continue
+		}
 
-	resp, err := h.client.Send(ctx, req)
-	if err != nil {
-		h.logger.Errorw("failed to send webhook",
-			"error", err,
+		h.logger.Infow("webhook sent successfully",
 			"message_uuid", msg.UUID,
 			"tenant_id", event.TenantID,
+			"webhook_endpoint_id", target.EndpointID,
+			"webhook_delivery_id", d.ID,
 			"event", event.EventName,
+			"status_code", lo.FromPtr(d.ResponseStatus),
 		)
-		return err
 	}
 
-	h.logger.Infow("webhook sent successfully",
-		"message_uuid", msg.UUID,
-		"tenant_id", event.TenantID,
-		"event", event.EventName,
-		"status_code", resp.StatusCode,
-	)
+	return errors.Join(errs...)
+}
 
-	return nil
+// getTargets returns the destinations of the event. These are the enabled webhook endpoints of the
+// tenant environment subscribed to the event, or the static tenant configuration when the tenant
+// has no webhook endpoint.
+func (h *handler) getTargets(ctx context.Context, event *types.WebhookEvent) ([]delivery.Target, error) {
+	endpoints, err := h.endpointRepo.List(ctx, types.NewNoLimitWebhookEndpointFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(endpoints) > 0 {
+		now := time.Now().UTC()
+		targets := make([]delivery.Target, 0, len(endpoints))
+		for _, e := range endpoints {
+			if !e.SubscribesTo(event.EventName) {
+				continue
+			}
+
+			target, err := delivery.NewEndpointTarget(e, h.encryptionService, now)
+			if err != nil {
+				h.logger.Errorw("failed to decrypt webhook endpoint signing secret",
+					"error", err,
+					"webhook_endpoint_id", e.ID,
+				)
+				return nil, err
+			}
+			targets = append(targets, target)
+		}
+		return targets, nil
+	}
+
+	tenantCfg, ok := h.config.Tenants[event.TenantID]
+	if !ok || !tenantCfg.Enabled || lo.Contains(tenantCfg.ExcludedEvents, event.EventName) {
+		return nil, nil
+	}
+
+	return []delivery.Target{{
+		URL:     tenantCfg.Endpoint,
+		Headers: tenantCfg.Headers,
+	}}, nil
 }
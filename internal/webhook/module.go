@@ -2,9 +2,11 @@ package webhook
 
 import (
 	"github.com/flexprice/flexprice/internal/config"
+	domainOutbox "github.com/flexprice/flexprice/internal/domain/outbox"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/pubsub"
 	"github.com/flexprice/flexprice/internal/pubsub/memory"
+	"github.com/flexprice/flexprice/internal/pubsub/outbox"
 	"github.com/flexprice/flexprice/internal/service"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/webhook/handler"
@@ -19,6 +21,12 @@ var Module = fx.Options(
 	fx.Provide(
 		// PubSub for sending webhook events
 		providePubSub,
+
+		// Publisher writing to the outbox or directly to the pubsub
+		providePublisher,
+
+		// Relay publishing the outbox events to the pubsub
+		outbox.NewRelay,
 	),
 
 	// Webhook components
@@ -29,6 +37,9 @@ var Module = fx.Options(
 		// Handler for processing webhook events
 		handler.NewHandler,
 
+		// Dead letter queue for the events not delivered after all retries
+		handler.NewDeadLetterPublisher,
+
 		// Payload builder factory and services
 		providePayloadBuilderFactory,
 
@@ -45,6 +56,10 @@ func providePayloadBuilderFactory(
 	entitlementService service.EntitlementService,
 	featureService service.FeatureService,
 	subscriptionService service.SubscriptionService,
+	alertService service.AlertService,
+	paymentService service.PaymentService,
+	walletService service.WalletService,
+	customerService service.CustomerService,
 ) payload.PayloadBuilderFactory {
 	services := payload.NewServices(
 		invoiceService,
@@ -53,6 +68,10 @@ func providePayloadBuilderFactory(
 		entitlementService,
 		featureService,
 		subscriptionService,
+		alertService,
+		paymentService,
+		walletService,
+		customerService,
 	)
 	return payload.NewPayloadBuilderFactory(services)
 }
@@ -69,3 +88,17 @@ func providePubSub(
 	}
 	panic("unsupported pubsub type")
 }
+
+// providePublisher writes the webhook events to the outbox when it is enabled, so that they are
+// committed along with the state change they report and relayed to the pubsub afterwards
+func providePublisher(
+	cfg *config.Configuration,
+	pubSub pubsub.PubSub,
+	outboxRepo domainOutbox.Repository,
+	logger *logger.Logger,
+) pubsub.Publisher {
+	if cfg.Outbox.Enabled {
+		return outbox.NewPublisher(outboxRepo, logger)
+	}
+	return pubSub
+}
@@ -0,0 +1,54 @@
+package payload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+type AlertPayloadBuilder struct {
+	services *Services
+}
+
+func NewAlertPayloadBuilder(services *Services) PayloadBuilder {
+	return &AlertPayloadBuilder{
+		services: services,
+	}
+}
+
+// BuildPayload builds the webhook payload for usage alert events
+func (b *AlertPayloadBuilder) BuildPayload(ctx context.Context, eventType string, data interface{}) (json.RawMessage, error) {
+	parsedPayload := struct {
+		AlertEventID string `json:"alert_event_id"`
+		TenantID     string `json:"tenant_id"`
+	}{}
+
+	err := json.Unmarshal(data.(json.RawMessage), &parsedPayload)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Unable to unmarshal alert event payload").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	alertEventID, tenantID := parsedPayload.AlertEventID, parsedPayload.TenantID
+	if alertEventID == "" || tenantID == "" {
+		return nil, ierr.NewError("invalid data type for alert event").
+			WithHint("Please provide a valid alert event ID and tenant ID").
+			WithReportableDetails(map[string]any{
+				"expected": "string",
+				"got":      fmt.Sprintf("%T", data),
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	// Get the crossed threshold details
+	alertEvent, err := b.services.AlertService.GetAlertEvent(ctx, alertEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the alert event response as is
+	return json.Marshal(alertEvent)
+}
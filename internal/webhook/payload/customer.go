@@ -0,0 +1,54 @@
+package payload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+type CustomerPayloadBuilder struct {
+	services *Services
+}
+
+func NewCustomerPayloadBuilder(services *Services) PayloadBuilder {
+	return &CustomerPayloadBuilder{
+		services: services,
+	}
+}
+
+// BuildPayload builds the webhook payload for customer events
+func (b *CustomerPayloadBuilder) BuildPayload(ctx context.Context, eventType string, data interface{}) (json.RawMessage, error) {
+	parsedPayload := struct {
+		CustomerID string `json:"customer_id"`
+		TenantID   string `json:"tenant_id"`
+	}{}
+
+	err := json.Unmarshal(data.(json.RawMessage), &parsedPayload)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Unable to unmarshal customer event payload").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	customerID, tenantID := parsedPayload.CustomerID, parsedPayload.TenantID
+	if customerID == "" || tenantID == "" {
+		return nil, ierr.NewError("invalid data type for customer event").
+			WithHint("Please provide a valid customer ID and tenant ID").
+			WithReportableDetails(map[string]any{
+				"expected": "string",
+				"got":      fmt.Sprintf("%T", data),
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	// Get customer details
+	customer, err := b.services.CustomerService.GetCustomer(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the customer response as is
+	return json.Marshal(customer)
+}
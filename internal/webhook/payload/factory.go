@@ -36,6 +36,63 @@ func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 	f.builders[types.WebhookEventInvoiceUpdatePayment] = func() PayloadBuilder {
 		return NewInvoicePayloadBuilder(f.services)
 	}
+	f.builders[types.WebhookEventSubscriptionCreated] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionUpdated] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionCancelled] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionPaused] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionResumed] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionTrialWillEnd] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionTrialEnded] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventInvoiceDunningAttemptFailed] = func() PayloadBuilder {
+		return NewInvoicePayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventInvoiceDunningAttemptSucceeded] = func() PayloadBuilder {
+		return NewInvoicePayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionPastDue] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionRecovered] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionDunningExhausted] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventUsageThresholdCrossed] = func() PayloadBuilder {
+		return NewAlertPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventPaymentSucceeded] = func() PayloadBuilder {
+		return NewPaymentPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventPaymentFailed] = func() PayloadBuilder {
+		return NewPaymentPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventWalletTransactionCreated] = func() PayloadBuilder {
+		return NewWalletPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventWalletBalanceLow] = func() PayloadBuilder {
+		return NewWalletPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventCustomerCreated] = func() PayloadBuilder {
+		return NewCustomerPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventCustomerUpdated] = func() PayloadBuilder {
+		return NewCustomerPayloadBuilder(f.services)
+	}
 
 	return f
 }
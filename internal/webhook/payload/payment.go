@@ -0,0 +1,54 @@
+package payload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+type PaymentPayloadBuilder struct {
+	services *Services
+}
+
+func NewPaymentPayloadBuilder(services *Services) PayloadBuilder {
+	return &PaymentPayloadBuilder{
+		services: services,
+	}
+}
+
+// BuildPayload builds the webhook payload for payment events
+func (b *PaymentPayloadBuilder) BuildPayload(ctx context.Context, eventType string, data interface{}) (json.RawMessage, error) {
+	parsedPayload := struct {
+		PaymentID string `json:"payment_id"`
+		TenantID  string `json:"tenant_id"`
+	}{}
+
+	err := json.Unmarshal(data.(json.RawMessage), &parsedPayload)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Unable to unmarshal payment event payload").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	paymentID, tenantID := parsedPayload.PaymentID, parsedPayload.TenantID
+	if paymentID == "" || tenantID == "" {
+		return nil, ierr.NewError("invalid data type for payment event").
+			WithHint("Please provide a valid payment ID and tenant ID").
+			WithReportableDetails(map[string]any{
+				"expected": "string",
+				"got":      fmt.Sprintf("%T", data),
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	// Get payment details
+	payment, err := b.services.PaymentService.GetPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the payment response as is
+	return json.Marshal(payment)
+}
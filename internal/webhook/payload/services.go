@@ -10,6 +10,10 @@ type Services struct {
 	EntitlementService  service.EntitlementService
 	FeatureService      service.FeatureService
 	SubscriptionService service.SubscriptionService
+	AlertService        service.AlertService
+	PaymentService      service.PaymentService
+	WalletService       service.WalletService
+	CustomerService     service.CustomerService
 }
 
 // NewServices creates a new Services container
@@ -20,6 +24,10 @@ func NewServices(
 	entitlementService service.EntitlementService,
 	featureService service.FeatureService,
 	subscriptionService service.SubscriptionService,
+	alertService service.AlertService,
+	paymentService service.PaymentService,
+	walletService service.WalletService,
+	customerService service.CustomerService,
 ) *Services {
 	return &Services{
 		InvoiceService:      invoiceService,
@@ -28,5 +36,9 @@ func NewServices(
 		EntitlementService:  entitlementService,
 		FeatureService:      featureService,
 		SubscriptionService: subscriptionService,
+		AlertService:        alertService,
+		PaymentService:      paymentService,
+		WalletService:       walletService,
+		CustomerService:     customerService,
 	}
 }
@@ -0,0 +1,54 @@
+package payload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+type SubscriptionPayloadBuilder struct {
+	services *Services
+}
+
+func NewSubscriptionPayloadBuilder(services *Services) PayloadBuilder {
+	return &SubscriptionPayloadBuilder{
+		services: services,
+	}
+}
+
+// BuildPayload builds the webhook payload for subscription events
+func (b *SubscriptionPayloadBuilder) BuildPayload(ctx context.Context, eventType string, data interface{}) (json.RawMessage, error) {
+	parsedPayload := struct {
+		SubscriptionID string `json:"subscription_id"`
+		TenantID       string `json:"tenant_id"`
+	}{}
+
+	err := json.Unmarshal(data.(json.RawMessage), &parsedPayload)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Unable to unmarshal subscription event payload").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	subscriptionID, tenantID := parsedPayload.SubscriptionID, parsedPayload.TenantID
+	if subscriptionID == "" || tenantID == "" {
+		return nil, ierr.NewError("invalid data type for subscription event").
+			WithHint("Please provide a valid subscription ID and tenant ID").
+			WithReportableDetails(map[string]any{
+				"expected": "string",
+				"got":      fmt.Sprintf("%T", data),
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	// Get subscription details
+	subscription, err := b.services.SubscriptionService.GetSubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the subscription response as is
+	return json.Marshal(subscription)
+}
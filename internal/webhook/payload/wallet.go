@@ -0,0 +1,69 @@
+package payload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type WalletPayloadBuilder struct {
+	services *Services
+}
+
+func NewWalletPayloadBuilder(services *Services) PayloadBuilder {
+	return &WalletPayloadBuilder{
+		services: services,
+	}
+}
+
+// BuildPayload builds the webhook payload for wallet events. Transaction events carry the
+// transaction, the other wallet events carry the wallet.
+func (b *WalletPayloadBuilder) BuildPayload(ctx context.Context, eventType string, data interface{}) (json.RawMessage, error) {
+	parsedPayload := struct {
+		WalletID      string `json:"wallet_id"`
+		TransactionID string `json:"transaction_id"`
+		TenantID      string `json:"tenant_id"`
+	}{}
+
+	err := json.Unmarshal(data.(json.RawMessage), &parsedPayload)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Unable to unmarshal wallet event payload").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	walletID, transactionID, tenantID := parsedPayload.WalletID, parsedPayload.TransactionID, parsedPayload.TenantID
+	isTransactionEvent := eventType == types.WebhookEventWalletTransactionCreated
+	if walletID == "" || tenantID == "" || (isTransactionEvent && transactionID == "") {
+		return nil, ierr.NewError("invalid data type for wallet event").
+			WithHint("Please provide a valid wallet ID, transaction ID and tenant ID").
+			WithReportableDetails(map[string]any{
+				"expected": "string",
+				"got":      fmt.Sprintf("%T", data),
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	if isTransactionEvent {
+		// Get transaction details
+		transaction, err := b.services.WalletService.GetWalletTransactionByID(ctx, transactionID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Return the transaction response as is
+		return json.Marshal(transaction)
+	}
+
+	// Get wallet details
+	wallet, err := b.services.WalletService.GetWalletByID(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the wallet response as is
+	return json.Marshal(wallet)
+}
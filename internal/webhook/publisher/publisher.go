@@ -20,14 +20,14 @@ type WebhookPublisher interface {
 
 // Handler implements handler.Handler using watermill's gochannel
 type webhookPublisher struct {
-	pubSub pubsub.PubSub
+	pubSub pubsub.Publisher
 	config *config.Webhook
 	logger *logger.Logger
 }
 
 // NewHandler creates a new memory-based handler
 func NewPublisher(
-	pubSub pubsub.PubSub,
+	pubSub pubsub.Publisher,
 	cfg *config.Configuration,
 	logger *logger.Logger,
 ) (WebhookPublisher, error) {
@@ -0,0 +1,126 @@
+// Package signature signs the webhook deliveries and verifies their signatures.
+//
+// The signature header has the form "t=<unix timestamp>,v1=<signature>[,v1=<signature>]" where
+// each signature is the hex encoded HMAC-SHA256 of "<unix timestamp>.<body>" with one of the active
+// signing secrets of the endpoint. Two signatures are sent while a rotated secret is still active.
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+const (
+	// Header is the HTTP header carrying the signature of a webhook delivery
+	Header = "X-Flexprice-Signature"
+
+	// DefaultTolerance is the maximum age of a delivery accepted by Verify
+	DefaultTolerance = 5 * time.Minute
+
+	secretPrefix     = "whsec_"
+	timestampKey     = "t"
+	signatureVersion = "v1"
+)
+
+// GenerateSecret generates a new random signing secret
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to generate signing secret").
+			Mark(ierr.ErrSystem)
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
+
+// ComputeSignature computes the signature of the payload sent at the timestamp with the secret
+func ComputeSignature(timestamp time.Time, payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// BuildHeader builds the signature header of the payload sent at the timestamp, with one
+// signature for each secret
+func BuildHeader(timestamp time.Time, payload []byte, secrets ...string) string {
+	parts := make([]string, 0, len(secrets)+1)
+	parts = append(parts, timestampKey+"="+strconv.FormatInt(timestamp.Unix(), 10))
+	for _, secret := range secrets {
+		parts = append(parts, signatureVersion+"="+ComputeSignature(timestamp, payload, secret))
+	}
+	return strings.Join(parts, ",")
+}
+
+// Verify checks the signature header of a delivery was computed over the payload with the secret
+// and the delivery is not older than the tolerance. A zero tolerance disables the age check.
+func Verify(payload []byte, header string, secret string, tolerance time.Duration) error {
+	timestamp, signatures, err := parseHeader(header)
+	if err != nil {
+		return err
+	}
+
+	if tolerance > 0 && time.Since(timestamp) > tolerance {
+		return ierr.NewError("webhook signature timestamp is too old").
+			WithHint("The webhook delivery is older than the tolerance").
+			WithReportableDetails(map[string]any{
+				"timestamp": timestamp.Unix(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	expected := []byte(ComputeSignature(timestamp, payload, secret))
+	for _, signature := range signatures {
+		if hmac.Equal(expected, []byte(signature)) {
+			return nil
+		}
+	}
+
+	return ierr.NewError("no valid webhook signature found").
+		WithHint("None of the signatures of the webhook delivery match the signing secret").
+		Mark(ierr.ErrValidation)
+}
+
+// parseHeader extracts the timestamp and the signatures of a signature header
+func parseHeader(header string) (time.Time, []string, error) {
+	var (
+		timestamp  time.Time
+		signatures []string
+	)
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case timestampKey:
+			unix, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return time.Time{}, nil, ierr.WithError(err).
+					WithHint("The timestamp of the webhook signature header is invalid").
+					Mark(ierr.ErrValidation)
+			}
+			timestamp = time.Unix(unix, 0)
+		case signatureVersion:
+			signatures = append(signatures, value)
+		}
+	}
+
+	if timestamp.IsZero() || len(signatures) == 0 {
+		return time.Time{}, nil, ierr.NewError("invalid webhook signature header").
+			WithHint("The webhook signature header must contain a timestamp and at least one signature").
+			Mark(ierr.ErrValidation)
+	}
+
+	return timestamp, signatures, nil
+}
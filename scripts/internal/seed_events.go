@@ -58,9 +58,8 @@ func (g *EventGenerator) generateEvent(index int) dto.IngestEventRequest {
 	properties := make(map[string]interface{})
 
 	// Handle properties based on meter aggregation and filters
-	if selectedMeter.Aggregation.Type == types.AggregationSum ||
-		selectedMeter.Aggregation.Type == types.AggregationAvg {
-		// For sum/avg aggregation, we need to generate a value for the aggregation field
+	if selectedMeter.Aggregation.Type.RequiresField() {
+		// For field based aggregations, we need to generate a value for the aggregation field
 		if selectedMeter.Aggregation.Field != "" {
 			// Generate a random value between 1 and 1000
 			properties[selectedMeter.Aggregation.Field] = rand.Int63n(1000) + 1